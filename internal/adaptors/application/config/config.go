@@ -4,34 +4,73 @@ package config
 
 import (
 	"encoding/json"
+	"math"
 	"path/filepath"
+	"runtime"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabrelease"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 )
 
 const redactedValue = "[REDACTED]"
 
+// minMATLABMemoryLimit is the smallest MATLAB memory limit that is accepted. Smaller limits cannot start MATLAB at all.
+const minMATLABMemoryLimit = 1 << 20
+
 type validatedArguments struct {
 	versionMode     bool
 	helpMode        bool
 	watchdogMode    bool
 	setupMATLABMode bool
+	doctorMode      bool
+	doctorFormat    entities.ReportFormat
+	analyzeFolder   string
+	analyzeFormat   entities.ReportFormat
+	printConfigMode bool
 
+	configFile       string
 	baseDirectory    string
 	serverInstanceID string
 
 	// Logger
 	logLevel              entities.LogLevel
 	duplicateLogsToStderr bool
+	logMaxSize            uint64
+	logMaxAge             time.Duration
+	logMaxFiles           int
+	logPerSessionFiles    bool
+
+	// Audit
+	auditLogFolder    string
+	auditLogMaxSize   uint64
+	auditLogHashChain bool
+
+	// Tool output
+	maxToolOutputBytes int
 
 	// MATLAB
 	useSingleMATLABSession           bool
 	initializeMATLABOnStartup        bool
 	preferredLocalMATLABRoot         string
+	preferredMATLABRelease           string
+	matlabSearchFolders              []string
+	matlabStartupFlags               []string
+	matlabEnvironmentVariables       []string
+	matlabStartupScript              string
+	matlabPath                       []string
+	matlabSessionPoolSize            int
+	matlabIdleTimeout                time.Duration
+	matlabMemoryLimit                uint64
+	matlabQueueMaxDepth              int
+	matlabQueueWaitTimeout           time.Duration
+	workspaceSnapshotMaxSize         uint64
+	workspaceSnapshotMaxTotalSize    uint64
 	preferredMATLABStartingDirectory string
 	displayMode                      entities.DisplayMode
 	matlabSessionMode                entities.MATLABSessionMode
@@ -40,6 +79,9 @@ type validatedArguments struct {
 	matlabSessionDiscoveryTimeout    time.Duration
 	embeddedConnectorDetailsTimeout  time.Duration
 	extensionFiles                   []string
+	codePolicyFile                   string
+	confirmDestructiveTools          bool
+	openMATLABProject                bool
 
 	// Telemetry
 	disableTelemetry                   bool
@@ -105,6 +147,22 @@ func (c *config) DuplicateLogsToStderr() bool {
 	return c.duplicateLogsToStderr
 }
 
+func (c *config) LogMaxSize() uint64 {
+	return c.logMaxSize
+}
+
+func (c *config) LogMaxAge() time.Duration {
+	return c.logMaxAge
+}
+
+func (c *config) LogMaxFiles() int {
+	return c.logMaxFiles
+}
+
+func (c *config) LogPerSessionFiles() bool {
+	return c.logPerSessionFiles
+}
+
 func (c *config) VersionMode() bool {
 	return c.versionMode
 }
@@ -121,6 +179,30 @@ func (c *config) SetupMATLABMode() bool {
 	return c.setupMATLABMode
 }
 
+func (c *config) DoctorMode() bool {
+	return c.doctorMode
+}
+
+func (c *config) DoctorFormat() entities.ReportFormat {
+	return c.doctorFormat
+}
+
+func (c *config) AnalyzeFolder() string {
+	return c.analyzeFolder
+}
+
+func (c *config) AnalyzeFormat() entities.ReportFormat {
+	return c.analyzeFormat
+}
+
+func (c *config) PrintConfigMode() bool {
+	return c.printConfigMode
+}
+
+func (c *config) ConfigFile() string {
+	return c.configFile
+}
+
 func (c *config) UseSingleMATLABSession() bool {
 	return c.useSingleMATLABSession
 }
@@ -129,6 +211,58 @@ func (c *config) PreferredLocalMATLABRoot() string {
 	return c.preferredLocalMATLABRoot
 }
 
+func (c *config) PreferredMATLABRelease() string {
+	return c.preferredMATLABRelease
+}
+
+func (c *config) MATLABSearchFolders() []string {
+	return c.matlabSearchFolders
+}
+
+func (c *config) MATLABStartupFlags() []string {
+	return c.matlabStartupFlags
+}
+
+func (c *config) MATLABEnvironmentVariables() []string {
+	return c.matlabEnvironmentVariables
+}
+
+func (c *config) MATLABStartupScript() string {
+	return c.matlabStartupScript
+}
+
+func (c *config) MATLABPath() []string {
+	return c.matlabPath
+}
+
+func (c *config) MATLABSessionPoolSize() int {
+	return c.matlabSessionPoolSize
+}
+
+func (c *config) MATLABIdleTimeout() time.Duration {
+	return c.matlabIdleTimeout
+}
+
+func (c *config) MATLABMemoryLimit() uint64 {
+	return c.matlabMemoryLimit
+}
+
+func (c *config) MATLABQueueMaxDepth() int {
+	return c.matlabQueueMaxDepth
+}
+
+func (c *config) MATLABQueueWaitTimeout() time.Duration {
+	return c.matlabQueueWaitTimeout
+}
+
+func (c *config) WorkspaceSnapshotMaxSize() uint64 {
+	return c.workspaceSnapshotMaxSize
+}
+
+func (c *config) WorkspaceSnapshotMaxTotalSize() uint64 {
+	return c.workspaceSnapshotMaxTotalSize
+}
+
 func (c *config) PreferredMATLABStartingDirectory() string {
 	return c.preferredMATLABStartingDirectory
 }
@@ -168,6 +302,34 @@ func (c *config) ExtensionFiles() []string {
 	return c.extensionFiles
 }
 
+func (c *config) CodePolicyFile() string {
+	return c.codePolicyFile
+}
+
+func (c *config) ConfirmDestructiveTools() bool {
+	return c.confirmDestructiveTools
+}
+
+func (c *config) OpenMATLABProject() bool {
+	return c.openMATLABProject
+}
+
+func (c *config) AuditLogFolder() string {
+	return c.auditLogFolder
+}
+
+func (c *config) AuditLogMaxSize() uint64 {
+	return c.auditLogMaxSize
+}
+
+func (c *config) AuditLogHashChain() bool {
+	return c.auditLogHashChain
+}
+
+func (c *config) MaxToolOutputBytes() int {
+	return c.maxToolOutputBytes
+}
+
 func (c *config) BaseDir() string {
 	return c.baseDirectory
 }
@@ -253,6 +415,48 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		return validatedArguments{}, err
 	}
 
+	doctorMode, err := get(rawCfg, defaultparameters.DoctorMode())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	doctorFormat, err := get(rawCfg, defaultparameters.DoctorFormat())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	switch doctorFormat {
+	case string(entities.ReportFormatText), string(entities.ReportFormatJSON):
+	default:
+		return validatedArguments{}, messages.New_StartupErrors_InvalidDoctorFormat_Error(doctorFormat)
+	}
+
+	analyzeFolder, err := get(rawCfg, defaultparameters.AnalyzeFolder())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	analyzeFormat, err := get(rawCfg, defaultparameters.AnalyzeFormat())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	switch analyzeFormat {
+	case string(entities.ReportFormatText), string(entities.ReportFormatJSON), string(entities.ReportFormatSARIF):
+	default:
+		return validatedArguments{}, messages.New_StartupErrors_InvalidAnalyzeFormat_Error(analyzeFormat)
+	}
+
+	printConfigMode, err := get(rawCfg, defaultparameters.PrintConfigMode())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	configFile, err := get(rawCfg, defaultparameters.ConfigFile())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
 	baseDirectory, err := get(rawCfg, defaultparameters.BaseDir())
 	if err != nil {
 		return validatedArguments{}, err
@@ -279,6 +483,71 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		return validatedArguments{}, err
 	}
 
+	rawLogMaxSize, err := get(rawCfg, defaultparameters.LogMaxSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	var logMaxSize uint64
+	if rawLogMaxSize != "" {
+		var ok bool
+		if logMaxSize, ok = parseMemorySize(rawLogMaxSize); !ok {
+			return validatedArguments{}, messages.New_StartupErrors_InvalidLogMaxSize_Error(rawLogMaxSize)
+		}
+	}
+
+	logMaxAge, err := get(rawCfg, defaultparameters.LogMaxAge())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if logMaxAge < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidLogMaxAge_Error(logMaxAge.String())
+	}
+
+	logMaxFiles, err := get(rawCfg, defaultparameters.LogMaxFiles())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if logMaxFiles < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidLogMaxFiles_Error(strconv.Itoa(logMaxFiles))
+	}
+
+	logPerSessionFiles, err := get(rawCfg, defaultparameters.LogPerSessionFiles())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	auditLogFolder, err := get(rawCfg, defaultparameters.AuditLogFolder())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	rawAuditLogMaxSize, err := get(rawCfg, defaultparameters.AuditLogMaxSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	auditLogMaxSize, validAuditLogMaxSize := parseMemorySize(rawAuditLogMaxSize)
+	if !validAuditLogMaxSize {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidAuditLogMaxSize_Error(rawAuditLogMaxSize)
+	}
+
+	auditLogHashChain, err := get(rawCfg, defaultparameters.AuditLogHashChain())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	maxToolOutputBytes, err := get(rawCfg, defaultparameters.MaxToolOutputBytes())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if maxToolOutputBytes < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMaxToolOutputBytes_Error(strconv.Itoa(maxToolOutputBytes))
+	}
+
 	useSingleMATLABSession, err := get(rawCfg, defaultparameters.UseSingleMATLABSession())
 	if err != nil {
 		return validatedArguments{}, err
@@ -293,11 +562,154 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		initializeMATLABOnStartup = false
 	}
 
+	matlabSessionPoolSize, err := get(rawCfg, defaultparameters.MATLABSessionPoolSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabSessionPoolSize < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABSessionPoolSize_Error(strconv.Itoa(matlabSessionPoolSize))
+	}
+
+	// The pool only serves the start_matlab_session tool, which is not available with a single session
+	if useSingleMATLABSession {
+		matlabSessionPoolSize = 0
+	}
+
+	matlabIdleTimeout, err := get(rawCfg, defaultparameters.MATLABIdleTimeout())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabIdleTimeout < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABIdleTimeout_Error(matlabIdleTimeout.String())
+	}
+
+	rawMATLABMemoryLimit, err := get(rawCfg, defaultparameters.MATLABMemoryLimit())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	var matlabMemoryLimit uint64
+	if rawMATLABMemoryLimit != "" {
+		var ok bool
+		if matlabMemoryLimit, ok = parseMemorySize(rawMATLABMemoryLimit); !ok {
+			return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABMemoryLimit_Error(rawMATLABMemoryLimit)
+		}
+
+		if matlabMemoryLimit < minMATLABMemoryLimit {
+			return validatedArguments{}, messages.New_StartupErrors_MATLABMemoryLimitTooSmall_Error(rawMATLABMemoryLimit)
+		}
+
+		// The limit is applied with RLIMIT_AS, which only caps the memory of MATLAB on Linux
+		if runtime.GOOS != "linux" {
+			return validatedArguments{}, messages.New_StartupErrors_UnsupportedMATLABMemoryLimit_Error()
+		}
+	}
+
+	matlabQueueMaxDepth, err := get(rawCfg, defaultparameters.MATLABQueueMaxDepth())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabQueueMaxDepth < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABQueueMaxDepth_Error(strconv.Itoa(matlabQueueMaxDepth))
+	}
+
+	matlabQueueWaitTimeout, err := get(rawCfg, defaultparameters.MATLABQueueWaitTimeout())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	if matlabQueueWaitTimeout < 0 {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABQueueWaitTimeout_Error(matlabQueueWaitTimeout.String())
+	}
+
+	rawWorkspaceSnapshotMaxSize, err := get(rawCfg, defaultparameters.WorkspaceSnapshotMaxSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	workspaceSnapshotMaxSize, validWorkspaceSnapshotMaxSize := parseMemorySize(rawWorkspaceSnapshotMaxSize)
+	if !validWorkspaceSnapshotMaxSize {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error(rawWorkspaceSnapshotMaxSize)
+	}
+
+	rawWorkspaceSnapshotMaxTotalSize, err := get(rawCfg, defaultparameters.WorkspaceSnapshotMaxTotalSize())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	workspaceSnapshotMaxTotalSize, validWorkspaceSnapshotMaxTotalSize := parseMemorySize(rawWorkspaceSnapshotMaxTotalSize)
+	if !validWorkspaceSnapshotMaxTotalSize {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error(rawWorkspaceSnapshotMaxTotalSize)
+	}
+
 	preferredLocalMATLABRoot, err := get(rawCfg, defaultparameters.PreferredLocalMATLABRoot())
 	if err != nil {
 		return validatedArguments{}, err
 	}
 
+	rawPreferredMATLABRelease, err := get(rawCfg, defaultparameters.PreferredMATLABRelease())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	preferredMATLABRelease, parseErr := matlabrelease.ParseSelector(rawPreferredMATLABRelease)
+	if parseErr != nil {
+		return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABRelease_Error(rawPreferredMATLABRelease)
+	}
+
+	rawMATLABSearchFolders, err := get(rawCfg, defaultparameters.MATLABSearchFolders())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	var matlabSearchFolders []string
+	for _, entry := range rawMATLABSearchFolders {
+		matlabSearchFolders = append(matlabSearchFolders, filepath.SplitList(entry)...)
+	}
+
+	rawMATLABStartupFlags, err := get(rawCfg, defaultparameters.MATLABStartupFlags())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	var matlabStartupFlags []string
+	for _, entry := range rawMATLABStartupFlags {
+		flags, ok := splitStartupFlags(entry)
+		if !ok {
+			return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABStartupFlag_Error(entry)
+		}
+		matlabStartupFlags = append(matlabStartupFlags, flags...)
+	}
+
+	matlabEnvironmentVariables, err := get(rawCfg, defaultparameters.MATLABEnvironmentVariables())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	for _, entry := range matlabEnvironmentVariables {
+		if name, _, found := strings.Cut(entry, "="); !found || name == "" {
+			return validatedArguments{}, messages.New_StartupErrors_InvalidMATLABEnvironmentVariable_Error(entry)
+		}
+	}
+
+	matlabStartupScript, err := get(rawCfg, defaultparameters.MATLABStartupScript())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	rawMATLABPath, err := get(rawCfg, defaultparameters.MATLABPath())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	var matlabPath []string
+	for _, entry := range rawMATLABPath {
+		matlabPath = append(matlabPath, filepath.SplitList(entry)...)
+	}
+
 	preferredMATLABStartingDirectory, err := get(rawCfg, defaultparameters.PreferredMATLABStartingDirectory())
 	if err != nil {
 		return validatedArguments{}, err
@@ -325,6 +737,21 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		extensionFiles = append(extensionFiles, filepath.SplitList(entry)...)
 	}
 
+	codePolicyFile, err := get(rawCfg, defaultparameters.CodePolicyFile())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	confirmDestructiveTools, err := get(rawCfg, defaultparameters.ConfirmDestructiveTools())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
+	openMATLABProject, err := get(rawCfg, defaultparameters.OpenMATLABProject())
+	if err != nil {
+		return validatedArguments{}, err
+	}
+
 	matlabSessionMode, err := get(rawCfg, defaultparameters.MATLABSessionMode())
 	if err != nil {
 		return validatedArguments{}, err
@@ -397,18 +824,49 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		helpMode:        helpMode,
 		watchdogMode:    watchdogMode,
 		setupMATLABMode: setupMATLABMode,
+		doctorMode:      doctorMode,
+		doctorFormat:    entities.ReportFormat(doctorFormat),
+		analyzeFolder:   analyzeFolder,
+		analyzeFormat:   entities.ReportFormat(analyzeFormat),
+		printConfigMode: printConfigMode,
 
+		configFile:       configFile,
 		baseDirectory:    baseDirectory,
 		serverInstanceID: serverInstanceID,
 
 		// Logger
 		logLevel:              entities.LogLevel(logLevel),
 		duplicateLogsToStderr: duplicateLogsToStderr,
+		logMaxSize:            logMaxSize,
+		logMaxAge:             logMaxAge,
+		logMaxFiles:           logMaxFiles,
+		logPerSessionFiles:    logPerSessionFiles,
+
+		// Audit
+		auditLogFolder:    auditLogFolder,
+		auditLogMaxSize:   auditLogMaxSize,
+		auditLogHashChain: auditLogHashChain,
+
+		// Tool output
+		maxToolOutputBytes: maxToolOutputBytes,
 
 		// MATLAB
 		useSingleMATLABSession:           useSingleMATLABSession,
 		initializeMATLABOnStartup:        initializeMATLABOnStartup,
 		preferredLocalMATLABRoot:         preferredLocalMATLABRoot,
+		preferredMATLABRelease:           preferredMATLABRelease.String(),
+		matlabSearchFolders:              matlabSearchFolders,
+		matlabStartupFlags:               matlabStartupFlags,
+		matlabEnvironmentVariables:       matlabEnvironmentVariables,
+		matlabStartupScript:              matlabStartupScript,
+		matlabPath:                       matlabPath,
+		matlabSessionPoolSize:            matlabSessionPoolSize,
+		matlabIdleTimeout:                matlabIdleTimeout,
+		matlabMemoryLimit:                matlabMemoryLimit,
+		matlabQueueMaxDepth:              matlabQueueMaxDepth,
+		matlabQueueWaitTimeout:           matlabQueueWaitTimeout,
+		workspaceSnapshotMaxSize:         workspaceSnapshotMaxSize,
+		workspaceSnapshotMaxTotalSize:    workspaceSnapshotMaxTotalSize,
 		preferredMATLABStartingDirectory: preferredMATLABStartingDirectory,
 		displayMode:                      entities.DisplayMode(displayMode),
 		matlabSessionMode:                entities.MATLABSessionMode(matlabSessionMode),
@@ -417,6 +875,9 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 		matlabSessionDiscoveryTimeout:    matlabSessionDiscoveryTimeout,
 		embeddedConnectorDetailsTimeout:  embeddedConnectorDetailsTimeout,
 		extensionFiles:                   extensionFiles,
+		codePolicyFile:                   codePolicyFile,
+		confirmDestructiveTools:          confirmDestructiveTools,
+		openMATLABProject:                openMATLABProject,
 
 		// Telemetry
 		disableTelemetry:                   disableTelemetry,
@@ -435,9 +896,9 @@ func validateArguments(rawCfg *rawConfig) (validatedArguments, messages.Error) {
 }
 
 func adjustDefaults(args validatedArguments, specifiedParameters []string) validatedArguments {
-	// If installing the MATLAB Add-On, and displayMode isn't specified
+	// If installing the MATLAB Add-On, running the doctor checks or analyzing code, and displayMode isn't specified
 	// it's a better user experience to not flash the desktop
-	if args.setupMATLABMode &&
+	if (args.setupMATLABMode || args.doctorMode || args.analyzeFolder != "") &&
 		!slices.Contains(specifiedParameters, defaultparameters.MATLABDisplayMode().GetID()) {
 		args.displayMode = entities.DisplayModeNoDesktop
 	}
@@ -470,10 +931,27 @@ func checkArgumentCompatibility(args validatedArguments, specifiedParameters []s
 		)
 	}
 
+	// An explicit MATLAB root leaves nothing for the release selector to choose from
+	if args.preferredLocalMATLABRoot != "" && args.preferredMATLABRelease != "" {
+		return messages.New_StartupErrors_MutuallyExclusiveArguments_Error(
+			defaultparameters.PreferredLocalMATLABRoot().GetFlagName(),
+			defaultparameters.PreferredMATLABRelease().GetFlagName(),
+		)
+	}
+
 	// If using MATLAB Session Mode `existing`, most of the MATLAB flags are unsupported
 	if args.matlabSessionMode == entities.MATLABSessionModeExisting {
 		disallowedParametersInExistingSessionMode := []entities.Parameter{
 			defaultparameters.PreferredLocalMATLABRoot(),
+			defaultparameters.PreferredMATLABRelease(),
+			defaultparameters.MATLABSearchFolders(),
+			defaultparameters.MATLABStartupFlags(),
+			defaultparameters.MATLABEnvironmentVariables(),
+			defaultparameters.MATLABStartupScript(),
+			defaultparameters.MATLABPath(),
+			defaultparameters.MATLABSessionPoolSize(),
+			defaultparameters.MATLABIdleTimeout(),
+			defaultparameters.MATLABMemoryLimit(),
 			defaultparameters.PreferredMATLABStartingDirectory(),
 			defaultparameters.MATLABDisplayMode(),
 		}
@@ -490,6 +968,40 @@ func checkArgumentCompatibility(args validatedArguments, specifiedParameters []s
 	return nil
 }
 
+// parseMemorySize parses a size with a binary unit, such as 512MB or 8GB, into bytes.
+func parseMemorySize(value string) (uint64, bool) {
+	units := []struct {
+		suffix     string
+		multiplier uint64
+	}{
+		{"TB", 1 << 40},
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"T", 1 << 40},
+		{"G", 1 << 30},
+		{"M", 1 << 20},
+		{"K", 1 << 10},
+		{"B", 1},
+	}
+
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	for _, unit := range units {
+		number, found := strings.CutSuffix(normalized, unit.suffix)
+		if !found {
+			continue
+		}
+
+		size, err := strconv.ParseUint(strings.TrimSpace(number), 10, 64)
+		if err != nil || size == 0 || size > math.MaxUint64/unit.multiplier {
+			return 0, false
+		}
+		return size * unit.multiplier, true
+	}
+
+	return 0, false
+}
+
 func getForKey(args map[string]any, key string) (any, messages.Error) {
 	if value, ok := args[key]; ok {
 		return value, nil
@@ -5,6 +5,7 @@ package config_test
 import (
 	"encoding/json"
 	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
@@ -24,17 +25,44 @@ func defaultParameters() []entities.Parameter {
 		defaultparameters.VersionMode(),
 		defaultparameters.WatchdogMode(),
 		defaultparameters.SetupMATLABMode(),
+		defaultparameters.DoctorMode(),
+		defaultparameters.DoctorFormat(),
+		defaultparameters.AnalyzeFolder(),
+		defaultparameters.AnalyzeFormat(),
+		defaultparameters.PrintConfigMode(),
 
+		defaultparameters.ConfigFile(),
 		defaultparameters.BaseDir(),
 		defaultparameters.ServerInstanceID(),
 
 		defaultparameters.LogLevel(),
 		defaultparameters.DuplicateLogsToStderr(),
+		defaultparameters.LogMaxSize(),
+		defaultparameters.LogMaxAge(),
+		defaultparameters.LogMaxFiles(),
+		defaultparameters.LogPerSessionFiles(),
+		defaultparameters.AuditLogFolder(),
+		defaultparameters.AuditLogMaxSize(),
+		defaultparameters.AuditLogHashChain(),
+		defaultparameters.MaxToolOutputBytes(),
 
 		defaultparameters.UseSingleMATLABSession(),
 		defaultparameters.PreferredLocalMATLABRoot(),
+		defaultparameters.PreferredMATLABRelease(),
+		defaultparameters.MATLABSearchFolders(),
+		defaultparameters.MATLABStartupFlags(),
+		defaultparameters.MATLABEnvironmentVariables(),
+		defaultparameters.MATLABStartupScript(),
+		defaultparameters.MATLABPath(),
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.InitializeMATLABOnStartup(),
+		defaultparameters.MATLABSessionPoolSize(),
+		defaultparameters.MATLABIdleTimeout(),
+		defaultparameters.MATLABMemoryLimit(),
+		defaultparameters.MATLABQueueMaxDepth(),
+		defaultparameters.MATLABQueueWaitTimeout(),
+		defaultparameters.WorkspaceSnapshotMaxSize(),
+		defaultparameters.WorkspaceSnapshotMaxTotalSize(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
 		defaultparameters.MATLABSessionConnectionDetails(),
@@ -44,6 +72,9 @@ func defaultParameters() []entities.Parameter {
 
 		defaultparameters.DisableTelemetry(),
 		defaultparameters.ExtensionFiles(),
+		defaultparameters.CodePolicyFile(),
+		defaultparameters.ConfirmDestructiveTools(),
+		defaultparameters.OpenMATLABProject(),
 		defaultparameters.TelemetryCollectorEndpoint(),
 		defaultparameters.TelemetryCollectionInterval(),
 		defaultparameters.TelemetryCollectorEndpointInsecure(),
@@ -68,16 +99,45 @@ func TestNewConfig_InvalidParameterType(t *testing.T) {
 		{key: defaultparameters.HelpMode().GetID(), invalidValue: "false", expectedType: "bool"},
 		{key: defaultparameters.WatchdogMode().GetID(), invalidValue: "false", expectedType: "bool"},
 		{key: defaultparameters.SetupMATLABMode().GetID(), invalidValue: "false", expectedType: "bool"},
+		{key: defaultparameters.DoctorMode().GetID(), invalidValue: "false", expectedType: "bool"},
+		{key: defaultparameters.DoctorFormat().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.AnalyzeFolder().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.AnalyzeFormat().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.PrintConfigMode().GetID(), invalidValue: "false", expectedType: "bool"},
 
+		{key: defaultparameters.ConfigFile().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.BaseDir().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.ServerInstanceID().GetID(), invalidValue: 123, expectedType: "string"},
 
 		{key: defaultparameters.LogLevel().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.DuplicateLogsToStderr().GetID(), invalidValue: "false", expectedType: "bool"},
+		{key: defaultparameters.LogMaxSize().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.LogMaxAge().GetID(), invalidValue: "24h", expectedType: "time.Duration"},
+		{key: defaultparameters.LogMaxFiles().GetID(), invalidValue: "5", expectedType: "int"},
+		{key: defaultparameters.LogPerSessionFiles().GetID(), invalidValue: "true", expectedType: "bool"},
+
+		{key: defaultparameters.AuditLogFolder().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.AuditLogMaxSize().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.AuditLogHashChain().GetID(), invalidValue: "true", expectedType: "bool"},
+
+		{key: defaultparameters.MaxToolOutputBytes().GetID(), invalidValue: "100000", expectedType: "int"},
 
 		{key: defaultparameters.UseSingleMATLABSession().GetID(), invalidValue: "true", expectedType: "bool"},
 		{key: defaultparameters.InitializeMATLABOnStartup().GetID(), invalidValue: "false", expectedType: "bool"},
 		{key: defaultparameters.PreferredLocalMATLABRoot().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.PreferredMATLABRelease().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.MATLABSearchFolders().GetID(), invalidValue: "not-a-slice", expectedType: "[]string"},
+		{key: defaultparameters.MATLABStartupFlags().GetID(), invalidValue: "not-a-slice", expectedType: "[]string"},
+		{key: defaultparameters.MATLABEnvironmentVariables().GetID(), invalidValue: "not-a-slice", expectedType: "[]string"},
+		{key: defaultparameters.MATLABStartupScript().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.MATLABPath().GetID(), invalidValue: "not-a-slice", expectedType: "[]string"},
+		{key: defaultparameters.MATLABSessionPoolSize().GetID(), invalidValue: "2", expectedType: "int"},
+		{key: defaultparameters.MATLABIdleTimeout().GetID(), invalidValue: "30m", expectedType: "time.Duration"},
+		{key: defaultparameters.MATLABMemoryLimit().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.MATLABQueueMaxDepth().GetID(), invalidValue: "16", expectedType: "int"},
+		{key: defaultparameters.MATLABQueueWaitTimeout().GetID(), invalidValue: "5m", expectedType: "time.Duration"},
+		{key: defaultparameters.WorkspaceSnapshotMaxSize().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.WorkspaceSnapshotMaxTotalSize().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.PreferredMATLABStartingDirectory().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABDisplayMode().GetID(), invalidValue: 123, expectedType: "string"},
 		{key: defaultparameters.MATLABSessionMode().GetID(), invalidValue: 123, expectedType: "string"},
@@ -86,6 +146,9 @@ func TestNewConfig_InvalidParameterType(t *testing.T) {
 		{key: defaultparameters.MATLABSessionDiscoveryTimeout().GetID(), invalidValue: "30s", expectedType: "time.Duration"},
 		{key: defaultparameters.EmbeddedConnectorDetailsTimeout().GetID(), invalidValue: "1m", expectedType: "time.Duration"},
 		{key: defaultparameters.ExtensionFiles().GetID(), invalidValue: "not-a-slice", expectedType: "[]string"},
+		{key: defaultparameters.CodePolicyFile().GetID(), invalidValue: 123, expectedType: "string"},
+		{key: defaultparameters.ConfirmDestructiveTools().GetID(), invalidValue: "true", expectedType: "bool"},
+		{key: defaultparameters.OpenMATLABProject().GetID(), invalidValue: "true", expectedType: "bool"},
 
 		{key: defaultparameters.DisableTelemetry().GetID(), invalidValue: "false", expectedType: "bool"},
 		{key: defaultparameters.TelemetryCollectorEndpoint().GetID(), invalidValue: 123, expectedType: "string"},
@@ -139,13 +202,40 @@ func TestNewConfig_MissingParameter(t *testing.T) {
 		defaultparameters.HelpMode(),
 		defaultparameters.WatchdogMode(),
 		defaultparameters.SetupMATLABMode(),
+		defaultparameters.DoctorMode(),
+		defaultparameters.DoctorFormat(),
+		defaultparameters.AnalyzeFolder(),
+		defaultparameters.AnalyzeFormat(),
+		defaultparameters.PrintConfigMode(),
+		defaultparameters.ConfigFile(),
 		defaultparameters.BaseDir(),
 		defaultparameters.ServerInstanceID(),
 		defaultparameters.LogLevel(),
 		defaultparameters.DuplicateLogsToStderr(),
+		defaultparameters.LogMaxSize(),
+		defaultparameters.LogMaxAge(),
+		defaultparameters.LogMaxFiles(),
+		defaultparameters.LogPerSessionFiles(),
+		defaultparameters.AuditLogFolder(),
+		defaultparameters.AuditLogMaxSize(),
+		defaultparameters.AuditLogHashChain(),
+		defaultparameters.MaxToolOutputBytes(),
 		defaultparameters.UseSingleMATLABSession(),
 		defaultparameters.InitializeMATLABOnStartup(),
+		defaultparameters.MATLABSessionPoolSize(),
+		defaultparameters.MATLABIdleTimeout(),
+		defaultparameters.MATLABMemoryLimit(),
+		defaultparameters.MATLABQueueMaxDepth(),
+		defaultparameters.MATLABQueueWaitTimeout(),
+		defaultparameters.WorkspaceSnapshotMaxSize(),
+		defaultparameters.WorkspaceSnapshotMaxTotalSize(),
 		defaultparameters.PreferredLocalMATLABRoot(),
+		defaultparameters.PreferredMATLABRelease(),
+		defaultparameters.MATLABSearchFolders(),
+		defaultparameters.MATLABStartupFlags(),
+		defaultparameters.MATLABEnvironmentVariables(),
+		defaultparameters.MATLABStartupScript(),
+		defaultparameters.MATLABPath(),
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
@@ -154,6 +244,9 @@ func TestNewConfig_MissingParameter(t *testing.T) {
 		defaultparameters.MATLABSessionDiscoveryTimeout(),
 		defaultparameters.EmbeddedConnectorDetailsTimeout(),
 		defaultparameters.ExtensionFiles(),
+		defaultparameters.CodePolicyFile(),
+		defaultparameters.ConfirmDestructiveTools(),
+		defaultparameters.OpenMATLABProject(),
 		defaultparameters.DisableTelemetry(),
 		defaultparameters.TelemetryCollectorEndpoint(),
 		defaultparameters.TelemetryCollectionInterval(),
@@ -172,35 +265,1397 @@ func TestNewConfig_MissingParameter(t *testing.T) {
 			mockBuildInfo := &configmocks.MockBuildInfo{}
 			defer mockBuildInfo.AssertExpectations(t)
 
-			programName := "testprocess"
-			args := []string{programName}
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			delete(parsedArgs, parameter.GetID())
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_InvalidParameterKey_Error(parameter.GetID())
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_ParseError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return(nil, nil, nil, messages.AnError).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.ErrorIs(t, err, messages.AnError)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestNewConfig_InvalidLogLevel(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidLevel := "invalid-level"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.LogLevel().GetID()] = invalidLevel
+
+	expectedError := messages.New_StartupErrors_InvalidLogLevel_Error(invalidLevel)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestNewConfig_InvalidDisplayMode(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidMode := "invalid-mode"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABDisplayMode().GetID()] = invalidMode
+
+	expectedError := messages.New_StartupErrors_InvalidDisplayMode_Error(invalidMode)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestNewConfig_InvalidMATLABSessionMode(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidMode := "invalid-mode"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABSessionMode().GetID()] = invalidMode
+
+	expectedError := messages.New_StartupErrors_InvalidMATLABSessionMode_Error(invalidMode)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestNewConfig_InvalidMATLABRelease(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidRelease := "R24b"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.PreferredMATLABRelease().GetID()] = invalidRelease
+
+	expectedError := messages.New_StartupErrors_InvalidMATLABRelease_Error(invalidRelease)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestConfig_PreferredMATLABRelease_IsNormalized(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: ""},
+		{input: "Latest", expected: "latest"},
+		{input: "r2024B", expected: "R2024b"},
+		{input: ">= R2023b", expected: ">=R2023b"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.PreferredMATLABRelease().GetID()] = tc.input
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg.PreferredMATLABRelease())
+		})
+	}
+}
+
+func TestNewConfig_MATLABRootAndMATLABReleaseAreMutuallyExclusive(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.PreferredLocalMATLABRoot().GetID()] = filepath.Join("usr", "local", "MATLAB")
+	parsedArgs[defaultparameters.PreferredMATLABRelease().GetID()] = "latest"
+
+	expectedError := messages.New_StartupErrors_MutuallyExclusiveArguments_Error(
+		defaultparameters.PreferredLocalMATLABRoot().GetFlagName(),
+		defaultparameters.PreferredMATLABRelease().GetFlagName(),
+	)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg, "Config should be nil")
+}
+
+func TestConfig_MATLABSearchFolders_ExpandsPathSeparator(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	folderA := filepath.Join("opt", "tools", "MATLAB")
+	folderB := filepath.Join("srv", "MATLAB")
+	folderC := filepath.Join("home", "user", "MATLAB")
+	combinedEntry := folderA + string(filepath.ListSeparator) + folderB
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABSearchFolders().GetID()] = []string{combinedEntry, folderC}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{folderA, folderB, folderC}, cfg.MATLABSearchFolders())
+}
+
+func TestConfig_MATLABLaunchSettings_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	folderA := filepath.Join("home", "user", "lib")
+	folderB := filepath.Join("home", "user", "utils")
+	startupScript := filepath.Join("home", "user", "project", "startup.m")
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABStartupFlags().GetID()] = []string{"-singleCompThread -softwareopengl", "-logfile"}
+	parsedArgs[defaultparameters.MATLABEnvironmentVariables().GetID()] = []string{"MLM_LICENSE_FILE=27000@licenseserver", "HTTPS_PROXY=http://proxy:8080"}
+	parsedArgs[defaultparameters.MATLABStartupScript().GetID()] = startupScript
+	parsedArgs[defaultparameters.MATLABPath().GetID()] = []string{folderA + string(filepath.ListSeparator) + folderB}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-singleCompThread", "-softwareopengl", "-logfile"}, cfg.MATLABStartupFlags())
+	assert.Equal(t, []string{"MLM_LICENSE_FILE=27000@licenseserver", "HTTPS_PROXY=http://proxy:8080"}, cfg.MATLABEnvironmentVariables())
+	assert.Equal(t, startupScript, cfg.MATLABStartupScript())
+	assert.Equal(t, []string{folderA, folderB}, cfg.MATLABPath())
+}
+
+func TestNewConfig_InvalidMATLABEnvironmentVariable(t *testing.T) {
+	testCases := []string{
+		"NO_EQUALS_SIGN",
+		"=value",
+	}
+
+	for _, invalidEntry := range testCases {
+		t.Run(invalidEntry, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABEnvironmentVariables().GetID()] = []string{"VALID=1", invalidEntry}
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_InvalidMATLABEnvironmentVariable_Error(invalidEntry)
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_InvalidMATLABSessionPoolSize(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABSessionPoolSize().GetID()] = -1
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	expectedError := messages.New_StartupErrors_InvalidMATLABSessionPoolSize_Error("-1")
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_MATLABSessionPoolSize_DisabledWhenSingleSession(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedPoolSize       int
+	}{
+		{name: "single session", useSingleMATLABSession: true, expectedPoolSize: 0},
+		{name: "multiple sessions", useSingleMATLABSession: false, expectedPoolSize: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.UseSingleMATLABSession().GetID()] = tc.useSingleMATLABSession
+			parsedArgs[defaultparameters.MATLABSessionPoolSize().GetID()] = 2
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPoolSize, cfg.MATLABSessionPoolSize())
+		})
+	}
+}
+
+func TestConfig_MATLABIdleTimeoutAndMemoryLimit_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                string
+		idleTimeout         time.Duration
+		memoryLimit         string
+		expectedMemoryLimit uint64
+	}{
+		{name: "disabled", idleTimeout: 0, memoryLimit: "", expectedMemoryLimit: 0},
+		{name: "gigabytes", idleTimeout: 30 * time.Minute, memoryLimit: "8GB", expectedMemoryLimit: 8 << 30},
+		{name: "short megabytes", idleTimeout: time.Hour, memoryLimit: "512m", expectedMemoryLimit: 512 << 20},
+		{name: "space before unit", idleTimeout: time.Hour, memoryLimit: "2 TB", expectedMemoryLimit: 2 << 40},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.memoryLimit != "" && runtime.GOOS != "linux" {
+				t.Skip("The MATLAB memory limit is supported on Linux only")
+			}
+
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABIdleTimeout().GetID()] = tc.idleTimeout
+			parsedArgs[defaultparameters.MATLABMemoryLimit().GetID()] = tc.memoryLimit
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.idleTimeout, cfg.MATLABIdleTimeout())
+			assert.Equal(t, tc.expectedMemoryLimit, cfg.MATLABMemoryLimit())
+		})
+	}
+}
+
+func TestNewConfig_InvalidMATLABIdleTimeout(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABIdleTimeout().GetID()] = -time.Minute
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	expectedError := messages.New_StartupErrors_InvalidMATLABIdleTimeout_Error("-1m0s")
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_MATLABQueue_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABQueueMaxDepth().GetID()] = 4
+	parsedArgs[defaultparameters.MATLABQueueWaitTimeout().GetID()] = 30 * time.Second
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 4, cfg.MATLABQueueMaxDepth())
+	assert.Equal(t, 30*time.Second, cfg.MATLABQueueWaitTimeout())
+}
+
+func TestConfig_MATLABQueue_Defaults(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 16, cfg.MATLABQueueMaxDepth())
+	assert.Equal(t, 5*time.Minute, cfg.MATLABQueueWaitTimeout())
+}
+
+func TestNewConfig_InvalidMATLABQueue(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           string
+		invalidValue  any
+		expectedError messages.Error
+	}{
+		{
+			name:          "negative maximum depth",
+			key:           defaultparameters.MATLABQueueMaxDepth().GetID(),
+			invalidValue:  -1,
+			expectedError: messages.New_StartupErrors_InvalidMATLABQueueMaxDepth_Error("-1"),
+		},
+		{
+			name:          "negative wait timeout",
+			key:           defaultparameters.MATLABQueueWaitTimeout().GetID(),
+			invalidValue:  -time.Minute,
+			expectedError: messages.New_StartupErrors_InvalidMATLABQueueWaitTimeout_Error("-1m0s"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[testCase.key] = testCase.invalidValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, testCase.expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_WorkspaceSnapshotSizes_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.WorkspaceSnapshotMaxSize().GetID()] = "512MB"
+	parsedArgs[defaultparameters.WorkspaceSnapshotMaxTotalSize().GetID()] = "2GB"
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uint64(512<<20), cfg.WorkspaceSnapshotMaxSize())
+	assert.Equal(t, uint64(2<<30), cfg.WorkspaceSnapshotMaxTotalSize())
+}
+
+func TestConfig_WorkspaceSnapshotSizes_Defaults(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1<<30), cfg.WorkspaceSnapshotMaxSize())
+	assert.Equal(t, uint64(4<<30), cfg.WorkspaceSnapshotMaxTotalSize())
+}
+
+func TestNewConfig_InvalidWorkspaceSnapshotSizes(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           string
+		invalidValue  string
+		expectedError messages.Error
+	}{
+		{
+			name:          "maximum size without unit",
+			key:           defaultparameters.WorkspaceSnapshotMaxSize().GetID(),
+			invalidValue:  "512",
+			expectedError: messages.New_StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error("512"),
+		},
+		{
+			name:          "zero maximum total size",
+			key:           defaultparameters.WorkspaceSnapshotMaxTotalSize().GetID(),
+			invalidValue:  "0GB",
+			expectedError: messages.New_StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error("0GB"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[testCase.key] = testCase.invalidValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, testCase.expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_InvalidMATLABMemoryLimit(t *testing.T) {
+	testCases := []string{
+		"8",
+		"GB",
+		"0GB",
+		"-1GB",
+		"eightGB",
+		"99999999999TB",
+	}
+
+	for _, invalidValue := range testCases {
+		t.Run(invalidValue, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABMemoryLimit().GetID()] = invalidValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_InvalidMATLABMemoryLimit_Error(invalidValue)
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_MATLABMemoryLimitTooSmall(t *testing.T) {
+	testCases := []string{
+		"1B",
+		"512KB",
+		"1023KB",
+	}
+
+	for _, tooSmallValue := range testCases {
+		t.Run(tooSmallValue, func(t *testing.T) {
+			if runtime.GOOS != "linux" {
+				t.Skip("The MATLAB memory limit is only supported on Linux")
+			}
+
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABMemoryLimit().GetID()] = tooSmallValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_MATLABMemoryLimitTooSmall_Error(tooSmallValue)
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestNewConfig_MATLABMemoryLimitUnsupportedPlatform(t *testing.T) {
+	if runtime.GOOS == "linux" {
+		t.Skip("The MATLAB memory limit is supported on Linux")
+	}
+
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABMemoryLimit().GetID()] = "8GB"
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	expectedError := messages.New_StartupErrors_UnsupportedMATLABMemoryLimit_Error()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_ExtensionFiles_ExpandsPathSeparator(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	fileA := filepath.Join("path", "to", "a.json")
+	fileB := filepath.Join("path", "to", "b.json")
+	combinedEntry := fileA + string(filepath.ListSeparator) + fileB
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{combinedEntry}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileA, fileB}, cfg.ExtensionFiles())
+}
+
+func TestConfig_ExtensionFiles_MultipleEntriesWithSeparators(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	fileA := filepath.Join("path", "to", "a.json")
+	fileB := filepath.Join("path", "to", "b.json")
+	fileC := filepath.Join("path", "to", "c.json")
+	combinedEntry := fileA + string(filepath.ListSeparator) + fileB
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{combinedEntry, fileC}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileA, fileB, fileC}, cfg.ExtensionFiles())
+}
+
+func TestConfig_ExtensionFiles_SingleEntryWithoutSeparator(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	expectedFile := filepath.Join("path", "to", "tools.json")
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{expectedFile}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{expectedFile}, cfg.ExtensionFiles())
+}
+
+func TestConfig_ExtensionFiles_NilInput(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string(nil)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, cfg.ExtensionFiles())
+}
+
+func TestConfig_CodePolicyFile_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	expectedFile := filepath.Join("policies", "code-policy.json")
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.CodePolicyFile().GetID()] = expectedFile
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFile, cfg.CodePolicyFile())
+}
+
+func TestConfig_ConfigFile_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	expectedFile := filepath.Join("settings", "matlab-mcp-server.yaml")
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ConfigFile().GetID()] = expectedFile
+	parsedArgs[defaultparameters.PrintConfigMode().GetID()] = true
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFile, cfg.ConfigFile())
+	assert.True(t, cfg.PrintConfigMode())
+}
+
+func TestConfig_DoctorMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.DoctorMode().GetID()] = true
+	parsedArgs[defaultparameters.DoctorFormat().GetID()] = "json"
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cfg.DoctorMode())
+	assert.Equal(t, entities.ReportFormatJSON, cfg.DoctorFormat())
+	assert.False(t, cfg.ShouldShowMATLABDesktop())
+}
+
+func TestNewConfig_InvalidDoctorFormat(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidFormat := "xml"
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.DoctorFormat().GetID()] = invalidFormat
+
+	expectedError := messages.New_StartupErrors_InvalidDoctorFormat_Error(invalidFormat)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_AnalyzeMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.AnalyzeFolder().GetID()] = "/work/project"
+	parsedArgs[defaultparameters.AnalyzeFormat().GetID()] = "sarif"
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/work/project", cfg.AnalyzeFolder())
+	assert.Equal(t, entities.ReportFormatSARIF, cfg.AnalyzeFormat())
+	assert.False(t, cfg.ShouldShowMATLABDesktop())
+}
+
+func TestNewConfig_InvalidAnalyzeFormat(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	invalidFormat := "xml"
 
-			parsedArgs := configDefaultParsedArgs()
-			delete(parsedArgs, parameter.GetID())
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.AnalyzeFormat().GetID()] = invalidFormat
 
-			mockOSLayer.EXPECT().
-				Args().
-				Return(args).
-				Once()
+	expectedError := messages.New_StartupErrors_InvalidAnalyzeFormat_Error(invalidFormat)
 
-			mockParser.EXPECT().
-				Parse(args[1:]).
-				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
-				Once()
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
 
-			expectedError := messages.New_StartupErrors_InvalidParameterKey_Error(parameter.GetID())
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
 
-			// Act
-			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
 
-			// Assert
-			require.Equal(t, expectedError, err)
-			assert.Nil(t, cfg)
-		})
-	}
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
 }
 
-func TestNewConfig_ParseError(t *testing.T) {
+func TestConfig_ConfirmDestructiveTools_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -214,6 +1669,9 @@ func TestNewConfig_ParseError(t *testing.T) {
 	programName := "testprocess"
 	args := []string{programName}
 
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.ConfirmDestructiveTools().GetID()] = true
+
 	mockOSLayer.EXPECT().
 		Args().
 		Return(args).
@@ -221,18 +1679,18 @@ func TestNewConfig_ParseError(t *testing.T) {
 
 	mockParser.EXPECT().
 		Parse(args[1:]).
-		Return(nil, nil, nil, messages.AnError).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
 		Once()
 
 	// Act
 	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
 
 	// Assert
-	require.ErrorIs(t, err, messages.AnError)
-	assert.Nil(t, cfg, "Config should be nil")
+	require.NoError(t, err)
+	assert.True(t, cfg.ConfirmDestructiveTools())
 }
 
-func TestNewConfig_InvalidLogLevel(t *testing.T) {
+func TestConfig_OpenMATLABProject_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -245,12 +1703,9 @@ func TestNewConfig_InvalidLogLevel(t *testing.T) {
 
 	programName := "testprocess"
 	args := []string{programName}
-	invalidLevel := "invalid-level"
 
 	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.LogLevel().GetID()] = invalidLevel
-
-	expectedError := messages.New_StartupErrors_InvalidLogLevel_Error(invalidLevel)
+	parsedArgs[defaultparameters.OpenMATLABProject().GetID()] = true
 
 	mockOSLayer.EXPECT().
 		Args().
@@ -266,11 +1721,11 @@ func TestNewConfig_InvalidLogLevel(t *testing.T) {
 	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
 
 	// Assert
-	require.Equal(t, expectedError, err)
-	assert.Nil(t, cfg, "Config should be nil")
+	require.NoError(t, err)
+	assert.True(t, cfg.OpenMATLABProject())
 }
 
-func TestNewConfig_InvalidDisplayMode(t *testing.T) {
+func TestConfig_LogFiles_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -283,12 +1738,12 @@ func TestNewConfig_InvalidDisplayMode(t *testing.T) {
 
 	programName := "testprocess"
 	args := []string{programName}
-	invalidMode := "invalid-mode"
 
 	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.MATLABDisplayMode().GetID()] = invalidMode
-
-	expectedError := messages.New_StartupErrors_InvalidDisplayMode_Error(invalidMode)
+	parsedArgs[defaultparameters.LogMaxSize().GetID()] = "10MB"
+	parsedArgs[defaultparameters.LogMaxAge().GetID()] = 24 * time.Hour
+	parsedArgs[defaultparameters.LogMaxFiles().GetID()] = 3
+	parsedArgs[defaultparameters.LogPerSessionFiles().GetID()] = true
 
 	mockOSLayer.EXPECT().
 		Args().
@@ -304,11 +1759,14 @@ func TestNewConfig_InvalidDisplayMode(t *testing.T) {
 	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
 
 	// Assert
-	require.Equal(t, expectedError, err)
-	assert.Nil(t, cfg, "Config should be nil")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10<<20), cfg.LogMaxSize())
+	assert.Equal(t, 24*time.Hour, cfg.LogMaxAge())
+	assert.Equal(t, 3, cfg.LogMaxFiles())
+	assert.True(t, cfg.LogPerSessionFiles())
 }
 
-func TestNewConfig_InvalidMATLABSessionMode(t *testing.T) {
+func TestConfig_LogFiles_Defaults(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -321,12 +1779,6 @@ func TestNewConfig_InvalidMATLABSessionMode(t *testing.T) {
 
 	programName := "testprocess"
 	args := []string{programName}
-	invalidMode := "invalid-mode"
-
-	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.MATLABSessionMode().GetID()] = invalidMode
-
-	expectedError := messages.New_StartupErrors_InvalidMATLABSessionMode_Error(invalidMode)
 
 	mockOSLayer.EXPECT().
 		Args().
@@ -335,18 +1787,98 @@ func TestNewConfig_InvalidMATLABSessionMode(t *testing.T) {
 
 	mockParser.EXPECT().
 		Parse(args[1:]).
-		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
 		Once()
 
 	// Act
 	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
 
 	// Assert
-	require.Equal(t, expectedError, err)
-	assert.Nil(t, cfg, "Config should be nil")
+	require.NoError(t, err)
+	assert.Zero(t, cfg.LogMaxSize())
+	assert.Zero(t, cfg.LogMaxAge())
+	assert.Equal(t, 5, cfg.LogMaxFiles())
+	assert.False(t, cfg.LogPerSessionFiles())
 }
 
-func TestConfig_ExtensionFiles_ExpandsPathSeparator(t *testing.T) {
+func TestNewConfig_InvalidLogFiles(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           string
+		invalidValue  any
+		expectedError messages.Error
+	}{
+		{
+			name:          "size without unit",
+			key:           defaultparameters.LogMaxSize().GetID(),
+			invalidValue:  "100",
+			expectedError: messages.New_StartupErrors_InvalidLogMaxSize_Error("100"),
+		},
+		{
+			name:          "zero size",
+			key:           defaultparameters.LogMaxSize().GetID(),
+			invalidValue:  "0MB",
+			expectedError: messages.New_StartupErrors_InvalidLogMaxSize_Error("0MB"),
+		},
+		{
+			name:          "negative age",
+			key:           defaultparameters.LogMaxAge().GetID(),
+			invalidValue:  -time.Hour,
+			expectedError: messages.New_StartupErrors_InvalidLogMaxAge_Error("-1h0m0s"),
+		},
+		{
+			name:          "negative number of files",
+			key:           defaultparameters.LogMaxFiles().GetID(),
+			invalidValue:  -1,
+			expectedError: messages.New_StartupErrors_InvalidLogMaxFiles_Error("-1"),
+		},
+		{
+			name:          "negative maximum tool output size",
+			key:           defaultparameters.MaxToolOutputBytes().GetID(),
+			invalidValue:  -1,
+			expectedError: messages.New_StartupErrors_InvalidMaxToolOutputBytes_Error("-1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[testCase.key] = testCase.invalidValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, testCase.expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_AuditLog_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -359,13 +1891,12 @@ func TestConfig_ExtensionFiles_ExpandsPathSeparator(t *testing.T) {
 
 	programName := "testprocess"
 	args := []string{programName}
-
-	fileA := filepath.Join("path", "to", "a.json")
-	fileB := filepath.Join("path", "to", "b.json")
-	combinedEntry := fileA + string(filepath.ListSeparator) + fileB
+	expectedFolder := filepath.Join("path", "to", "audit")
 
 	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{combinedEntry}
+	parsedArgs[defaultparameters.AuditLogFolder().GetID()] = expectedFolder
+	parsedArgs[defaultparameters.AuditLogMaxSize().GetID()] = "10MB"
+	parsedArgs[defaultparameters.AuditLogHashChain().GetID()] = true
 
 	mockOSLayer.EXPECT().
 		Args().
@@ -382,10 +1913,12 @@ func TestConfig_ExtensionFiles_ExpandsPathSeparator(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, []string{fileA, fileB}, cfg.ExtensionFiles())
+	assert.Equal(t, expectedFolder, cfg.AuditLogFolder())
+	assert.Equal(t, uint64(10<<20), cfg.AuditLogMaxSize())
+	assert.True(t, cfg.AuditLogHashChain())
 }
 
-func TestConfig_ExtensionFiles_MultipleEntriesWithSeparators(t *testing.T) {
+func TestConfig_AuditLog_Defaults(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -399,14 +1932,6 @@ func TestConfig_ExtensionFiles_MultipleEntriesWithSeparators(t *testing.T) {
 	programName := "testprocess"
 	args := []string{programName}
 
-	fileA := filepath.Join("path", "to", "a.json")
-	fileB := filepath.Join("path", "to", "b.json")
-	fileC := filepath.Join("path", "to", "c.json")
-	combinedEntry := fileA + string(filepath.ListSeparator) + fileB
-
-	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{combinedEntry, fileC}
-
 	mockOSLayer.EXPECT().
 		Args().
 		Return(args).
@@ -414,7 +1939,7 @@ func TestConfig_ExtensionFiles_MultipleEntriesWithSeparators(t *testing.T) {
 
 	mockParser.EXPECT().
 		Parse(args[1:]).
-		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
 		Once()
 
 	// Act
@@ -422,10 +1947,12 @@ func TestConfig_ExtensionFiles_MultipleEntriesWithSeparators(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, []string{fileA, fileB, fileC}, cfg.ExtensionFiles())
+	assert.Empty(t, cfg.AuditLogFolder())
+	assert.Equal(t, uint64(100<<20), cfg.AuditLogMaxSize())
+	assert.False(t, cfg.AuditLogHashChain())
 }
 
-func TestConfig_ExtensionFiles_SingleEntryWithoutSeparator(t *testing.T) {
+func TestConfig_MaxToolOutputBytes_HappyPath(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -439,10 +1966,8 @@ func TestConfig_ExtensionFiles_SingleEntryWithoutSeparator(t *testing.T) {
 	programName := "testprocess"
 	args := []string{programName}
 
-	expectedFile := filepath.Join("path", "to", "tools.json")
-
 	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string{expectedFile}
+	parsedArgs[defaultparameters.MaxToolOutputBytes().GetID()] = 2048
 
 	mockOSLayer.EXPECT().
 		Args().
@@ -459,10 +1984,10 @@ func TestConfig_ExtensionFiles_SingleEntryWithoutSeparator(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, []string{expectedFile}, cfg.ExtensionFiles())
+	assert.Equal(t, 2048, cfg.MaxToolOutputBytes())
 }
 
-func TestConfig_ExtensionFiles_NilInput(t *testing.T) {
+func TestConfig_MaxToolOutputBytes_Default(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
@@ -476,9 +2001,6 @@ func TestConfig_ExtensionFiles_NilInput(t *testing.T) {
 	programName := "testprocess"
 	args := []string{programName}
 
-	parsedArgs := configDefaultParsedArgs()
-	parsedArgs[defaultparameters.ExtensionFiles().GetID()] = []string(nil)
-
 	mockOSLayer.EXPECT().
 		Args().
 		Return(args).
@@ -486,7 +2008,7 @@ func TestConfig_ExtensionFiles_NilInput(t *testing.T) {
 
 	mockParser.EXPECT().
 		Parse(args[1:]).
-		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
 		Once()
 
 	// Act
@@ -494,7 +2016,55 @@ func TestConfig_ExtensionFiles_NilInput(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Nil(t, cfg.ExtensionFiles())
+	assert.Equal(t, 100000, cfg.MaxToolOutputBytes())
+}
+
+func TestNewConfig_InvalidAuditLogMaxSize(t *testing.T) {
+	testCases := []string{
+		"",
+		"100",
+		"0MB",
+		"tenMB",
+	}
+
+	for _, invalidValue := range testCases {
+		t.Run(invalidValue, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.AuditLogMaxSize().GetID()] = invalidValue
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			expectedError := messages.New_StartupErrors_InvalidAuditLogMaxSize_Error(invalidValue)
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.Equal(t, expectedError, err)
+			assert.Nil(t, cfg)
+		})
+	}
 }
 
 func TestConfig_Version_HappyPath(t *testing.T) {
@@ -949,6 +2519,15 @@ func TestConfig_RecordToLogger_HappyPath(t *testing.T) {
 func TestNewConfig_ExistingSessionMode_DisallowedParameter(t *testing.T) {
 	disallowedParameters := []entities.Parameter{
 		defaultparameters.PreferredLocalMATLABRoot(),
+		defaultparameters.PreferredMATLABRelease(),
+		defaultparameters.MATLABSearchFolders(),
+		defaultparameters.MATLABStartupFlags(),
+		defaultparameters.MATLABEnvironmentVariables(),
+		defaultparameters.MATLABStartupScript(),
+		defaultparameters.MATLABPath(),
+		defaultparameters.MATLABSessionPoolSize(),
+		defaultparameters.MATLABIdleTimeout(),
+		defaultparameters.MATLABMemoryLimit(),
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.MATLABDisplayMode(),
 	}
@@ -1452,3 +3031,113 @@ func TestConfig_AsPIISafeJSONString_HappyPath(t *testing.T) {
 		assert.Equal(t, config.RedactedValue, parsed[param.GetID()], "%s should be redacted", param.GetID())
 	}
 }
+
+func TestConfig_MATLABStartupFlags_Quoted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         string
+		expectedFlags []string
+	}{
+		{
+			name:          "double quoted path with spaces",
+			value:         `-logfile "C:\My Logs\matlab.log"`,
+			expectedFlags: []string{"-logfile", `C:\My Logs\matlab.log`},
+		},
+		{
+			name:          "single quoted path with spaces",
+			value:         `-logfile '/tmp/my logs/matlab.log' -nosplash`,
+			expectedFlags: []string{"-logfile", "/tmp/my logs/matlab.log", "-nosplash"},
+		},
+		{
+			name:          "escaped double quote",
+			value:         `-r "disp(\"a b\")"`,
+			expectedFlags: []string{"-r", `disp("a b")`},
+		},
+		{
+			name:          "empty quoted flag",
+			value:         `-sd ""`,
+			expectedFlags: []string{"-sd", ""},
+		},
+		{
+			name:          "unquoted backslashes",
+			value:         `-logfile C:\logs\matlab.log`,
+			expectedFlags: []string{"-logfile", `C:\logs\matlab.log`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockParser := &configmocks.MockParser{}
+			defer mockParser.AssertExpectations(t)
+
+			mockBuildInfo := &configmocks.MockBuildInfo{}
+			defer mockBuildInfo.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName}
+
+			parsedArgs := configDefaultParsedArgs()
+			parsedArgs[defaultparameters.MATLABStartupFlags().GetID()] = []string{tc.value}
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			mockParser.EXPECT().
+				Parse(args[1:]).
+				Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+				Once()
+
+			// Act
+			cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFlags, cfg.MATLABStartupFlags())
+		})
+	}
+}
+
+func TestNewConfig_InvalidMATLABStartupFlag(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+
+	invalidEntry := `-logfile "/tmp/my logs/matlab.log`
+
+	parsedArgs := configDefaultParsedArgs()
+	parsedArgs[defaultparameters.MATLABStartupFlags().GetID()] = []string{invalidEntry}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, parsedArgs, []string{}, nil).
+		Once()
+
+	expectedError := messages.New_StartupErrors_InvalidMATLABStartupFlag_Error(invalidEntry)
+
+	// Act
+	cfg, err := config.NewConfig(mockOSLayer, mockParser, mockBuildInfo)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, cfg)
+}
@@ -12,6 +12,8 @@ import (
 
 type Parser interface {
 	Parse(args []string) ([]entities.Parameter, map[string]any, []string, messages.Error)
+	SetProjectConfigFile(path string)
+	ProjectConfigFileSettings() []entities.ConfigFileSetting
 }
 
 type OSLayer interface {
@@ -34,19 +36,50 @@ type Config interface {
 	VersionMode() bool
 	WatchdogMode() bool
 	SetupMATLABMode() bool
+	DoctorMode() bool
+	DoctorFormat() entities.ReportFormat
+	AnalyzeFolder() string
+	AnalyzeFormat() entities.ReportFormat
+	PrintConfigMode() bool
 
+	ConfigFile() string
 	BaseDir() string
 	ServerInstanceID() string
 
 	// Logger
 	LogLevel() entities.LogLevel
 	DuplicateLogsToStderr() bool
+	LogMaxSize() uint64
+	LogMaxAge() time.Duration
+	LogMaxFiles() int
+	LogPerSessionFiles() bool
 	RecordToLogger(logger entities.Logger)
 
+	// Audit
+	AuditLogFolder() string
+	AuditLogMaxSize() uint64
+	AuditLogHashChain() bool
+
+	// Tool output
+	MaxToolOutputBytes() int
+
 	// MATLAB
 	UseSingleMATLABSession() bool
 	InitializeMATLABOnStartup() bool
 	PreferredLocalMATLABRoot() string
+	PreferredMATLABRelease() string
+	MATLABSearchFolders() []string
+	MATLABStartupFlags() []string
+	MATLABEnvironmentVariables() []string
+	MATLABStartupScript() string
+	MATLABPath() []string
+	MATLABSessionPoolSize() int
+	MATLABIdleTimeout() time.Duration
+	MATLABMemoryLimit() uint64
+	MATLABQueueMaxDepth() int
+	MATLABQueueWaitTimeout() time.Duration
+	WorkspaceSnapshotMaxSize() uint64
+	WorkspaceSnapshotMaxTotalSize() uint64
 	PreferredMATLABStartingDirectory() string
 	ShouldShowMATLABDesktop() bool
 	MATLABSessionMode() entities.MATLABSessionMode
@@ -55,6 +88,9 @@ type Config interface {
 	MATLABSessionDiscoveryTimeout() time.Duration
 	EmbeddedConnectorDetailsTimeout() time.Duration
 	ExtensionFiles() []string
+	CodePolicyFile() string
+	ConfirmDestructiveTools() bool
+	OpenMATLABProject() bool
 
 	// Telemetry
 	DisableTelemetry() bool
@@ -70,9 +106,12 @@ type Factory struct {
 	osLayer   OSLayer
 	buildInfo BuildInfo
 
-	initOnce       sync.Once
-	initError      messages.Error
-	configInstance *config
+	initOnce  sync.Once
+	initError messages.Error
+
+	lock              sync.RWMutex
+	configInstance    *config
+	projectConfigFile string
 }
 
 func NewFactory(parser Parser, osLayer OSLayer, buildInfo BuildInfo) *Factory {
@@ -98,5 +137,36 @@ func (f *Factory) Config() (Config, messages.Error) {
 		return nil, f.initError
 	}
 
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
 	return f.configInstance, nil
 }
+
+// UseProjectConfigFile reads the configuration again with the configuration file of a project, which overrides
+// the configuration file from --config, and returns the settings of the project configuration file.
+// An empty path removes the project configuration file.
+// If the new configuration is not valid, the factory keeps the current configuration.
+func (f *Factory) UseProjectConfigFile(path string) ([]entities.ConfigFileSetting, messages.Error) {
+	if _, err := f.Config(); err != nil {
+		return nil, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if path == f.projectConfigFile {
+		return nil, nil
+	}
+
+	f.parser.SetProjectConfigFile(path)
+	configInstance, err := newConfig(f.osLayer, f.parser, f.buildInfo)
+	if err != nil {
+		f.parser.SetProjectConfigFile(f.projectConfigFile)
+		return nil, err
+	}
+
+	f.projectConfigFile = path
+	f.configInstance = configInstance
+	return f.parser.ProjectConfigFileSettings(), nil
+}
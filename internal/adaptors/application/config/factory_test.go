@@ -3,9 +3,11 @@
 package config_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
@@ -137,3 +139,128 @@ func TestFactory_Config_SingletonPreservesError(t *testing.T) {
 	assert.Nil(t, cfg1, "First config should be nil")
 	assert.Nil(t, cfg2, "Second config should be nil")
 }
+
+func TestFactory_UseProjectConfigFile_ReplacesConfig(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	projectConfigFile := filepath.Join("project", ".matlab-mcp-server.yaml")
+
+	projectParsedArgs := configDefaultParsedArgs()
+	projectParsedArgs[defaultparameters.MaxToolOutputBytes().GetID()] = 5000
+	expectedSettings := []entities.ConfigFileSetting{
+		{Key: "max-tool-output-bytes", Value: "5000"},
+		{Key: "code-policy-file", Ignored: true},
+	}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Twice()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
+		Once()
+
+	mockParser.EXPECT().
+		SetProjectConfigFile(projectConfigFile).
+		Return().
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, projectParsedArgs, []string{}, nil).
+		Once()
+
+	mockParser.EXPECT().
+		ProjectConfigFileSettings().
+		Return(expectedSettings).
+		Once()
+
+	factory := config.NewFactory(mockParser, mockOSLayer, mockBuildInfo)
+
+	initialCfg, err := factory.Config()
+	require.NoError(t, err)
+
+	// Act
+	firstSettings, firstErr := factory.UseProjectConfigFile(projectConfigFile)
+	secondSettings, secondErr := factory.UseProjectConfigFile(projectConfigFile)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr, "Using the same project configuration file again should not read the configuration again")
+	assert.Equal(t, expectedSettings, firstSettings)
+	assert.Empty(t, secondSettings)
+
+	cfg, err := factory.Config()
+	require.NoError(t, err)
+	assert.Equal(t, 100000, initialCfg.MaxToolOutputBytes())
+	assert.Equal(t, 5000, cfg.MaxToolOutputBytes())
+}
+
+func TestFactory_UseProjectConfigFile_KeepsConfigOnError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockParser := &configmocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockBuildInfo := &configmocks.MockBuildInfo{}
+	defer mockBuildInfo.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName}
+	projectConfigFile := filepath.Join("project", ".matlab-mcp-server.toml")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Twice()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return([]entities.Parameter{}, configDefaultParsedArgs(), []string{}, nil).
+		Once()
+
+	mockParser.EXPECT().
+		SetProjectConfigFile(projectConfigFile).
+		Return().
+		Once()
+
+	mockParser.EXPECT().
+		Parse(args[1:]).
+		Return(nil, nil, nil, messages.AnError).
+		Once()
+
+	mockParser.EXPECT().
+		SetProjectConfigFile("").
+		Return().
+		Once()
+
+	factory := config.NewFactory(mockParser, mockOSLayer, mockBuildInfo)
+
+	initialCfg, err := factory.Config()
+	require.NoError(t, err)
+
+	// Act
+	settings, useErr := factory.UseProjectConfigFile(projectConfigFile)
+
+	// Assert
+	require.ErrorIs(t, useErr, messages.AnError)
+	assert.Nil(t, settings)
+
+	cfg, err := factory.Config()
+	require.NoError(t, err)
+	assert.Same(t, initialCfg, cfg, "The factory should keep the current configuration when the project configuration is not valid")
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package config
+
+import "strings"
+
+// splitStartupFlags splits a value of the MATLAB startup flag parameter into flags, the way a shell splits words.
+// Single or double quotes keep spaces in a flag, for example in -logfile "C:\My Logs\matlab.log".
+// Inside double quotes, a backslash escapes a double quote or a backslash. Elsewhere, backslashes are kept, so that Windows paths work unquoted.
+// The second return value is false when a quote is not closed.
+func splitStartupFlags(value string) ([]string, bool) {
+	var (
+		flags   []string
+		current strings.Builder
+		inFlag  bool
+		quote   rune
+	)
+
+	runes := []rune(value)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			switch {
+			case r == '"':
+				quote = 0
+			case r == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\'):
+				i++
+				current.WriteRune(runes[i])
+			default:
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inFlag = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inFlag {
+				flags = append(flags, current.String())
+				current.Reset()
+				inFlag = false
+			}
+		default:
+			current.WriteRune(r)
+			inFlag = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, false
+	}
+
+	if inFlag {
+		flags = append(flags, current.String())
+	}
+
+	return flags, true
+}
@@ -3,9 +3,12 @@
 package definition
 
 import (
+	"time"
+
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type MessageCatalog interface {
@@ -24,6 +27,11 @@ type Definition struct {
 	dependenciesProvider DependenciesProvider
 
 	toolsProvider ToolsProvider
+
+	toolMiddlewareProvider ToolMiddlewareProvider
+
+	lifecycleHooks       []LifecycleHook
+	lifecycleHookTimeout time.Duration
 }
 
 func New(
@@ -34,6 +42,9 @@ func New(
 	parameters []entities.Parameter,
 	dependenciesProvider DependenciesProvider,
 	toolsProvider ToolsProvider,
+	toolMiddlewareProvider ToolMiddlewareProvider,
+	lifecycleHooks []LifecycleHook,
+	lifecycleHookTimeout time.Duration,
 ) Definition {
 	return Definition{
 		name:         name,
@@ -47,6 +58,11 @@ func New(
 		dependenciesProvider: dependenciesProvider,
 
 		toolsProvider: toolsProvider,
+
+		toolMiddlewareProvider: toolMiddlewareProvider,
+
+		lifecycleHooks:       lifecycleHooks,
+		lifecycleHookTimeout: lifecycleHookTimeout,
 	}
 }
 
@@ -85,3 +101,23 @@ func (d Definition) Tools(resources ToolsProviderResources) []tools.Tool {
 
 	return d.toolsProvider(resources)
 }
+
+func (d Definition) ToolMiddleware(resources ToolsProviderResources) []mcp.Middleware {
+	if d.toolMiddlewareProvider == nil {
+		return nil
+	}
+
+	return d.toolMiddlewareProvider(resources)
+}
+
+func (d Definition) LifecycleHooks() []LifecycleHook {
+	return d.lifecycleHooks
+}
+
+func (d Definition) LifecycleHookTimeout() time.Duration {
+	if d.lifecycleHookTimeout <= 0 {
+		return DefaultLifecycleHookTimeout
+	}
+
+	return d.lifecycleHookTimeout
+}
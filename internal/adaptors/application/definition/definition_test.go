@@ -4,6 +4,7 @@ package definition_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
@@ -12,13 +13,14 @@ import (
 	toolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools"
 	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/require"
 )
 
 func TestDefinition_Name_HappyPath(t *testing.T) {
 	// Arrange
 	expectedName := "my-definition"
-	def := definition.New(expectedName, "", "", definition.Features{}, nil, nil, nil)
+	def := definition.New(expectedName, "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Name()
@@ -30,7 +32,7 @@ func TestDefinition_Name_HappyPath(t *testing.T) {
 func TestDefinition_Title_HappyPath(t *testing.T) {
 	// Arrange
 	expectedTitle := "My Definition Title"
-	def := definition.New("", expectedTitle, "", definition.Features{}, nil, nil, nil)
+	def := definition.New("", expectedTitle, "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Title()
@@ -42,7 +44,7 @@ func TestDefinition_Title_HappyPath(t *testing.T) {
 func TestDefinition_Instructions_HappyPath(t *testing.T) {
 	// Arrange
 	expectedInstructions := "These are the instructions"
-	def := definition.New("", "", expectedInstructions, definition.Features{}, nil, nil, nil)
+	def := definition.New("", "", expectedInstructions, definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Instructions()
@@ -56,7 +58,7 @@ func TestDefinition_Features_HappyPath(t *testing.T) {
 	expectedFeatures := definition.Features{
 		MATLAB: definition.MATLABFeature{Enabled: true},
 	}
-	def := definition.New("", "", "", expectedFeatures, nil, nil, nil)
+	def := definition.New("", "", "", expectedFeatures, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Features()
@@ -74,7 +76,7 @@ func TestDefinition_Parameters_HappyPath(t *testing.T) {
 	defer mockParam2.AssertExpectations(t)
 
 	expectedParameters := []entities.Parameter{mockParam1, mockParam2}
-	def := definition.New("", "", "", definition.Features{}, expectedParameters, nil, nil)
+	def := definition.New("", "", "", definition.Features{}, expectedParameters, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Parameters()
@@ -86,7 +88,7 @@ func TestDefinition_Parameters_HappyPath(t *testing.T) {
 func TestDefinition_Parameters_EmptySlice(t *testing.T) {
 	// Arrange
 	expectedParameters := []entities.Parameter{}
-	def := definition.New("", "", "", definition.Features{}, expectedParameters, nil, nil)
+	def := definition.New("", "", "", definition.Features{}, expectedParameters, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Parameters()
@@ -97,7 +99,7 @@ func TestDefinition_Parameters_EmptySlice(t *testing.T) {
 
 func TestDefinition_Parameters_Nil(t *testing.T) {
 	// Arrange
-	def := definition.New("", "", "", definition.Features{}, nil, nil, nil)
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Parameters()
@@ -120,7 +122,7 @@ func TestDefinition_Dependencies_HappyPath(t *testing.T) {
 		return expectedDependencies, nil
 	}
 
-	def := definition.New("", "", "", definition.Features{}, nil, dependenciesProvider, nil)
+	def := definition.New("", "", "", definition.Features{}, nil, dependenciesProvider, nil, nil, nil, 0)
 
 	// Act
 	result, err := def.Dependencies(expectedResources)
@@ -137,7 +139,7 @@ func TestDefinition_Dependencies_NilProvider(t *testing.T) {
 	expectedResources := definition.DependenciesProviderResources{
 		Logger: mockLogger,
 	}
-	def := definition.New("", "", "", definition.Features{}, nil, nil, nil)
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result, err := def.Dependencies(expectedResources)
@@ -165,7 +167,7 @@ func TestDefinition_Tools_HappyPath(t *testing.T) {
 		return expectedTools
 	}
 
-	def := definition.New("", "", "", definition.Features{}, nil, nil, toolsProvider)
+	def := definition.New("", "", "", definition.Features{}, nil, nil, toolsProvider, nil, nil, 0)
 
 	// Act
 	result := def.Tools(expectedResources)
@@ -177,7 +179,7 @@ func TestDefinition_Tools_HappyPath(t *testing.T) {
 func TestDefinition_Tools_NilProvider(t *testing.T) {
 	// Arrange
 	expectedResources := definition.ToolsProviderResources{}
-	def := definition.New("", "", "", definition.Features{}, nil, nil, nil)
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 
 	// Act
 	result := def.Tools(expectedResources)
@@ -185,3 +187,80 @@ func TestDefinition_Tools_NilProvider(t *testing.T) {
 	// Assert
 	require.Nil(t, result)
 }
+
+func TestDefinition_ToolMiddleware_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedResources := definition.ToolsProviderResources{
+		LoggerFactory: mockLoggerFactory,
+	}
+	expectedMiddleware := []mcp.Middleware{
+		func(next mcp.MethodHandler) mcp.MethodHandler { return next },
+		func(next mcp.MethodHandler) mcp.MethodHandler { return next },
+	}
+
+	toolMiddlewareProvider := func(resources definition.ToolsProviderResources) []mcp.Middleware {
+		require.Equal(t, expectedResources, resources)
+		return expectedMiddleware
+	}
+
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, toolMiddlewareProvider, nil, 0)
+
+	// Act
+	result := def.ToolMiddleware(expectedResources)
+
+	// Assert
+	require.Len(t, result, len(expectedMiddleware))
+}
+
+func TestDefinition_ToolMiddleware_NilProvider(t *testing.T) {
+	// Arrange
+	expectedResources := definition.ToolsProviderResources{}
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
+
+	// Act
+	result := def.ToolMiddleware(expectedResources)
+
+	// Assert
+	require.Nil(t, result)
+}
+
+func TestDefinition_LifecycleHooks_HappyPath(t *testing.T) {
+	// Arrange
+	expectedHooks := []definition.LifecycleHook{
+		{Name: "first"},
+		{Name: "second"},
+	}
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, expectedHooks, 0)
+
+	// Act
+	result := def.LifecycleHooks()
+
+	// Assert
+	require.Equal(t, expectedHooks, result)
+}
+
+func TestDefinition_LifecycleHookTimeout_HappyPath(t *testing.T) {
+	// Arrange
+	expectedTimeout := 5 * time.Second
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, expectedTimeout)
+
+	// Act
+	result := def.LifecycleHookTimeout()
+
+	// Assert
+	require.Equal(t, expectedTimeout, result)
+}
+
+func TestDefinition_LifecycleHookTimeout_Default(t *testing.T) {
+	// Arrange
+	def := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
+
+	// Act
+	result := def.LifecycleHookTimeout()
+
+	// Assert
+	require.Equal(t, definition.DefaultLifecycleHookTimeout, result)
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package definition
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// DefaultLifecycleHookTimeout is the time each lifecycle hook is given to complete, when the definition does not set one.
+const DefaultLifecycleHookTimeout = 30 * time.Second
+
+type LifecycleHookResources struct {
+	Logger         entities.Logger
+	Config         config.GenericConfig
+	MessageCatalog MessageCatalog
+
+	Dependencies any
+}
+
+type LifecycleHookFunc func(ctx context.Context, resources LifecycleHookResources) error
+
+// LifecycleHook is run by the orchestrator: OnStart before the server starts serving, and OnShutdown when the server stops.
+// Either function may be nil.
+type LifecycleHook struct {
+	Name       string
+	OnStart    LifecycleHookFunc
+	OnShutdown LifecycleHookFunc
+}
+
+func NewLifecycleHookResources(
+	logger entities.Logger,
+	config config.GenericConfig,
+	messageCatalog MessageCatalog,
+	dependencies any,
+) LifecycleHookResources {
+	return LifecycleHookResources{
+		Logger:         logger,
+		Config:         config,
+		MessageCatalog: messageCatalog,
+
+		Dependencies: dependencies,
+	}
+}
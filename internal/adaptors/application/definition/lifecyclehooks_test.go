@@ -0,0 +1,40 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package definition_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	definitionmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/definition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLifecycleHookResources_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &configmocks.MockGenericConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	dependencies := &struct{ Value string }{Value: "test"}
+
+	// Act
+	result := definition.NewLifecycleHookResources(
+		mockLogger,
+		mockConfig,
+		mockMessageCatalog,
+		dependencies,
+	)
+
+	// Assert
+	require.Equal(t, mockLogger, result.Logger)
+	require.Equal(t, mockConfig, result.Config)
+	require.Equal(t, mockMessageCatalog, result.MessageCatalog)
+	require.Equal(t, dependencies, result.Dependencies)
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package definition
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ToolMiddlewareProvider returns the middleware to apply to every tool call, outermost first.
+type ToolMiddlewareProvider func(resources ToolsProviderResources) []mcp.Middleware
@@ -0,0 +1,142 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package analyze runs the Code Analyzer on the MATLAB code files of a folder, and prints the issues as text, JSON or SARIF.
+package analyze
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type WatchdogClient interface {
+	Start() error
+	Stop() error
+}
+
+type GlobalMATLAB interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+}
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request analyzematlabproject.Args) (analyzematlabproject.ReturnArgs, error)
+}
+
+type Mode struct {
+	configFactory  ConfigFactory
+	osLayer        OSLayer
+	loggerFactory  LoggerFactory
+	watchdogClient WatchdogClient
+	globalMATLAB   GlobalMATLAB
+	usecase        Usecase
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+	watchdogClient WatchdogClient,
+	globalMATLAB GlobalMATLAB,
+	usecase Usecase,
+) *Mode {
+	return &Mode{
+		configFactory:  configFactory,
+		osLayer:        osLayer,
+		loggerFactory:  loggerFactory,
+		watchdogClient: watchdogClient,
+		globalMATLAB:   globalMATLAB,
+		usecase:        usecase,
+	}
+}
+
+// StartAndWaitForCompletion analyzes the folder, prints the issues, and returns an error if the analysis failed or found errors.
+func (m *Mode) StartAndWaitForCompletion(ctx context.Context) messages.Error {
+	cfg, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	logger, messagesErr := m.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	folder := cfg.AnalyzeFolder()
+
+	logger.Debug("Starting watchdog")
+
+	err := m.watchdogClient.Start()
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("Failed to start watchdog")
+		return messages.New_StartupErrors_AnalysisFailed_Error(folder, err.Error())
+	}
+	defer func() {
+		logger.Debug("Stopping watchdog")
+
+		err := m.watchdogClient.Stop()
+		if err != nil {
+			logger.
+				WithError(err).
+				Warn("Watchdog shutdown failed")
+		}
+	}()
+
+	logger.Info("Analyzing MATLAB code")
+
+	client, err := m.globalMATLAB.Client(ctx, logger)
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("Failed to get MATLAB Client")
+		return messages.New_StartupErrors_AnalysisFailed_Error(folder, err.Error())
+	}
+
+	result, err := m.usecase.Execute(ctx, logger, client, analyzematlabproject.Args{
+		FolderPath: folder,
+	})
+	if err != nil {
+		logger.
+			WithError(err).
+			Error("Failed to analyze MATLAB code")
+		return messages.New_StartupErrors_AnalysisFailed_Error(folder, err.Error())
+	}
+
+	if err := writeReport(m.osLayer.Stdout(), cfg.AnalyzeFormat(), result); err != nil {
+		return messages.New_StartupErrors_WriteError_Error("analysis", err.Error())
+	}
+
+	if errorCount := countSeverity(result.Issues, analyzematlabproject.SeverityError); errorCount > 0 {
+		return messages.New_StartupErrors_AnalysisFoundErrors_Error(strconv.Itoa(errorCount), result.FolderPath)
+	}
+
+	return nil
+}
+
+func countSeverity(issues []analyzematlabproject.CodeIssue, severity string) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyze_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/analyze"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/modeselector/modes/analyze"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type analyzeMocks struct {
+	configFactory  *mocks.MockConfigFactory
+	osLayer        *mocks.MockOSLayer
+	loggerFactory  *mocks.MockLoggerFactory
+	watchdogClient *mocks.MockWatchdogClient
+	globalMATLAB   *mocks.MockGlobalMATLAB
+	usecase        *mocks.MockUsecase
+
+	config *configmocks.MockConfig
+	client *entitiesmocks.MockMATLABSessionClient
+	logger *testutils.InspectableLogger
+	stdout *bytes.Buffer
+}
+
+func newAnalyzeMocks(t *testing.T) analyzeMocks {
+	t.Helper()
+
+	m := analyzeMocks{
+		configFactory:  &mocks.MockConfigFactory{},
+		osLayer:        &mocks.MockOSLayer{},
+		loggerFactory:  &mocks.MockLoggerFactory{},
+		watchdogClient: &mocks.MockWatchdogClient{},
+		globalMATLAB:   &mocks.MockGlobalMATLAB{},
+		usecase:        &mocks.MockUsecase{},
+
+		config: &configmocks.MockConfig{},
+		client: &entitiesmocks.MockMATLABSessionClient{},
+		logger: testutils.NewInspectableLogger(),
+		stdout: &bytes.Buffer{},
+	}
+	t.Cleanup(func() {
+		m.configFactory.AssertExpectations(t)
+		m.osLayer.AssertExpectations(t)
+		m.loggerFactory.AssertExpectations(t)
+		m.watchdogClient.AssertExpectations(t)
+		m.globalMATLAB.AssertExpectations(t)
+		m.usecase.AssertExpectations(t)
+		m.config.AssertExpectations(t)
+		m.client.AssertExpectations(t)
+	})
+	return m
+}
+
+func (m analyzeMocks) newMode() *analyze.Mode {
+	return analyze.New(
+		m.configFactory,
+		m.osLayer,
+		m.loggerFactory,
+		m.watchdogClient,
+		m.globalMATLAB,
+		m.usecase,
+	)
+}
+
+// expectSetup sets the expectations of the steps before the analysis.
+func (m analyzeMocks) expectSetup(folder string) {
+	m.configFactory.EXPECT().
+		Config().
+		Return(m.config, nil).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(m.logger, nil).
+		Once()
+
+	m.config.EXPECT().
+		AnalyzeFolder().
+		Return(folder).
+		Once()
+}
+
+// expectAnalysis sets the expectations of a successful analysis that writes a report in the given format.
+func (m analyzeMocks) expectAnalysis(t *testing.T, format entities.ReportFormat, result analyzematlabproject.ReturnArgs) {
+	t.Helper()
+
+	m.expectSetup(result.FolderPath)
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.globalMATLAB.EXPECT().
+		Client(t.Context(), m.logger.AsMockArg()).
+		Return(m.client, nil).
+		Once()
+
+	m.usecase.EXPECT().
+		Execute(t.Context(), m.logger.AsMockArg(), m.client, analyzematlabproject.Args{FolderPath: result.FolderPath}).
+		Return(result, nil).
+		Once()
+
+	m.config.EXPECT().
+		AnalyzeFormat().
+		Return(format).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stdout().
+		Return(m.stdout).
+		Once()
+}
+
+func analysisResult() analyzematlabproject.ReturnArgs {
+	folder := filepath.Join("work", "my project")
+	return analyzematlabproject.ReturnArgs{
+		FolderPath:        folder,
+		ConfigurationFile: filepath.Join(folder, "resources", "codeAnalyzerConfiguration.json"),
+		Files:             []string{filepath.Join(folder, "main.m"), filepath.Join(folder, "sub", "helper.m")},
+		Issues: []analyzematlabproject.CodeIssue{
+			{File: filepath.Join(folder, "main.m"), Description: "Parse error.", Line: 3, StartColumn: 2, EndColumn: 2, Severity: analyzematlabproject.SeverityError},
+			{File: filepath.Join(folder, "main.m"), CheckID: "SAGROW", Description: "Array grows.", Line: 3, StartColumn: 9, EndColumn: 12, Severity: analyzematlabproject.SeverityInfo},
+			{File: filepath.Join(folder, "sub", "helper.m"), CheckID: "NASGU", Description: "Variable 'x' might be unused.", Line: 2, StartColumn: 5, EndColumn: 5, Severity: analyzematlabproject.SeverityWarning, Fixable: true},
+		},
+	}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	// Act
+	mode := m.newMode()
+
+	// Assert
+	assert.NotNil(t, mode)
+}
+
+func TestMode_StartAndWaitForCompletion_TextReport(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	result := analysisResult()
+	result.Issues = result.Issues[1:]
+
+	m.expectAnalysis(t, entities.ReportFormatText, result)
+
+	expectedOutput := "main.m:3:9: info [SAGROW] Array grows.\n" +
+		"sub/helper.m:2:5: warning [NASGU] Variable 'x' might be unused.\n" +
+		"\nConfiguration: " + result.ConfigurationFile +
+		"\n2 issues in 2 files: 0 errors, 1 warnings, 1 info\n"
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error when there are no error issues")
+	assert.Equal(t, expectedOutput, m.stdout.String())
+}
+
+func TestMode_StartAndWaitForCompletion_ErrorIssues(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	result := analysisResult()
+
+	m.expectAnalysis(t, entities.ReportFormatText, result)
+
+	expectedError := messages.New_StartupErrors_AnalysisFoundErrors_Error("1", result.FolderPath)
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, expectedError, err, "StartAndWaitForCompletion should return an error when there are error issues")
+	assert.Contains(t, m.stdout.String(), "main.m:3:2: error Parse error.\n")
+	assert.Contains(t, m.stdout.String(), "3 issues in 2 files: 1 errors, 1 warnings, 1 info\n")
+}
+
+func TestMode_StartAndWaitForCompletion_JSONReport(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	result := analysisResult()
+	result.Issues = result.Issues[2:]
+
+	m.expectAnalysis(t, entities.ReportFormatJSON, result)
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+
+	var report struct {
+		Folder            string           `json:"folder"`
+		ConfigurationFile string           `json:"configurationFile"`
+		FileCount         int              `json:"fileCount"`
+		Issues            []map[string]any `json:"issues"`
+		Summary           map[string]int   `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(m.stdout.Bytes(), &report), "Report should be valid JSON")
+	assert.Equal(t, result.FolderPath, report.Folder)
+	assert.Equal(t, result.ConfigurationFile, report.ConfigurationFile)
+	assert.Equal(t, 2, report.FileCount)
+	assert.Equal(t, map[string]int{"error": 0, "warning": 1, "info": 0}, report.Summary)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, "NASGU", report.Issues[0]["checkId"])
+	assert.Equal(t, true, report.Issues[0]["fixable"])
+}
+
+func TestMode_StartAndWaitForCompletion_SARIFReport(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	result := analysisResult()
+
+	m.expectAnalysis(t, entities.ReportFormatSARIF, result)
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_AnalysisFoundErrors_Error("1", result.FolderPath), err)
+
+	var report struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			OriginalURIBaseIDs map[string]struct {
+				URI string `json:"uri"`
+			} `json:"originalUriBaseIds"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				RuleIndex *int   `json:"ruleIndex"`
+				Level     string `json:"level"`
+				Message   struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI       string `json:"uri"`
+							URIBaseID string `json:"uriBaseId"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine   int `json:"startLine"`
+							StartColumn int `json:"startColumn"`
+							EndColumn   int `json:"endColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal(m.stdout.Bytes(), &report), "Report should be valid JSON")
+	assert.Equal(t, "2.1.0", report.Version)
+	require.Len(t, report.Runs, 1)
+
+	run := report.Runs[0]
+	assert.Equal(t, "MATLAB Code Analyzer", run.Tool.Driver.Name)
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	assert.Equal(t, "SAGROW", run.Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "NASGU", run.Tool.Driver.Rules[1].ID)
+	assert.Equal(t, "file:///work/my%20project/", run.OriginalURIBaseIDs["SRCROOT"].URI)
+
+	require.Len(t, run.Results, 3)
+
+	assert.Empty(t, run.Results[0].RuleID, "Issues without a check should not have a rule")
+	assert.Nil(t, run.Results[0].RuleIndex)
+	assert.Equal(t, "error", run.Results[0].Level)
+	assert.Equal(t, "Parse error.", run.Results[0].Message.Text)
+
+	assert.Equal(t, "note", run.Results[1].Level)
+
+	helperResult := run.Results[2]
+	assert.Equal(t, "NASGU", helperResult.RuleID)
+	require.NotNil(t, helperResult.RuleIndex)
+	assert.Equal(t, 1, *helperResult.RuleIndex)
+	assert.Equal(t, "warning", helperResult.Level)
+	require.Len(t, helperResult.Locations, 1)
+	assert.Equal(t, "sub/helper.m", helperResult.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "SRCROOT", helperResult.Locations[0].PhysicalLocation.ArtifactLocation.URIBaseID)
+	assert.Equal(t, 2, helperResult.Locations[0].PhysicalLocation.Region.StartLine)
+	assert.Equal(t, 5, helperResult.Locations[0].PhysicalLocation.Region.StartColumn)
+	assert.Equal(t, 6, helperResult.Locations[0].PhysicalLocation.Region.EndColumn, "SARIF end columns are exclusive")
+}
+
+func TestMode_StartAndWaitForCompletion_WatchdogStartError(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	folder := filepath.Join("work", "project")
+	m.expectSetup(folder)
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(assert.AnError).
+		Once()
+
+	expectedError := messages.New_StartupErrors_AnalysisFailed_Error(folder, assert.AnError.Error())
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, expectedError, err)
+}
+
+func TestMode_StartAndWaitForCompletion_ClientError(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	folder := filepath.Join("work", "project")
+	m.expectSetup(folder)
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.globalMATLAB.EXPECT().
+		Client(t.Context(), m.logger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	expectedError := messages.New_StartupErrors_AnalysisFailed_Error(folder, assert.AnError.Error())
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, expectedError, err)
+}
+
+func TestMode_StartAndWaitForCompletion_UsecaseError(t *testing.T) {
+	// Arrange
+	m := newAnalyzeMocks(t)
+
+	folder := filepath.Join("work", "project")
+	m.expectSetup(folder)
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(assert.AnError).
+		Once()
+
+	m.globalMATLAB.EXPECT().
+		Client(t.Context(), m.logger.AsMockArg()).
+		Return(m.client, nil).
+		Once()
+
+	m.usecase.EXPECT().
+		Execute(t.Context(), m.logger.AsMockArg(), m.client, analyzematlabproject.Args{FolderPath: folder}).
+		Return(analyzematlabproject.ReturnArgs{}, analyzematlabproject.ErrNoMATLABFiles).
+		Once()
+
+	expectedError := messages.New_StartupErrors_AnalysisFailed_Error(folder, analyzematlabproject.ErrNoMATLABFiles.Error())
+
+	// Act
+	err := m.newMode().StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Contains(t, m.logger.WarnLogs(), "Watchdog shutdown failed")
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sarif"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+)
+
+type jsonIssue struct {
+	File        string `json:"file"`
+	CheckID     string `json:"checkId,omitempty"`
+	Description string `json:"description"`
+	Line        int    `json:"line"`
+	StartColumn int    `json:"startColumn"`
+	EndColumn   int    `json:"endColumn"`
+	Severity    string `json:"severity"`
+	Fixable     bool   `json:"fixable"`
+}
+
+type jsonReport struct {
+	Folder            string         `json:"folder"`
+	ConfigurationFile string         `json:"configurationFile,omitempty"`
+	FileCount         int            `json:"fileCount"`
+	Issues            []jsonIssue    `json:"issues"`
+	Summary           map[string]int `json:"summary"`
+}
+
+func writeReport(writer io.Writer, format entities.ReportFormat, result analyzematlabproject.ReturnArgs) error {
+	switch format {
+	case entities.ReportFormatJSON:
+		return writeJSONReport(writer, result)
+	case entities.ReportFormatSARIF:
+		return sarif.Encode(writer, result)
+	default:
+		return writeTextReport(writer, result)
+	}
+}
+
+func summarize(issues []analyzematlabproject.CodeIssue) map[string]int {
+	summary := map[string]int{
+		analyzematlabproject.SeverityError:   0,
+		analyzematlabproject.SeverityWarning: 0,
+		analyzematlabproject.SeverityInfo:    0,
+	}
+	for _, issue := range issues {
+		summary[issue.Severity]++
+	}
+	return summary
+}
+
+func writeTextReport(writer io.Writer, result analyzematlabproject.ReturnArgs) error {
+	var report strings.Builder
+
+	for _, issue := range result.Issues {
+		fmt.Fprintf(&report, "%s:%d:%d: %s", relativePath(result.FolderPath, issue.File), issue.Line, issue.StartColumn, issue.Severity)
+		if issue.CheckID != "" {
+			fmt.Fprintf(&report, " [%s]", issue.CheckID)
+		}
+		fmt.Fprintf(&report, " %s\n", issue.Description)
+	}
+
+	if result.ConfigurationFile != "" {
+		fmt.Fprintf(&report, "\nConfiguration: %s", result.ConfigurationFile)
+	}
+
+	summary := summarize(result.Issues)
+	fmt.Fprintf(&report, "\n%d issues in %d files: %d errors, %d warnings, %d info\n",
+		len(result.Issues), len(result.Files),
+		summary[analyzematlabproject.SeverityError], summary[analyzematlabproject.SeverityWarning], summary[analyzematlabproject.SeverityInfo])
+
+	_, err := io.WriteString(writer, report.String())
+	return err
+}
+
+func writeJSONReport(writer io.Writer, result analyzematlabproject.ReturnArgs) error {
+	report := jsonReport{
+		Folder:            result.FolderPath,
+		ConfigurationFile: result.ConfigurationFile,
+		FileCount:         len(result.Files),
+		Issues:            make([]jsonIssue, 0, len(result.Issues)),
+		Summary:           summarize(result.Issues),
+	}
+
+	for _, issue := range result.Issues {
+		report.Issues = append(report.Issues, jsonIssue(issue))
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// relativePath returns the path of a file relative to the analyzed folder, with forward slashes, or the full path if the file is outside the folder.
+func relativePath(folder string, file string) string {
+	if relative, ok := relativeToFolder(folder, file); ok {
+		return relative
+	}
+	return filepath.ToSlash(file)
+}
+
+// relativeToFolder returns the path of a file relative to a folder, with forward slashes, and whether the file is in the folder.
+func relativeToFolder(folder string, file string) (string, bool) {
+	relative, err := filepath.Rel(folder, file)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(relative), true
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/directory"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const (
+	tlsHandshakeTimeout = 5 * time.Second
+
+	// toolboxInstalledCode prints 1 if the MATLAB MCP Server Toolbox is on the path of MATLAB, and 0 otherwise.
+	toolboxInstalledCode = "fprintf('%d', exist('shareMATLABSession', 'file') > 0);"
+)
+
+func (m *Mode) checkMATLABInstallations(cfg config.Config, environments []entities.EnvironmentInfo) Result {
+	const name = "MATLAB installations"
+
+	if len(environments) == 0 {
+		if cfg.MATLABSessionMode() == entities.MATLABSessionModeExisting {
+			return skipped(name, "No MATLAB installation found. The server does not start MATLAB in 'existing' session mode.")
+		}
+		return failed(name, fmt.Sprintf("No MATLAB installation found. Add MATLAB to the system PATH, or use %s or %s.",
+			flag(defaultparameters.PreferredLocalMATLABRoot()), flag(defaultparameters.MATLABSearchFolders())))
+	}
+
+	found := make([]string, 0, len(environments))
+	for _, environment := range environments {
+		found = append(found, fmt.Sprintf("%s (%s)", environment.Version, environment.MATLABRoot))
+	}
+
+	return passed(name, fmt.Sprintf("Found %d: %s", len(environments), strings.Join(found, ", ")))
+}
+
+func (m *Mode) checkMATLABRoot(cfg config.Config) Result {
+	name := flag(defaultparameters.PreferredLocalMATLABRoot())
+
+	matlabRoot := cfg.PreferredLocalMATLABRoot()
+	if matlabRoot == "" {
+		return skipped(name, "Not specified.")
+	}
+
+	versionInfo, err := m.matlabVersionGetter.Get(matlabRoot)
+	if err != nil {
+		return failed(name, fmt.Sprintf("%s is not a MATLAB root. Do not include /bin in the path. Error: %v", matlabRoot, err))
+	}
+
+	return passed(name, fmt.Sprintf("%s (%s)", versionInfo.ReleaseFamily, matlabRoot))
+}
+
+func (m *Mode) checkLogFolder(dir directory.Directory) Result {
+	const name = "Log folder"
+
+	testFile := filepath.Join(dir.BaseDir(), "doctor-"+dir.ID()+".tmp")
+	if err := m.osLayer.WriteFile(testFile, []byte{}, 0o600); err != nil {
+		return failed(name, fmt.Sprintf("%s is not writable. Use %s to choose another folder. Error: %v", dir.BaseDir(), flag(defaultparameters.BaseDir()), err))
+	}
+
+	if err := m.osLayer.RemoveAll(testFile); err != nil {
+		return warned(name, fmt.Sprintf("%s is writable, but the test file %s could not be removed. Error: %v", dir.BaseDir(), testFile, err))
+	}
+
+	return passed(name, dir.BaseDir()+" is writable.")
+}
+
+func (m *Mode) checkSessionFolder(dir directory.Directory) Result {
+	const name = "Session folder"
+
+	sessionDir, messagesErr := dir.CreateSubDir("doctor-")
+	if messagesErr != nil {
+		return failed(name, fmt.Sprintf("Cannot create MATLAB session folders in %s. Use %s to choose another folder. Error: %v", dir.BaseDir(), flag(defaultparameters.BaseDir()), messagesErr))
+	}
+
+	if err := m.osLayer.RemoveAll(sessionDir); err != nil {
+		return warned(name, fmt.Sprintf("MATLAB session folders can be created in %s, but the test folder %s could not be removed. Error: %v", dir.BaseDir(), sessionDir, err))
+	}
+
+	return passed(name, fmt.Sprintf("MATLAB session folders can be created in %s.", dir.BaseDir()))
+}
+
+// checkSharedSession checks the MATLAB session that a user shared with shareMATLABSession, or that --matlab-session-connection-details specifies.
+func (m *Mode) checkSharedSession(ctx context.Context, logger entities.Logger, cfg config.Config) []Result {
+	const (
+		sessionName = "Shared MATLAB session"
+		tlsName     = "Embedded connector TLS handshake"
+		pingName    = "Embedded connector ping"
+	)
+
+	// Without a shared session, the later checks have nothing to connect to.
+	skipConnection := func(reason string) []Result {
+		return []Result{skipped(tlsName, reason), skipped(pingName, reason)}
+	}
+
+	sessionDetails, source, sessionResult := m.readSessionDetails(cfg)
+	if sessionResult.Status != StatusPass {
+		return append([]Result{sessionResult}, skipConnection("No shared MATLAB session to connect to.")...)
+	}
+
+	pid, err := m.sessionDiscoverer.PIDFromSessionDetails(sessionDetails)
+	if err != nil {
+		sessionResult = failed(sessionName, fmt.Sprintf("%s does not contain a valid MATLAB process ID. Run shareMATLABSession() in MATLAB again.", source))
+		return append([]Result{sessionResult}, skipConnection("No shared MATLAB session to connect to.")...)
+	}
+
+	if m.processManager.FindProcess(pid) == nil {
+		sessionResult = failed(sessionName, fmt.Sprintf("%s refers to MATLAB process %d, which is not running. Run shareMATLABSession() in a running MATLAB.", source, pid))
+		return append([]Result{sessionResult}, skipConnection("The shared MATLAB session is not running.")...)
+	}
+
+	sessionResult = passed(sessionName, fmt.Sprintf("%s refers to running MATLAB process %d.", source, pid))
+
+	connectionDetails, err := m.sessionDiscoverer.FromSessionDetails(logger, sessionDetails)
+	if err != nil {
+		return []Result{
+			sessionResult,
+			failed(tlsName, fmt.Sprintf("%s does not contain valid connection details. Error: %v", source, err)),
+			skipped(pingName, "No connection details."),
+		}
+	}
+
+	return []Result{
+		sessionResult,
+		m.checkTLSHandshake(ctx, tlsName, connectionDetails),
+		m.checkPing(ctx, logger, pingName, connectionDetails),
+	}
+}
+
+func (m *Mode) readSessionDetails(cfg config.Config) ([]byte, string, Result) {
+	const name = "Shared MATLAB session"
+
+	if connectionDetails := cfg.MATLABSessionConnectionDetails(); connectionDetails != "" {
+		source := flag(defaultparameters.MATLABSessionConnectionDetails())
+		return []byte(connectionDetails), source, passed(name, "")
+	}
+
+	// Only 'existing' mode requires a shared session, 'auto' mode starts MATLAB without one.
+	missingSession := skipped
+	if cfg.MATLABSessionMode() == entities.MATLABSessionModeExisting {
+		missingSession = failed
+	}
+
+	sessionFile, err := m.sessionDiscoverer.SessionDetailsFile()
+	if err != nil {
+		return nil, "", missingSession(name, fmt.Sprintf("Cannot find the folder of shared MATLAB sessions. Error: %v", err))
+	}
+
+	sessionDetails, err := m.osLayer.ReadFile(sessionFile)
+	if err != nil {
+		return nil, "", missingSession(name, fmt.Sprintf("No shared MATLAB session in %s. To share a MATLAB session, run shareMATLABSession() in MATLAB.", sessionFile))
+	}
+
+	return sessionDetails, sessionFile, passed(name, "")
+}
+
+func (m *Mode) checkTLSHandshake(ctx context.Context, name string, connectionDetails embeddedconnector.ConnectionDetails) Result {
+	start := time.Now()
+
+	client, err := m.httpClientFactory.NewClientForSelfSignedTLSServer(connectionDetails.CertificatePEM)
+	if err != nil {
+		return failed(name, fmt.Sprintf("The certificate of the shared MATLAB session is not valid. Error: %v", err))
+	}
+	defer client.CloseIdleConnections()
+
+	ctx, cancel := context.WithTimeout(ctx, tlsHandshakeTimeout)
+	defer cancel()
+
+	address := "https://" + net.JoinHostPort(connectionDetails.Host, connectionDetails.Port) + "/"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return failed(name, fmt.Sprintf("Invalid address %s. Error: %v", address, err))
+	}
+
+	// Any HTTP response, whatever its status, means that the handshake succeeded.
+	response, err := client.Do(request)
+	if err != nil {
+		return failed(name, fmt.Sprintf("Cannot connect to %s. Error: %v", address, err))
+	}
+	defer response.Body.Close() //nolint:errcheck // Only the handshake matters
+
+	return timed(passed(name, "Connected to "+address+"."), start)
+}
+
+func (m *Mode) checkPing(ctx context.Context, logger entities.Logger, name string, connectionDetails embeddedconnector.ConnectionDetails) Result {
+	start := time.Now()
+
+	client, err := m.matlabSessionClientFactory.New(connectionDetails)
+	if err != nil {
+		return failed(name, fmt.Sprintf("Cannot create a client for the shared MATLAB session. Error: %v", err))
+	}
+
+	if !client.Ping(ctx, logger).IsAlive {
+		return timed(failed(name, "The shared MATLAB session did not respond."), start)
+	}
+
+	return timed(passed(name, "The shared MATLAB session responded."), start)
+}
+
+func (m *Mode) checkExtensionFiles(cfg config.Config) []Result {
+	extensionFiles := cfg.ExtensionFiles()
+	if len(extensionFiles) == 0 {
+		return []Result{skipped("Extension files", "Not specified.")}
+	}
+
+	results := make([]Result, 0, len(extensionFiles))
+	for _, extensionFile := range extensionFiles {
+		name := "Extension file " + extensionFile
+
+		tools, messagesErr := m.extensionLoader.Load(extensionFile)
+		if messagesErr != nil {
+			results = append(results, failed(name, messagesErr.Error()))
+			continue
+		}
+
+		results = append(results, passed(name, fmt.Sprintf("Defines %d valid tools.", len(tools))))
+	}
+
+	return results
+}
+
+// checkWatchdog starts the watchdog, which creates the socket that the server and watchdog communicate through.
+// The returned function stops the watchdog, after the MATLAB sessions that it watches have stopped.
+func (m *Mode) checkWatchdog(logger entities.Logger) (Result, func()) {
+	const name = "Watchdog"
+
+	err := m.watchdogClient.Start()
+	stop := func() {
+		if err := m.watchdogClient.Stop(); err != nil {
+			logger.WithError(err).Warn("Watchdog shutdown failed")
+		}
+	}
+
+	if err != nil {
+		return failed(name, fmt.Sprintf("Cannot start the watchdog or connect to its socket. Error: %v", err)), stop
+	}
+
+	return passed(name, "Started the watchdog and connected to its socket."), stop
+}
+
+// checkMATLABStarts starts each MATLAB installation, beginning with the one that the server selects,
+// and checks whether the MATLAB MCP Server Toolbox is installed in it.
+func (m *Mode) checkMATLABStarts(ctx context.Context, logger entities.Logger, cfg config.Config, environments []entities.EnvironmentInfo, watchdogStarted bool) []Result {
+	const name = "MATLAB start"
+
+	if cfg.MATLABSessionMode() == entities.MATLABSessionModeExisting {
+		return []Result{skipped(name, "The server does not start MATLAB in 'existing' session mode.")}
+	}
+
+	matlabRoots := make([]string, 0, len(environments)+1)
+	selectedMATLABRoot, err := m.matlabRootSelector.SelectMATLABRoot(ctx, logger)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to select MATLAB root")
+	} else {
+		matlabRoots = append(matlabRoots, selectedMATLABRoot)
+	}
+
+	for _, environment := range environments {
+		if !slices.Contains(matlabRoots, environment.MATLABRoot) {
+			matlabRoots = append(matlabRoots, environment.MATLABRoot)
+		}
+	}
+
+	if len(matlabRoots) == 0 {
+		return []Result{skipped(name, "No MATLAB installation to start.")}
+	}
+
+	if !watchdogStarted {
+		return []Result{skipped(name, "The server cannot start MATLAB without the watchdog.")}
+	}
+
+	results := make([]Result, 0, 2*len(matlabRoots))
+	for _, matlabRoot := range matlabRoots {
+		results = append(results, m.checkMATLABStart(ctx, logger, cfg, matlabRoot)...)
+	}
+
+	return results
+}
+
+func (m *Mode) checkMATLABStart(ctx context.Context, logger entities.Logger, cfg config.Config, matlabRoot string) []Result {
+	startName := "MATLAB start " + matlabRoot
+	toolboxName := "Toolbox in " + matlabRoot
+
+	start := time.Now()
+
+	sessionID, err := m.matlabManager.StartMATLABSession(ctx, logger, entities.LocalSessionDetails{
+		MATLABRoot:        matlabRoot,
+		ShowMATLABDesktop: cfg.ShouldShowMATLABDesktop(),
+	})
+	if err != nil {
+		return []Result{
+			timed(failed(startName, fmt.Sprintf("MATLAB did not start. Error: %v", err)), start),
+			skipped(toolboxName, "MATLAB did not start."),
+		}
+	}
+
+	startResult := timed(passed(startName, "MATLAB started."), start)
+
+	defer func() {
+		if err := m.matlabManager.StopMATLABSession(ctx, logger, sessionID); err != nil {
+			logger.WithError(err).Warn("Failed to stop MATLAB session")
+		}
+	}()
+
+	return []Result{startResult, m.checkToolbox(ctx, logger, toolboxName, sessionID)}
+}
+
+func (m *Mode) checkToolbox(ctx context.Context, logger entities.Logger, name string, sessionID entities.SessionID) Result {
+	client, err := m.matlabManager.GetMATLABSessionClient(ctx, logger, sessionID)
+	if err != nil {
+		return failed(name, fmt.Sprintf("Cannot connect to MATLAB. Error: %v", err))
+	}
+
+	response, err := client.Eval(ctx, logger, entities.EvalRequest{Code: toolboxInstalledCode})
+	if err != nil {
+		return failed(name, fmt.Sprintf("Cannot check the toolbox. Error: %v", err))
+	}
+
+	if strings.TrimSpace(response.ConsoleOutput) != "1" {
+		return warned(name, fmt.Sprintf("The MATLAB MCP Server Toolbox is not installed. To share this MATLAB with the server, run the server with %s and %s.",
+			flag(defaultparameters.SetupMATLABMode()), flag(defaultparameters.PreferredLocalMATLABRoot())))
+	}
+
+	return passed(name, "The MATLAB MCP Server Toolbox is installed.")
+}
+
+func flag(parameter entities.Parameter) string {
+	return "--" + parameter.GetFlagName()
+}
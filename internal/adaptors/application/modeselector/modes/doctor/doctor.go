@@ -0,0 +1,191 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package doctor runs a checklist of the MATLAB toolchain that the server depends on, and prints a report of the results.
+package doctor
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/directory"
+	httpclient "github.com/matlab/matlab-mcp-server/internal/adaptors/http/client"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	Stdout() io.Writer
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	RemoveAll(path string) error
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type DirectoryFactory interface {
+	Directory() (directory.Directory, messages.Error)
+}
+
+type MATLABManager interface {
+	ListEnvironments(ctx context.Context, sessionLogger entities.Logger) []entities.EnvironmentInfo
+	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
+	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
+	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type MATLABRootSelector interface {
+	SelectMATLABRoot(ctx context.Context, logger entities.Logger) (string, error)
+}
+
+type SessionDiscoverer interface {
+	SessionDetailsFile() (string, error)
+	PIDFromSessionDetails(sessionDetails []byte) (int, error)
+	FromSessionDetails(logger entities.Logger, sessionDetails []byte) (embeddedconnector.ConnectionDetails, error)
+}
+
+type ProcessManager interface {
+	FindProcess(processPid int) osfacade.Process
+}
+
+type HTTPClientFactory interface {
+	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclient.HttpClient, error)
+}
+
+type MATLABSessionClientFactory interface {
+	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
+}
+
+type WatchdogClient interface {
+	Start() error
+	Stop() error
+}
+
+type ExtensionLoader interface {
+	Load(filePath string) ([]definition.ValidatedTool, messages.Error)
+}
+
+type Mode struct {
+	configFactory              ConfigFactory
+	osLayer                    OSLayer
+	loggerFactory              LoggerFactory
+	directoryFactory           DirectoryFactory
+	matlabManager              MATLABManager
+	matlabVersionGetter        MATLABVersionGetter
+	matlabRootSelector         MATLABRootSelector
+	sessionDiscoverer          SessionDiscoverer
+	processManager             ProcessManager
+	httpClientFactory          HTTPClientFactory
+	matlabSessionClientFactory MATLABSessionClientFactory
+	watchdogClient             WatchdogClient
+	extensionLoader            ExtensionLoader
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+	directoryFactory DirectoryFactory,
+	matlabManager MATLABManager,
+	matlabVersionGetter MATLABVersionGetter,
+	matlabRootSelector MATLABRootSelector,
+	sessionDiscoverer SessionDiscoverer,
+	processManager ProcessManager,
+	httpClientFactory HTTPClientFactory,
+	matlabSessionClientFactory MATLABSessionClientFactory,
+	watchdogClient WatchdogClient,
+	extensionLoader ExtensionLoader,
+) *Mode {
+	return &Mode{
+		configFactory:              configFactory,
+		osLayer:                    osLayer,
+		loggerFactory:              loggerFactory,
+		directoryFactory:           directoryFactory,
+		matlabManager:              matlabManager,
+		matlabVersionGetter:        matlabVersionGetter,
+		matlabRootSelector:         matlabRootSelector,
+		sessionDiscoverer:          sessionDiscoverer,
+		processManager:             processManager,
+		httpClientFactory:          httpClientFactory,
+		matlabSessionClientFactory: matlabSessionClientFactory,
+		watchdogClient:             watchdogClient,
+		extensionLoader:            extensionLoader,
+	}
+}
+
+// StartAndWaitForCompletion runs all checks, prints the report, and returns an error if any check failed.
+func (m *Mode) StartAndWaitForCompletion(ctx context.Context) messages.Error {
+	cfg, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	logger, messagesErr := m.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	dir, messagesErr := m.directoryFactory.Directory()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	logger.Info("Running doctor checks")
+
+	results := m.runChecks(ctx, logger, cfg, dir)
+
+	if err := writeReport(m.osLayer.Stdout(), cfg.DoctorFormat(), results); err != nil {
+		return messages.New_StartupErrors_WriteError_Error("doctor", err.Error())
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == StatusFail {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return messages.New_StartupErrors_DoctorChecksFailed_Error(strconv.Itoa(failed), strconv.Itoa(len(results)), dir.BaseDir())
+	}
+
+	return nil
+}
+
+func (m *Mode) runChecks(ctx context.Context, logger entities.Logger, cfg config.Config, dir directory.Directory) []Result {
+	environments := m.matlabManager.ListEnvironments(ctx, logger)
+
+	results := []Result{
+		m.checkMATLABInstallations(cfg, environments),
+		m.checkMATLABRoot(cfg),
+		m.checkLogFolder(dir),
+		m.checkSessionFolder(dir),
+	}
+
+	results = append(results, m.checkSharedSession(ctx, logger, cfg)...)
+	results = append(results, m.checkExtensionFiles(cfg)...)
+
+	watchdogResult, stopWatchdog := m.checkWatchdog(logger)
+	defer stopWatchdog()
+	results = append(results, watchdogResult)
+
+	results = append(results, m.checkMATLABStarts(ctx, logger, cfg, environments, watchdogResult.Status == StatusPass)...)
+
+	return results
+}
@@ -0,0 +1,734 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package doctor_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/doctor"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	directorymocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/directory"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/modeselector/modes/doctor"
+	httpclientmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/http/client"
+	definitionmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/custom/definition"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type doctorMocks struct {
+	configFactory              *mocks.MockConfigFactory
+	osLayer                    *mocks.MockOSLayer
+	loggerFactory              *mocks.MockLoggerFactory
+	directoryFactory           *mocks.MockDirectoryFactory
+	matlabManager              *mocks.MockMATLABManager
+	matlabVersionGetter        *mocks.MockMATLABVersionGetter
+	matlabRootSelector         *mocks.MockMATLABRootSelector
+	sessionDiscoverer          *mocks.MockSessionDiscoverer
+	processManager             *mocks.MockProcessManager
+	httpClientFactory          *mocks.MockHTTPClientFactory
+	matlabSessionClientFactory *mocks.MockMATLABSessionClientFactory
+	watchdogClient             *mocks.MockWatchdogClient
+	extensionLoader            *mocks.MockExtensionLoader
+
+	config    *configmocks.MockConfig
+	directory *directorymocks.MockDirectory
+	logger    *testutils.InspectableLogger
+	stdout    *bytes.Buffer
+}
+
+func newDoctorMocks(t *testing.T) doctorMocks {
+	t.Helper()
+
+	m := doctorMocks{
+		configFactory:              &mocks.MockConfigFactory{},
+		osLayer:                    &mocks.MockOSLayer{},
+		loggerFactory:              &mocks.MockLoggerFactory{},
+		directoryFactory:           &mocks.MockDirectoryFactory{},
+		matlabManager:              &mocks.MockMATLABManager{},
+		matlabVersionGetter:        &mocks.MockMATLABVersionGetter{},
+		matlabRootSelector:         &mocks.MockMATLABRootSelector{},
+		sessionDiscoverer:          &mocks.MockSessionDiscoverer{},
+		processManager:             &mocks.MockProcessManager{},
+		httpClientFactory:          &mocks.MockHTTPClientFactory{},
+		matlabSessionClientFactory: &mocks.MockMATLABSessionClientFactory{},
+		watchdogClient:             &mocks.MockWatchdogClient{},
+		extensionLoader:            &mocks.MockExtensionLoader{},
+
+		config:    &configmocks.MockConfig{},
+		directory: &directorymocks.MockDirectory{},
+		logger:    testutils.NewInspectableLogger(),
+		stdout:    &bytes.Buffer{},
+	}
+	t.Cleanup(func() {
+		m.configFactory.AssertExpectations(t)
+		m.osLayer.AssertExpectations(t)
+		m.loggerFactory.AssertExpectations(t)
+		m.directoryFactory.AssertExpectations(t)
+		m.matlabManager.AssertExpectations(t)
+		m.matlabVersionGetter.AssertExpectations(t)
+		m.matlabRootSelector.AssertExpectations(t)
+		m.sessionDiscoverer.AssertExpectations(t)
+		m.processManager.AssertExpectations(t)
+		m.httpClientFactory.AssertExpectations(t)
+		m.matlabSessionClientFactory.AssertExpectations(t)
+		m.watchdogClient.AssertExpectations(t)
+		m.extensionLoader.AssertExpectations(t)
+		m.config.AssertExpectations(t)
+		m.directory.AssertExpectations(t)
+	})
+	return m
+}
+
+func (m doctorMocks) newMode() *doctor.Mode {
+	return doctor.New(
+		m.configFactory,
+		m.osLayer,
+		m.loggerFactory,
+		m.directoryFactory,
+		m.matlabManager,
+		m.matlabVersionGetter,
+		m.matlabRootSelector,
+		m.sessionDiscoverer,
+		m.processManager,
+		m.httpClientFactory,
+		m.matlabSessionClientFactory,
+		m.watchdogClient,
+		m.extensionLoader,
+	)
+}
+
+// expectSetup sets the expectations of the steps before the checks.
+func (m doctorMocks) expectSetup(baseDir string) {
+	m.configFactory.EXPECT().
+		Config().
+		Return(m.config, nil).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(m.logger, nil).
+		Once()
+
+	m.directoryFactory.EXPECT().
+		Directory().
+		Return(m.directory, nil).
+		Once()
+
+	m.directory.EXPECT().
+		BaseDir().
+		Return(baseDir)
+
+	m.directory.EXPECT().
+		ID().
+		Return("abc").
+		Once()
+}
+
+// expectWritableFolders sets the expectations of the log and session folder checks when both pass.
+func (m doctorMocks) expectWritableFolders(baseDir string) {
+	testFile := filepath.Join(baseDir, "doctor-abc.tmp")
+	sessionDir := filepath.Join(baseDir, "doctor-abc-123")
+
+	m.osLayer.EXPECT().
+		WriteFile(testFile, []byte{}, mock.Anything).
+		Return(nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		RemoveAll(testFile).
+		Return(nil).
+		Once()
+
+	m.directory.EXPECT().
+		CreateSubDir("doctor-").
+		Return(sessionDir, nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		RemoveAll(sessionDir).
+		Return(nil).
+		Once()
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	// Act
+	mode := m.newMode()
+
+	// Assert
+	assert.NotNil(t, mode)
+}
+
+func TestMode_StartAndWaitForCompletion_AllChecksPass(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	mockProcess := &osfacademocks.MockProcess{}
+	defer mockProcess.AssertExpectations(t)
+
+	mockHTTPClient := &httpclientmocks.MockHttpClient{}
+	defer mockHTTPClient.AssertExpectations(t)
+
+	mockSharedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSharedSessionClient.AssertExpectations(t)
+
+	mockStartedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockStartedSessionClient.AssertExpectations(t)
+
+	mockTool := &definitionmocks.MockValidatedTool{}
+	defer mockTool.AssertExpectations(t)
+
+	ctx := t.Context()
+	baseDir := filepath.Join("tmp", "logs")
+	matlabRoot := filepath.Join("opt", "MATLAB", "R2025b")
+	sessionFile := filepath.Join("home", "user", "v1", "sessionDetails.json")
+	sessionDetails := []byte(`{"pid": 1234}`)
+	extensionFile := filepath.Join("tools", "tools.json")
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           "31515",
+		APIKey:         "key",
+		CertificatePEM: []byte("pem"),
+	}
+	const sessionID = entities.SessionID(7)
+
+	m.expectSetup(baseDir)
+	m.expectWritableFolders(baseDir)
+
+	m.matlabManager.EXPECT().
+		ListEnvironments(ctx, m.logger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{{MATLABRoot: matlabRoot, Version: "R2025b"}}).
+		Once()
+
+	m.config.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return(matlabRoot).
+		Once()
+
+	m.matlabVersionGetter.EXPECT().
+		Get(matlabRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2025b"}, nil).
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeAuto).
+		Twice()
+
+	m.sessionDiscoverer.EXPECT().
+		SessionDetailsFile().
+		Return(sessionFile, nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		ReadFile(sessionFile).
+		Return(sessionDetails, nil).
+		Once()
+
+	m.sessionDiscoverer.EXPECT().
+		PIDFromSessionDetails(sessionDetails).
+		Return(1234, nil).
+		Once()
+
+	m.processManager.EXPECT().
+		FindProcess(1234).
+		Return(mockProcess).
+		Once()
+
+	m.sessionDiscoverer.EXPECT().
+		FromSessionDetails(m.logger.AsMockArg(), sessionDetails).
+		Return(connectionDetails, nil).
+		Once()
+
+	m.httpClientFactory.EXPECT().
+		NewClientForSelfSignedTLSServer(connectionDetails.CertificatePEM).
+		Return(mockHTTPClient, nil).
+		Once()
+
+	mockHTTPClient.EXPECT().
+		Do(mock.MatchedBy(func(request *http.Request) bool {
+			return request.URL.String() == "https://localhost:31515/"
+		})).
+		Return(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil).
+		Once()
+
+	mockHTTPClient.EXPECT().
+		CloseIdleConnections().
+		Once()
+
+	m.matlabSessionClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSharedSessionClient, nil).
+		Once()
+
+	mockSharedSessionClient.EXPECT().
+		Ping(ctx, m.logger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	m.config.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	m.extensionLoader.EXPECT().
+		Load(extensionFile).
+		Return([]definition.ValidatedTool{mockTool}, nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.matlabRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, m.logger.AsMockArg()).
+		Return(matlabRoot, nil).
+		Once()
+
+	m.config.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	m.matlabManager.EXPECT().
+		StartMATLABSession(ctx, m.logger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(sessionID, nil).
+		Once()
+
+	m.matlabManager.EXPECT().
+		GetMATLABSessionClient(ctx, m.logger.AsMockArg(), sessionID).
+		Return(mockStartedSessionClient, nil).
+		Once()
+
+	mockStartedSessionClient.EXPECT().
+		Eval(ctx, m.logger.AsMockArg(), mock.MatchedBy(func(request entities.EvalRequest) bool {
+			return strings.Contains(request.Code, "shareMATLABSession")
+		})).
+		Return(entities.EvalResponse{ConsoleOutput: "1"}, nil).
+		Once()
+
+	m.matlabManager.EXPECT().
+		StopMATLABSession(ctx, m.logger.AsMockArg(), sessionID).
+		Return(nil).
+		Once()
+
+	m.config.EXPECT().
+		DoctorFormat().
+		Return(entities.ReportFormatText).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stdout().
+		Return(m.stdout).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+
+	report := m.stdout.String()
+	assert.Contains(t, report, "[PASS] MATLAB installations: Found 1: R2025b ("+matlabRoot+")\n")
+	assert.Contains(t, report, "[PASS] --matlab-root: R2025b ("+matlabRoot+")\n")
+	assert.Contains(t, report, "[PASS] Log folder: "+baseDir+" is writable.\n")
+	assert.Contains(t, report, "[PASS] Shared MATLAB session: "+sessionFile+" refers to running MATLAB process 1234.\n")
+	assert.Contains(t, report, "[PASS] Embedded connector TLS handshake: Connected to https://localhost:31515/.")
+	assert.Contains(t, report, "[PASS] Extension file "+extensionFile+": Defines 1 valid tools.\n")
+	assert.Contains(t, report, "[PASS] Toolbox in "+matlabRoot+": The MATLAB MCP Server Toolbox is installed.\n")
+	assert.Contains(t, report, "\n11 passed, 0 warnings, 0 failed, 0 skipped\n")
+}
+
+func TestMode_StartAndWaitForCompletion_FailedChecksJSONReport(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	ctx := t.Context()
+	baseDir := filepath.Join("tmp", "logs")
+	sessionFile := filepath.Join("home", "user", "v1", "sessionDetails.json")
+
+	m.expectSetup(baseDir)
+	m.expectWritableFolders(baseDir)
+
+	m.matlabManager.EXPECT().
+		ListEnvironments(ctx, m.logger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{}).
+		Once()
+
+	m.config.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Times(3)
+
+	m.sessionDiscoverer.EXPECT().
+		SessionDetailsFile().
+		Return(sessionFile, nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		ReadFile(sessionFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	m.config.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(assert.AnError).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.matlabRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, m.logger.AsMockArg()).
+		Return("", assert.AnError).
+		Once()
+
+	m.config.EXPECT().
+		DoctorFormat().
+		Return(entities.ReportFormatJSON).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stdout().
+		Return(m.stdout).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_DoctorChecksFailed_Error("2", "10", baseDir), err)
+
+	var report struct {
+		Checks []struct {
+			Name   string `json:"name"`
+			Status string `json:"status"`
+		} `json:"checks"`
+		Summary map[string]int `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(m.stdout.Bytes(), &report))
+
+	statuses := make(map[string]string, len(report.Checks))
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	assert.Equal(t, map[string]string{
+		"MATLAB installations":             "fail",
+		"--matlab-root":                    "skip",
+		"Log folder":                       "pass",
+		"Session folder":                   "pass",
+		"Shared MATLAB session":            "skip",
+		"Embedded connector TLS handshake": "skip",
+		"Embedded connector ping":          "skip",
+		"Extension files":                  "skip",
+		"Watchdog":                         "fail",
+		"MATLAB start":                     "skip",
+	}, statuses)
+	assert.Equal(t, map[string]int{"pass": 2, "warn": 0, "fail": 2, "skip": 6}, report.Summary)
+}
+
+func TestMode_StartAndWaitForCompletion_ExistingSessionModeWithStaleSession(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	ctx := t.Context()
+	baseDir := filepath.Join("tmp", "logs")
+	connectionDetails := `{"pid": 1234}`
+
+	m.expectSetup(baseDir)
+	m.expectWritableFolders(baseDir)
+
+	m.matlabManager.EXPECT().
+		ListEnvironments(ctx, m.logger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{}).
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Twice()
+
+	m.config.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return(connectionDetails).
+		Once()
+
+	m.sessionDiscoverer.EXPECT().
+		PIDFromSessionDetails([]byte(connectionDetails)).
+		Return(1234, nil).
+		Once()
+
+	m.processManager.EXPECT().
+		FindProcess(1234).
+		Return(nil).
+		Once()
+
+	m.config.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.config.EXPECT().
+		DoctorFormat().
+		Return(entities.ReportFormatText).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stdout().
+		Return(m.stdout).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_DoctorChecksFailed_Error("1", "10", baseDir), err)
+
+	report := m.stdout.String()
+	assert.Contains(t, report, "[FAIL] Shared MATLAB session: --matlab-session-connection-details refers to MATLAB process 1234, which is not running.")
+	assert.Contains(t, report, "[SKIP] MATLAB installations: No MATLAB installation found.")
+	assert.Contains(t, report, "[SKIP] MATLAB start: The server does not start MATLAB in 'existing' session mode.\n")
+}
+
+func TestMode_StartAndWaitForCompletion_MATLABStartFailure(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	ctx := t.Context()
+	baseDir := filepath.Join("tmp", "logs")
+	selectedMATLABRoot := filepath.Join("opt", "MATLAB", "R2025b")
+	otherMATLABRoot := filepath.Join("opt", "MATLAB", "R2024a")
+	const sessionID = entities.SessionID(3)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	m.expectSetup(baseDir)
+	m.expectWritableFolders(baseDir)
+
+	m.matlabManager.EXPECT().
+		ListEnvironments(ctx, m.logger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{
+			{MATLABRoot: otherMATLABRoot, Version: "R2024a"},
+			{MATLABRoot: selectedMATLABRoot, Version: "R2025b"},
+		}).
+		Once()
+
+	m.config.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionConnectionDetails().
+		Return("").
+		Once()
+
+	m.config.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Twice()
+
+	m.sessionDiscoverer.EXPECT().
+		SessionDetailsFile().
+		Return("", assert.AnError).
+		Once()
+
+	m.config.EXPECT().
+		ExtensionFiles().
+		Return([]string{}).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	m.watchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	m.matlabRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, m.logger.AsMockArg()).
+		Return(selectedMATLABRoot, nil).
+		Once()
+
+	m.config.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true).
+		Twice()
+
+	m.matlabManager.EXPECT().
+		StartMATLABSession(ctx, m.logger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: selectedMATLABRoot, ShowMATLABDesktop: true}).
+		Return(sessionID, nil).
+		Once()
+
+	m.matlabManager.EXPECT().
+		GetMATLABSessionClient(ctx, m.logger.AsMockArg(), sessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(ctx, m.logger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{ConsoleOutput: "0\n"}, nil).
+		Once()
+
+	m.matlabManager.EXPECT().
+		StopMATLABSession(ctx, m.logger.AsMockArg(), sessionID).
+		Return(nil).
+		Once()
+
+	m.matlabManager.EXPECT().
+		StartMATLABSession(ctx, m.logger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: otherMATLABRoot, ShowMATLABDesktop: true}).
+		Return(0, assert.AnError).
+		Once()
+
+	m.config.EXPECT().
+		DoctorFormat().
+		Return(entities.ReportFormatText).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stdout().
+		Return(m.stdout).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_DoctorChecksFailed_Error("1", "13", baseDir), err)
+
+	lines := strings.Split(m.stdout.String(), "\n")
+	selectedStart := indexOfPrefix(lines, "[PASS] MATLAB start "+selectedMATLABRoot+": MATLAB started.")
+	otherStart := indexOfPrefix(lines, "[FAIL] MATLAB start "+otherMATLABRoot+": MATLAB did not start.")
+	require.NotEqual(t, -1, selectedStart)
+	require.NotEqual(t, -1, otherStart)
+	assert.Less(t, selectedStart, otherStart, "The selected MATLAB should start first")
+	assert.Contains(t, lines, "[WARN] Toolbox in "+selectedMATLABRoot+": The MATLAB MCP Server Toolbox is not installed. To share this MATLAB with the server, run the server with --setup-matlab and --matlab-root.")
+	assert.Contains(t, lines, "[SKIP] Toolbox in "+otherMATLABRoot+": MATLAB did not start.")
+}
+
+func TestMode_StartAndWaitForCompletion_ConfigError(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	m.configFactory.EXPECT().
+		Config().
+		Return(nil, messages.AnError).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, messages.AnError, err)
+}
+
+func TestMode_StartAndWaitForCompletion_DirectoryError(t *testing.T) {
+	// Arrange
+	m := newDoctorMocks(t)
+
+	m.configFactory.EXPECT().
+		Config().
+		Return(m.config, nil).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(m.logger, nil).
+		Once()
+
+	m.directoryFactory.EXPECT().
+		Directory().
+		Return(nil, messages.AnError).
+		Once()
+
+	mode := m.newMode()
+
+	// Act
+	err := mode.StartAndWaitForCompletion(t.Context())
+
+	// Assert
+	require.Equal(t, messages.AnError, err)
+}
+
+func indexOfPrefix(lines []string, prefix string) int {
+	for i, line := range lines {
+		if strings.HasPrefix(line, prefix) {
+			return i
+		}
+	}
+	return -1
+}
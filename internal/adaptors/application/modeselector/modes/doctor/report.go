@@ -0,0 +1,124 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Result is the outcome of one check. Duration is zero for checks that are not timed.
+type Result struct {
+	Name     string
+	Status   Status
+	Detail   string
+	Duration time.Duration
+}
+
+type jsonResult struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	Detail     string `json:"detail"`
+	DurationMS *int64 `json:"durationMs,omitempty"`
+}
+
+type jsonReport struct {
+	Checks  []jsonResult   `json:"checks"`
+	Summary map[Status]int `json:"summary"`
+}
+
+func passed(name string, detail string) Result {
+	return Result{Name: name, Status: StatusPass, Detail: detail}
+}
+
+func warned(name string, detail string) Result {
+	return Result{Name: name, Status: StatusWarn, Detail: detail}
+}
+
+func failed(name string, detail string) Result {
+	return Result{Name: name, Status: StatusFail, Detail: detail}
+}
+
+func skipped(name string, detail string) Result {
+	return Result{Name: name, Status: StatusSkip, Detail: detail}
+}
+
+func timed(result Result, start time.Time) Result {
+	result.Duration = time.Since(start)
+	return result
+}
+
+func summarize(results []Result) map[Status]int {
+	summary := map[Status]int{StatusPass: 0, StatusWarn: 0, StatusFail: 0, StatusSkip: 0}
+	for _, result := range results {
+		summary[result.Status]++
+	}
+	return summary
+}
+
+func writeReport(writer io.Writer, format entities.ReportFormat, results []Result) error {
+	if format == entities.ReportFormatJSON {
+		return writeJSONReport(writer, results)
+	}
+	return writeTextReport(writer, results)
+}
+
+func writeTextReport(writer io.Writer, results []Result) error {
+	var report strings.Builder
+
+	for _, result := range results {
+		fmt.Fprintf(&report, "[%s] %s", strings.ToUpper(string(result.Status)), result.Name)
+		if result.Detail != "" {
+			fmt.Fprintf(&report, ": %s", result.Detail)
+		}
+		if result.Duration > 0 {
+			fmt.Fprintf(&report, " (%s)", result.Duration.Round(time.Millisecond))
+		}
+		report.WriteString("\n")
+	}
+
+	summary := summarize(results)
+	fmt.Fprintf(&report, "\n%d passed, %d warnings, %d failed, %d skipped\n",
+		summary[StatusPass], summary[StatusWarn], summary[StatusFail], summary[StatusSkip])
+
+	_, err := io.WriteString(writer, report.String())
+	return err
+}
+
+func writeJSONReport(writer io.Writer, results []Result) error {
+	report := jsonReport{
+		Checks:  make([]jsonResult, 0, len(results)),
+		Summary: summarize(results),
+	}
+
+	for _, result := range results {
+		checkResult := jsonResult{
+			Name:   result.Name,
+			Status: result.Status,
+			Detail: result.Detail,
+		}
+		if result.Duration > 0 {
+			durationMS := result.Duration.Milliseconds()
+			checkResult.DurationMS = &durationMS
+		}
+		report.Checks = append(report.Checks, checkResult)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -20,6 +20,7 @@ type ConfigFactory interface {
 
 type Parser interface {
 	Usage() (string, messages.Error)
+	PrintableConfig() (string, messages.Error)
 }
 
 type TelemetryFactory interface {
@@ -47,7 +48,15 @@ type LoggerFactory interface {
 	GetGlobalLogger() (entities.Logger, messages.Error)
 }
 
-type SetupMATLAB interface {
+type SetupMATLAB interface { //nolint:iface // Intentional interface for deps injection
+	StartAndWaitForCompletion(ctx context.Context) messages.Error
+}
+
+type Doctor interface { //nolint:iface // Intentional interface for deps injection
+	StartAndWaitForCompletion(ctx context.Context) messages.Error
+}
+
+type Analyze interface { //nolint:iface // Intentional interface for deps injection
 	StartAndWaitForCompletion(ctx context.Context) messages.Error
 }
 
@@ -61,6 +70,8 @@ type ModeSelector struct {
 	lifecycleSignaler LifecycleSignaler
 	loggerFactory     LoggerFactory
 	setupMATLAB       SetupMATLAB
+	doctor            Doctor
+	analyze           Analyze
 }
 
 func New(
@@ -73,6 +84,8 @@ func New(
 	lifecycleSignaler LifecycleSignaler,
 	loggerFactory LoggerFactory,
 	setupMATLAB SetupMATLAB,
+	doctor Doctor,
+	analyze Analyze,
 ) *ModeSelector {
 	return &ModeSelector{
 		configFactory:     configFactory,
@@ -84,6 +97,8 @@ func New(
 		lifecycleSignaler: lifecycleSignaler,
 		loggerFactory:     loggerFactory,
 		setupMATLAB:       setupMATLAB,
+		doctor:            doctor,
+		analyze:           analyze,
 	}
 }
 
@@ -124,11 +139,28 @@ func (m *ModeSelector) StartAndWaitForCompletion(ctx context.Context) messages.E
 			return m.shutdownAndReturn(logger, messagesErr)
 		}
 		return m.shutdownAndReturn(logger, nil)
+	case config.PrintConfigMode():
+		printableConfig, messagesErr := m.parser.PrintableConfig()
+		if messagesErr != nil {
+			return m.shutdownAndReturn(logger, messagesErr)
+		}
+		_, err := fmt.Fprint(m.osLayer.Stdout(), printableConfig)
+		if err != nil {
+			messagesErr := messages.New_StartupErrors_WriteError_Error("configuration", err.Error())
+			return m.shutdownAndReturn(logger, messagesErr)
+		}
+		return m.shutdownAndReturn(logger, nil)
 	case config.WatchdogMode():
 		return m.toMessagesError(logger, m.watchdogProcess.StartAndWaitForCompletion(ctx))
 	case config.SetupMATLABMode():
 		err := m.setupMATLAB.StartAndWaitForCompletion(ctx)
 		return m.shutdownAndReturn(logger, err)
+	case config.DoctorMode():
+		err := m.doctor.StartAndWaitForCompletion(ctx)
+		return m.shutdownAndReturn(logger, err)
+	case config.AnalyzeFolder() != "":
+		err := m.analyze.StartAndWaitForCompletion(ctx)
+		return m.shutdownAndReturn(logger, err)
 	default:
 		return m.toMessagesError(logger, m.orchestrator.StartAndWaitForCompletion(ctx))
 	}
@@ -45,6 +45,12 @@ func TestNew_HappyPath(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	// Act
 	modeSelectorInstance := modeselector.New(
 		mockConfigFactory,
@@ -56,6 +62,8 @@ func TestNew_HappyPath(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Assert
@@ -91,6 +99,12 @@ func TestStartAndWaitForCompletion_ConfigError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -108,6 +122,8 @@ func TestStartAndWaitForCompletion_ConfigError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -149,6 +165,12 @@ func TestStartAndWaitForCompletion_GetGlobalLoggerError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -171,6 +193,8 @@ func TestStartAndWaitForCompletion_GetGlobalLoggerError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -215,6 +239,12 @@ func TestStartAndWaitForCompletion_TelemetryError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockLoggerFactory.EXPECT().
@@ -242,6 +272,8 @@ func TestStartAndWaitForCompletion_TelemetryError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -292,6 +324,12 @@ func TestStartAndWaitForCompletion_VersionMode_HappyPath(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 	expectedVersion := "25.6.68"
 
@@ -358,6 +396,8 @@ func TestStartAndWaitForCompletion_VersionMode_HappyPath(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -408,6 +448,12 @@ func TestStartAndWaitForCompletion_VersionMode_WriteError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 	expectedVersion := "25.6.68"
 	writeError := assert.AnError
@@ -475,6 +521,8 @@ func TestStartAndWaitForCompletion_VersionMode_WriteError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -528,6 +576,12 @@ func TestStartAndWaitForCompletion_VersionMode_ShutdownError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 	expectedVersion := "25.6.68"
 
@@ -603,6 +657,8 @@ func TestStartAndWaitForCompletion_VersionMode_ShutdownError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -650,6 +706,12 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -681,6 +743,11 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(true).
@@ -701,6 +768,8 @@ func TestStartAndWaitForCompletion_WatchdogMode_HappyPath(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -748,6 +817,12 @@ func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T)
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	watchdogError := assert.AnError
 	expectedCtx := t.Context()
 
@@ -780,6 +855,11 @@ func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T)
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(true).
@@ -809,6 +889,8 @@ func TestStartAndWaitForCompletion_WatchdogMode_StartAndWaitError(t *testing.T)
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -857,6 +939,12 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_HappyPath(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -888,6 +976,11 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -922,6 +1015,8 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_HappyPath(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -969,6 +1064,12 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_Error(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedError := messages.AnError
 	expectedCtx := t.Context()
 
@@ -1001,6 +1102,11 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_Error(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -1035,6 +1141,8 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_Error(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
@@ -1044,7 +1152,7 @@ func TestStartAndWaitForCompletion_SetupMATLABMode_Error(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from SetupMATLAB")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
+func TestStartAndWaitForCompletion_DoctorMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1082,6 +1190,12 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1113,6 +1227,11 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -1123,11 +1242,25 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
-	mockOrchestrator.EXPECT().
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(true).
+		Once()
+
+	mockDoctor.EXPECT().
 		StartAndWaitForCompletion(expectedCtx).
 		Return(nil).
 		Once()
 
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
 	modeSelectorInstance := modeselector.New(
 		mockConfigFactory,
 		mockParser,
@@ -1138,16 +1271,18 @@ func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in default mode")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in doctor mode")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
+func TestStartAndWaitForCompletion_DoctorMode_Error(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1185,7 +1320,13 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
-	orchestratorError := assert.AnError
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	expectedError := messages.AnError
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1217,6 +1358,11 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -1227,18 +1373,23 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 		Return(false).
 		Once()
 
-	mockOrchestrator.EXPECT().
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(true).
+		Once()
+
+	mockDoctor.EXPECT().
 		StartAndWaitForCompletion(expectedCtx).
-		Return(orchestratorError).
+		Return(expectedError).
 		Once()
 
-	mockLogger.EXPECT().
-		WithError(orchestratorError).
-		Return(mockLogger).
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
 		Once()
 
-	mockLogger.EXPECT().
-		Error("Server failed with unexpected error").
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
@@ -1251,17 +1402,18 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	var genericErr *messages.StartupErrors_GenericInitializeFailure_Error
-	require.ErrorAs(t, err, &genericErr)
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Doctor")
 }
 
-func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *testing.T) {
+func TestStartAndWaitForCompletion_AnalyzeMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1299,7 +1451,12 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *test
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
-	expectedError := messages.AnError
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1331,6 +1488,11 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *test
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
 	mockConfig.EXPECT().
 		WatchdogMode().
 		Return(false).
@@ -1341,9 +1503,28 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *test
 		Return(false).
 		Once()
 
-	mockOrchestrator.EXPECT().
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AnalyzeFolder().
+		Return("/work/project").
+		Once()
+
+	mockAnalyze.EXPECT().
 		StartAndWaitForCompletion(expectedCtx).
-		Return(expectedError).
+		Return(nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
 		Once()
 
 	modeSelectorInstance := modeselector.New(
@@ -1356,16 +1537,18 @@ func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *test
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should pass through messages.Error without wrapping")
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in analyze mode")
 }
 
-func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T) {
+func TestStartAndWaitForCompletion_AnalyzeMode_Error(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1391,9 +1574,6 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T)
 	mockParser := &modeselectormocks.MockParser{}
 	defer mockParser.AssertExpectations(t)
 
-	mockStdout := &entitiesmocks.MockWriter{}
-	defer mockStdout.AssertExpectations(t)
-
 	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -1406,7 +1586,13 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T)
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
-	helpText := "Help me get my feet back on the ground."
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	expectedError := messages.AnError
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1430,22 +1616,42 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T)
 
 	mockConfig.EXPECT().
 		HelpMode().
-		Return(true).
+		Return(false).
 		Once()
 
-	mockParser.EXPECT().
-		Usage().
-		Return(helpText, nil).
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
 		Once()
 
-	mockOsLayer.EXPECT().
-		Stdout().
-		Return(mockStdout).
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
 		Once()
 
-	mockStdout.EXPECT().
-		Write([]byte(fmt.Sprintf("%s\n", helpText))).
-		Return(len(helpText)+1, nil).
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SetupMATLABMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AnalyzeFolder().
+		Return("/work/project").
+		Once()
+
+	mockAnalyze.EXPECT().
+		StartAndWaitForCompletion(expectedCtx).
+		Return(expectedError).
 		Once()
 
 	mockLifecycleSignaler.EXPECT().
@@ -1467,16 +1673,18 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T)
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	require.NoError(t, err)
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should return the error from Analyze")
 }
 
-func TestStartAndWaitForCompletion_HelpMode_UsageError(t *testing.T) {
+func TestStartAndWaitForCompletion_DefaultMode_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1514,6 +1722,12 @@ func TestStartAndWaitForCompletion_HelpMode_UsageError(t *testing.T) {
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1537,20 +1751,41 @@ func TestStartAndWaitForCompletion_HelpMode_UsageError(t *testing.T) {
 
 	mockConfig.EXPECT().
 		HelpMode().
-		Return(true).
+		Return(false).
 		Once()
 
-	mockParser.EXPECT().
-		Usage().
-		Return("", messages.AnError).
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
 		Once()
 
-	mockLifecycleSignaler.EXPECT().
-		RequestShutdown().
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
 		Once()
 
-	mockLifecycleSignaler.EXPECT().
-		WaitForShutdownToComplete().
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SetupMATLABMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AnalyzeFolder().
+		Return("").
+		Once()
+
+	mockOrchestrator.EXPECT().
+		StartAndWaitForCompletion(expectedCtx).
 		Return(nil).
 		Once()
 
@@ -1564,16 +1799,18 @@ func TestStartAndWaitForCompletion_HelpMode_UsageError(t *testing.T) {
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	require.ErrorIs(t, err, messages.AnError)
+	require.NoError(t, err, "StartAndWaitForCompletion should not return an error in default mode")
 }
 
-func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T) {
+func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitError(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
@@ -1599,9 +1836,6 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T)
 	mockParser := &modeselectormocks.MockParser{}
 	defer mockParser.AssertExpectations(t)
 
-	mockStdout := &entitiesmocks.MockWriter{}
-	defer mockStdout.AssertExpectations(t)
-
 	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -1614,8 +1848,13 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T)
 	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
 	defer mockSetupMATLAB.AssertExpectations(t)
 
-	helpText := "Help me get my feet back on the ground."
-	writeError := assert.AnError
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	orchestratorError := assert.AnError
 	expectedCtx := t.Context()
 
 	mockLoggerFactory.EXPECT().
@@ -1639,31 +1878,51 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T)
 
 	mockConfig.EXPECT().
 		HelpMode().
-		Return(true).
+		Return(false).
 		Once()
 
-	mockParser.EXPECT().
-		Usage().
-		Return(helpText, nil).
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
 		Once()
 
-	mockLifecycleSignaler.EXPECT().
-		RequestShutdown().
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
 		Once()
 
-	mockLifecycleSignaler.EXPECT().
-		WaitForShutdownToComplete().
-		Return(nil).
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
 		Once()
 
-	mockOsLayer.EXPECT().
-		Stdout().
-		Return(mockStdout).
+	mockConfig.EXPECT().
+		SetupMATLABMode().
+		Return(false).
 		Once()
 
-	mockStdout.EXPECT().
-		Write([]byte(fmt.Sprintf("%s\n", helpText))).
-		Return(0, writeError).
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AnalyzeFolder().
+		Return("").
+		Once()
+
+	mockOrchestrator.EXPECT().
+		StartAndWaitForCompletion(expectedCtx).
+		Return(orchestratorError).
+		Once()
+
+	mockLogger.EXPECT().
+		WithError(orchestratorError).
+		Return(mockLogger).
+		Once()
+
+	mockLogger.EXPECT().
+		Error("Server failed with unexpected error").
 		Once()
 
 	modeSelectorInstance := modeselector.New(
@@ -1676,14 +1935,732 @@ func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T)
 		mockLifecycleSignaler,
 		mockLoggerFactory,
 		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
 	)
 
 	// Act
 	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
 
 	// Assert
-	var writeErr *messages.StartupErrors_WriteError_Error
+	var genericErr *messages.StartupErrors_GenericInitializeFailure_Error
+	require.ErrorAs(t, err, &genericErr)
+}
+
+func TestStartAndWaitForCompletion_DefaultMode_StartAndWaitMessagesError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	expectedError := messages.AnError
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		SetupMATLABMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DoctorMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AnalyzeFolder().
+		Return("").
+		Once()
+
+	mockOrchestrator.EXPECT().
+		StartAndWaitForCompletion(expectedCtx).
+		Return(expectedError).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "StartAndWaitForCompletion should pass through messages.Error without wrapping")
+}
+
+func TestStartAndWaitForCompletion_HelpMode_StartAndWaitHappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	helpText := "Help me get my feet back on the ground."
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(true).
+		Once()
+
+	mockParser.EXPECT().
+		Usage().
+		Return(helpText, nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockStdout.EXPECT().
+		Write([]byte(fmt.Sprintf("%s\n", helpText))).
+		Return(len(helpText)+1, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestStartAndWaitForCompletion_HelpMode_UsageError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(true).
+		Once()
+
+	mockParser.EXPECT().
+		Usage().
+		Return("", messages.AnError).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	require.ErrorIs(t, err, messages.AnError)
+}
+
+func TestStartAndWaitForCompletion_HelpMode_StartAndWaitWriteError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	helpText := "Help me get my feet back on the ground."
+	writeError := assert.AnError
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(true).
+		Once()
+
+	mockParser.EXPECT().
+		Usage().
+		Return(helpText, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockStdout.EXPECT().
+		Write([]byte(fmt.Sprintf("%s\n", helpText))).
+		Return(0, writeError).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	var writeErr *messages.StartupErrors_WriteError_Error
 	require.ErrorAs(t, err, &writeErr)
 	require.Equal(t, "help", writeErr.Attr0)
 	require.Equal(t, writeError.Error(), writeErr.Attr1)
 }
+
+func TestStartAndWaitForCompletion_PrintConfigMode_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	printableConfig := "matlab-root: \"/MATLAB\"  # config file matlab-mcp-server.yaml\n"
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(true).
+		Once()
+
+	mockParser.EXPECT().
+		PrintableConfig().
+		Return(printableConfig, nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockStdout.EXPECT().
+		Write([]byte(printableConfig)).
+		Return(len(printableConfig), nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestStartAndWaitForCompletion_PrintConfigMode_PrintableConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &modeselectormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockTelemetryFactory := &modeselectormocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockWatchdogProcess := &modeselectormocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockOrchestrator := &modeselectormocks.MockOrchestrator{}
+	defer mockOrchestrator.AssertExpectations(t)
+
+	mockOsLayer := &modeselectormocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockParser := &modeselectormocks.MockParser{}
+	defer mockParser.AssertExpectations(t)
+
+	mockLoggerFactory := &modeselectormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := &entitiesmocks.MockLogger{}
+	defer mockLogger.AssertExpectations(t)
+
+	mockLifecycleSignaler := &modeselectormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockSetupMATLAB := &modeselectormocks.MockSetupMATLAB{}
+	defer mockSetupMATLAB.AssertExpectations(t)
+
+	mockDoctor := &modeselectormocks.MockDoctor{}
+	defer mockDoctor.AssertExpectations(t)
+
+	mockAnalyze := &modeselectormocks.MockAnalyze{}
+	defer mockAnalyze.AssertExpectations(t)
+
+	expectedCtx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockTelemetry.EXPECT().
+		RecordServerStart(expectedCtx).
+		Once()
+
+	mockConfig.EXPECT().
+		HelpMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		VersionMode().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PrintConfigMode().
+		Return(true).
+		Once()
+
+	mockParser.EXPECT().
+		PrintableConfig().
+		Return("", messages.AnError).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	modeSelectorInstance := modeselector.New(
+		mockConfigFactory,
+		mockParser,
+		mockTelemetryFactory,
+		mockWatchdogProcess,
+		mockOrchestrator,
+		mockOsLayer,
+		mockLifecycleSignaler,
+		mockLoggerFactory,
+		mockSetupMATLAB,
+		mockDoctor,
+		mockAnalyze,
+	)
+
+	// Act
+	err := modeSelectorInstance.StartAndWaitForCompletion(expectedCtx)
+
+	// Assert
+	require.ErrorIs(t, err, messages.AnError)
+}
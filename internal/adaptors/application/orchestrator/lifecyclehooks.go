@@ -0,0 +1,112 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+var errLifecycleHookTimedOut = errors.New("lifecycle hook timed out")
+
+// lifecycleHooks runs the lifecycle hooks of the application definition, and keeps track of the hooks that started,
+// so that only those are shut down, and of the hook functions that are still running after they timed out.
+type lifecycleHooks struct {
+	logger    entities.Logger
+	timeout   time.Duration
+	resources definition.LifecycleHookResources
+
+	started []definition.LifecycleHook
+	running sync.WaitGroup
+}
+
+func newLifecycleHooks(logger entities.Logger, timeout time.Duration, resources definition.LifecycleHookResources) *lifecycleHooks {
+	return &lifecycleHooks{
+		logger:    logger,
+		timeout:   timeout,
+		resources: resources,
+	}
+}
+
+// start runs the OnStart function of each hook in order, and stops at the first one that fails or times out.
+func (h *lifecycleHooks) start(ctx context.Context, hooks []definition.LifecycleHook) messages.Error {
+	for index, hook := range hooks {
+		if hook.Name == "" {
+			hook.Name = fmt.Sprintf("#%d", index+1)
+		}
+
+		if hook.OnStart != nil {
+			hookLogger := h.logger.With("hook", hook.Name)
+			hookLogger.Debug("Running lifecycle start hook")
+
+			err := h.run(ctx, hook.OnStart)
+			if errors.Is(err, errLifecycleHookTimedOut) {
+				hookLogger.With("timeout", h.timeout).Error("Lifecycle start hook timed out")
+				return messages.New_StartupErrors_LifecycleHookTimedOut_Error(hook.Name, h.timeout.String())
+			}
+			if err != nil {
+				hookLogger.WithError(err).Error("Lifecycle start hook failed")
+				return messages.New_StartupErrors_LifecycleHookFailed_Error(hook.Name, err.Error())
+			}
+		}
+
+		h.started = append(h.started, hook)
+	}
+
+	return nil
+}
+
+// shutdown runs the OnShutdown function of each started hook in reverse order. A hook that fails does not stop the others.
+// It first waits for the start hooks that timed out to return, so that no shutdown hook runs alongside a start hook.
+func (h *lifecycleHooks) shutdown(ctx context.Context) {
+	h.logger.Debug("Waiting for the lifecycle start hooks to return")
+	h.running.Wait()
+
+	// The hooks must run even when the application stops because its context was cancelled.
+	ctx = context.WithoutCancel(ctx)
+
+	for i := len(h.started) - 1; i >= 0; i-- {
+		hook := h.started[i]
+		if hook.OnShutdown == nil {
+			continue
+		}
+
+		hookLogger := h.logger.With("hook", hook.Name)
+		hookLogger.Debug("Running lifecycle shutdown hook")
+
+		if err := h.run(ctx, hook.OnShutdown); err != nil {
+			hookLogger.WithError(err).Warn("Lifecycle shutdown hook failed")
+		}
+	}
+
+	h.started = nil
+}
+
+// run runs a hook function, and stops waiting for it when the timeout expires. The context of the hook function is then
+// cancelled, and the function is tracked until it returns.
+func (h *lifecycleHooks) run(ctx context.Context, hookFunc definition.LifecycleHookFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	errC := make(chan error, 1)
+	h.running.Go(func() {
+		errC <- hookFunc(ctx, h.resources)
+	})
+
+	select {
+	case err := <-errC:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return errLifecycleHookTimedOut
+		}
+		return ctx.Err()
+	}
+}
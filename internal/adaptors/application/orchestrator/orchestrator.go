@@ -5,6 +5,7 @@ package orchestrator
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
@@ -28,6 +29,9 @@ type ApplicationDefinition interface {
 	Features() definition.Features
 	Dependencies(resources definition.DependenciesProviderResources) (any, error)
 	Tools(resources definition.ToolsProviderResources) []tools.Tool
+	ToolMiddleware(resources definition.ToolsProviderResources) []mcp.Middleware
+	LifecycleHooks() []definition.LifecycleHook
+	LifecycleHookTimeout() time.Duration
 }
 
 type ConfigFactory interface {
@@ -35,7 +39,7 @@ type ConfigFactory interface {
 }
 
 type Server interface {
-	Run(tools []tools.Tool) error
+	Run(tools []tools.Tool, toolMiddleware []mcp.Middleware) error
 }
 
 type WatchdogClient interface {
@@ -120,6 +124,8 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		return err
 	}
 
+	var hooks *lifecycleHooks
+
 	defer func() {
 		logger.Info("Initiating application shutdown")
 		o.lifecycleSignaler.RequestShutdown()
@@ -129,6 +135,11 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 			logger.WithError(err).Warn("Application shutdown failed")
 		}
 
+		if hooks != nil {
+			logger.Debug("Running SDK shutdown hooks")
+			hooks.shutdown(ctx)
+		}
+
 		logger.Debug("Shutdown functions have all completed, stopping the watchdog")
 		err = o.watchdogClient.Stop()
 		if err != nil {
@@ -171,18 +182,38 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		return err
 	}
 
-	logger.Debug("Building SDK tools")
-	tools := o.applicationDefinition.Tools(definition.NewToolsProviderResources(
+	toolsProviderResources := definition.NewToolsProviderResources(
 		logger,
 		config,
 		o.messageCatalog,
 		dependencies,
 		o.loggerFactory,
-	))
+	)
+
+	logger.Debug("Building SDK tools")
+	tools := o.applicationDefinition.Tools(toolsProviderResources)
+
+	logger.Debug("Building SDK tool middleware")
+	toolMiddleware := o.applicationDefinition.ToolMiddleware(toolsProviderResources)
+
+	logger.Debug("Running SDK start hooks")
+	hooks = newLifecycleHooks(
+		logger,
+		o.applicationDefinition.LifecycleHookTimeout(),
+		definition.NewLifecycleHookResources(
+			logger,
+			config,
+			o.messageCatalog,
+			dependencies,
+		),
+	)
+	if messagesErr := hooks.start(ctx, o.applicationDefinition.LifecycleHooks()); messagesErr != nil {
+		return messagesErr
+	}
 
 	serverErrC := make(chan error, 1)
 	go func() {
-		serverErrC <- o.server.Run(tools)
+		serverErrC <- o.server.Run(tools, toolMiddleware)
 	}()
 
 	logger.Info("Application startup complete")
@@ -3,8 +3,12 @@
 package orchestrator_test
 
 import (
+	"context"
+	"errors"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/orchestrator"
@@ -16,7 +20,9 @@ import (
 	directorymocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/directory"
 	orchestratormocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/orchestrator"
 	toolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -668,6 +674,7 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
 	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
 	expectedTools := []tools.Tool{mockTool}
+	expectedToolMiddleware := []mcp.Middleware{func(next mcp.MethodHandler) mcp.MethodHandler { return next }}
 	expectedVersion := "test-version"
 
 	mockLoggerFactory.EXPECT().
@@ -724,10 +731,25 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Return(expectedTools).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(expectedToolMiddleware).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(nil).
+		Once()
+
 	// Server should run indefinitely (simulate with a blocking channel)
 	mockServer.EXPECT().
-		Run(expectedTools).
-		RunAndReturn(func(_ []tools.Tool) error {
+		Run(expectedTools, mock.MatchedBy(func(toolMiddleware []mcp.Middleware) bool { return len(toolMiddleware) == 1 })).
+		RunAndReturn(func(_ []tools.Tool, _ []mcp.Middleware) error {
 			close(serverStarted)
 			<-stopServer
 			return nil
@@ -884,8 +906,23 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		Return(expectedTools).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(nil).
+		Once()
+
 	mockServer.EXPECT().
-		Run(expectedTools).
+		Run(expectedTools, []mcp.Middleware(nil)).
 		Return(expectedError).
 		Once()
 
@@ -1026,8 +1063,23 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		Return(expectedTools).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(nil).
+		Once()
+
 	mockServer.EXPECT().
-		Run(expectedTools).
+		Run(expectedTools, []mcp.Middleware(nil)).
 		Return(nil).
 		Once()
 
@@ -1189,9 +1241,24 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 		Return(expectedTools).
 		Once()
 
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(nil).
+		Once()
+
 	mockServer.EXPECT().
-		Run(expectedTools).
-		RunAndReturn(func(_ []tools.Tool) error {
+		Run(expectedTools, []mcp.Middleware(nil)).
+		RunAndReturn(func(_ []tools.Tool, _ []mcp.Middleware) error {
 			close(serverStarted)
 			<-stopServer
 			return nil
@@ -1254,6 +1321,935 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	assert.Equal(t, expectedError, fields["error"])
 }
 
+func TestOrchestrator_StartAndWaitForCompletion_LifecycleHooks(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	interruptC := getInterruptChannel()
+	serverStarted := make(chan struct{})
+	stopServer := make(chan struct{})
+	defer close(stopServer)
+
+	expectedDependencies := &struct{}{}
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+
+	var events []string
+	record := func(event string) definition.LifecycleHookFunc {
+		return func(_ context.Context, resources definition.LifecycleHookResources) error {
+			assert.Equal(t, expectedDependencies, resources.Dependencies)
+			events = append(events, event)
+			return nil
+		}
+	}
+	hooks := []definition.LifecycleHook{
+		{Name: "database", OnStart: record("start database"), OnShutdown: record("shutdown database")},
+		{Name: "cache", OnStart: record("start cache"), OnShutdown: record("shutdown cache")},
+		{Name: "metrics", OnStart: record("start metrics")},
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(expectedDependencies, nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Tools(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(hooks).
+		Once()
+
+	mockServer.EXPECT().
+		Run([]tools.Tool(nil), []mcp.Middleware(nil)).
+		RunAndReturn(func(_ []tools.Tool, _ []mcp.Middleware) error {
+			events = append(events, "server run")
+			close(serverStarted)
+			<-stopServer
+			return nil
+		}).
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(interruptC).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		RunAndReturn(func() error {
+			events = append(events, "server shutdown")
+			return nil
+		}).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		RunAndReturn(func() error {
+			events = append(events, "watchdog stop")
+			return nil
+		}).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+	)
+
+	// Act
+	errC := make(chan error)
+	go func() {
+		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
+	}()
+
+	<-serverStarted
+
+	sendInterruptSignal(interruptC)
+
+	// Assert
+	require.NoError(t, <-errC, "StartAndWaitForCompletion should not return an error on signal interrupt")
+	assert.Equal(t, []string{
+		"start database",
+		"start cache",
+		"start metrics",
+		"server run",
+		"server shutdown",
+		"shutdown cache",
+		"shutdown database",
+		"watchdog stop",
+	}, events, "Start hooks should run in order before the server, and shutdown hooks in reverse order after it")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_LifecycleStartHookError(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	expectedDependencies := &struct{}{}
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedError := messages.New_StartupErrors_LifecycleHookFailed_Error("cache", "connection refused")
+
+	var events []string
+	record := func(event string) definition.LifecycleHookFunc {
+		return func(_ context.Context, _ definition.LifecycleHookResources) error {
+			events = append(events, event)
+			return nil
+		}
+	}
+	hooks := []definition.LifecycleHook{
+		{Name: "database", OnStart: record("start database"), OnShutdown: record("shutdown database")},
+		{
+			Name: "cache",
+			OnStart: func(_ context.Context, _ definition.LifecycleHookResources) error {
+				return errors.New("connection refused")
+			},
+			OnShutdown: record("shutdown cache"),
+		},
+		{Name: "metrics", OnStart: record("start metrics")},
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(expectedDependencies, nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Tools(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(hooks).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		RunAndReturn(func() error {
+			events = append(events, "server shutdown")
+			return nil
+		}).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		RunAndReturn(func() error {
+			events = append(events, "watchdog stop")
+			return nil
+		}).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, expectedError, err, "StartAndWaitForCompletion should return the error of the start hook")
+	assert.Equal(t, []string{
+		"start database",
+		"server shutdown",
+		"shutdown database",
+		"watchdog stop",
+	}, events, "Only the hooks that started should be shut down")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_LifecycleStartHookTimeout(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	expectedDependencies := &struct{}{}
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	hookTimeout := 10 * time.Millisecond
+	expectedError := messages.New_StartupErrors_LifecycleHookTimedOut_Error("#1", "10ms")
+
+	var events []string
+	hooks := []definition.LifecycleHook{
+		{
+			OnStart: func(ctx context.Context, _ definition.LifecycleHookResources) error {
+				<-ctx.Done()
+				return nil
+			},
+		},
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(expectedDependencies, nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Tools(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(hookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(hooks).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		RunAndReturn(func() error {
+			events = append(events, "server shutdown")
+			return nil
+		}).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		RunAndReturn(func() error {
+			events = append(events, "watchdog stop")
+			return nil
+		}).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, expectedError, err, "StartAndWaitForCompletion should return a timeout error for an unnamed hook")
+	assert.Equal(t, []string{"server shutdown", "watchdog stop"}, events)
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_LifecycleStartHookTimeout_WaitsBeforeShutdownHooks(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	expectedDependencies := &struct{}{}
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	hookTimeout := 10 * time.Millisecond
+	expectedError := messages.New_StartupErrors_LifecycleHookTimedOut_Error("slow", "10ms")
+
+	var eventsLock sync.Mutex
+	var events []string
+	addEvent := func(event string) {
+		eventsLock.Lock()
+		defer eventsLock.Unlock()
+		events = append(events, event)
+	}
+
+	hooks := []definition.LifecycleHook{
+		{
+			Name: "first",
+			OnStart: func(context.Context, definition.LifecycleHookResources) error {
+				return nil
+			},
+			OnShutdown: func(context.Context, definition.LifecycleHookResources) error {
+				addEvent("first hook shutdown")
+				return nil
+			},
+		},
+		{
+			Name: "slow",
+			OnStart: func(ctx context.Context, _ definition.LifecycleHookResources) error {
+				<-ctx.Done()
+				// The hook takes time to stop after its context is cancelled.
+				time.Sleep(50 * time.Millisecond)
+				addEvent("slow hook returned")
+				return nil
+			},
+		},
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(expectedDependencies, nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Tools(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(hookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(hooks).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		RunAndReturn(func() error {
+			addEvent("server shutdown")
+			return nil
+		}).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		RunAndReturn(func() error {
+			addEvent("watchdog stop")
+			return nil
+		}).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Equal(t, []string{"server shutdown", "slow hook returned", "first hook shutdown", "watchdog stop"}, events, "The shutdown hooks should wait for the start hook that timed out to return")
+}
+
+func TestOrchestrator_StartAndWaitForCompletion_LifecycleShutdownHookError(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockApplicationDefinition := &orchestratormocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockConfigFactory := &orchestratormocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockDirectoryFactory := &orchestratormocks.MockDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockResourceLimitManager := &orchestratormocks.MockResourceLimitManager{}
+	defer mockResourceLimitManager.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	interruptC := getInterruptChannel()
+	serverStarted := make(chan struct{})
+	stopServer := make(chan struct{})
+	defer close(stopServer)
+
+	expectedDependencies := &struct{}{}
+	expectedDependenciesProviderResources := definition.NewDependenciesProviderResources(mockLogger, mockConfig, mockMessageCatalog, mockWatchdogClient)
+	expectedToolProviderResources := definition.NewToolsProviderResources(mockLogger, mockConfig, mockMessageCatalog, expectedDependencies, mockLoggerFactory)
+	expectedError := errors.New("failed to flush")
+
+	var events []string
+	hooks := []definition.LifecycleHook{
+		{
+			Name: "database",
+			OnShutdown: func(_ context.Context, _ definition.LifecycleHookResources) error {
+				events = append(events, "shutdown database")
+				return nil
+			},
+		},
+		{
+			Name: "cache",
+			OnShutdown: func(_ context.Context, _ definition.LifecycleHookResources) error {
+				return expectedError
+			},
+		},
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockResourceLimitManager.EXPECT().
+		CapOpenFilesLimit(orchestrator.UnixOpenFileDescriptorsSoftCap).
+		Return(func() error { return nil }, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return("test-version").
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Directory().
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Dependencies(expectedDependenciesProviderResources).
+		Return(expectedDependencies, nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		Tools(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		ToolMiddleware(expectedToolProviderResources).
+		Return(nil).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHookTimeout().
+		Return(definition.DefaultLifecycleHookTimeout).
+		Once()
+
+	mockApplicationDefinition.EXPECT().
+		LifecycleHooks().
+		Return(hooks).
+		Once()
+
+	mockServer.EXPECT().
+		Run([]tools.Tool(nil), []mcp.Middleware(nil)).
+		RunAndReturn(func(_ []tools.Tool, _ []mcp.Middleware) error {
+			events = append(events, "server run")
+			close(serverStarted)
+			<-stopServer
+			return nil
+		}).
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(interruptC).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		RunAndReturn(func() error {
+			events = append(events, "server shutdown")
+			return nil
+		}).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		RunAndReturn(func() error {
+			events = append(events, "watchdog stop")
+			return nil
+		}).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockMessageCatalog,
+		mockLifecycleSignaler,
+		mockApplicationDefinition,
+		mockConfigFactory,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockDirectoryFactory,
+		mockResourceLimitManager,
+	)
+
+	// Act
+	errC := make(chan error)
+	go func() {
+		errC <- orchestratorInstance.StartAndWaitForCompletion(ctx)
+	}()
+
+	<-serverStarted
+
+	sendInterruptSignal(interruptC)
+
+	// Assert
+	require.NoError(t, <-errC)
+	assert.Equal(t, []string{"server run", "server shutdown", "shutdown database", "watchdog stop"}, events, "A failed shutdown hook should not stop the others")
+
+	logs := mockLogger.WarnLogs()
+	fields, found := logs["Lifecycle shutdown hook failed"]
+	require.True(t, found, "Expected warning log for the failed shutdown hook")
+	assert.Equal(t, expectedError, fields["error"])
+	assert.Equal(t, "cache", fields["hook"])
+}
+
 func getInterruptChannel() chan os.Signal {
 	return make(chan os.Signal, 1)
 }
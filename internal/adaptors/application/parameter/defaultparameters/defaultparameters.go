@@ -51,6 +51,84 @@ func SetupMATLABMode() *parameter.Parameter[bool] {
 	)
 }
 
+func DoctorMode() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "DoctorMode",
+		/* flagName */ "doctor",
+		/* hiddenFlag */ false,
+		/* envVarName */ "",
+		/* descriptionKey */ messages.CLIMessages_DoctorDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ false,
+		/* piiSafe */ true,
+	)
+}
+
+func DoctorFormat() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "DoctorFormat",
+		/* flagName */ "doctor-format",
+		/* hiddenFlag */ false,
+		/* envVarName */ "",
+		/* descriptionKey */ messages.CLIMessages_DoctorFormatDescription,
+		/* defaultValue */ string(entities.ReportFormatText),
+		/* recordToLog */ false,
+		/* piiSafe */ true,
+	)
+}
+
+func AnalyzeFolder() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "AnalyzeFolder",
+		/* flagName */ "analyze",
+		/* hiddenFlag */ false,
+		/* envVarName */ "",
+		/* descriptionKey */ messages.CLIMessages_AnalyzeFolderDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ false,
+		/* piiSafe */ false,
+	)
+}
+
+func AnalyzeFormat() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "AnalyzeFormat",
+		/* flagName */ "analyze-format",
+		/* hiddenFlag */ false,
+		/* envVarName */ "",
+		/* descriptionKey */ messages.CLIMessages_AnalyzeFormatDescription,
+		/* defaultValue */ string(entities.ReportFormatText),
+		/* recordToLog */ false,
+		/* piiSafe */ true,
+	)
+}
+
+func PrintConfigMode() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "PrintConfigMode",
+		/* flagName */ "print-config",
+		/* hiddenFlag */ false,
+		/* envVarName */ "",
+		/* descriptionKey */ messages.CLIMessages_PrintConfigDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ false,
+		/* piiSafe */ true,
+	)
+}
+
+func ConfigFile() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "ConfigFile",
+		/* flagName */ "config",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"CONFIG",
+		/* descriptionKey */ messages.CLIMessages_ConfigFileDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
 func PreferredLocalMATLABRoot() *parameter.Parameter[string] {
 	return parameter.NewParameter(
 		/* id */ "PreferredLocalMATLABRoot",
@@ -64,6 +142,175 @@ func PreferredLocalMATLABRoot() *parameter.Parameter[string] {
 	)
 }
 
+func PreferredMATLABRelease() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "PreferredMATLABRelease",
+		/* flagName */ "matlab-release",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_RELEASE",
+		/* descriptionKey */ messages.CLIMessages_PreferredMATLABReleaseDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABSearchFolders() *parameter.Parameter[[]string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABSearchFolders",
+		/* flagName */ "matlab-search-folder",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_SEARCH_FOLDER",
+		/* descriptionKey */ messages.CLIMessages_MATLABSearchFoldersDescription,
+		/* defaultValue */ []string(nil),
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func MATLABStartupFlags() *parameter.Parameter[[]string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABStartupFlags",
+		/* flagName */ "matlab-startup-flag",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_STARTUP_FLAG",
+		/* descriptionKey */ messages.CLIMessages_MATLABStartupFlagsDescription,
+		/* defaultValue */ []string(nil),
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func MATLABEnvironmentVariables() *parameter.Parameter[[]string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABEnvironmentVariables",
+		/* flagName */ "matlab-env",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_ENV",
+		/* descriptionKey */ messages.CLIMessages_MATLABEnvironmentVariablesDescription,
+		/* defaultValue */ []string(nil),
+		/* recordToLog */ false,
+		/* piiSafe */ false,
+	)
+}
+
+func MATLABStartupScript() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABStartupScript",
+		/* flagName */ "matlab-startup-script",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_STARTUP_SCRIPT",
+		/* descriptionKey */ messages.CLIMessages_MATLABStartupScriptDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func MATLABPath() *parameter.Parameter[[]string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABPath",
+		/* flagName */ "matlab-path",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_PATH",
+		/* descriptionKey */ messages.CLIMessages_MATLABPathDescription,
+		/* defaultValue */ []string(nil),
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func MATLABSessionPoolSize() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "MATLABSessionPoolSize",
+		/* flagName */ "matlab-session-pool-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_SESSION_POOL_SIZE",
+		/* descriptionKey */ messages.CLIMessages_MATLABSessionPoolSizeDescription,
+		/* defaultValue */ 0,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABIdleTimeout() *parameter.Parameter[time.Duration] {
+	return parameter.NewParameter(
+		/* id */ "MATLABIdleTimeout",
+		/* flagName */ "matlab-idle-timeout",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_IDLE_TIMEOUT",
+		/* descriptionKey */ messages.CLIMessages_MATLABIdleTimeoutDescription,
+		/* defaultValue */ time.Duration(0),
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABMemoryLimit() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "MATLABMemoryLimit",
+		/* flagName */ "matlab-memory-limit",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_MEMORY_LIMIT",
+		/* descriptionKey */ messages.CLIMessages_MATLABMemoryLimitDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABQueueMaxDepth() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "MATLABQueueMaxDepth",
+		/* flagName */ "matlab-queue-max-depth",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_QUEUE_MAX_DEPTH",
+		/* descriptionKey */ messages.CLIMessages_MATLABQueueMaxDepthDescription,
+		/* defaultValue */ 16,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MATLABQueueWaitTimeout() *parameter.Parameter[time.Duration] {
+	return parameter.NewParameter(
+		/* id */ "MATLABQueueWaitTimeout",
+		/* flagName */ "matlab-queue-wait-timeout",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MATLAB_QUEUE_WAIT_TIMEOUT",
+		/* descriptionKey */ messages.CLIMessages_MATLABQueueWaitTimeoutDescription,
+		/* defaultValue */ 5*time.Minute,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func WorkspaceSnapshotMaxSize() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "WorkspaceSnapshotMaxSize",
+		/* flagName */ "workspace-snapshot-max-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"WORKSPACE_SNAPSHOT_MAX_SIZE",
+		/* descriptionKey */ messages.CLIMessages_WorkspaceSnapshotMaxSizeDescription,
+		/* defaultValue */ "1GB",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func WorkspaceSnapshotMaxTotalSize() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "WorkspaceSnapshotMaxTotalSize",
+		/* flagName */ "workspace-snapshot-max-total-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"WORKSPACE_SNAPSHOT_MAX_TOTAL_SIZE",
+		/* descriptionKey */ messages.CLIMessages_WorkspaceSnapshotMaxTotalSizeDescription,
+		/* defaultValue */ "4GB",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
 func PreferredMATLABStartingDirectory() *parameter.Parameter[string] {
 	return parameter.NewParameter(
 		/* id */ "PreferredMATLABStartingDirectory",
@@ -116,6 +363,58 @@ func DuplicateLogsToStderr() *parameter.Parameter[bool] {
 	)
 }
 
+func LogMaxSize() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "LogMaxSize",
+		/* flagName */ "log-max-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"LOG_MAX_SIZE",
+		/* descriptionKey */ messages.CLIMessages_LogMaxSizeDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func LogMaxAge() *parameter.Parameter[time.Duration] {
+	return parameter.NewParameter(
+		/* id */ "LogMaxAge",
+		/* flagName */ "log-max-age",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"LOG_MAX_AGE",
+		/* descriptionKey */ messages.CLIMessages_LogMaxAgeDescription,
+		/* defaultValue */ time.Duration(0),
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func LogMaxFiles() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "LogMaxFiles",
+		/* flagName */ "log-max-files",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"LOG_MAX_FILES",
+		/* descriptionKey */ messages.CLIMessages_LogMaxFilesDescription,
+		/* defaultValue */ 5,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func LogPerSessionFiles() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "LogPerSessionFiles",
+		/* flagName */ "log-per-session-files",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"LOG_PER_SESSION_FILES",
+		/* descriptionKey */ messages.CLIMessages_LogPerSessionFilesDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
 func InitializeMATLABOnStartup() *parameter.Parameter[bool] {
 	return parameter.NewParameter(
 		/* id */ "InitializeMATLABOnStartup",
@@ -310,3 +609,111 @@ func ExtensionFiles() *parameter.Parameter[[]string] {
 		/* piiSafe */ false,
 	)
 }
+
+func CodePolicyFile() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "CodePolicyFile",
+		/* flagName */ "code-policy-file",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"CODE_POLICY_FILE",
+		/* descriptionKey */ messages.CLIMessages_CodePolicyFileDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func ConfirmDestructiveTools() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "ConfirmDestructiveTools",
+		/* flagName */ "confirm-destructive-tools",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"CONFIRM_DESTRUCTIVE_TOOLS",
+		/* descriptionKey */ messages.CLIMessages_ConfirmDestructiveToolsDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func OpenMATLABProject() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "OpenMATLABProject",
+		/* flagName */ "open-matlab-project",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"OPEN_MATLAB_PROJECT",
+		/* descriptionKey */ messages.CLIMessages_OpenMATLABProjectDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func AuditLogFolder() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "AuditLogFolder",
+		/* flagName */ "audit-log-folder",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"AUDIT_LOG_FOLDER",
+		/* descriptionKey */ messages.CLIMessages_AuditLogFolderDescription,
+		/* defaultValue */ "",
+		/* recordToLog */ true,
+		/* piiSafe */ false,
+	)
+}
+
+func AuditLogMaxSize() *parameter.Parameter[string] {
+	return parameter.NewParameter(
+		/* id */ "AuditLogMaxSize",
+		/* flagName */ "audit-log-max-size",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"AUDIT_LOG_MAX_SIZE",
+		/* descriptionKey */ messages.CLIMessages_AuditLogMaxSizeDescription,
+		/* defaultValue */ "100MB",
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func AuditLogHashChain() *parameter.Parameter[bool] {
+	return parameter.NewParameter(
+		/* id */ "AuditLogHashChain",
+		/* flagName */ "audit-log-hash-chain",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"AUDIT_LOG_HASH_CHAIN",
+		/* descriptionKey */ messages.CLIMessages_AuditLogHashChainDescription,
+		/* defaultValue */ false,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+func MaxToolOutputBytes() *parameter.Parameter[int] {
+	return parameter.NewParameter(
+		/* id */ "MaxToolOutputBytes",
+		/* flagName */ "max-tool-output-bytes",
+		/* hiddenFlag */ false,
+		/* envVarName */ envVarNamePrefix+"MAX_TOOL_OUTPUT_BYTES",
+		/* descriptionKey */ messages.CLIMessages_MaxToolOutputBytesDescription,
+		/* defaultValue */ 100000,
+		/* recordToLog */ true,
+		/* piiSafe */ true,
+	)
+}
+
+// ProjectConfigFileParameters are the parameters that the configuration file of a project can set.
+// A project comes from the MCP client, so its file cannot set hidden parameters, or parameters that change
+// which code the server runs or how the server guards it, such as the MATLAB root or the code policy.
+func ProjectConfigFileParameters() []entities.Parameter {
+	return []entities.Parameter{
+		MATLABSessionPoolSize(),
+		MATLABIdleTimeout(),
+		MATLABMemoryLimit(),
+		MATLABQueueMaxDepth(),
+		MATLABQueueWaitTimeout(),
+		WorkspaceSnapshotMaxSize(),
+		WorkspaceSnapshotMaxTotalSize(),
+		MATLABDisplayMode(),
+		MaxToolOutputBytes(),
+	}
+}
@@ -38,9 +38,23 @@ func (s *Selector) DefaultParameters() []entities.Parameter {
 		defaultparameters.HelpMode(),
 		defaultparameters.VersionMode(),
 		defaultparameters.SetupMATLABMode(),
+		defaultparameters.DoctorMode(),
+		defaultparameters.DoctorFormat(),
+		defaultparameters.AnalyzeFolder(),
+		defaultparameters.AnalyzeFormat(),
+		defaultparameters.PrintConfigMode(),
+		defaultparameters.ConfigFile(),
 		defaultparameters.BaseDir(),
 		defaultparameters.LogLevel(),
 		defaultparameters.DuplicateLogsToStderr(),
+		defaultparameters.LogMaxSize(),
+		defaultparameters.LogMaxAge(),
+		defaultparameters.LogMaxFiles(),
+		defaultparameters.LogPerSessionFiles(),
+		defaultparameters.AuditLogFolder(),
+		defaultparameters.AuditLogMaxSize(),
+		defaultparameters.AuditLogHashChain(),
+		defaultparameters.MaxToolOutputBytes(),
 		defaultparameters.WatchdogMode(),
 		defaultparameters.ServerInstanceID(),
 		defaultparameters.DisableTelemetry(),
@@ -51,9 +65,22 @@ func (s *Selector) DefaultParameters() []entities.Parameter {
 
 	matlabParameters := []parameter.ParameterWithDescriptionFromMessageCatalog{
 		defaultparameters.PreferredLocalMATLABRoot(),
+		defaultparameters.PreferredMATLABRelease(),
+		defaultparameters.MATLABSearchFolders(),
+		defaultparameters.MATLABStartupFlags(),
+		defaultparameters.MATLABEnvironmentVariables(),
+		defaultparameters.MATLABStartupScript(),
+		defaultparameters.MATLABPath(),
 		defaultparameters.PreferredMATLABStartingDirectory(),
 		defaultparameters.UseSingleMATLABSession(),
 		defaultparameters.InitializeMATLABOnStartup(),
+		defaultparameters.MATLABSessionPoolSize(),
+		defaultparameters.MATLABIdleTimeout(),
+		defaultparameters.MATLABMemoryLimit(),
+		defaultparameters.MATLABQueueMaxDepth(),
+		defaultparameters.MATLABQueueWaitTimeout(),
+		defaultparameters.WorkspaceSnapshotMaxSize(),
+		defaultparameters.WorkspaceSnapshotMaxTotalSize(),
 		defaultparameters.MATLABDisplayMode(),
 		defaultparameters.MATLABSessionMode(),
 		defaultparameters.MATLABSessionConnectionDetails(),
@@ -61,6 +88,9 @@ func (s *Selector) DefaultParameters() []entities.Parameter {
 		defaultparameters.MATLABSessionDiscoveryTimeout(),
 		defaultparameters.EmbeddedConnectorDetailsTimeout(),
 		defaultparameters.ExtensionFiles(),
+		defaultparameters.CodePolicyFile(),
+		defaultparameters.ConfirmDestructiveTools(),
+		defaultparameters.OpenMATLABProject(),
 	}
 
 	matlabFeature := s.applicationDefinition.Features().MATLAB
@@ -34,6 +34,24 @@ func TestSelector_DefaultParameters_DescriptionsResolvedForVisibleParameters(t *
 		messages.CLIMessages_SetupMATLABDescription: {
 			description: "Install MATLAB Add-On description",
 		},
+		messages.CLIMessages_DoctorDescription: {
+			description: "Doctor description",
+		},
+		messages.CLIMessages_DoctorFormatDescription: {
+			description: "Doctor format description",
+		},
+		messages.CLIMessages_AnalyzeFolderDescription: {
+			description: "Analyze folder description",
+		},
+		messages.CLIMessages_AnalyzeFormatDescription: {
+			description: "Analyze format description",
+		},
+		messages.CLIMessages_PrintConfigDescription: {
+			description: "Print config description",
+		},
+		messages.CLIMessages_ConfigFileDescription: {
+			description: "Config file description",
+		},
 		messages.CLIMessages_DisableTelemetryDescription: {
 			description: "Disable telemetry description",
 		},
@@ -46,12 +64,51 @@ func TestSelector_DefaultParameters_DescriptionsResolvedForVisibleParameters(t *
 		messages.CLIMessages_PreferredLocalMATLABRootDescription: {
 			description: "MATLAB root description",
 		},
+		messages.CLIMessages_PreferredMATLABReleaseDescription: {
+			description: "MATLAB release description",
+		},
+		messages.CLIMessages_MATLABSearchFoldersDescription: {
+			description: "MATLAB search folders description",
+		},
+		messages.CLIMessages_MATLABStartupFlagsDescription: {
+			description: "MATLAB startup flags description",
+		},
+		messages.CLIMessages_MATLABEnvironmentVariablesDescription: {
+			description: "MATLAB environment variables description",
+		},
+		messages.CLIMessages_MATLABStartupScriptDescription: {
+			description: "MATLAB startup script description",
+		},
+		messages.CLIMessages_MATLABPathDescription: {
+			description: "MATLAB path description",
+		},
 		messages.CLIMessages_PreferredMATLABStartingDirectoryDescription: {
 			description: "MATLAB starting directory description",
 		},
 		messages.CLIMessages_InitializeMATLABOnStartupDescription: {
 			description: "Initialize MATLAB on startup description",
 		},
+		messages.CLIMessages_MATLABSessionPoolSizeDescription: {
+			description: "MATLAB session pool size description",
+		},
+		messages.CLIMessages_MATLABIdleTimeoutDescription: {
+			description: "MATLAB idle timeout description",
+		},
+		messages.CLIMessages_MATLABMemoryLimitDescription: {
+			description: "MATLAB memory limit description",
+		},
+		messages.CLIMessages_MATLABQueueMaxDepthDescription: {
+			description: "MATLAB queue max depth description",
+		},
+		messages.CLIMessages_MATLABQueueWaitTimeoutDescription: {
+			description: "MATLAB queue wait timeout description",
+		},
+		messages.CLIMessages_WorkspaceSnapshotMaxSizeDescription: {
+			description: "Workspace snapshot max size description",
+		},
+		messages.CLIMessages_WorkspaceSnapshotMaxTotalSizeDescription: {
+			description: "Workspace snapshot max total size description",
+		},
 		messages.CLIMessages_DisplayModeDescription: {
 			description: "Display mode description",
 		},
@@ -61,6 +118,39 @@ func TestSelector_DefaultParameters_DescriptionsResolvedForVisibleParameters(t *
 		messages.CLIMessages_ExtensionFileDescription: {
 			description: "Extension file description",
 		},
+		messages.CLIMessages_CodePolicyFileDescription: {
+			description: "Code policy file description",
+		},
+		messages.CLIMessages_ConfirmDestructiveToolsDescription: {
+			description: "Confirm destructive tools description",
+		},
+		messages.CLIMessages_OpenMATLABProjectDescription: {
+			description: "Open MATLAB project description",
+		},
+		messages.CLIMessages_LogMaxSizeDescription: {
+			description: "Log max size description",
+		},
+		messages.CLIMessages_LogMaxAgeDescription: {
+			description: "Log max age description",
+		},
+		messages.CLIMessages_LogMaxFilesDescription: {
+			description: "Log max files description",
+		},
+		messages.CLIMessages_LogPerSessionFilesDescription: {
+			description: "Log per session files description",
+		},
+		messages.CLIMessages_AuditLogFolderDescription: {
+			description: "Audit log folder description",
+		},
+		messages.CLIMessages_AuditLogMaxSizeDescription: {
+			description: "Audit log max size description",
+		},
+		messages.CLIMessages_AuditLogHashChainDescription: {
+			description: "Audit log hash chain description",
+		},
+		messages.CLIMessages_MaxToolOutputBytesDescription: {
+			description: "Max tool output bytes description",
+		},
 	}
 
 	mockAppDef.EXPECT().
@@ -122,7 +212,7 @@ func TestSelector_DefaultParameters_MATLABEnabled(t *testing.T) {
 	parameters := sut.DefaultParameters()
 
 	// Assert
-	assert.Len(t, parameters, 23)
+	assert.Len(t, parameters, 53)
 
 	for _, p := range parameters {
 		assert.True(t, p.GetActive(), "parameter %s should be active", p.GetID())
@@ -141,19 +231,46 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 		"HelpMode":                           true,
 		"VersionMode":                        true,
 		"SetupMATLABMode":                    true,
+		"DoctorMode":                         true,
+		"DoctorFormat":                       true,
+		"AnalyzeFolder":                      true,
+		"AnalyzeFormat":                      true,
+		"PrintConfigMode":                    true,
+		"ConfigFile":                         true,
 		"DisableTelemetry":                   true,
 		"BaseDir":                            true,
 		"LogLevel":                           true,
 		"DuplicateLogsToStderr":              true,
+		"LogMaxSize":                         true,
+		"LogMaxAge":                          true,
+		"LogMaxFiles":                        true,
+		"LogPerSessionFiles":                 true,
+		"AuditLogFolder":                     true,
+		"AuditLogMaxSize":                    true,
+		"AuditLogHashChain":                  true,
+		"MaxToolOutputBytes":                 true,
 		"WatchdogMode":                       true,
 		"ServerInstanceID":                   true,
 		"TelemetryCollectorEndpoint":         true,
 		"TelemetryCollectionInterval":        true,
 		"TelemetryCollectorEndpointInsecure": true,
 		"PreferredLocalMATLABRoot":           false,
+		"PreferredMATLABRelease":             false,
+		"MATLABSearchFolders":                false,
+		"MATLABStartupFlags":                 false,
+		"MATLABEnvironmentVariables":         false,
+		"MATLABStartupScript":                false,
+		"MATLABPath":                         false,
 		"PreferredMATLABStartingDirectory":   false,
 		"UseSingleMATLABSession":             false,
 		"InitializeMATLABOnStartup":          false,
+		"MATLABSessionPoolSize":              false,
+		"MATLABIdleTimeout":                  false,
+		"MATLABMemoryLimit":                  false,
+		"MATLABQueueMaxDepth":                false,
+		"MATLABQueueWaitTimeout":             false,
+		"WorkspaceSnapshotMaxSize":           false,
+		"WorkspaceSnapshotMaxTotalSize":      false,
 		"MATLABDisplayMode":                  false,
 		"MATLABSessionMode":                  false,
 		"MATLABSessionConnectionDetails":     false,
@@ -161,6 +278,9 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 		"MATLABSessionDiscoveryTimeout":      false,
 		"EmbeddedConnectorDetailsTimeout":    false,
 		"ExtensionFiles":                     false,
+		"CodePolicyFile":                     false,
+		"ConfirmDestructiveTools":            false,
+		"OpenMATLABProject":                  false,
 	}
 
 	mockAppDef.EXPECT().
@@ -178,7 +298,7 @@ func TestSelector_DefaultParameters_MATLABDisabled(t *testing.T) {
 	parameters := sut.DefaultParameters()
 
 	// Assert
-	assert.Len(t, parameters, 23)
+	assert.Len(t, parameters, 53)
 
 	for _, p := range parameters {
 		expectedState, exists := expectedActiveStateByParameterID[p.GetID()]
@@ -0,0 +1,111 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package parser
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser/configfile"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/spf13/pflag"
+)
+
+// configFileKey is the key of a parameter in a configuration file: its flag name, or its ID if it has no flag.
+func configFileKey(parameter entities.Parameter) string {
+	if flagName := parameter.GetFlagName(); flagName != "" {
+		return flagName
+	}
+	return parameter.GetID()
+}
+
+// configFilePath returns the path from the --config flag, or else from its environment variable.
+func (p *Parser) configFilePath(flagSet *pflag.FlagSet) string {
+	configFileID := defaultparameters.ConfigFile().GetID()
+
+	for _, parameter := range p.parameters {
+		if parameter.GetID() != configFileID || !parameter.GetActive() {
+			continue
+		}
+
+		if flagName := parameter.GetFlagName(); flagName != "" && flagSet.Changed(flagName) {
+			path, err := flagSet.GetString(flagName)
+			if err == nil {
+				return path
+			}
+		}
+
+		if envVarName := parameter.GetEnvVarName(); envVarName != "" {
+			if path, ok := p.osLayer.LookupEnv(envVarName); ok {
+				return path
+			}
+		}
+	}
+
+	return ""
+}
+
+// parseConfigFile sets the parameters of a configuration file. If allowedIDs is not nil, the file can only set the parameters
+// with these IDs, and the other keys are ignored.
+func (p *Parser) parseConfigFile(path string, source string, allowedIDs map[string]struct{}, resolved *resolvedArgs) messages.Error {
+	content, err := p.osLayer.ReadFile(path)
+	if err != nil {
+		return messages.New_StartupErrors_FailedToReadConfigFile_Error(path)
+	}
+
+	settings, err := configfile.Parse(path, content)
+	if errors.Is(err, configfile.ErrUnsupportedFormat) {
+		return messages.New_StartupErrors_UnsupportedConfigFileFormat_Error(path)
+	}
+	if err != nil {
+		return messages.New_StartupErrors_InvalidConfigFile_Error(path, err.Error())
+	}
+
+	configFileID := defaultparameters.ConfigFile().GetID()
+	for _, setting := range settings {
+		parameter, ok := p.keyToParameter[setting.Key]
+		if !ok || parameter.GetID() == configFileID {
+			return messages.New_StartupErrors_UnknownConfigFileKey_Error(setting.Path, path)
+		}
+
+		// A configuration file can be shared between servers with different features, so inactive parameters are ignored
+		if !parameter.GetActive() {
+			continue
+		}
+
+		if _, allowed := allowedIDs[parameter.GetID()]; allowedIDs != nil && !allowed {
+			resolved.ignoredKeys = append(resolved.ignoredKeys, setting.Path)
+			continue
+		}
+
+		val, err := configFileValue(parameter.GetDefaultValue(), setting.Value)
+		if errors.Is(err, errUnimplementedParameterType) {
+			// If you hit this error, it means parseValue is not implementing a supported type in `pkg/config`
+			return messages.New_StartupErrors_ParseFailed_Error("\n", internalErrorText)
+		}
+		if err != nil {
+			return messages.New_StartupErrors_BadValueForConfigFileKey_Error(path, formatValue(setting.Value.Items, setting.Value.List), setting.Path)
+		}
+
+		resolved.set(parameter, val, source)
+	}
+
+	return nil
+}
+
+var errListForSingleValue = errors.New("list for a parameter with a single value")
+
+// configFileValue converts the value of a setting like an environment variable, except that a list parameter takes all the items of a list.
+func configFileValue(defaultValue any, value configfile.Value) (any, error) {
+	if _, isList := defaultValue.([]string); isList {
+		return slices.Clone(value.Items), nil
+	}
+
+	if value.List {
+		return nil, errListForSingleValue
+	}
+
+	return parseValue(defaultValue, value.Items[0])
+}
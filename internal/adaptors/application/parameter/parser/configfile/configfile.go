@@ -0,0 +1,81 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package configfile reads the settings of a YAML or TOML configuration file.
+// A setting is a key with a string, number, Boolean, or list of these as value. The keys of nested mappings and tables
+// are joined with a hyphen, so that "matlab: {root: ...}" in YAML and "[matlab] root = ..." in TOML both set matlab-root.
+package configfile
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported configuration file format, use a .yaml, .yml, or .toml file")
+
+// keySeparator joins the keys of nested mappings and tables into the key of a setting.
+const keySeparator = "-"
+
+// SettingError reports a setting that the package cannot turn into a value.
+type SettingError struct {
+	Path   string
+	Reason string
+}
+
+func (e *SettingError) Error() string {
+	return fmt.Sprintf("setting %q: %s", e.Path, e.Reason)
+}
+
+// Value is the value of a setting, as text. Each setting is either a single item or a list of items.
+type Value struct {
+	Items []string
+	List  bool
+}
+
+// Setting is a key of a configuration file and its value, in the order of the file.
+// Path is the key as it is written in the file, with the keys of nested mappings and tables joined with a dot.
+type Setting struct {
+	Key   string
+	Path  string
+	Value Value
+}
+
+// Parse reads the settings of a configuration file. The extension of the file name selects the format.
+func Parse(name string, content []byte) ([]Setting, error) {
+	var settings []Setting
+	var err error
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		settings, err = parseYAML(content)
+	case ".toml":
+		settings, err = parseTOML(content)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]string{}
+	for _, setting := range settings {
+		if path, ok := seen[setting.Key]; ok {
+			if path == setting.Path {
+				return nil, &SettingError{Path: setting.Path, Reason: "is set twice"}
+			}
+			return nil, &SettingError{Path: setting.Path, Reason: fmt.Sprintf("sets %q, which %q already sets", setting.Key, path)}
+		}
+		seen[setting.Key] = setting.Path
+	}
+
+	return settings, nil
+}
+
+func newSetting(path []string, value Value) Setting {
+	return Setting{
+		Key:   strings.Join(path, keySeparator),
+		Path:  strings.Join(path, "."),
+		Value: value,
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package configfile_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser/configfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_YAML_HappyPath(t *testing.T) {
+	// Arrange
+	content := "\ufeff# MATLAB MCP server settings\n" +
+		"---\n" +
+		"matlab-root: /usr/local/MATLAB/R2025b  # installed release\n" +
+		"initial-working-folder: \"C:\\\\work\\\\project\"\n" +
+		"log-level: 'debug'\n" +
+		"disable-telemetry: true\n" +
+		"matlab-session-mode: existing#mode\n" +
+		"\n" +
+		"extension-file:\n" +
+		"  - tools/custom.json\n" +
+		"  - 'tools/it''s.json'\n" +
+		"matlab-display-mode: [desktop]\n" +
+		"empty-list: []\n" +
+		"startup-code: >-\n" +
+		"  disp('a');\n" +
+		"  disp('b')\n" +
+		"...\n"
+
+	// Act
+	settings, err := configfile.Parse("config.yaml", []byte(content))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []configfile.Setting{
+		{Key: "matlab-root", Path: "matlab-root", Value: configfile.Value{Items: []string{"/usr/local/MATLAB/R2025b"}}},
+		{Key: "initial-working-folder", Path: "initial-working-folder", Value: configfile.Value{Items: []string{`C:\work\project`}}},
+		{Key: "log-level", Path: "log-level", Value: configfile.Value{Items: []string{"debug"}}},
+		{Key: "disable-telemetry", Path: "disable-telemetry", Value: configfile.Value{Items: []string{"true"}}},
+		{Key: "matlab-session-mode", Path: "matlab-session-mode", Value: configfile.Value{Items: []string{"existing#mode"}}},
+		{Key: "extension-file", Path: "extension-file", Value: configfile.Value{Items: []string{"tools/custom.json", "tools/it's.json"}, List: true}},
+		{Key: "matlab-display-mode", Path: "matlab-display-mode", Value: configfile.Value{Items: []string{"desktop"}, List: true}},
+		{Key: "empty-list", Path: "empty-list", Value: configfile.Value{Items: []string{}, List: true}},
+		{Key: "startup-code", Path: "startup-code", Value: configfile.Value{Items: []string{"disp('a'); disp('b')"}}},
+	}, settings)
+}
+
+func TestParse_YAML_NestedMappings(t *testing.T) {
+	// Arrange
+	content := "defaults: &defaults\n" +
+		"  level: debug\n" +
+		"matlab:\n" +
+		"  root: /usr/local/MATLAB/R2025b\n" +
+		"  session:\n" +
+		"    mode: new\n" +
+		"log:\n" +
+		"  <<: *defaults\n" +
+		"  max-files: 3\n"
+
+	// Act
+	settings, err := configfile.Parse("config.yml", []byte(content))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []configfile.Setting{
+		{Key: "defaults-level", Path: "defaults.level", Value: configfile.Value{Items: []string{"debug"}}},
+		{Key: "matlab-root", Path: "matlab.root", Value: configfile.Value{Items: []string{"/usr/local/MATLAB/R2025b"}}},
+		{Key: "matlab-session-mode", Path: "matlab.session.mode", Value: configfile.Value{Items: []string{"new"}}},
+		{Key: "log-level", Path: "log.level", Value: configfile.Value{Items: []string{"debug"}}},
+		{Key: "log-max-files", Path: "log.max-files", Value: configfile.Value{Items: []string{"3"}}},
+	}, settings)
+}
+
+func TestParse_YAML_Empty(t *testing.T) {
+	for _, content := range []string{"", "# No settings\n", "---\n"} {
+		// Act
+		settings, err := configfile.Parse("config.yaml", []byte(content))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, settings)
+	}
+}
+
+func TestParse_TOML_HappyPath(t *testing.T) {
+	// Arrange
+	content := "# MATLAB MCP server settings\r\n" +
+		"matlab-root = \"/usr/local/MATLAB/R2025b\" # installed release\r\n" +
+		"'initial-working-folder' = 'C:\\work\\project'\r\n" +
+		"disable-telemetry = true\r\n" +
+		"log-max-files = 3\r\n" +
+		"extension-file = [ # custom tools\r\n" +
+		"  \"tools/custom.json\", # first\r\n" +
+		"  \"tools/#second.json\",\r\n" +
+		"]\r\n" +
+		"startup-code = \"\"\"\r\n" +
+		"disp('a')\"\"\"\r\n" +
+		"log.level = \"debug\"\r\n" +
+		"\r\n" +
+		"[matlab]\r\n" +
+		"session-mode = \"new\"\r\n" +
+		"display = { mode = \"desktop\" }\r\n"
+
+	// Act
+	settings, err := configfile.Parse("config.TOML", []byte(content))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []configfile.Setting{
+		{Key: "matlab-root", Path: "matlab-root", Value: configfile.Value{Items: []string{"/usr/local/MATLAB/R2025b"}}},
+		{Key: "initial-working-folder", Path: "initial-working-folder", Value: configfile.Value{Items: []string{`C:\work\project`}}},
+		{Key: "disable-telemetry", Path: "disable-telemetry", Value: configfile.Value{Items: []string{"true"}}},
+		{Key: "log-max-files", Path: "log-max-files", Value: configfile.Value{Items: []string{"3"}}},
+		{Key: "extension-file", Path: "extension-file", Value: configfile.Value{Items: []string{"tools/custom.json", "tools/#second.json"}, List: true}},
+		{Key: "startup-code", Path: "startup-code", Value: configfile.Value{Items: []string{"disp('a')"}}},
+		{Key: "log-level", Path: "log.level", Value: configfile.Value{Items: []string{"debug"}}},
+		{Key: "matlab-session-mode", Path: "matlab.session-mode", Value: configfile.Value{Items: []string{"new"}}},
+		{Key: "matlab-display-mode", Path: "matlab.display.mode", Value: configfile.Value{Items: []string{"desktop"}}},
+	}, settings)
+}
+
+func TestParse_UnsupportedFormat(t *testing.T) {
+	// Arrange
+	content := []byte(`{"matlab-root": "/usr/local/MATLAB/R2025b"}`)
+
+	// Act
+	settings, err := configfile.Parse("config.json", content)
+
+	// Assert
+	require.ErrorIs(t, err, configfile.ErrUnsupportedFormat)
+	assert.Nil(t, settings)
+}
+
+func TestParse_SyntaxErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{name: "YAML missing colon", fileName: "config.yaml", content: "matlab-root: /MATLAB\nlog-level\n"},
+		{name: "YAML unterminated list", fileName: "config.yaml", content: "extension-file: [a,\n"},
+		{name: "YAML unterminated quote", fileName: "config.yaml", content: "matlab-root: \"/MATLAB\n"},
+		{name: "YAML top-level list", fileName: "config.yaml", content: "- /MATLAB\n"},
+		{name: "TOML missing equal", fileName: "config.toml", content: "matlab-root \"/MATLAB\"\n"},
+		{name: "TOML unterminated array", fileName: "config.toml", content: "log-level = \"info\"\nextension-file = [\n  \"a\",\n"},
+		{name: "TOML invalid escape", fileName: "config.toml", content: "matlab-root = \"C:\\MATLAB\"\n"},
+		{name: "TOML duplicate key", fileName: "config.toml", content: "log-level = \"info\"\nlog-level = \"debug\"\n"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			settings, err := configfile.Parse(testCase.fileName, []byte(testCase.content))
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "line ")
+			assert.Nil(t, settings)
+		})
+	}
+}
+
+func TestParse_SettingErrors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		fileName     string
+		content      string
+		expectedPath string
+	}{
+		{name: "YAML duplicate key", fileName: "config.yaml", content: "log-level: info\nlog-level: debug\n", expectedPath: "log-level"},
+		{name: "YAML missing value", fileName: "config.yaml", content: "matlab:\n  root:\n", expectedPath: "matlab.root"},
+		{name: "YAML nested list", fileName: "config.yml", content: "extension-file:\n  - [a, b]\n", expectedPath: "extension-file"},
+		{name: "YAML same setting twice", fileName: "config.yaml", content: "matlab-root: /a\nmatlab:\n  root: /b\n", expectedPath: "matlab.root"},
+		{name: "TOML array of tables", fileName: "config.toml", content: "[[matlab]]\nroot = \"/MATLAB\"\n", expectedPath: "matlab"},
+		{name: "TOML date", fileName: "config.toml", content: "log-max-age = 2026-01-01\n", expectedPath: "log-max-age"},
+		{name: "TOML array of arrays", fileName: "config.toml", content: "extension-file = [[\"a\"]]\n", expectedPath: "extension-file"},
+		{name: "TOML same setting twice", fileName: "config.toml", content: "matlab-root = \"/a\"\nmatlab.root = \"/b\"\n", expectedPath: "matlab.root"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			settings, err := configfile.Parse(testCase.fileName, []byte(testCase.content))
+
+			// Assert
+			var settingErr *configfile.SettingError
+			require.ErrorAs(t, err, &settingErr)
+			assert.Equal(t, testCase.expectedPath, settingErr.Path)
+			assert.NotEmpty(t, settingErr.Reason)
+			assert.Nil(t, settings)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package configfile
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/BurntSushi/toml"
+)
+
+// parseTOML reads the settings of a TOML file, in the order that the file defines them.
+func parseTOML(content []byte) ([]Setting, error) {
+	var document map[string]any
+	metadata, err := toml.Decode(string(content), &document)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := []Setting{}
+	for _, key := range metadata.Keys() {
+		value, err := tomlValue(lookupTOML(document, key))
+		if errors.Is(err, errTOMLTable) {
+			// The keys of a table come after the table
+			continue
+		}
+		if err != nil {
+			return nil, &SettingError{Path: key.String(), Reason: err.Error()}
+		}
+		settings = append(settings, newSetting(key, value))
+	}
+
+	return settings, nil
+}
+
+var errTOMLTable = errors.New("table")
+
+// lookupTOML returns the value of a key that metadata lists. Tables always come before their keys, so each parent is a table.
+func lookupTOML(document map[string]any, key toml.Key) any {
+	var value any = document
+	for _, part := range key {
+		table, _ := value.(map[string]any)
+		value = table[part]
+	}
+	return value
+}
+
+// tomlValue converts a value or an array of values.
+func tomlValue(value any) (Value, error) {
+	switch value := value.(type) {
+	case map[string]any:
+		return Value{}, errTOMLTable
+	case []map[string]any:
+		return Value{}, errors.New("arrays of tables are not supported")
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			text, err := tomlScalar(item)
+			if err != nil {
+				return Value{}, errors.New("each array item must be a string, number, or Boolean")
+			}
+			items = append(items, text)
+		}
+		return Value{Items: items, List: true}, nil
+	}
+
+	text, err := tomlScalar(value)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{Items: []string{text}}, nil
+}
+
+func tomlScalar(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64), nil
+	default:
+		return "", fmt.Errorf("values of type %T are not supported, use a string, number, or Boolean", value)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package configfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	yamlNullTag  = "!!null"
+	yamlMergeKey = "<<"
+)
+
+// parseYAML reads the settings of the first document of a YAML file.
+func parseYAML(content []byte) ([]Setting, error) {
+	var document yaml.Node
+	if err := yaml.NewDecoder(bytes.NewReader(content)).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return []Setting{}, nil
+		}
+		return nil, err
+	}
+
+	root := resolveYAMLAlias(document.Content[0])
+	if root.Kind == yaml.ScalarNode && root.Tag == yamlNullTag {
+		return []Setting{}, nil
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: the file must be a mapping of settings", root.Line)
+	}
+
+	settings := []Setting{}
+	if err := appendYAMLMapping(&settings, nil, root); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// appendYAMLMapping appends the settings of a mapping, whose keys are below path.
+func appendYAMLMapping(settings *[]Setting, path []string, mapping *yaml.Node) error {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		keyNode := resolveYAMLAlias(mapping.Content[i])
+		valueNode := resolveYAMLAlias(mapping.Content[i+1])
+
+		if keyNode.Kind != yaml.ScalarNode || keyNode.Value == "" {
+			return &SettingError{Path: strings.Join(path, "."), Reason: "each key must be a non-empty string"}
+		}
+
+		if keyNode.Value == yamlMergeKey && valueNode.Kind == yaml.MappingNode {
+			if err := appendYAMLMapping(settings, path, valueNode); err != nil {
+				return err
+			}
+			continue
+		}
+
+		keyPath := append(slices.Clone(path), keyNode.Value)
+
+		if valueNode.Kind == yaml.MappingNode {
+			if err := appendYAMLMapping(settings, keyPath, valueNode); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := yamlValue(valueNode)
+		if err != nil {
+			return &SettingError{Path: strings.Join(keyPath, "."), Reason: err.Error()}
+		}
+		*settings = append(*settings, newSetting(keyPath, value))
+	}
+
+	return nil
+}
+
+// yamlValue converts a scalar or a sequence of scalars.
+func yamlValue(node *yaml.Node) (Value, error) {
+	if node.Kind == yaml.ScalarNode {
+		if node.Tag == yamlNullTag {
+			return Value{}, errors.New("missing value")
+		}
+		return Value{Items: []string{node.Value}}, nil
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		return Value{}, errors.New("expected a value or a list of values")
+	}
+
+	items := make([]string, 0, len(node.Content))
+	for _, itemNode := range node.Content {
+		itemNode = resolveYAMLAlias(itemNode)
+		if itemNode.Kind != yaml.ScalarNode || itemNode.Tag == yamlNullTag {
+			return Value{}, errors.New("each list item must be a value")
+		}
+		items = append(items, itemNode.Value)
+	}
+
+	return Value{Items: items, List: true}, nil
+}
+
+func resolveYAMLAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
@@ -0,0 +1,476 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package parser_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	parsermocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/parameter/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configFileEnvVar = "MW_MCP_SERVER_CONFIG"
+
+func TestParser_Parse_ConfigFileFromFlag(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	configFile := "matlab-mcp-server.yaml"
+
+	stringParam := newMockParam(t, "string-param", "string-flag", "", "default", "String", false, true)
+	listParam := newMockParam(t, "list-param", "list-flag", "", []string{}, "List", false, true)
+	boolParam := newMockParam(t, "bool-param", "bool-flag", "", false, "Bool", false, true)
+	intParam := newMockParam(t, "int-param", "int-flag", "", 1, "Int", false, true)
+	durationParam := newMockParam(t, "duration-param", "duration-flag", "", time.Second, "Duration", false, true)
+	noFlagParam := newMockParam(t, "NoFlagParam", "", "", "default", "", false, true)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultparameters.ConfigFile(), stringParam, listParam, boolParam, intParam, durationParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{noFlagParam}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(configFileEnvVar).
+		Return("", false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFile).
+		Return([]byte("string-flag: from file\n"+
+			"list-flag:\n"+
+			"  - first\n"+
+			"  - second\n"+
+			"bool-flag: true\n"+
+			"int-flag: 3\n"+
+			"duration-flag: 2m\n"+
+			"NoFlagParam: without flag\n"), nil).
+		Once()
+
+	args := []string{"--config", configFile}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	_, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, configFile, result["ConfigFile"])
+	assert.Equal(t, "from file", result["string-param"])
+	assert.Equal(t, []string{"first", "second"}, result["list-param"])
+	assert.Equal(t, true, result["bool-param"])
+	assert.Equal(t, 3, result["int-param"])
+	assert.Equal(t, 2*time.Minute, result["duration-param"])
+	assert.Equal(t, "without flag", result["NoFlagParam"])
+	assert.Equal(t, []string{"ConfigFile", "NoFlagParam", "bool-param", "duration-param", "int-param", "list-param", "string-param"}, specifiedParameters)
+}
+
+func TestParser_Parse_ConfigFileWithTOMLTables(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	configFile := "matlab-mcp-server.toml"
+
+	stringParam := newMockParam(t, "string-param", "matlab-root", "", "default", "String", false, true)
+	listParam := newMockParam(t, "list-param", "matlab-startup-flags", "", []string{}, "List", false, true)
+	durationParam := newMockParam(t, "duration-param", "log-max-age", "", time.Second, "Duration", false, true)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultparameters.ConfigFile(), stringParam, listParam, durationParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(configFileEnvVar).
+		Return("", false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFile).
+		Return([]byte("log.max-age = \"2h\"\n"+
+			"\n"+
+			"[matlab]\n"+
+			"root = '/usr/local/MATLAB/R2025b'\n"+
+			"startup-flags = [\n"+
+			"  \"-nosplash\",\n"+
+			"  \"-nodesktop\",\n"+
+			"]\n"), nil).
+		Once()
+
+	args := []string{"--config", configFile}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	_, result, _, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/local/MATLAB/R2025b", result["string-param"])
+	assert.Equal(t, []string{"-nosplash", "-nodesktop"}, result["list-param"])
+	assert.Equal(t, 2*time.Hour, result["duration-param"])
+}
+
+func TestParser_Parse_ConfigFilePrecedence(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	configFile := "matlab-mcp-server.toml"
+	projectConfigFile := ".matlab-mcp-server.yaml"
+
+	projectParam := defaultparameters.MATLABDisplayMode()
+	projectAndEnvParam := defaultparameters.MaxToolOutputBytes()
+	fileParam := newMockParam(t, "file-param", "file-flag", "", "default", "File", false, true)
+	envParam := newMockParam(t, "env-param", "env-flag", "ENV_PARAM", "default", "Env", false, true)
+	flagParam := newMockParam(t, "flag-param", "flag-flag", "", []string{}, "Flag", false, true)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultparameters.ConfigFile(), projectParam, projectAndEnvParam, fileParam, envParam, flagParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(configFileEnvVar).
+		Return(configFile, true).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(projectParam.GetEnvVarName()).
+		Return("", false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(projectAndEnvParam.GetEnvVarName()).
+		Return("2000", true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv("ENV_PARAM").
+		Return("from env", true).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFile).
+		Return([]byte(`file-flag = "from file"
+matlab-display-mode = "desktop"
+env-flag = "from file"
+flag-flag = ["from file"]
+`), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(projectConfigFile).
+		Return([]byte("matlab-display-mode: nodesktop\nmax-tool-output-bytes: 1000\n"), nil).
+		Once()
+
+	args := []string{"--flag-flag", "from flag"}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	p.SetProjectConfigFile(projectConfigFile)
+	_, result, _, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "from file", result["file-param"])
+	assert.Equal(t, "nodesktop", result[projectParam.GetID()])
+	assert.Equal(t, 2000, result[projectAndEnvParam.GetID()])
+	assert.Equal(t, "from env", result["env-param"])
+	assert.Equal(t, []string{"from file", "from flag"}, result["flag-param"], "Flags should append to list values from the file, as they do for environment variables")
+	assert.Equal(t, []entities.ConfigFileSetting{
+		{Key: "matlab-display-mode", Value: `"nodesktop"`},
+	}, p.ProjectConfigFileSettings(), "Only the values that the project configuration file sets in the end should be reported")
+}
+
+func TestParser_Parse_ProjectConfigFileIgnoresKeysItCannotSet(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	projectConfigFile := ".matlab-mcp-server.toml"
+
+	allowedParam := defaultparameters.MATLABIdleTimeout()
+	securityParam := defaultparameters.CodePolicyFile()
+	hiddenParam := defaultparameters.MATLABSessionConnectionTimeout()
+	customParam := newMockParam(t, "custom-param", "custom-flag", "", "default", "Custom", false, true)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultparameters.ConfigFile(), allowedParam, securityParam, hiddenParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{customParam}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(configFileEnvVar).
+		Return("", false).
+		Twice()
+
+	for _, envVarName := range []string{allowedParam.GetEnvVarName(), securityParam.GetEnvVarName(), hiddenParam.GetEnvVarName()} {
+		mockOSLayer.EXPECT().
+			LookupEnv(envVarName).
+			Return("", false).
+			Once()
+	}
+
+	mockOSLayer.EXPECT().
+		ReadFile(projectConfigFile).
+		Return([]byte(`matlab-idle-timeout = "5m"
+code-policy-file = "allow-everything.json"
+matlab-session-connection-timeout = "1h"
+custom-flag = "from project"
+`), nil).
+		Once()
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	p.SetProjectConfigFile(projectConfigFile)
+	_, result, specifiedParameters, err := p.Parse([]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, result[allowedParam.GetID()])
+	assert.Equal(t, securityParam.GetDefaultValue(), result[securityParam.GetID()])
+	assert.Equal(t, hiddenParam.GetDefaultValue(), result[hiddenParam.GetID()])
+	assert.Equal(t, "default", result["custom-param"])
+	assert.Equal(t, []string{allowedParam.GetID()}, specifiedParameters)
+	assert.Equal(t, []entities.ConfigFileSetting{
+		{Key: "matlab-idle-timeout", Value: "5m0s"},
+		{Key: "code-policy-file", Ignored: true},
+		{Key: "matlab-session-connection-timeout", Ignored: true},
+		{Key: "custom-flag", Ignored: true},
+	}, p.ProjectConfigFileSettings())
+}
+
+func TestParser_Parse_ConfigFileSkipsInactiveParameters(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	configFile := "matlab-mcp-server.yaml"
+	inactiveParam := newMockParam(t, "inactive-param", "inactive-flag", "", "default", "Inactive", false, false)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultparameters.ConfigFile(), inactiveParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv(configFileEnvVar).
+		Return("", false).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFile).
+		Return([]byte("inactive-flag: from file\n"), nil).
+		Once()
+
+	args := []string{"--config=" + configFile}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	_, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "default", result["inactive-param"])
+	assert.Equal(t, []string{"ConfigFile"}, specifiedParameters)
+}
+
+func TestParser_Parse_ConfigFileErrors(t *testing.T) {
+	const configFile = "matlab-mcp-server.yaml"
+
+	testCases := []struct {
+		name          string
+		configFile    string
+		content       []byte
+		readErr       error
+		expectedError messages.Error
+	}{
+		{
+			name:          "read error",
+			configFile:    configFile,
+			readErr:       assert.AnError,
+			expectedError: messages.New_StartupErrors_FailedToReadConfigFile_Error(configFile),
+		},
+		{
+			name:          "unsupported format",
+			configFile:    "matlab-mcp-server.json",
+			content:       []byte("{}"),
+			expectedError: messages.New_StartupErrors_UnsupportedConfigFileFormat_Error("matlab-mcp-server.json"),
+		},
+		{
+			name:          "syntax error",
+			configFile:    configFile,
+			content:       []byte("test-flag = value\n"),
+			expectedError: messages.New_StartupErrors_InvalidConfigFile_Error(configFile, "line 1: the file must be a mapping of settings"),
+		},
+		{
+			name:          "unknown key",
+			configFile:    configFile,
+			content:       []byte("# Settings\ntest-flag: 1\nunknown:\n  flag: value\n"),
+			expectedError: messages.New_StartupErrors_UnknownConfigFileKey_Error("unknown.flag", configFile),
+		},
+		{
+			name:          "config file in config file",
+			configFile:    configFile,
+			content:       []byte("config: other.yaml\n"),
+			expectedError: messages.New_StartupErrors_UnknownConfigFileKey_Error("config", configFile),
+		},
+		{
+			name:          "bad value",
+			configFile:    configFile,
+			content:       []byte("test-flag: many\n"),
+			expectedError: messages.New_StartupErrors_BadValueForConfigFileKey_Error(configFile, `"many"`, "test-flag"),
+		},
+		{
+			name:          "list for single value",
+			configFile:    configFile,
+			content:       []byte("test-flag: [1, 2]\n"),
+			expectedError: messages.New_StartupErrors_BadValueForConfigFileKey_Error(configFile, `["1", "2"]`, "test-flag"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &parsermocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+			defer mockDefaultParamFactory.AssertExpectations(t)
+
+			mockParamFactory := &parsermocks.MockParameterFactory{}
+			defer mockParamFactory.AssertExpectations(t)
+
+			intParam := newMockParam(t, "test-param", "test-flag", "", 0, "Int", false, true)
+
+			mockDefaultParamFactory.EXPECT().
+				DefaultParameters().
+				Return([]entities.Parameter{defaultparameters.ConfigFile(), intParam}).
+				Once()
+
+			mockParamFactory.EXPECT().
+				Parameters().
+				Return([]entities.Parameter{}).
+				Once()
+
+			mockOSLayer.EXPECT().
+				LookupEnv(configFileEnvVar).
+				Return(testCase.configFile, true).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(testCase.configFile).
+				Return(testCase.content, testCase.readErr).
+				Once()
+
+			// Act
+			p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+			parameters, result, specifiedParameters, err := p.Parse([]string{})
+
+			// Assert
+			require.Equal(t, testCase.expectedError, err)
+			assert.Nil(t, parameters)
+			assert.Nil(t, result)
+			assert.Nil(t, specifiedParameters)
+		})
+	}
+}
+
+func TestParser_Parse_RepeatedParseDoesNotDuplicateListFlags(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	listParam := newMockParam(t, "list-param", "list-flag", "", []string{}, "List", false, true)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{listParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	args := []string{"--list-flag", "first", "--list-flag", "second"}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	_, firstResult, _, firstErr := p.Parse(args)
+	_, secondResult, _, secondErr := p.Parse(args)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, []string{"first", "second"}, firstResult["list-param"])
+	assert.Equal(t, []string{"first", "second"}, secondResult["list-param"])
+}
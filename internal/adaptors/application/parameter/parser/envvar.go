@@ -3,6 +3,7 @@
 package parser
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
@@ -11,7 +12,9 @@ import (
 
 const internalErrorText = "Unimplemented parameter type"
 
-func (p *Parser) parseEnvVars(specifiedArgs map[string]any, specifiedParameters map[string]struct{}) messages.Error {
+var errUnimplementedParameterType = errors.New("unimplemented parameter type")
+
+func (p *Parser) parseEnvVars(resolved *resolvedArgs) messages.Error {
 	for _, parameter := range p.parameters {
 		if !parameter.GetActive() {
 			continue
@@ -27,32 +30,34 @@ func (p *Parser) parseEnvVars(specifiedArgs map[string]any, specifiedParameters
 			continue
 		}
 
-		var parsedVal any
-
-		switch parameter.GetDefaultValue().(type) {
-		case bool:
-			boolVal, err := strconv.ParseBool(val)
-			if err != nil {
-				return messages.New_StartupErrors_BadValueForEnvVar_Error(val, envVarName)
-			}
-			parsedVal = boolVal
-		case string:
-			parsedVal = val
-		case []string:
-			parsedVal = []string{val}
-		case time.Duration:
-			durationVal, err := time.ParseDuration(val)
-			if err != nil {
-				return messages.New_StartupErrors_BadValueForEnvVar_Error(val, envVarName)
-			}
-			parsedVal = durationVal
-		default:
-			// If you hit this error, it means this switch is not implementing a supported type in `pkg/config`
+		parsedVal, err := parseValue(parameter.GetDefaultValue(), val)
+		if errors.Is(err, errUnimplementedParameterType) {
+			// If you hit this error, it means parseValue is not implementing a supported type in `pkg/config`
 			return messages.New_StartupErrors_ParseFailed_Error("\n", internalErrorText)
 		}
+		if err != nil {
+			return messages.New_StartupErrors_BadValueForEnvVar_Error(val, envVarName)
+		}
 
-		specifiedArgs[parameter.GetID()] = parsedVal
-		specifiedParameters[parameter.GetID()] = struct{}{}
+		resolved.set(parameter, parsedVal, "environment variable "+envVarName)
 	}
 	return nil
 }
+
+// parseValue converts text to the type of the default value of a parameter. A list parameter gets a list of one item.
+func parseValue(defaultValue any, val string) (any, error) {
+	switch defaultValue.(type) {
+	case bool:
+		return strconv.ParseBool(val)
+	case string:
+		return val, nil
+	case []string:
+		return []string{val}, nil
+	case int:
+		return strconv.Atoi(val)
+	case time.Duration:
+		return time.ParseDuration(val)
+	default:
+		return nil, errUnimplementedParameterType
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/spf13/pflag"
@@ -23,6 +24,7 @@ type ParameterFactory interface {
 
 type OSLayer interface {
 	LookupEnv(key string) (string, bool)
+	ReadFile(name string) ([]byte, error)
 }
 
 type Parser struct {
@@ -30,13 +32,19 @@ type Parser struct {
 	defaultParameterFactory DefaultParameterFactory
 	parameterFactory        ParameterFactory
 
-	flagSet         *pflag.FlagSet
 	parameters      []entities.Parameter
 	flagToParameter map[string]entities.Parameter
+	keyToParameter  map[string]entities.Parameter
+
+	projectConfigFileIDs map[string]struct{}
 
 	once      sync.Once
 	usageText string
 	initErr   messages.Error
+
+	lock              sync.Mutex
+	projectConfigFile string
+	lastResolved      *resolvedArgs
 }
 
 func New(
@@ -59,33 +67,62 @@ func (p *Parser) Usage() (string, messages.Error) {
 	return p.usageText, nil
 }
 
+// SetProjectConfigFile sets the configuration file of the project, which overrides the configuration file
+// from --config for the next calls to Parse. An empty path removes the project configuration file.
+func (p *Parser) SetProjectConfigFile(path string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.projectConfigFile = path
+}
+
+// Parse resolves the value of each parameter. From lowest to highest precedence, the value comes from the default,
+// the configuration file, the project configuration file, the environment variable, or the flag.
 func (p *Parser) Parse(args []string) ([]entities.Parameter, map[string]any, []string, messages.Error) {
 	if err := p.initialize(); err != nil {
 		return nil, nil, nil, err
 	}
 
-	specifiedArgs := make(map[string]any)
-	for _, param := range p.parameters {
-		specifiedArgs[param.GetID()] = param.GetDefaultValue()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	resolved := newResolvedArgs(p.parameters)
+
+	flagSet := p.newFlagSet()
+	if err := flagSet.Parse(args); err != nil {
+		return nil, nil, nil, p.convertToUserFacingError(err)
 	}
 
-	specifiedParameters := map[string]struct{}{}
+	if configFile := p.configFilePath(flagSet); configFile != "" {
+		if err := p.parseConfigFile(configFile, "config file "+configFile, nil, resolved); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if p.projectConfigFile != "" {
+		resolved.projectSource = "project config file " + p.projectConfigFile
+		if err := p.parseConfigFile(p.projectConfigFile, resolved.projectSource, p.projectConfigFileIDs, resolved); err != nil {
+			return nil, nil, nil, err
+		}
+	}
 
-	if err := p.parseEnvVars(specifiedArgs, specifiedParameters); err != nil {
+	if err := p.parseEnvVars(resolved); err != nil {
 		return nil, nil, nil, err
 	}
 
-	if err := p.parseFlags(args, specifiedArgs, specifiedParameters); err != nil {
+	if err := p.parseFlags(flagSet, resolved); err != nil {
 		return nil, nil, nil, err
 	}
 
-	specifiedIDs := make([]string, 0, len(specifiedParameters))
-	for id := range specifiedParameters {
+	p.lastResolved = resolved
+
+	specifiedIDs := make([]string, 0, len(resolved.specified))
+	for id := range resolved.specified {
 		specifiedIDs = append(specifiedIDs, id)
 	}
 	sort.Strings(specifiedIDs)
 
-	return p.parameters, specifiedArgs, specifiedIDs, nil
+	return p.parameters, resolved.values, specifiedIDs, nil
 }
 
 func (p *Parser) initialize() messages.Error {
@@ -98,15 +135,20 @@ func (p *Parser) initialize() messages.Error {
 
 		p.parameters = allParameters
 		p.flagToParameter = make(map[string]entities.Parameter)
+		p.keyToParameter = make(map[string]entities.Parameter)
 		for _, param := range allParameters {
 			if flagName := param.GetFlagName(); flagName != "" {
 				p.flagToParameter[flagName] = param
 			}
+			p.keyToParameter[configFileKey(param)] = param
+		}
+
+		p.projectConfigFileIDs = make(map[string]struct{})
+		for _, param := range defaultparameters.ProjectConfigFileParameters() {
+			p.projectConfigFileIDs[param.GetID()] = struct{}{}
 		}
 
-		p.flagSet = pflag.NewFlagSet(pflag.CommandLine.Name(), pflag.ContinueOnError)
-		p.setupFlags()
-		p.generateUsageText()
+		p.generateUsageText(p.newFlagSet())
 	})
 	return p.initErr
 }
@@ -149,7 +191,7 @@ func (p *Parser) allParameters() ([]entities.Parameter, messages.Error) {
 	return allParams, nil
 }
 
-func (p *Parser) generateUsageText() {
+func (p *Parser) generateUsageText(flagSet *pflag.FlagSet) {
 	usageText := fmt.Sprintf("%s\n", "Usage:")
 
 	// Determine max flag length
@@ -157,7 +199,7 @@ func (p *Parser) generateUsageText() {
 	prePadding := 6
 	postPadding := 2
 
-	p.flagSet.VisitAll(func(f *pflag.Flag) {
+	flagSet.VisitAll(func(f *pflag.Flag) {
 		if f.Hidden {
 			return
 		}
@@ -166,7 +208,7 @@ func (p *Parser) generateUsageText() {
 		}
 	})
 
-	p.flagSet.VisitAll(func(f *pflag.Flag) {
+	flagSet.VisitAll(func(f *pflag.Flag) {
 		if f.Hidden {
 			return
 		}
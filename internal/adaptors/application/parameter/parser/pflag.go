@@ -10,7 +10,9 @@ import (
 	"github.com/spf13/pflag"
 )
 
-func (p *Parser) setupFlags() {
+func (p *Parser) newFlagSet() *pflag.FlagSet {
+	flagSet := pflag.NewFlagSet(pflag.CommandLine.Name(), pflag.ContinueOnError)
+
 	for flagName, parameter := range p.flagToParameter {
 		if !parameter.GetActive() {
 			continue
@@ -18,29 +20,28 @@ func (p *Parser) setupFlags() {
 
 		switch defaultValue := parameter.GetDefaultValue().(type) {
 		case bool:
-			p.flagSet.Bool(flagName, defaultValue, parameter.GetDescription())
+			flagSet.Bool(flagName, defaultValue, parameter.GetDescription())
 		case string:
-			p.flagSet.String(flagName, defaultValue, parameter.GetDescription())
+			flagSet.String(flagName, defaultValue, parameter.GetDescription())
 		case []string:
-			p.flagSet.StringArray(flagName, defaultValue, parameter.GetDescription())
+			flagSet.StringArray(flagName, defaultValue, parameter.GetDescription())
+		case int:
+			flagSet.Int(flagName, defaultValue, parameter.GetDescription())
 		case time.Duration:
-			p.flagSet.Duration(flagName, defaultValue, parameter.GetDescription())
+			flagSet.Duration(flagName, defaultValue, parameter.GetDescription())
 		}
 		if parameter.GetHiddenFlag() {
-			_ = p.flagSet.MarkHidden(flagName) // Logically impossible to hit NotExistError
+			_ = flagSet.MarkHidden(flagName) // Logically impossible to hit NotExistError
 		}
 	}
-}
 
-func (p *Parser) parseFlags(args []string, specifiedArgs map[string]any, specifiedParameters map[string]struct{}) messages.Error {
-	err := p.flagSet.Parse(args)
-	if err != nil {
-		return p.convertToUserFacingError(err)
-	}
+	return flagSet
+}
 
+func (p *Parser) parseFlags(flagSet *pflag.FlagSet, resolved *resolvedArgs) messages.Error {
 	var messagesErr messages.Error
 
-	p.flagSet.Visit(func(f *pflag.Flag) {
+	flagSet.Visit(func(f *pflag.Flag) {
 		parameter := p.flagToParameter[f.Name]
 
 		var val any
@@ -48,22 +49,24 @@ func (p *Parser) parseFlags(args []string, specifiedArgs map[string]any, specifi
 
 		switch parameter.GetDefaultValue().(type) {
 		case bool:
-			val, err = p.flagSet.GetBool(f.Name)
+			val, err = flagSet.GetBool(f.Name)
 		case string:
-			val, err = p.flagSet.GetString(f.Name)
+			val, err = flagSet.GetString(f.Name)
 		case []string:
 			var flagValues []string
-			flagValues, err = p.flagSet.GetStringArray(f.Name)
+			flagValues, err = flagSet.GetStringArray(f.Name)
 			if err == nil {
 				// Append CLI values after any existing values (e.g. from env var)
-				if existing, ok := specifiedArgs[parameter.GetID()].([]string); ok {
+				if existing, ok := resolved.values[parameter.GetID()].([]string); ok {
 					val = append(existing, flagValues...)
 				} else {
 					val = flagValues
 				}
 			}
+		case int:
+			val, err = flagSet.GetInt(f.Name)
 		case time.Duration:
-			val, err = p.flagSet.GetDuration(f.Name)
+			val, err = flagSet.GetDuration(f.Name)
 		default:
 			// If you hit this error, it means this switch is not implementing a supported type in `pkg/config`
 			messagesErr = messages.New_StartupErrors_ParseFailed_Error("\n", internalErrorText)
@@ -75,8 +78,7 @@ func (p *Parser) parseFlags(args []string, specifiedArgs map[string]any, specifi
 			return
 		}
 
-		specifiedArgs[parameter.GetID()] = val
-		specifiedParameters[parameter.GetID()] = struct{}{}
+		resolved.set(parameter, val, "flag --"+f.Name)
 	})
 
 	return messagesErr
@@ -197,6 +197,54 @@ func TestParser_Parse_DurationFlag(t *testing.T) {
 	assert.Equal(t, []string{paramID}, specifiedParameters)
 }
 
+func TestParser_Parse_IntFlag(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	paramID := "int-param"
+	paramFlagName := "my-int"
+
+	mockParam := newMockParam(
+		t,
+		paramID,
+		paramFlagName,
+		"",
+		3,
+		"Test int description",
+		false,
+		true,
+	)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	args := []string{"--" + paramFlagName + "=42"}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	parameters, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 42, result[paramID])
+	assert.Equal(t, []entities.Parameter{mockParam}, parameters)
+	assert.Equal(t, []string{paramID}, specifiedParameters)
+}
+
 func TestParser_Parse_BadDurationFlagValue(t *testing.T) {
 	// Arrange
 	mockOSLayer := &parsermocks.MockOSLayer{}
@@ -246,6 +294,55 @@ func TestParser_Parse_BadDurationFlagValue(t *testing.T) {
 	assert.Nil(t, specifiedParameters)
 }
 
+func TestParser_Parse_BadIntFlagValue(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	paramFlagName := "my-int"
+	badValue := "notanint"
+
+	mockParam := newMockParam(
+		t,
+		"int-param",
+		paramFlagName,
+		"",
+		3,
+		"Test int description",
+		false,
+		true,
+	)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	args := []string{"--" + paramFlagName + "=" + badValue}
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	parameters, result, specifiedParameters, err := p.Parse(args)
+
+	// Assert
+	expectedError := messages.New_StartupErrors_BadValue_Error(badValue, paramFlagName)
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, result)
+	assert.Nil(t, parameters)
+	assert.Nil(t, specifiedParameters)
+}
+
 func TestParser_Parse_BadBoolFlagValue(t *testing.T) {
 	// Arrange
 	mockOSLayer := &parsermocks.MockOSLayer{}
@@ -0,0 +1,135 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+const defaultSource = "default"
+
+// resolvedArgs holds the value of each parameter, and where the value comes from.
+type resolvedArgs struct {
+	values    map[string]any
+	sources   map[string]string
+	specified map[string]struct{}
+
+	// projectSource is the source of the values from the project configuration file, and ignoredKeys are the keys
+	// of that file that it is not allowed to set.
+	projectSource string
+	ignoredKeys   []string
+}
+
+func newResolvedArgs(parameters []entities.Parameter) *resolvedArgs {
+	resolved := &resolvedArgs{
+		values:    make(map[string]any, len(parameters)),
+		sources:   make(map[string]string, len(parameters)),
+		specified: make(map[string]struct{}),
+	}
+	for _, param := range parameters {
+		resolved.values[param.GetID()] = param.GetDefaultValue()
+		resolved.sources[param.GetID()] = defaultSource
+	}
+	return resolved
+}
+
+func (r *resolvedArgs) set(parameter entities.Parameter, val any, source string) {
+	r.values[parameter.GetID()] = val
+	r.sources[parameter.GetID()] = source
+	r.specified[parameter.GetID()] = struct{}{}
+}
+
+// PrintableConfig returns the values of the last call to Parse, one "key: value" line per visible parameter,
+// with a comment that shows where the value comes from. The keys are the keys of a configuration file.
+func (p *Parser) PrintableConfig() (string, messages.Error) {
+	if err := p.initialize(); err != nil {
+		return "", err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	resolved := p.lastResolved
+	if resolved == nil {
+		resolved = newResolvedArgs(p.parameters)
+	}
+
+	var settings [][2]string
+	maxSettingLength := 0
+	for _, param := range p.parameters {
+		if !param.GetActive() || param.GetHiddenFlag() {
+			continue
+		}
+
+		setting := configFileKey(param) + ": " + formatResolvedValue(resolved.values[param.GetID()])
+		maxSettingLength = max(maxSettingLength, len(setting))
+		settings = append(settings, [2]string{setting, resolved.sources[param.GetID()]})
+	}
+
+	var printableConfig strings.Builder
+	for _, setting := range settings {
+		padding := maxSettingLength + 2 - len(setting[0])
+		fmt.Fprintf(&printableConfig, "%s%s# %s\n", setting[0], strings.Repeat(" ", padding), setting[1])
+	}
+
+	return printableConfig.String(), nil
+}
+
+// ProjectConfigFileSettings returns the settings of the project configuration file in the last call to Parse:
+// the values that the file sets, unless an environment variable or a flag overrides them, and the keys that it is not allowed to set.
+func (p *Parser) ProjectConfigFileSettings() []entities.ConfigFileSetting {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	resolved := p.lastResolved
+	if resolved == nil || resolved.projectSource == "" {
+		return nil
+	}
+
+	var settings []entities.ConfigFileSetting
+	for _, param := range p.parameters {
+		if resolved.sources[param.GetID()] != resolved.projectSource {
+			continue
+		}
+
+		settings = append(settings, entities.ConfigFileSetting{
+			Key:   configFileKey(param),
+			Value: formatResolvedValue(resolved.values[param.GetID()]),
+		})
+	}
+
+	for _, key := range resolved.ignoredKeys {
+		settings = append(settings, entities.ConfigFileSetting{Key: key, Ignored: true})
+	}
+
+	return settings
+}
+
+func formatResolvedValue(val any) string {
+	switch val := val.(type) {
+	case string:
+		return formatValue([]string{val}, false)
+	case []string:
+		return formatValue(val, true)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// formatValue quotes each item, and puts the items of a list in brackets.
+func formatValue(items []string, list bool) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = strconv.Quote(item)
+	}
+
+	if !list {
+		return strings.Join(quoted, "")
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
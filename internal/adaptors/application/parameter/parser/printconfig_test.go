@@ -0,0 +1,108 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package parser_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	parsermocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/parameter/parser"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_PrintableConfig_ShowsValuesAndSources(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	defaultParam := newMockParam(t, "default-param", "default-flag", "", time.Minute, "Default", false, true)
+	envParam := newMockParam(t, "env-param", "env-flag", "ENV_PARAM", 0, "Env", false, true)
+	flagParam := newMockParam(t, "flag-param", "flag-flag", "", []string{}, "Flag", false, true)
+	noFlagParam := newMockParam(t, "NoFlagParam", "", "", "with \"quotes\"", "", false, true)
+	hiddenParam := newMockParam(t, "hidden-param", "hidden-flag", "", "hidden", "Hidden", true, true)
+	inactiveParam := newMockParam(t, "inactive-param", "inactive-flag", "", "inactive", "Inactive", false, false)
+
+	noFlagParam.EXPECT().
+		GetHiddenFlag().
+		Return(false)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{defaultParam, envParam, flagParam, noFlagParam, hiddenParam, inactiveParam}).
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		LookupEnv("ENV_PARAM").
+		Return("42", true).
+		Once()
+
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	_, _, _, err := p.Parse([]string{"--flag-flag", "a", "--flag-flag", "b"})
+	require.NoError(t, err)
+
+	// Act
+	printableConfig, err := p.PrintableConfig()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"default-flag: 1m0s              # default\n"+
+		"env-flag: 42                    # environment variable ENV_PARAM\n"+
+		"flag-flag: [\"a\", \"b\"]           # flag --flag-flag\n"+
+		"NoFlagParam: \"with \\\"quotes\\\"\"  # default\n",
+		printableConfig)
+}
+
+func TestParser_PrintableConfig_PropagatesInitError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &parsermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDefaultParamFactory := &parsermocks.MockDefaultParameterFactory{}
+	defer mockDefaultParamFactory.AssertExpectations(t)
+
+	mockParamFactory := &parsermocks.MockParameterFactory{}
+	defer mockParamFactory.AssertExpectations(t)
+
+	mockParam := &entitiesmocks.MockParameter{}
+	defer mockParam.AssertExpectations(t)
+
+	mockDefaultParamFactory.EXPECT().
+		DefaultParameters().
+		Return([]entities.Parameter{mockParam}).
+		Once()
+
+	mockParam.EXPECT().
+		GetID().
+		Return("").
+		Once()
+
+	mockParamFactory.EXPECT().
+		Parameters().
+		Return([]entities.Parameter{}).
+		Once()
+
+	// Act
+	p := parser.New(mockOSLayer, mockDefaultParamFactory, mockParamFactory)
+	printableConfig, err := p.PrintableConfig()
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_InvalidParameterKey_Error(""), err)
+	assert.Empty(t, printableConfig)
+}
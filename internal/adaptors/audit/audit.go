@@ -0,0 +1,291 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package audit writes an append-only audit log of tool calls, in JSON Lines format.
+// Each entry records the exact MATLAB code and function calls that ran during the tool call.
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+const (
+	logFileName          = "audit"
+	logFileExt           = ".jsonl"
+	rotatedFileTimestamp = "20060102T150405.000000000Z"
+
+	folderPermissions = 0o700
+	filePermissions   = 0o600
+
+	lastLineChunkSize = 4096
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	MkdirAll(name string, perm os.FileMode) error
+	Open(path string) (osfacade.File, error)
+	OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error)
+	Rename(oldPath string, newPath string) error
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeError   Outcome = "error"
+)
+
+// Entry is one line of the audit log. It describes one tool call.
+type Entry struct {
+	Timestamp   time.Time   `json:"timestamp"`
+	Client      Client      `json:"client"`
+	Tool        string      `json:"tool"`
+	SessionID   string      `json:"sessionId"`
+	Executions  []Execution `json:"executions"`
+	DurationMS  int64       `json:"durationMs"`
+	Outcome     Outcome     `json:"outcome"`
+	Error       string      `json:"error,omitempty"`
+	OutputBytes int         `json:"outputBytes"`
+
+	// PreviousHash is the hash of the previous entry, if the hash chain is enabled.
+	PreviousHash string `json:"previousHash,omitempty"`
+}
+
+type Client struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Execution is a MATLAB code evaluation or function call that ran during a tool call.
+// Exactly one of Code and Function is set.
+type Execution struct {
+	MATLABSessionID int      `json:"matlabSessionId,omitempty"`
+	MATLABPID       int      `json:"matlabPid,omitempty"`
+	MATLABRelease   string   `json:"matlabRelease,omitempty"`
+	Code            string   `json:"code,omitempty"`
+	Function        string   `json:"function,omitempty"`
+	Arguments       []string `json:"arguments,omitempty"`
+}
+
+// Log appends entries to audit.jsonl in the audit log folder.
+// When the file reaches the maximum size, Log renames it with a timestamp and starts a new file.
+// Log never deletes files.
+type Log struct {
+	configFactory ConfigFactory
+	osLayer       OSLayer
+
+	initOnce  sync.Once
+	initError messages.Error
+
+	lock      sync.Mutex
+	path      string
+	maxSize   uint64
+	hashChain bool
+	// file is nil if the audit log is disabled.
+	file     osfacade.File
+	size     uint64
+	lastHash string
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+) *Log {
+	return &Log{
+		configFactory: configFactory,
+		osLayer:       osLayer,
+	}
+}
+
+func (l *Log) init() messages.Error {
+	l.initOnce.Do(func() {
+		cfg, messagesErr := l.configFactory.Config()
+		if messagesErr != nil {
+			l.initError = messagesErr
+			return
+		}
+
+		folder := cfg.AuditLogFolder()
+		if folder == "" {
+			return
+		}
+
+		l.path = filepath.Join(folder, logFileName+logFileExt)
+		l.maxSize = cfg.AuditLogMaxSize()
+		l.hashChain = cfg.AuditLogHashChain()
+
+		if err := l.osLayer.MkdirAll(folder, folderPermissions); err != nil {
+			l.initError = messages.New_StartupErrors_FailedToOpenAuditLog_Error(folder)
+			return
+		}
+
+		if l.hashChain {
+			lastHash, err := l.readLastHash()
+			if err != nil {
+				l.initError = messages.New_StartupErrors_FailedToOpenAuditLog_Error(folder)
+				return
+			}
+			l.lastHash = lastHash
+		}
+
+		if err := l.openFile(); err != nil {
+			l.initError = messages.New_StartupErrors_FailedToOpenAuditLog_Error(folder)
+			return
+		}
+	})
+
+	return l.initError
+}
+
+// readLastHash returns the hash of the last entry of an existing log, so that the hash chain continues across server runs.
+func (l *Log) readLastHash() (string, error) {
+	file, err := l.osLayer.Open(l.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() //nolint:errcheck // The file is only read
+
+	info, err := l.osLayer.Stat(l.path)
+	if err != nil {
+		return "", err
+	}
+
+	lastLine, err := readLastLine(file, info.Size())
+	if err != nil {
+		return "", err
+	}
+	if len(lastLine) == 0 {
+		return "", nil
+	}
+
+	var lastEntry struct {
+		Hash string `json:"hash"`
+	}
+	if err := json.Unmarshal(lastLine, &lastEntry); err != nil {
+		return "", err
+	}
+
+	return lastEntry.Hash, nil
+}
+
+// readLastLine reads the file backwards from its end, one chunk at a time, until it finds the start of the last line.
+// A large log is therefore never read in full.
+func readLastLine(file osfacade.File, size int64) ([]byte, error) {
+	var tail []byte
+	for end := size; end > 0; {
+		start := max(end-lastLineChunkSize, 0)
+		chunk := make([]byte, end-start)
+		if _, err := file.ReadAt(chunk, start); err != nil {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+
+		content := bytes.TrimSpace(tail)
+		if newline := bytes.LastIndexByte(content, '\n'); newline >= 0 {
+			return content[newline+1:], nil
+		}
+		end = start
+	}
+
+	return bytes.TrimSpace(tail), nil
+}
+
+func (l *Log) openFile() error {
+	file, err := l.osLayer.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	info, err := l.osLayer.Stat(l.path)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	l.file = file
+	l.size = uint64(info.Size()) //nolint:gosec // File sizes are never negative
+	return nil
+}
+
+func (l *Log) enabled() bool {
+	return l.file != nil
+}
+
+// append writes the entry as one line. If the hash chain is enabled, append sets the previous hash of the entry
+// and appends the hash of the entry, which is the SHA-256 hash of the line without the hash field.
+func (l *Log) append(entry Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.hashChain {
+		entry.PreviousHash = l.lastHash
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit log entry: %w", err)
+	}
+
+	var hash string
+	if l.hashChain {
+		sum := sha256.Sum256(line)
+		hash = hex.EncodeToString(sum[:])
+		line = append(line[:len(line)-1], fmt.Sprintf(`,"hash":%q}`, hash)...)
+	}
+	line = append(line, '\n')
+
+	if l.size > 0 && l.size+uint64(len(line)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(line)
+	l.size += uint64(n) //nolint:gosec // Write never returns a negative count
+	if err != nil {
+		return fmt.Errorf("failed to write audit log entry: %w", err)
+	}
+
+	if l.hashChain {
+		l.lastHash = hash
+	}
+
+	return nil
+}
+
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log: %w", err)
+	}
+
+	rotatedPath := filepath.Join(filepath.Dir(l.path), logFileName+"-"+time.Now().UTC().Format(rotatedFileTimestamp)+logFileExt)
+	if err := l.osLayer.Rename(l.path, rotatedPath); err != nil {
+		return fmt.Errorf("failed to rotate audit log: %w", err)
+	}
+
+	if err := l.openFile(); err != nil {
+		return fmt.Errorf("failed to open new audit log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package audit
+
+import "context"
+
+// WithRecord returns a context of an audited tool call, and a function that returns the executions recorded so far.
+func WithRecord(ctx context.Context) (context.Context, func() []Execution) {
+	ctx, r := withRecord(ctx)
+	return ctx, r.list
+}
+
+// DetailsExpression is the MATLAB expression that returns the details of MATLAB for the audit log.
+const DetailsExpression = detailsExpression
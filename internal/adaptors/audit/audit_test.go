@@ -0,0 +1,552 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/audit"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/audit"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	toolName       = "greet"
+	auditLogFolder = "audit"
+)
+
+// auditLogFile fakes audit.jsonl, keeping what the log writes.
+type auditLogFile struct {
+	*osfacademocks.MockFile
+
+	content bytes.Buffer
+}
+
+func newAuditLogFile(t *testing.T) *auditLogFile {
+	t.Helper()
+
+	file := &auditLogFile{MockFile: &osfacademocks.MockFile{}}
+	t.Cleanup(func() { file.AssertExpectations(t) })
+
+	file.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			return file.content.Write(b)
+		}).
+		Maybe()
+
+	return file
+}
+
+func (f *auditLogFile) entries(t *testing.T) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+	for line := range strings.SplitSeq(strings.TrimSpace(f.content.String()), "\n") {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func newConfigFactory(t *testing.T, folder string, maxSize uint64, hashChain bool) *mocks.MockConfigFactory {
+	t.Helper()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	t.Cleanup(func() { mockConfigFactory.AssertExpectations(t) })
+
+	mockConfig := &configmocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		AuditLogFolder().
+		Return(folder).
+		Once()
+
+	if folder == "" {
+		return mockConfigFactory
+	}
+
+	mockConfig.EXPECT().
+		AuditLogMaxSize().
+		Return(maxSize).
+		Once()
+
+	mockConfig.EXPECT().
+		AuditLogHashChain().
+		Return(hashChain).
+		Once()
+
+	return mockConfigFactory
+}
+
+func expectOpen(mockOSLayer *mocks.MockOSLayer, file *auditLogFile, size int64) {
+	auditLogPath := filepath.Join(auditLogFolder, "audit.jsonl")
+
+	mockOSLayer.EXPECT().
+		OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(0o600)).
+		Return(file, nil).
+		Once()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	mockFileInfo.EXPECT().
+		Size().
+		Return(size).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(auditLogPath).
+		Return(mockFileInfo, nil).
+		Once()
+}
+
+// expectExistingLog fakes an existing audit.jsonl with the content, which the log reads from its end.
+func expectExistingLog(t *testing.T, mockOSLayer *mocks.MockOSLayer, content string) {
+	t.Helper()
+
+	auditLogPath := filepath.Join(auditLogFolder, "audit.jsonl")
+	reader := strings.NewReader(content)
+
+	existingFile := &osfacademocks.MockFile{}
+	t.Cleanup(func() { existingFile.AssertExpectations(t) })
+
+	existingFile.EXPECT().
+		ReadAt(mock.Anything, mock.Anything).
+		RunAndReturn(reader.ReadAt)
+
+	existingFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(auditLogPath).
+		Return(existingFile, nil).
+		Once()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(len(content))).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(auditLogPath).
+		Return(mockFileInfo, nil).
+		Once()
+}
+
+// callTools connects a client to a server with the middleware, and calls each tool.
+// The greet tool returns its text, or a tool error if its text is empty.
+func callTools(t *testing.T, middleware mcp.Middleware, calls ...string) {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "server"}, nil)
+	server.AddReceivingMiddleware(middleware)
+	server.AddTool(&mcp.Tool{Name: toolName, InputSchema: &jsonschema.Schema{Type: "object"}}, func(_ context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args struct {
+			Text string `json:"text"`
+		}
+		_ = json.Unmarshal(req.Params.Arguments, &args)
+
+		if args.Text == "" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "no text"}}}, nil
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: args.Text}}}, nil
+	})
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.2.3"}, nil)
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = clientSession.Close()
+		_ = serverSession.Wait()
+	}()
+
+	for _, text := range calls {
+		name := toolName
+		if text == "missing" {
+			name = "missing_tool"
+		}
+		_, _ = clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: name, Arguments: map[string]any{"text": text}})
+	}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	auditLog := audit.New(mockConfigFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, auditLog)
+}
+
+func TestLog_Middleware_Disabled(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	auditLog := audit.New(newConfigFactory(t, "", 0, false), mockOSLayer)
+
+	// Act
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+
+	// Assert
+	require.NoError(t, err)
+	callTools(t, middleware, "hello")
+}
+
+func TestLog_Middleware_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	auditLog := audit.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+
+	// Assert
+	require.Equal(t, expectedError, err)
+	assert.Nil(t, middleware)
+}
+
+func TestLog_Middleware_OpenError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(filepath.Join(auditLogFolder, "audit.jsonl"), mock.Anything, mock.Anything).
+		Return(nil, assert.AnError).
+		Once()
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, false), mockOSLayer)
+
+	// Act
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToOpenAuditLog_Error(auditLogFolder), err)
+	assert.Nil(t, middleware)
+}
+
+func TestLog_Middleware_CreateFolderError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(assert.AnError).
+		Once()
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, false), mockOSLayer)
+
+	// Act
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToOpenAuditLog_Error(auditLogFolder), err)
+	assert.Nil(t, middleware)
+}
+
+func TestLog_Middleware_WritesEntryForEachToolCall(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	file := newAuditLogFile(t)
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	expectOpen(mockOSLayer, file, 0)
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, false), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello", "", "missing")
+
+	// Assert
+	entries := file.entries(t)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, toolName, entries[0]["tool"])
+	assert.Equal(t, map[string]any{"name": "client", "version": "1.2.3"}, entries[0]["client"])
+	assert.Equal(t, "success", entries[0]["outcome"])
+	assert.InDelta(t, len("hello"), entries[0]["outputBytes"], 0)
+	assert.NotContains(t, entries[0], "error")
+	assert.NotContains(t, entries[0], "hash")
+	assert.Contains(t, entries[0], "timestamp")
+	assert.Contains(t, entries[0], "durationMs")
+
+	assert.Equal(t, "error", entries[1]["outcome"])
+	assert.Equal(t, "no text", entries[1]["error"])
+
+	assert.Equal(t, "missing_tool", entries[2]["tool"])
+	assert.Equal(t, "error", entries[2]["outcome"])
+	assert.NotEmpty(t, entries[2]["error"])
+}
+
+func TestLog_Middleware_HashChain(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	file := newAuditLogFile(t)
+	lastHashOfPreviousRun := strings.Repeat("a", 64)
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	expectExistingLog(t, mockOSLayer, `{"tool":"greet"}`+"\n"+`{"tool":"greet","hash":"`+lastHashOfPreviousRun+`"}`+"\n")
+
+	expectOpen(mockOSLayer, file, 100)
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, true), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello", "world")
+
+	// Assert
+	lines := strings.Split(strings.TrimSpace(file.content.String()), "\n")
+	require.Len(t, lines, 2)
+
+	entries := file.entries(t)
+	assert.Equal(t, lastHashOfPreviousRun, entries[0]["previousHash"])
+	assert.Equal(t, entries[0]["hash"], entries[1]["previousHash"])
+
+	for i, line := range lines {
+		hash, ok := entries[i]["hash"].(string)
+		require.True(t, ok)
+
+		lineWithoutHash := strings.TrimSuffix(line, `,"hash":"`+hash+`"}`) + "}"
+		sum := sha256.Sum256([]byte(lineWithoutHash))
+		assert.Equal(t, hex.EncodeToString(sum[:]), hash, "The hash should be the hash of the line without the hash field")
+	}
+}
+
+func TestLog_Middleware_HashChainContinuesLongLog(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	file := newAuditLogFile(t)
+	lastHashOfPreviousRun := strings.Repeat("b", 64)
+	longEntry := `{"tool":"greet","text":"` + strings.Repeat("x", 10000) + `"}`
+	lastEntry := `{"tool":"greet","text":"` + strings.Repeat("y", 5000) + `","hash":"` + lastHashOfPreviousRun + `"}`
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	expectExistingLog(t, mockOSLayer, longEntry+"\n"+lastEntry+"\n")
+
+	expectOpen(mockOSLayer, file, 100)
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, true), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello")
+
+	// Assert
+	entries := file.entries(t)
+	require.Len(t, entries, 1)
+	assert.Equal(t, lastHashOfPreviousRun, entries[0]["previousHash"], "The log should read the last entry even if it is longer than one chunk")
+}
+
+func TestLog_Middleware_HashChainStartsWithNewLog(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	file := newAuditLogFile(t)
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Open(filepath.Join(auditLogFolder, "audit.jsonl")).
+		Return(nil, fs.ErrNotExist).
+		Once()
+
+	expectOpen(mockOSLayer, file, 0)
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, true), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello")
+
+	// Assert
+	entries := file.entries(t)
+	require.Len(t, entries, 1)
+	assert.NotContains(t, entries[0], "previousHash")
+	assert.Len(t, entries[0]["hash"], 64)
+}
+
+func TestLog_Middleware_RotatesFullLog(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	fullFile := newAuditLogFile(t)
+	newFile := newAuditLogFile(t)
+	auditLogPath := filepath.Join(auditLogFolder, "audit.jsonl")
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	expectOpen(mockOSLayer, fullFile, 1000)
+
+	fullFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	var rotatedPath string
+	mockOSLayer.EXPECT().
+		Rename(auditLogPath, mock.Anything).
+		RunAndReturn(func(_ string, newPath string) error {
+			rotatedPath = newPath
+			return nil
+		}).
+		Once()
+
+	expectOpen(mockOSLayer, newFile, 0)
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 1024, false), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(testutils.NewInspectableLogger())
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello")
+
+	// Assert
+	assert.Empty(t, fullFile.content.String())
+	assert.Len(t, newFile.entries(t), 1)
+	assert.Equal(t, auditLogFolder, filepath.Dir(rotatedPath))
+	assert.Regexp(t, `^audit-\d{8}T\d{6}\.\d{9}Z\.jsonl$`, filepath.Base(rotatedPath))
+}
+
+func TestLog_Middleware_WriteErrorIsLogged(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	auditLogPath := filepath.Join(auditLogFolder, "audit.jsonl")
+
+	mockOSLayer.EXPECT().
+		MkdirAll(auditLogFolder, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(auditLogPath, mock.Anything, mock.Anything).
+		Return(mockFile, nil).
+		Once()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	mockFileInfo.EXPECT().
+		Size().
+		Return(int64(0)).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(auditLogPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFile.EXPECT().
+		Write(mock.Anything).
+		Return(0, assert.AnError).
+		Once()
+
+	auditLog := audit.New(newConfigFactory(t, auditLogFolder, 100<<20, false), mockOSLayer)
+
+	middleware, err := auditLog.Middleware(logger)
+	require.NoError(t, err)
+
+	// Act
+	callTools(t, middleware, "hello")
+
+	// Assert
+	assert.Len(t, logger.ErrorLogs(), 1)
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package audit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type GlobalMATLABAdaptor interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+	SessionID() (entities.SessionID, bool)
+}
+
+type MATLABManagerAdaptor interface {
+	ListEnvironments(ctx context.Context, sessionLogger entities.Logger) []entities.EnvironmentInfo
+	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
+	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
+	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+}
+
+// GlobalMATLAB returns MATLAB session clients that record the code they run in the audit log entry of the tool call.
+type GlobalMATLAB struct {
+	globalMATLAB GlobalMATLABAdaptor
+
+	lock   sync.Mutex
+	client *sessionClient
+}
+
+func NewGlobalMATLAB(globalMATLAB GlobalMATLABAdaptor) *GlobalMATLAB {
+	return &GlobalMATLAB{
+		globalMATLAB: globalMATLAB,
+	}
+}
+
+func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+	client, err := g.globalMATLAB.Client(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	// The global MATLAB session changes when MATLAB restarts
+	if g.client == nil || g.client.MATLABSessionClient != client {
+		g.client = newSessionClient(client, 0)
+	}
+
+	return g.client, nil
+}
+
+func (g *GlobalMATLAB) SessionID() (entities.SessionID, bool) {
+	return g.globalMATLAB.SessionID()
+}
+
+// MATLABManager returns MATLAB session clients that record the code they run in the audit log entry of the tool call.
+type MATLABManager struct {
+	MATLABManagerAdaptor
+
+	lock    sync.Mutex
+	clients map[entities.SessionID]*sessionClient
+}
+
+func NewMATLABManager(matlabManager MATLABManagerAdaptor) *MATLABManager {
+	return &MATLABManager{
+		MATLABManagerAdaptor: matlabManager,
+		clients:              make(map[entities.SessionID]*sessionClient),
+	}
+}
+
+func (m *MATLABManager) StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	m.lock.Lock()
+	delete(m.clients, sessionID)
+	m.lock.Unlock()
+
+	return m.MATLABManagerAdaptor.StopMATLABSession(ctx, sessionLogger, sessionID)
+}
+
+func (m *MATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	client, err := m.MATLABManagerAdaptor.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if existing, ok := m.clients[sessionID]; ok && existing.MATLABSessionClient == client {
+		return existing, nil
+	}
+
+	wrapped := newSessionClient(client, sessionID)
+	m.clients[sessionID] = wrapped
+	return wrapped, nil
+}
+
+// detailsExpression asks MATLAB for its process ID and release, one per line.
+const detailsExpression = `sprintf('%d\n%s', feature('getpid'), version('-release'))`
+
+// sessionClient records each evaluation and function call in the audit log entry of the tool call that the context belongs to.
+// It asks MATLAB for its details once, before the first recorded execution.
+type sessionClient struct {
+	entities.MATLABSessionClient
+
+	sessionID entities.SessionID
+
+	detailsLock sync.Mutex
+	detailsRead bool
+	pid         int
+	release     string
+}
+
+func newSessionClient(client entities.MATLABSessionClient, sessionID entities.SessionID) *sessionClient {
+	return &sessionClient{
+		MATLABSessionClient: client,
+		sessionID:           sessionID,
+	}
+}
+
+func (c *sessionClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	c.record(ctx, sessionLogger, Execution{Code: request.Code})
+	return c.MATLABSessionClient.Eval(ctx, sessionLogger, request)
+}
+
+func (c *sessionClient) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	c.record(ctx, logger, Execution{Code: input.Code})
+	return c.MATLABSessionClient.EvalWithCapture(ctx, logger, input)
+}
+
+func (c *sessionClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	c.record(ctx, sessionLogger, Execution{Function: request.Function, Arguments: request.Arguments})
+	return c.MATLABSessionClient.FEval(ctx, sessionLogger, request)
+}
+
+func (c *sessionClient) record(ctx context.Context, logger entities.Logger, execution Execution) {
+	r := recordFromContext(ctx)
+	if r == nil {
+		return
+	}
+
+	execution.MATLABSessionID = int(c.sessionID)
+	execution.MATLABPID, execution.MATLABRelease = c.details(ctx, logger)
+
+	r.add(execution)
+}
+
+// details returns the process ID and release of MATLAB. The first call evaluates detailsExpression, without recording the evaluation.
+// If MATLAB does not report its details, the next call asks again.
+func (c *sessionClient) details(ctx context.Context, logger entities.Logger) (int, string) {
+	c.detailsLock.Lock()
+	defer c.detailsLock.Unlock()
+
+	if c.detailsRead {
+		return c.pid, c.release
+	}
+
+	response, err := c.MATLABSessionClient.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "eval",
+		Arguments:  []string{detailsExpression},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		logger.WithError(err).Debug("Failed to get MATLAB details for the audit log")
+		return 0, ""
+	}
+
+	if len(response.Outputs) == 0 {
+		return 0, ""
+	}
+
+	output, _ := response.Outputs[0].(string)
+	pidField, release, found := strings.Cut(output, "\n")
+	if !found {
+		return 0, ""
+	}
+
+	c.pid, _ = strconv.Atoi(pidField)
+	c.release = release
+	c.detailsRead = true
+
+	return c.pid, c.release
+}
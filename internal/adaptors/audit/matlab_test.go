@@ -0,0 +1,385 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package audit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/audit"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/audit"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	matlabPID     = 4242
+	matlabRelease = "2025b"
+)
+
+func expectFEval(mockClient *entitiesmocks.MockMATLABSessionClient, function string, arguments []string, output any) *entitiesmocks.MockMATLABSessionClient_FEval_Call {
+	return mockClient.EXPECT().
+		FEval(mock.Anything, mock.Anything, entities.FEvalRequest{Function: function, Arguments: arguments, NumOutputs: 1}).
+		Return(entities.FEvalResponse{Outputs: []any{output}}, nil)
+}
+
+func expectMATLABDetails(mockClient *entitiesmocks.MockMATLABSessionClient) {
+	details := fmt.Sprintf("%d\n%s", matlabPID, matlabRelease)
+	expectFEval(mockClient, "eval", []string{audit.DetailsExpression}, details).Once()
+}
+
+func TestNewGlobalMATLAB_HappyPath(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, globalMATLAB)
+}
+
+func TestGlobalMATLAB_Client_RecordsExecutions(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx, executions := audit.WithRecord(t.Context())
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+	captureRequest := entities.EvalRequest{Code: "disp(x)"}
+	fevalRequest := entities.FEvalRequest{Function: "checkcode", Arguments: []string{"script.m"}, NumOutputs: 1}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(mockClient, nil).
+		Once()
+
+	expectMATLABDetails(mockClient)
+
+	mockClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, logger, captureRequest).
+		Return(entities.EvalResponse{ConsoleOutput: "1"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, logger, fevalRequest).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, logger)
+	require.NoError(t, err)
+
+	_, evalErr := client.Eval(ctx, logger, evalRequest)
+	captureResponse, captureErr := client.EvalWithCapture(ctx, logger, captureRequest)
+	_, fevalErr := client.FEval(ctx, logger, fevalRequest)
+
+	// Assert
+	require.NoError(t, evalErr)
+	require.NoError(t, captureErr)
+	require.NoError(t, fevalErr)
+	assert.Equal(t, "1", captureResponse.ConsoleOutput)
+
+	expectedExecution := audit.Execution{MATLABPID: matlabPID, MATLABRelease: matlabRelease}
+	evalExecution, captureExecution, fevalExecution := expectedExecution, expectedExecution, expectedExecution
+	evalExecution.Code = "x = 1"
+	captureExecution.Code = "disp(x)"
+	fevalExecution.Function = "checkcode"
+	fevalExecution.Arguments = []string{"script.m"}
+
+	assert.Equal(t, []audit.Execution{evalExecution, captureExecution, fevalExecution}, executions())
+}
+
+func TestGlobalMATLAB_Client_AsksRestartedMATLABForDetails(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFirstClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockFirstClient.AssertExpectations(t)
+
+	mockSecondClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSecondClient.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx, executions := audit.WithRecord(t.Context())
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+	restartedPID := matlabPID + 1
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(mockFirstClient, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(mockSecondClient, nil).
+		Once()
+
+	expectMATLABDetails(mockFirstClient)
+	expectFEval(mockSecondClient, "eval", []string{audit.DetailsExpression}, fmt.Sprintf("%d\n%s", restartedPID, matlabRelease)).Once()
+
+	mockFirstClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockSecondClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	for range 2 {
+		client, err := globalMATLAB.Client(ctx, logger)
+		require.NoError(t, err)
+
+		_, err = client.Eval(ctx, logger, evalRequest)
+		require.NoError(t, err)
+	}
+
+	// Assert
+	assert.Equal(t, []audit.Execution{
+		{MATLABPID: matlabPID, MATLABRelease: matlabRelease, Code: "x = 1"},
+		{MATLABPID: restartedPID, MATLABRelease: matlabRelease, Code: "x = 1"},
+	}, executions())
+}
+
+func TestGlobalMATLAB_Client_NotAudited(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, logger)
+	require.NoError(t, err)
+
+	_, evalErr := client.Eval(ctx, logger, evalRequest)
+
+	// Assert
+	require.NoError(t, evalErr, "The client should not ask MATLAB for details outside an audited tool call")
+}
+
+func TestGlobalMATLAB_Client_Error(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(nil, assert.AnError).
+		Once()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, logger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, client)
+}
+
+func TestGlobalMATLAB_SessionID_HappyPath(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(expectedSessionID, true).
+		Once()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	sessionID, ok := globalMATLAB.SessionID()
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestGlobalMATLAB_Client_MATLABDetailsUnavailable(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLABAdaptor{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx, executions := audit.WithRecord(t.Context())
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, logger, mock.Anything).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Twice()
+
+	mockClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Twice()
+
+	globalMATLAB := audit.NewGlobalMATLAB(mockGlobalMATLAB)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, logger)
+	require.NoError(t, err)
+
+	_, firstErr := client.Eval(ctx, logger, evalRequest)
+	_, secondErr := client.Eval(ctx, logger, evalRequest)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, []audit.Execution{{Code: "x = 1"}, {Code: "x = 1"}}, executions(), "The client should record the code even if MATLAB does not report its details")
+}
+
+func TestNewMATLABManager_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	matlabManager := audit.NewMATLABManager(mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, matlabManager)
+}
+
+func TestMATLABManager_GetMATLABSessionClient_RecordsSessionID(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx, executions := audit.WithRecord(t.Context())
+	sessionID := entities.SessionID(3)
+	evalRequest := entities.EvalRequest{Code: "x = 1"}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, logger, sessionID).
+		Return(mockClient, nil).
+		Twice()
+
+	expectMATLABDetails(mockClient)
+
+	mockClient.EXPECT().
+		Eval(ctx, logger, evalRequest).
+		Return(entities.EvalResponse{}, nil).
+		Twice()
+
+	matlabManager := audit.NewMATLABManager(mockMATLABManager)
+
+	// Act
+	for range 2 {
+		client, err := matlabManager.GetMATLABSessionClient(ctx, logger, sessionID)
+		require.NoError(t, err)
+
+		_, err = client.Eval(ctx, logger, evalRequest)
+		require.NoError(t, err)
+	}
+
+	// Assert
+	expectedExecution := audit.Execution{MATLABSessionID: 3, MATLABPID: matlabPID, MATLABRelease: matlabRelease, Code: "x = 1"}
+	assert.Equal(t, []audit.Execution{expectedExecution, expectedExecution}, executions(), "The client should ask for the MATLAB process once per session")
+}
+
+func TestMATLABManager_GetMATLABSessionClient_Error(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	sessionID := entities.SessionID(3)
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, logger, sessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	matlabManager := audit.NewMATLABManager(mockMATLABManager)
+
+	// Act
+	client, err := matlabManager.GetMATLABSessionClient(ctx, logger, sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, client)
+}
+
+func TestMATLABManager_StopMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	logger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	sessionID := entities.SessionID(3)
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(ctx, logger, sessionID).
+		Return(assert.AnError).
+		Once()
+
+	matlabManager := audit.NewMATLABManager(mockMATLABManager)
+
+	// Act
+	err := matlabManager.StopMATLABSession(ctx, logger, sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const toolsCallMethod = "tools/call"
+
+type recordContextKey struct{}
+
+// record collects the executions of one tool call.
+type record struct {
+	lock       sync.Mutex
+	executions []Execution
+}
+
+func (r *record) add(execution Execution) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.executions = append(r.executions, execution)
+}
+
+func (r *record) list() []Execution {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Execution{}, r.executions...)
+}
+
+func withRecord(ctx context.Context) (context.Context, *record) {
+	r := &record{}
+	return context.WithValue(ctx, recordContextKey{}, r), r
+}
+
+// recordFromContext returns the record of the tool call that the context belongs to, or nil if the call is not audited.
+func recordFromContext(ctx context.Context) *record {
+	r, _ := ctx.Value(recordContextKey{}).(*record)
+	return r
+}
+
+// Middleware returns an MCP middleware that writes an audit log entry for each tool call, whether the tool is
+// built in, custom, or added through the SDK. If no audit log folder is configured, the middleware does nothing.
+// Middleware returns an error if the audit log cannot be opened.
+func (l *Log) Middleware(logger entities.Logger) (mcp.Middleware, messages.Error) {
+	if err := l.init(); err != nil {
+		return nil, err
+	}
+
+	if !l.enabled() {
+		return func(next mcp.MethodHandler) mcp.MethodHandler {
+			return next
+		}, nil
+	}
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			callToolRequest, ok := req.(*mcp.CallToolRequest)
+			if method != toolsCallMethod || !ok {
+				return next(ctx, method, req)
+			}
+
+			ctx, r := withRecord(ctx)
+			start := time.Now()
+
+			result, err := next(ctx, method, req)
+
+			entry := newEntry(callToolRequest, start, time.Since(start), r.list())
+			setOutcome(&entry, result, err)
+
+			if appendErr := l.append(entry); appendErr != nil {
+				logger.
+					WithError(appendErr).
+					With("tool", entry.Tool).
+					Error("Failed to write audit log entry")
+			}
+
+			return result, err
+		}
+	}, nil
+}
+
+func newEntry(req *mcp.CallToolRequest, start time.Time, duration time.Duration, executions []Execution) Entry {
+	entry := Entry{
+		Timestamp:  start.UTC(),
+		DurationMS: duration.Milliseconds(),
+		Executions: executions,
+	}
+
+	if req.Params != nil {
+		entry.Tool = req.Params.Name
+	}
+
+	if req.Session != nil {
+		entry.SessionID = req.Session.ID()
+
+		if params := req.Session.InitializeParams(); params != nil && params.ClientInfo != nil {
+			entry.Client = Client{
+				Name:    params.ClientInfo.Name,
+				Version: params.ClientInfo.Version,
+			}
+		}
+	}
+
+	return entry
+}
+
+func setOutcome(entry *Entry, result mcp.Result, err error) {
+	entry.Outcome = OutcomeSuccess
+
+	if err != nil {
+		entry.Outcome = OutcomeError
+		entry.Error = err.Error()
+		return
+	}
+
+	callToolResult, ok := result.(*mcp.CallToolResult)
+	if !ok || callToolResult == nil {
+		return
+	}
+
+	entry.OutputBytes = outputBytes(callToolResult)
+
+	if callToolResult.IsError {
+		entry.Outcome = OutcomeError
+		entry.Error = errorText(callToolResult)
+	}
+}
+
+// outputBytes returns the size of the text, images, and audio, and of the structured content of the result.
+func outputBytes(result *mcp.CallToolResult) int {
+	size := 0
+	for _, content := range result.Content {
+		switch c := content.(type) {
+		case *mcp.TextContent:
+			size += len(c.Text)
+		case *mcp.ImageContent:
+			size += len(c.Data)
+		case *mcp.AudioContent:
+			size += len(c.Data)
+		}
+	}
+
+	if result.StructuredContent != nil {
+		if structuredContent, err := json.Marshal(result.StructuredContent); err == nil {
+			size += len(structuredContent)
+		}
+	}
+
+	return size
+}
+
+func errorText(result *mcp.CallToolResult) string {
+	for _, content := range result.Content {
+		if textContent, ok := content.(*mcp.TextContent); ok {
+			return textContent.Text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package codepolicy checks MATLAB code against the code policy file before the code runs in MATLAB.
+package codepolicy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+var ErrCodePolicyViolation = errors.New("code policy violation")
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type Enforcer struct {
+	configFactory ConfigFactory
+	osLayer       OSLayer
+
+	initOnce  sync.Once
+	initError messages.Error
+	// policy is nil if no code policy file is configured.
+	policy *Policy
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+) *Enforcer {
+	return &Enforcer{
+		configFactory: configFactory,
+		osLayer:       osLayer,
+	}
+}
+
+// Check returns an error that wraps ErrCodePolicyViolation if the code violates the code policy.
+// If the code policy file cannot be loaded, Check returns that error for any code.
+func (e *Enforcer) Check(code string) error {
+	policy, err := e.loadPolicy()
+	if err != nil {
+		return err
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	if v := policy.check(code); v != nil {
+		return v.toError("")
+	}
+
+	return nil
+}
+
+// CheckFile checks the content of a MATLAB file, as Check does. It reads the file only if a code policy is configured.
+func (e *Enforcer) CheckFile(filePath string) error {
+	policy, err := e.loadPolicy()
+	if err != nil {
+		return err
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	code, err := e.osLayer.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	if v := policy.check(string(code)); v != nil {
+		return v.toError(filePath)
+	}
+
+	return nil
+}
+
+func (e *Enforcer) loadPolicy() (*Policy, error) {
+	e.initOnce.Do(func() {
+		cfg, err := e.configFactory.Config()
+		if err != nil {
+			e.initError = err
+			return
+		}
+
+		policyFile := cfg.CodePolicyFile()
+		if policyFile == "" {
+			return
+		}
+
+		data, readErr := e.osLayer.ReadFile(policyFile)
+		if readErr != nil {
+			e.initError = messages.New_StartupErrors_FailedToReadCodePolicyFile_Error(policyFile)
+			return
+		}
+
+		policy, parseErr := parsePolicy(data)
+		if parseErr != nil {
+			e.initError = messages.New_StartupErrors_InvalidCodePolicyFile_Error(policyFile, parseErr.Error())
+			return
+		}
+
+		e.policy = policy
+	})
+
+	if e.initError != nil {
+		return nil, e.initError
+	}
+
+	return e.policy, nil
+}
+
+func (v *violation) toError(filePath string) error {
+	location := ""
+	if v.line > 0 {
+		location = fmt.Sprintf(", line %d", v.line)
+		if filePath != "" {
+			location += " of " + filePath
+		}
+	}
+
+	return fmt.Errorf("%w: rule %q%s: %s", ErrCodePolicyViolation, v.rule, location, v.detail)
+}
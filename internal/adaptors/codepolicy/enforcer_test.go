@@ -0,0 +1,420 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codepolicy_test
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/codepolicy"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/codepolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyFile = "/policies/code-policy.json"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	enforcer := codepolicy.New(mockConfigFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, enforcer)
+}
+
+func TestEnforcer_Check(t *testing.T) {
+	allowedFolder := filepath.Join(t.TempDir(), "data")
+	policy := `{
+		"deniedFunctions": ["system", "!", "delete", "java.*", "py.*"],
+		"fileAccess": {
+			"functions": ["fopen", "load", "copyfile"],
+			"allowedFolders": [` + strconv.Quote(allowedFolder) + `]
+		},
+		"maxCodeLength": 200
+	}`
+
+	tests := []struct {
+		name          string
+		code          string
+		expectedError string
+	}{
+		{
+			name: "AllowedCode",
+			code: "x = magic(4);\ndisp(x')",
+		},
+		{
+			name:          "DeniedFunctionCall",
+			code:          "x = 1;\n[status, out] = system('ls');",
+			expectedError: `code policy violation: rule "deniedFunctions", line 2: system is not allowed`,
+		},
+		{
+			name:          "DeniedCommand",
+			code:          "delete foo.txt",
+			expectedError: `code policy violation: rule "deniedFunctions", line 1: delete is not allowed`,
+		},
+		{
+			name:          "DeniedFunctionHandle",
+			code:          "f = @system;",
+			expectedError: `code policy violation: rule "deniedFunctions", line 1: system is not allowed`,
+		},
+		{
+			name:          "DeniedShellEscape",
+			code:          "!ls",
+			expectedError: `code policy violation: rule "deniedFunctions", line 1: shell escapes (!) are not allowed`,
+		},
+		{
+			name:          "DeniedPackage",
+			code:          "r = java.lang.Runtime.getRuntime();",
+			expectedError: `code policy violation: rule "deniedFunctions", line 1: java.lang.Runtime.getRuntime is not allowed`,
+		},
+		{
+			name:          "DeniedPythonCall",
+			code:          "py.os.system('ls')",
+			expectedError: `code policy violation: rule "deniedFunctions", line 1: py.os.system is not allowed`,
+		},
+		{
+			name: "DeniedNamesInCommentsAndStrings",
+			code: "% system('ls')\ndisp('delete everything') % !ls\n%{\njava.lang.System.exit(0)\n%}",
+		},
+		{
+			name: "MethodWithDeniedName",
+			code: "obj(1).delete()",
+		},
+		{
+			name: "FileAccessInAllowedFolder",
+			code: "fid = fopen(" + quoteMATLAB(filepath.Join(allowedFolder, "results.txt")) + ", 'w');",
+		},
+		{
+			name: "FileAccessWithRelativePath",
+			code: "load data/results.mat",
+		},
+		{
+			name: "FileAccessWithoutArguments",
+			code: "load",
+		},
+		{
+			name:          "FileAccessOutsideAllowedFolders",
+			code:          "x = 1;\n\nfid = fopen(" + quoteMATLAB(filepath.Join(filepath.Dir(allowedFolder), "secret.txt")) + ");",
+			expectedError: `code policy violation: rule "fileAccess", line 3: fopen cannot access ` + strconv.Quote(filepath.Join(filepath.Dir(allowedFolder), "secret.txt")),
+		},
+		{
+			name:          "FileAccessToParentFolder",
+			code:          "load ../secret.mat",
+			expectedError: `code policy violation: rule "fileAccess", line 1: load cannot access "../secret.mat"`,
+		},
+		{
+			name:          "FileAccessInSecondArgument",
+			code:          "copyfile('a.txt', '/etc/passwd')",
+			expectedError: `code policy violation: rule "fileAccess", line 1: copyfile cannot access "/etc/passwd"`,
+		},
+		{
+			name:          "FileAccessWithComputedPath",
+			code:          "fopen(fullfile(folder, 'a.txt'))",
+			expectedError: `code policy violation: rule "fileAccess", line 1: the first argument of fopen must be a literal path`,
+		},
+		{
+			name:          "FileAccessWithURL",
+			code:          `load("https://example.com/data.mat")`,
+			expectedError: `code policy violation: rule "fileAccess", line 1: load cannot access "https://example.com/data.mat"`,
+		},
+		{
+			name:          "FileAccessFunctionHandle",
+			code:          "f = @fopen;",
+			expectedError: `code policy violation: rule "fileAccess", line 1: fopen cannot be used as a function handle`,
+		},
+		{
+			name:          "CodeTooLong",
+			code:          "x = '" + strings.Repeat("a", 200) + "';",
+			expectedError: `code policy violation: rule "maxCodeLength": the code has 207 characters, which is more than the limit of 200`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			enforcer := newEnforcer(t, policy)
+
+			// Act
+			err := enforcer.Check(tt.code)
+
+			// Assert
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, codepolicy.ErrCodePolicyViolation)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestEnforcer_Check_NoPolicyFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		CodePolicyFile().
+		Return("").
+		Once()
+
+	enforcer := codepolicy.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	err := enforcer.Check("system('ls')")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestEnforcer_Check_LoadsPolicyOnce(t *testing.T) {
+	// Arrange
+	enforcer := newEnforcer(t, `{"deniedFunctions": ["system"]}`)
+
+	// Act
+	firstErr := enforcer.Check("system('ls')")
+	secondErr := enforcer.Check("x = 1;")
+
+	// Assert
+	require.ErrorIs(t, firstErr, codepolicy.ErrCodePolicyViolation)
+	require.NoError(t, secondErr)
+}
+
+func TestEnforcer_Check_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedError := messages.New_StartupErrors_BadFlag_Error("flag", "value", "reason")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	enforcer := codepolicy.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	err := enforcer.Check("x = 1;")
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestEnforcer_Check_PolicyFileReadError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		CodePolicyFile().
+		Return(policyFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	enforcer := codepolicy.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	firstErr := enforcer.Check("x = 1;")
+	secondErr := enforcer.Check("x = 1;")
+
+	// Assert
+	expectedError := messages.New_StartupErrors_FailedToReadCodePolicyFile_Error(policyFile)
+	require.Equal(t, expectedError, firstErr)
+	require.Equal(t, expectedError, secondErr)
+}
+
+func TestEnforcer_Check_InvalidPolicyFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         string
+		expectedReason string
+	}{
+		{
+			name:           "MalformedJSON",
+			policy:         `{"deniedFunctions": [`,
+			expectedReason: "unexpected EOF",
+		},
+		{
+			name:           "UnknownKey",
+			policy:         `{"deniedFunction": ["system"]}`,
+			expectedReason: `json: unknown field "deniedFunction"`,
+		},
+		{
+			name:           "NegativeMaxCodeLength",
+			policy:         `{"maxCodeLength": -1}`,
+			expectedReason: "maxCodeLength must be zero or a positive number",
+		},
+		{
+			name:           "EmptyFunctionName",
+			policy:         `{"deniedFunctions": [""]}`,
+			expectedReason: "function names cannot be empty",
+		},
+		{
+			name:           "RelativeAllowedFolder",
+			policy:         `{"fileAccess": {"functions": ["fopen"], "allowedFolders": ["data"]}}`,
+			expectedReason: `allowed folder "data" must be an absolute path`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			enforcer := newEnforcer(t, tt.policy)
+
+			// Act
+			err := enforcer.Check("x = 1;")
+
+			// Assert
+			assert.Equal(t, messages.New_StartupErrors_InvalidCodePolicyFile_Error(policyFile, tt.expectedReason), err)
+		})
+	}
+}
+
+func TestEnforcer_CheckFile_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	enforcer := newEnforcerWithOSLayer(t, mockOSLayer, `{"deniedFunctions": ["rmdir"]}`)
+
+	const scriptPath = "/scripts/cleanup.m"
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte("x = 1;\nrmdir('out', 's')"), nil).
+		Once()
+
+	// Act
+	err := enforcer.CheckFile(scriptPath)
+
+	// Assert
+	require.ErrorIs(t, err, codepolicy.ErrCodePolicyViolation)
+	assert.EqualError(t, err, `code policy violation: rule "deniedFunctions", line 2 of /scripts/cleanup.m: rmdir is not allowed`)
+}
+
+func TestEnforcer_CheckFile_ReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	enforcer := newEnforcerWithOSLayer(t, mockOSLayer, `{"deniedFunctions": ["rmdir"]}`)
+
+	const scriptPath = "/scripts/cleanup.m"
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	err := enforcer.CheckFile(scriptPath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestEnforcer_CheckFile_NoPolicyFile(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		CodePolicyFile().
+		Return("").
+		Once()
+
+	enforcer := codepolicy.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	err := enforcer.CheckFile("/scripts/cleanup.m")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func newEnforcer(t *testing.T, policy string) *codepolicy.Enforcer {
+	return newEnforcerWithOSLayer(t, &mocks.MockOSLayer{}, policy)
+}
+
+// newEnforcerWithOSLayer returns an enforcer that loads the policy from policyFile through mockOSLayer.
+func newEnforcerWithOSLayer(t *testing.T, mockOSLayer *mocks.MockOSLayer, policy string) *codepolicy.Enforcer {
+	t.Helper()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	mockConfig := &configmocks.MockConfig{}
+	t.Cleanup(func() {
+		mockConfigFactory.AssertExpectations(t)
+		mockConfig.AssertExpectations(t)
+		mockOSLayer.AssertExpectations(t)
+	})
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		CodePolicyFile().
+		Return(policyFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(policyFile).
+		Return([]byte(policy), nil).
+		Once()
+
+	return codepolicy.New(mockConfigFactory, mockOSLayer)
+}
+
+// quoteMATLAB returns the value as a MATLAB character vector literal.
+func quoteMATLAB(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codepolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/codepolicy/tokenizer"
+)
+
+// Rule names match the keys of the policy file, so that users can find the rule that denied their code.
+const (
+	ruleDeniedFunctions = "deniedFunctions"
+	ruleFileAccess      = "fileAccess"
+	ruleMaxCodeLength   = "maxCodeLength"
+)
+
+// shellEscape is the entry of deniedFunctions that denies the "!" operator.
+const shellEscape = "!"
+
+// Policy is the content of a code policy file.
+type Policy struct {
+	// DeniedFunctions lists the functions and commands that the code cannot use.
+	// An entry that ends in ".*", such as "java.*", denies every name in that package.
+	DeniedFunctions []string   `json:"deniedFunctions"`
+	FileAccess      FileAccess `json:"fileAccess"`
+	// MaxCodeLength is the maximum number of characters in the code. Zero means no limit.
+	MaxCodeLength int `json:"maxCodeLength"`
+}
+
+// FileAccess restricts the paths that file functions can use.
+// The first argument of these functions must be a literal path. Absolute paths must be in one of
+// the allowed folders, and relative paths cannot refer to a parent folder.
+type FileAccess struct {
+	Functions      []string `json:"functions"`
+	AllowedFolders []string `json:"allowedFolders"`
+}
+
+type violation struct {
+	rule string
+	// line is the 1-based line of the code that violates the rule, or zero if the rule applies to the whole code.
+	line   int
+	detail string
+}
+
+func parsePolicy(data []byte) (*Policy, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var policy Policy
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, err
+	}
+
+	if policy.MaxCodeLength < 0 {
+		return nil, errors.New("maxCodeLength must be zero or a positive number")
+	}
+
+	for _, name := range slices.Concat(policy.DeniedFunctions, policy.FileAccess.Functions) {
+		if strings.TrimSpace(name) == "" {
+			return nil, errors.New("function names cannot be empty")
+		}
+	}
+
+	for i, folder := range policy.FileAccess.AllowedFolders {
+		if !filepath.IsAbs(folder) {
+			return nil, fmt.Errorf("allowed folder %q must be an absolute path", folder)
+		}
+		policy.FileAccess.AllowedFolders[i] = filepath.Clean(folder)
+	}
+
+	return &policy, nil
+}
+
+func (p *Policy) check(code string) *violation {
+	if p.MaxCodeLength > 0 {
+		if length := utf8.RuneCountInString(code); length > p.MaxCodeLength {
+			return &violation{
+				rule:   ruleMaxCodeLength,
+				detail: fmt.Sprintf("the code has %d characters, which is more than the limit of %d", length, p.MaxCodeLength),
+			}
+		}
+	}
+
+	tokens := tokenizer.Tokenize(code)
+	for i, token := range tokens {
+		switch token.Kind {
+		case tokenizer.ShellEscape:
+			if slices.Contains(p.DeniedFunctions, shellEscape) {
+				return &violation{rule: ruleDeniedFunctions, line: token.Line, detail: "shell escapes (!) are not allowed"}
+			}
+		case tokenizer.Identifier:
+			if p.isDenied(token.Text) {
+				return &violation{rule: ruleDeniedFunctions, line: token.Line, detail: fmt.Sprintf("%s is not allowed", token.Text)}
+			}
+			if slices.Contains(p.FileAccess.Functions, token.Text) {
+				if v := p.checkFileAccess(tokens, i); v != nil {
+					return v
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) isDenied(name string) bool {
+	for _, pattern := range p.DeniedFunctions {
+		if prefix, isPackage := strings.CutSuffix(pattern, ".*"); isPackage {
+			if name == prefix || strings.HasPrefix(name, prefix+".") {
+				return true
+			}
+		} else if name == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFileAccess checks the arguments of the file function at tokens[index].
+func (p *Policy) checkFileAccess(tokens []tokenizer.Token, index int) *violation {
+	function := tokens[index]
+
+	if index > 0 && tokens[index-1].Kind == tokenizer.Operator && tokens[index-1].Text == "@" {
+		return &violation{rule: ruleFileAccess, line: function.Line, detail: fmt.Sprintf("%s cannot be used as a function handle", function.Text)}
+	}
+
+	arguments, literals := callArguments(tokens, index)
+	if len(arguments) == 0 {
+		return nil
+	}
+
+	if !literals[0] {
+		return &violation{rule: ruleFileAccess, line: function.Line, detail: fmt.Sprintf("the first argument of %s must be a literal path", function.Text)}
+	}
+
+	for i, argument := range arguments {
+		if literals[i] && !p.isAllowedPath(argument) {
+			return &violation{rule: ruleFileAccess, line: function.Line, detail: fmt.Sprintf("%s cannot access %q", function.Text, argument)}
+		}
+	}
+
+	return nil
+}
+
+// callArguments returns the arguments of the function at tokens[index], called either with command syntax or
+// with parentheses. For each argument, it reports whether the argument is a literal, in which case the
+// argument is the text of the literal.
+func callArguments(tokens []tokenizer.Token, index int) ([]string, []bool) {
+	var arguments []string
+	var literals []bool
+
+	next := index + 1
+	if next < len(tokens) && tokens[next].Kind == tokenizer.CommandArgument {
+		for ; next < len(tokens) && tokens[next].Kind == tokenizer.CommandArgument; next++ {
+			arguments = append(arguments, tokens[next].Text)
+			literals = append(literals, true)
+		}
+		return arguments, literals
+	}
+
+	if next >= len(tokens) || tokens[next].Kind != tokenizer.Operator || tokens[next].Text != "(" {
+		return nil, nil
+	}
+
+	depth := 0
+	var current []tokenizer.Token
+	addArgument := func() {
+		if len(current) == 1 && current[0].Kind == tokenizer.String {
+			arguments = append(arguments, current[0].Text)
+			literals = append(literals, true)
+		} else if len(current) > 0 {
+			arguments = append(arguments, "")
+			literals = append(literals, false)
+		}
+		current = nil
+	}
+
+	for _, token := range tokens[next+1:] {
+		if token.Kind == tokenizer.Operator {
+			switch token.Text {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				if depth == 0 {
+					addArgument()
+					return arguments, literals
+				}
+				depth--
+			case ",":
+				if depth == 0 {
+					addArgument()
+					continue
+				}
+			}
+		}
+		current = append(current, token)
+	}
+
+	// The call is not closed, so the arguments are incomplete
+	addArgument()
+	return arguments, literals
+}
+
+func (p *Policy) isAllowedPath(path string) bool {
+	// URLs and home folder references can point anywhere
+	if strings.Contains(path, "://") || strings.HasPrefix(path, "~") {
+		return false
+	}
+
+	if !isAbsolutePath(path) {
+		segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '\\' })
+		return !slices.Contains(segments, "..")
+	}
+
+	cleanedPath := filepath.Clean(path)
+	for _, folder := range p.FileAccess.AllowedFolders {
+		relativePath, err := filepath.Rel(folder, cleanedPath)
+		if err == nil && relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAbsolutePath reports whether the path is absolute on any platform, so that paths
+// such as "/etc" are not treated as relative on Windows, nor "C:\Windows" on Linux.
+func isAbsolutePath(path string) bool {
+	if filepath.IsAbs(path) || strings.HasPrefix(path, "/") || strings.HasPrefix(path, `\`) {
+		return true
+	}
+	return len(path) >= 2 && path[1] == ':' && ((path[0] >= 'a' && path[0] <= 'z') || (path[0] >= 'A' && path[0] <= 'Z'))
+}
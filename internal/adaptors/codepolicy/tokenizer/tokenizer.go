@@ -0,0 +1,442 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package tokenizer splits MATLAB code into the tokens that a code policy needs to inspect.
+// It understands comments, strings, the transpose operator, shell escapes, and command syntax,
+// but it is not a parser: it does not check that the code is valid MATLAB.
+package tokenizer
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+type Kind int
+
+const (
+	// Identifier is a name, such as a variable, a function, or a qualified name such as java.lang.System.
+	Identifier Kind = iota
+	// Field is a name that follows a dot after an expression, such as the method in obj(1).delete.
+	Field
+	Keyword
+	Number
+	// String is a character vector or string literal. The text excludes the quotes.
+	String
+	// CommandArgument is an argument of a function called with command syntax, such as foo.txt in "delete foo.txt".
+	CommandArgument
+	// ShellEscape is the "!" operator. The text is the rest of the line, which MATLAB runs in the system shell.
+	ShellEscape
+	Operator
+)
+
+type Token struct {
+	Kind Kind
+	Text string
+	// Line is the 1-based line on which the token starts.
+	Line int
+}
+
+const (
+	commandSyntaxOperators = "+-*/\\^<>&|~=:.@"
+	numberOperators        = "*/\\^'"
+)
+
+// Tokenize returns the tokens of the code, skipping whitespace and comments.
+func Tokenize(code string) []Token {
+	t := &tokenizer{
+		src:            []rune(strings.ReplaceAll(code, "\r\n", "\n")),
+		line:           1,
+		statementStart: true,
+	}
+	t.run()
+	return t.tokens
+}
+
+type tokenizer struct {
+	src  []rune
+	pos  int
+	line int
+
+	tokens []Token
+	// depth is the nesting level of parentheses, brackets, and braces.
+	depth          int
+	statementStart bool
+	// spaceBefore reports whether whitespace separates the current position from the previous token.
+	spaceBefore bool
+}
+
+func (t *tokenizer) run() {
+	for t.pos < len(t.src) {
+		c := t.src[t.pos]
+
+		switch {
+		case c == '\n':
+			t.pos++
+			t.line++
+			t.spaceBefore = true
+			if t.depth == 0 {
+				t.statementStart = true
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			t.pos++
+			t.spaceBefore = true
+		case c == '%':
+			if t.atBlockCommentStart() {
+				t.skipBlockComment()
+				continue
+			}
+			t.skipToEndOfLine()
+		case c == '.' && t.hasPrefix("..."):
+			// A continuation: the rest of the line is a comment and the statement continues on the next line
+			t.skipToEndOfLine()
+			if t.pos < len(t.src) {
+				t.pos++
+				t.line++
+			}
+			t.spaceBefore = true
+		case c == '!' && t.peek(1) != '=':
+			t.readShellEscape()
+		case c == '"':
+			t.readString('"')
+		case c == '\'':
+			if t.isTranspose() {
+				t.emit(Operator, "'")
+				t.pos++
+			} else {
+				t.readString('\'')
+			}
+		case isIdentifierStart(c):
+			t.readName()
+		case unicode.IsDigit(c) || (c == '.' && unicode.IsDigit(t.peek(1))):
+			t.readNumber()
+		default:
+			t.readOperator()
+		}
+	}
+}
+
+func (t *tokenizer) emit(kind Kind, text string) {
+	t.tokens = append(t.tokens, Token{Kind: kind, Text: text, Line: t.line})
+	t.spaceBefore = false
+	t.statementStart = false
+}
+
+func (t *tokenizer) peek(offset int) rune {
+	if t.pos+offset >= len(t.src) {
+		return 0
+	}
+	return t.src[t.pos+offset]
+}
+
+func (t *tokenizer) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(t.src[t.pos:min(t.pos+len(prefix), len(t.src))]), prefix)
+}
+
+func (t *tokenizer) currentLine() (int, int) {
+	start := t.pos
+	for start > 0 && t.src[start-1] != '\n' {
+		start--
+	}
+	end := t.pos
+	for end < len(t.src) && t.src[end] != '\n' {
+		end++
+	}
+	return start, end
+}
+
+// atBlockCommentStart reports whether the line at the current position contains only "%{".
+// Block comments must be on lines of their own, otherwise "%{" starts a line comment.
+func (t *tokenizer) atBlockCommentStart() bool {
+	start, end := t.currentLine()
+	return strings.TrimSpace(string(t.src[start:end])) == "%{"
+}
+
+// skipBlockComment skips a block comment, including nested block comments,
+// and stops at the end of the line that closes it.
+func (t *tokenizer) skipBlockComment() {
+	nesting := 0
+	for t.pos < len(t.src) {
+		start, end := t.currentLine()
+		switch strings.TrimSpace(string(t.src[start:end])) {
+		case "%{":
+			nesting++
+		case "%}":
+			nesting--
+		}
+
+		t.pos = end
+		if nesting == 0 {
+			return
+		}
+		if t.pos < len(t.src) {
+			t.pos++
+			t.line++
+		}
+	}
+}
+
+func (t *tokenizer) skipToEndOfLine() {
+	for t.pos < len(t.src) && t.src[t.pos] != '\n' {
+		t.pos++
+	}
+}
+
+func (t *tokenizer) readShellEscape() {
+	t.pos++
+	start := t.pos
+	t.skipToEndOfLine()
+	t.emit(ShellEscape, strings.TrimSpace(string(t.src[start:t.pos])))
+}
+
+// isTranspose reports whether a single quote at the current position is the transpose operator
+// rather than the start of a character vector.
+func (t *tokenizer) isTranspose() bool {
+	if t.spaceBefore || len(t.tokens) == 0 {
+		return false
+	}
+
+	previous := t.tokens[len(t.tokens)-1]
+	switch previous.Kind {
+	case Identifier, Field, Number, String:
+		return true
+	case Keyword:
+		return previous.Text == "end"
+	case Operator:
+		return slices.Contains([]string{")", "]", "}", "'", ".'"}, previous.Text)
+	default:
+		return false
+	}
+}
+
+// readString reads a string delimited by quote, where two quotes in a row stand for one quote.
+// An unterminated string ends at the end of the line.
+func (t *tokenizer) readString(quote rune) {
+	line := t.line
+	t.pos++
+
+	var text strings.Builder
+	for t.pos < len(t.src) && t.src[t.pos] != '\n' {
+		if t.src[t.pos] == quote {
+			if t.peek(1) != quote {
+				t.pos++
+				break
+			}
+			t.pos++
+		}
+		text.WriteRune(t.src[t.pos])
+		t.pos++
+	}
+
+	t.emit(String, text.String())
+	t.tokens[len(t.tokens)-1].Line = line
+}
+
+func (t *tokenizer) readName() {
+	isField := len(t.tokens) > 0 && !t.spaceBefore && t.tokens[len(t.tokens)-1].Kind == Operator && t.tokens[len(t.tokens)-1].Text == "."
+	statementStart := t.statementStart
+
+	start := t.pos
+	t.skipIdentifier()
+	// Read qualified names, such as java.lang.System or py.os.system, as a single name
+	for t.peek(0) == '.' && isIdentifierStart(t.peek(1)) {
+		t.pos++
+		t.skipIdentifier()
+	}
+	name := string(t.src[start:t.pos])
+
+	switch {
+	case isField:
+		t.emit(Field, name)
+	case isKeyword(name):
+		t.emit(Keyword, name)
+		t.statementStart = statementStart && isStatementKeyword(name)
+	default:
+		t.emit(Identifier, name)
+		if statementStart && t.depth == 0 {
+			t.readCommandArguments()
+		}
+	}
+}
+
+func (t *tokenizer) skipIdentifier() {
+	for t.pos < len(t.src) && isIdentifierPart(t.src[t.pos]) {
+		t.pos++
+	}
+}
+
+// readCommandArguments reads the arguments of a function called with command syntax, as in "delete foo.txt".
+// MATLAB uses command syntax when a name at the start of a statement is followed by whitespace and
+// anything other than an assignment, an opening parenthesis, or an operator followed by whitespace.
+func (t *tokenizer) readCommandArguments() {
+	next := t.pos
+	for next < len(t.src) && (t.src[next] == ' ' || t.src[next] == '\t') {
+		next++
+	}
+	if next == t.pos || next == len(t.src) {
+		return
+	}
+
+	c := t.src[next]
+	if strings.ContainsRune("\n\r;,%(", c) {
+		return
+	}
+	if c == '=' && (next+1 == len(t.src) || t.src[next+1] != '=') {
+		return
+	}
+
+	ambiguous := strings.ContainsRune(commandSyntaxOperators, c)
+	if ambiguous {
+		operatorEnd := next + 1
+		for operatorEnd < len(t.src) && strings.ContainsRune(commandSyntaxOperators, t.src[operatorEnd]) {
+			operatorEnd++
+		}
+		if operatorEnd == len(t.src) || unicode.IsSpace(t.src[operatorEnd]) {
+			return
+		}
+	}
+
+	end := next
+	for _, argument := range splitCommandArguments(t.src, &end) {
+		t.emit(CommandArgument, argument)
+	}
+
+	if ambiguous {
+		// If the name is a variable, as in "x -system('ls')", MATLAB evaluates the line as an expression instead,
+		// so the rest of the line is read as code as well
+		t.spaceBefore = true
+		return
+	}
+	t.pos = end
+}
+
+// splitCommandArguments splits command syntax arguments at whitespace, starting at *pos.
+// Quoted parts can contain whitespace. The arguments end at a newline, or at a semicolon,
+// comma, or percent sign outside quotes, and *pos is left there.
+func splitCommandArguments(src []rune, pos *int) []string {
+	var arguments []string
+	var current strings.Builder
+	inArgument := false
+	inQuotes := false
+
+	for ; *pos < len(src); *pos++ {
+		c := src[*pos]
+		if c == '\n' {
+			break
+		}
+
+		if inQuotes {
+			if c == '\'' {
+				if *pos+1 < len(src) && src[*pos+1] == '\'' {
+					current.WriteRune(c)
+					*pos++
+					continue
+				}
+				inQuotes = false
+				continue
+			}
+			current.WriteRune(c)
+			continue
+		}
+
+		if strings.ContainsRune(";,%", c) {
+			break
+		}
+
+		switch c {
+		case ' ', '\t', '\r':
+			if inArgument {
+				arguments = append(arguments, current.String())
+				current.Reset()
+				inArgument = false
+			}
+		case '\'':
+			inQuotes = true
+			inArgument = true
+		default:
+			current.WriteRune(c)
+			inArgument = true
+		}
+	}
+
+	if inArgument {
+		arguments = append(arguments, current.String())
+	}
+
+	return arguments
+}
+
+func (t *tokenizer) readNumber() {
+	start := t.pos
+	t.skipDigits()
+	if t.peek(0) == '.' && !strings.ContainsRune(numberOperators, t.peek(1)) {
+		t.pos++
+		t.skipDigits()
+	}
+	if (t.peek(0) == 'e' || t.peek(0) == 'E') &&
+		(unicode.IsDigit(t.peek(1)) || ((t.peek(1) == '+' || t.peek(1) == '-') && unicode.IsDigit(t.peek(2)))) {
+		t.pos += 2
+		t.skipDigits()
+	}
+	// Suffixes, such as the imaginary unit in 2i, or hexadecimal and binary literals such as 0xFFu8
+	t.skipIdentifier()
+
+	t.emit(Number, string(t.src[start:t.pos]))
+}
+
+func (t *tokenizer) skipDigits() {
+	for t.pos < len(t.src) && unicode.IsDigit(t.src[t.pos]) {
+		t.pos++
+	}
+}
+
+func (t *tokenizer) readOperator() {
+	c := t.src[t.pos]
+	t.pos++
+
+	if c == '.' && t.peek(0) == '\'' {
+		t.pos++
+		t.emit(Operator, ".'")
+		return
+	}
+
+	switch c {
+	case '(', '[', '{':
+		t.depth++
+	case ')', ']', '}':
+		t.depth = max(t.depth-1, 0)
+	}
+
+	t.emit(Operator, string(c))
+	if t.depth == 0 && (c == ';' || c == ',') {
+		t.statementStart = true
+	}
+}
+
+func isKeyword(name string) bool {
+	switch name {
+	case "break", "case", "catch", "classdef", "continue", "else", "elseif", "end", "for", "function",
+		"global", "if", "otherwise", "parfor", "persistent", "return", "spmd", "switch", "try", "while":
+		return true
+	default:
+		return false
+	}
+}
+
+// isStatementKeyword reports whether a new statement can start after the keyword on the same line,
+// as in "try delete foo.txt, end".
+func isStatementKeyword(name string) bool {
+	switch name {
+	case "else", "end", "otherwise", "try":
+		return true
+	default:
+		return false
+	}
+}
+
+func isIdentifierStart(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c rune) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9') || c == '_'
+}
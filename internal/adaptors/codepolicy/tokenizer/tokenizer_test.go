@@ -0,0 +1,255 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package tokenizer_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/codepolicy/tokenizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected []tokenizer.Token
+	}{
+		{
+			name: "FunctionCall",
+			code: "x = delete('a.txt');",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "delete", Line: 1},
+				{Kind: tokenizer.Operator, Text: "(", Line: 1},
+				{Kind: tokenizer.String, Text: "a.txt", Line: 1},
+				{Kind: tokenizer.Operator, Text: ")", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+			},
+		},
+		{
+			name: "LineComments",
+			code: "x = 1; % system('ls')\n% delete foo\ny",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "y", Line: 3},
+			},
+		},
+		{
+			name: "BlockComment",
+			code: "%{\nsystem('ls')\n  %{\n  nested\n  %}\n%}\nx",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 7},
+			},
+		},
+		{
+			name: "NotABlockComment",
+			code: "x = 1; %{\ny",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "y", Line: 2},
+			},
+		},
+		{
+			name: "Continuation",
+			code: "x = [1, ... system\n2];",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Operator, Text: "[", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ",", Line: 1},
+				{Kind: tokenizer.Number, Text: "2", Line: 2},
+				{Kind: tokenizer.Operator, Text: "]", Line: 2},
+				{Kind: tokenizer.Operator, Text: ";", Line: 2},
+			},
+		},
+		{
+			name: "StringsWithEscapedQuotes",
+			code: `a = 'it''s % not a comment'; b = "say ""system""";`,
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "a", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.String, Text: "it's % not a comment", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "b", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.String, Text: `say "system"`, Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+			},
+		},
+		{
+			name: "Transpose",
+			code: "y = x' + a(1)'.' + [x 'abc'];",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "y", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "'", Line: 1},
+				{Kind: tokenizer.Operator, Text: "+", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "a", Line: 1},
+				{Kind: tokenizer.Operator, Text: "(", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ")", Line: 1},
+				{Kind: tokenizer.Operator, Text: "'", Line: 1},
+				{Kind: tokenizer.Operator, Text: ".'", Line: 1},
+				{Kind: tokenizer.Operator, Text: "+", Line: 1},
+				{Kind: tokenizer.Operator, Text: "[", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.String, Text: "abc", Line: 1},
+				{Kind: tokenizer.Operator, Text: "]", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+			},
+		},
+		{
+			name: "ShellEscape",
+			code: "x = 1;\n!rm -rf /tmp/data % not a comment\ny",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+				{Kind: tokenizer.ShellEscape, Text: "rm -rf /tmp/data % not a comment", Line: 2},
+				{Kind: tokenizer.Identifier, Text: "y", Line: 3},
+			},
+		},
+		{
+			name: "QualifiedNamesAndFields",
+			code: "r = java.lang.Runtime.getRuntime(); s(1).delete",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "r", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "java.lang.Runtime.getRuntime", Line: 1},
+				{Kind: tokenizer.Operator, Text: "(", Line: 1},
+				{Kind: tokenizer.Operator, Text: ")", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "s", Line: 1},
+				{Kind: tokenizer.Operator, Text: "(", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ")", Line: 1},
+				{Kind: tokenizer.Operator, Text: ".", Line: 1},
+				{Kind: tokenizer.Field, Text: "delete", Line: 1},
+			},
+		},
+		{
+			name: "CommandSyntax",
+			code: "delete foo.txt 'my file.txt', disp done % comment",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "delete", Line: 1},
+				{Kind: tokenizer.CommandArgument, Text: "foo.txt", Line: 1},
+				{Kind: tokenizer.CommandArgument, Text: "my file.txt", Line: 1},
+				{Kind: tokenizer.Operator, Text: ",", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "disp", Line: 1},
+				{Kind: tokenizer.CommandArgument, Text: "done", Line: 1},
+			},
+		},
+		{
+			name: "NotCommandSyntax",
+			code: "x = 1\ny == 2\nz (3)",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "y", Line: 2},
+				{Kind: tokenizer.Operator, Text: "=", Line: 2},
+				{Kind: tokenizer.Operator, Text: "=", Line: 2},
+				{Kind: tokenizer.Number, Text: "2", Line: 2},
+				{Kind: tokenizer.Identifier, Text: "z", Line: 3},
+				{Kind: tokenizer.Operator, Text: "(", Line: 3},
+				{Kind: tokenizer.Number, Text: "3", Line: 3},
+				{Kind: tokenizer.Operator, Text: ")", Line: 3},
+			},
+		},
+		{
+			name: "AmbiguousCommandSyntax",
+			code: "x -system('ls')",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.CommandArgument, Text: "-system(ls)", Line: 1},
+				{Kind: tokenizer.Operator, Text: "-", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "system", Line: 1},
+				{Kind: tokenizer.Operator, Text: "(", Line: 1},
+				{Kind: tokenizer.String, Text: "ls", Line: 1},
+				{Kind: tokenizer.Operator, Text: ")", Line: 1},
+			},
+		},
+		{
+			name: "Keywords",
+			code: "for i = 1:2\ntry delete f, end\nend",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Keyword, Text: "for", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "i", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Number, Text: "1", Line: 1},
+				{Kind: tokenizer.Operator, Text: ":", Line: 1},
+				{Kind: tokenizer.Number, Text: "2", Line: 1},
+				{Kind: tokenizer.Keyword, Text: "try", Line: 2},
+				{Kind: tokenizer.Identifier, Text: "delete", Line: 2},
+				{Kind: tokenizer.CommandArgument, Text: "f", Line: 2},
+				{Kind: tokenizer.Operator, Text: ",", Line: 2},
+				{Kind: tokenizer.Keyword, Text: "end", Line: 2},
+				{Kind: tokenizer.Keyword, Text: "end", Line: 3},
+			},
+		},
+		{
+			name: "FunctionHandle",
+			code: "f = @system;",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "f", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Operator, Text: "@", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "system", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+			},
+		},
+		{
+			name: "Numbers",
+			code: "x = [1.5e-3 .5 2i 0x1Fu8 2.^3];",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Operator, Text: "=", Line: 1},
+				{Kind: tokenizer.Operator, Text: "[", Line: 1},
+				{Kind: tokenizer.Number, Text: "1.5e-3", Line: 1},
+				{Kind: tokenizer.Number, Text: ".5", Line: 1},
+				{Kind: tokenizer.Number, Text: "2i", Line: 1},
+				{Kind: tokenizer.Number, Text: "0x1Fu8", Line: 1},
+				{Kind: tokenizer.Number, Text: "2", Line: 1},
+				{Kind: tokenizer.Operator, Text: ".", Line: 1},
+				{Kind: tokenizer.Operator, Text: "^", Line: 1},
+				{Kind: tokenizer.Number, Text: "3", Line: 1},
+				{Kind: tokenizer.Operator, Text: "]", Line: 1},
+				{Kind: tokenizer.Operator, Text: ";", Line: 1},
+			},
+		},
+		{
+			name: "WindowsLineEndings",
+			code: "x\r\ny",
+			expected: []tokenizer.Token{
+				{Kind: tokenizer.Identifier, Text: "x", Line: 1},
+				{Kind: tokenizer.Identifier, Text: "y", Line: 2},
+			},
+		},
+		{
+			name:     "Empty",
+			code:     "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			tokens := tokenizer.Tokenize(tt.code)
+
+			// Assert
+			assert.Equal(t, tt.expected, tokens)
+		})
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package snapshotstore keeps the workspace snapshots of a MATLAB session in its session directory.
+// Each snapshot is a MAT-file, next to a JSON file with its metadata. The snapshots are deleted with the session directory.
+package snapshotstore
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+const (
+	snapshotsFolder = "workspace_snapshots"
+	snapshotExt     = ".mat"
+	metadataExt     = ".json"
+	// MATLAB saves a snapshot to a partial file first, so that a failed save does not replace an existing snapshot.
+	partialSuffix = ".partial"
+
+	defaultNamePrefix    = "snapshot-"
+	defaultNameTimestamp = "20060102-150405"
+
+	folderPermissions = 0o700
+	filePermissions   = 0o600
+)
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	MkdirAll(name string, perm os.FileMode) error
+	ReadDir(name string) ([]os.DirEntry, error)
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Rename(oldPath string, newPath string) error
+	RemoveAll(path string) error
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type metadata struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Variables []string  `json:"variables"`
+}
+
+type Store struct {
+	configFactory ConfigFactory
+	osLayer       OSLayer
+
+	l *sync.Mutex
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+) *Store {
+	return &Store{
+		configFactory: configFactory,
+		osLayer:       osLayer,
+
+		l: new(sync.Mutex),
+	}
+}
+
+// Reserve prepares a new snapshot, and returns its name and the path of the file that MATLAB saves it to.
+// An empty name uses a name made of the current time.
+func (s *Store) Reserve(sessionFolder string, name string, overwrite bool) (string, string, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	folder := filepath.Join(sessionFolder, snapshotsFolder)
+	if err := s.osLayer.MkdirAll(folder, folderPermissions); err != nil {
+		return "", "", fmt.Errorf("failed to create the workspace snapshots folder: %w", err)
+	}
+
+	if name == "" {
+		name = s.defaultName(folder)
+	}
+
+	if !validName.MatchString(name) {
+		return "", "", fmt.Errorf("%w: %q", workspacesnapshot.ErrInvalidSnapshotName, name)
+	}
+
+	if !overwrite && s.exists(snapshotPath(folder, name)) {
+		return "", "", fmt.Errorf("%w: %s", workspacesnapshot.ErrSnapshotExists, name)
+	}
+
+	filePath := partialPath(folder, name)
+
+	// A partial file is left over from a save that did not finish
+	if err := s.osLayer.RemoveAll(filePath); err != nil {
+		return "", "", fmt.Errorf("failed to delete the partial workspace snapshot: %w", err)
+	}
+
+	return name, filePath, nil
+}
+
+// CheckSize checks the size of the workspace in memory before MATLAB saves it, so that a workspace that exceeds
+// the maximum size of a snapshot is refused without writing it.
+func (s *Store) CheckSize(sizeBytes int64) error {
+	cfg, messagesErr := s.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	return checkSize(cfg, "workspace", sizeBytes)
+}
+
+// Commit checks the size of a snapshot that MATLAB saved, and replaces the snapshot with the same name, if any.
+// A snapshot that exceeds the size limits is deleted.
+func (s *Store) Commit(sessionFolder string, name string, variables []string) (workspacesnapshot.Snapshot, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	folder := filepath.Join(sessionFolder, snapshotsFolder)
+	filePath := partialPath(folder, name)
+
+	snapshot, err := s.commit(folder, name, filePath, variables)
+	if err != nil {
+		if removeErr := s.osLayer.RemoveAll(filePath); removeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to delete the partial workspace snapshot: %w", removeErr))
+		}
+		return workspacesnapshot.Snapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// Discard deletes the file of a snapshot that MATLAB failed to save.
+func (s *Store) Discard(sessionFolder string, name string) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	return s.osLayer.RemoveAll(partialPath(filepath.Join(sessionFolder, snapshotsFolder), name))
+}
+
+// Get returns a snapshot and the path of the file that MATLAB loads it from.
+func (s *Store) Get(sessionFolder string, name string) (workspacesnapshot.Snapshot, string, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	folder := filepath.Join(sessionFolder, snapshotsFolder)
+
+	snapshot, err := s.get(folder, name)
+	if err != nil {
+		return workspacesnapshot.Snapshot{}, "", err
+	}
+
+	return snapshot, snapshotPath(folder, name), nil
+}
+
+// List returns the snapshots, oldest first.
+func (s *Store) List(sessionFolder string) ([]workspacesnapshot.Snapshot, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	return s.list(filepath.Join(sessionFolder, snapshotsFolder))
+}
+
+// Delete deletes a snapshot, and returns it.
+func (s *Store) Delete(sessionFolder string, name string) (workspacesnapshot.Snapshot, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	folder := filepath.Join(sessionFolder, snapshotsFolder)
+
+	snapshot, err := s.get(folder, name)
+	if err != nil {
+		return workspacesnapshot.Snapshot{}, err
+	}
+
+	if err := s.osLayer.RemoveAll(snapshotPath(folder, name)); err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to delete workspace snapshot %s: %w", name, err)
+	}
+
+	if err := s.osLayer.RemoveAll(metadataPath(folder, name)); err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to delete the metadata of workspace snapshot %s: %w", name, err)
+	}
+
+	return snapshot, nil
+}
+
+func (s *Store) commit(folder string, name string, filePath string, variables []string) (workspacesnapshot.Snapshot, error) {
+	cfg, messagesErr := s.configFactory.Config()
+	if messagesErr != nil {
+		return workspacesnapshot.Snapshot{}, messagesErr
+	}
+
+	info, err := s.osLayer.Stat(filePath)
+	if err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to find the workspace snapshot that MATLAB saved: %w", err)
+	}
+
+	size := info.Size()
+	if err := checkSize(cfg, "snapshot", size); err != nil {
+		return workspacesnapshot.Snapshot{}, err
+	}
+
+	existing, err := s.list(folder)
+	if err != nil {
+		return workspacesnapshot.Snapshot{}, err
+	}
+
+	total := size
+	for _, snapshot := range existing {
+		// The snapshot replaces the one with the same name
+		if snapshot.Name != name {
+			total += snapshot.SizeBytes
+		}
+	}
+
+	if maxTotalSize := cfg.WorkspaceSnapshotMaxTotalSize(); uint64(total) > maxTotalSize { //nolint:gosec // File sizes are never negative
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("%w: the snapshots would take %d bytes and the maximum is %d bytes", workspacesnapshot.ErrSnapshotLimitReached, total, maxTotalSize)
+	}
+
+	if variables == nil {
+		variables = []string{}
+	}
+
+	meta := metadata{
+		CreatedAt: time.Now().UTC(),
+		Variables: variables,
+	}
+
+	content, err := json.Marshal(meta)
+	if err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to encode the metadata of workspace snapshot %s: %w", name, err)
+	}
+
+	if err := s.osLayer.WriteFile(metadataPath(folder, name), content, filePermissions); err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to write the metadata of workspace snapshot %s: %w", name, err)
+	}
+
+	if err := s.osLayer.Rename(filePath, snapshotPath(folder, name)); err != nil {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to save workspace snapshot %s: %w", name, err)
+	}
+
+	return workspacesnapshot.Snapshot{
+		Name:      name,
+		CreatedAt: meta.CreatedAt,
+		SizeBytes: size,
+		Variables: meta.Variables,
+	}, nil
+}
+
+func (s *Store) get(folder string, name string) (workspacesnapshot.Snapshot, error) {
+	if !validName.MatchString(name) {
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("%w: %q", workspacesnapshot.ErrInvalidSnapshotName, name)
+	}
+
+	info, err := s.osLayer.Stat(snapshotPath(folder, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return workspacesnapshot.Snapshot{}, fmt.Errorf("%w: %s", workspacesnapshot.ErrSnapshotNotFound, name)
+		}
+		return workspacesnapshot.Snapshot{}, fmt.Errorf("failed to read workspace snapshot %s: %w", name, err)
+	}
+
+	return s.describe(folder, name, info), nil
+}
+
+func (s *Store) list(folder string) ([]workspacesnapshot.Snapshot, error) {
+	entries, err := s.osLayer.ReadDir(folder)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []workspacesnapshot.Snapshot{}, nil
+		}
+		return nil, fmt.Errorf("failed to list the workspace snapshots: %w", err)
+	}
+
+	snapshots := []workspacesnapshot.Snapshot{}
+	for _, entry := range entries {
+		name, isSnapshot := strings.CutSuffix(entry.Name(), snapshotExt)
+		if !isSnapshot || entry.IsDir() || !validName.MatchString(name) {
+			continue
+		}
+
+		info, err := s.osLayer.Stat(snapshotPath(folder, name))
+		if err != nil {
+			// The snapshot was deleted since the folder was read
+			continue
+		}
+
+		snapshots = append(snapshots, s.describe(folder, name, info))
+	}
+
+	slices.SortFunc(snapshots, func(a, b workspacesnapshot.Snapshot) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Name, b.Name))
+	})
+
+	return snapshots, nil
+}
+
+// describe returns a snapshot from its file and its metadata.
+// Without metadata, the snapshot dates from the last change of its file, and its variables are unknown.
+func (s *Store) describe(folder string, name string, info osfacade.FileInfo) workspacesnapshot.Snapshot {
+	snapshot := workspacesnapshot.Snapshot{
+		Name:      name,
+		CreatedAt: info.ModTime().UTC(),
+		SizeBytes: info.Size(),
+		Variables: []string{},
+	}
+
+	content, err := s.osLayer.ReadFile(metadataPath(folder, name))
+	if err != nil {
+		return snapshot
+	}
+
+	var meta metadata
+	if err := json.Unmarshal(content, &meta); err != nil {
+		return snapshot
+	}
+
+	snapshot.CreatedAt = meta.CreatedAt
+	if meta.Variables != nil {
+		snapshot.Variables = meta.Variables
+	}
+
+	return snapshot
+}
+
+// defaultName returns a name made of the current time, with a number if a snapshot already has that name.
+func (s *Store) defaultName(folder string) string {
+	base := defaultNamePrefix + time.Now().UTC().Format(defaultNameTimestamp)
+
+	name := base
+	for i := 2; s.exists(snapshotPath(folder, name)); i++ {
+		name = base + "-" + strconv.Itoa(i)
+	}
+
+	return name
+}
+
+func (s *Store) exists(filePath string) bool {
+	_, err := s.osLayer.Stat(filePath)
+	return err == nil
+}
+
+// checkSize checks that the size of a snapshot, or of the workspace it is saved from, does not exceed the maximum size.
+func checkSize(cfg config.Config, what string, size int64) error {
+	if maxSize := cfg.WorkspaceSnapshotMaxSize(); uint64(size) > maxSize { //nolint:gosec // Sizes are never negative
+		return fmt.Errorf("%w: the %s is %d bytes and the maximum is %d bytes", workspacesnapshot.ErrSnapshotTooLarge, what, size, maxSize)
+	}
+
+	return nil
+}
+
+func snapshotPath(folder string, name string) string {
+	return filepath.Join(folder, name+snapshotExt)
+}
+
+func partialPath(folder string, name string) string {
+	return filepath.Join(folder, name+partialSuffix+snapshotExt)
+}
+
+func metadataPath(folder string, name string) string {
+	return filepath.Join(folder, name+metadataExt)
+}
@@ -0,0 +1,414 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package snapshotstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/filesystem/snapshotstore"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/filesystem/snapshotstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultMaxSize      = 1 << 20
+	defaultMaxTotalSize = 4 << 20
+)
+
+func newStore(t *testing.T) (*snapshotstore.Store, *mocks.MockConfigFactory) {
+	t.Helper()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	t.Cleanup(func() { mockConfigFactory.AssertExpectations(t) })
+
+	return snapshotstore.New(mockConfigFactory, osfacade.New()), mockConfigFactory
+}
+
+// expectConfig expects a commit to read the size limits. A commit that fails the size check does not read the total size limit.
+func expectConfig(t *testing.T, mockConfigFactory *mocks.MockConfigFactory, maxSize uint64, maxTotalSize uint64, readsTotal bool) {
+	t.Helper()
+
+	mockConfig := &configmocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		WorkspaceSnapshotMaxSize().
+		Return(maxSize).
+		Once()
+
+	if !readsTotal {
+		return
+	}
+
+	mockConfig.EXPECT().
+		WorkspaceSnapshotMaxTotalSize().
+		Return(maxTotalSize).
+		Once()
+}
+
+// saveSnapshot reserves a snapshot, writes its file the way MATLAB does, and commits it.
+func saveSnapshot(t *testing.T, store *snapshotstore.Store, mockConfigFactory *mocks.MockConfigFactory, sessionFolder string, name string, size int, variables []string) workspacesnapshot.Snapshot {
+	t.Helper()
+
+	expectConfig(t, mockConfigFactory, defaultMaxSize, defaultMaxTotalSize, true)
+
+	name, filePath, err := store.Reserve(sessionFolder, name, true)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, size), 0o600))
+
+	snapshot, err := store.Commit(sessionFolder, name, variables)
+	require.NoError(t, err)
+
+	return snapshot
+}
+
+func snapshotFiles(t *testing.T, sessionFolder string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(filepath.Join(sessionFolder, "workspace_snapshots"))
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	return names
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	store := snapshotstore.New(mockConfigFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, store)
+}
+
+func TestStore_ReserveAndCommit_HappyPath(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	expectConfig(t, mockConfigFactory, defaultMaxSize, defaultMaxTotalSize, true)
+
+	before := time.Now().UTC()
+
+	// Act
+	name, filePath, err := store.Reserve(sessionFolder, "before-fit", false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, 2048), 0o600))
+
+	snapshot, err := store.Commit(sessionFolder, name, []string{"x", "y"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "before-fit", name)
+	assert.Equal(t, filepath.Join(sessionFolder, "workspace_snapshots"), filepath.Dir(filePath), "MATLAB should save the snapshot in the session directory")
+	assert.Equal(t, "before-fit", snapshot.Name)
+	assert.Equal(t, int64(2048), snapshot.SizeBytes)
+	assert.Equal(t, []string{"x", "y"}, snapshot.Variables)
+	assert.False(t, snapshot.CreatedAt.Before(before))
+	assert.ElementsMatch(t, []string{"before-fit.mat", "before-fit.json"}, snapshotFiles(t, sessionFolder))
+}
+
+func TestStore_Reserve_DefaultName(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	first := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "", 16, nil)
+
+	// Act
+	name, _, err := store.Reserve(sessionFolder, "", false)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(first.Name, "snapshot-"), "The default name should start with snapshot-")
+	assert.True(t, strings.HasPrefix(name, "snapshot-"), "The default name should start with snapshot-")
+	assert.NotEqual(t, first.Name, name, "The default name should not reuse the name of an existing snapshot")
+}
+
+func TestStore_Reserve_InvalidName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		snapshotName string
+	}{
+		{name: "path separator", snapshotName: "../escape"},
+		{name: "dot", snapshotName: "before.fit"},
+		{name: "leading hyphen", snapshotName: "-before-fit"},
+		{name: "space", snapshotName: "before fit"},
+		{name: "too long", snapshotName: strings.Repeat("a", 65)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			store, _ := newStore(t)
+
+			// Act
+			_, _, err := store.Reserve(t.TempDir(), tc.snapshotName, false)
+
+			// Assert
+			require.ErrorIs(t, err, workspacesnapshot.ErrInvalidSnapshotName)
+		})
+	}
+}
+
+func TestStore_Reserve_ExistingSnapshot(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 16, []string{"x"})
+
+	// Act
+	_, _, err := store.Reserve(sessionFolder, "before-fit", false)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotExists)
+}
+
+func TestStore_Commit_OverwritesSnapshot(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 16, []string{"x"})
+
+	// Act
+	snapshot := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 32, []string{"x", "y"})
+
+	// Assert
+	snapshots, err := store.List(sessionFolder)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, snapshot, snapshots[0])
+	assert.Equal(t, int64(32), snapshots[0].SizeBytes)
+	assert.Equal(t, []string{"x", "y"}, snapshots[0].Variables)
+}
+
+func TestStore_Commit_SnapshotTooLarge(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 16, []string{"x"})
+	expectConfig(t, mockConfigFactory, 64, defaultMaxTotalSize, false)
+
+	name, filePath, err := store.Reserve(sessionFolder, "before-fit", true)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, 128), 0o600))
+
+	// Act
+	_, err = store.Commit(sessionFolder, name, []string{"x", "bigMatrix"})
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotTooLarge)
+	assert.ElementsMatch(t, []string{"before-fit.mat", "before-fit.json"}, snapshotFiles(t, sessionFolder), "The snapshot that is too large should be deleted and the existing snapshot kept")
+
+	snapshot, _, err := store.Get(sessionFolder, "before-fit")
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), snapshot.SizeBytes)
+}
+
+func TestStore_Commit_TotalSizeLimitReached(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "first", 64, nil)
+	expectConfig(t, mockConfigFactory, defaultMaxSize, 100, true)
+
+	name, filePath, err := store.Reserve(sessionFolder, "second", false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, 64), 0o600))
+
+	// Act
+	_, err = store.Commit(sessionFolder, name, nil)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotLimitReached)
+	assert.ElementsMatch(t, []string{"first.mat", "first.json"}, snapshotFiles(t, sessionFolder))
+}
+
+func TestStore_Commit_TotalSizeExcludesReplacedSnapshot(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "first", 64, nil)
+	expectConfig(t, mockConfigFactory, defaultMaxSize, 100, true)
+
+	name, filePath, err := store.Reserve(sessionFolder, "first", true)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, make([]byte, 80), 0o600))
+
+	// Act
+	snapshot, err := store.Commit(sessionFolder, name, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), snapshot.SizeBytes)
+	assert.Equal(t, []string{}, snapshot.Variables)
+}
+
+func TestStore_CheckSize_HappyPath(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	expectConfig(t, mockConfigFactory, 64, defaultMaxTotalSize, false)
+
+	// Act
+	err := store.CheckSize(64)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestStore_CheckSize_WorkspaceTooLarge(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	expectConfig(t, mockConfigFactory, 64, defaultMaxTotalSize, false)
+
+	// Act
+	err := store.CheckSize(65)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotTooLarge)
+	assert.Contains(t, err.Error(), "the workspace is 65 bytes")
+}
+
+func TestStore_Discard_HappyPath(t *testing.T) {
+	// Arrange
+	store, _ := newStore(t)
+	sessionFolder := t.TempDir()
+
+	name, filePath, err := store.Reserve(sessionFolder, "before-fit", false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, []byte("partial"), 0o600))
+
+	// Act
+	err = store.Discard(sessionFolder, name)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, snapshotFiles(t, sessionFolder))
+}
+
+func TestStore_Get_HappyPath(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	expectedSnapshot := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 16, []string{"x"})
+
+	// Act
+	snapshot, filePath, err := store.Get(sessionFolder, "before-fit")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSnapshot, snapshot)
+	assert.Equal(t, filepath.Join(sessionFolder, "workspace_snapshots", "before-fit.mat"), filePath)
+}
+
+func TestStore_Get_NotFound(t *testing.T) {
+	// Arrange
+	store, _ := newStore(t)
+
+	// Act
+	_, _, err := store.Get(t.TempDir(), "missing")
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotNotFound)
+}
+
+func TestStore_List_NoSnapshots(t *testing.T) {
+	// Arrange
+	store, _ := newStore(t)
+
+	// Act
+	snapshots, err := store.List(t.TempDir())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+	assert.NotNil(t, snapshots)
+}
+
+func TestStore_List_OldestFirstAndSkipsOtherFiles(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	first := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "zeta", 16, []string{"x"})
+	second := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "alpha", 32, []string{"y"})
+
+	folder := filepath.Join(sessionFolder, "workspace_snapshots")
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "leftover.partial.mat"), []byte("partial"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "notes.txt"), []byte("notes"), 0o600))
+
+	// Act
+	snapshots, err := store.List(sessionFolder)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []workspacesnapshot.Snapshot{first, second}, snapshots)
+}
+
+func TestStore_List_SnapshotWithoutMetadata(t *testing.T) {
+	// Arrange
+	store, _ := newStore(t)
+	sessionFolder := t.TempDir()
+
+	folder := filepath.Join(sessionFolder, "workspace_snapshots")
+	require.NoError(t, os.MkdirAll(folder, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "manual.mat"), make([]byte, 8), 0o600))
+
+	// Act
+	snapshots, err := store.List(sessionFolder)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "manual", snapshots[0].Name)
+	assert.Equal(t, int64(8), snapshots[0].SizeBytes)
+	assert.Equal(t, []string{}, snapshots[0].Variables)
+	assert.False(t, snapshots[0].CreatedAt.IsZero(), "The snapshot should date from the last change of its file")
+}
+
+func TestStore_Delete_HappyPath(t *testing.T) {
+	// Arrange
+	store, mockConfigFactory := newStore(t)
+	sessionFolder := t.TempDir()
+	expectedSnapshot := saveSnapshot(t, store, mockConfigFactory, sessionFolder, "before-fit", 16, []string{"x"})
+	saveSnapshot(t, store, mockConfigFactory, sessionFolder, "after-fit", 16, []string{"x"})
+
+	// Act
+	snapshot, err := store.Delete(sessionFolder, "before-fit")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSnapshot, snapshot)
+	assert.ElementsMatch(t, []string{"after-fit.mat", "after-fit.json"}, snapshotFiles(t, sessionFolder))
+}
+
+func TestStore_Delete_NotFound(t *testing.T) {
+	// Arrange
+	store, _ := newStore(t)
+
+	// Act
+	_, err := store.Delete(t.TempDir(), "missing")
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotNotFound)
+}
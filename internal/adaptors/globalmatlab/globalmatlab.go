@@ -8,6 +8,8 @@ import (
 	"sync"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 )
@@ -21,6 +23,8 @@ type MATLABManagerAdaptor interface {
 	ShouldRestart() (bool, messages.Error)
 	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	IsAttachedSession(sessionID entities.SessionID) bool
 }
 
 type GlobalMATLAB struct {
@@ -53,6 +57,54 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 	return g.getOrCreateClient(ctx, logger)
 }
 
+// IsAttachedToExistingSession reports whether the global MATLAB session is an existing MATLAB session that the server attached to.
+// It is false until the global MATLAB session starts.
+func (g *GlobalMATLAB) IsAttachedToExistingSession() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+	if g.sessionID == sessionIDZeroValue {
+		return false
+	}
+
+	return g.matlabManagerAdaptor.IsAttachedSession(g.sessionID)
+}
+
+// SessionID returns the ID of the global MATLAB session. ok is false until MATLAB starts, and after it stops.
+// A session that MATLAB starts again gets a new ID.
+func (g *GlobalMATLAB) SessionID() (entities.SessionID, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+	return g.sessionID, g.sessionID != sessionIDZeroValue
+}
+
+// BackgroundClient returns a client of the global MATLAB session for work the user did not ask for, such as completions.
+// Unlike Client, it never starts MATLAB, so ok is false until a tool call starts MATLAB, and after MATLAB stops.
+// The calls of the client fail instead of waiting behind tool calls, and do not keep an idle session running.
+func (g *GlobalMATLAB) BackgroundClient(logger entities.Logger) (entities.MATLABSessionClient, bool) {
+	// The lock is held while MATLAB starts, so do not wait for it
+	if !g.lock.TryLock() {
+		return nil, false
+	}
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+	if g.sessionID == sessionIDZeroValue {
+		return nil, false
+	}
+
+	client, err := g.matlabManagerAdaptor.GetBackgroundMATLABSessionClient(g.sessionID)
+	if err != nil {
+		logger.WithError(err).Debug("global MATLAB session is not available for background work")
+		return nil, false
+	}
+
+	return client, true
+}
+
 func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
 	var sessionIDZeroValue entities.SessionID
 
@@ -67,23 +119,40 @@ func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Lo
 
 	// Try to get the client
 	client, err := g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
-	if err != nil {
-		// Retry: stop old session and start a new one
-		if stopErr := g.matlabManagerAdaptor.StopMATLABSession(ctx, logger, g.sessionID); stopErr != nil {
-			logger.WithError(stopErr).Warn("failed to stop MATLAB session")
-		}
+	if err == nil {
+		return client, nil
+	}
 
-		sessionID, err := g.restartMATLABSession(ctx, logger)
-		if err != nil {
-			g.sessionID = sessionIDZeroValue
-			return nil, err
-		}
-		g.sessionID = sessionID
+	switch {
+	case errors.Is(err, matlabmanager.ErrMATLABSessionExited):
+		// Report that MATLAB exited, for example after exceeding its memory limit, rather than silently starting it again.
+		// The next call starts a new session.
+		g.stopMATLABSession(ctx, logger)
+		g.sessionID = sessionIDZeroValue
+		return nil, err
+	case errors.Is(err, matlabsessionstore.ErrSessionStoppedWhileIdle):
+		// The session is already stopped, forget it and start MATLAB again now that it is needed
+		logger.Info("Starting MATLAB again after it was stopped while idle")
+		g.stopMATLABSession(ctx, logger)
+	default:
+		// Retry: stop old session and start a new one
+		g.stopMATLABSession(ctx, logger)
+	}
 
-		return g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+	sessionID, err := g.restartMATLABSession(ctx, logger)
+	if err != nil {
+		g.sessionID = sessionIDZeroValue
+		return nil, err
 	}
+	g.sessionID = sessionID
 
-	return client, nil
+	return g.matlabManagerAdaptor.GetMATLABSessionClient(ctx, logger, g.sessionID)
+}
+
+func (g *GlobalMATLAB) stopMATLABSession(ctx context.Context, logger entities.Logger) {
+	if stopErr := g.matlabManagerAdaptor.StopMATLABSession(ctx, logger, g.sessionID); stopErr != nil {
+		logger.WithError(stopErr).Warn("failed to stop MATLAB session")
+	}
 }
 
 func (g *GlobalMATLAB) restartMATLABSession(ctx context.Context, logger entities.Logger) (entities.SessionID, error) {
@@ -3,11 +3,15 @@
 package globalmatlab_test
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
@@ -684,3 +688,376 @@ func TestGlobalMATLAB_Client_ShouldRestartError(t *testing.T) {
 	require.ErrorIs(t, err, messages.AnError)
 	require.Nil(t, client)
 }
+
+func TestGlobalMATLAB_Client_SessionStoppedWhileIdle_ForgetsSessionAndStartsAgain(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil, fmt.Errorf("%w: idle", matlabsessionstore.ErrSessionStoppedWhileIdle)).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		ShouldRestart().
+		Return(true, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	// Act
+	client, err := globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_Client_SessionExited_ReturnsErrorThenStartsNewSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil, fmt.Errorf("%w: out of memory", matlabmanager.ErrMATLABSessionExited)).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	// Act
+	_, firstErr := globalMATLAB.Client(ctx, mockLogger)
+	client, secondErr := globalMATLAB.Client(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, firstErr, matlabmanager.ErrMATLABSessionExited)
+	require.NoError(t, secondErr)
+	require.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_IsAttachedToExistingSession_BeforeSessionStarts(t *testing.T) {
+	// Arrange
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	// Act
+	isAttached := globalMATLAB.IsAttachedToExistingSession()
+
+	// Assert
+	require.False(t, isAttached, "No session should be reported as attached before one starts")
+}
+
+func TestGlobalMATLAB_IsAttachedToExistingSession_AfterSessionStarts(t *testing.T) {
+	testCases := []struct {
+		name       string
+		isAttached bool
+	}{
+		{name: "attached session", isAttached: true},
+		{name: "started session", isAttached: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+			defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+			mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockSessionClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			expectedSessionID := entities.SessionID(123)
+
+			mockMATLABManagerAdaptor.EXPECT().
+				StartSession(ctx, mockLogger.AsMockArg()).
+				Return(expectedSessionID, nil).
+				Once()
+
+			mockMATLABManagerAdaptor.EXPECT().
+				GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+				Return(mockSessionClient, nil).
+				Once()
+
+			mockMATLABManagerAdaptor.EXPECT().
+				IsAttachedSession(expectedSessionID).
+				Return(tc.isAttached).
+				Once()
+
+			globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+			_, err := globalMATLAB.Client(ctx, mockLogger)
+			require.NoError(t, err)
+
+			// Act
+			isAttached := globalMATLAB.IsAttachedToExistingSession()
+
+			// Assert
+			require.Equal(t, tc.isAttached, isAttached)
+		})
+	}
+}
+
+func TestGlobalMATLAB_SessionID_BeforeSessionStarts(t *testing.T) {
+	// Arrange
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	// Act
+	sessionID, ok := globalMATLAB.SessionID()
+
+	// Assert
+	require.False(t, ok, "There should be no session ID before MATLAB starts")
+	assert.Zero(t, sessionID)
+}
+
+func TestGlobalMATLAB_SessionID_AfterSessionStarts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	sessionID, ok := globalMATLAB.SessionID()
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestGlobalMATLAB_BackgroundClient_BeforeSessionStarts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	// Act
+	client, ok := globalMATLAB.BackgroundClient(mockLogger)
+
+	// Assert
+	require.False(t, ok, "MATLAB should not be started to get a background client")
+	assert.Nil(t, client)
+}
+
+func TestGlobalMATLAB_BackgroundClient_AfterSessionStarts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedBackgroundClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer expectedBackgroundClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetBackgroundMATLABSessionClient(expectedSessionID).
+		Return(expectedBackgroundClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	client, ok := globalMATLAB.BackgroundClient(mockLogger)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedBackgroundClient, client)
+}
+
+func TestGlobalMATLAB_BackgroundClient_SessionBusy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetBackgroundMATLABSessionClient(expectedSessionID).
+		Return(nil, matlabsessionstore.ErrSessionBusy).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	_, err := globalMATLAB.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	client, ok := globalMATLAB.BackgroundClient(mockLogger)
+
+	// Assert
+	require.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestGlobalMATLAB_BackgroundClient_WhileMATLABStarts_DoesNotWait(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManagerAdaptor := &mocks.MockMATLABManagerAdaptor{}
+	defer mockMATLABManagerAdaptor.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	starting := make(chan struct{})
+	finishStarting := make(chan struct{})
+
+	mockMATLABManagerAdaptor.EXPECT().
+		StartSession(ctx, mockLogger.AsMockArg()).
+		RunAndReturn(func(context.Context, entities.Logger) (entities.SessionID, error) {
+			close(starting)
+			<-finishStarting
+			return expectedSessionID, nil
+		}).
+		Once()
+
+	mockMATLABManagerAdaptor.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	globalMATLAB := globalmatlab.New(mockMATLABManagerAdaptor)
+
+	clientErr := make(chan error)
+	go func() {
+		_, err := globalMATLAB.Client(ctx, mockLogger)
+		clientErr <- err
+	}()
+	<-starting
+
+	// Act
+	client, ok := globalMATLAB.BackgroundClient(mockLogger)
+
+	// Assert
+	require.False(t, ok)
+	assert.Nil(t, client)
+
+	close(finishStarting)
+	require.NoError(t, <-clientErr)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabprojectdetector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+)
+
+// maxSearchDepth is the number of levels of subfolders of each root that are searched for a project.
+const maxSearchDepth = 3
+
+const projectFileExtension = ".prj"
+
+// projectResourcesFolder holds the definition of a MATLAB project. Other .prj files, such as the
+// ones of MATLAB Compiler or of toolbox packaging, do not have it.
+const projectResourcesFolder = "resources/project"
+
+type OSLayer interface {
+	ReadDir(name string) ([]os.DirEntry, error)
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type RootStore interface {
+	GetRoots() []entities.MCPRoot
+}
+
+type RootPathResolver interface {
+	Resolve(root entities.MCPRoot) (string, error)
+}
+
+type MATLABProjectDetector struct {
+	osLayer          OSLayer
+	rootStore        RootStore
+	rootPathResolver RootPathResolver
+}
+
+func New(
+	osLayer OSLayer,
+	rootStore RootStore,
+	rootPathResolver RootPathResolver,
+) *MATLABProjectDetector {
+	return &MATLABProjectDetector{
+		osLayer:          osLayer,
+		rootStore:        rootStore,
+		rootPathResolver: rootPathResolver,
+	}
+}
+
+// DetectMATLABProject returns the path of the .prj file of the MATLAB project found in the MCP roots, or an empty string if there is none.
+// Roots are searched in order, breadth first, so the project closest to the first root that contains one is returned.
+func (d *MATLABProjectDetector) DetectMATLABProject(logger entities.Logger) string {
+	for _, root := range d.rootStore.GetRoots() {
+		rootDir, err := d.rootPathResolver.Resolve(root)
+		if err != nil {
+			logger.WithError(err).Warn("failed to resolve MCP root, skipping it when detecting MATLAB projects")
+			continue
+		}
+
+		if rootDir == "" {
+			continue
+		}
+
+		if projectFile := d.searchFolder(logger, rootDir); projectFile != "" {
+			return projectFile
+		}
+	}
+
+	return ""
+}
+
+func (d *MATLABProjectDetector) searchFolder(logger entities.Logger, rootDir string) string {
+	folders := []string{rootDir}
+
+	for depth := 0; depth <= maxSearchDepth && len(folders) > 0; depth++ {
+		var subfolders []string
+
+		for _, folder := range folders {
+			entries, err := d.osLayer.ReadDir(folder)
+			if err != nil {
+				logger.WithError(err).With("folder", folder).Debug("failed to read folder when detecting MATLAB projects")
+				continue
+			}
+
+			if projectFile := d.projectFile(folder, entries); projectFile != "" {
+				return projectFile
+			}
+
+			for _, entry := range entries {
+				if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+					subfolders = append(subfolders, filepath.Join(folder, entry.Name()))
+				}
+			}
+		}
+
+		folders = subfolders
+	}
+
+	return ""
+}
+
+// projectFile returns the .prj file of the folder if the folder is the root folder of a MATLAB project.
+func (d *MATLABProjectDetector) projectFile(folder string, entries []os.DirEntry) string {
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), projectFileExtension) {
+			continue
+		}
+
+		info, err := d.osLayer.Stat(filepath.Join(folder, filepath.FromSlash(projectResourcesFolder)))
+		if err != nil || !info.IsDir() {
+			return ""
+		}
+
+		return filepath.Join(folder, entry.Name())
+	}
+
+	return ""
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabprojectdetector_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager/matlabprojectdetector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/globalmatlab/sessionmanager/matlabprojectdetector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	// Act
+	detector := matlabprojectdetector.New(mockOSLayer, mockRootStore, mockRootPathResolver)
+
+	// Assert
+	assert.NotNil(t, detector)
+}
+
+func TestMATLABProjectDetector_DetectMATLABProject_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name            string
+		projectFolder   string
+		projectFileName string
+	}{
+		{
+			name:            "project in root",
+			projectFolder:   ".",
+			projectFileName: "Weather.prj",
+		},
+		{
+			name:            "project in subfolder",
+			projectFolder:   filepath.Join("apps", "weather"),
+			projectFileName: "Weather.prj",
+		},
+		{
+			name:            "project at maximum depth",
+			projectFolder:   filepath.Join("a", "b", "c"),
+			projectFileName: "Deep.prj",
+		},
+		{
+			name:            "uppercase extension",
+			projectFolder:   "tools",
+			projectFileName: "Tools.PRJ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRootStore := &mocks.MockRootStore{}
+			defer mockRootStore.AssertExpectations(t)
+
+			mockRootPathResolver := &mocks.MockRootPathResolver{}
+			defer mockRootPathResolver.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			rootDir := t.TempDir()
+			createMATLABProject(t, filepath.Join(rootDir, tc.projectFolder), tc.projectFileName)
+
+			root := entities.NewMCPRoot("file://"+rootDir, "root")
+
+			mockRootStore.EXPECT().
+				GetRoots().
+				Return([]entities.MCPRoot{root}).
+				Once()
+
+			mockRootPathResolver.EXPECT().
+				Resolve(root).
+				Return(rootDir, nil).
+				Once()
+
+			detector := matlabprojectdetector.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+			// Act
+			projectFile := detector.DetectMATLABProject(mockLogger)
+
+			// Assert
+			assert.Equal(t, filepath.Join(rootDir, tc.projectFolder, tc.projectFileName), projectFile)
+		})
+	}
+}
+
+func TestMATLABProjectDetector_DetectMATLABProject_NoProject(t *testing.T) {
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T, rootDir string)
+	}{
+		{
+			name:  "empty root",
+			setup: func(t *testing.T, rootDir string) {},
+		},
+		{
+			name: "prj file without project resources",
+			setup: func(t *testing.T, rootDir string) {
+				writeFile(t, filepath.Join(rootDir, "Compiler.prj"))
+			},
+		},
+		{
+			name: "project deeper than maximum depth",
+			setup: func(t *testing.T, rootDir string) {
+				createMATLABProject(t, filepath.Join(rootDir, "a", "b", "c", "d"), "TooDeep.prj")
+			},
+		},
+		{
+			name: "project in hidden folder",
+			setup: func(t *testing.T, rootDir string) {
+				createMATLABProject(t, filepath.Join(rootDir, ".cache"), "Hidden.prj")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRootStore := &mocks.MockRootStore{}
+			defer mockRootStore.AssertExpectations(t)
+
+			mockRootPathResolver := &mocks.MockRootPathResolver{}
+			defer mockRootPathResolver.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			rootDir := t.TempDir()
+			tc.setup(t, rootDir)
+
+			root := entities.NewMCPRoot("file://"+rootDir, "root")
+
+			mockRootStore.EXPECT().
+				GetRoots().
+				Return([]entities.MCPRoot{root}).
+				Once()
+
+			mockRootPathResolver.EXPECT().
+				Resolve(root).
+				Return(rootDir, nil).
+				Once()
+
+			detector := matlabprojectdetector.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+			// Act
+			projectFile := detector.DetectMATLABProject(mockLogger)
+
+			// Assert
+			assert.Empty(t, projectFile)
+		})
+	}
+}
+
+func TestMATLABProjectDetector_DetectMATLABProject_NearestProjectFirst(t *testing.T) {
+	// Arrange
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	rootDir := t.TempDir()
+	createMATLABProject(t, filepath.Join(rootDir, "a", "nested"), "Nested.prj")
+	createMATLABProject(t, filepath.Join(rootDir, "b"), "Near.prj")
+
+	root := entities.NewMCPRoot("file://"+rootDir, "root")
+
+	mockRootStore.EXPECT().
+		GetRoots().
+		Return([]entities.MCPRoot{root}).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(root).
+		Return(rootDir, nil).
+		Once()
+
+	detector := matlabprojectdetector.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+	// Act
+	projectFile := detector.DetectMATLABProject(mockLogger)
+
+	// Assert
+	assert.Equal(t, filepath.Join(rootDir, "b", "Near.prj"), projectFile)
+}
+
+func TestMATLABProjectDetector_DetectMATLABProject_SkipsRootsThatFailToResolve(t *testing.T) {
+	// Arrange
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	rootDir := t.TempDir()
+	createMATLABProject(t, rootDir, "Weather.prj")
+
+	invalidRoot := entities.NewMCPRoot("https://example.com", "remote")
+	root := entities.NewMCPRoot("file://"+rootDir, "root")
+
+	mockRootStore.EXPECT().
+		GetRoots().
+		Return([]entities.MCPRoot{invalidRoot, root}).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(invalidRoot).
+		Return("", assert.AnError).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(root).
+		Return(rootDir, nil).
+		Once()
+
+	detector := matlabprojectdetector.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+	// Act
+	projectFile := detector.DetectMATLABProject(mockLogger)
+
+	// Assert
+	assert.Equal(t, filepath.Join(rootDir, "Weather.prj"), projectFile)
+}
+
+func TestMATLABProjectDetector_DetectMATLABProject_NoRoots(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockRootStore.EXPECT().
+		GetRoots().
+		Return([]entities.MCPRoot{}).
+		Once()
+
+	detector := matlabprojectdetector.New(mockOSLayer, mockRootStore, mockRootPathResolver)
+
+	// Act
+	projectFile := detector.DetectMATLABProject(mockLogger)
+
+	// Assert
+	assert.Empty(t, projectFile)
+}
+
+func createMATLABProject(t *testing.T, folder string, projectFileName string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(folder, "resources", "project"), 0o700))
+	writeFile(t, filepath.Join(folder, projectFileName))
+}
+
+func writeFile(t *testing.T, path string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte{}, 0o600))
+}
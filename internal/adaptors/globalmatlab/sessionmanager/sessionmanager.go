@@ -26,6 +26,7 @@ type MATLABManager interface {
 	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
 	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error)
 }
 
 type MATLABRootSelector interface {
@@ -48,6 +49,9 @@ type SessionManager struct {
 	initErr           error
 	matlabRoot        string
 	matlabStartingDir string
+
+	attachedSessionsLock sync.Mutex
+	attachedSessions     map[entities.SessionID]struct{}
 }
 
 func New(
@@ -63,6 +67,8 @@ func New(
 		matlabStartingDirSelector: matlabStartingDirSelector,
 
 		discoveryRetryInterval: defaultDiscoveryRetryInterval,
+
+		attachedSessions: make(map[entities.SessionID]struct{}),
 	}
 }
 
@@ -107,7 +113,20 @@ func (s *SessionManager) ShouldRestart() (bool, messages.Error) {
 	return !shouldNotRestart, nil
 }
 
+// IsAttachedSession reports whether the session is an existing MATLAB session that the server attached to, rather than one it started.
+func (s *SessionManager) IsAttachedSession(sessionID entities.SessionID) bool {
+	s.attachedSessionsLock.Lock()
+	defer s.attachedSessionsLock.Unlock()
+
+	_, ok := s.attachedSessions[sessionID]
+	return ok
+}
+
 func (s *SessionManager) StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	s.attachedSessionsLock.Lock()
+	delete(s.attachedSessions, sessionID)
+	s.attachedSessionsLock.Unlock()
+
 	return s.matlabManager.StopMATLABSession(ctx, sessionLogger, sessionID)
 }
 
@@ -115,6 +134,10 @@ func (s *SessionManager) GetMATLABSessionClient(ctx context.Context, sessionLogg
 	return s.matlabManager.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
 }
 
+func (s *SessionManager) GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	return s.matlabManager.GetBackgroundMATLABSessionClient(sessionID)
+}
+
 func (s *SessionManager) initializeStartupConfig(ctx context.Context, logger entities.Logger) error {
 	matlabRoot, err := s.matlabRootSelector.SelectMATLABRoot(ctx, logger)
 	if err != nil {
@@ -198,5 +221,9 @@ func (s *SessionManager) getSessionFromAttachingToExistingMATLAB(ctx context.Con
 		return 0, err
 	}
 
+	s.attachedSessionsLock.Lock()
+	s.attachedSessions[sessionID] = struct{}{}
+	s.attachedSessionsLock.Unlock()
+
 	return sessionID, nil
 }
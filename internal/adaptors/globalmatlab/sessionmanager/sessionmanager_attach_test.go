@@ -82,6 +82,72 @@ func TestSessionManager_StartSession_AttachMode_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 	require.Equal(t, expectedSessionID, sessionID)
+	assert.True(t, starter.IsAttachedSession(sessionID), "An attached session should be reported as attached")
+}
+
+func TestSessionManager_IsAttachedSession_FalseAfterStop(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(456)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionDiscoveryTimeout().
+		Return(5 * time.Second).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), entities.AttachToExistingSession{}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(nil).
+		Once()
+
+	starter := sessionmanager.New(
+		mockMATLABManager,
+		mockConfigFactory,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	sessionID, err := starter.StartSession(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	err = starter.StopMATLABSession(ctx, mockLogger, sessionID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, starter.IsAttachedSession(sessionID), "A stopped session should no longer be reported as attached")
 }
 
 func TestSessionManager_StartSession_AttachMode_StartMATLABSessionError(t *testing.T) {
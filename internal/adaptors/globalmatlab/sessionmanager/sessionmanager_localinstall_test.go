@@ -90,6 +90,7 @@ func TestSessionManager_StartSession_LocalInstall_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 	require.Equal(t, expectedSessionID, sessionID)
+	assert.False(t, starter.IsAttachedSession(sessionID), "A session that the server started should not be reported as attached")
 }
 
 func TestSessionManager_StartSession_LocalInstall_NoStartingDirectory(t *testing.T) {
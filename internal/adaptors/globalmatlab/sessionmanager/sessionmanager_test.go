@@ -370,6 +370,44 @@ func TestSessionManager_GetMATLABSessionClient_Error(t *testing.T) {
 	require.Nil(t, client)
 }
 
+func TestSessionManager_GetBackgroundMATLABSessionClient_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	expectedSessionID := entities.SessionID(123)
+
+	mockMATLABManager.EXPECT().
+		GetBackgroundMATLABSessionClient(expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	starter := sessionmanager.New(
+		mockMATLABManager,
+		mockConfigFactory,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	client, err := starter.GetBackgroundMATLABSessionClient(expectedSessionID)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, expectedSessionClient, client)
+}
+
 func TestSessionManager_StartSession_ConfigError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -5,8 +5,11 @@ package logger
 import (
 	"io"
 	"log/slog"
+	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/directory"
@@ -21,6 +24,7 @@ const defaultGlobalLogLevel slog.Level = slog.LevelDebug
 const (
 	logFileName         = "server"
 	watchdogLogFileName = "watchdog"
+	sessionLogFileName  = "matlab-session-"
 	logFileExt          = ".log"
 )
 
@@ -39,6 +43,9 @@ type FilenameFactory interface {
 type OSLayer interface {
 	Stderr() io.Writer
 	Create(name string) (osfacade.File, error)
+	OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error)
+	Rename(oldPath string, newPath string) error
+	RemoveAll(path string) error
 }
 
 type Factory struct {
@@ -51,7 +58,9 @@ type Factory struct {
 	initError             messages.Error
 	parsedLogLevel        slog.Level
 	duplicateLogsToStderr bool
+	rotation              rotationOptions
 	logFile               entities.Writer
+	sessionFiles          *sessionFiles
 
 	globalLoggerOnce sync.Once
 	globalLogger     *slogLogger
@@ -85,6 +94,8 @@ func (f *Factory) NewMCPSessionLogger(session *mcp.ServerSession) (entities.Logg
 		return nil, err
 	}
 
+	// The client chooses the level of the messages it receives with logging/setLevel, independently of the level of the log file.
+	// Until the client sets a level, it receives no messages.
 	sessionHandler := mcp.NewLoggingHandler(session, &mcp.LoggingHandlerOptions{})
 
 	handler := slog.NewJSONHandler(f.logFile, &slog.HandlerOptions{
@@ -92,7 +103,7 @@ func (f *Factory) NewMCPSessionLogger(session *mcp.ServerSession) (entities.Logg
 	})
 
 	return &slogLogger{
-		logger: slog.New(NewMultiHandler(sessionHandler, handler)),
+		logger: slog.New(NewMultiHandler(sessionHandler, f.withSessionFiles(handler))),
 	}, nil
 }
 
@@ -116,7 +127,7 @@ func (f *Factory) GetGlobalLogger() (entities.Logger, messages.Error) {
 			Level: f.parsedLogLevel,
 		})
 		f.globalLogger = &slogLogger{
-			logger: slog.New(handler),
+			logger: slog.New(f.withSessionFiles(handler)),
 		}
 	})
 
@@ -166,14 +177,66 @@ func (f *Factory) initialize() messages.Error {
 
 		logFilePath := f.filenameFactory.FilenameWithSuffix(logFileBase, logFileExt, id)
 
-		logFile, err := f.osLayer.Create(logFilePath)
+		f.rotation = rotationOptions{
+			maxSize:  config.LogMaxSize(),
+			maxAge:   config.LogMaxAge(),
+			maxFiles: config.LogMaxFiles(),
+		}
+
+		logFile, err := f.createLogFile(logFilePath)
 		if err != nil {
 			f.initError = messages.New_StartupErrors_FailedToCreateLogFile_Error(logFilePath)
 			return
 		}
 
 		f.logFile = logFile
+
+		if config.LogPerSessionFiles() {
+			f.sessionFiles = newSessionFiles(func(sessionID string) (entities.Writer, error) {
+				return f.createLogFile(f.filenameFactory.FilenameWithSuffix(filepath.Join(baseDir, sessionLogFileName+sessionID), logFileExt, id))
+			}, f.parsedLogLevel)
+		}
 	})
 
 	return f.initError
 }
+
+// CloseSessionLogFile closes the log file of the MATLAB session, if per-session log files are enabled.
+// Later messages about the session go to the server log file only.
+func (f *Factory) CloseSessionLogFile(sessionID entities.SessionID) error {
+	if err := f.initialize(); err != nil {
+		return err
+	}
+
+	if f.sessionFiles == nil {
+		return nil
+	}
+
+	return f.sessionFiles.close(strconv.Itoa(int(sessionID)))
+}
+
+// createLogFile creates a log file, which rotates if a maximum size or age is configured.
+func (f *Factory) createLogFile(path string) (entities.Writer, error) {
+	if !f.rotation.enabled() {
+		file, err := f.osLayer.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		return file, nil
+	}
+
+	file, err := newRotatingFile(f.osLayer, path, f.rotation, time.Now)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// withSessionFiles adds the per-session log files to the handler of the log file, if they are enabled.
+func (f *Factory) withSessionFiles(handler Handler) Handler {
+	if f.sessionFiles == nil {
+		return handler
+	}
+
+	return NewMultiHandler(handler, newSessionFileHandler(f.sessionFiles))
+}
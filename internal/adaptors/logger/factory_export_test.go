@@ -1,9 +1,32 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package logger
 
+import (
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
 const (
 	LogFileName         = logFileName
 	WatchdogLogFileName = watchdogLogFileName
+	SessionLogFileName  = sessionLogFileName
 	LogFileExt          = logFileExt
 )
+
+func NewRotatingFile(osLayer OSLayer, path string, maxSize uint64, maxAge time.Duration, maxFiles int, now func() time.Time) (io.Writer, error) {
+	return newRotatingFile(osLayer, path, rotationOptions{maxSize: maxSize, maxAge: maxAge, maxFiles: maxFiles}, now)
+}
+
+func NewSessionFileHandler(open func(sessionID string) (entities.Writer, error), level slog.Level) slog.Handler {
+	return newSessionFileHandler(newSessionFiles(open, level))
+}
+
+// NewClosableSessionFileHandler also returns the function that closes the log file of a session.
+func NewClosableSessionFileHandler(open func(sessionID string) (entities.Writer, error), level slog.Level) (slog.Handler, func(sessionID string) error) {
+	files := newSessionFiles(open, level)
+	return newSessionFileHandler(files), files.close
+}
@@ -3,8 +3,12 @@
 package logger_test
 
 import (
+	"context"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
@@ -88,6 +92,21 @@ func TestFactory_NewMCPSessionLogger_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -113,6 +132,11 @@ func TestFactory_NewMCPSessionLogger_HappyPath(t *testing.T) {
 		Return(mockLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogPerSessionFiles().
+		Return(false).
+		Once()
+
 	factory := logger.NewFactory(mockConfigFactory, mockDirectoryFactory, mockFilenameFactory, mockOSLayer)
 
 	// Act
@@ -289,6 +313,21 @@ func TestFactory_NewMCPSessionLogger_ReturnsErrorWhenLogFileCreationFails(t *tes
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -371,6 +410,21 @@ func TestFactory_GetGlobalLogger_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -396,6 +450,11 @@ func TestFactory_GetGlobalLogger_HappyPath(t *testing.T) {
 		Return(mockLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogPerSessionFiles().
+		Return(false).
+		Once()
+
 	factory := logger.NewFactory(mockConfigFactory, mockDirectoryFactory, mockFilenameFactory, mockOSLayer)
 
 	// Act
@@ -458,6 +517,21 @@ func TestFactory_GetGlobalLogger_DuplicatesLogsToStderrWhenEnabled(t *testing.T)
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -483,6 +557,11 @@ func TestFactory_GetGlobalLogger_DuplicatesLogsToStderrWhenEnabled(t *testing.T)
 		Return(mockLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogPerSessionFiles().
+		Return(false).
+		Once()
+
 	mockOSLayer.EXPECT().
 		Stderr().
 		Return(mockStderr).
@@ -561,6 +640,21 @@ func TestFactory_GetGlobalLogger_UsesWatchdogLogFileInWatchdogMode(t *testing.T)
 		Return(true).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -586,6 +680,11 @@ func TestFactory_GetGlobalLogger_UsesWatchdogLogFileInWatchdogMode(t *testing.T)
 		Return(mockLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogPerSessionFiles().
+		Return(false).
+		Once()
+
 	factory := logger.NewFactory(mockConfigFactory, mockDirectoryFactory, mockFilenameFactory, mockOSLayer)
 
 	// Act
@@ -643,6 +742,21 @@ func TestFactory_GetGlobalLogger_IsSingleton(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -668,6 +782,11 @@ func TestFactory_GetGlobalLogger_IsSingleton(t *testing.T) {
 		Return(mockLogFile, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		LogPerSessionFiles().
+		Return(false).
+		Once()
+
 	factory := logger.NewFactory(mockConfigFactory, mockDirectoryFactory, mockFilenameFactory, mockOSLayer)
 
 	// Act
@@ -848,6 +967,21 @@ func TestFactory_GetGlobalLogger_ReturnsErrorWhenLogFileCreationFails(t *testing
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		LogMaxSize().
+		Return(uint64(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
 	mockDirectoryFactory.EXPECT().
 		Directory().
 		Return(mockDirectory, nil).
@@ -882,3 +1016,349 @@ func TestFactory_GetGlobalLogger_ReturnsErrorWhenLogFileCreationFails(t *testing
 	require.Equal(t, expectedError, err)
 	assert.Nil(t, result)
 }
+
+type factoryMocks struct {
+	configFactory    *loggermocks.MockConfigFactory
+	config           *configmocks.MockConfig
+	directoryFactory *loggermocks.MockDirectoryFactory
+	directory        *directorymocks.MockDirectory
+	filenameFactory  *loggermocks.MockFilenameFactory
+	osLayer          *loggermocks.MockOSLayer
+}
+
+func newFactoryMocks(t *testing.T) factoryMocks {
+	t.Helper()
+
+	m := factoryMocks{
+		configFactory:    &loggermocks.MockConfigFactory{},
+		config:           &configmocks.MockConfig{},
+		directoryFactory: &loggermocks.MockDirectoryFactory{},
+		directory:        &directorymocks.MockDirectory{},
+		filenameFactory:  &loggermocks.MockFilenameFactory{},
+		osLayer:          &loggermocks.MockOSLayer{},
+	}
+
+	t.Cleanup(func() {
+		m.configFactory.AssertExpectations(t)
+		m.config.AssertExpectations(t)
+		m.directoryFactory.AssertExpectations(t)
+		m.directory.AssertExpectations(t)
+		m.filenameFactory.AssertExpectations(t)
+		m.osLayer.AssertExpectations(t)
+	})
+
+	return m
+}
+
+// expectInitialization expects the factory to read the configuration and to compute the name of the log file.
+func (m factoryMocks) expectInitialization(logLevel entities.LogLevel, maxSize uint64, perSessionFiles bool, baseDir string, suffix string, logFile string) {
+	m.configFactory.EXPECT().
+		Config().
+		Return(m.config, nil).
+		Once()
+
+	m.config.EXPECT().
+		DuplicateLogsToStderr().
+		Return(false).
+		Once()
+
+	m.config.EXPECT().
+		LogLevel().
+		Return(logLevel).
+		Once()
+
+	m.config.EXPECT().
+		WatchdogMode().
+		Return(false).
+		Once()
+
+	m.config.EXPECT().
+		LogMaxSize().
+		Return(maxSize).
+		Once()
+
+	m.config.EXPECT().
+		LogMaxAge().
+		Return(time.Duration(0)).
+		Once()
+
+	m.config.EXPECT().
+		LogMaxFiles().
+		Return(5).
+		Once()
+
+	m.config.EXPECT().
+		LogPerSessionFiles().
+		Return(perSessionFiles).
+		Once()
+
+	m.directoryFactory.EXPECT().
+		Directory().
+		Return(m.directory, nil).
+		Once()
+
+	m.directory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	m.directory.EXPECT().
+		ID().
+		Return(suffix).
+		Once()
+
+	m.filenameFactory.EXPECT().
+		FilenameWithSuffix(filepath.Join(baseDir, logger.LogFileName), logger.LogFileExt, suffix).
+		Return(logFile).
+		Once()
+}
+
+func TestFactory_NewMCPSessionLogger_ForwardsAtClientLevel(t *testing.T) {
+	// Arrange
+	m := newFactoryMocks(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	expectedBaseDir := filepath.Join("some", "directory")
+	expectedSuffix := "1337"
+	expectedLogFile := filepath.Join(expectedBaseDir, "server.log")
+
+	m.expectInitialization(entities.LogLevelError, 0, false, expectedBaseDir, expectedSuffix, expectedLogFile)
+
+	m.osLayer.EXPECT().
+		Create(expectedLogFile).
+		Return(mockLogFile, nil).
+		Once()
+
+	ctx := t.Context()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "server"}, nil)
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	forwarded := make(chan *mcp.LoggingMessageParams, 10)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(_ context.Context, req *mcp.LoggingMessageRequest) {
+			forwarded <- req.Params
+		},
+	})
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	factory := logger.NewFactory(m.configFactory, m.directoryFactory, m.filenameFactory, m.osLayer)
+
+	sessionLogger, messagesErr := factory.NewMCPSessionLogger(serverSession)
+	require.NoError(t, messagesErr)
+
+	// Act
+	sessionLogger.Debug("Before the client sets a level")
+
+	require.NoError(t, clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "debug"}))
+	sessionLogger.Debug("Debug message")
+
+	require.NoError(t, clientSession.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "warning"}))
+	sessionLogger.Info("Info message")
+	sessionLogger.Warn("Warning message")
+
+	// Assert
+	var forwardedMessages []any
+	for range 2 {
+		select {
+		case params := <-forwarded:
+			data, ok := params.Data.(map[string]any)
+			require.True(t, ok)
+			forwardedMessages = append(forwardedMessages, data["msg"])
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for a log message")
+		}
+	}
+
+	assert.Equal(t, []any{"Debug message", "Warning message"}, forwardedMessages, "The client level, not the log file level, should select the messages forwarded to the client")
+}
+
+func TestFactory_GetGlobalLogger_WritesSessionFilesWhenEnabled(t *testing.T) {
+	// Arrange
+	m := newFactoryMocks(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockSessionLogFile := &osfacademocks.MockFile{}
+	defer mockSessionLogFile.AssertExpectations(t)
+
+	expectedBaseDir := filepath.Join("some", "directory")
+	expectedSuffix := "1337"
+	expectedLogFile := filepath.Join(expectedBaseDir, "server.log")
+	expectedSessionLogFile := filepath.Join(expectedBaseDir, "matlab-session-3.log")
+
+	m.expectInitialization(entities.LogLevelInfo, 0, true, expectedBaseDir, expectedSuffix, expectedLogFile)
+
+	m.osLayer.EXPECT().
+		Create(expectedLogFile).
+		Return(mockLogFile, nil).
+		Once()
+
+	m.filenameFactory.EXPECT().
+		FilenameWithSuffix(filepath.Join(expectedBaseDir, logger.SessionLogFileName+"3"), logger.LogFileExt, expectedSuffix).
+		Return(expectedSessionLogFile).
+		Once()
+
+	m.osLayer.EXPECT().
+		Create(expectedSessionLogFile).
+		Return(mockSessionLogFile, nil).
+		Once()
+
+	mockLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		Return(0, nil).
+		Twice()
+
+	mockSessionLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		Return(0, nil).
+		Once()
+
+	factory := logger.NewFactory(m.configFactory, m.directoryFactory, m.filenameFactory, m.osLayer)
+
+	// Act
+	globalLogger, err := factory.GetGlobalLogger()
+	require.NoError(t, err)
+
+	globalLogger.Info("Server started")
+	globalLogger.With(entities.MATLABSessionIDLogKey, entities.SessionID(3)).Info("Started MATLAB session")
+
+	// Assert
+	mockSessionLogFile.AssertCalled(t, "Write", mock.MatchedBy(func(line []byte) bool {
+		return strings.Contains(string(line), "Started MATLAB session")
+	}))
+}
+
+func TestFactory_CloseSessionLogFile_ClosesTheFileOfTheSession(t *testing.T) {
+	// Arrange
+	m := newFactoryMocks(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockSessionLogFile := &osfacademocks.MockFile{}
+	defer mockSessionLogFile.AssertExpectations(t)
+
+	expectedBaseDir := filepath.Join("some", "directory")
+	expectedSuffix := "1337"
+	expectedLogFile := filepath.Join(expectedBaseDir, "server.log")
+	expectedSessionLogFile := filepath.Join(expectedBaseDir, "matlab-session-3.log")
+
+	m.expectInitialization(entities.LogLevelInfo, 0, true, expectedBaseDir, expectedSuffix, expectedLogFile)
+
+	m.osLayer.EXPECT().
+		Create(expectedLogFile).
+		Return(mockLogFile, nil).
+		Once()
+
+	m.filenameFactory.EXPECT().
+		FilenameWithSuffix(filepath.Join(expectedBaseDir, logger.SessionLogFileName+"3"), logger.LogFileExt, expectedSuffix).
+		Return(expectedSessionLogFile).
+		Once()
+
+	m.osLayer.EXPECT().
+		Create(expectedSessionLogFile).
+		Return(mockSessionLogFile, nil).
+		Once()
+
+	mockLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		Return(0, nil).
+		Twice()
+
+	mockSessionLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		Return(0, nil).
+		Once()
+
+	mockSessionLogFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	factory := logger.NewFactory(m.configFactory, m.directoryFactory, m.filenameFactory, m.osLayer)
+
+	globalLogger, err := factory.GetGlobalLogger()
+	require.NoError(t, err)
+
+	sessionLogger := globalLogger.With(entities.MATLABSessionIDLogKey, entities.SessionID(3))
+	sessionLogger.Info("Started MATLAB session")
+
+	// Act
+	closeErr := factory.CloseSessionLogFile(entities.SessionID(3))
+	sessionLogger.Info("Stopped MATLAB session")
+
+	// Assert
+	require.NoError(t, closeErr)
+}
+
+func TestFactory_GetGlobalLogger_RotatesWhenConfigured(t *testing.T) {
+	// Arrange
+	m := newFactoryMocks(t)
+
+	mockLogFile := &osfacademocks.MockFile{}
+	defer mockLogFile.AssertExpectations(t)
+
+	mockNewLogFile := &osfacademocks.MockFile{}
+	defer mockNewLogFile.AssertExpectations(t)
+
+	expectedBaseDir := filepath.Join("some", "directory")
+	expectedSuffix := "1337"
+	expectedLogFile := filepath.Join(expectedBaseDir, "server.log")
+
+	m.expectInitialization(entities.LogLevelInfo, 1, false, expectedBaseDir, expectedSuffix, expectedLogFile)
+
+	m.osLayer.EXPECT().
+		Create(expectedLogFile).
+		Return(mockLogFile, nil).
+		Once()
+
+	mockLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		RunAndReturn(func(b []byte) (int, error) { return len(b), nil }).
+		Once()
+
+	mockLogFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		Rename(expectedLogFile, mock.MatchedBy(func(rotatedLogFile string) bool {
+			return strings.HasPrefix(rotatedLogFile, filepath.Join(expectedBaseDir, "server-")) && strings.HasSuffix(rotatedLogFile, logger.LogFileExt)
+		})).
+		Return(nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		OpenFile(expectedLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(0o600)).
+		Return(mockNewLogFile, nil).
+		Once()
+
+	mockNewLogFile.EXPECT().
+		Write(mock.AnythingOfType("[]uint8")).
+		RunAndReturn(func(b []byte) (int, error) { return len(b), nil }).
+		Once()
+
+	factory := logger.NewFactory(m.configFactory, m.directoryFactory, m.filenameFactory, m.osLayer)
+
+	// Act
+	globalLogger, err := factory.GetGlobalLogger()
+	require.NoError(t, err)
+
+	globalLogger.Info("First message")
+	globalLogger.Info("Second message")
+
+	// Assert
+	mockNewLogFile.AssertCalled(t, "Write", mock.MatchedBy(func(line []byte) bool {
+		return strings.Contains(string(line), "Second message")
+	}))
+}
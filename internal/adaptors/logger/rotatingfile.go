@@ -0,0 +1,131 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+)
+
+const rotatedLogFileTimeFormat = "20060102T150405.000000000Z"
+
+type rotationOptions struct {
+	maxSize  uint64
+	maxAge   time.Duration
+	maxFiles int
+}
+
+func (o rotationOptions) enabled() bool {
+	return o.maxSize > 0 || o.maxAge > 0
+}
+
+// rotatingFile writes to a log file. When the file reaches its maximum size or age, rotatingFile renames it
+// with a timestamp and starts a new file. It keeps the newest maxFiles rotated files and deletes the others.
+type rotatingFile struct {
+	osLayer OSLayer
+	path    string
+	options rotationOptions
+	now     func() time.Time
+
+	lock     sync.Mutex
+	file     osfacade.File
+	size     uint64
+	openedAt time.Time
+	rotated  []string
+}
+
+func newRotatingFile(osLayer OSLayer, path string, options rotationOptions, now func() time.Time) (*rotatingFile, error) {
+	file, err := osLayer.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rotatingFile{
+		osLayer:  osLayer,
+		path:     path,
+		options:  options,
+		now:      now,
+		file:     file,
+		openedAt: now(),
+	}, nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.shouldRotate(len(p)) {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += uint64(n) //nolint:gosec // n is never negative
+	return n, err
+}
+
+func (r *rotatingFile) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.file.Close()
+}
+
+func (r *rotatingFile) shouldRotate(writeSize int) bool {
+	// A record larger than the maximum size goes to its own file, rather than rotating an empty file
+	if r.size == 0 {
+		return false
+	}
+
+	if r.options.maxSize > 0 && r.size+uint64(writeSize) > r.options.maxSize { //nolint:gosec // writeSize is never negative
+		return true
+	}
+
+	return r.options.maxAge > 0 && r.now().Sub(r.openedAt) >= r.options.maxAge
+}
+
+// rotate closes the file before renaming it, because Windows does not rename open files.
+// If the rename fails, rotate keeps writing to the same file and tries again when the next period starts.
+func (r *rotatingFile) rotate() error {
+	// The file is reopened below, even if it did not close cleanly
+	_ = r.file.Close()
+
+	now := r.now()
+	rotatedPath := strings.TrimSuffix(r.path, filepath.Ext(r.path)) + "-" + now.UTC().Format(rotatedLogFileTimeFormat) + filepath.Ext(r.path)
+	renamed := r.osLayer.Rename(r.path, rotatedPath) == nil
+
+	file, err := r.osLayer.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	r.file = file
+	r.size = 0
+	r.openedAt = now
+
+	if renamed {
+		r.rotated = append(r.rotated, rotatedPath)
+		r.removeOldFiles()
+	}
+
+	return nil
+}
+
+// removeOldFiles deletes the oldest rotated files beyond the retention count. A maxFiles of zero keeps all files.
+func (r *rotatingFile) removeOldFiles() {
+	if r.options.maxFiles == 0 {
+		return
+	}
+
+	for len(r.rotated) > r.options.maxFiles {
+		// A file that cannot be deleted is left in place, because logging must not fail because of it
+		_ = r.osLayer.RemoveAll(r.rotated[0])
+		r.rotated = r.rotated[1:]
+	}
+}
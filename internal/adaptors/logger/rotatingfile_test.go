@@ -0,0 +1,307 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package logger_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/logger"
+	loggermocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/logger"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rotatedLogFileFlags = os.O_APPEND | os.O_CREATE | os.O_WRONLY
+
+func rotatedLogFile(logFile string, at time.Time) string {
+	return filepath.Join(filepath.Dir(logFile), "server-1337-"+at.UTC().Format("20060102T150405.000000000Z")+".log")
+}
+
+func TestNewRotatingFile_CreateError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	logFile := filepath.Join("logs", "server-1337.log")
+
+	mockOSLayer.EXPECT().
+		Create(logFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	writer, err := logger.NewRotatingFile(mockOSLayer, logFile, 10, 0, 0, time.Now)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, writer)
+}
+
+func TestRotatingFile_Write_RotatesBySizeAndRemovesOldFiles(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFirstFile := &osfacademocks.MockFile{}
+	defer mockFirstFile.AssertExpectations(t)
+
+	mockSecondFile := &osfacademocks.MockFile{}
+	defer mockSecondFile.AssertExpectations(t)
+
+	mockThirdFile := &osfacademocks.MockFile{}
+	defer mockThirdFile.AssertExpectations(t)
+
+	logFile := filepath.Join("logs", "server-1337.log")
+	start := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	clock := start
+	now := func() time.Time { return clock }
+	line := []byte("0123456789")
+
+	mockOSLayer.EXPECT().
+		Create(logFile).
+		Return(mockFirstFile, nil).
+		Once()
+
+	mockFirstFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	mockFirstFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	firstRotation := start.Add(time.Second)
+	mockOSLayer.EXPECT().
+		Rename(logFile, rotatedLogFile(logFile, firstRotation)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(logFile, rotatedLogFileFlags, os.FileMode(0o600)).
+		Return(mockSecondFile, nil).
+		Once()
+
+	mockSecondFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	mockSecondFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	secondRotation := start.Add(2 * time.Second)
+	mockOSLayer.EXPECT().
+		Rename(logFile, rotatedLogFile(logFile, secondRotation)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(logFile, rotatedLogFileFlags, os.FileMode(0o600)).
+		Return(mockThirdFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(rotatedLogFile(logFile, firstRotation)).
+		Return(nil).
+		Once()
+
+	mockThirdFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	writer, err := logger.NewRotatingFile(mockOSLayer, logFile, 15, 0, 1, now)
+	require.NoError(t, err)
+
+	// Act
+	var writeErrs []error
+	for i := range 3 {
+		clock = start.Add(time.Duration(i) * time.Second)
+		_, writeErr := writer.Write(line)
+		writeErrs = append(writeErrs, writeErr)
+	}
+
+	// Assert
+	for _, writeErr := range writeErrs {
+		require.NoError(t, writeErr)
+	}
+}
+
+func TestRotatingFile_Write_RotatesByAge(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFirstFile := &osfacademocks.MockFile{}
+	defer mockFirstFile.AssertExpectations(t)
+
+	mockSecondFile := &osfacademocks.MockFile{}
+	defer mockSecondFile.AssertExpectations(t)
+
+	logFile := filepath.Join("logs", "server-1337.log")
+	start := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	clock := start
+	now := func() time.Time { return clock }
+	line := []byte("line")
+
+	mockOSLayer.EXPECT().
+		Create(logFile).
+		Return(mockFirstFile, nil).
+		Once()
+
+	mockFirstFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Twice()
+
+	mockFirstFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	rotation := start.Add(time.Hour)
+	mockOSLayer.EXPECT().
+		Rename(logFile, rotatedLogFile(logFile, rotation)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(logFile, rotatedLogFileFlags, os.FileMode(0o600)).
+		Return(mockSecondFile, nil).
+		Once()
+
+	mockSecondFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	writer, err := logger.NewRotatingFile(mockOSLayer, logFile, 0, time.Hour, 0, now)
+	require.NoError(t, err)
+
+	// Act
+	_, firstErr := writer.Write(line)
+	clock = start.Add(time.Hour - time.Second)
+	_, secondErr := writer.Write(line)
+	clock = rotation
+	_, thirdErr := writer.Write(line)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.NoError(t, thirdErr)
+}
+
+func TestRotatingFile_Write_RenameFailsKeepsWriting(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFirstFile := &osfacademocks.MockFile{}
+	defer mockFirstFile.AssertExpectations(t)
+
+	mockReopenedFile := &osfacademocks.MockFile{}
+	defer mockReopenedFile.AssertExpectations(t)
+
+	logFile := filepath.Join("logs", "server-1337.log")
+	now := func() time.Time { return time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC) }
+	line := []byte("0123456789")
+
+	mockOSLayer.EXPECT().
+		Create(logFile).
+		Return(mockFirstFile, nil).
+		Once()
+
+	mockFirstFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	mockFirstFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Rename(logFile, rotatedLogFile(logFile, now())).
+		Return(assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(logFile, rotatedLogFileFlags, os.FileMode(0o600)).
+		Return(mockReopenedFile, nil).
+		Once()
+
+	mockReopenedFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	writer, err := logger.NewRotatingFile(mockOSLayer, logFile, 15, 0, 1, now)
+	require.NoError(t, err)
+
+	// Act
+	_, firstErr := writer.Write(line)
+	_, secondErr := writer.Write(line)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr, "Log messages should still be written when the file cannot be renamed")
+}
+
+func TestRotatingFile_Write_ReopenError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &loggermocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	logFile := filepath.Join("logs", "server-1337.log")
+	now := func() time.Time { return time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC) }
+	line := []byte("0123456789")
+
+	mockOSLayer.EXPECT().
+		Create(logFile).
+		Return(mockFile, nil).
+		Once()
+
+	mockFile.EXPECT().
+		Write(line).
+		Return(len(line), nil).
+		Once()
+
+	mockFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Rename(logFile, rotatedLogFile(logFile, now())).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(logFile, rotatedLogFileFlags, os.FileMode(0o600)).
+		Return(nil, assert.AnError).
+		Once()
+
+	writer, err := logger.NewRotatingFile(mockOSLayer, logFile, 15, 0, 0, now)
+	require.NoError(t, err)
+
+	// Act
+	_, firstErr := writer.Write(line)
+	n, secondErr := writer.Write(line)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.ErrorIs(t, secondErr, assert.AnError)
+	assert.Zero(t, n)
+}
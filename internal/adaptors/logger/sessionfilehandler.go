@@ -0,0 +1,178 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// sessionFiles opens the log file of a MATLAB session when the server logs the first message about the session,
+// and closes it when the session ends.
+type sessionFiles struct {
+	open  func(sessionID string) (entities.Writer, error)
+	level slog.Level
+
+	lock  sync.Mutex
+	files map[string]*sessionFile
+	// closed holds the sessions that ended. Later records about them are not written to a session file,
+	// so that a late record does not create the file again and overwrite it.
+	closed map[string]struct{}
+}
+
+type sessionFile struct {
+	writer  entities.Writer
+	handler slog.Handler
+}
+
+func newSessionFiles(open func(sessionID string) (entities.Writer, error), level slog.Level) *sessionFiles {
+	return &sessionFiles{
+		open:   open,
+		level:  level,
+		files:  map[string]*sessionFile{},
+		closed: map[string]struct{}{},
+	}
+}
+
+// handler returns the handler of the log file of the session, or nil if the session ended.
+func (s *sessionFiles) handler(sessionID string) (slog.Handler, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, closed := s.closed[sessionID]; closed {
+		return nil, nil
+	}
+
+	if file, ok := s.files[sessionID]; ok {
+		return file.handler, nil
+	}
+
+	writer, err := s.open(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := slog.NewJSONHandler(writer, &slog.HandlerOptions{
+		Level: s.level,
+	})
+	s.files[sessionID] = &sessionFile{
+		writer:  writer,
+		handler: handler,
+	}
+	return handler, nil
+}
+
+// close closes the log file of the session, if the server opened one.
+func (s *sessionFiles) close(sessionID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closed[sessionID] = struct{}{}
+
+	file, ok := s.files[sessionID]
+	if !ok {
+		return nil
+	}
+	delete(s.files, sessionID)
+
+	if closer, ok := file.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// sessionFileHandler writes the records tagged with entities.MATLABSessionIDLogKey to the log file of that MATLAB session,
+// and drops the other records.
+type sessionFileHandler struct {
+	files *sessionFiles
+
+	sessionID string
+	grouped   bool
+	// derive replays the WithAttrs and WithGroup calls on the handler of the session file
+	derive []func(slog.Handler) slog.Handler
+}
+
+func newSessionFileHandler(files *sessionFiles) *sessionFileHandler {
+	return &sessionFileHandler{
+		files: files,
+	}
+}
+
+func (h *sessionFileHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.files.level
+}
+
+func (h *sessionFileHandler) Handle(ctx context.Context, record slog.Record) error {
+	sessionID := h.sessionID
+	if sessionID == "" && !h.grouped {
+		record.Attrs(func(attr slog.Attr) bool {
+			sessionID = sessionIDFromAttr(attr)
+			return sessionID == ""
+		})
+	}
+
+	if sessionID == "" {
+		return nil
+	}
+
+	handler, err := h.files.handler(sessionID)
+	if err != nil || handler == nil {
+		return err
+	}
+
+	for _, derive := range h.derive {
+		handler = derive(handler)
+	}
+
+	return handler.Handle(ctx, record)
+}
+
+func (h *sessionFileHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	derived := h.clone(func(handler slog.Handler) slog.Handler {
+		return handler.WithAttrs(attrs)
+	})
+
+	// The session ID only tags the record when it is not in a group
+	if !h.grouped {
+		for _, attr := range attrs {
+			if sessionID := sessionIDFromAttr(attr); sessionID != "" {
+				derived.sessionID = sessionID
+			}
+		}
+	}
+
+	return derived
+}
+
+func (h *sessionFileHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	derived := h.clone(func(handler slog.Handler) slog.Handler {
+		return handler.WithGroup(name)
+	})
+	derived.grouped = true
+	return derived
+}
+
+func (h *sessionFileHandler) clone(derive func(slog.Handler) slog.Handler) *sessionFileHandler {
+	return &sessionFileHandler{
+		files:     h.files,
+		sessionID: h.sessionID,
+		grouped:   h.grouped,
+		derive:    append(append([]func(slog.Handler) slog.Handler{}, h.derive...), derive),
+	}
+}
+
+func sessionIDFromAttr(attr slog.Attr) string {
+	if attr.Key != entities.MATLABSessionIDLogKey {
+		return ""
+	}
+
+	return attr.Value.Resolve().String()
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/logger"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionLogFiles map[string]*bytes.Buffer
+
+func (f sessionLogFiles) open(sessionID string) (entities.Writer, error) {
+	file := &bytes.Buffer{}
+	f[sessionID] = file
+	return file, nil
+}
+
+func (f sessionLogFiles) records(t *testing.T, sessionID string) []map[string]any {
+	t.Helper()
+
+	file, ok := f[sessionID]
+	if !ok {
+		return nil
+	}
+
+	var records []map[string]any
+	for line := range strings.Lines(file.String()) {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestSessionFileHandler_WritesRecordsToTheFileOfTheSession(t *testing.T) {
+	// Arrange
+	files := sessionLogFiles{}
+	log := slog.New(logger.NewSessionFileHandler(files.open, slog.LevelInfo))
+
+	// Act
+	log.With(entities.MATLABSessionIDLogKey, entities.SessionID(1)).With("matlab-root", "/MATLAB").Info("Started MATLAB session")
+	log.Warn("MATLAB process exited unexpectedly", entities.MATLABSessionIDLogKey, entities.SessionID(2))
+	log.With(entities.MATLABSessionIDLogKey, entities.SessionID(1)).Info("Stopping MATLAB session")
+
+	// Assert
+	require.Len(t, files, 2)
+
+	firstSessionRecords := files.records(t, "1")
+	require.Len(t, firstSessionRecords, 2)
+	assert.Equal(t, "Started MATLAB session", firstSessionRecords[0]["msg"])
+	assert.Equal(t, "/MATLAB", firstSessionRecords[0]["matlab-root"])
+	assert.Equal(t, "Stopping MATLAB session", firstSessionRecords[1]["msg"])
+
+	secondSessionRecords := files.records(t, "2")
+	require.Len(t, secondSessionRecords, 1)
+	assert.Equal(t, "MATLAB process exited unexpectedly", secondSessionRecords[0]["msg"])
+}
+
+func TestSessionFileHandler_DropsRecordsWithoutSession(t *testing.T) {
+	// Arrange
+	files := sessionLogFiles{}
+	log := slog.New(logger.NewSessionFileHandler(files.open, slog.LevelInfo))
+
+	// Act
+	log.Info("Server started")
+	log.WithGroup("request").Info("Tool call", entities.MATLABSessionIDLogKey, entities.SessionID(1))
+
+	// Assert
+	assert.Empty(t, files, "No session file should be opened for records that are not about a session")
+}
+
+func TestSessionFileHandler_Enabled(t *testing.T) {
+	// Arrange
+	files := sessionLogFiles{}
+	handler := logger.NewSessionFileHandler(files.open, slog.LevelInfo)
+
+	// Act
+	debugEnabled := handler.Enabled(t.Context(), slog.LevelDebug)
+	infoEnabled := handler.Enabled(t.Context(), slog.LevelInfo)
+
+	// Assert
+	assert.False(t, debugEnabled)
+	assert.True(t, infoEnabled)
+}
+
+func TestSessionFileHandler_OpenError(t *testing.T) {
+	// Arrange
+	handler := logger.NewSessionFileHandler(func(string) (entities.Writer, error) {
+		return nil, assert.AnError
+	}, slog.LevelInfo)
+
+	record := slog.Record{Message: "Started MATLAB session", Level: slog.LevelInfo}
+	record.AddAttrs(slog.Any(entities.MATLABSessionIDLogKey, entities.SessionID(1)))
+
+	// Act
+	err := handler.Handle(t.Context(), record)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+type closableLogFile struct {
+	bytes.Buffer
+
+	closed bool
+}
+
+func (f *closableLogFile) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestSessionFileHandler_CloseClosesTheFileOfTheSession(t *testing.T) {
+	// Arrange
+	files := map[string]*closableLogFile{}
+	handler, closeSessionFile := logger.NewClosableSessionFileHandler(func(sessionID string) (entities.Writer, error) {
+		file := &closableLogFile{}
+		files[sessionID] = file
+		return file, nil
+	}, slog.LevelInfo)
+	log := slog.New(handler)
+
+	log.Info("Started MATLAB session", entities.MATLABSessionIDLogKey, entities.SessionID(1))
+	log.Info("Started MATLAB session", entities.MATLABSessionIDLogKey, entities.SessionID(2))
+
+	// Act
+	err := closeSessionFile("1")
+	log.Info("Late record", entities.MATLABSessionIDLogKey, entities.SessionID(1))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, files, 2, "A late record should not open the file of an ended session again")
+	assert.True(t, files["1"].closed)
+	assert.False(t, files["2"].closed)
+	assert.NotContains(t, files["1"].String(), "Late record")
+}
+
+func TestSessionFileHandler_CloseWithoutFile(t *testing.T) {
+	// Arrange
+	files := sessionLogFiles{}
+	handler, closeSessionFile := logger.NewClosableSessionFileHandler(files.open, slog.LevelInfo)
+	log := slog.New(handler)
+
+	// Act
+	err := closeSessionFile("1")
+	log.Info("Late record", entities.MATLABSessionIDLogKey, entities.SessionID(1))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package logger
 
@@ -46,18 +46,22 @@ func (h *SlogMultiHandler) Handle(ctx context.Context, record slog.Record) error
 	return err
 }
 
+// WithAttrs returns a new handler, so that the attributes do not leak into the loggers that share h.
 func (h *SlogMultiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]Handler, len(h.handlers))
 	for i, handler := range h.handlers {
-		h.handlers[i] = handler.WithAttrs(attrs)
+		handlers[i] = handler.WithAttrs(attrs)
 	}
 
-	return h
+	return NewMultiHandler(handlers...)
 }
 
+// WithGroup returns a new handler, so that the group does not leak into the loggers that share h.
 func (h *SlogMultiHandler) WithGroup(name string) slog.Handler {
+	handlers := make([]Handler, len(h.handlers))
 	for i, handler := range h.handlers {
-		h.handlers[i] = handler.WithGroup(name)
+		handlers[i] = handler.WithGroup(name)
 	}
 
-	return h
+	return NewMultiHandler(handlers...)
 }
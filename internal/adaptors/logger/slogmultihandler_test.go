@@ -252,6 +252,12 @@ func TestSlogMultiHandler_WithAttrs_CallsAllHandlers(t *testing.T) {
 	mockHandler2 := &loggermocks.MockHandler{}
 	defer mockHandler2.AssertExpectations(t)
 
+	derivedHandler1 := &loggermocks.MockHandler{}
+	defer derivedHandler1.AssertExpectations(t)
+
+	derivedHandler2 := &loggermocks.MockHandler{}
+	defer derivedHandler2.AssertExpectations(t)
+
 	expectedAttrs := []slog.Attr{
 		slog.String("key1", "value1"),
 		slog.String("key2", "value2"),
@@ -259,12 +265,12 @@ func TestSlogMultiHandler_WithAttrs_CallsAllHandlers(t *testing.T) {
 
 	mockHandler1.EXPECT().
 		WithAttrs(expectedAttrs).
-		Return(mockHandler1).
+		Return(derivedHandler1).
 		Once()
 
 	mockHandler2.EXPECT().
 		WithAttrs(expectedAttrs).
-		Return(mockHandler2).
+		Return(derivedHandler2).
 		Once()
 
 	multiHandler := logger.NewMultiHandler(mockHandler1, mockHandler2)
@@ -273,10 +279,11 @@ func TestSlogMultiHandler_WithAttrs_CallsAllHandlers(t *testing.T) {
 	result := multiHandler.WithAttrs(expectedAttrs)
 
 	// Assert
-	assert.Equal(t, multiHandler, result, "WithAttrs should return the same multiHandler instance")
+	assert.Equal(t, logger.NewMultiHandler(derivedHandler1, derivedHandler2), result, "WithAttrs should return a handler with the derived handlers")
+	assert.Equal(t, logger.NewMultiHandler(mockHandler1, mockHandler2), multiHandler, "WithAttrs should not change the original handler")
 }
 
-func TestSlogMultiHandler_WithAttrs_NoHandlers_ReturnsItself(t *testing.T) {
+func TestSlogMultiHandler_WithAttrs_NoHandlers(t *testing.T) {
 	// Arrange
 	attrs := []slog.Attr{
 		slog.String("key1", "value1"),
@@ -288,7 +295,8 @@ func TestSlogMultiHandler_WithAttrs_NoHandlers_ReturnsItself(t *testing.T) {
 	result := multiHandler.WithAttrs(attrs)
 
 	// Assert
-	assert.Equal(t, multiHandler, result, "WithAttrs should return the same multiHandler instance")
+	assert.NotSame(t, multiHandler, result, "WithAttrs should return a new handler")
+	assert.False(t, result.Enabled(t.Context(), slog.LevelError), "WithAttrs should return a handler without handlers")
 }
 
 func TestSlogMultiHandler_WithGroup_CallsAllHandlers(t *testing.T) {
@@ -299,16 +307,22 @@ func TestSlogMultiHandler_WithGroup_CallsAllHandlers(t *testing.T) {
 	mockHandler2 := &loggermocks.MockHandler{}
 	defer mockHandler2.AssertExpectations(t)
 
+	derivedHandler1 := &loggermocks.MockHandler{}
+	defer derivedHandler1.AssertExpectations(t)
+
+	derivedHandler2 := &loggermocks.MockHandler{}
+	defer derivedHandler2.AssertExpectations(t)
+
 	expectedGroupName := "test-group"
 
 	mockHandler1.EXPECT().
 		WithGroup(expectedGroupName).
-		Return(mockHandler1).
+		Return(derivedHandler1).
 		Once()
 
 	mockHandler2.EXPECT().
 		WithGroup(expectedGroupName).
-		Return(mockHandler2).
+		Return(derivedHandler2).
 		Once()
 
 	multiHandler := logger.NewMultiHandler(mockHandler1, mockHandler2)
@@ -317,10 +331,11 @@ func TestSlogMultiHandler_WithGroup_CallsAllHandlers(t *testing.T) {
 	result := multiHandler.WithGroup(expectedGroupName)
 
 	// Assert
-	assert.Equal(t, multiHandler, result, "WithGroup should return the same multiHandler instance")
+	assert.Equal(t, logger.NewMultiHandler(derivedHandler1, derivedHandler2), result, "WithGroup should return a handler with the derived handlers")
+	assert.Equal(t, logger.NewMultiHandler(mockHandler1, mockHandler2), multiHandler, "WithGroup should not change the original handler")
 }
 
-func TestSlogMultiHandler_WithGroup_NoHandlers_ReturnsItself(t *testing.T) {
+func TestSlogMultiHandler_WithGroup_NoHandlers(t *testing.T) {
 	// Arrange
 	groupName := "test-group"
 
@@ -330,5 +345,6 @@ func TestSlogMultiHandler_WithGroup_NoHandlers_ReturnsItself(t *testing.T) {
 	result := multiHandler.WithGroup(groupName)
 
 	// Assert
-	assert.Equal(t, multiHandler, result, "WithGroup should return the same multiHandler instance")
+	assert.NotSame(t, multiHandler, result, "WithGroup should return a new handler")
+	assert.False(t, result.Enabled(t.Context(), slog.LevelError), "WithGroup should return a handler without handlers")
 }
@@ -0,0 +1,153 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codeanalyzer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
+)
+
+// matlabFileIssue represents a single issue from codeIssues function, when it analyzes several files
+type matlabFileIssue struct {
+	matlabIssue
+
+	FullFilename string `json:"FullFilename"`
+	CheckID      string `json:"CheckID"`
+}
+
+// matlabFilesCodeIssuesResponse represents the response from codeIssues function, when it analyzes several files
+type matlabFilesCodeIssuesResponse struct {
+	Issues json.RawMessage `json:"Issues"`
+}
+
+// matlabCheckcodeItemWithID represents a single item from checkcode function called with the -id option
+type matlabCheckcodeItemWithID struct {
+	matlabCheckcodeItem
+
+	ID string `json:"id"`
+}
+
+// AnalyzeFiles runs MATLAB code analysis on several files in one call and returns the issues found in each file.
+// If configurationFile is not empty, the analysis uses that Code Analyzer configuration, where MATLAB supports it.
+func (a *Analyzer) AnalyzeFiles(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string, configurationFile string) ([]analyzematlabproject.CodeIssue, error) {
+	method := selectCodeCheckMethod(ctx, logger, client)
+
+	if method == checkCodeMethodName && configurationFile != "" {
+		logger.With("configuration", configurationFile).Warn("This MATLAB release does not support Code Analyzer configuration files, analyzing with the default checks")
+	}
+
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code: filesAnalysisExpression(method, files, configurationFile),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if method == codeIssuesMethodName {
+		return parseFilesCodeIssuesResponse(response.ConsoleOutput)
+	}
+
+	return parseFilesCheckcodeResponse(response.ConsoleOutput, files)
+}
+
+func filesAnalysisExpression(method string, files []string, configurationFile string) string {
+	quotedFiles := make([]string, len(files))
+	for i, file := range files {
+		quotedFiles[i] = "'" + matlabstring.EscapeSingleQuotes(file) + "'"
+	}
+	fileList := "{" + strings.Join(quotedFiles, ", ") + "}"
+
+	if method == checkCodeMethodName {
+		return fmt.Sprintf("disp(jsonencode(%s(%s, '-id')))", method, fileList)
+	}
+
+	if configurationFile == "" {
+		return fmt.Sprintf("disp(jsonencode(%s(%s)))", method, fileList)
+	}
+
+	return fmt.Sprintf("disp(jsonencode(%s(%s, 'CodeAnalyzerConfiguration', '%s')))", method, fileList, matlabstring.EscapeSingleQuotes(configurationFile))
+}
+
+// parseFilesCodeIssuesResponse processes output from the codeIssues function (R2022b+), for several files
+func parseFilesCodeIssuesResponse(jsonOutput string) ([]analyzematlabproject.CodeIssue, error) {
+	var response matlabFilesCodeIssuesResponse
+	if err := unmarshalJSON(jsonOutput, &response, codeIssuesMethodName); err != nil {
+		return nil, err
+	}
+
+	var matlabIssues []matlabFileIssue
+	if err := unmarshalStructArray(response.Issues, &matlabIssues, codeIssuesMethodName); err != nil {
+		return nil, err
+	}
+
+	issues := make([]analyzematlabproject.CodeIssue, 0, len(matlabIssues))
+	for _, matlabIssue := range matlabIssues {
+		issues = append(issues, analyzematlabproject.CodeIssue{
+			File:        matlabIssue.FullFilename,
+			CheckID:     matlabIssue.CheckID,
+			Description: matlabIssue.Description,
+			Line:        matlabIssue.LineStart,
+			StartColumn: matlabIssue.ColumnStart,
+			EndColumn:   matlabIssue.ColumnEnd,
+			Severity:    matlabIssue.Severity,
+			Fixable:     strings.EqualFold(matlabIssue.Fixability, "auto"),
+		})
+	}
+
+	return issues, nil
+}
+
+// parseFilesCheckcodeResponse processes output from the checkcode function (legacy), which returns a list of the issues of each file, in order
+func parseFilesCheckcodeResponse(jsonOutput string, files []string) ([]analyzematlabproject.CodeIssue, error) {
+	var itemsPerFile []json.RawMessage
+	if err := unmarshalStructArray([]byte(jsonOutput), &itemsPerFile, checkCodeMethodName); err != nil {
+		return nil, err
+	}
+
+	if len(itemsPerFile) != len(files) {
+		return nil, fmt.Errorf("failed to parse %s output: got issues for %d files, expected %d", checkCodeMethodName, len(itemsPerFile), len(files))
+	}
+
+	var issues []analyzematlabproject.CodeIssue
+	for i, rawItems := range itemsPerFile {
+		var items []matlabCheckcodeItemWithID
+		if err := unmarshalStructArray(rawItems, &items, checkCodeMethodName); err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			startColumn, endColumn := extractColumnRange(item.Column)
+			issues = append(issues, analyzematlabproject.CodeIssue{
+				File:        files[i],
+				CheckID:     item.ID,
+				Description: item.Message,
+				Line:        item.Line,
+				StartColumn: startColumn,
+				EndColumn:   endColumn,
+				Severity:    analyzematlabproject.SeverityWarning,
+				Fixable:     item.Fix == 1,
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// unmarshalStructArray unmarshals the JSON of a MATLAB array, which jsonencode writes as a single value when it has one element
+func unmarshalStructArray(raw []byte, target any, methodName string) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] != '[' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return unmarshalJSON(string(raw), target, methodName)
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codeanalyzer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/codeanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzer_AnalyzeFiles_CodeIssuesMethod(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	firstFile := filepath.Join("project", "first.m")
+	secondFile := filepath.Join("project", "sub", "second.mlx")
+	configurationFile := filepath.Join("project", "resources", "codeAnalyzerConfiguration.json")
+
+	expectedVersionCheckRequest := entities.FEvalRequest{
+		Function:   "isMATLABReleaseOlderThan",
+		Arguments:  []string{"R2022b"},
+		NumOutputs: 1,
+	}
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "disp(jsonencode(codeIssues({'" + firstFile + "', '" + secondFile + "'}, 'CodeAnalyzerConfiguration', '" + configurationFile + "')))",
+	}
+	codeIssuesJSON := `{"Release":"R2024b","Issues":[` +
+		`{"FullFilename":"` + filepath.ToSlash(firstFile) + `","CheckID":"NASGU","Description":"Variable 'x' might be unused.","LineStart":5,"ColumnStart":1,"ColumnEnd":10,"Severity":"warning","Fixability":"auto"},` +
+		`{"FullFilename":"` + filepath.ToSlash(secondFile) + `","CheckID":"","Description":"Parse error.","LineStart":2,"ColumnStart":3,"ColumnEnd":3,"Severity":"error","Fixability":"none"}]}`
+	expectedIssues := []analyzematlabproject.CodeIssue{
+		{
+			File:        filepath.ToSlash(firstFile),
+			CheckID:     "NASGU",
+			Description: "Variable 'x' might be unused.",
+			Line:        5,
+			StartColumn: 1,
+			EndColumn:   10,
+			Severity:    "warning",
+			Fixable:     true,
+		},
+		{
+			File:        filepath.ToSlash(secondFile),
+			Description: "Parse error.",
+			Line:        2,
+			StartColumn: 3,
+			EndColumn:   3,
+			Severity:    "error",
+		},
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), expectedVersionCheckRequest).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: codeIssuesJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	issues, err := analyzer.AnalyzeFiles(t.Context(), mockLogger, mockClient, []string{firstFile, secondFile}, configurationFile)
+
+	// Assert
+	require.NoError(t, err, "AnalyzeFiles should not return an error")
+	assert.Equal(t, expectedIssues, issues, "Issues should match expected value")
+}
+
+func TestAnalyzer_AnalyzeFiles_CodeIssuesMethod_SingleIssueWithoutConfiguration(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	file := filepath.Join("project", "it's.m")
+	escapedFile := filepath.Join("project", "it''s.m")
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "disp(jsonencode(codeIssues({'" + escapedFile + "'})))",
+	}
+	codeIssuesJSON := `{"Issues":{"FullFilename":"a.m","CheckID":"SAGROW","Description":"Array grows.","LineStart":4,"ColumnStart":2,"ColumnEnd":6,"Severity":"warning","Fixability":"manual"}}`
+	expectedIssues := []analyzematlabproject.CodeIssue{
+		{
+			File:        "a.m",
+			CheckID:     "SAGROW",
+			Description: "Array grows.",
+			Line:        4,
+			StartColumn: 2,
+			EndColumn:   6,
+			Severity:    "warning",
+		},
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "isMATLABReleaseOlderThan",
+			Arguments:  []string{"R2022b"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: codeIssuesJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	issues, err := analyzer.AnalyzeFiles(t.Context(), mockLogger, mockClient, []string{file}, "")
+
+	// Assert
+	require.NoError(t, err, "AnalyzeFiles should not return an error")
+	assert.Equal(t, expectedIssues, issues, "Issues should match expected value")
+}
+
+func TestAnalyzer_AnalyzeFiles_CheckcodeMethod(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	firstFile := filepath.Join("project", "first.m")
+	secondFile := filepath.Join("project", "second.m")
+	thirdFile := filepath.Join("project", "third.m")
+	configurationFile := filepath.Join("project", "resources", "codeAnalyzerConfiguration.json")
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "disp(jsonencode(checkcode({'" + firstFile + "', '" + secondFile + "', '" + thirdFile + "'}, '-id')))",
+	}
+	checkcodeJSON := `[` +
+		`[{"id":"NASGU","message":"Variable 'x' might be unused.","fix":0,"line":5,"column":[1,10]},{"id":"NOPRT","message":"Terminate statement with semicolon.","fix":1,"line":6,"column":[4,4]}],` +
+		`[],` +
+		`{"id":"SAGROW","message":"Array grows.","fix":0,"line":2,"column":[3,8]}]`
+	expectedIssues := []analyzematlabproject.CodeIssue{
+		{File: firstFile, CheckID: "NASGU", Description: "Variable 'x' might be unused.", Line: 5, StartColumn: 1, EndColumn: 10, Severity: "warning"},
+		{File: firstFile, CheckID: "NOPRT", Description: "Terminate statement with semicolon.", Line: 6, StartColumn: 4, EndColumn: 4, Severity: "warning", Fixable: true},
+		{File: thirdFile, CheckID: "SAGROW", Description: "Array grows.", Line: 2, StartColumn: 3, EndColumn: 8, Severity: "warning"},
+	}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "isMATLABReleaseOlderThan",
+			Arguments:  []string{"R2022b"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{true}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: checkcodeJSON}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	issues, err := analyzer.AnalyzeFiles(t.Context(), mockLogger, mockClient, []string{firstFile, secondFile, thirdFile}, configurationFile)
+
+	// Assert
+	require.NoError(t, err, "AnalyzeFiles should not return an error")
+	assert.Equal(t, expectedIssues, issues, "Issues should match expected value")
+	assert.Contains(t, mockLogger.WarnLogs(), "This MATLAB release does not support Code Analyzer configuration files, analyzing with the default checks")
+}
+
+func TestAnalyzer_AnalyzeFiles_CheckcodeMethod_FileCountMismatch(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	files := []string{filepath.Join("project", "first.m"), filepath.Join("project", "second.m")}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "isMATLABReleaseOlderThan",
+			Arguments:  []string{"R2022b"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{true}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{
+			Code: "disp(jsonencode(checkcode({'" + files[0] + "', '" + files[1] + "'}, '-id')))",
+		}).
+		Return(entities.EvalResponse{ConsoleOutput: `[[]]`}, nil).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	issues, err := analyzer.AnalyzeFiles(t.Context(), mockLogger, mockClient, files, "")
+
+	// Assert
+	require.ErrorContains(t, err, "got issues for 1 files, expected 2")
+	assert.Nil(t, issues, "Issues should be nil on error")
+}
+
+func TestAnalyzer_AnalyzeFiles_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	files := []string{filepath.Join("project", "first.m")}
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "isMATLABReleaseOlderThan",
+			Arguments:  []string{"R2022b"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{false}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{
+			Code: "disp(jsonencode(codeIssues({'" + files[0] + "'})))",
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	analyzer := codeanalyzer.New()
+
+	// Act
+	issues, err := analyzer.AnalyzeFiles(t.Context(), mockLogger, mockClient, files, "")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, issues, "Issues should be nil on error")
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package dependencyanalyzer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
+)
+
+const (
+	requiredFilesAndProductsMethodName = "matlab.codetools.requiredFilesAndProducts"
+	mtreeMethodName                    = "mtree"
+	existMethodName                    = "exist"
+
+	// requiredFilesAndProductsCode needs variables for the two outputs, which it clears afterwards.
+	requiredFilesAndProductsCode = "[mcpRequiredFiles, mcpRequiredProducts] = matlab.codetools.requiredFilesAndProducts(%s); " +
+		"disp(jsonencode(struct('Files', {mcpRequiredFiles}, 'Products', {mcpRequiredProducts}))); " +
+		"clear('mcpRequiredFiles', 'mcpRequiredProducts')"
+
+	// functionReferencesCode parses each file, and lists the names it calls as functions, and the names of the functions,
+	// arguments, variables and loop indices it defines.
+	functionReferencesCode = "disp(jsonencode(cellfun(@(file, tree) struct(" +
+		"'File', file, " +
+		"'Calls', {strings(Left(mtfind(tree, 'Kind', {'CALL', 'DCALL'})))}, " +
+		"'Definitions', {[strings(Fname(mtfind(tree, 'Kind', 'FUNCTION'))), " +
+		"strings(List(Ins(mtfind(tree, 'Kind', 'FUNCTION')))), " +
+		"strings(List(Outs(mtfind(tree, 'Kind', 'FUNCTION')))), " +
+		"strings(asgvars(tree)), " +
+		"strings(Index(mtfind(tree, 'Kind', {'FOR', 'PARFOR'})))]}), " +
+		"%[1]s, cellfun(@(file) mtree(file, '-file'), %[1]s, 'UniformOutput', false), 'UniformOutput', false)))"
+
+	undefinedFunctionsCode = "disp(jsonencode(cellfun(@(name) exist(name), %s)))"
+)
+
+// matlabProduct represents a product from the requiredFilesAndProducts function
+type matlabProduct struct {
+	Name          string `json:"Name"`
+	Version       string `json:"Version"`
+	ProductNumber int    `json:"ProductNumber"`
+	Certain       bool   `json:"Certain"`
+}
+
+// matlabRequirements represents the outputs of the requiredFilesAndProducts function
+type matlabRequirements struct {
+	Files    json.RawMessage `json:"Files"`
+	Products json.RawMessage `json:"Products"`
+}
+
+// matlabFileReferences represents the names that a file calls and defines, from mtree
+type matlabFileReferences struct {
+	File        string          `json:"File"`
+	Calls       json.RawMessage `json:"Calls"`
+	Definitions json.RawMessage `json:"Definitions"`
+}
+
+// Analyzer finds the files, products and functions that MATLAB code depends on.
+type Analyzer struct{}
+
+// New creates a new Analyzer instance.
+func New() *Analyzer {
+	return &Analyzer{}
+}
+
+// RequiredFilesAndProducts returns the user files and MathWorks products that the files depend on, including the files themselves.
+func (a *Analyzer) RequiredFilesAndProducts(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) (analyzematlabdependencies.Requirements, error) {
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code: fmt.Sprintf(requiredFilesAndProductsCode, cellArray(files)),
+	})
+	if err != nil {
+		return analyzematlabdependencies.Requirements{}, err
+	}
+
+	var matlabResponse matlabRequirements
+	if err := unmarshalJSON([]byte(response.ConsoleOutput), &matlabResponse, requiredFilesAndProductsMethodName); err != nil {
+		return analyzematlabdependencies.Requirements{}, err
+	}
+
+	var requiredFiles []string
+	if err := unmarshalArray(matlabResponse.Files, &requiredFiles, requiredFilesAndProductsMethodName); err != nil {
+		return analyzematlabdependencies.Requirements{}, err
+	}
+
+	var matlabProducts []matlabProduct
+	if err := unmarshalArray(matlabResponse.Products, &matlabProducts, requiredFilesAndProductsMethodName); err != nil {
+		return analyzematlabdependencies.Requirements{}, err
+	}
+
+	requirements := analyzematlabdependencies.Requirements{
+		Files:    requiredFiles,
+		Products: make([]analyzematlabdependencies.RequiredProduct, 0, len(matlabProducts)),
+	}
+	for _, product := range matlabProducts {
+		requirements.Products = append(requirements.Products, analyzematlabdependencies.RequiredProduct{
+			Name:          product.Name,
+			Version:       product.Version,
+			ProductNumber: product.ProductNumber,
+			Certain:       product.Certain,
+		})
+	}
+
+	return requirements, nil
+}
+
+// FunctionReferences returns the names that each .m file calls as functions, and the names that it defines.
+func (a *Analyzer) FunctionReferences(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) ([]analyzematlabdependencies.FileReferences, error) {
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code: fmt.Sprintf(functionReferencesCode, cellArray(files)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matlabReferences []matlabFileReferences
+	if err := unmarshalArray([]byte(response.ConsoleOutput), &matlabReferences, mtreeMethodName); err != nil {
+		return nil, err
+	}
+
+	references := make([]analyzematlabdependencies.FileReferences, 0, len(matlabReferences))
+	for _, fileReferences := range matlabReferences {
+		var calls, definitions []string
+		if err := unmarshalArray(fileReferences.Calls, &calls, mtreeMethodName); err != nil {
+			return nil, err
+		}
+		if err := unmarshalArray(fileReferences.Definitions, &definitions, mtreeMethodName); err != nil {
+			return nil, err
+		}
+
+		references = append(references, analyzematlabdependencies.FileReferences{
+			File:        fileReferences.File,
+			Calls:       calls,
+			Definitions: definitions,
+		})
+	}
+
+	return references, nil
+}
+
+// UndefinedFunctions returns the names that MATLAB cannot find on its path or in its current folder, in the order of names.
+func (a *Analyzer) UndefinedFunctions(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, names []string) ([]string, error) {
+	response, err := client.EvalWithCapture(ctx, logger, entities.EvalRequest{
+		Code: fmt.Sprintf(undefinedFunctionsCode, cellArray(names)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var existCodes []int
+	if err := unmarshalArray([]byte(response.ConsoleOutput), &existCodes, existMethodName); err != nil {
+		return nil, err
+	}
+
+	if len(existCodes) != len(names) {
+		return nil, fmt.Errorf("failed to parse %s output: got %d results, expected %d", existMethodName, len(existCodes), len(names))
+	}
+
+	undefinedFunctions := []string{}
+	for i, name := range names {
+		if existCodes[i] == 0 {
+			undefinedFunctions = append(undefinedFunctions, name)
+		}
+	}
+
+	return undefinedFunctions, nil
+}
+
+func cellArray(values []string) string {
+	quotedValues := make([]string, len(values))
+	for i, value := range values {
+		quotedValues[i] = "'" + matlabstring.EscapeSingleQuotes(value) + "'"
+	}
+	return "{" + strings.Join(quotedValues, ", ") + "}"
+}
+
+func unmarshalJSON(raw []byte, target any, methodName string) error {
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("failed to parse %s output: %w", methodName, err)
+	}
+	return nil
+}
+
+// unmarshalArray unmarshals the JSON of a MATLAB array, which jsonencode writes as a single value when it has one element
+func unmarshalArray(raw []byte, target any, methodName string) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] != '[' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return unmarshalJSON(raw, target, methodName)
+}
@@ -0,0 +1,285 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package dependencyanalyzer_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/dependencyanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	analyzer := dependencyanalyzer.New()
+
+	// Assert
+	assert.NotNil(t, analyzer, "Analyzer should not be nil")
+}
+
+func TestAnalyzer_RequiredFilesAndProducts_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mainFile := filepath.Join("work", "main.m")
+	quotedFile := filepath.Join("work", "it's.m")
+	helperFile := filepath.Join("work", "helper.m")
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "[mcpRequiredFiles, mcpRequiredProducts] = matlab.codetools.requiredFilesAndProducts({'" + mainFile + "', '" + strings.ReplaceAll(quotedFile, "'", "''") + "'}); " +
+			"disp(jsonencode(struct('Files', {mcpRequiredFiles}, 'Products', {mcpRequiredProducts}))); " +
+			"clear('mcpRequiredFiles', 'mcpRequiredProducts')",
+	}
+	consoleOutput := fmt.Sprintf(`{"Files":[%q,%q,%q],"Products":[{"Name":"MATLAB","Version":"24.2","ProductNumber":1,"Certain":true},{"Name":"Signal Processing Toolbox","Version":"24.2","ProductNumber":8,"Certain":false}]}`, mainFile, quotedFile, helperFile)
+
+	expectedRequirements := analyzematlabdependencies.Requirements{
+		Files: []string{mainFile, quotedFile, helperFile},
+		Products: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false},
+		},
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: consoleOutput}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	requirements, err := analyzer.RequiredFilesAndProducts(t.Context(), mockLogger, mockClient, []string{mainFile, quotedFile})
+
+	// Assert
+	require.NoError(t, err, "RequiredFilesAndProducts should not return an error")
+	assert.Equal(t, expectedRequirements, requirements)
+}
+
+func TestAnalyzer_RequiredFilesAndProducts_SingleValues(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mainFile := filepath.Join("work", "main.m")
+	consoleOutput := fmt.Sprintf(`{"Files":%q,"Products":{"Name":"MATLAB","Version":"24.2","ProductNumber":1,"Certain":true}}`, mainFile)
+
+	expectedRequirements := analyzematlabdependencies.Requirements{
+		Files:    []string{mainFile},
+		Products: []analyzematlabdependencies.RequiredProduct{{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true}},
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "[mcpRequiredFiles, mcpRequiredProducts] = matlab.codetools.requiredFilesAndProducts({'" + mainFile + "'}); " +
+			"disp(jsonencode(struct('Files', {mcpRequiredFiles}, 'Products', {mcpRequiredProducts}))); " +
+			"clear('mcpRequiredFiles', 'mcpRequiredProducts')"}).
+		Return(entities.EvalResponse{ConsoleOutput: consoleOutput}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	requirements, err := analyzer.RequiredFilesAndProducts(t.Context(), mockLogger, mockClient, []string{mainFile})
+
+	// Assert
+	require.NoError(t, err, "RequiredFilesAndProducts should not return an error")
+	assert.Equal(t, expectedRequirements, requirements)
+}
+
+func TestAnalyzer_RequiredFilesAndProducts_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := fmt.Errorf("eval failed")
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "[mcpRequiredFiles, mcpRequiredProducts] = matlab.codetools.requiredFilesAndProducts({'main.m'}); " +
+			"disp(jsonencode(struct('Files', {mcpRequiredFiles}, 'Products', {mcpRequiredProducts}))); " +
+			"clear('mcpRequiredFiles', 'mcpRequiredProducts')"}).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	requirements, err := analyzer.RequiredFilesAndProducts(t.Context(), mockLogger, mockClient, []string{"main.m"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, requirements)
+}
+
+func TestAnalyzer_RequiredFilesAndProducts_InvalidOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "[mcpRequiredFiles, mcpRequiredProducts] = matlab.codetools.requiredFilesAndProducts({'main.m'}); " +
+			"disp(jsonencode(struct('Files', {mcpRequiredFiles}, 'Products', {mcpRequiredProducts}))); " +
+			"clear('mcpRequiredFiles', 'mcpRequiredProducts')"}).
+		Return(entities.EvalResponse{ConsoleOutput: "Error using matlab.codetools.requiredFilesAndProducts"}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	requirements, err := analyzer.RequiredFilesAndProducts(t.Context(), mockLogger, mockClient, []string{"main.m"})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse matlab.codetools.requiredFilesAndProducts output")
+	assert.Empty(t, requirements)
+}
+
+func TestAnalyzer_FunctionReferences_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mainFile := filepath.Join("work", "main.m")
+	helperFile := filepath.Join("work", "helper.m")
+	files := "{'" + mainFile + "', '" + helperFile + "'}"
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code: "disp(jsonencode(cellfun(@(file, tree) struct(" +
+			"'File', file, " +
+			"'Calls', {strings(Left(mtfind(tree, 'Kind', {'CALL', 'DCALL'})))}, " +
+			"'Definitions', {[strings(Fname(mtfind(tree, 'Kind', 'FUNCTION'))), " +
+			"strings(List(Ins(mtfind(tree, 'Kind', 'FUNCTION')))), " +
+			"strings(List(Outs(mtfind(tree, 'Kind', 'FUNCTION')))), " +
+			"strings(asgvars(tree)), " +
+			"strings(Index(mtfind(tree, 'Kind', {'FOR', 'PARFOR'})))]}), " +
+			files + ", cellfun(@(file) mtree(file, '-file'), " + files + ", 'UniformOutput', false), 'UniformOutput', false)))",
+	}
+	consoleOutput := fmt.Sprintf(`[{"File":%q,"Calls":["helper","plot"],"Definitions":["main","x"]},{"File":%q,"Calls":"zeros","Definitions":[]}]`, mainFile, helperFile)
+
+	expectedReferences := []analyzematlabdependencies.FileReferences{
+		{File: mainFile, Calls: []string{"helper", "plot"}, Definitions: []string{"main", "x"}},
+		{File: helperFile, Calls: []string{"zeros"}, Definitions: []string{}},
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(entities.EvalResponse{ConsoleOutput: consoleOutput}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	references, err := analyzer.FunctionReferences(t.Context(), mockLogger, mockClient, []string{mainFile, helperFile})
+
+	// Assert
+	require.NoError(t, err, "FunctionReferences should not return an error")
+	assert.Equal(t, expectedReferences, references)
+}
+
+func TestAnalyzer_FunctionReferences_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := fmt.Errorf("eval failed")
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	references, err := analyzer.FunctionReferences(t.Context(), mockLogger, mockClient, []string{"main.m"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, references)
+}
+
+func TestAnalyzer_UndefinedFunctions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "disp(jsonencode(cellfun(@(name) exist(name), {'fancyFilter', 'plot', 'zeros'})))"}).
+		Return(entities.EvalResponse{ConsoleOutput: "[0,2,5]\n"}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	undefinedFunctions, err := analyzer.UndefinedFunctions(t.Context(), mockLogger, mockClient, []string{"fancyFilter", "plot", "zeros"})
+
+	// Assert
+	require.NoError(t, err, "UndefinedFunctions should not return an error")
+	assert.Equal(t, []string{"fancyFilter"}, undefinedFunctions)
+}
+
+func TestAnalyzer_UndefinedFunctions_SingleName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "disp(jsonencode(cellfun(@(name) exist(name), {'plot'})))"}).
+		Return(entities.EvalResponse{ConsoleOutput: "2"}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	undefinedFunctions, err := analyzer.UndefinedFunctions(t.Context(), mockLogger, mockClient, []string{"plot"})
+
+	// Assert
+	require.NoError(t, err, "UndefinedFunctions should not return an error")
+	assert.NotNil(t, undefinedFunctions)
+	assert.Empty(t, undefinedFunctions)
+}
+
+func TestAnalyzer_UndefinedFunctions_ResultCountMismatch(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		EvalWithCapture(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "disp(jsonencode(cellfun(@(name) exist(name), {'plot', 'zeros'})))"}).
+		Return(entities.EvalResponse{ConsoleOutput: "[2]"}, nil).
+		Once()
+
+	analyzer := dependencyanalyzer.New()
+
+	// Act
+	undefinedFunctions, err := analyzer.UndefinedFunctions(t.Context(), mockLogger, mockClient, []string{"plot", "zeros"})
+
+	// Assert
+	require.ErrorContains(t, err, "got 1 results, expected 2")
+	assert.Nil(t, undefinedFunctions)
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package helpreader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+)
+
+const (
+	helpFunction    = "matlab_mcp.mcpHelp"
+	releaseFunction = "version"
+)
+
+var (
+	// markupRegexp matches the hyperlinks and bold text that MATLAB adds to help text.
+	markupRegexp = regexp.MustCompile(`</?(a|strong)\b[^>]*>`)
+	// seeAlsoRegexp matches the start of the list of related functions.
+	seeAlsoRegexp = regexp.MustCompile(`(?i)^see\s+also:?\s*`)
+	// relatedNameRegexp matches the related functions that can be looked up, and not documentation topics such as "Line Properties".
+	relatedNameRegexp = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
+)
+
+// Reader reads the help of MATLAB functions, classes and namespaces.
+type Reader struct {
+	lock             sync.Mutex
+	releaseSessionID entities.SessionID
+	release          string
+}
+
+// New creates a new Reader instance.
+func New() *Reader {
+	return &Reader{}
+}
+
+type helpInfo struct {
+	Found   bool
+	Text    string
+	Product string
+	Members []string
+}
+
+// Release returns the release of the MATLAB session, such as R2025b. It is read once per session,
+// and read again when MATLAB starts again, since the new session has another ID.
+func (r *Reader) Release(ctx context.Context, logger entities.Logger, sessionID entities.SessionID, client entities.MATLABSessionClient) (string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.release != "" && r.releaseSessionID == sessionID {
+		return r.release, nil
+	}
+
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   releaseFunction,
+		Arguments:  []string{"-release"},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Outputs) != 1 {
+		return "", fmt.Errorf("unexpected number of outputs from %s: %d", releaseFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to cast output of %s to string", releaseFunction)
+	}
+
+	r.releaseSessionID = sessionID
+	r.release = "R" + output
+
+	return r.release, nil
+}
+
+// Read returns the help of a MATLAB function, class or namespace.
+func (r *Reader) Read(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, name string) (matlabhelp.Help, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   helpFunction,
+		Arguments:  []string{name},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return matlabhelp.Help{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return matlabhelp.Help{}, fmt.Errorf("unexpected number of outputs from %s: %d", helpFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return matlabhelp.Help{}, fmt.Errorf("failed to cast output of %s to string", helpFunction)
+	}
+
+	var info helpInfo
+	if err := json.Unmarshal([]byte(output), &info); err != nil {
+		return matlabhelp.Help{}, fmt.Errorf("failed to parse output of %s: %w", helpFunction, err)
+	}
+
+	if !info.Found {
+		return matlabhelp.Help{}, fmt.Errorf("%w: %q", matlabhelp.ErrNotFound, name)
+	}
+
+	help := parseHelpText(name, info.Text)
+	help.Product = info.Product
+	help.Members = info.Members
+	if help.Members == nil {
+		help.Members = []string{}
+	}
+
+	return help, nil
+}
+
+// parseHelpText splits the help text that MATLAB prints into a summary, the syntax lines, the related functions
+// and the rest of the text. It handles the help of functions written in the "H1 line" style, where the syntax is
+// given inline, as well as the help of recent releases, which has a Syntax section.
+func parseHelpText(name string, text string) matlabhelp.Help {
+	help := matlabhelp.Help{
+		Name:    name,
+		Syntax:  []string{},
+		SeeAlso: []string{},
+	}
+
+	lines := strings.Split(strings.ReplaceAll(markupRegexp.ReplaceAllString(text, ""), "\r\n", "\n"), "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+
+	if len(lines) == 0 {
+		return help
+	}
+
+	help.Summary = summary(name, lines[0])
+	lines = dedent(lines[1:])
+
+	var description []string
+	inSyntax, inSeeAlso := false, false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		atTopLevel := trimmed != "" && !strings.HasPrefix(line, " ")
+
+		if atTopLevel && (strings.HasPrefix(trimmed, "Documentation for") || strings.HasPrefix(trimmed, "Other uses of")) {
+			break
+		}
+
+		switch {
+		case atTopLevel && trimmed == "Syntax":
+			inSyntax, inSeeAlso = true, false
+			continue
+		case atTopLevel && seeAlsoRegexp.MatchString(trimmed):
+			inSyntax, inSeeAlso = false, true
+			help.SeeAlso = append(help.SeeAlso, relatedNames(seeAlsoRegexp.ReplaceAllString(trimmed, ""))...)
+			continue
+		case atTopLevel:
+			inSyntax, inSeeAlso = false, false
+		case inSeeAlso && trimmed == "":
+			inSeeAlso = false
+		}
+
+		switch {
+		case inSyntax && trimmed != "":
+			help.Syntax = append(help.Syntax, trimmed)
+		case inSyntax:
+		case inSeeAlso:
+			help.SeeAlso = append(help.SeeAlso, relatedNames(trimmed)...)
+		default:
+			description = append(description, strings.TrimRight(line, " \t"))
+		}
+	}
+
+	if len(help.Syntax) == 0 {
+		help.Syntax = inlineSyntax(name, description)
+	}
+
+	help.Text = joinParagraphs(description)
+	return help
+}
+
+// summary removes the name that starts the first line of the help text, such as "plot - " or "PLOT   ".
+func summary(name string, line string) string {
+	trimmed := strings.TrimSpace(line)
+
+	shortName := name[strings.LastIndex(name, ".")+1:]
+	firstWord, rest, _ := strings.Cut(trimmed, " ")
+	if !strings.EqualFold(firstWord, name) && !strings.EqualFold(firstWord, shortName) {
+		return trimmed
+	}
+
+	rest = strings.TrimSpace(rest)
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, "-"))
+	return rest
+}
+
+// dedent removes the indentation that all the non-empty lines share.
+func dedent(lines []string) []string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent < 0 || lineIndent < indent {
+			indent = lineIndent
+		}
+	}
+
+	dedented := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			line = line[indent:]
+		}
+
+		dedented[i] = line
+	}
+
+	return dedented
+}
+
+// relatedNames splits a list of related functions, such as "title, xlabel, LineSpec.". Names written in capitals
+// by older help text, such as "PLOT3", are lowercased, as MATLAB names are case sensitive.
+func relatedNames(list string) []string {
+	var names []string
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(entry), "."))
+		if !relatedNameRegexp.MatchString(entry) {
+			continue
+		}
+
+		if entry == strings.ToUpper(entry) {
+			entry = strings.ToLower(entry)
+		}
+
+		names = append(names, entry)
+	}
+
+	return names
+}
+
+// inlineSyntax finds the calls that start the paragraphs of help text without a Syntax section, such as
+// "plot(X,Y) plots vector Y versus vector X." or "[B,I] = SORT(A) also returns an index matrix.".
+func inlineSyntax(name string, lines []string) []string {
+	shortName := regexp.QuoteMeta(name[strings.LastIndex(name, ".")+1:])
+	callRegexp := regexp.MustCompile(`(?i)^((\[[^\]]*\]|\w+)\s*=\s*)?(` + regexp.QuoteMeta(name) + `|` + shortName + `)\([^)]*\)(\s|$)`)
+
+	syntax := []string{}
+	for _, line := range lines {
+		if strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		call := strings.TrimSpace(callRegexp.FindString(line))
+		if call == "" || slices.Contains(syntax, call) {
+			continue
+		}
+
+		syntax = append(syntax, call)
+	}
+
+	return syntax
+}
+
+// joinParagraphs joins the lines of text, without the leading and trailing empty lines, and with a single empty line
+// between paragraphs.
+func joinParagraphs(lines []string) string {
+	var builder strings.Builder
+	pendingEmptyLine := false
+	for _, line := range lines {
+		if line == "" {
+			pendingEmptyLine = builder.Len() > 0
+			continue
+		}
+
+		if pendingEmptyLine {
+			builder.WriteString("\n")
+			pendingEmptyLine = false
+		}
+
+		builder.WriteString(line)
+		builder.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
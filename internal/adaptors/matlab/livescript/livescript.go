@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package livescript
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const (
+	liveScriptCodeFunction    = "matlab_mcp.mcpLiveScriptCode"
+	convertLiveScriptFunction = "matlab_mcp.mcpConvertLiveScript"
+)
+
+// Converter converts live scripts (.mlx) with the Live Editor of a MATLAB session.
+type Converter struct{}
+
+// New creates a new Converter instance.
+func New() *Converter {
+	return &Converter{}
+}
+
+// Code returns the code of a live script, in which the formatted text of the live script is comments.
+func (c *Converter) Code(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string) (string, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   liveScriptCodeFunction,
+		Arguments:  []string{liveScriptPath},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Outputs) != 1 {
+		return "", fmt.Errorf("unexpected number of outputs from %s: %d", liveScriptCodeFunction, len(response.Outputs))
+	}
+
+	code, ok := response.Outputs[0].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to cast output of %s to string", liveScriptCodeFunction)
+	}
+
+	return code, nil
+}
+
+// Convert exports a live script to outputPath. The extension of outputPath selects the format.
+func (c *Converter) Convert(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string, outputPath string) error {
+	_, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   convertLiveScriptFunction,
+		Arguments:  []string{liveScriptPath, outputPath},
+		NumOutputs: 0,
+	})
+	return err
+}
@@ -0,0 +1,242 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabinstallation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const matlabProductName = "MATLAB"
+
+var (
+	updateLevelRegexp     = regexp.MustCompile(`Update\s?(\d+)`)
+	contentsNameRegexp    = regexp.MustCompile(`^%\s*(\S.*?)\s*$`)
+	contentsVersionRegexp = regexp.MustCompile(`^%\s*Version\s+(\S+)\s+\((R\d{4}[ab])\)`)
+)
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+type versionInfo struct {
+	Version     string `xml:"version"`
+	Release     string `xml:"release"`
+	Description string `xml:"description"`
+}
+
+// Reader reads the MATLAB release and the installed products from the files of a MATLAB installation,
+// without starting MATLAB.
+type Reader struct {
+	osLayer   OSLayer
+	fileLayer FileLayer
+}
+
+func New(
+	osLayer OSLayer,
+	fileLayer FileLayer,
+) *Reader {
+	return &Reader{
+		osLayer:   osLayer,
+		fileLayer: fileLayer,
+	}
+}
+
+// Read returns the MATLAB installation details found in matlabRoot.
+// The release comes from VersionInfo.xml, which must exist.
+// Products come from the product metadata in appdata/products/*.xml and from toolbox/*/Contents.m.
+// Files that cannot be read or parsed are skipped.
+func (r *Reader) Read(logger entities.Logger, matlabRoot string) (entities.MATLABInstallation, error) {
+	versionInfoPath := filepath.Join(matlabRoot, "VersionInfo.xml")
+
+	versionInfoContent, err := r.osLayer.ReadFile(versionInfoPath)
+	if err != nil {
+		return entities.MATLABInstallation{}, err
+	}
+
+	var info versionInfo
+	if err := xml.Unmarshal(versionInfoContent, &info); err != nil {
+		return entities.MATLABInstallation{}, fmt.Errorf("failed to parse %s: %w", versionInfoPath, err)
+	}
+
+	updateLevel := 0
+	if match := updateLevelRegexp.FindStringSubmatch(info.Description); match != nil {
+		updateLevel, _ = strconv.Atoi(match[1])
+	}
+
+	productsByName := make(map[string]entities.MATLABProduct)
+
+	for _, productFile := range r.glob(logger, filepath.Join(matlabRoot, "appdata", "products", "*.xml")) {
+		product, ok := r.readProductFile(logger, productFile)
+		if !ok {
+			continue
+		}
+		mergeProduct(productsByName, product)
+	}
+
+	for _, contentsFile := range r.glob(logger, filepath.Join(matlabRoot, "toolbox", "*", "Contents.m")) {
+		product, ok := r.readContentsFile(logger, contentsFile)
+		if !ok {
+			continue
+		}
+		mergeProduct(productsByName, product)
+	}
+
+	products := make([]entities.MATLABProduct, 0, len(productsByName))
+	for _, product := range productsByName {
+		// Every product in a MATLAB root belongs to the release of that root
+		if product.Release == "" {
+			product.Release = info.Release
+		}
+		products = append(products, product)
+	}
+
+	// Follow the order of ver: MATLAB first, then the other products alphabetically
+	sort.Slice(products, func(i, j int) bool {
+		if (products[i].Name == matlabProductName) != (products[j].Name == matlabProductName) {
+			return products[i].Name == matlabProductName
+		}
+		return products[i].Name < products[j].Name
+	})
+
+	return entities.MATLABInstallation{
+		Version:     info.Version,
+		Release:     info.Release,
+		UpdateLevel: updateLevel,
+		Products:    products,
+	}, nil
+}
+
+func (r *Reader) glob(logger entities.Logger, pattern string) []string {
+	matches, err := r.fileLayer.Glob(pattern)
+	if err != nil {
+		logger.With("pattern", pattern).WithError(err).Warn("Unable to list MATLAB product metadata")
+		return nil
+	}
+
+	return matches
+}
+
+// readProductFile reads a product metadata XML file.
+// The exact layout varies between releases, so the first name, version and base code found,
+// either as elements or as attributes, are used.
+func (r *Reader) readProductFile(logger entities.Logger, productFile string) (entities.MATLABProduct, bool) {
+	content, err := r.osLayer.ReadFile(productFile)
+	if err != nil {
+		logger.With("path", productFile).WithError(err).Warn("Unable to read MATLAB product metadata")
+		return entities.MATLABProduct{}, false
+	}
+
+	var product entities.MATLABProduct
+	var currentField *string
+
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.With("path", productFile).WithError(err).Warn("Unable to parse MATLAB product metadata")
+			return entities.MATLABProduct{}, false
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			currentField = productField(&product, element.Name.Local)
+			for _, attr := range element.Attr {
+				if field := productField(&product, attr.Name.Local); field != nil && *field == "" {
+					*field = strings.TrimSpace(attr.Value)
+				}
+			}
+		case xml.CharData:
+			if currentField != nil && *currentField == "" {
+				*currentField = strings.TrimSpace(string(element))
+			}
+		case xml.EndElement:
+			currentField = nil
+		}
+	}
+
+	if product.Name == "" {
+		return entities.MATLABProduct{}, false
+	}
+
+	return product, true
+}
+
+func productField(product *entities.MATLABProduct, name string) *string {
+	switch strings.ToLower(name) {
+	case "productname":
+		return &product.Name
+	case "productversion":
+		return &product.Version
+	case "productbasecode", "basecode":
+		return &product.BaseCode
+	case "productrelease", "release":
+		return &product.Release
+	default:
+		return nil
+	}
+}
+
+// readContentsFile reads the product name, version and release from the header of a toolbox Contents.m file:
+//
+//	% Signal Processing Toolbox
+//	% Version 24.2 (R2024b) 19-Jun-2024
+func (r *Reader) readContentsFile(logger entities.Logger, contentsFile string) (entities.MATLABProduct, bool) {
+	content, err := r.osLayer.ReadFile(contentsFile)
+	if err != nil {
+		logger.With("path", contentsFile).WithError(err).Warn("Unable to read toolbox Contents.m")
+		return entities.MATLABProduct{}, false
+	}
+
+	lines := strings.SplitN(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n", 3)
+	if len(lines) < 2 {
+		return entities.MATLABProduct{}, false
+	}
+
+	nameMatch := contentsNameRegexp.FindStringSubmatch(lines[0])
+	versionMatch := contentsVersionRegexp.FindStringSubmatch(lines[1])
+	if nameMatch == nil || versionMatch == nil {
+		return entities.MATLABProduct{}, false
+	}
+
+	return entities.MATLABProduct{
+		Name:    nameMatch[1],
+		Version: versionMatch[1],
+		Release: versionMatch[2],
+	}, true
+}
+
+func mergeProduct(productsByName map[string]entities.MATLABProduct, product entities.MATLABProduct) {
+	existing, ok := productsByName[product.Name]
+	if !ok {
+		productsByName[product.Name] = product
+		return
+	}
+
+	if existing.Version == "" {
+		existing.Version = product.Version
+	}
+	if existing.Release == "" {
+		existing.Release = product.Release
+	}
+	if existing.BaseCode == "" {
+		existing.BaseCode = product.BaseCode
+	}
+	productsByName[product.Name] = existing
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabinstallation_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabinstallation"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlab/matlabinstallation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const versionInfoXML = `<?xml version="1.0" encoding="UTF-8"?>
+<MathWorks_version_info>
+  <version>24.2.0.2712019</version>
+  <release>R2024b</release>
+  <description>Update 1</description>
+  <date>Aug 21 2024</date>
+</MathWorks_version_info>`
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	// Act
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Assert
+	assert.NotNil(t, reader)
+}
+
+func TestReader_Read_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	matlabProductFile := filepath.Join(matlabRoot, "appdata", "products", "MATLAB 24.2.xml")
+	signalProductFile := filepath.Join(matlabRoot, "appdata", "products", "Signal Processing Toolbox 24.2.xml")
+	signalContentsFile := filepath.Join(matlabRoot, "toolbox", "signal", "Contents.m")
+	statsContentsFile := filepath.Join(matlabRoot, "toolbox", "stats", "Contents.m")
+	unrelatedContentsFile := filepath.Join(matlabRoot, "toolbox", "local", "Contents.m")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(matlabRoot, "VersionInfo.xml")).
+		Return([]byte(versionInfoXML), nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "appdata", "products", "*.xml")).
+		Return([]string{matlabProductFile, signalProductFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(matlabProductFile).
+		Return([]byte(`<productInfo><productName>MATLAB</productName><productVersion>24.2</productVersion><productBaseCode>ML</productBaseCode></productInfo>`), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(signalProductFile).
+		Return([]byte(`<product baseCode="SG"><productName>Signal Processing Toolbox</productName></product>`), nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "toolbox", "*", "Contents.m")).
+		Return([]string{signalContentsFile, statsContentsFile, unrelatedContentsFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(signalContentsFile).
+		Return([]byte("% Signal Processing Toolbox\r\n% Version 24.2 (R2024b) 19-Jun-2024\r\n%\r\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(statsContentsFile).
+		Return([]byte("% Statistics and Machine Learning Toolbox\n% Version 24.2 (R2024b) 19-Jun-2024\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(unrelatedContentsFile).
+		Return([]byte("% Local and site-specific files\n"), nil).
+		Once()
+
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	expectedInstallation := entities.MATLABInstallation{
+		Version:     "24.2.0.2712019",
+		Release:     "R2024b",
+		UpdateLevel: 1,
+		Products: []entities.MATLABProduct{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", BaseCode: "ML"},
+			{Name: "Signal Processing Toolbox", Version: "24.2", Release: "R2024b", BaseCode: "SG"},
+			{Name: "Statistics and Machine Learning Toolbox", Version: "24.2", Release: "R2024b"},
+		},
+	}
+
+	// Act
+	installation, err := reader.Read(mockLogger, matlabRoot)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedInstallation, installation)
+
+	//nolint:testifylint // Clearer to use len check for number of errors
+	assert.Len(t, mockLogger.WarnLogs(), 0, "Unrelated Contents.m files should be skipped silently")
+}
+
+func TestReader_Read_VersionInfoReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(matlabRoot, "VersionInfo.xml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	installation, err := reader.Read(mockLogger, matlabRoot)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, installation)
+}
+
+func TestReader_Read_InvalidVersionInfo(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(matlabRoot, "VersionInfo.xml")).
+		Return([]byte("<MathWorks_version_info><release>"), nil).
+		Once()
+
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	installation, err := reader.Read(mockLogger, matlabRoot)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, installation)
+}
+
+func TestReader_Read_SkipsUnreadableProductMetadata(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	unreadableProductFile := filepath.Join(matlabRoot, "appdata", "products", "unreadable.xml")
+	invalidProductFile := filepath.Join(matlabRoot, "appdata", "products", "invalid.xml")
+	unreadableContentsFile := filepath.Join(matlabRoot, "toolbox", "signal", "Contents.m")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(matlabRoot, "VersionInfo.xml")).
+		Return([]byte(versionInfoXML), nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "appdata", "products", "*.xml")).
+		Return([]string{unreadableProductFile, invalidProductFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(unreadableProductFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(invalidProductFile).
+		Return([]byte("<product><productName>Broken"), nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "toolbox", "*", "Contents.m")).
+		Return([]string{unreadableContentsFile}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(unreadableContentsFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	installation, err := reader.Read(mockLogger, matlabRoot)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "R2024b", installation.Release)
+	assert.Empty(t, installation.Products)
+	assert.Len(t, mockLogger.WarnLogs(), 3, "Each unreadable file should trigger a warning log")
+}
+
+func TestReader_Read_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(matlabRoot, "VersionInfo.xml")).
+		Return([]byte(versionInfoXML), nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "appdata", "products", "*.xml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRoot, "toolbox", "*", "Contents.m")).
+		Return(nil, assert.AnError).
+		Once()
+
+	reader := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	installation, err := reader.Read(mockLogger, matlabRoot)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, installation.Products)
+	assert.Contains(t, mockLogger.WarnLogs(), "Unable to list MATLAB product metadata")
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabrelease
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	latestKeyword  = "latest"
+	minimumPrefix  = ">="
+	releasePattern = `^[Rr](\d{4})([abAB])$`
+)
+
+var releaseRegexp = regexp.MustCompile(releasePattern)
+
+// Release identifies a MATLAB release, such as R2024b.
+type Release struct {
+	year int
+	half byte
+}
+
+// Parse parses a MATLAB release name such as "R2024b". The match is case-insensitive.
+func Parse(name string) (Release, error) {
+	match := releaseRegexp.FindStringSubmatch(strings.TrimSpace(name))
+	if match == nil {
+		return Release{}, fmt.Errorf("invalid MATLAB release %q", name)
+	}
+
+	year, err := strconv.Atoi(match[1])
+	if err != nil {
+		return Release{}, fmt.Errorf("invalid MATLAB release %q: %w", name, err)
+	}
+
+	return Release{
+		year: year,
+		half: strings.ToLower(match[2])[0],
+	}, nil
+}
+
+// Compare returns -1, 0 or +1 depending on whether r is older than, the same as, or newer than other.
+func (r Release) Compare(other Release) int {
+	switch {
+	case r.year < other.year:
+		return -1
+	case r.year > other.year:
+		return 1
+	case r.half < other.half:
+		return -1
+	case r.half > other.half:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (r Release) String() string {
+	return fmt.Sprintf("R%d%c", r.year, r.half)
+}
+
+type selectorKind int
+
+const (
+	selectorKindAny selectorKind = iota
+	selectorKindLatest
+	selectorKindExact
+	selectorKindMinimum
+)
+
+// Selector chooses a MATLAB release from a list of candidates.
+// It is parsed from one of:
+//   - an empty string, which selects the first candidate
+//   - "latest", which selects the newest candidate
+//   - an exact release, such as "R2024b"
+//   - a minimum release, such as ">=R2023b", which selects the first candidate that is at least that release
+type Selector struct {
+	kind    selectorKind
+	release Release
+}
+
+// ParseSelector parses a release selector.
+func ParseSelector(value string) (Selector, error) {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case value == "":
+		return Selector{kind: selectorKindAny}, nil
+	case strings.EqualFold(value, latestKeyword):
+		return Selector{kind: selectorKindLatest}, nil
+	case strings.HasPrefix(value, minimumPrefix):
+		release, err := Parse(strings.TrimPrefix(value, minimumPrefix))
+		if err != nil {
+			return Selector{}, err
+		}
+		return Selector{kind: selectorKindMinimum, release: release}, nil
+	default:
+		release, err := Parse(value)
+		if err != nil {
+			return Selector{}, err
+		}
+		return Selector{kind: selectorKindExact, release: release}, nil
+	}
+}
+
+// Select returns the index of the chosen candidate release name, or false if no candidate matches.
+// Candidates that are not valid release names are skipped unless the selector accepts any release.
+func (s Selector) Select(candidates []string) (int, bool) {
+	if s.kind == selectorKindAny {
+		return 0, len(candidates) > 0
+	}
+
+	selectedIndex := -1
+	var selectedRelease Release
+
+	for i, candidate := range candidates {
+		release, err := Parse(candidate)
+		if err != nil {
+			continue
+		}
+
+		switch s.kind {
+		case selectorKindExact:
+			if release.Compare(s.release) == 0 {
+				return i, true
+			}
+		case selectorKindMinimum:
+			if release.Compare(s.release) >= 0 {
+				return i, true
+			}
+		case selectorKindLatest:
+			if selectedIndex < 0 || release.Compare(selectedRelease) > 0 {
+				selectedIndex = i
+				selectedRelease = release
+			}
+		}
+	}
+
+	return selectedIndex, selectedIndex >= 0
+}
+
+func (s Selector) String() string {
+	switch s.kind {
+	case selectorKindLatest:
+		return latestKeyword
+	case selectorKindExact:
+		return s.release.String()
+	case selectorKindMinimum:
+		return minimumPrefix + s.release.String()
+	default:
+		return ""
+	}
+}
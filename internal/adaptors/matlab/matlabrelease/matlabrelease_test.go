@@ -0,0 +1,169 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabrelease_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabrelease"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_HappyPath(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "R2024b", expected: "R2024b"},
+		{input: "R2023a", expected: "R2023a"},
+		{input: "r2022B", expected: "R2022b"},
+		{input: " R2025a ", expected: "R2025a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			// Act
+			release, err := matlabrelease.Parse(tc.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, release.String())
+		})
+	}
+}
+
+func TestParse_InvalidRelease(t *testing.T) {
+	testCases := []string{
+		"",
+		"2024b",
+		"R24b",
+		"R2024c",
+		"R2024",
+		"R2024bUpdate3",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			// Act
+			_, err := matlabrelease.Parse(input)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRelease_Compare(t *testing.T) {
+	testCases := []struct {
+		name     string
+		left     string
+		right    string
+		expected int
+	}{
+		{name: "same release", left: "R2024b", right: "R2024b", expected: 0},
+		{name: "older year", left: "R2023b", right: "R2024a", expected: -1},
+		{name: "newer year", left: "R2025a", right: "R2024b", expected: 1},
+		{name: "older half", left: "R2024a", right: "R2024b", expected: -1},
+		{name: "newer half", left: "R2024b", right: "R2024a", expected: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			left, err := matlabrelease.Parse(tc.left)
+			require.NoError(t, err)
+
+			right, err := matlabrelease.Parse(tc.right)
+			require.NoError(t, err)
+
+			// Act
+			result := left.Compare(right)
+
+			// Assert
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParseSelector_HappyPath(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: ""},
+		{input: "latest", expected: "latest"},
+		{input: "LATEST", expected: "latest"},
+		{input: "R2024b", expected: "R2024b"},
+		{input: ">=R2023b", expected: ">=R2023b"},
+		{input: ">= r2023a", expected: ">=R2023a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			// Act
+			selector, err := matlabrelease.ParseSelector(tc.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, selector.String())
+		})
+	}
+}
+
+func TestParseSelector_InvalidSelector(t *testing.T) {
+	testCases := []string{
+		"newest",
+		">R2023b",
+		"<=R2023b",
+		">=latest",
+		"2023b",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			// Act
+			_, err := matlabrelease.ParseSelector(input)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSelector_Select(t *testing.T) {
+	candidates := []string{"R2023a", "R2024b", "not-a-release", "R2023b", "R2024b"}
+
+	testCases := []struct {
+		name          string
+		selector      string
+		candidates    []string
+		expectedIndex int
+		expectedFound bool
+	}{
+		{name: "empty selector picks first", selector: "", candidates: candidates, expectedIndex: 0, expectedFound: true},
+		{name: "empty selector with no candidates", selector: "", candidates: nil, expectedIndex: 0, expectedFound: false},
+		{name: "latest picks first newest", selector: "latest", candidates: candidates, expectedIndex: 1, expectedFound: true},
+		{name: "exact match", selector: "R2023b", candidates: candidates, expectedIndex: 3, expectedFound: true},
+		{name: "exact match is case-insensitive", selector: "r2023B", candidates: candidates, expectedIndex: 3, expectedFound: true},
+		{name: "exact without match", selector: "R2022b", candidates: candidates, expectedIndex: -1, expectedFound: false},
+		{name: "minimum picks first satisfying candidate", selector: ">=R2023b", candidates: candidates, expectedIndex: 1, expectedFound: true},
+		{name: "minimum without match", selector: ">=R2025a", candidates: candidates, expectedIndex: -1, expectedFound: false},
+		{name: "latest with only invalid candidates", selector: "latest", candidates: []string{"unknown"}, expectedIndex: -1, expectedFound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			selector, err := matlabrelease.ParseSelector(tc.selector)
+			require.NoError(t, err)
+
+			// Act
+			index, found := selector.Select(tc.candidates)
+
+			// Assert
+			assert.Equal(t, tc.expectedFound, found)
+			assert.Equal(t, tc.expectedIndex, index)
+		})
+	}
+}
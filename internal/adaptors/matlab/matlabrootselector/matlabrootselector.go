@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabrelease"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 )
@@ -49,5 +50,20 @@ func (m *MATLABRootSelector) SelectMATLABRoot(ctx context.Context, logger entiti
 		return "", fmt.Errorf("no valid MATLAB environments found")
 	}
 
-	return environments[0].MATLABRoot, nil
+	releaseSelector, parseErr := matlabrelease.ParseSelector(config.PreferredMATLABRelease())
+	if parseErr != nil {
+		return "", parseErr
+	}
+
+	versions := make([]string, len(environments))
+	for i, environment := range environments {
+		versions[i] = environment.Version
+	}
+
+	selectedIndex, found := releaseSelector.Select(versions)
+	if !found {
+		return "", fmt.Errorf("no MATLAB installation matching release %q found", releaseSelector.String())
+	}
+
+	return environments[selectedIndex].MATLABRoot, nil
 }
@@ -136,6 +136,11 @@ func TestMATLABRootSelector_SelectMATLABRoot_HappyPath(t *testing.T) {
 				Return("").
 				Once()
 
+			mockConfig.EXPECT().
+				PreferredMATLABRelease().
+				Return("").
+				Once()
+
 			mockMATLABManager.EXPECT().
 				ListEnvironments(ctx, mockLogger.AsMockArg()).
 				Return(tc.environments).
@@ -247,3 +252,141 @@ func TestMATLABRootSelector_SelectMATLABRoot_ListEnvironmentsEmpty(t *testing.T)
 		})
 	}
 }
+
+func TestMATLABRootSelector_SelectMATLABRoot_PreferredMATLABRelease_HappyPath(t *testing.T) {
+	environments := []entities.EnvironmentInfo{
+		{
+			MATLABRoot: filepath.Join("usr", "local", "MATLAB", "R2023a"),
+			Version:    "R2023a",
+		},
+		{
+			MATLABRoot: filepath.Join("opt", "matlab", "R2024b"),
+			Version:    "R2024b",
+		},
+		{
+			MATLABRoot: filepath.Join("usr", "local", "MATLAB", "R2023b"),
+			Version:    "R2023b",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		release  string
+		expected string
+	}{
+		{
+			name:     "exact release",
+			release:  "R2023b",
+			expected: filepath.Join("usr", "local", "MATLAB", "R2023b"),
+		},
+		{
+			name:     "latest release",
+			release:  "latest",
+			expected: filepath.Join("opt", "matlab", "R2024b"),
+		},
+		{
+			name:     "minimum release",
+			release:  ">=R2023b",
+			expected: filepath.Join("opt", "matlab", "R2024b"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockMATLABManager := &mocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				PreferredLocalMATLABRoot().
+				Return("").
+				Once()
+
+			mockConfig.EXPECT().
+				PreferredMATLABRelease().
+				Return(tc.release).
+				Once()
+
+			mockMATLABManager.EXPECT().
+				ListEnvironments(ctx, mockLogger.AsMockArg()).
+				Return(environments).
+				Once()
+
+			selector := matlabrootselector.New(mockConfigFactory, mockMATLABManager)
+
+			// Act
+			result, err := selector.SelectMATLABRoot(ctx, mockLogger)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestMATLABRootSelector_SelectMATLABRoot_PreferredMATLABReleaseNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	environments := []entities.EnvironmentInfo{
+		{
+			MATLABRoot: filepath.Join("usr", "local", "MATLAB", "R2023a"),
+			Version:    "R2023a",
+		},
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		PreferredMATLABRelease().
+		Return(">=R2024a").
+		Once()
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(ctx, mockLogger.AsMockArg()).
+		Return(environments).
+		Once()
+
+	selector := matlabrootselector.New(mockConfigFactory, mockMATLABManager)
+
+	// Act
+	result, err := selector.SelectMATLABRoot(ctx, mockLogger)
+
+	// Assert
+	require.ErrorContains(t, err, ">=R2024a")
+	assert.Empty(t, result)
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package projectmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+)
+
+const projectFunction = "matlab_mcp.mcpProject"
+
+// Actions of the project helper function.
+const (
+	actionOpen      = "open"
+	actionClose     = "close"
+	actionListFiles = "listFiles"
+	actionRunChecks = "runChecks"
+)
+
+// Manager manages the MATLAB project (.prj) of a MATLAB session.
+type Manager struct{}
+
+// New creates a new Manager instance.
+func New() *Manager {
+	return &Manager{}
+}
+
+// Open opens a MATLAB project from its .prj file or its root folder. MATLAB closes the project that is open, if any.
+func (m *Manager) Open(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error) {
+	var project matlabproject.Project
+	if err := callProject(ctx, logger, client, &project, actionOpen, projectPath); err != nil {
+		return matlabproject.Project{}, err
+	}
+
+	return project, nil
+}
+
+// Close closes the open MATLAB project, and returns it.
+func (m *Manager) Close(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (matlabproject.Project, error) {
+	var project matlabproject.Project
+	if err := callProject(ctx, logger, client, &project, actionClose); err != nil {
+		return matlabproject.Project{}, err
+	}
+
+	return project, nil
+}
+
+// ListFiles lists the files of the open MATLAB project with their labels.
+func (m *Manager) ListFiles(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.File, error) {
+	files := []matlabproject.File{}
+	if err := callProject(ctx, logger, client, &files, actionListFiles); err != nil {
+		return nil, err
+	}
+
+	for i := range files {
+		if files[i].Labels == nil {
+			files[i].Labels = []matlabproject.Label{}
+		}
+	}
+
+	return files, nil
+}
+
+// RunChecks runs the checks of the open MATLAB project.
+func (m *Manager) RunChecks(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.CheckResult, error) {
+	results := []matlabproject.CheckResult{}
+	if err := callProject(ctx, logger, client, &results, actionRunChecks); err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		if results[i].ProblemFiles == nil {
+			results[i].ProblemFiles = []string{}
+		}
+	}
+
+	return results, nil
+}
+
+// callProject runs an action of the project helper function, and unmarshals the JSON that it returns into target.
+func callProject(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, target any, action string, arguments ...string) error {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   projectFunction,
+		Arguments:  append([]string{action}, arguments...),
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from %s: %d", projectFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output of %s to string", projectFunction)
+	}
+
+	if err := json.Unmarshal([]byte(output), target); err != nil {
+		return fmt.Errorf("failed to parse output of %s %s: %w", projectFunction, action, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package workspacemanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+const workspaceFunction = "matlab_mcp.mcpWorkspace"
+
+// sessionDirVariable is the environment variable that holds the session directory of a MATLAB session that the server started.
+const sessionDirVariable = "MW_MCP_SESSION_DIR"
+
+// Actions of the workspace helper function.
+const (
+	actionSize = "size"
+	actionSave = "save"
+	actionLoad = "load"
+)
+
+// Manager saves and loads the base workspace of a MATLAB session.
+type Manager struct{}
+
+// New creates a new Manager instance.
+func New() *Manager {
+	return &Manager{}
+}
+
+// SessionFolder returns the session directory of the MATLAB session, or an empty string if the server did not start the session.
+func (m *Manager) SessionFolder(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (string, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   "getenv",
+		Arguments:  []string{sessionDirVariable},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Outputs) != 1 {
+		return "", fmt.Errorf("unexpected number of outputs from getenv: %d", len(response.Outputs))
+	}
+
+	sessionFolder, ok := response.Outputs[0].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to cast output of getenv to string")
+	}
+
+	return sessionFolder, nil
+}
+
+// Size returns the size, in bytes, of the variables of the base workspace in memory.
+func (m *Manager) Size(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (int64, error) {
+	var size int64
+	if err := callWorkspace(ctx, logger, client, &size, actionSize); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// Save saves the variables of the base workspace to a MAT-file, and returns their names.
+func (m *Manager) Save(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string) ([]string, error) {
+	variables := []string{}
+	if err := callWorkspace(ctx, logger, client, &variables, actionSave, filePath); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// Load loads the variables of a MAT-file into the base workspace, and returns their names.
+// In replace mode, the base workspace is cleared once the MAT-file is read, so that it is kept if the MAT-file cannot be read.
+func (m *Manager) Load(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string, mode workspacesnapshot.RestoreMode) ([]string, error) {
+	variables := []string{}
+	if err := callWorkspace(ctx, logger, client, &variables, actionLoad, filePath, string(mode)); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// callWorkspace runs an action of the workspace helper function, and parses the JSON text that it returns into result.
+func callWorkspace(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, result any, action string, arguments ...string) error {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   workspaceFunction,
+		Arguments:  append([]string{action}, arguments...),
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from %s: %d", workspaceFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output of %s to string", workspaceFunction)
+	}
+
+	if err := json.Unmarshal([]byte(output), result); err != nil {
+		return fmt.Errorf("failed to parse output of %s %s: %w", workspaceFunction, action, err)
+	}
+
+	return nil
+}
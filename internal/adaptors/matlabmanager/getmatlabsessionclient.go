@@ -4,15 +4,19 @@ package matlabmanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/time/retry"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 )
 
 const defaultMATLABSessionConnectionRetryInterval = 100 * time.Millisecond
 
+var ErrMATLABSessionExited = errors.New("MATLAB process exited unexpectedly")
+
 func (m *MATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
 	config, messagesErr := m.configFactory.Config()
 	if messagesErr != nil {
@@ -24,6 +28,13 @@ func (m *MATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogge
 		return nil, err
 	}
 
+	select {
+	case <-client.Exited():
+		sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID).Warn("MATLAB process exited unexpectedly")
+		return nil, sessionExitedError(sessionID, config.MATLABMemoryLimit())
+	default:
+	}
+
 	pingCtx, cancel := context.WithTimeout(ctx, config.MATLABSessionConnectionTimeout())
 	defer cancel()
 
@@ -38,3 +49,39 @@ func (m *MATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogge
 
 	return client, nil
 }
+
+// GetBackgroundMATLABSessionClient returns a client for work the user did not ask for, such as completions.
+// Its calls fail with matlabsessionstore.ErrSessionBusy instead of waiting behind tool calls, and do not keep an idle session running.
+// Unlike GetMATLABSessionClient, it does not ping MATLAB, so it returns without waiting for a slow session.
+func (m *MATLABManager) GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	config, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		return nil, messagesErr
+	}
+
+	client, err := m.sessionStore.GetBackground(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-client.Exited():
+		return nil, sessionExitedError(sessionID, config.MATLABMemoryLimit())
+	default:
+	}
+
+	return client, nil
+}
+
+// sessionExitedError explains why the MATLAB process of a session might have exited.
+// The server cannot tell an exit caused by the memory limit apart from other crashes.
+func sessionExitedError(sessionID entities.SessionID, memoryLimit uint64) error {
+	if memoryLimit > 0 {
+		return fmt.Errorf(
+			"%w: MATLAB session %v might have exceeded the memory limit of %d MB set with --%s. Start a new session, and reduce the memory your code uses or increase the limit",
+			ErrMATLABSessionExited, sessionID, memoryLimit>>20, defaultparameters.MATLABMemoryLimit().GetFlagName(),
+		)
+	}
+
+	return fmt.Errorf("%w: MATLAB session %v. Start a new session", ErrMATLABSessionExited, sessionID)
+}
@@ -26,6 +26,15 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockConfig := &configmocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
 
@@ -62,12 +71,17 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 		Return(mockSessionClient, nil).
 		Once()
 
+	mockSessionClient.EXPECT().
+		Exited().
+		Return(nil).
+		Once()
+
 	mockSessionClient.EXPECT().
 		Ping(mock.Anything, mockLogger.AsMockArg()).
 		Return(entities.PingResponse{IsAlive: true}).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -85,6 +99,15 @@ func TestMATLABManager_GetMATLABSessionClient_Retries(t *testing.T) {
 		mockConfigFactory := &mocks.MockConfigFactory{}
 		defer mockConfigFactory.AssertExpectations(t)
 
+		mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+		defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+		mockSessionPool := &mocks.MockMATLABSessionPool{}
+		defer mockSessionPool.AssertExpectations(t)
+
+		mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+		defer mockMATLABProjectOpener.AssertExpectations(t)
+
 		mockConfig := &configmocks.MockConfig{}
 		defer mockConfig.AssertExpectations(t)
 
@@ -123,6 +146,11 @@ func TestMATLABManager_GetMATLABSessionClient_Retries(t *testing.T) {
 			Return(mockSessionClient, nil).
 			Once()
 
+		mockSessionClient.EXPECT().
+			Exited().
+			Return(nil).
+			Once()
+
 		mockSessionClient.EXPECT().
 			Ping(mock.Anything, mockLogger.AsMockArg()).
 			Return(entities.PingResponse{IsAlive: false}).
@@ -133,7 +161,7 @@ func TestMATLABManager_GetMATLABSessionClient_Retries(t *testing.T) {
 			Return(entities.PingResponse{IsAlive: true}).
 			Once()
 
-		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 		manager.SetMATLABSessionConnectionRetryInterval(retryInterval)
 
 		// Act
@@ -153,6 +181,15 @@ func TestMATLABManager_GetMATLABSessionClient_RetryExhausted(t *testing.T) {
 		mockConfigFactory := &mocks.MockConfigFactory{}
 		defer mockConfigFactory.AssertExpectations(t)
 
+		mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+		defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+		mockSessionPool := &mocks.MockMATLABSessionPool{}
+		defer mockSessionPool.AssertExpectations(t)
+
+		mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+		defer mockMATLABProjectOpener.AssertExpectations(t)
+
 		mockConfig := &configmocks.MockConfig{}
 		defer mockConfig.AssertExpectations(t)
 
@@ -191,12 +228,17 @@ func TestMATLABManager_GetMATLABSessionClient_RetryExhausted(t *testing.T) {
 			Return(mockSessionClient, nil).
 			Once()
 
+		mockSessionClient.EXPECT().
+			Exited().
+			Return(nil).
+			Once()
+
 		mockSessionClient.EXPECT().
 			Ping(mock.Anything, mockLogger.AsMockArg()).
 			Return(entities.PingResponse{IsAlive: false}).
 			Twice()
 
-		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+		manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 		manager.SetMATLABSessionConnectionRetryInterval(retryInterval)
 
 		// Act
@@ -209,6 +251,150 @@ func TestMATLABManager_GetMATLABSessionClient_RetryExhausted(t *testing.T) {
 	})
 }
 
+func TestMATLABManager_GetMATLABSessionClient_SessionExited(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	processExited := make(chan struct{})
+	close(processExited)
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABMemoryLimit().
+		Return(uint64(0)).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Exited().
+		Return(processExited).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, matlabmanager.ErrMATLABSessionExited)
+	assert.Contains(t, err.Error(), "Start a new session")
+	assert.Nil(t, client)
+
+	logFields, hasWarnLog := mockLogger.WarnLogs()["MATLAB process exited unexpectedly"]
+	require.True(t, hasWarnLog, "should log that the MATLAB process exited")
+	assert.Equal(t, expectedSessionID, logFields[entities.MATLABSessionIDLogKey])
+}
+
+func TestMATLABManager_GetMATLABSessionClient_SessionExitedWithMemoryLimit(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	processExited := make(chan struct{})
+	close(processExited)
+	ctx := t.Context()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABMemoryLimit().
+		Return(uint64(2 << 30)).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Exited().
+		Return(processExited).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, matlabmanager.ErrMATLABSessionExited)
+	assert.Contains(t, err.Error(), "2048 MB")
+	assert.Nil(t, client)
+}
+
 func TestMATLABManager_GetMATLABSessionClient_ConfigFactoryError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -216,6 +402,15 @@ func TestMATLABManager_GetMATLABSessionClient_ConfigFactoryError(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -236,7 +431,7 @@ func TestMATLABManager_GetMATLABSessionClient_ConfigFactoryError(t *testing.T) {
 		Return(nil, messages.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -253,6 +448,15 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockConfig := &configmocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
 
@@ -282,7 +486,7 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -291,3 +495,180 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, client)
 }
+
+func TestMATLABManager_GetBackgroundMATLABSessionClient_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		GetBackground(expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Exited().
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	client, err := manager.GetBackgroundMATLABSessionClient(expectedSessionID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockSessionClient, client)
+}
+
+func TestMATLABManager_GetBackgroundMATLABSessionClient_SessionExited(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	processExited := make(chan struct{})
+	close(processExited)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABMemoryLimit().
+		Return(uint64(0)).
+		Once()
+
+	mockSessionStore.EXPECT().
+		GetBackground(expectedSessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Exited().
+		Return(processExited).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	client, err := manager.GetBackgroundMATLABSessionClient(expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, matlabmanager.ErrMATLABSessionExited)
+	assert.Nil(t, client)
+}
+
+func TestMATLABManager_GetBackgroundMATLABSessionClient_SessionStoreError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	expectedError := assert.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		GetBackground(expectedSessionID).
+		Return(nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	client, err := manager.GetBackgroundMATLABSessionClient(expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, client)
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package launchsettings
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+// Settings describes how to launch a MATLAB process, on top of what the server needs to connect to it.
+type Settings struct {
+	StartupFlags         []string
+	EnvironmentVariables []string
+	StartupScript        string
+	Path                 []string
+	// MemoryLimit is the maximum address space of the MATLAB process, in bytes, or zero for no limit.
+	MemoryLimit uint64
+}
+
+// extensionFile is the part of an extension file that configures how MATLAB starts.
+// The rest of the file, such as custom tools, is read by other components.
+type extensionFile struct {
+	MATLAB *extensionFileMATLABSettings `json:"matlab"`
+}
+
+type extensionFileMATLABSettings struct {
+	StartupFlags  []string          `json:"startupFlags"`
+	Environment   map[string]string `json:"environment"`
+	StartupScript string            `json:"startupScript"`
+	Path          []string          `json:"path"`
+}
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type Provider struct {
+	configFactory ConfigFactory
+	osLayer       OSLayer
+}
+
+func New(
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+) *Provider {
+	return &Provider{
+		configFactory: configFactory,
+		osLayer:       osLayer,
+	}
+}
+
+// Settings combines the MATLAB settings of the extension files, in order, with the command-line arguments.
+// Startup flags and path folders accumulate, environment variables and the startup script from later sources win.
+// Relative paths in an extension file are relative to the folder of that file.
+func (p *Provider) Settings(logger entities.Logger) (Settings, error) {
+	cfg, messagesErr := p.configFactory.Config()
+	if messagesErr != nil {
+		return Settings{}, messagesErr
+	}
+
+	var settings Settings
+
+	for _, extensionFilePath := range cfg.ExtensionFiles() {
+		fileSettings, err := p.readExtensionFile(logger, extensionFilePath)
+		if err != nil {
+			return Settings{}, err
+		}
+
+		if fileSettings == nil {
+			continue
+		}
+
+		extensionFileDir := filepath.Dir(extensionFilePath)
+
+		settings.StartupFlags = append(settings.StartupFlags, fileSettings.StartupFlags...)
+
+		// Sort the names so that the environment of MATLAB does not depend on map ordering
+		names := make([]string, 0, len(fileSettings.Environment))
+		for name := range fileSettings.Environment {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			if name == "" {
+				logger.With("path", extensionFilePath).Warn("Ignoring MATLAB environment variable without a name in extension file")
+				continue
+			}
+			settings.EnvironmentVariables = setEnvironmentVariable(settings.EnvironmentVariables, name+"="+fileSettings.Environment[name])
+		}
+
+		if fileSettings.StartupScript != "" {
+			settings.StartupScript = resolvePath(extensionFileDir, fileSettings.StartupScript)
+		}
+
+		for _, folder := range fileSettings.Path {
+			settings.Path = append(settings.Path, resolvePath(extensionFileDir, folder))
+		}
+	}
+
+	settings.StartupFlags = append(settings.StartupFlags, cfg.MATLABStartupFlags()...)
+
+	for _, entry := range cfg.MATLABEnvironmentVariables() {
+		settings.EnvironmentVariables = setEnvironmentVariable(settings.EnvironmentVariables, entry)
+	}
+
+	if cfg.MATLABStartupScript() != "" {
+		settings.StartupScript = cfg.MATLABStartupScript()
+	}
+
+	settings.Path = append(settings.Path, cfg.MATLABPath()...)
+
+	settings.MemoryLimit = cfg.MATLABMemoryLimit()
+
+	return settings, nil
+}
+
+func (p *Provider) readExtensionFile(logger entities.Logger, filePath string) (*extensionFileMATLABSettings, error) {
+	data, err := p.osLayer.ReadFile(filePath)
+	if err != nil {
+		logger.WithError(err).Error("Failed to read extension file")
+		return nil, messages.New_StartupErrors_FailedToReadExtensionFile_Error(filePath)
+	}
+
+	var parsed extensionFile
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		logger.WithError(err).Error("Failed to parse extension file")
+		return nil, messages.New_StartupErrors_FailedToParseExtensionFile_Error(filePath)
+	}
+
+	return parsed.MATLAB, nil
+}
+
+// setEnvironmentVariable adds entry, in the form NAME=VALUE, replacing any earlier value of the same variable.
+func setEnvironmentVariable(environment []string, entry string) []string {
+	name, _, _ := strings.Cut(entry, "=")
+
+	for i, existing := range environment {
+		if existingName, _, _ := strings.Cut(existing, "="); existingName == name {
+			environment[i] = entry
+			return environment
+		}
+	}
+
+	return append(environment, entry)
+}
+
+func resolvePath(baseDir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package launchsettings_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/launchsettings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, provider)
+}
+
+func TestProvider_Settings_CommandLineOnly(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSettings := launchsettings.Settings{
+		StartupFlags:         []string{"-singleCompThread"},
+		EnvironmentVariables: []string{"MLM_LICENSE_FILE=27000@licenseserver"},
+		StartupScript:        filepath.Join("path", "to", "startup.m"),
+		Path:                 []string{filepath.Join("path", "to", "lib")},
+		MemoryLimit:          4 << 30,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABStartupFlags().
+		Return(expectedSettings.StartupFlags).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentVariables().
+		Return(expectedSettings.EnvironmentVariables).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABStartupScript().
+		Return(expectedSettings.StartupScript)
+
+	mockConfig.EXPECT().
+		MATLABPath().
+		Return(expectedSettings.Path).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABMemoryLimit().
+		Return(expectedSettings.MemoryLimit).
+		Once()
+
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	settings, err := provider.Settings(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSettings, settings)
+}
+
+func TestProvider_Settings_ExtensionFilesMergedWithCommandLine(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	firstExtensionFileDir := filepath.Join("path", "to", "first")
+	firstExtensionFile := filepath.Join(firstExtensionFileDir, "extension.json")
+	secondExtensionFile := filepath.Join("path", "to", "second", "extension.json")
+	toolsOnlyExtensionFile := filepath.Join("path", "to", "tools", "extension.json")
+	commandLineScript := filepath.Join("path", "to", "cli", "startup.m")
+
+	firstContent := `{
+		"matlab": {
+			"startupFlags": ["-singleCompThread"],
+			"environment": {"B_VAR": "first", "A_VAR": "first"},
+			"startupScript": "setup.m",
+			"path": ["lib"]
+		}
+	}`
+	secondContent := `{"matlab": {"environment": {"B_VAR": "second"}, "startupFlags": ["-nojvm"]}}`
+	toolsOnlyContent := `{"tools": []}`
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{firstExtensionFile, secondExtensionFile, toolsOnlyExtensionFile}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(firstExtensionFile).
+		Return([]byte(firstContent), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(secondExtensionFile).
+		Return([]byte(secondContent), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(toolsOnlyExtensionFile).
+		Return([]byte(toolsOnlyContent), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABStartupFlags().
+		Return([]string{"-nosplash"}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentVariables().
+		Return([]string{"A_VAR=cli"}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABStartupScript().
+		Return(commandLineScript)
+
+	mockConfig.EXPECT().
+		MATLABPath().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABMemoryLimit().
+		Return(uint64(0)).
+		Once()
+
+	expectedSettings := launchsettings.Settings{
+		StartupFlags:         []string{"-singleCompThread", "-nojvm", "-nosplash"},
+		EnvironmentVariables: []string{"A_VAR=cli", "B_VAR=second"},
+		StartupScript:        commandLineScript,
+		Path:                 []string{filepath.Join(firstExtensionFileDir, "lib")},
+	}
+
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	settings, err := provider.Settings(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSettings, settings)
+}
+
+func TestProvider_Settings_ConfigError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	settings, err := provider.Settings(mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, settings)
+}
+
+func TestProvider_Settings_ReadExtensionFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	extensionFile := filepath.Join("path", "to", "extension.json")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(extensionFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	settings, err := provider.Settings(mockLogger)
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToReadExtensionFile_Error(extensionFile), err)
+	assert.Empty(t, settings)
+}
+
+func TestProvider_Settings_ParseExtensionFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	extensionFile := filepath.Join("path", "to", "extension.json")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ExtensionFiles().
+		Return([]string{extensionFile}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(extensionFile).
+		Return([]byte("{not json"), nil).
+		Once()
+
+	provider := launchsettings.New(mockConfigFactory, mockOSLayer)
+
+	// Act
+	settings, err := provider.Settings(mockLogger)
+
+	// Assert
+	require.Equal(t, messages.New_StartupErrors_FailedToParseExtensionFile_Error(extensionFile), err)
+	assert.Empty(t, settings)
+}
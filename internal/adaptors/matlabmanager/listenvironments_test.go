@@ -21,6 +21,15 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABServices{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -58,7 +67,7 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 	ctx := t.Context()
 
 	// Act
@@ -81,6 +90,15 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABServices{}
 	defer mockMATLABManager.AssertExpectations(t)
 
@@ -101,7 +119,7 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 	ctx := t.Context()
 
 	// Act
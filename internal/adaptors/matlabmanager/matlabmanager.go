@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
 )
 
 type ConfigFactory interface {
@@ -20,12 +22,13 @@ type ConfigFactory interface {
 
 type MATLABServices interface {
 	ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo
-	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error)
 }
 
 type MATLABSessionStore interface {
 	Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID
 	Get(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
+	GetBackground(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
 	Remove(sessionID entities.SessionID)
 }
 
@@ -37,12 +40,28 @@ type SessionSelector interface {
 	SelectSessionToAttachTo(logger entities.Logger) (embeddedconnector.ConnectionDetails, error)
 }
 
+type LaunchSettingsProvider interface {
+	Settings(logger entities.Logger) (launchsettings.Settings, error)
+}
+
+type MATLABSessionPool interface {
+	Take(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, bool)
+	Fill(logger entities.Logger, request datatypes.LocalSessionDetails)
+}
+
+type MATLABProjectOpener interface {
+	Open(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error)
+}
+
 type MATLABManager struct {
-	configFactory   ConfigFactory
-	matlabServices  MATLABServices
-	sessionStore    MATLABSessionStore
-	clientFactory   MATLABSessionClientFactory
-	sessionSelector SessionSelector
+	configFactory          ConfigFactory
+	matlabServices         MATLABServices
+	sessionStore           MATLABSessionStore
+	clientFactory          MATLABSessionClientFactory
+	sessionSelector        SessionSelector
+	launchSettingsProvider LaunchSettingsProvider
+	sessionPool            MATLABSessionPool
+	matlabProjectOpener    MATLABProjectOpener
 
 	matlabSessionConnectionRetryInterval time.Duration
 }
@@ -55,13 +74,19 @@ func New(
 	sessionStore MATLABSessionStore,
 	clientFactory MATLABSessionClientFactory,
 	sessionSelector SessionSelector,
+	launchSettingsProvider LaunchSettingsProvider,
+	sessionPool MATLABSessionPool,
+	matlabProjectOpener MATLABProjectOpener,
 ) *MATLABManager {
 	return &MATLABManager{
-		configFactory:   configFactory,
-		matlabServices:  matlabServices,
-		sessionStore:    sessionStore,
-		clientFactory:   clientFactory,
-		sessionSelector: sessionSelector,
+		configFactory:          configFactory,
+		matlabServices:         matlabServices,
+		sessionStore:           sessionStore,
+		clientFactory:          clientFactory,
+		sessionSelector:        sessionSelector,
+		launchSettingsProvider: launchSettingsProvider,
+		sessionPool:            sessionPool,
+		matlabProjectOpener:    matlabProjectOpener,
 
 		matlabSessionConnectionRetryInterval: defaultMATLABSessionConnectionRetryInterval,
 	}
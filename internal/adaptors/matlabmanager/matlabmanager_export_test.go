@@ -16,6 +16,6 @@ func NewMATLABSessionClientWithoutCleanup(matlabSessionClient entities.MATLABSes
 	return newMATLABSessionClientWithoutCleanup(matlabSessionClient)
 }
 
-func NewMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error) *matlabSessionClientWithCleanup {
-	return newMATLABSessionClientWithCleanup(matlabSessionClient, sessionCleanup)
+func NewMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error, exited <-chan struct{}) *matlabSessionClientWithCleanup {
+	return newMATLABSessionClientWithCleanup(matlabSessionClient, sessionCleanup, exited)
 }
@@ -15,6 +15,15 @@ func TestNew_HappyPath(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -28,7 +37,7 @@ func TestNew_HappyPath(t *testing.T) {
 	defer mockSessionSelector.AssertExpectations(t)
 
 	// Act
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Assert
 	assert.NotNil(t, manager, "MATLABManager should not be nil")
@@ -1,7 +1,14 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package config
 
 const (
 	MATLABExeName = "matlab"
 )
+
+// StandardInstallationPatterns returns glob patterns matching the default MATLAB installation folders.
+func StandardInstallationPatterns() []string {
+	return []string{
+		"/Applications/MATLAB_*.app",
+	}
+}
@@ -8,3 +8,10 @@ const (
 	ArchFolder          = "win64"
 	ArchSpecificExeName = "MATLAB.exe"
 )
+
+// StandardInstallationPatterns returns glob patterns matching the default MATLAB installation folders.
+func StandardInstallationPatterns() []string {
+	return []string{
+		`C:\Program Files\MATLAB\*`,
+	}
+}
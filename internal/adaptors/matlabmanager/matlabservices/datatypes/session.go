@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package datatypes
 
@@ -9,4 +9,10 @@ type LocalSessionDetails struct {
 	IsStartingDirectorySet bool
 	StartingDirectory      string
 	ShowMATLABDesktop      bool
+	StartupFlags           []string
+	EnvironmentVariables   []string
+	StartupScript          string
+	Path                   []string
+	// MemoryLimit is the maximum address space of the MATLAB process, in bytes, or zero for no limit.
+	MemoryLimit uint64
 }
@@ -15,7 +15,7 @@ type MATLABLocator interface {
 }
 
 type LocalMATLABSessionLauncher interface {
-	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error)
 }
 
 type MATLABServices struct {
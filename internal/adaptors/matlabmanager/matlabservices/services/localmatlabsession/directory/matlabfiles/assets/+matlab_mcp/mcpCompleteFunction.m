@@ -0,0 +1,40 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function result = mcpCompleteFunction(prefix)
+    % mcpCompleteFunction A helper function for the completions of the MATLAB MCP Server.
+    % It returns, as JSON text, the names of the functions on the MATLAB path and in
+    % the current folder that start with prefix.
+    %
+    % The names are returned as a cell array, so that jsonencode writes them as a
+    % JSON array even when there is a single name.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    maxNames = 100;
+
+    folders = [{pwd}, strsplit(path, pathsep)];
+    names = {};
+    for i = 1:numel(folders)
+        entries = dir(fullfile(folders{i}, [prefix '*']));
+        for j = 1:numel(entries)
+            [~, name, extension] = fileparts(entries(j).name);
+            if ~entries(j).isdir && isFunctionFile(extension)
+                names{end + 1} = name; %#ok<AGROW>
+            end
+        end
+    end
+
+    names = unique(names);
+    names = names(1:min(numel(names), maxNames));
+
+    % which resolves what MATLAB would call, so that names that cannot be called are dropped.
+    isCallable = cellfun(@(name) ~isempty(which(name)), names);
+    result = jsonencode(names(isCallable));
+end
+
+function tf = isFunctionFile(extension)
+    tf = any(strcmpi(extension, {'.m', '.mlx', '.p', '.mlapp', ['.' mexext]}));
+end
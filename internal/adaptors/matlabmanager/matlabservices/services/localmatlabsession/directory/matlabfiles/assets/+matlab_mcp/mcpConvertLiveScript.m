@@ -0,0 +1,14 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function mcpConvertLiveScript(liveScriptPath, outputPath)
+    % mcpConvertLiveScript Exports a live script (.mlx) with the Live Editor.
+    % The extension of outputPath selects the format: .m for plain text live code,
+    % .html for HTML, or .pdf for PDF.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    matlab.internal.liveeditor.openAndConvert(char(liveScriptPath), char(outputPath));
+end
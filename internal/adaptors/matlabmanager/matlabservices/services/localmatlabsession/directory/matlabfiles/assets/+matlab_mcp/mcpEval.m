@@ -3,7 +3,7 @@
 % change without any prior notice. Usage of these undocumented APIs outside of
 % these files is not supported.
 
-function results = mcpEval(code)
+function results = mcpEval(code, figureOptions)
     % mcpEval A helper function for handling execution of MATLAB code and post-processing
     % the outputs. The MATLAB MCP Server will then convert those to the appropriate MCP Server Tool Content, see:
     % 
@@ -17,6 +17,9 @@ function results = mcpEval(code)
         
     % This is largely a re-use of:
     % https://github.com/mathworks/jupyter-matlab-proxy/blob/057564dccb7de37f052e709f5380e3ece0b2c4a1/src/jupyter_matlab_kernel/matlab/%2Bjupyter/execute.m#L1
+    %
+    % When figureOptions is given, as JSON text, the figures that the code creates are
+    % exported with matlab_mcp.mcpExportFigures instead of captured by the Live Editor.
 
     % Copyright 2025-2026 The MathWorks, Inc.
 
@@ -32,6 +35,13 @@ function results = mcpEval(code)
             'end'], code);
     end
 
+    % The export runs as the last line of the code, while the figures are still open.
+    exportFigures = nargin > 1 && ~isempty(figureOptions);
+    if exportFigures
+        matlab_mcp.mcpExportFigures('start');
+        code = sprintf('%s\nmatlab_mcp.mcpExportFigures(''export'', ''%s'')', code, strrep(figureOptions, '''', ''''''));
+    end
+
     fileToShowErrors = 'matlab_mcp_server';
 
     request = struct( ...
@@ -47,7 +57,12 @@ function results = mcpEval(code)
 
     resp = jsondecode(matlab.internal.editor.evaluateSynchronousRequest(request));
 
-    results = jsonencode(processOutputs(resp.outputs));
+    exportedFigures = struct('exported', false, 'images', {{}});
+    if exportFigures
+        exportedFigures = matlab_mcp.mcpExportFigures('collect');
+    end
+
+    results = jsonencode(processOutputs(resp.outputs, exportedFigures));
 end
 
 % Helper function to update fields in the request based on MATLAB and LiveEditor
@@ -100,10 +115,14 @@ function request = updateRequest(request, code)
     end
 end
 
-function result = processOutputs(outputs)
+function result = processOutputs(outputs, exportedFigures)
     result =cell(1,length(outputs));
     figureTrackingMap = containers.Map;
 
+    % When the figures were exported, the images that the Live Editor captured are left out.
+    % If the code stopped before the export, the captured images are kept instead.
+    keepCapturedFigures = ~exportedFigures.exported;
+
     % Post process each captured output based on its type.
     for ii = 1:length(outputs)
         out = outputs(ii);
@@ -118,7 +137,7 @@ function result = processOutputs(outputs)
             case 'symbolic'
                 result{ii} = processSymbolic(outputData);
             case 'error'
-                result{ii} = processStream('stderr', outputData.text);
+                result{ii} = processError(outputData.text);
             case 'warning'
                 result{ii} = processStream('stderr', outputData.text);
             case 'text'
@@ -126,6 +145,9 @@ function result = processOutputs(outputs)
             case 'stderr'
                 result{ii} = processStream('stderr', outputData.text);
             case 'figure'
+                if ~keepCapturedFigures
+                    continue
+                end
                 % 'figure' outputType may not necessarily contain the actual image.
                 % Hence, if the 'figure' is a placeholder, we store its position in
                 % a map to preserve the ordering. In a later 'figure' output, if the
@@ -150,9 +172,11 @@ function result = processOutputs(outputs)
         end
     end
 
+    result = [result, exportedFigures.images];
+
     ME = matlab_mcp.getOrStashExceptions([], true);
     if ~isempty(ME)
-        result{end+1} = processStream('stderr', ME.message);
+        result{end+1} = processError(ME.message);
     end
 
     % Helper functions to post process output of type 'matrix', 'variable' and
@@ -250,6 +274,13 @@ function result = processOutputs(outputs)
         result.content.text = text;
     end
 
+    % Helper function for processing errors. Errors are displayed like stderr,
+    % and flagged so that callers can tell that the code failed.
+    function result = processError(text)
+        result = processStream('stderr', text);
+        result.isError = true;
+    end
+
     % Helper function for processing figure outputs.
     % base64Data will be 'data:image/png;base64,<base64_value>'
     function result = processFigure(base64Data)
@@ -0,0 +1,147 @@
+function figures = mcpExportFigures(action, figureOptions)
+    % mcpExportFigures Exports the figures that the code run by matlab_mcp.mcpEval creates or changes,
+    % in the format, size and resolution that the MATLAB MCP Server asks for.
+    %
+    % mcpExportFigures('start') records the figures that are already open, with their graphics
+    % objects, and listens for them to be redrawn.
+    % mcpExportFigures('export', figureOptions) runs at the end of the code, and exports
+    % the figures opened since 'start', and the figures that were open before and that the code
+    % changed, such as with hold on and plot. figureOptions is JSON text with the format
+    % (png, jpeg or svg), the width and height in pixels and the resolution in DPI.
+    % Zero values keep the size and resolution of the figure.
+    % figures = mcpExportFigures('collect') returns the exported figures and whether
+    % the export ran, which it does not when the code stops on an error.
+    % mcpExportFigures('touch', fig) records that a figure was redrawn, and is called by the listeners.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    persistent existingFigures existingObjects touchedFigures listeners exportedFigures exported
+
+    switch action
+        case 'start'
+            deleteListeners(listeners);
+            existingFigures = findall(groot, 'Type', 'figure');
+            existingObjects = cell(size(existingFigures));
+            listeners = cell(size(existingFigures));
+            for ii = 1:numel(existingFigures)
+                existingObjects{ii} = findall(existingFigures(ii));
+                listeners{ii} = addlistener(existingFigures(ii), 'MarkedClean', ...
+                    @(fig, ~) matlab_mcp.mcpExportFigures('touch', fig));
+            end
+            touchedFigures = gobjects(0);
+            exportedFigures = {};
+            exported = false;
+        case 'touch'
+            touchedFigures(end + 1) = figureOptions;
+        case 'export'
+            options = jsondecode(figureOptions);
+            % drawnow redraws the figures that the code changed, so that their listeners run.
+            drawnow;
+            figuresToExport = findChangedFigures(existingFigures, existingObjects, touchedFigures);
+            exportedFigures = cell(1, numel(figuresToExport));
+            for ii = 1:numel(figuresToExport)
+                exportedFigures{ii} = exportFigure(figuresToExport(ii), options);
+            end
+            exported = true;
+        case 'collect'
+            figures = struct('exported', isequal(exported, true), 'images', {exportedFigures});
+            deleteListeners(listeners);
+            listeners = {};
+            existingFigures = [];
+            existingObjects = {};
+            touchedFigures = gobjects(0);
+            exportedFigures = {};
+            exported = false;
+        otherwise
+            error('matlab_mcp:exportFigures:unknownAction', 'Unknown figure export action: %s', action);
+    end
+end
+
+function changedFigures = findChangedFigures(existingFigures, existingObjects, touchedFigures)
+    % findall lists the most recent figures first, so they are flipped to export them
+    % in the order the code created them.
+    allFigures = flip(findall(groot, 'Type', 'figure'));
+    isChanged = true(size(allFigures));
+    for ii = 1:numel(allFigures)
+        index = find(existingFigures == allFigures(ii), 1);
+        if isempty(index)
+            continue
+        end
+
+        % A figure that was open before is exported when the code redrew it, or added or
+        % removed graphics objects, which is also seen when the figure is not redrawn.
+        isChanged(ii) = any(touchedFigures == allFigures(ii)) || ...
+            ~isequal(findall(allFigures(ii)), existingObjects{index});
+    end
+    changedFigures = allFigures(isChanged);
+end
+
+function deleteListeners(listeners)
+    for ii = 1:numel(listeners)
+        delete(listeners{ii});
+    end
+end
+
+function image = exportFigure(fig, options)
+    if options.width > 0 || options.height > 0
+        % The figure is the user's, so its size is restored after the export.
+        originalUnits = fig.Units;
+        originalPosition = fig.Position;
+        positionCleanup = onCleanup(@() restorePosition(fig, originalUnits, originalPosition));
+
+        fig.Units = 'pixels';
+        position = fig.Position;
+        if options.width > 0
+            position(3) = options.width;
+        end
+        if options.height > 0
+            position(4) = options.height;
+        end
+        fig.Position = position;
+    end
+
+    switch options.format
+        case 'svg'
+            mimetype = 'image/svg+xml';
+            extension = '.svg';
+        case 'jpeg'
+            mimetype = 'image/jpeg';
+            extension = '.jpg';
+        otherwise
+            mimetype = 'image/png';
+            extension = '.png';
+    end
+
+    file = [tempname extension];
+    fileCleanup = onCleanup(@() deleteFile(file));
+
+    if strcmp(options.format, 'svg')
+        % exportgraphics does not write SVG files.
+        print(fig, file, '-dsvg');
+    elseif options.dpi > 0
+        exportgraphics(fig, file, 'Resolution', options.dpi);
+    else
+        exportgraphics(fig, file);
+    end
+
+    fid = fopen(file, 'r');
+    bytes = fread(fid, Inf, '*uint8');
+    fclose(fid);
+
+    image.type = 'execute_result';
+    image.mimetype = {mimetype};
+    image.value = {matlab.net.base64encode(bytes)};
+end
+
+function restorePosition(fig, units, position)
+    if isvalid(fig)
+        fig.Units = units;
+        fig.Position = position;
+    end
+end
+
+function deleteFile(file)
+    if isfile(file)
+        delete(file);
+    end
+end
@@ -0,0 +1,57 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function result = mcpHelp(name)
+    % mcpHelp A helper function for the MATLAB help resource of the MATLAB MCP Server.
+    % It returns, as JSON text, the help text of a function, class or namespace, the
+    % product that owns it and, for a namespace, its members.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    info = struct('Found', false, 'Text', '', 'Product', '', 'Members', {{}});
+
+    namespace = meta.package.fromName(name);
+    location = which(name);
+    if isempty(location) && isempty(namespace) && exist(name) == 0 %#ok<EXIST>
+        result = jsonencode(info);
+        return
+    end
+
+    info.Found = true;
+    info.Text = help(name);
+    info.Product = productOf(location);
+
+    if ~isempty(namespace)
+        members = [ ...
+            strcat(name, '.', {namespace.FunctionList.Name}), ...
+            {namespace.ClassList.Name}, ...
+            {namespace.PackageList.Name}];
+        info.Members = sort(members);
+    end
+
+    result = jsonencode(info);
+end
+
+function product = productOf(location)
+    % The product is found from the toolbox folder that the file is in, such as
+    % matlabroot/toolbox/signal for the Signal Processing Toolbox.
+    product = '';
+
+    toolboxRoot = [fullfile(matlabroot, 'toolbox') filesep];
+    if isempty(location) || ~startsWith(location, toolboxRoot)
+        return
+    end
+
+    folders = strsplit(extractAfter(location, toolboxRoot), filesep);
+    try
+        versionInfo = ver(folders{1});
+    catch
+        return
+    end
+
+    if ~isempty(versionInfo)
+        product = versionInfo(1).Name;
+    end
+end
@@ -0,0 +1,27 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function code = mcpLiveScriptCode(liveScriptPath)
+    % mcpLiveScriptCode Returns the code of a live script (.mlx), so that the MATLAB MCP Server
+    % can run it through matlab_mcp.mcpEval and capture its outputs like any other code.
+    %
+    % The Live Editor converts the live script to a plain code file, in which the
+    % formatted text of the live script becomes comments.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    codeFile = [tempname, '.m'];
+    cleanupObj = onCleanup(@() deleteIfExists(codeFile));
+
+    matlab.internal.liveeditor.openAndConvert(char(liveScriptPath), codeFile);
+
+    code = fileread(codeFile);
+end
+
+function deleteIfExists(file)
+    if isfile(file)
+        delete(file);
+    end
+end
@@ -0,0 +1,81 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function result = mcpProject(action, varargin)
+    % mcpProject A helper function for the MATLAB project tools of the MATLAB MCP Server.
+    % It runs an action on the open MATLAB project and returns the result as JSON text.
+    %
+    % Lists are returned as cell arrays, so that jsonencode writes them as JSON
+    % arrays even when they have a single element.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    switch action
+        case 'open'
+            data = openMATLABProject(varargin{:});
+        case 'close'
+            data = closeMATLABProject();
+        case 'listFiles'
+            data = listFiles();
+        case 'runChecks'
+            data = runProjectChecks();
+        otherwise
+            error('matlab_mcp:project:unknownAction', 'Unknown project action: %s', action);
+    end
+
+    result = jsonencode(data);
+end
+
+function data = openMATLABProject(projectPath)
+    % Opening a project closes the project that is open, if any.
+    proj = openProject(projectPath);
+    data = describeProject(proj);
+end
+
+function data = closeMATLABProject()
+    proj = requireProject();
+    data = describeProject(proj);
+    close(proj);
+end
+
+function data = listFiles()
+    proj = requireProject();
+
+    files = proj.Files;
+    data = cell(1, numel(files));
+    for i = 1:numel(files)
+        labels = files(i).Labels;
+        data{i} = struct( ...
+            'Path', char(files(i).Path), ...
+            'Labels', {arrayfun(@(label) struct( ...
+                'Category', char(label.CategoryName), ...
+                'Name', char(label.Name)), labels, 'UniformOutput', false)});
+    end
+end
+
+function data = runProjectChecks()
+    proj = requireProject();
+
+    checkResults = runChecks(proj);
+    data = arrayfun(@(checkResult) struct( ...
+        'ID', char(checkResult.ID), ...
+        'Description', char(checkResult.Description), ...
+        'Passed', logical(checkResult.Passed), ...
+        'ProblemFiles', {cellstr(checkResult.ProblemFiles(:)')}), checkResults, 'UniformOutput', false);
+end
+
+function data = describeProject(proj)
+    data = struct( ...
+        'Name', char(proj.Name), ...
+        'RootFolder', char(proj.RootFolder), ...
+        'FileCount', numel(proj.Files));
+end
+
+function proj = requireProject()
+    proj = matlab.project.rootProject;
+    if isempty(proj)
+        error('matlab_mcp:project:noProject', 'No MATLAB project is open. Open a project first.');
+    end
+end
@@ -0,0 +1,328 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function result = mcpSimulink(action, varargin)
+    % mcpSimulink A helper function for the Simulink tools of the MATLAB MCP Server.
+    % It runs a Simulink action on a model and returns the result as JSON text.
+    %
+    % Lists are returned as cell arrays, so that jsonencode writes them as JSON
+    % arrays even when they have a single element.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    switch action
+        case 'openModel'
+            data = openModel(varargin{:});
+        case 'listBlocks'
+            data = listBlocks(varargin{:});
+        case 'getBlockParams'
+            data = getBlockParams(varargin{:});
+        case 'setBlockParams'
+            data = setBlockParams(varargin{:});
+        case 'updateDiagram'
+            data = updateDiagram(varargin{:});
+        case 'simulate'
+            data = simulate(varargin{:});
+        otherwise
+            error('matlab_mcp:simulink:unknownAction', 'Unknown Simulink action: %s', action);
+    end
+
+    result = jsonencode(data);
+end
+
+function data = openModel(modelPath)
+    % Loads the model without opening it in the Simulink Editor.
+    load_system(modelPath);
+    [~, model] = fileparts(modelPath);
+
+    data = struct( ...
+        'Name', model, ...
+        'FileName', get_param(model, 'FileName'), ...
+        'BlockCount', numel(findBlocks(model, true)));
+end
+
+function data = listBlocks(system, recursive)
+    loadModelOf(system);
+
+    paths = findBlocks(system, strcmp(recursive, 'true'));
+    data = cellfun(@(path) struct( ...
+        'Path', path, ...
+        'Name', get_param(path, 'Name'), ...
+        'BlockType', get_param(path, 'BlockType')), paths, 'UniformOutput', false);
+end
+
+function data = getBlockParams(blockPath, namesJSON)
+    loadModelOf(blockPath);
+
+    names = jsondecode(namesJSON);
+    if isempty(names)
+        dialogParameters = get_param(blockPath, 'DialogParameters');
+        if isempty(dialogParameters)
+            names = {};
+        else
+            names = fieldnames(dialogParameters);
+        end
+    end
+
+    data = cellfun(@(name) struct( ...
+        'Name', name, ...
+        'Value', valueText(get_param(blockPath, name))), names, 'UniformOutput', false);
+end
+
+function data = setBlockParams(blockPath, parametersJSON)
+    loadModelOf(blockPath);
+
+    parameters = jsondecode(parametersJSON);
+    nameValuePairs = reshape([{parameters.Name}; {parameters.Value}], 1, []);
+    set_param(blockPath, nameValuePairs{:});
+
+    data = getBlockParams(blockPath, jsonencode({parameters.Name}));
+end
+
+function data = updateDiagram(model)
+    load_system(model);
+
+    diagnostics = {};
+    sllastwarning([]);
+    try
+        set_param(model, 'SimulationCommand', 'update');
+    catch exception
+        diagnostics = exceptionDiagnostics(exception);
+    end
+    diagnostics = [diagnostics, warningDiagnostics(sllastwarning)];
+
+    data = struct( ...
+        'Model', model, ...
+        'Succeeded', ~any(cellfun(@(diagnostic) strcmp(diagnostic.Severity, 'error'), diagnostics)), ...
+        'Diagnostics', {diagnostics});
+end
+
+function data = simulate(model, stopTime)
+    load_system(model);
+
+    simulationInput = Simulink.SimulationInput(model);
+    simulationInput = simulationInput.setModelParameter('SignalLogging', 'on', 'ReturnWorkspaceOutputs', 'on');
+    if ~isempty(stopTime)
+        simulationInput = simulationInput.setModelParameter('StopTime', stopTime);
+    end
+
+    % Log the data of each scope, without changing the model, so that the scopes can be plotted.
+    scopes = find_system(model, 'LookUnderMasks', 'all', 'FollowLinks', 'on', 'BlockType', 'Scope');
+    scopeVariables = arrayfun(@(index) sprintf('mcpScope%d', index), 1:numel(scopes), 'UniformOutput', false);
+    for index = 1:numel(scopes)
+        simulationInput = simulationInput.setBlockParameter(scopes{index}, ...
+            'DataLogging', 'on', ...
+            'DataLoggingVariableName', scopeVariables{index}, ...
+            'DataLoggingSaveFormat', 'Dataset');
+    end
+
+    diagnostics = {};
+    try
+        simulationOutput = sim(simulationInput);
+    catch exception
+        data = struct('Model', model, 'SimulationTime', NaN, 'Signals', {{}}, ...
+            'Diagnostics', {exceptionDiagnostics(exception)}, 'Image', '');
+        return
+    end
+
+    if ~isempty(simulationOutput.ErrorMessage)
+        diagnostics{end+1} = diagnostic('error', simulationOutput.ErrorMessage, '', {});
+    end
+    executionInfo = simulationOutput.SimulationMetadata.ExecutionInfo;
+    if isfield(executionInfo, 'WarningDiagnostics')
+        for index = 1:numel(executionInfo.WarningDiagnostics)
+            warningDiagnostic = executionInfo.WarningDiagnostics(index).Diagnostic;
+            diagnostics{end+1} = diagnostic('warning', warningDiagnostic.message, warningDiagnostic.identifier, {}); %#ok<AGROW>
+        end
+    end
+
+    outputNames = simulationOutput.who;
+
+    simulationTime = NaN;
+    if any(strcmp(outputNames, 'tout'))
+        time = simulationOutput.get('tout');
+        if ~isempty(time)
+            simulationTime = time(end);
+        end
+    end
+
+    signals = {};
+    if any(strcmp(outputNames, 'logsout'))
+        signals = datasetSummaries(simulationOutput.get('logsout'), '');
+    end
+
+    scopeData = {};
+    for index = 1:numel(scopes)
+        if any(strcmp(outputNames, scopeVariables{index}))
+            dataset = simulationOutput.get(scopeVariables{index});
+            signals = [signals, datasetSummaries(dataset, scopes{index})]; %#ok<AGROW>
+            scopeData(end+1, :) = {scopes{index}, dataset}; %#ok<AGROW>
+        end
+    end
+
+    data = struct( ...
+        'Model', model, ...
+        'SimulationTime', simulationTime, ...
+        'Signals', {signals}, ...
+        'Diagnostics', {diagnostics}, ...
+        'Image', plotScopes(scopeData));
+end
+
+function paths = findBlocks(system, recursive)
+    searchOptions = {'LookUnderMasks', 'all', 'FollowLinks', 'on'};
+    if ~recursive
+        searchOptions = [{'SearchDepth', 1}, searchOptions];
+    end
+
+    paths = find_system(system, searchOptions{:}, 'Type', 'Block');
+    % find_system also returns the system itself when it is a subsystem.
+    paths = paths(~strcmp(paths, system));
+end
+
+function loadModelOf(system)
+    load_system(strtok(system, '/'));
+end
+
+function text = valueText(value)
+    if ischar(value) || isstring(value)
+        text = char(value);
+    elseif (isnumeric(value) || islogical(value)) && ismatrix(value)
+        text = mat2str(value);
+    else
+        text = char(strtrim(formattedDisplayText(value)));
+    end
+end
+
+function result = diagnostic(severity, message, identifier, blocks)
+    result = struct( ...
+        'Severity', severity, ...
+        'Message', message, ...
+        'Identifier', identifier, ...
+        'Blocks', {blocks});
+end
+
+% Returns a diagnostic for each cause of the exception that has no cause itself,
+% with the blocks that Simulink reports for it.
+function diagnostics = exceptionDiagnostics(exception)
+    if ~isempty(exception.cause)
+        diagnostics = {};
+        for index = 1:numel(exception.cause)
+            diagnostics = [diagnostics, exceptionDiagnostics(exception.cause{index})]; %#ok<AGROW>
+        end
+        return
+    end
+
+    blocks = {};
+    if isa(exception, 'MSLException') && ~isempty(exception.handles)
+        try
+            blocks = cellstr(getfullname([exception.handles{:}]));
+        catch
+            blocks = {};
+        end
+        blocks = reshape(blocks, 1, []);
+    end
+
+    diagnostics = {diagnostic('error', exception.message, exception.identifier, blocks)};
+end
+
+function diagnostics = warningDiagnostics(warnings)
+    diagnostics = cell(1, numel(warnings));
+    for index = 1:numel(warnings)
+        blocks = {};
+        if ~isempty(warnings(index).SourceFullName)
+            blocks = {warnings(index).SourceFullName};
+        end
+        diagnostics{index} = diagnostic('warning', warnings(index).Message, warnings(index).MessageID, blocks);
+    end
+end
+
+function summaries = datasetSummaries(dataset, blockPath)
+    summaries = {};
+    for index = 1:dataset.numElements
+        element = dataset.getElement(index);
+        elementBlockPath = blockPath;
+        if isempty(elementBlockPath) && isprop(element, 'BlockPath') && element.BlockPath.getLength > 0
+            elementBlockPath = element.BlockPath.getBlock(1);
+        end
+        summaries = [summaries, valueSummaries(element.Name, elementBlockPath, element.Values)]; %#ok<AGROW>
+    end
+end
+
+% Summarizes a logged value. Buses are logged as structures of timeseries, which are summarized field by field.
+function summaries = valueSummaries(name, blockPath, values)
+    summaries = {};
+    if isstruct(values)
+        fields = fieldnames(values);
+        for index = 1:numel(fields)
+            summaries = [summaries, valueSummaries([name, '.', fields{index}], blockPath, values.(fields{index}))]; %#ok<AGROW>
+        end
+        return
+    end
+
+    if ~isa(values, 'timeseries') || isempty(values.Time)
+        return
+    end
+
+    data = double(values.Data);
+    sampleCount = numel(values.Time);
+    if values.IsTimeFirst
+        samples = reshape(data, sampleCount, [])';
+    else
+        samples = reshape(data, [], sampleCount);
+    end
+
+    summaries = {struct( ...
+        'Name', name, ...
+        'BlockPath', blockPath, ...
+        'Samples', sampleCount, ...
+        'StartTime', values.Time(1), ...
+        'EndTime', values.Time(end), ...
+        'Min', min(data(:)), ...
+        'Max', max(data(:)), ...
+        'Mean', mean(data(:)), ...
+        'Final', {num2cell(samples(:, end)')})};
+end
+
+% Plots the data logged by each scope in its own axes, and returns the figure as a base64 PNG image.
+function image = plotScopes(scopeData)
+    image = '';
+    scopeCount = size(scopeData, 1);
+    if scopeCount == 0
+        return
+    end
+
+    figureHandle = figure('Visible', 'off');
+    closeFigure = onCleanup(@() close(figureHandle));
+
+    for index = 1:scopeCount
+        axesHandle = subplot(scopeCount, 1, index, 'Parent', figureHandle);
+        hold(axesHandle, 'on');
+        dataset = scopeData{index, 2};
+        names = {};
+        for elementIndex = 1:dataset.numElements
+            element = dataset.getElement(elementIndex);
+            if isa(element.Values, 'timeseries')
+                plot(axesHandle, element.Values.Time, squeeze(element.Values.Data));
+                names{end+1} = element.Name; %#ok<AGROW>
+            end
+        end
+        title(axesHandle, get_param(scopeData{index, 1}, 'Name'), 'Interpreter', 'none');
+        xlabel(axesHandle, 'Time');
+        grid(axesHandle, 'on');
+        if ~isempty(names) && ~all(cellfun(@isempty, names))
+            legend(axesHandle, names, 'Interpreter', 'none');
+        end
+    end
+
+    imageFile = [tempname, '.png'];
+    deleteImage = onCleanup(@() delete(imageFile));
+    print(figureHandle, imageFile, '-dpng', '-r100');
+
+    fileID = fopen(imageFile, 'r');
+    bytes = fread(fileID, '*uint8');
+    fclose(fileID);
+
+    image = matlab.net.base64encode(bytes');
+end
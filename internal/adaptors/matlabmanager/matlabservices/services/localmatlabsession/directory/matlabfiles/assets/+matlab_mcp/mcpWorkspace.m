@@ -0,0 +1,68 @@
+% IMPORTANT NOTICE:
+% This file may contain calls to MathWorks internal APIs which are subject to
+% change without any prior notice. Usage of these undocumented APIs outside of
+% these files is not supported.
+
+function result = mcpWorkspace(action, varargin)
+    % mcpWorkspace A helper function for the workspace snapshot tools of the MATLAB MCP Server.
+    % It saves the base workspace to a MAT-file, or loads a MAT-file into the base workspace,
+    % and returns the names of the variables as JSON text. It also returns the size, in bytes,
+    % of the base workspace in memory, which is checked before the workspace is saved.
+    %
+    % Lists are returned as cell arrays, so that jsonencode writes them as JSON
+    % arrays even when they have a single element.
+
+    % Copyright 2026 The MathWorks, Inc.
+
+    switch action
+        case 'size'
+            data = workspaceSize();
+        case 'save'
+            data = saveWorkspace(varargin{:});
+        case 'load'
+            data = loadWorkspace(varargin{:});
+        otherwise
+            error('matlab_mcp:workspace:unknownAction', 'Unknown workspace action: %s', action);
+    end
+
+    result = jsonencode(data);
+end
+
+function data = workspaceSize()
+    info = evalin('base', 'whos');
+    data = sum([info.bytes]);
+end
+
+function data = saveWorkspace(filePath)
+    variables = evalin('base', 'who');
+
+    % Version 7.3 MAT-files can hold variables of 2 GB and more.
+    evalin('base', sprintf('save(%s, ''-v7.3'');', quote(filePath)));
+
+    data = reshape(variables, 1, []);
+end
+
+function data = loadWorkspace(filePath, mode)
+    if ~any(strcmp(mode, {'merge', 'replace'}))
+        error('matlab_mcp:workspace:unknownMode', 'Unknown restore mode: %s', mode);
+    end
+
+    % The MAT-file is read before the workspace is cleared, so that the workspace is kept
+    % if the MAT-file cannot be read.
+    variables = load(filePath);
+
+    if strcmp(mode, 'replace')
+        evalin('base', 'clear');
+    end
+
+    names = fieldnames(variables);
+    for ii = 1:numel(names)
+        assignin('base', names{ii}, variables.(names{ii}));
+    end
+
+    data = reshape(names, 1, []);
+end
+
+function quoted = quote(text)
+    quoted = ['''' strrep(text, '''', '''''') ''''];
+end
@@ -10,9 +10,33 @@ var initializeMCP []byte
 //go:embed assets/+matlab_mcp/mcpEval.m
 var mcpEval []byte
 
+//go:embed assets/+matlab_mcp/mcpExportFigures.m
+var mcpExportFigures []byte
+
 //go:embed assets/+matlab_mcp/getOrStashExceptions.m
 var getOrStashExceptions []byte
 
+//go:embed assets/+matlab_mcp/mcpLiveScriptCode.m
+var mcpLiveScriptCode []byte
+
+//go:embed assets/+matlab_mcp/mcpConvertLiveScript.m
+var mcpConvertLiveScript []byte
+
+//go:embed assets/+matlab_mcp/mcpCompleteFunction.m
+var mcpCompleteFunction []byte
+
+//go:embed assets/+matlab_mcp/mcpHelp.m
+var mcpHelp []byte
+
+//go:embed assets/+matlab_mcp/mcpProject.m
+var mcpProject []byte
+
+//go:embed assets/+matlab_mcp/mcpSimulink.m
+var mcpSimulink []byte
+
+//go:embed assets/+matlab_mcp/mcpWorkspace.m
+var mcpWorkspace []byte
+
 type MATLABFiles struct{}
 
 func New() MATLABFiles {
@@ -23,6 +47,14 @@ func (g MATLABFiles) GetAll() map[string][]byte {
 	return map[string][]byte{
 		"initializeMCP.m":        initializeMCP,
 		"mcpEval.m":              mcpEval,
+		"mcpExportFigures.m":     mcpExportFigures,
 		"getOrStashExceptions.m": getOrStashExceptions,
+		"mcpLiveScriptCode.m":    mcpLiveScriptCode,
+		"mcpConvertLiveScript.m": mcpConvertLiveScript,
+		"mcpCompleteFunction.m":  mcpCompleteFunction,
+		"mcpHelp.m":              mcpHelp,
+		"mcpProject.m":           mcpProject,
+		"mcpSimulink.m":          mcpSimulink,
+		"mcpWorkspace.m":         mcpWorkspace,
 	}
 }
@@ -5,6 +5,7 @@ package localmatlabsession
 import (
 	"context"
 	"runtime"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
@@ -20,12 +21,12 @@ type SessionDirectoryFactory interface {
 
 type ProcessDetails interface {
 	NewAPIKey() string
-	EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string
-	StartupFlag(os string, showMATLAB bool, startupCode string) []string
+	EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, additionalEnvironmentVariables []string) []string
+	StartupFlag(os string, showMATLAB bool, additionalFlags []string, startupCode string) []string
 }
 
 type MATLABProcessLauncher interface {
-	Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error)
+	Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string, memoryLimit uint64) (int, func(), <-chan struct{}, error)
 }
 
 type Watchdog interface {
@@ -53,12 +54,13 @@ func NewStarter(
 	}
 }
 
-func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+// StartLocalMATLABSession also returns a channel that is closed when the MATLAB process exits.
+func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error) {
 	logger.Debug("Starting a local MATLAB session")
 
 	sessionDir, err := m.directoryFactory.New(logger)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, nil, nil, err
 	}
 
 	sessionDirPath := sessionDir.Path()
@@ -77,16 +79,17 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 		uniqueAPIKey,
 		sessionDir.CertificateFile(),
 		sessionDir.CertificateKeyFile(),
+		request.EnvironmentVariables,
 	)
 
-	startupFlags := m.processDetails.StartupFlag(runtime.GOOS, request.ShowMATLABDesktop, startupCode)
+	startupFlags := m.processDetails.StartupFlag(runtime.GOOS, request.ShowMATLABDesktop, request.StartupFlags, startupCodeFor(request))
 
-	processID, processCleanup, _, err := m.matlabProcessLauncher.Launch(ctx, logger, sessionDirPath, request.MATLABRoot, request.StartingDirectory, startupFlags, env)
+	processID, processCleanup, processExited, err := m.matlabProcessLauncher.Launch(ctx, logger, sessionDirPath, request.MATLABRoot, request.StartingDirectory, startupFlags, env, request.MemoryLimit)
 	if err != nil {
 		if cleanupErr := sessionDir.Cleanup(); cleanupErr != nil {
 			logger.WithError(cleanupErr).Warn("Failed to cleanup session directory after launch error")
 		}
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, nil, nil, err
 	}
 
 	logger = logger.With("pid", processID)
@@ -112,7 +115,7 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 		if cleanupErr := cleanup(); cleanupErr != nil {
 			logger.WithError(cleanupErr).Warn("Failed to cleanup after startup error")
 		}
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, nil, nil, err
 	}
 
 	logger.Debug("Retrieved EC details")
@@ -122,5 +125,29 @@ func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.L
 		Port:           securePort,
 		APIKey:         uniqueAPIKey,
 		CertificatePEM: certificatePEM,
-	}, cleanup, nil
+	}, cleanup, processExited, nil
+}
+
+// startupCodeFor appends the path folders and the startup script of the request to the startup code.
+// They run after the MCP initialization, so that an error in the startup script does not prevent the connection.
+func startupCodeFor(request datatypes.LocalSessionDetails) string {
+	code := startupCode
+
+	if len(request.Path) > 0 {
+		quotedFolders := make([]string, len(request.Path))
+		for i, folder := range request.Path {
+			quotedFolders[i] = quoteMATLABString(folder)
+		}
+		code += "addpath(" + strings.Join(quotedFolders, ",") + ");"
+	}
+
+	if request.StartupScript != "" {
+		code += "run(" + quoteMATLABString(request.StartupScript) + ");"
+	}
+
+	return code
+}
+
+func quoteMATLABString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
 }
@@ -105,19 +105,19 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, processCleanup, nil, nil).
 		Once()
 
@@ -149,7 +149,7 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, startErr)
@@ -166,6 +166,245 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	assert.True(t, processCleanupCalled)
 }
 
+func TestStarter_StartLocalMATLABSession_MemoryLimit(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedEnv := []string{"MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	expectedStartupCode := "sessionPath = getenv('MW_MCP_SESSION_DIR');addpath(sessionPath);matlab_mcp.initializeMCP(); clear sessionPath;"
+	showDesktop := false
+	expectedStartupFlags := []string{"-r", expectedStartupCode}
+	expectedProcessID := 12345
+	expectedMemoryLimit := uint64(8 << 30)
+	processExited := make(chan struct{})
+	processCleanup := func() {}
+
+	mockDirectoryFactory.EXPECT().
+		New(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
+		Return(expectedEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
+		Return(expectedStartupFlags).
+		Once()
+
+	expectedCtx := t.Context()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, expectedMemoryLimit).
+		Return(expectedProcessID, processCleanup, processExited, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		IsStartingDirectorySet: false,
+		MATLABRoot:             expectedMATLABRoot,
+		MemoryLimit:            expectedMemoryLimit,
+	}
+
+	// Act
+	_, _, exited, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, startErr)
+	assert.Equal(t, (<-chan struct{})(processExited), exited)
+}
+
+func TestStarter_StartLocalMATLABSession_LaunchSettings(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedEnv := []string{"MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	startupFlags := []string{"-singleCompThread"}
+	environmentVariables := []string{"MLM_LICENSE_FILE=27000@licenseserver"}
+	startupScript := filepath.Join("home", "user's project", "startup.m")
+	pathFolderA := filepath.Join("home", "user", "lib")
+	pathFolderB := filepath.Join("home", "user", "utils")
+	expectedStartupCode := "sessionPath = getenv('MW_MCP_SESSION_DIR');addpath(sessionPath);matlab_mcp.initializeMCP(); clear sessionPath;" +
+		"addpath('" + pathFolderA + "','" + pathFolderB + "');" +
+		"run('" + filepath.Join("home", "user''s project", "startup.m") + "');"
+	showDesktop := false
+	expectedStartupFlags := []string{"-r", expectedStartupCode}
+	expectedProcessID := 12345
+	processCleanupCalled := false
+	processCleanup := func() {
+		processCleanupCalled = true
+	}
+
+	mockDirectoryFactory.EXPECT().
+		New(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, environmentVariables).
+		Return(expectedEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDesktop, startupFlags, expectedStartupCode).
+		Return(expectedStartupFlags).
+		Once()
+
+	expectedCtx := t.Context()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
+		Return(expectedProcessID, processCleanup, nil, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		IsStartingDirectorySet: false,
+		MATLABRoot:             expectedMATLABRoot,
+		StartupFlags:           startupFlags,
+		EnvironmentVariables:   environmentVariables,
+		StartupScript:          startupScript,
+		Path:                   []string{pathFolderA, pathFolderB},
+	}
+
+	// Act
+	connectionDetails, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, startErr)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, "localhost", connectionDetails.Host)
+	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
+	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
+	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+
+	require.NoError(t, cleanup())
+	assert.True(t, processCleanupCalled)
+}
+
 func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	// Arrange
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
@@ -226,19 +465,19 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, processCleanup, nil, nil).
 		Once()
 
@@ -267,7 +506,7 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, startErr)
@@ -316,7 +555,7 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryNewError(t *testing.T)
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+	connectionDetails, cleanup, _, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -380,19 +619,19 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(0, nil, nil, expectedError).
 		Once()
 
@@ -414,7 +653,7 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 	}
 
 	// Act
-	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, startErr, expectedError)
@@ -483,19 +722,19 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, processCleanup, nil, nil).
 		Once()
 
@@ -524,7 +763,7 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	}
 
 	// Act
-	connectionDetails, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, startErr)
@@ -600,19 +839,19 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, processCleanup, nil, nil).
 		Once()
 
@@ -644,7 +883,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, err := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -713,19 +952,19 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, showDesktop, expectedStartupCode).
+		StartupFlag(runtime.GOOS, showDesktop, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, processCleanup, nil, nil).
 		Once()
 
@@ -757,7 +996,7 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 	}
 
 	// Act
-	_, cleanup, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	_, cleanup, _, startErr := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 	require.NoError(t, startErr)
 	require.NotNil(t, cleanup)
 	// The caller owns the returned cleanup callback, so invoke it and assert the propagated cleanup error.
@@ -823,19 +1062,19 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, []string(nil)).
 		Return(expectedEnv).
 		Once()
 
 	mockProcessDetails.EXPECT().
-		StartupFlag(runtime.GOOS, false, expectedStartupCode).
+		StartupFlag(runtime.GOOS, false, []string(nil), expectedStartupCode).
 		Return(expectedStartupFlags).
 		Once()
 
 	expectedCtx := t.Context()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
+		Launch(expectedCtx, mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv, uint64(0)).
 		Return(expectedProcessID, nil, nil, nil).
 		Once()
 
@@ -867,7 +1106,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError_WithNi
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
+	connectionDetails, cleanup, _, err := starter.StartLocalMATLABSession(expectedCtx, mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
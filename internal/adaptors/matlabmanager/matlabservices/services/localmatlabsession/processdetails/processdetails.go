@@ -38,9 +38,17 @@ func (*ProcessDetails) NewAPIKey() string {
 	return uuid.NewString()
 }
 
-func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string {
-	processEnvVars := append(
-		g.osLayer.Environ(),
+// EnvironmentVariables returns the environment of the MATLAB process.
+// additionalEnvironmentVariables, in the form NAME=VALUE, override the environment of the server,
+// but not the variables the server needs to connect to MATLAB.
+func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, additionalEnvironmentVariables []string) []string {
+	processEnvVars := g.osLayer.Environ()
+	for _, envVar := range additionalEnvironmentVariables {
+		processEnvVars = setEnvVar(processEnvVars, envVar)
+	}
+
+	processEnvVars = append(
+		processEnvVars,
 		"MATLAB_LOG_DIR="+sessionDirPath,
 		"MW_MCP_SESSION_DIR="+sessionDirPath,
 		`MW_DIAGNOSTIC_DEST="filedir=`+sessionDirPath+`"`,
@@ -77,7 +85,9 @@ func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey stri
 	return processEnvVars
 }
 
-func (*ProcessDetails) StartupFlag(os string, showMATLAB bool, startupCode string) []string {
+// StartupFlag returns the command-line arguments of the MATLAB process.
+// additionalFlags come after the flags for the display mode, and before the startup code.
+func (*ProcessDetails) StartupFlag(os string, showMATLAB bool, additionalFlags []string, startupCode string) []string {
 	startupFlags := []string{}
 	if showMATLAB {
 		startupFlags = append(startupFlags,
@@ -97,9 +107,26 @@ func (*ProcessDetails) StartupFlag(os string, showMATLAB bool, startupCode strin
 			)
 		}
 	}
+	startupFlags = append(startupFlags, additionalFlags...)
 	startupFlags = append(startupFlags,
 		"-r",
 		startupCode,
 	)
 	return startupFlags
 }
+
+// setEnvVar adds envVar, in the form NAME=VALUE, replacing any existing value of the same variable.
+// Duplicates must be avoided, as which value the process sees is platform dependent.
+func setEnvVar(processEnvVars []string, envVar string) []string {
+	name, _, _ := strings.Cut(envVar, "=")
+	envVarPrefix := name + "="
+
+	result := make([]string, 0, len(processEnvVars)+1)
+	for _, existing := range processEnvVars {
+		if !strings.HasPrefix(existing, envVarPrefix) {
+			result = append(result, existing)
+		}
+	}
+
+	return append(result, envVar)
+}
@@ -75,7 +75,7 @@ func TestProcessDetails_EnvironmentVariables_HappyPath(t *testing.T) {
 	details := processdetails.New(mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, nil)
 
 	// Assert
 	expectedEnv := append(
@@ -112,7 +112,7 @@ func TestProcessDetails_EnvironmentVariables_EmptyExistingEnvironment(t *testing
 	details := processdetails.New(mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, nil)
 
 	// Assert
 	expectedEnv := []string{
@@ -152,7 +152,7 @@ func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.
 	details := processdetails.New(mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, nil)
 
 	// Assert
 	expectedEnv := append(
@@ -170,6 +170,60 @@ func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.
 	assert.ElementsMatch(t, expectedEnv, env)
 }
 
+func TestProcessDetails_EnvironmentVariables_AdditionalEnvironmentVariables(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirPath := "/tmp/matlab-session-12345"
+	apiKey := "test-api-key-12345"
+	certificateFile := "/tmp/matlab-session-12345/cert.pem"
+	certificateKey := "/tmp/matlab-session-12345/cert.key"
+	existingEnv := []string{"PATH=/usr/bin", "HTTPS_PROXY=http://old-proxy:8080"}
+	additionalEnv := []string{"HTTPS_PROXY=http://proxy:8080", "MLM_LICENSE_FILE=27000@licenseserver"}
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return(existingEnv).
+		Once()
+
+	details := processdetails.New(mockOSLayer)
+
+	// Act
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, additionalEnv)
+
+	// Assert
+	expectedEnv := []string{
+		"PATH=/usr/bin",
+		"HTTPS_PROXY=http://proxy:8080",
+		"MLM_LICENSE_FILE=27000@licenseserver",
+		"MATLAB_LOG_DIR=" + sessionDirPath,
+		"MW_MCP_SESSION_DIR=" + sessionDirPath,
+		`MW_DIAGNOSTIC_DEST="filedir=` + sessionDirPath + `"`,
+		"MW_CONTEXT_TAGS=MATLAB:MATLAB_MCP_CORE_SERVER:V1",
+		"MWAPIKEY=" + apiKey,
+		"MW_CERTFILE=" + certificateFile,
+		"MW_PKEYFILE=" + certificateKey,
+	}
+	assert.ElementsMatch(t, expectedEnv, env)
+}
+
+func TestProcessDetails_StartupFlag_AdditionalFlags(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	details := processdetails.New(mockOSLayer)
+	startupCode := "disp('Hello World');"
+	additionalFlags := []string{"-singleCompThread", "-logfile", "/tmp/matlab.log"}
+
+	// Act
+	flags := details.StartupFlag("linux", true, additionalFlags, startupCode)
+
+	// Assert
+	assert.Equal(t, []string{"-desktop", "-singleCompThread", "-logfile", "/tmp/matlab.log", "-r", startupCode}, flags)
+}
+
 func TestProcessDetails_StartupFlag_HappyPath(t *testing.T) {
 	for _, testConfig := range []struct {
 		os            string
@@ -237,7 +291,7 @@ func TestProcessDetails_StartupFlag_HappyPath(t *testing.T) {
 			startupCode := "disp('Hello World');"
 
 			// Act
-			flags := details.StartupFlag(testConfig.os, testConfig.showDesktop, startupCode)
+			flags := details.StartupFlag(testConfig.os, testConfig.showDesktop, nil, startupCode)
 
 			// Assert
 			assert.Equal(t,
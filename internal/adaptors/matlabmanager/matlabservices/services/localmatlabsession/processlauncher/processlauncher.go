@@ -16,10 +16,20 @@ import (
 
 const gracefulShutdownTimeout = 2 * time.Minute
 
-type MATLABProcessLauncher struct{}
+type MemoryLimiter interface {
+	LimitMemory(pid int, limit uint64) error
+}
+
+type MATLABProcessLauncher struct {
+	memoryLimiter MemoryLimiter
+}
 
-func New() *MATLABProcessLauncher {
-	return &MATLABProcessLauncher{}
+func New(
+	memoryLimiter MemoryLimiter,
+) *MATLABProcessLauncher {
+	return &MATLABProcessLauncher{
+		memoryLimiter: memoryLimiter,
+	}
 }
 
 func (l *MATLABProcessLauncher) Launch(
@@ -30,6 +40,7 @@ func (l *MATLABProcessLauncher) Launch(
 	workingDir string,
 	args []string,
 	env []string,
+	memoryLimit uint64,
 ) (int, func(), <-chan struct{}, error) {
 	stdIO, stdIOCleanup, err := createLocalStdioForNewProcess(logger, sessionRoot)
 	if err != nil {
@@ -44,6 +55,16 @@ func (l *MATLABProcessLauncher) Launch(
 		return 0, nil, nil, fmt.Errorf("failed to start MATLAB process: %w", err)
 	}
 
+	// The MATLAB launcher script takes a while before it starts MATLAB itself, which then inherits the limit.
+	if memoryLimit > 0 {
+		if err := l.memoryLimiter.LimitMemory(process.Pid, memoryLimit); err != nil {
+			killMATLABProcess(logger, process)
+			_, _ = process.Wait()
+			stdIOCleanup()
+			return 0, nil, nil, fmt.Errorf("failed to limit the memory of MATLAB: %w", err)
+		}
+	}
+
 	processExited := make(chan struct{})
 	waitResult := make(chan error, 1)
 
@@ -8,11 +8,16 @@ import (
 	"path/filepath"
 	"strings"
 
+	appconfig "github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/config"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
 )
 
+// matlabRootEnvVar may list one or more MATLAB roots, separated by the platform path list separator.
+const matlabRootEnvVar = "MATLAB_ROOT"
+
 type OSLayer interface {
 	Getenv(key string) string
 	Stat(name string) (osfacade.FileInfo, error)
@@ -20,73 +25,143 @@ type OSLayer interface {
 
 type FileLayer interface {
 	EvalSymlinks(path string) (string, error)
+	Glob(pattern string) ([]string, error)
+}
+
+type ConfigFactory interface {
+	Config() (appconfig.Config, messages.Error)
 }
 
 type Getter struct {
-	osLayer   OSLayer
-	fileLayer FileLayer
+	osLayer       OSLayer
+	fileLayer     FileLayer
+	configFactory ConfigFactory
 }
 
 func New(
 	osLayer OSLayer,
 	fileLayer FileLayer,
+	configFactory ConfigFactory,
 ) *Getter {
 	return &Getter{
-		osLayer:   osLayer,
-		fileLayer: fileLayer,
+		osLayer:       osLayer,
+		fileLayer:     fileLayer,
+		configFactory: configFactory,
 	}
 }
 
+// GetAll returns the MATLAB roots found, in order, on the PATH, in the MATLAB_ROOT environment variable,
+// in the user configured search folders and in the platform standard installation folders.
+// Each MATLAB root is only returned once.
 func (s *Getter) GetAll(logger entities.Logger) []string {
-	pathList := strings.Split(s.osLayer.Getenv("PATH"), string(os.PathListSeparator))
-
 	matlabLocations := make([]string, 0)
+	seen := make(map[string]struct{})
 
-	for _, path := range pathList {
-		// Fix path formatting on all platforms as CMD formatting can be strange
-		path = strings.Trim(path, ` "'`)
+	addLocation := func(matlabRoot string) {
+		key := filepath.Clean(matlabRoot)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		matlabLocations = append(matlabLocations, matlabRoot)
+	}
 
-		if path == "" {
-			continue
+	for _, path := range strings.Split(s.osLayer.Getenv("PATH"), string(os.PathListSeparator)) {
+		if matlabRoot, ok := s.matlabRootFromBinFolder(logger, path); ok {
+			addLocation(matlabRoot)
 		}
+	}
 
-		path, err := s.fileLayer.EvalSymlinks(path)
-		if err != nil {
-			logger.With("path", path).WithError(err).Warn("Error evaluating if the path was a symbolic link")
-			continue
+	for _, candidate := range s.candidateMATLABRoots(logger) {
+		if matlabRoot, ok := s.matlabRootFromBinFolder(logger, filepath.Join(candidate, "bin")); ok {
+			addLocation(matlabRoot)
 		}
+	}
 
-		matlabExePath := filepath.Join(path, config.MATLABExeName)
+	if len(matlabLocations) == 0 {
+		return nil
+	}
+
+	return matlabLocations
+}
+
+// candidateMATLABRoots lists the folders outside of the PATH that might be MATLAB roots.
+func (s *Getter) candidateMATLABRoots(logger entities.Logger) []string {
+	candidates := make([]string, 0)
 
-		// Check that the MATLAB executable exists and is a file
-		fileInfo, err := s.osLayer.Stat(matlabExePath)
-		if err != nil {
-			if !errors.Is(err, os.ErrNotExist) {
-				logger.With("path", matlabExePath).WithError(err).Warn("Unable to evaluate file")
-			}
-			continue
+	for _, matlabRoot := range filepath.SplitList(s.osLayer.Getenv(matlabRootEnvVar)) {
+		if matlabRoot = strings.Trim(matlabRoot, ` "'`); matlabRoot != "" {
+			candidates = append(candidates, matlabRoot)
 		}
-		if fileInfo.IsDir() {
-			continue
+	}
+
+	cfg, err := s.configFactory.Config()
+	if err != nil {
+		logger.WithError(err).Warn("Unable to get configuration, skipping MATLAB search folders")
+	} else {
+		for _, searchFolder := range cfg.MATLABSearchFolders() {
+			// A search folder can either be a MATLAB root or contain MATLAB roots
+			candidates = append(candidates, searchFolder)
+			candidates = append(candidates, s.glob(logger, filepath.Join(searchFolder, "*"))...)
 		}
+	}
 
-		// Follow the executable symlink to find the MATLAB root directory
-		// If the executable is not a symlink then the raw path will be the same as the original path
-		matlabRawPath, err := s.fileLayer.EvalSymlinks(matlabExePath)
-		if err != nil {
-			logger.With("path", matlabExePath).WithError(err).Warn("Error evaluating if the found executable was a symbolic link")
-			continue
+	for _, pattern := range config.StandardInstallationPatterns() {
+		candidates = append(candidates, s.glob(logger, pattern)...)
+	}
+
+	return candidates
+}
+
+func (s *Getter) glob(logger entities.Logger, pattern string) []string {
+	matches, err := s.fileLayer.Glob(pattern)
+	if err != nil {
+		logger.With("pattern", pattern).WithError(err).Warn("Unable to search for MATLAB installations")
+		return nil
+	}
+
+	return matches
+}
+
+// matlabRootFromBinFolder checks whether the given folder contains the MATLAB executable and, if so, returns the MATLAB root.
+func (s *Getter) matlabRootFromBinFolder(logger entities.Logger, path string) (string, bool) {
+	// Fix path formatting on all platforms as CMD formatting can be strange
+	path = strings.Trim(path, ` "'`)
+
+	if path == "" {
+		return "", false
+	}
+
+	path, err := s.fileLayer.EvalSymlinks(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.With("path", path).WithError(err).Warn("Error evaluating if the path was a symbolic link")
 		}
+		return "", false
+	}
 
-		// The matlab exe is in the bin directory of matlab root and so we need to extract the root directory
-		matlabRoot := filepath.Dir(filepath.Dir(matlabRawPath))
+	matlabExePath := filepath.Join(path, config.MATLABExeName)
 
-		matlabLocations = append(matlabLocations, matlabRoot)
+	// Check that the MATLAB executable exists and is a file
+	fileInfo, err := s.osLayer.Stat(matlabExePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.With("path", matlabExePath).WithError(err).Warn("Unable to evaluate file")
+		}
+		return "", false
+	}
+	if fileInfo.IsDir() {
+		return "", false
 	}
 
-	if len(matlabLocations) == 0 {
-		return nil
+	// Follow the executable symlink to find the MATLAB root directory
+	// If the executable is not a symlink then the raw path will be the same as the original path
+	matlabRawPath, err := s.fileLayer.EvalSymlinks(matlabExePath)
+	if err != nil {
+		logger.With("path", matlabExePath).WithError(err).Warn("Error evaluating if the found executable was a symbolic link")
+		return "", false
 	}
 
-	return matlabLocations
+	// The matlab exe is in the bin directory of matlab root and so we need to extract the root directory
+	return filepath.Dir(filepath.Dir(matlabRawPath)), true
 }
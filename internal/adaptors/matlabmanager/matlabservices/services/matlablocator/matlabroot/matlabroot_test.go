@@ -10,7 +10,9 @@ import (
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,12 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
@@ -37,8 +45,8 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 	}()
 
 	inputPaths := []string{
-		"/valid/path1",
-		"/valid/path2",
+		"/valid/path1/bin",
+		"/valid/path2/bin",
 	}
 
 	pathEnv := strings.Join(inputPaths, string(os.PathListSeparator))
@@ -52,7 +60,9 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 		addSuccessfulPathCheck(path, mockOSLayer, mockFileLayer, mockFileInfo)
 	}
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	results := service.GetAll(mockLogger)
@@ -80,6 +90,12 @@ func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
@@ -103,7 +119,9 @@ func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -125,6 +143,12 @@ func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
@@ -154,7 +178,9 @@ func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -176,6 +202,12 @@ func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
@@ -210,7 +242,9 @@ func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -233,6 +267,12 @@ func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
@@ -272,7 +312,9 @@ func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -292,6 +334,12 @@ func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockOSLayer.EXPECT().
@@ -299,7 +347,9 @@ func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 		Return("").
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -316,6 +366,12 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 	mockFileLayer := &mocks.MockFileLayer{}
 	defer mockFileLayer.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	// Setup paths
@@ -338,7 +394,9 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 		Return(nil, os.ErrNotExist).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoAdditionalLocations(mockOSLayer, mockFileLayer, mockConfigFactory, mockConfig)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -349,6 +407,201 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 	assert.Len(t, mockLogger.WarnLogs(), 0, "No warning logs should be generated for files not existing") //nolint:testifylint // Len check is consistent with other logger checks
 }
 
+func TestMATLABRootGetter_GetAll_AdditionalLocations(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	pathMATLABRoot := filepath.FromSlash("/path/MATLAB/R2024b")
+	envMATLABRoot := filepath.FromSlash("/env/MATLAB/R2023b")
+	searchFolder := filepath.FromSlash("/search/MATLAB")
+	searchFolderMATLABRoot := filepath.Join(searchFolder, "R2024a")
+	standardPatterns := config.StandardInstallationPatterns()
+	standardMATLABRoot := filepath.FromSlash("/standard/MATLAB/R2025a")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return(filepath.Join(pathMATLABRoot, "bin")).
+		Once()
+
+	addSuccessfulPathCheck(filepath.Join(pathMATLABRoot, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	mockOSLayer.EXPECT().
+		Getenv("MATLAB_ROOT").
+		Return(strings.Join([]string{envMATLABRoot, pathMATLABRoot}, string(os.PathListSeparator))).
+		Once()
+
+	addSuccessfulPathCheck(filepath.Join(envMATLABRoot, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	// The MATLAB root on the PATH is also listed in MATLAB_ROOT, so it is checked twice but only returned once
+	addSuccessfulPathCheck(filepath.Join(pathMATLABRoot, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSearchFolders().
+		Return([]string{searchFolder}).
+		Once()
+
+	// The search folder is not itself a MATLAB root
+	mockFileLayer.EXPECT().
+		EvalSymlinks(filepath.Join(searchFolder, "bin")).
+		Return("", os.ErrNotExist).
+		Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(searchFolder, "*")).
+		Return([]string{searchFolderMATLABRoot}, nil).
+		Once()
+
+	addSuccessfulPathCheck(filepath.Join(searchFolderMATLABRoot, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	for i, pattern := range standardPatterns {
+		var matches []string
+		if i == 0 {
+			matches = []string{standardMATLABRoot}
+		}
+
+		mockFileLayer.EXPECT().
+			Glob(pattern).
+			Return(matches, nil).
+			Once()
+	}
+
+	addSuccessfulPathCheck(filepath.Join(standardMATLABRoot, "bin"), mockOSLayer, mockFileLayer, mockFileInfo)
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
+
+	// Act
+	results := service.GetAll(mockLogger)
+
+	// Assert
+	expectedResults := []string{pathMATLABRoot, envMATLABRoot, searchFolderMATLABRoot, standardMATLABRoot}
+	assert.Equal(t, expectedResults, results)
+
+	//nolint:testifylint // Clearer to use len check for number of errors
+	assert.Len(t, mockLogger.WarnLogs(), 0, "No warning logs should be produced for folders that do not exist")
+}
+
+func TestMATLABRootGetter_GetAll_ConfigError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("MATLAB_ROOT").
+		Return("").
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, messages.AnError).
+		Once()
+
+	for _, pattern := range config.StandardInstallationPatterns() {
+		mockFileLayer.EXPECT().
+			Glob(pattern).
+			Return(nil, nil).
+			Once()
+	}
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Nil(t, result)
+
+	assert.Len(t, mockLogger.WarnLogs(), 1, "Failing to get the configuration should trigger a warning log")
+}
+
+func TestMATLABRootGetter_GetAll_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	standardPatterns := config.StandardInstallationPatterns()
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("MATLAB_ROOT").
+		Return("").
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSearchFolders().
+		Return(nil).
+		Once()
+
+	for _, pattern := range standardPatterns {
+		mockFileLayer.EXPECT().
+			Glob(pattern).
+			Return(nil, assert.AnError).
+			Once()
+	}
+
+	service := matlabroot.New(mockOSLayer, mockFileLayer, mockConfigFactory)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Nil(t, result)
+
+	assert.Contains(t, mockLogger.WarnLogs(), "Unable to search for MATLAB installations", "A failed search should trigger a warning log")
+}
+
 func addSuccessfulPathCheck(
 	path string,
 	mockOSLayer *mocks.MockOSLayer,
@@ -377,3 +630,32 @@ func addSuccessfulPathCheck(
 		Return(matlabExePath, nil).
 		Once()
 }
+
+func addNoAdditionalLocations(
+	mockOSLayer *mocks.MockOSLayer,
+	mockFileLayer *mocks.MockFileLayer,
+	mockConfigFactory *mocks.MockConfigFactory,
+	mockConfig *configmocks.MockConfig,
+) {
+	mockOSLayer.EXPECT().
+		Getenv("MATLAB_ROOT").
+		Return("").
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSearchFolders().
+		Return(nil).
+		Once()
+
+	for _, pattern := range config.StandardInstallationPatterns() {
+		mockFileLayer.EXPECT().
+			Glob(pattern).
+			Return(nil, nil).
+			Once()
+	}
+}
@@ -112,9 +112,14 @@ func (c *Client) sendEval(ctx context.Context, logger entities.Logger, code stri
 }
 
 func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	arguments := []string{input.Code}
+	if input.Figures.IsSet() {
+		arguments = append(arguments, newFigureOptionsArgument(input.Figures))
+	}
+
 	fevalRequest := entities.FEvalRequest{
 		Function:   "matlab_mcp.mcpEval",
-		Arguments:  []string{input.Code},
+		Arguments:  arguments,
 		NumOutputs: 1,
 	}
 
@@ -155,6 +155,61 @@ func TestClient_EvalWithCapture_ReturnStreams(t *testing.T) {
 	assert.Nil(t, response.Images)
 }
 
+func TestClient_EvalWithCapture_ReturnErrors(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	expectedWarning := "Warning: some warning"
+	expectedError := "Unrecognized function or variable 'undefined_function'."
+
+	entries := []embeddedconnector.LiveEditorResponseEntry{
+		{
+			Type: "stream",
+			Content: struct {
+				Text string `json:"text"`
+				Name string `json:"name"`
+			}{
+				Text: expectedWarning,
+				Name: "stderr",
+			},
+		},
+		{
+			Type: "stream",
+			Content: struct {
+				Text string `json:"text"`
+				Name string `json:"name"`
+			}{
+				Text: expectedError,
+				Name: "stderr",
+			},
+			IsError: true,
+		},
+	}
+	responseBody := buildEvalWithCaptureResponse(t, entries)
+
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(validateConnectorRequest)).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(responseBody)),
+		}, nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{Code: "undefined_function"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedWarning+expectedError, response.ConsoleOutput)
+	assert.Equal(t, []string{expectedError}, response.Errors)
+}
+
 func TestClient_EvalWithCapture_MultipleStreams_SameName(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -460,3 +515,104 @@ func TestClient_EvalWithCapture_ContextPropagation(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response)
 }
+
+func TestClient_EvalWithCapture_FigureOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	expectedCode := "plot(1:10)"
+	expectedImageData := []byte("<svg></svg>")
+
+	entries := []embeddedconnector.LiveEditorResponseEntry{
+		{
+			Type:     "execute_result",
+			MimeType: []string{"image/svg+xml"},
+			Value:    []json.RawMessage{json.RawMessage(`"` + base64.StdEncoding.EncodeToString(expectedImageData) + `"`)},
+		},
+	}
+	responseBody := buildEvalWithCaptureResponse(t, entries)
+
+	var figureOptions embeddedconnector.FigureOptionsMessage
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			payload, ok := parseConnectorRequest(req)
+			if !ok || len(payload.Messages.FEval) != 1 {
+				return false
+			}
+			feval := payload.Messages.FEval[0]
+			return feval.Function == "matlab_mcp.mcpEval" &&
+				len(feval.Arguments) == 2 &&
+				feval.Arguments[0] == expectedCode &&
+				json.Unmarshal([]byte(feval.Arguments[1]), &figureOptions) == nil
+		})).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(responseBody)),
+		}, nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{
+		Code:    expectedCode,
+		Figures: entities.FigureOptions{Format: entities.FigureFormatSVG, Width: 640, Height: 480},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, embeddedconnector.FigureOptionsMessage{Format: "svg", Width: 640, Height: 480}, figureOptions)
+	assert.Equal(t, [][]byte{expectedImageData}, response.Images)
+}
+
+func TestClient_EvalWithCapture_FigureOptions_DefaultFormat(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientmocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	expectedImageData := []byte("jpeg data")
+
+	entries := []embeddedconnector.LiveEditorResponseEntry{
+		{
+			Type:     "execute_result",
+			MimeType: []string{"image/jpeg"},
+			Value:    []json.RawMessage{json.RawMessage(`"` + base64.StdEncoding.EncodeToString(expectedImageData) + `"`)},
+		},
+	}
+	responseBody := buildEvalWithCaptureResponse(t, entries)
+
+	var figureOptions embeddedconnector.FigureOptionsMessage
+	mockHttpClient.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			payload, ok := parseConnectorRequest(req)
+			if !ok || len(payload.Messages.FEval) != 1 || len(payload.Messages.FEval[0].Arguments) != 2 {
+				return false
+			}
+			return json.Unmarshal([]byte(payload.Messages.FEval[0].Arguments[1]), &figureOptions) == nil
+		})).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(responseBody)),
+		}, nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	response, err := client.EvalWithCapture(t.Context(), mockLogger, entities.EvalRequest{
+		Code:    "plot(1:10)",
+		Figures: entities.FigureOptions{DPI: 300},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, embeddedconnector.FigureOptionsMessage{Format: "png", DPI: 300}, figureOptions, "The format should default to png")
+	assert.Equal(t, [][]byte{expectedImageData}, response.Images)
+}
@@ -64,11 +64,38 @@ type LiveEditorResponseEntry struct {
 		Text string `json:"text"`
 		Name string `json:"name"`
 	} `json:"content"`
+	IsError bool `json:"isError"`
+}
+
+// FigureOptionsMessage is the JSON argument that asks matlab_mcp.mcpEval to export the figures itself.
+type FigureOptionsMessage struct {
+	Format string `json:"format"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	DPI    int    `json:"dpi"`
+}
+
+func newFigureOptionsArgument(options entities.FigureOptions) string {
+	format := options.Format
+	if format == "" {
+		format = entities.FigureFormatPNG
+	}
+
+	// Marshalling a struct of strings and integers cannot fail.
+	argument, _ := json.Marshal(FigureOptionsMessage{ //nolint:errchkjson // See above
+		Format: string(format),
+		Width:  options.Width,
+		Height: options.Height,
+		DPI:    options.DPI,
+	})
+
+	return string(argument)
 }
 
 type responseProcessor struct {
 	consoleOutput        []string
 	images               [][]byte
+	errors               []string
 	pendingStreamName    string
 	pendingStreamContent string
 }
@@ -100,7 +127,7 @@ func (p *responseProcessor) processExecuteResult(entry LiveEditorResponseEntry)
 				return err
 			}
 			p.consoleOutput = append(p.consoleOutput, value)
-		case "image/png":
+		case "image/png", "image/jpeg", "image/svg+xml":
 			var value []byte
 			err := json.Unmarshal(entry.Value[i], &value)
 			if err != nil {
@@ -113,6 +140,10 @@ func (p *responseProcessor) processExecuteResult(entry LiveEditorResponseEntry)
 }
 
 func (p *responseProcessor) processStream(entry LiveEditorResponseEntry) {
+	if entry.IsError {
+		p.errors = append(p.errors, entry.Content.Text)
+	}
+
 	// If we have a different stream name, flush the previous one
 	if p.pendingStreamName != entry.Content.Name {
 		p.flushPendingStream()
@@ -163,5 +194,6 @@ func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.Eva
 	return entities.EvalResponse{
 		ConsoleOutput: strings.Join(processor.consoleOutput, "\n"),
 		Images:        processor.images,
+		Errors:        processor.errors,
 	}, nil
 }
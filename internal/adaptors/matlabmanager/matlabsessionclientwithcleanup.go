@@ -15,6 +15,7 @@ type matlabSessionClientWithoutCleanup struct {
 type matlabSessionClientWithCleanup struct {
 	entities.MATLABSessionClient
 	sessionCleanup func() error
+	exited         <-chan struct{}
 }
 
 func newMATLABSessionClientWithoutCleanup(matlabSessionClient entities.MATLABSessionClient) *matlabSessionClientWithoutCleanup {
@@ -28,18 +29,33 @@ func (c *matlabSessionClientWithoutCleanup) StopSession(ctx context.Context, ses
 	return nil
 }
 
-func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error) *matlabSessionClientWithCleanup {
+// Exited returns nil, as the server does not own the process of an externally managed MATLAB session.
+func (c *matlabSessionClientWithoutCleanup) Exited() <-chan struct{} {
+	return nil
+}
+
+func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error, exited <-chan struct{}) *matlabSessionClientWithCleanup {
 	return &matlabSessionClientWithCleanup{
 		MATLABSessionClient: matlabSessionClient,
 		sessionCleanup:      sessionCleanup,
+		exited:              exited,
 	}
 }
 
 func (c *matlabSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
-	_, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"})
-	if err != nil {
-		return err
+	select {
+	case <-c.exited:
+		sessionLogger.Debug("MATLAB process already exited, skipping exit request")
+	default:
+		_, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"})
+		if err != nil {
+			return err
+		}
 	}
 
 	return c.sessionCleanup()
 }
+
+func (c *matlabSessionClientWithCleanup) Exited() <-chan struct{} {
+	return c.exited
+}
@@ -53,7 +53,7 @@ func TestNewMATLABSessionClientWithCleanup_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	result := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup)
+	result := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup, nil)
 
 	// Assert
 	require.NotNil(t, result)
@@ -81,7 +81,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_HappyPath(t *testing.T) {
 		Return(entities.EvalResponse{}, nil).
 		Once()
 
-	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup)
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup, nil)
 
 	// Act
 	err := client.StopSession(ctx, mockLogger)
@@ -113,7 +113,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_EvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup)
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup, nil)
 
 	// Act
 	err := client.StopSession(ctx, mockLogger)
@@ -143,7 +143,7 @@ func TestMATLABSessionClientWithCleanup_StopSession_CleanupError(t *testing.T) {
 		Return(entities.EvalResponse{}, nil).
 		Once()
 
-	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup)
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup, nil)
 
 	// Act
 	err := client.StopSession(ctx, mockLogger)
@@ -151,3 +151,45 @@ func TestMATLABSessionClientWithCleanup_StopSession_CleanupError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedCleanupError)
 }
+
+func TestMATLABSessionClientWithCleanup_StopSession_ProcessAlreadyExited(t *testing.T) {
+	// Arrange
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	cleanupCalled := false
+	cleanup := func() error {
+		cleanupCalled = true
+		return nil
+	}
+
+	processExited := make(chan struct{})
+	close(processExited)
+
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockClient, cleanup, processExited)
+
+	// Act
+	err := client.StopSession(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cleanupCalled, "Cleanup should be called even when the process already exited")
+	_, hasDebugLog := mockLogger.DebugLogs()["MATLAB process already exited, skipping exit request"]
+	assert.True(t, hasDebugLog, "should log that the exit request was skipped")
+}
+
+func TestMATLABSessionClientWithoutCleanup_Exited_Nil(t *testing.T) {
+	// Arrange
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	client := matlabmanager.NewMATLABSessionClientWithoutCleanup(mockClient)
+
+	// Act
+	exited := client.Exited()
+
+	// Assert
+	assert.Nil(t, exited)
+}
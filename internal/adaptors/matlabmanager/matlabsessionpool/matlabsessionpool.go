@@ -0,0 +1,219 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionpool
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type MATLABSessionLauncher interface {
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error)
+}
+
+type pooledSession struct {
+	connectionDetails embeddedconnector.ConnectionDetails
+	cleanup           func() error
+	exited            <-chan struct{}
+}
+
+// poolKey identifies which requests a pre-started session can serve.
+// Sessions for a specific starting folder are never pooled.
+type poolKey struct {
+	matlabRoot        string
+	showMATLABDesktop bool
+}
+
+// Pool keeps MATLAB sessions started in advance, so that starting a session can return immediately.
+type Pool struct {
+	configFactory ConfigFactory
+	launcher      MATLABSessionLauncher
+
+	l        *sync.Mutex
+	starting *sync.WaitGroup
+	idle     map[poolKey][]pooledSession
+	pending  map[poolKey]int
+	closed   bool
+}
+
+func New(
+	configFactory ConfigFactory,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	launcher MATLABSessionLauncher,
+) *Pool {
+	pool := &Pool{
+		configFactory: configFactory,
+		launcher:      launcher,
+
+		l:        new(sync.Mutex),
+		starting: new(sync.WaitGroup),
+		idle:     map[poolKey][]pooledSession{},
+		pending:  map[poolKey]int{},
+	}
+
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		logger, err := loggerFactory.GetGlobalLogger()
+		if err != nil {
+			return err
+		}
+
+		pool.l.Lock()
+		pool.closed = true
+		idle := pool.idle
+		pool.idle = map[poolKey][]pooledSession{}
+		pool.l.Unlock()
+
+		for _, sessions := range idle {
+			for _, session := range sessions {
+				if err := session.cleanup(); err != nil {
+					logger.WithError(err).Warn("Failed to clean up pre-started MATLAB session")
+				}
+			}
+		}
+
+		// Sessions still starting clean themselves up once started, as the pool is closed
+		pool.starting.Wait()
+
+		return nil
+	})
+
+	return pool
+}
+
+// Take returns a pre-started session that can serve request, if there is one.
+// The caller owns the returned cleanup function.
+// Sessions whose MATLAB process exited while waiting in the pool are cleaned up and skipped.
+func (p *Pool) Take(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, bool) {
+	if request.IsStartingDirectorySet {
+		return embeddedconnector.ConnectionDetails{}, nil, nil, false
+	}
+
+	key := keyFor(request)
+
+	for {
+		session, ok := p.takeIdleSession(key)
+		if !ok {
+			return embeddedconnector.ConnectionDetails{}, nil, nil, false
+		}
+
+		select {
+		case <-session.exited:
+			logger.Warn("Discarding pre-started MATLAB session whose process exited")
+			if err := session.cleanup(); err != nil {
+				logger.WithError(err).Warn("Failed to clean up pre-started MATLAB session")
+			}
+			continue
+		default:
+		}
+
+		logger.Debug("Took a pre-started MATLAB session from the pool")
+
+		return session.connectionDetails, session.cleanup, session.exited, true
+	}
+}
+
+func (p *Pool) takeIdleSession(key poolKey) (pooledSession, bool) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	sessions := p.idle[key]
+	if len(sessions) == 0 {
+		return pooledSession{}, false
+	}
+
+	p.idle[key] = sessions[1:]
+
+	return sessions[0], true
+}
+
+// Fill starts, in the background, enough sessions for request to reach the configured pool size.
+func (p *Pool) Fill(logger entities.Logger, request datatypes.LocalSessionDetails) {
+	if request.IsStartingDirectorySet {
+		return
+	}
+
+	cfg, messagesErr := p.configFactory.Config()
+	if messagesErr != nil {
+		logger.WithError(messagesErr).Warn("Failed to get configuration for the MATLAB session pool")
+		return
+	}
+
+	key := keyFor(request)
+
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	missing := cfg.MATLABSessionPoolSize() - len(p.idle[key]) - p.pending[key]
+	if missing <= 0 {
+		return
+	}
+
+	logger.With("count", missing).Info("Starting MATLAB sessions in advance")
+
+	p.pending[key] += missing
+	for range missing {
+		p.starting.Add(1)
+		go p.startSession(logger, key, request)
+	}
+}
+
+func (p *Pool) startSession(logger entities.Logger, key poolKey, request datatypes.LocalSessionDetails) {
+	defer p.starting.Done()
+
+	// Pooled sessions outlive the request that triggered them
+	connectionDetails, cleanup, exited, err := p.launcher.StartLocalMATLABSession(context.Background(), logger, request)
+
+	p.l.Lock()
+	p.pending[key]--
+	closed := p.closed
+	if err == nil && !closed {
+		p.idle[key] = append(p.idle[key], pooledSession{
+			connectionDetails: connectionDetails,
+			cleanup:           cleanup,
+			exited:            exited,
+		})
+	}
+	p.l.Unlock()
+
+	if err != nil {
+		logger.WithError(err).Warn("Failed to start MATLAB session in advance")
+		return
+	}
+
+	if closed {
+		if cleanupErr := cleanup(); cleanupErr != nil {
+			logger.WithError(cleanupErr).Warn("Failed to clean up pre-started MATLAB session")
+		}
+	}
+}
+
+func keyFor(request datatypes.LocalSessionDetails) poolKey {
+	return poolKey{
+		matlabRoot:        filepath.Clean(request.MATLABRoot),
+		showMATLABDesktop: request.ShowMATLABDesktop,
+	}
+}
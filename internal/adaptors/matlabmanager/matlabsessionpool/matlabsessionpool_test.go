@@ -0,0 +1,362 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionpool_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	// Act
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+
+	// Assert
+	assert.NotNil(t, pool)
+}
+
+func TestPool_Take_EmptyPool(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+
+	// Act
+	connectionDetails, cleanup, exited, ok := pool.Take(mockLogger, datatypes.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab"),
+	})
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, cleanup)
+	assert.Nil(t, exited)
+	assert.Empty(t, connectionDetails)
+}
+
+func TestPool_FillThenTake_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab"),
+	}
+	expectedConnectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "9999",
+	}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(1).
+		Once()
+
+	mockLauncher.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(expectedConnectionDetails, func() error { return nil }, nil, nil).
+		Once()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+
+	// Act
+	pool.Fill(mockLogger, request)
+
+	// Assert
+	var connectionDetails embeddedconnector.ConnectionDetails
+	var cleanup func() error
+	require.Eventually(t, func() bool {
+		var ok bool
+		connectionDetails, cleanup, _, ok = pool.Take(mockLogger, request)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, expectedConnectionDetails, connectionDetails)
+	assert.NotNil(t, cleanup)
+
+	_, _, _, ok := pool.Take(mockLogger, request)
+	assert.False(t, ok, "A pre-started session should only be handed out once")
+}
+
+func TestPool_Take_DiscardsExitedSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab"),
+	}
+	processExited := make(chan struct{})
+	close(processExited)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(1).
+		Once()
+
+	cleanupCalls := make(chan struct{}, 1)
+	mockLauncher.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(embeddedconnector.ConnectionDetails{}, func() error {
+			cleanupCalls <- struct{}{}
+			return nil
+		}, processExited, nil).
+		Once()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+	pool.Fill(mockLogger, request)
+
+	// Act
+	require.Eventually(t, func() bool {
+		_, _, _, ok := pool.Take(mockLogger, request)
+		require.False(t, ok)
+		return len(cleanupCalls) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Assert
+	assert.NotEmpty(t, mockLogger.WarnLogs())
+}
+
+func TestPool_Fill_StartingDirectorySet(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+
+	// Act
+	pool.Fill(mockLogger, datatypes.LocalSessionDetails{
+		MATLABRoot:             filepath.Join("path", "to", "matlab"),
+		IsStartingDirectorySet: true,
+		StartingDirectory:      filepath.Join("path", "to", "project"),
+	})
+
+	// Assert
+	mockLauncher.AssertNotCalled(t, "StartLocalMATLABSession")
+}
+
+func TestPool_Fill_PoolDisabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(0).
+		Once()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+
+	// Act
+	pool.Fill(mockLogger, datatypes.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab"),
+	})
+
+	// Assert
+	mockLauncher.AssertNotCalled(t, "StartLocalMATLABSession")
+}
+
+func TestNew_ShutdownFunctionCleansUpIdleSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLauncher := &mocks.MockMATLABSessionLauncher{}
+	defer mockLauncher.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	request := datatypes.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab"),
+	}
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(2).
+		Once()
+
+	cleanupCalls := make(chan struct{}, 2)
+	mockLauncher.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(embeddedconnector.ConnectionDetails{}, func() error {
+			cleanupCalls <- struct{}{}
+			return nil
+		}, nil, nil).
+		Twice()
+
+	pool := matlabsessionpool.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler, mockLauncher)
+	require.NotNil(t, capturedShutdownFunc)
+
+	pool.Fill(mockLogger, request)
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, cleanupCalls, 2)
+
+	_, _, _, ok := pool.Take(mockLogger, request)
+	assert.False(t, ok)
+}
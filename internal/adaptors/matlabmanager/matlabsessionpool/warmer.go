@@ -0,0 +1,57 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionpool
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type MATLABRootSelector interface {
+	SelectMATLABRoot(ctx context.Context, logger entities.Logger) (string, error)
+}
+
+type MATLABManager interface {
+	PrestartMATLABSessions(ctx context.Context, sessionLogger entities.Logger, request entities.LocalSessionDetails) error
+}
+
+// Warmer fills the pool for the default MATLAB installation, before the first session is requested.
+type Warmer struct {
+	configFactory      ConfigFactory
+	matlabRootSelector MATLABRootSelector
+	matlabManager      MATLABManager
+}
+
+func NewWarmer(
+	configFactory ConfigFactory,
+	matlabRootSelector MATLABRootSelector,
+	matlabManager MATLABManager,
+) *Warmer {
+	return &Warmer{
+		configFactory:      configFactory,
+		matlabRootSelector: matlabRootSelector,
+		matlabManager:      matlabManager,
+	}
+}
+
+func (w *Warmer) Warm(ctx context.Context, logger entities.Logger) error {
+	cfg, messagesErr := w.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	if cfg.MATLABSessionPoolSize() == 0 {
+		return nil
+	}
+
+	matlabRoot, err := w.matlabRootSelector.SelectMATLABRoot(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	return w.matlabManager.PrestartMATLABSessions(ctx, logger, entities.LocalSessionDetails{
+		MATLABRoot:        matlabRoot,
+		ShowMATLABDesktop: cfg.ShouldShowMATLABDesktop(),
+	})
+}
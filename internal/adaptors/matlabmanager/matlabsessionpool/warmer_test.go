@@ -0,0 +1,180 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionpool_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmer_Warm_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab")
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(2).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true).
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		PrestartMATLABSessions(ctx, mockLogger.AsMockArg(), entities.LocalSessionDetails{
+			MATLABRoot:        expectedMATLABRoot,
+			ShowMATLABDesktop: true,
+		}).
+		Return(nil).
+		Once()
+
+	warmer := matlabsessionpool.NewWarmer(mockConfigFactory, mockMATLABRootSelector, mockMATLABManager)
+
+	// Act
+	err := warmer.Warm(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestWarmer_Warm_PoolDisabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(0).
+		Once()
+
+	warmer := matlabsessionpool.NewWarmer(mockConfigFactory, mockMATLABRootSelector, mockMATLABManager)
+
+	// Act
+	err := warmer.Warm(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestWarmer_Warm_ConfigError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	warmer := matlabsessionpool.NewWarmer(mockConfigFactory, mockMATLABRootSelector, mockMATLABManager)
+
+	// Act
+	err := warmer.Warm(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestWarmer_Warm_SelectMATLABRootError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(1).
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return("", expectedError).
+		Once()
+
+	warmer := matlabsessionpool.NewWarmer(mockConfigFactory, mockMATLABRootSelector, mockMATLABManager)
+
+	// Act
+	err := warmer.Warm(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -3,45 +3,97 @@
 package matlabsessionstore
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionqueue"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"golang.org/x/sync/errgroup"
 )
 
+var (
+	ErrSessionStoppedWhileIdle = errors.New("MATLAB session was stopped because it was idle")
+	ErrSessionBusy             = errors.New("MATLAB session is busy with tool calls")
+)
+
+const maxIdleCheckInterval = time.Minute
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
 type LoggerFactory interface {
 	GetGlobalLogger() (entities.Logger, messages.Error)
+	CloseSessionLogFile(sessionID entities.SessionID) error
 }
 
 type MATLABSessionClientWithCleanup interface {
 	entities.MATLABSessionClient
 	StopSession(ctx context.Context, sessionLogger entities.Logger) error
+	// Exited is closed when the MATLAB process of the session exits, or nil when the server does not own the process.
+	Exited() <-chan struct{}
 }
 
 type LifecycleSignaler interface {
 	AddShutdownFunction(shutdownFcn func() error)
 }
 
+type storedSession struct {
+	client MATLABSessionClientWithCleanup
+	// queuedClient is the client that Get returns, which waits in the queue of the session.
+	queuedClient MATLABSessionClientWithCleanup
+	// backgroundClient is the client that GetBackground returns, which never waits in the queue of the session.
+	backgroundClient MATLABSessionClientWithCleanup
+	queue            *sessionqueue.Queue
+	lastUsed         time.Time
+	inUse            int
+}
+
 type Store struct {
-	l       *sync.RWMutex
-	next    entities.SessionID
-	clients map[entities.SessionID]MATLABSessionClientWithCleanup
+	configFactory ConfigFactory
+	loggerFactory LoggerFactory
+
+	l                *sync.Mutex
+	next             entities.SessionID
+	sessions         map[entities.SessionID]*storedSession
+	stoppedWhileIdle map[entities.SessionID]time.Duration
+
+	configureOnce   *sync.Once
+	idleTimeout     time.Duration
+	stopIdleMonitor chan struct{}
+
+	queueMaxDepth    int
+	queueWaitTimeout time.Duration
 }
 
 func New(
+	configFactory ConfigFactory,
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 ) *Store {
 	store := &Store{
-		l:       new(sync.RWMutex),
-		next:    1,
-		clients: map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		configFactory: configFactory,
+		loggerFactory: loggerFactory,
+
+		l:                new(sync.Mutex),
+		next:             1,
+		sessions:         map[entities.SessionID]*storedSession{},
+		stoppedWhileIdle: map[entities.SessionID]time.Duration{},
+
+		configureOnce:   new(sync.Once),
+		stopIdleMonitor: make(chan struct{}),
 	}
 
 	lifecycleSignaler.AddShutdownFunction(func() error {
+		close(store.stopIdleMonitor)
+
 		store.l.Lock()
 		defer store.l.Unlock()
 
@@ -52,9 +104,12 @@ func New(
 
 		wg := new(errgroup.Group)
 
-		for sessionID, client := range store.clients {
+		for sessionID, session := range store.sessions {
 			wg.Go(func() error {
-				err := client.StopSession(context.Background(), logger)
+				sessionLogger := logger.With(entities.MATLABSessionIDLogKey, sessionID)
+				defer store.closeSessionLogFile(sessionLogger, sessionID)
+
+				err := session.client.StopSession(context.Background(), sessionLogger)
 				if err != nil {
 					return fmt.Errorf("error stopping session %v: %w", sessionID, err)
 				}
@@ -69,30 +124,306 @@ func New(
 }
 
 func (s *Store) Add(client MATLABSessionClientWithCleanup) entities.SessionID {
+	s.configureOnce.Do(s.configure)
+
 	s.l.Lock()
 	defer s.l.Unlock()
 
 	sessionID := s.next
-	s.clients[sessionID] = client
+	session := &storedSession{
+		client:   client,
+		queue:    sessionqueue.New(s.queueMaxDepth, s.queueWaitTimeout),
+		lastUsed: time.Now(),
+	}
+	session.queuedClient = s.newQueuedClient(session)
+	session.backgroundClient = &backgroundClient{
+		MATLABSessionClientWithCleanup: client,
+		queue:                          session.queue,
+	}
+	s.sessions[sessionID] = session
 	s.next++
 	return entities.SessionID(sessionID)
 }
 
+// Get returns the client of a session.
+// The client waits in the queue of the session before each execution, so that tool calls use the session one at a time.
+// When an idle timeout is configured, the client records its activity, so that a session running code is never stopped.
 func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanup, error) {
-	s.l.RLock()
-	defer s.l.RUnlock()
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if idleTimeout, stopped := s.stoppedWhileIdle[sessionID]; stopped {
+		return nil, fmt.Errorf("%w: session %v did not run any code for %v", ErrSessionStoppedWhileIdle, sessionID, idleTimeout)
+	}
 
-	client, exists := s.clients[sessionID]
+	session, exists := s.sessions[sessionID]
 	if !exists {
 		return nil, fmt.Errorf("session not found: %v", sessionID)
 	}
 
-	return client, nil
+	if s.idleTimeout != 0 {
+		session.lastUsed = time.Now()
+	}
+
+	return session.queuedClient, nil
 }
 
-func (s *Store) Remove(sessionID entities.SessionID) {
+// GetBackground returns a client of a session for background work, such as completions, that must not get in the way of tool calls.
+// The client never waits in the queue of the session: it fails with ErrSessionBusy while a tool call uses or waits for the session.
+// It does not record its activity either, so that background work never keeps an idle session running.
+func (s *Store) GetBackground(sessionID entities.SessionID) (MATLABSessionClientWithCleanup, error) {
 	s.l.Lock()
 	defer s.l.Unlock()
 
-	delete(s.clients, sessionID)
+	session, exists := s.sessions[sessionID]
+	if !exists {
+		return nil, fmt.Errorf("session not found: %v", sessionID)
+	}
+
+	if session.queue.Status().Busy {
+		return nil, ErrSessionBusy
+	}
+
+	return session.backgroundClient, nil
+}
+
+// Statuses returns the queue status of each session, ordered by session ID.
+func (s *Store) Statuses() []entities.MATLABSessionStatus {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	statuses := make([]entities.MATLABSessionStatus, 0, len(s.sessions))
+	for sessionID, session := range s.sessions {
+		queueStatus := session.queue.Status()
+		statuses = append(statuses, entities.MATLABSessionStatus{
+			SessionID:   sessionID,
+			Busy:        queueStatus.Busy,
+			ToolName:    queueStatus.ToolName,
+			RunningFor:  queueStatus.RunningFor,
+			QueueLength: queueStatus.QueueLength,
+		})
+	}
+
+	slices.SortFunc(statuses, func(a, b entities.MATLABSessionStatus) int {
+		return cmp.Compare(a.SessionID, b.SessionID)
+	})
+
+	return statuses
+}
+
+// Remove forgets a session, including a session that was stopped while idle, and closes its log file.
+func (s *Store) Remove(sessionID entities.SessionID) {
+	s.l.Lock()
+	delete(s.sessions, sessionID)
+	delete(s.stoppedWhileIdle, sessionID)
+	s.l.Unlock()
+
+	logger, messagesErr := s.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return
+	}
+
+	s.closeSessionLogFile(logger.With(entities.MATLABSessionIDLogKey, sessionID), sessionID)
+}
+
+// closeSessionLogFile closes the log file of a session that ended. A failure only affects logging, so it is not returned.
+func (s *Store) closeSessionLogFile(sessionLogger entities.Logger, sessionID entities.SessionID) {
+	if err := s.loggerFactory.CloseSessionLogFile(sessionID); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to close the log file of the MATLAB session")
+	}
+}
+
+// configure reads the queue limits for new sessions, and starts the idle monitor when an idle timeout is configured.
+func (s *Store) configure() {
+	cfg, messagesErr := s.configFactory.Config()
+	if messagesErr != nil {
+		return
+	}
+
+	s.l.Lock()
+	s.queueMaxDepth = cfg.MATLABQueueMaxDepth()
+	s.queueWaitTimeout = cfg.MATLABQueueWaitTimeout()
+	s.l.Unlock()
+
+	idleTimeout := cfg.MATLABIdleTimeout()
+	if idleTimeout <= 0 {
+		return
+	}
+
+	logger, messagesErr := s.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return
+	}
+
+	s.l.Lock()
+	s.idleTimeout = idleTimeout
+	s.l.Unlock()
+
+	go s.monitorIdleSessions(logger, idleTimeout)
+}
+
+func (s *Store) monitorIdleSessions(logger entities.Logger, idleTimeout time.Duration) {
+	ticker := time.NewTicker(min(max(idleTimeout/2, time.Millisecond), maxIdleCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopIdleMonitor:
+			return
+		case now := <-ticker.C:
+			s.stopIdleSessions(logger, now)
+		}
+	}
+}
+
+func (s *Store) stopIdleSessions(logger entities.Logger, now time.Time) {
+	idleClients := map[entities.SessionID]MATLABSessionClientWithCleanup{}
+
+	s.l.Lock()
+	for sessionID, session := range s.sessions {
+		if session.inUse == 0 && now.Sub(session.lastUsed) >= s.idleTimeout {
+			idleClients[sessionID] = session.client
+			delete(s.sessions, sessionID)
+			s.stoppedWhileIdle[sessionID] = s.idleTimeout
+		}
+	}
+	s.l.Unlock()
+
+	for sessionID, client := range idleClients {
+		sessionLogger := logger.With(entities.MATLABSessionIDLogKey, sessionID)
+		sessionLogger.Info("Stopping idle MATLAB session")
+
+		if err := client.StopSession(context.Background(), sessionLogger); err != nil {
+			sessionLogger.WithError(err).Warn("Failed to stop idle MATLAB session")
+		}
+
+		s.closeSessionLogFile(sessionLogger, sessionID)
+	}
+}
+
+// newQueuedClient wraps the client of a session, so that it waits in the queue of the session and, when an idle timeout is configured, records its activity.
+// The caller must hold s.l.
+func (s *Store) newQueuedClient(session *storedSession) MATLABSessionClientWithCleanup {
+	client := session.client
+
+	if s.idleTimeout != 0 {
+		client = &activityTrackingClient{
+			MATLABSessionClientWithCleanup: client,
+			store:                          s,
+			session:                        session,
+		}
+	}
+
+	return &queuedClient{
+		MATLABSessionClientWithCleanup: client,
+		queue:                          session.queue,
+	}
+}
+
+// beginUse marks session as running code, and returns the function that marks the end of it.
+func (s *Store) beginUse(session *storedSession) func() {
+	s.l.Lock()
+	session.inUse++
+	s.l.Unlock()
+
+	return func() {
+		s.l.Lock()
+		session.inUse--
+		session.lastUsed = time.Now()
+		s.l.Unlock()
+	}
+}
+
+type activityTrackingClient struct {
+	MATLABSessionClientWithCleanup
+	store   *Store
+	session *storedSession
+}
+
+func (c *activityTrackingClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	defer c.store.beginUse(c.session)()
+	return c.MATLABSessionClientWithCleanup.Eval(ctx, sessionLogger, request)
+}
+
+func (c *activityTrackingClient) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	defer c.store.beginUse(c.session)()
+	return c.MATLABSessionClientWithCleanup.EvalWithCapture(ctx, logger, input)
+}
+
+func (c *activityTrackingClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	defer c.store.beginUse(c.session)()
+	return c.MATLABSessionClientWithCleanup.FEval(ctx, sessionLogger, request)
+}
+
+// queuedClient waits in the queue of its session before each execution.
+// Pings do not wait, so that checking that a session is alive never waits for a busy session.
+type queuedClient struct {
+	MATLABSessionClientWithCleanup
+	queue *sessionqueue.Queue
+}
+
+func (c *queuedClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	release, err := c.queue.Enter(ctx)
+	if err != nil {
+		return entities.EvalResponse{}, err
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.Eval(ctx, sessionLogger, request)
+}
+
+func (c *queuedClient) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	release, err := c.queue.Enter(ctx)
+	if err != nil {
+		return entities.EvalResponse{}, err
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.EvalWithCapture(ctx, logger, input)
+}
+
+func (c *queuedClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	release, err := c.queue.Enter(ctx)
+	if err != nil {
+		return entities.FEvalResponse{}, err
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.FEval(ctx, sessionLogger, request)
+}
+
+// backgroundClient runs each execution only when the queue of its session is free, and holds the queue while it runs.
+type backgroundClient struct {
+	MATLABSessionClientWithCleanup
+	queue *sessionqueue.Queue
+}
+
+func (c *backgroundClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	release, ok := c.queue.TryAcquire("")
+	if !ok {
+		return entities.EvalResponse{}, ErrSessionBusy
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.Eval(ctx, sessionLogger, request)
+}
+
+func (c *backgroundClient) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
+	release, ok := c.queue.TryAcquire("")
+	if !ok {
+		return entities.EvalResponse{}, ErrSessionBusy
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.EvalWithCapture(ctx, logger, input)
+}
+
+func (c *backgroundClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	release, ok := c.queue.TryAcquire("")
+	if !ok {
+		return entities.FEvalResponse{}, ErrSessionBusy
+	}
+	defer release()
+
+	return c.MATLABSessionClientWithCleanup.FEval(ctx, sessionLogger, request)
 }
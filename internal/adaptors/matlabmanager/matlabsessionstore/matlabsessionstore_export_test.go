@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstore
+
+// UnwrapClient returns the client that was added to the store, from a client that Get or GetBackground returned.
+func UnwrapClient(client MATLABSessionClientWithCleanup) MATLABSessionClientWithCleanup {
+	for {
+		switch wrapped := client.(type) {
+		case *queuedClient:
+			client = wrapped.MATLABSessionClientWithCleanup
+		case *activityTrackingClient:
+			client = wrapped.MATLABSessionClientWithCleanup
+		case *backgroundClient:
+			client = wrapped.MATLABSessionClientWithCleanup
+		default:
+			return client
+		}
+	}
+}
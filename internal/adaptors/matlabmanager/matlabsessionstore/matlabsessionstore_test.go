@@ -3,12 +3,18 @@
 package matlabsessionstore_test
 
 import (
+	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionqueue"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +23,9 @@ import (
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -32,7 +41,7 @@ func TestNew_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Assert
 	assert.NotNil(t, store)
@@ -40,9 +49,15 @@ func TestNew_HappyPath(t *testing.T) {
 
 func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -69,6 +84,11 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 		Return(mockLogger, nil).
 		Once()
 
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(mock.AnythingOfType("entities.SessionID")).
+		Return(nil).
+		Twice()
+
 	mockClient1.EXPECT().
 		StopSession(mock.AnythingOfType("context.backgroundCtx"), mockLogger.AsMockArg()).
 		Return(nil).
@@ -79,7 +99,27 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 		Return(nil).
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
 	store.Add(mockClient1)
@@ -94,6 +134,9 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 
 func TestNew_GetGlobalLoggerError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -116,7 +159,7 @@ func TestNew_GetGlobalLoggerError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
 	// Act
@@ -128,6 +171,9 @@ func TestNew_GetGlobalLoggerError(t *testing.T) {
 
 func TestNew_ShutdownFunctionHandlesEmptyStore(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -151,7 +197,7 @@ func TestNew_ShutdownFunctionHandlesEmptyStore(t *testing.T) {
 		Return(mockLogger, nil).
 		Once()
 
-	matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
 	// Act
@@ -163,9 +209,15 @@ func TestNew_ShutdownFunctionHandlesEmptyStore(t *testing.T) {
 
 func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -193,6 +245,11 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 		Return(mockLogger, nil).
 		Once()
 
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(mock.AnythingOfType("entities.SessionID")).
+		Return(nil).
+		Twice()
+
 	mockClient1.EXPECT().
 		StopSession(mock.AnythingOfType("context.backgroundCtx"), mockLogger.AsMockArg()).
 		Return(nil).
@@ -203,7 +260,27 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
 	store.Add(mockClient1)
@@ -218,9 +295,15 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 
 func TestStore_Add_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -232,7 +315,27 @@ func TestStore_Add_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
 	sessionID := store.Add(mockClient)
@@ -243,9 +346,15 @@ func TestStore_Add_HappyPath(t *testing.T) {
 
 func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -263,7 +372,27 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
 	sessionID1 := store.Add(mockClient1)
@@ -278,9 +407,15 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 
 func TestStore_Get_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -292,7 +427,27 @@ func TestStore_Get_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	sessionID := store.Add(mockClient)
 
 	// Act
@@ -300,11 +455,14 @@ func TestStore_Get_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, mockClient, retrievedClient)
+	assert.Equal(t, mockClient, matlabsessionstore.UnwrapClient(retrievedClient))
 }
 
 func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -316,7 +474,7 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	nonExistentSessionID := entities.SessionID(999)
 
 	// Act
@@ -329,11 +487,300 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "999")
 }
 
+func TestStore_GetBackground_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	request := entities.FEvalRequest{Function: "which"}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	var statusesDuringCall []entities.MATLABSessionStatus
+	mockClient.EXPECT().
+		FEval(t.Context(), mockLogger.AsMockArg(), request).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.FEvalRequest) {
+			statusesDuringCall = store.Statuses()
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	// Act
+	client, err := store.GetBackground(sessionID)
+	require.NoError(t, err)
+
+	_, err = client.FEval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockClient, matlabsessionstore.UnwrapClient(client))
+	require.Len(t, statusesDuringCall, 1)
+	assert.True(t, statusesDuringCall[0].Busy, "The background call should hold the queue while it runs")
+	assert.False(t, store.Statuses()[0].Busy)
+}
+
+func TestStore_GetBackground_BusySession(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	toolCallRequest := entities.EvalRequest{Code: "pause(1)"}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), toolCallRequest).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			close(running)
+			<-finish
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	backgroundClient, err := store.GetBackground(sessionID)
+	require.NoError(t, err)
+
+	toolCallClient, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	toolCallDone := make(chan error, 1)
+	go func() {
+		_, evalErr := toolCallClient.Eval(t.Context(), mockLogger, toolCallRequest)
+		toolCallDone <- evalErr
+	}()
+	<-running
+
+	// Act
+	_, getErr := store.GetBackground(sessionID)
+	_, fevalErr := backgroundClient.FEval(t.Context(), mockLogger, entities.FEvalRequest{Function: "which"})
+
+	// Assert
+	require.ErrorIs(t, getErr, matlabsessionstore.ErrSessionBusy)
+	require.ErrorIs(t, fevalErr, matlabsessionstore.ErrSessionBusy, "The background client should not wait for the tool call")
+
+	close(finish)
+	require.NoError(t, <-toolCallDone)
+}
+
+func TestStore_GetBackground_DoesNotKeepIdleSessionRunning(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	// The background calls log to another logger than the idle monitor, which logs concurrently
+	mockCallLogger := testutils.NewInspectableLogger()
+	idleTimeout := 20 * time.Millisecond
+	request := entities.FEvalRequest{Function: "which"}
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Twice()
+
+	mockClient.EXPECT().
+		FEval(t.Context(), mockCallLogger.AsMockArg(), request).
+		Return(entities.FEvalResponse{}, nil)
+
+	stopped := make(chan struct{})
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).
+		Run(func(_ context.Context, _ entities.Logger) {
+			close(stopped)
+		}).
+		Return(nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(mock.AnythingOfType("entities.SessionID")).
+		Return(nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	// Act
+	deadline := time.After(time.Second)
+	for running := true; running; {
+		select {
+		case <-stopped:
+			running = false
+		case <-deadline:
+			require.Fail(t, "Background calls kept the idle session running")
+		case <-time.After(idleTimeout / 4):
+			if client, err := store.GetBackground(sessionID); err == nil {
+				_, _ = client.FEval(t.Context(), mockCallLogger, request)
+			}
+		}
+	}
+
+	// Assert
+	_, err := store.Get(sessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionStoppedWhileIdle)
+
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestStore_GetBackground_NonExistentSession_ReturnsError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	client, err := store.GetBackground(entities.SessionID(999))
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, client)
+	assert.Contains(t, err.Error(), "session not found")
+}
+
 func TestStore_Remove_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -345,13 +792,43 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	sessionID := store.Add(mockClient)
 
 	// Verify client exists before removal
 	retrievedClient, err := store.Get(sessionID)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient, retrievedClient)
+	assert.Equal(t, mockClient, matlabsessionstore.UnwrapClient(retrievedClient))
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger(), nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(sessionID).
+		Return(nil).
+		Once()
 
 	// Act
 	store.Remove(sessionID)
@@ -365,6 +842,9 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 
 func TestStore_Remove_NonExistentSession_NoError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
@@ -376,18 +856,34 @@ func TestStore_Remove_NonExistentSession_NoError(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 	nonExistentSessionID := entities.SessionID(999)
 
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger(), nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(nonExistentSessionID).
+		Return(nil).
+		Once()
+
 	// Act & Assert (should not panic or error)
 	store.Remove(nonExistentSessionID)
 }
 
 func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -405,7 +901,27 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act - Add multiple clients
 	sessionID1 := store.Add(mockClient1)
@@ -415,15 +931,25 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	// Assert - All clients can be retrieved
 	retrievedClient1, err := store.Get(sessionID1)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient1, retrievedClient1)
+	assert.Equal(t, mockClient1, matlabsessionstore.UnwrapClient(retrievedClient1))
 
 	retrievedClient2, err := store.Get(sessionID2)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient2, retrievedClient2)
+	assert.Equal(t, mockClient2, matlabsessionstore.UnwrapClient(retrievedClient2))
 
 	retrievedClient3, err := store.Get(sessionID3)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient3, retrievedClient3)
+	assert.Equal(t, mockClient3, matlabsessionstore.UnwrapClient(retrievedClient3))
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger(), nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(sessionID2).
+		Return(nil).
+		Once()
 
 	// Act - Remove middle client
 	store.Remove(sessionID2)
@@ -431,7 +957,7 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	// Assert - Client 2 is gone, but 1 and 3 remain
 	retrievedClient1, err = store.Get(sessionID1)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient1, retrievedClient1)
+	assert.Equal(t, mockClient1, matlabsessionstore.UnwrapClient(retrievedClient1))
 
 	retrievedClient2, err = store.Get(sessionID2)
 	require.Error(t, err)
@@ -439,5 +965,451 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 
 	retrievedClient3, err = store.Get(sessionID3)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient3, retrievedClient3)
+	assert.Equal(t, mockClient3, matlabsessionstore.UnwrapClient(retrievedClient3))
+}
+
+func TestStore_IdleTimeout_StopsIdleSession(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(10 * time.Millisecond).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Times(3)
+
+	stopped := make(chan struct{})
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).
+		Run(func(_ context.Context, _ entities.Logger) {
+			close(stopped)
+		}).
+		Return(nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(mock.AnythingOfType("entities.SessionID")).
+		Return(nil).
+		Twice()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	// Act
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		require.Fail(t, "Idle session was not stopped")
+	}
+
+	// Assert
+	_, err := store.Get(sessionID)
+	require.ErrorIs(t, err, matlabsessionstore.ErrSessionStoppedWhileIdle)
+	assert.NotEmpty(t, mockLogger.InfoLogs())
+
+	store.Remove(sessionID)
+	_, err = store.Get(sessionID)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, matlabsessionstore.ErrSessionStoppedWhileIdle)
+
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestStore_IdleTimeout_DoesNotStopSessionRunningCode(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	idleTimeout := 10 * time.Millisecond
+	request := entities.EvalRequest{Code: "pause(1)"}
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Twice()
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), request).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			time.Sleep(10 * idleTimeout)
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Stopped once, either by the idle monitor or at shutdown
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		CloseSessionLogFile(mock.AnythingOfType("entities.SessionID")).
+		Return(nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	// Act
+	_, err = client.Eval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+
+	_, err = store.Get(sessionID)
+	require.NoError(t, err, "A session running code should not be stopped")
+
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestStore_Get_StepsOfToolCallsDoNotInterleave(t *testing.T) {
+	// Arrange
+	const calls = 5
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	var executedLock sync.Mutex
+	var executed []string
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), mock.AnythingOfType("entities.EvalRequest")).
+		Run(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) {
+			executedLock.Lock()
+			executed = append(executed, request.Code)
+			executedLock.Unlock()
+
+			time.Sleep(time.Millisecond)
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Times(2 * calls)
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	var wg sync.WaitGroup
+
+	// Act
+	for i := range calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, endCall := entities.WithToolCall(t.Context(), "evaluate_matlab_code", nil)
+			defer endCall()
+
+			client, err := store.Get(sessionID)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			folder := fmt.Sprintf("cd('folder%d')", i)
+			_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: folder})
+			assert.NoError(t, err)
+
+			_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: fmt.Sprintf("disp(%d)", i)})
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	require.Len(t, executed, 2*calls)
+	for step := 0; step < len(executed); step += 2 {
+		var call int
+		_, err := fmt.Sscanf(executed[step], "cd('folder%d')", &call)
+		require.NoError(t, err, "Calls must change the folder first: %v", executed)
+		assert.Equal(t, fmt.Sprintf("disp(%d)", call), executed[step+1], "Another call ran between the steps of a call: %v", executed)
+	}
+}
+
+func TestStore_Get_QueueFull(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	request := entities.FEvalRequest{Function: "pwd", NumOutputs: 1}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(1).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Minute).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient)
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	holderCtx, endHolderCall := entities.WithToolCall(t.Context(), "run_matlab_file", nil)
+	defer endHolderCall()
+
+	_, err = client.FEval(holderCtx, mockLogger, request)
+	require.NoError(t, err)
+
+	waiterCtx, cancelWaiter := context.WithCancel(t.Context())
+	waiting := make(chan error, 1)
+	go func() {
+		_, waiterErr := client.FEval(waiterCtx, mockLogger, request)
+		waiting <- waiterErr
+	}()
+
+	require.Eventually(t, func() bool {
+		return store.Statuses()[0].QueueLength == 1
+	}, 5*time.Second, time.Millisecond)
+
+	// Act
+	_, err = client.FEval(t.Context(), mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, sessionqueue.ErrQueueFull)
+
+	cancelWaiter()
+	require.ErrorIs(t, <-waiting, context.Canceled)
+}
+
+func TestStore_Statuses_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	request := entities.EvalRequest{Code: "x = 1;"}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueMaxDepth().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABQueueWaitTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABIdleTimeout().
+		Return(time.Duration(0)).
+		Once()
+
+	mockClient2.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), request).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfigFactory, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID1 := store.Add(mockClient1)
+	sessionID2 := store.Add(mockClient2)
+
+	client2, err := store.Get(sessionID2)
+	require.NoError(t, err)
+
+	ctx, endCall := entities.WithToolCall(t.Context(), "evaluate_matlab_code", nil)
+	defer endCall()
+
+	_, err = client2.Eval(ctx, mockLogger, request)
+	require.NoError(t, err)
+
+	// Act
+	statuses := store.Statuses()
+
+	// Assert
+	require.Len(t, statuses, 2)
+	assert.Equal(t, entities.MATLABSessionStatus{SessionID: sessionID1}, statuses[0])
+	assert.Equal(t, sessionID2, statuses[1].SessionID)
+	assert.True(t, statuses[1].Busy)
+	assert.Equal(t, "evaluate_matlab_code", statuses[1].ToolName)
+	assert.Equal(t, 0, statuses[1].QueueLength)
 }
@@ -0,0 +1,22 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// PrestartMATLABSessions starts, in the background, the sessions of the pool for startRequest.
+func (m *MATLABManager) PrestartMATLABSessions(_ context.Context, sessionLogger entities.Logger, startRequest entities.LocalSessionDetails) error {
+	localSessionLogger := sessionLogger.With("matlab-root", startRequest.MATLABRoot)
+
+	localSessionRequest, err := m.localSessionRequest(localSessionLogger, startRequest)
+	if err != nil {
+		return err
+	}
+
+	m.sessionPool.Fill(localSessionLogger, localSessionRequest)
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_PrestartMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	launchSettings := launchsettings.Settings{
+		StartupFlags: []string{"-singleCompThread"},
+	}
+
+	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
+		MATLABRoot:        expectedMATLABRoot,
+		ShowMATLABDesktop: true,
+		StartupFlags:      launchSettings.StartupFlags,
+	}
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchSettings, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot:        expectedMATLABRoot,
+		ShowMATLABDesktop: true,
+	}
+
+	// Act
+	err := manager.PrestartMATLABSessions(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMATLABManager_PrestartMATLABSessions_LaunchSettingsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchsettings.Settings{}, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	err := manager.PrestartMATLABSessions(t.Context(), mockLogger, entities.LocalSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionqueue
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// Enter waits until the context may use the session of the queue, and returns the function to call after using it.
+// When the context belongs to a tool call, the call keeps the queue until it ends, and the returned function does nothing.
+func (q *Queue) Enter(ctx context.Context) (func(), error) {
+	call, ok := entities.ToolCallFromContext(ctx)
+	if !ok {
+		return q.Acquire(ctx, "", nil)
+	}
+
+	return call.Hold(q, func() (func(), error) {
+		return q.Acquire(ctx, call.Name(), call.OnQueuePosition())
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionqueue_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionqueue"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue_Enter_WithoutCall_ReleasesAfterEachStep(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	// Act
+	release, err := queue.Enter(t.Context())
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, queue.Status().Busy)
+
+	release()
+	assert.False(t, queue.Status().Busy)
+}
+
+func TestQueue_Enter_WithCall_HoldsQueueUntilCallEnds(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	ctx, endCall := entities.WithToolCall(t.Context(), "evaluate_matlab_code", nil)
+
+	// Act
+	firstStep, err := queue.Enter(ctx)
+	require.NoError(t, err)
+	firstStep()
+
+	secondStep, err := queue.Enter(ctx)
+	require.NoError(t, err)
+	secondStep()
+
+	// Assert
+	status := queue.Status()
+	assert.True(t, status.Busy, "The call must hold the queue between its steps")
+	assert.Equal(t, "evaluate_matlab_code", status.ToolName)
+
+	endCall()
+	assert.False(t, queue.Status().Busy)
+}
+
+func TestQueue_Enter_StepsOfCallsDoNotInterleave(t *testing.T) {
+	// Arrange
+	const (
+		calls         = 10
+		stepsEachCall = 5
+	)
+
+	queue := sessionqueue.New(0, 0)
+
+	var stepsLock sync.Mutex
+	var steps []int
+
+	var wg sync.WaitGroup
+
+	// Act
+	for i := range calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, endCall := entities.WithToolCall(t.Context(), "tool", nil)
+			defer endCall()
+
+			for range stepsEachCall {
+				release, err := queue.Enter(ctx)
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				stepsLock.Lock()
+				steps = append(steps, i)
+				stepsLock.Unlock()
+
+				release()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	require.Len(t, steps, calls*stepsEachCall)
+	for start := 0; start < len(steps); start += stepsEachCall {
+		for _, call := range steps[start : start+stepsEachCall] {
+			assert.Equal(t, steps[start], call, "Steps of different calls interleaved: %v", steps)
+		}
+	}
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_Enter_WithCall_ReportsPositions(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+
+	positions := make(chan int, 1)
+	ctx, endCall := entities.WithToolCall(t.Context(), "waiter", func(position int) {
+		positions <- position
+	})
+	defer endCall()
+
+	entered := make(chan error, 1)
+
+	// Act
+	go func() {
+		_, enterErr := queue.Enter(ctx)
+		entered <- enterErr
+	}()
+
+	// Assert
+	assert.Equal(t, 1, <-positions)
+
+	release()
+	require.NoError(t, <-entered)
+	assert.Equal(t, "waiter", queue.Status().ToolName)
+}
+
+func TestQueue_Enter_AfterCallEnded_ReleasesAfterEachStep(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	ctx, endCall := entities.WithToolCall(t.Context(), "tool", nil)
+	endCall()
+
+	// Act
+	release, err := queue.Enter(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, queue.Status().Busy)
+
+	release()
+	assert.False(t, queue.Status().Busy)
+}
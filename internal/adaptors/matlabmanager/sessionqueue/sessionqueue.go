@@ -0,0 +1,217 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionqueue
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrQueueFull   = errors.New("MATLAB session is busy and too many tool calls are waiting for it")
+	ErrWaitTimeout = errors.New("timed out waiting for other tool calls on the MATLAB session to finish")
+)
+
+// Status describes the tool call that a queue is running and the calls that wait for it.
+type Status struct {
+	Busy bool
+	// ToolName and RunningFor describe the running call, when the queue is busy.
+	ToolName    string
+	RunningFor  time.Duration
+	QueueLength int
+}
+
+// Queue lets one holder use a MATLAB session at a time, and serves waiting holders in the order they arrived.
+type Queue struct {
+	maxDepth    int
+	waitTimeout time.Duration
+
+	l            *sync.Mutex
+	busy         bool
+	runningTool  string
+	runningSince time.Time
+	waiting      *list.List
+}
+
+type waiter struct {
+	toolName string
+	// ready is closed when the waiter becomes the holder of the queue.
+	ready chan struct{}
+	// moved receives a value when the position of the waiter in the queue changes.
+	moved   chan struct{}
+	element *list.Element
+}
+
+// New creates a queue that holds at most maxDepth waiting calls, each for at most waitTimeout.
+// A maxDepth or waitTimeout of zero removes the limit.
+func New(maxDepth int, waitTimeout time.Duration) *Queue {
+	return &Queue{
+		maxDepth:    maxDepth,
+		waitTimeout: waitTimeout,
+
+		l:       new(sync.Mutex),
+		waiting: list.New(),
+	}
+}
+
+// Acquire waits until the caller holds the queue, and returns the function that releases it.
+// While the caller waits, onPosition receives its position in the queue each time the position changes, starting at 1 for the next holder.
+func (q *Queue) Acquire(ctx context.Context, toolName string, onPosition func(position int)) (func(), error) {
+	q.l.Lock()
+	if !q.busy && q.waiting.Len() == 0 {
+		q.hold(toolName)
+		q.l.Unlock()
+		return q.releaseOnce(), nil
+	}
+
+	if q.maxDepth > 0 && q.waiting.Len() >= q.maxDepth {
+		q.l.Unlock()
+		return nil, ErrQueueFull
+	}
+
+	w := &waiter{
+		toolName: toolName,
+		ready:    make(chan struct{}),
+		moved:    make(chan struct{}, 1),
+	}
+	w.element = q.waiting.PushBack(w)
+	position := q.waiting.Len()
+	q.l.Unlock()
+
+	var timeout <-chan time.Time
+	if q.waitTimeout > 0 {
+		timer := time.NewTimer(q.waitTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	reportedPosition := 0
+	for {
+		if onPosition != nil && position > 0 && position != reportedPosition {
+			onPosition(position)
+			reportedPosition = position
+		}
+
+		select {
+		case <-w.ready:
+			return q.releaseOnce(), nil
+		case <-w.moved:
+			q.l.Lock()
+			position = q.positionOf(w)
+			q.l.Unlock()
+		case <-ctx.Done():
+			q.leave(w)
+			return nil, ctx.Err()
+		case <-timeout:
+			q.leave(w)
+			return nil, ErrWaitTimeout
+		}
+	}
+}
+
+// TryAcquire holds the queue if no one holds it or waits for it, and returns the function that releases it.
+// Unlike Acquire, it never waits, and ok is false when the queue is busy.
+func (q *Queue) TryAcquire(toolName string) (func(), bool) {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	if q.busy || q.waiting.Len() > 0 {
+		return nil, false
+	}
+
+	q.hold(toolName)
+	return q.releaseOnce(), true
+}
+
+// Status returns the state of the queue.
+func (q *Queue) Status() Status {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	status := Status{
+		Busy:        q.busy,
+		QueueLength: q.waiting.Len(),
+	}
+
+	if q.busy {
+		status.ToolName = q.runningTool
+		status.RunningFor = time.Since(q.runningSince)
+	}
+
+	return status
+}
+
+// hold makes the caller the holder of the queue. The caller must hold q.l.
+func (q *Queue) hold(toolName string) {
+	q.busy = true
+	q.runningTool = toolName
+	q.runningSince = time.Now()
+}
+
+func (q *Queue) releaseOnce() func() {
+	once := new(sync.Once)
+	return func() {
+		once.Do(q.release)
+	}
+}
+
+// release hands the queue to the first waiter, if any.
+func (q *Queue) release() {
+	q.l.Lock()
+	defer q.l.Unlock()
+
+	front := q.waiting.Front()
+	if front == nil {
+		q.busy = false
+		q.runningTool = ""
+		return
+	}
+
+	next := q.waiting.Remove(front).(*waiter) //nolint:forcetypeassert // The list only holds waiters
+	next.element = nil
+	q.hold(next.toolName)
+	close(next.ready)
+
+	q.notifyWaiters()
+}
+
+// leave removes a waiter that stopped waiting.
+// If the waiter became the holder in the meantime, it releases the queue instead.
+func (q *Queue) leave(w *waiter) {
+	q.l.Lock()
+	if w.element == nil {
+		q.l.Unlock()
+		q.release()
+		return
+	}
+
+	q.waiting.Remove(w.element)
+	w.element = nil
+	q.notifyWaiters()
+	q.l.Unlock()
+}
+
+// notifyWaiters tells all waiters that their position changed. The caller must hold q.l.
+func (q *Queue) notifyWaiters() {
+	for e := q.waiting.Front(); e != nil; e = e.Next() {
+		select {
+		case e.Value.(*waiter).moved <- struct{}{}: //nolint:forcetypeassert // The list only holds waiters
+		default:
+		}
+	}
+}
+
+// positionOf returns the position of a waiter, or 0 when it is no longer waiting. The caller must hold q.l.
+func (q *Queue) positionOf(w *waiter) int {
+	position := 1
+	for e := q.waiting.Front(); e != nil; e = e.Next() {
+		if e.Value == w {
+			return position
+		}
+		position++
+	}
+	return 0
+}
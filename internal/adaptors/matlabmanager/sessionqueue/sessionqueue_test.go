@@ -0,0 +1,343 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sessionqueue_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionqueue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForQueueLength waits until the given number of calls wait for the queue.
+func waitForQueueLength(t *testing.T, queue *sessionqueue.Queue, length int) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return queue.Status().QueueLength == length
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	queue := sessionqueue.New(0, 0)
+
+	// Assert
+	require.NotNil(t, queue)
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_Acquire_IdleQueue(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	// Act
+	release, err := queue.Acquire(t.Context(), "evaluate_matlab_code", nil)
+
+	// Assert
+	require.NoError(t, err)
+
+	status := queue.Status()
+	assert.True(t, status.Busy)
+	assert.Equal(t, "evaluate_matlab_code", status.ToolName)
+	assert.GreaterOrEqual(t, status.RunningFor, time.Duration(0))
+	assert.Equal(t, 0, status.QueueLength)
+
+	release()
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_TryAcquire_IdleQueue(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	// Act
+	release, ok := queue.TryAcquire("completion")
+
+	// Assert
+	require.True(t, ok)
+	assert.True(t, queue.Status().Busy)
+
+	release()
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_TryAcquire_BusyQueue(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	release, err := queue.Acquire(t.Context(), "evaluate_matlab_code", nil)
+	require.NoError(t, err)
+	defer release()
+
+	// Act
+	tryRelease, ok := queue.TryAcquire("completion")
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, tryRelease)
+	assert.Equal(t, "evaluate_matlab_code", queue.Status().ToolName)
+}
+
+func TestQueue_Acquire_ReleaseIsIdempotent(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	first, err := queue.Acquire(t.Context(), "first", nil)
+	require.NoError(t, err)
+
+	first()
+
+	second, err := queue.Acquire(t.Context(), "second", nil)
+	require.NoError(t, err)
+
+	// Act
+	first()
+
+	// Assert
+	status := queue.Status()
+	assert.True(t, status.Busy, "Releasing twice must not release the next holder")
+	assert.Equal(t, "second", status.ToolName)
+
+	second()
+}
+
+func TestQueue_Acquire_ServesWaitersInOrder(t *testing.T) {
+	// Arrange
+	const waiters = 5
+
+	queue := sessionqueue.New(0, 0)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+
+	var orderLock sync.Mutex
+	var order []int
+
+	var wg sync.WaitGroup
+	for i := range waiters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			waiterRelease, waiterErr := queue.Acquire(t.Context(), "waiter", nil)
+			if !assert.NoError(t, waiterErr) {
+				return
+			}
+
+			orderLock.Lock()
+			order = append(order, i)
+			orderLock.Unlock()
+
+			waiterRelease()
+		}()
+
+		// Queue the waiters one after the other, so that their order is known
+		waitForQueueLength(t, queue, i+1)
+	}
+
+	// Act
+	release()
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, order)
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_Acquire_OneHolderAtATime(t *testing.T) {
+	// Arrange
+	const callers = 20
+
+	queue := sessionqueue.New(0, 0)
+
+	var holdersLock sync.Mutex
+	holders := 0
+	maxHolders := 0
+
+	var wg sync.WaitGroup
+
+	// Act
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			release, err := queue.Acquire(t.Context(), "tool", nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer release()
+
+			holdersLock.Lock()
+			holders++
+			maxHolders = max(maxHolders, holders)
+			holdersLock.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			holdersLock.Lock()
+			holders--
+			holdersLock.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, 1, maxHolders)
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
+
+func TestQueue_Acquire_ReportsPositions(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+
+	firstRelease := make(chan func(), 1)
+	go func() {
+		waiterRelease, waiterErr := queue.Acquire(t.Context(), "first", nil)
+		assert.NoError(t, waiterErr)
+		firstRelease <- waiterRelease
+	}()
+	waitForQueueLength(t, queue, 1)
+
+	positions := make(chan int, 2)
+	done := make(chan struct{})
+
+	// Act
+	go func() {
+		defer close(done)
+
+		waiterRelease, waiterErr := queue.Acquire(t.Context(), "second", func(position int) {
+			positions <- position
+		})
+		if assert.NoError(t, waiterErr) {
+			waiterRelease()
+		}
+	}()
+
+	// Assert
+	assert.Equal(t, 2, <-positions)
+
+	release()
+	assert.Equal(t, 1, <-positions, "The waiter must move up when the holder releases the queue")
+
+	(<-firstRelease)()
+	<-done
+}
+
+func TestQueue_Acquire_QueueFull(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(1, 0)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	waiting := make(chan error, 1)
+	go func() {
+		_, waiterErr := queue.Acquire(ctx, "waiter", nil)
+		waiting <- waiterErr
+	}()
+	waitForQueueLength(t, queue, 1)
+
+	// Act
+	_, err = queue.Acquire(t.Context(), "rejected", nil)
+
+	// Assert
+	require.ErrorIs(t, err, sessionqueue.ErrQueueFull)
+	assert.Equal(t, 1, queue.Status().QueueLength)
+
+	cancel()
+	require.ErrorIs(t, <-waiting, context.Canceled)
+}
+
+func TestQueue_Acquire_WaitTimeout(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 10*time.Millisecond)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+	defer release()
+
+	// Act
+	_, err = queue.Acquire(t.Context(), "waiter", nil)
+
+	// Assert
+	require.ErrorIs(t, err, sessionqueue.ErrWaitTimeout)
+
+	status := queue.Status()
+	assert.Equal(t, 0, status.QueueLength, "A call that timed out must leave the queue")
+	assert.Equal(t, "holder", status.ToolName)
+}
+
+func TestQueue_Acquire_ContextCancelledWhileWaiting(t *testing.T) {
+	// Arrange
+	queue := sessionqueue.New(0, 0)
+
+	release, err := queue.Acquire(t.Context(), "holder", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	waiting := make(chan error, 1)
+	go func() {
+		_, waiterErr := queue.Acquire(ctx, "cancelled", nil)
+		waiting <- waiterErr
+	}()
+	waitForQueueLength(t, queue, 1)
+
+	// Act
+	cancel()
+
+	// Assert
+	require.ErrorIs(t, <-waiting, context.Canceled)
+	assert.Equal(t, 0, queue.Status().QueueLength)
+
+	release()
+	assert.Equal(t, sessionqueue.Status{}, queue.Status(), "The cancelled call must not hold the queue")
+}
+
+func TestQueue_Acquire_ConcurrentCancellationsAndReleases(t *testing.T) {
+	// Arrange
+	const callers = 50
+
+	queue := sessionqueue.New(0, 0)
+
+	var wg sync.WaitGroup
+
+	// Act
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(t.Context(), time.Duration(i%5)*time.Millisecond)
+			defer cancel()
+
+			release, err := queue.Acquire(ctx, "tool", func(int) {})
+			if err != nil {
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+				return
+			}
+
+			time.Sleep(time.Millisecond)
+			release()
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, sessionqueue.Status{}, queue.Status())
+}
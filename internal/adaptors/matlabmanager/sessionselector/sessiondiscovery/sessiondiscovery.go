@@ -86,15 +86,39 @@ func (d *SessionDiscoverer) FromSessionDetails(logger entities.Logger, sessionDe
 	}, nil
 }
 
-func (d *SessionDiscoverer) DiscoverSessions(logger entities.Logger) []embeddedconnector.ConnectionDetails {
+// PIDFromSessionDetails returns the process ID of the MATLAB that shared its session.
+func (d *SessionDiscoverer) PIDFromSessionDetails(sessionDetails []byte) (int, error) {
+	var details sessionDetailsJSON
+	if err := json.Unmarshal(sessionDetails, &details); err != nil {
+		return 0, err
+	}
+
+	pid, err := strconv.Atoi(details.PID.String())
+	if err != nil || pid < 1 {
+		return 0, ErrInvalidSessionDetails
+	}
+
+	return pid, nil
+}
+
+// SessionDetailsFile returns the path of the file in which a shared MATLAB session writes its details.
+func (d *SessionDiscoverer) SessionDetailsFile() (string, error) {
 	appDataDir, err := d.appDataDirGetter.AppDataDir()
+	if err != nil {
+		return "", err
+	}
+
+	// Hardcoding v1 for now, if we end up having multiple version, we'll need version based handlers
+	return filepath.Join(appDataDir, "v1", sessionDetailsFileName), nil
+}
+
+func (d *SessionDiscoverer) DiscoverSessions(logger entities.Logger) []embeddedconnector.ConnectionDetails {
+	sessionFilePath, err := d.SessionDetailsFile()
 	if err != nil {
 		logger.WithError(err).Debug("Failed to determine app data directory for session discovery")
 		return nil
 	}
 
-	// Hardcoding v1 for now, if we end up having multiple version, we'll need version based handlers
-	sessionFilePath := filepath.Join(appDataDir, "v1", sessionDetailsFileName)
 	data, err := d.osLayer.ReadFile(sessionFilePath)
 	if err != nil {
 		logger.WithError(err).Debug("No shared MATLAB session file found")
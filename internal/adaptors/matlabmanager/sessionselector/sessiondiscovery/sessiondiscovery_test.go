@@ -311,6 +311,115 @@ func TestSessionDiscoverer_FromSessionDetails_EmptyCertificatePEM(t *testing.T)
 	assert.Empty(t, result.Host)
 }
 
+func TestSessionDiscoverer_PIDFromSessionDetails_HappyPath(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionJSON := marshallSessionDetails(t, map[string]any{
+		"port":        31515,
+		"certificate": "cert.pem",
+		"apiKey":      "test-api-key",
+		"pid":         12345,
+	})
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+
+	// Act
+	pid, err := discoverer.PIDFromSessionDetails(sessionJSON)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 12345, pid)
+}
+
+func TestSessionDiscoverer_PIDFromSessionDetails_InvalidPID(t *testing.T) {
+	testCases := []struct {
+		name string
+		pid  any
+	}{
+		{name: "missing", pid: nil},
+		{name: "not a number", pid: "abc"},
+		{name: "zero", pid: 0},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+			defer mockAppDataDirGetter.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			rawData := map[string]any{"port": 31515}
+			if testCase.pid != nil {
+				rawData["pid"] = testCase.pid
+			}
+
+			discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+
+			// Act
+			pid, err := discoverer.PIDFromSessionDetails(marshallSessionDetails(t, rawData))
+
+			// Assert
+			require.Error(t, err)
+			assert.Zero(t, pid)
+		})
+	}
+}
+
+func TestSessionDiscoverer_SessionDetailsFile_HappyPath(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedAppDataDir := filepath.Join("home", "user", "MATLABMCPServer")
+
+	mockAppDataDirGetter.EXPECT().
+		AppDataDir().
+		Return(expectedAppDataDir, nil).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+
+	// Act
+	sessionFile, err := discoverer.SessionDetailsFile()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(expectedAppDataDir, "v1", "sessionDetails.json"), sessionFile)
+}
+
+func TestSessionDiscoverer_SessionDetailsFile_AppDataDirError(t *testing.T) {
+	// Arrange
+	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
+	defer mockAppDataDirGetter.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockAppDataDirGetter.EXPECT().
+		AppDataDir().
+		Return("", assert.AnError).
+		Once()
+
+	discoverer := sessiondiscovery.New(mockAppDataDirGetter, mockOSLayer)
+
+	// Act
+	sessionFile, err := discoverer.SessionDetailsFile()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, sessionFile)
+}
+
 func TestSessionDiscoverer_DiscoverSessions_HappyPath(t *testing.T) {
 	// Arrange
 	mockAppDataDirGetter := &mocks.MockAppDataDirGetter{}
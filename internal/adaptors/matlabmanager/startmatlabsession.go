@@ -8,30 +8,25 @@ import (
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
-	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
 )
 
 var ErrMATLABSessionNotAlive = errors.New("session is not alive")
 
 func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	var zeroValue entities.SessionID
-	var client matlabsessionstore.MATLABSessionClientWithCleanup
 
 	switch request := startRequest.(type) {
 	case entities.LocalSessionDetails:
 		localSessionLogger := sessionLogger.With("matlab-root", request.MATLABRoot)
+		localSessionRequest, err := m.localSessionRequest(localSessionLogger, request)
+		if err != nil {
+			return zeroValue, err
+		}
 		// For now, we return embedded connector details, to decouple the session start logic from the client creation.
-		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(
-			ctx,
-			localSessionLogger,
-			datatypes.LocalSessionDetails{
-				MATLABRoot:             request.MATLABRoot,
-				IsStartingDirectorySet: request.IsStartingDirectorySet,
-				StartingDirectory:      request.StartingDirectory,
-				ShowMATLABDesktop:      request.ShowMATLABDesktop,
-			},
-		)
+		embeddedConnectorEndpoint, sessionCleanup, processExited, err := m.startLocalMATLABSession(ctx, localSessionLogger, localSessionRequest)
 		if err != nil {
 			return zeroValue, err
 		}
@@ -42,7 +37,11 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 			}
 			return zeroValue, err
 		}
-		client = newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup)
+		sessionID := m.sessionStore.Add(newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup, processExited))
+		localSessionLogger = localSessionLogger.With(entities.MATLABSessionIDLogKey, sessionID)
+		localSessionLogger.Info("Started MATLAB session")
+		m.openMATLABProject(ctx, localSessionLogger, sessionID)
+		return sessionID, nil
 	case entities.AttachToExistingSession:
 		sessionLogger.Info("Attaching to existing session")
 
@@ -61,10 +60,75 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 			return zeroValue, ErrMATLABSessionNotAlive
 		}
 
-		client = newMATLABSessionClientWithoutCleanup(embeddedConnectorClient)
+		sessionID := m.sessionStore.Add(newMATLABSessionClientWithoutCleanup(embeddedConnectorClient))
+		sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID).Info("Attached to existing MATLAB session")
+		return sessionID, nil
 	default:
 		return zeroValue, fmt.Errorf("unknown request type: %T", request)
 	}
+}
+
+// startLocalMATLABSession uses a pre-started session when the pool has one,
+// and then refills the pool in the background.
+func (m *MATLABManager) startLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error) {
+	defer m.sessionPool.Fill(logger, request)
+
+	if connectionDetails, sessionCleanup, processExited, ok := m.sessionPool.Take(logger, request); ok {
+		logger.Info("Using a pre-started MATLAB session")
+		return connectionDetails, sessionCleanup, processExited, nil
+	}
+
+	return m.matlabServices.StartLocalMATLABSession(ctx, logger, request)
+}
+
+func (m *MATLABManager) localSessionRequest(logger entities.Logger, request entities.LocalSessionDetails) (datatypes.LocalSessionDetails, error) {
+	launchSettings, err := m.launchSettingsProvider.Settings(logger)
+	if err != nil {
+		return datatypes.LocalSessionDetails{}, err
+	}
+
+	return datatypes.LocalSessionDetails{
+		MATLABRoot:             request.MATLABRoot,
+		IsStartingDirectorySet: request.IsStartingDirectorySet,
+		StartingDirectory:      request.StartingDirectory,
+		ShowMATLABDesktop:      request.ShowMATLABDesktop,
+		StartupFlags:           launchSettings.StartupFlags,
+		EnvironmentVariables:   launchSettings.EnvironmentVariables,
+		StartupScript:          launchSettings.StartupScript,
+		Path:                   launchSettings.Path,
+		MemoryLimit:            launchSettings.MemoryLimit,
+	}, nil
+}
+
+// openMATLABProject opens the MATLAB project found in the MCP roots, when the server is configured to.
+// Only sessions that the server starts open the project, so that attaching does not change an existing MATLAB session.
+// The session is usable without the project, so failures are only logged.
+func (m *MATLABManager) openMATLABProject(ctx context.Context, logger entities.Logger, sessionID entities.SessionID) {
+	config, messagesErr := m.configFactory.Config()
+	if messagesErr != nil {
+		logger.WithError(messagesErr).Warn("failed to get configuration, proceeding without opening a MATLAB project")
+		return
+	}
+
+	if !config.OpenMATLABProject() {
+		return
+	}
+
+	client, err := m.GetMATLABSessionClient(ctx, logger, sessionID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get MATLAB session client, proceeding without opening a MATLAB project")
+		return
+	}
+
+	project, err := m.matlabProjectOpener.Open(ctx, logger, client, "")
+	if errors.Is(err, matlabproject.ErrNoProjectFound) {
+		logger.Debug("no MATLAB project found in the MCP roots")
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Warn("failed to open MATLAB project, proceeding without one")
+		return
+	}
 
-	return m.sessionStore.Add(client), nil
+	logger.With("project", project.RootFolder).Info("opened MATLAB project")
 }
@@ -5,13 +5,18 @@ package matlabmanager_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/matlabmanager/matlabsessionstore"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +42,18 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedSessionID := entities.SessionID(123)
@@ -48,16 +65,27 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 
 	sessionCleanupFunc := func() error { return nil }
 
+	launchSettings := launchsettings.Settings{
+		StartupFlags:         []string{"-singleCompThread"},
+		EnvironmentVariables: []string{"MLM_LICENSE_FILE=27000@licenseserver"},
+		StartupScript:        filepath.Join("path", "to", "startup.m"),
+		Path:                 []string{filepath.Join("path", "to", "lib")},
+	}
+
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
 		IsStartingDirectorySet: false,
+		StartupFlags:           launchSettings.StartupFlags,
+		EnvironmentVariables:   launchSettings.EnvironmentVariables,
+		StartupScript:          launchSettings.StartupScript,
+		Path:                   launchSettings.Path,
 	}
 
 	expectedCtx := t.Context()
 
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		Return(connectionDetails, sessionCleanupFunc, nil, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
@@ -70,7 +98,146 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchSettings, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		OpenMATLABProject().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		IsStartingDirectorySet: false,
+	}
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+
+	logFields, hasInfoLog := mockLogger.InfoLogs()["Started MATLAB session"]
+	require.True(t, hasInfoLog, "should log that the session started")
+	assert.Equal(t, expectedSessionID, logFields[entities.MATLABSessionIDLogKey])
+}
+
+func TestMATLABManager_StartMATLABSession_UsesPooledSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedSessionID := entities.SessionID(123)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	sessionCleanupFunc := func() error { return nil }
+
+	launchSettings := launchsettings.Settings{
+		StartupFlags:         []string{"-singleCompThread"},
+		EnvironmentVariables: []string{"MLM_LICENSE_FILE=27000@licenseserver"},
+		StartupScript:        filepath.Join("path", "to", "startup.m"),
+		Path:                 []string{filepath.Join("path", "to", "lib")},
+	}
+
+	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		IsStartingDirectorySet: false,
+		StartupFlags:           launchSettings.StartupFlags,
+		EnvironmentVariables:   launchSettings.EnvironmentVariables,
+		StartupScript:          launchSettings.StartupScript,
+		Path:                   launchSettings.Path,
+	}
+
+	expectedCtx := t.Context()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID).
+		Once()
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchSettings, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(connectionDetails, sessionCleanupFunc, nil, true).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		OpenMATLABProject().
+		Return(false).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -85,6 +252,230 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedSessionID, sessionID)
 }
 
+func TestMATLABManager_StartMATLABSession_OpensMATLABProject(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	mockStoredSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockStoredSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	connectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "1234"}
+	expectedLocalSessionDetails := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a")}
+	expectedCtx := t.Context()
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchsettings.Settings{}, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(connectionDetails, func() error { return nil }, nil, true).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Twice()
+
+	mockConfig.EXPECT().
+		OpenMATLABProject().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(5 * time.Second).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockStoredSessionClient, nil).
+		Once()
+
+	mockStoredSessionClient.EXPECT().
+		Exited().
+		Return(nil).
+		Once()
+
+	mockStoredSessionClient.EXPECT().
+		Ping(mock.Anything, mockLogger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockMATLABProjectOpener.EXPECT().
+		Open(expectedCtx, mockLogger.AsMockArg(), mockStoredSessionClient, "").
+		Return(matlabproject.Project{Name: "Weather", RootFolder: filepath.Join("home", "user", "weather"), FileCount: 12}, nil).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.LocalSessionDetails{MATLABRoot: expectedLocalSessionDetails.MATLABRoot})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	assert.Contains(t, mockLogger.InfoLogs(), "opened MATLAB project")
+}
+
+func TestMATLABManager_StartMATLABSession_OpenMATLABProjectError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	mockStoredSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockStoredSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	connectionDetails := embeddedconnector.ConnectionDetails{Host: "localhost", Port: "1234"}
+	expectedLocalSessionDetails := datatypes.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a")}
+	expectedCtx := t.Context()
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchsettings.Settings{}, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(connectionDetails, func() error { return nil }, nil, true).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(expectedSessionID).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Twice()
+
+	mockConfig.EXPECT().
+		OpenMATLABProject().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionConnectionTimeout().
+		Return(5 * time.Second).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockStoredSessionClient, nil).
+		Once()
+
+	mockStoredSessionClient.EXPECT().
+		Exited().
+		Return(nil).
+		Once()
+
+	mockStoredSessionClient.EXPECT().
+		Ping(mock.Anything, mockLogger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockMATLABProjectOpener.EXPECT().
+		Open(expectedCtx, mockLogger.AsMockArg(), mockStoredSessionClient, "").
+		Return(matlabproject.Project{}, assert.AnError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.LocalSessionDetails{MATLABRoot: expectedLocalSessionDetails.MATLABRoot})
+
+	// Assert
+	require.NoError(t, err, "the session should start without the project")
+	assert.Equal(t, expectedSessionID, sessionID)
+	assert.Contains(t, mockLogger.WarnLogs(), "failed to open MATLAB project, proceeding without one")
+}
+
 func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -104,9 +495,20 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedError := assert.AnError
 
+	launchSettings := launchsettings.Settings{}
+
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
 		IsStartingDirectorySet: false,
@@ -116,10 +518,25 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
-		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchSettings, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -153,6 +570,15 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	connectionDetails := embeddedconnector.ConnectionDetails{
 		Host: "localhost",
@@ -162,6 +588,8 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	sessionCleanupFunc := func() error { cleanupCalled = true; return nil }
 	expectedError := assert.AnError
 
+	launchSettings := launchsettings.Settings{}
+
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
 		IsStartingDirectorySet: false,
@@ -171,7 +599,7 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(expectedCtx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		Return(connectionDetails, sessionCleanupFunc, nil, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
@@ -179,7 +607,22 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchSettings, nil).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Take(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(embeddedconnector.ConnectionDetails{}, nil, nil, false).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Fill(mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -195,6 +638,55 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	assert.True(t, cleanupCalled, "session cleanup should be called when client factory fails")
 }
 
+func TestMATLABManager_StartMATLABSession_LaunchSettingsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionSelector := &mocks.MockSessionSelector{}
+	defer mockSessionSelector.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockLaunchSettingsProvider.EXPECT().
+		Settings(mockLogger.AsMockArg()).
+		Return(launchsettings.Settings{}, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
+	}
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
 func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -202,6 +694,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -246,7 +747,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -254,6 +755,10 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_HappyPath(t *t
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedSessionID, sessionID)
+
+	logFields, hasInfoLog := mockLogger.InfoLogs()["Attached to existing MATLAB session"]
+	require.True(t, hasInfoLog, "should log that the server attached to the session")
+	assert.Equal(t, expectedSessionID, logFields[entities.MATLABSessionIDLogKey])
 }
 
 func TestMATLABManager_StartMATLABSession_AttachToExistingSession_SessionSelectorError(t *testing.T) {
@@ -263,6 +768,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_SessionSelecto
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -282,7 +796,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_SessionSelecto
 		Return(embeddedconnector.ConnectionDetails{}, assert.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -299,6 +813,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_ClientFactoryE
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -329,7 +852,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_ClientFactoryE
 		Return(nil, assert.AnError).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -346,6 +869,15 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
+	mockLaunchSettingsProvider := &mocks.MockLaunchSettingsProvider{}
+	defer mockLaunchSettingsProvider.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockMATLABProjectOpener := &mocks.MockMATLABProjectOpener{}
+	defer mockMATLABProjectOpener.AssertExpectations(t)
+
 	mockMATLABServices := &mocks.MockMATLABServices{}
 	defer mockMATLABServices.AssertExpectations(t)
 
@@ -384,7 +916,7 @@ func TestMATLABManager_StartMATLABSession_AttachToExistingSession_PingFailure(t
 		Return(entities.PingResponse{IsAlive: false}).
 		Once()
 
-	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector)
+	manager := matlabmanager.New(mockConfigFactory, mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionSelector, mockLaunchSettingsProvider, mockSessionPool, mockMATLABProjectOpener)
 
 	// Act
 	sessionID, err := manager.StartMATLABSession(expectedCtx, mockLogger, entities.AttachToExistingSession{})
@@ -4,17 +4,27 @@ package matlabmanager
 
 import (
 	"context"
+	"errors"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 )
 
 func (m *MATLABManager) StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
 	client, err := m.sessionStore.Get(sessionID)
+	if errors.Is(err, matlabsessionstore.ErrSessionStoppedWhileIdle) {
+		// The idle monitor already stopped MATLAB, only forget the session
+		m.sessionStore.Remove(sessionID)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
 	defer m.sessionStore.Remove(sessionID)
 
-	return client.StopSession(ctx, sessionLogger.With("session-id", sessionID))
+	sessionLogger = sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID)
+	sessionLogger.Info("Stopping MATLAB session")
+
+	return client.StopSession(ctx, sessionLogger)
 }
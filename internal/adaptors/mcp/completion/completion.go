@@ -0,0 +1,174 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package completion
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxValues is the maximum number of values in a completion result, as defined by MCP.
+const maxValues = 100
+
+const (
+	referenceTypePrompt   = "ref/prompt"
+	referenceTypeResource = "ref/resource"
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) (entities.Logger, messages.Error)
+}
+
+// Provider suggests values for an argument, given the value that the user has typed so far.
+type Provider interface {
+	Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error)
+}
+
+// PromptWithCompletions is a prompt that suggests values for some of its arguments.
+type PromptWithCompletions interface {
+	prompts.Prompt
+	CompletionProviders() map[string]basetool.CompletionProvider
+}
+
+// ResourceWithCompletions is a resource template that suggests values for some of the variables of its URI template.
+type ResourceWithCompletions interface {
+	resources.Resource
+	URITemplate() string
+	CompletionProviders() map[string]baseresource.CompletionProvider
+}
+
+// Registry answers completion requests with the completion providers of the prompts and resource templates that are added to it.
+type Registry struct {
+	loggerFactory LoggerFactory
+
+	lock sync.RWMutex
+	// promptProviders holds the completion providers by prompt name, then by argument.
+	promptProviders map[string]map[string]Provider
+	// resourceProviders holds the completion providers by URI template, then by variable.
+	resourceProviders map[string]map[string]Provider
+}
+
+func New(
+	loggerFactory LoggerFactory,
+) *Registry {
+	return &Registry{
+		loggerFactory:     loggerFactory,
+		promptProviders:   map[string]map[string]Provider{},
+		resourceProviders: map[string]map[string]Provider{},
+	}
+}
+
+// AddPrompt registers the completion providers of the prompt, if it has any.
+func (r *Registry) AddPrompt(prompt prompts.Prompt) {
+	promptWithCompletions, ok := prompt.(PromptWithCompletions)
+	if !ok {
+		return
+	}
+
+	providers := promptWithCompletions.CompletionProviders()
+	if len(providers) == 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.promptProviders[prompt.Name()] = toProviders(providers)
+}
+
+// AddResource registers the completion providers of the resource template, if it has any.
+func (r *Registry) AddResource(resource resources.Resource) {
+	resourceWithCompletions, ok := resource.(ResourceWithCompletions)
+	if !ok {
+		return
+	}
+
+	providers := resourceWithCompletions.CompletionProviders()
+	if len(providers) == 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.resourceProviders[resourceWithCompletions.URITemplate()] = toProviders(providers)
+}
+
+// Complete answers a completion request. An unknown reference or argument has no completions, rather than being an error,
+// and a provider that fails is logged and has no completions, so that completions never get in the way of the user.
+func (r *Registry) Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	result := &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{Values: []string{}},
+	}
+
+	if req == nil || req.Params == nil || req.Params.Ref == nil {
+		return result, nil
+	}
+
+	provider := r.provider(req.Params.Ref, req.Params.Argument.Name)
+	if provider == nil {
+		return result, nil
+	}
+
+	logger, messagesErr := r.loggerFactory.NewMCPSessionLogger(req.Session)
+	if messagesErr != nil {
+		return nil, messagesErr
+	}
+
+	logger = logger.
+		With("completion-reference", referenceName(req.Params.Ref)).
+		With("completion-argument", req.Params.Argument.Name)
+
+	values, err := provider.Complete(ctx, logger, req.Params.Argument.Value)
+	if err != nil {
+		logger.WithError(err).Warn("Completion provider returned an error")
+		return result, nil
+	}
+
+	result.Completion.Total = len(values)
+	if len(values) > maxValues {
+		values = values[:maxValues]
+		result.Completion.HasMore = true
+	}
+	result.Completion.Values = append(result.Completion.Values, values...)
+
+	return result, nil
+}
+
+func (r *Registry) provider(ref *mcp.CompleteReference, argument string) Provider {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	switch ref.Type {
+	case referenceTypePrompt:
+		return r.promptProviders[ref.Name][argument]
+	case referenceTypeResource:
+		return r.resourceProviders[ref.URI][argument]
+	default:
+		return nil
+	}
+}
+
+// referenceName returns the prompt name or the URI template that a completion request refers to.
+func referenceName(ref *mcp.CompleteReference) string {
+	if ref.Type == referenceTypePrompt {
+		return ref.Name
+	}
+	return ref.URI
+}
+
+func toProviders[P Provider](providers map[string]P) map[string]Provider {
+	result := make(map[string]Provider, len(providers))
+	for argument, provider := range providers {
+		result[argument] = provider
+	}
+	return result
+}
@@ -0,0 +1,470 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package completion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/completion"
+	promptsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/prompts"
+	resourcesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/baseresource"
+	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testURITemplate = "matlab-help://{name}"
+	testPromptName  = "step_matlab_debugger"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	registry := completion.New(mockLoggerFactory)
+
+	// Assert
+	assert.NotNil(t, registry)
+}
+
+func TestRegistry_Complete_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockResource := &mocks.MockResourceWithCompletions{}
+	defer mockResource.AssertExpectations(t)
+
+	mockProvider := &baseresourcemocks.MockCompletionProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+
+	mockResource.EXPECT().
+		CompletionProviders().
+		Return(map[string]baseresource.CompletionProvider{"name": mockProvider}).
+		Once()
+
+	mockResource.EXPECT().
+		URITemplate().
+		Return(testURITemplate).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "plo").
+		Return([]string{"plot", "plot3"}, nil).
+		Once()
+
+	registry := completion.New(mockLoggerFactory)
+	registry.AddResource(mockResource)
+
+	// Act
+	result, err := registry.Complete(ctx, newCompleteRequest(session, "ref/resource", testURITemplate, "name", "plo"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mcp.CompletionResultDetails{Values: []string{"plot", "plot3"}, Total: 2}, result.Completion)
+}
+
+func TestRegistry_Complete_Prompt_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockPrompt := &mocks.MockPromptWithCompletions{}
+	defer mockPrompt.AssertExpectations(t)
+
+	mockProvider := &basetoolmocks.MockCompletionProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+
+	mockPrompt.EXPECT().
+		CompletionProviders().
+		Return(map[string]basetool.CompletionProvider{"action": mockProvider}).
+		Once()
+
+	mockPrompt.EXPECT().
+		Name().
+		Return(testPromptName).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "step").
+		Return([]string{"step", "step_in", "step_out"}, nil).
+		Once()
+
+	registry := completion.New(mockLoggerFactory)
+	registry.AddPrompt(mockPrompt)
+
+	// Act
+	result, err := registry.Complete(ctx, newPromptCompleteRequest(session, testPromptName, "action", "step"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mcp.CompletionResultDetails{Values: []string{"step", "step_in", "step_out"}, Total: 3}, result.Completion)
+}
+
+func TestRegistry_Complete_Prompt_NoProvider(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promptName string
+		argument   string
+	}{
+		{
+			name:       "unknown prompt",
+			promptName: "run_matlab_file",
+			argument:   "action",
+		},
+		{
+			name:       "unknown argument",
+			promptName: testPromptName,
+			argument:   "unknown_argument",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockPrompt := &mocks.MockPromptWithCompletions{}
+			defer mockPrompt.AssertExpectations(t)
+
+			mockProvider := &basetoolmocks.MockCompletionProvider{}
+			defer mockProvider.AssertExpectations(t)
+
+			mockPrompt.EXPECT().
+				CompletionProviders().
+				Return(map[string]basetool.CompletionProvider{"action": mockProvider}).
+				Once()
+
+			mockPrompt.EXPECT().
+				Name().
+				Return(testPromptName).
+				Once()
+
+			registry := completion.New(mockLoggerFactory)
+			registry.AddPrompt(mockPrompt)
+
+			// Act
+			result, err := registry.Complete(t.Context(), newPromptCompleteRequest(&mcp.ServerSession{}, tc.promptName, tc.argument, ""))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Empty(t, result.Completion.Values)
+			assert.NotNil(t, result.Completion.Values, "Values should be an empty list rather than null")
+		})
+	}
+}
+
+func TestRegistry_AddPrompt_PromptWithoutCompletions(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockPrompt := &promptsmocks.MockPrompt{}
+	defer mockPrompt.AssertExpectations(t)
+
+	registry := completion.New(mockLoggerFactory)
+
+	// Act
+	registry.AddPrompt(mockPrompt)
+	result, err := registry.Complete(t.Context(), newPromptCompleteRequest(&mcp.ServerSession{}, testPromptName, "action", ""))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Completion.Values)
+}
+
+func TestRegistry_Complete_CapsValues(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockResource := &mocks.MockResourceWithCompletions{}
+	defer mockResource.AssertExpectations(t)
+
+	mockProvider := &baseresourcemocks.MockCompletionProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+
+	values := make([]string, 0, 150)
+	for i := range 150 {
+		values = append(values, fmt.Sprintf("value%d", i))
+	}
+
+	mockResource.EXPECT().
+		CompletionProviders().
+		Return(map[string]baseresource.CompletionProvider{"name": mockProvider}).
+		Once()
+
+	mockResource.EXPECT().
+		URITemplate().
+		Return(testURITemplate).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "value").
+		Return(values, nil).
+		Once()
+
+	registry := completion.New(mockLoggerFactory)
+	registry.AddResource(mockResource)
+
+	// Act
+	result, err := registry.Complete(ctx, newCompleteRequest(session, "ref/resource", testURITemplate, "name", "value"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, values[:100], result.Completion.Values)
+	assert.Equal(t, 150, result.Completion.Total)
+	assert.True(t, result.Completion.HasMore)
+}
+
+func TestRegistry_Complete_NoProvider(t *testing.T) {
+	testCases := []struct {
+		name     string
+		refType  string
+		uri      string
+		argument string
+	}{
+		{
+			name:     "unknown resource template",
+			refType:  "ref/resource",
+			uri:      "matlab-doc://{name}",
+			argument: "name",
+		},
+		{
+			name:     "unknown variable",
+			refType:  "ref/resource",
+			uri:      testURITemplate,
+			argument: "unknown_variable",
+		},
+		{
+			name:     "prompt reference with the URI of the resource template",
+			refType:  "ref/prompt",
+			uri:      testURITemplate,
+			argument: "name",
+		},
+		{
+			name:     "unknown reference type",
+			refType:  "ref/tool",
+			uri:      testURITemplate,
+			argument: "name",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockResource := &mocks.MockResourceWithCompletions{}
+			defer mockResource.AssertExpectations(t)
+
+			mockProvider := &baseresourcemocks.MockCompletionProvider{}
+			defer mockProvider.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockResource.EXPECT().
+				CompletionProviders().
+				Return(map[string]baseresource.CompletionProvider{"name": mockProvider}).
+				Once()
+
+			mockResource.EXPECT().
+				URITemplate().
+				Return(testURITemplate).
+				Once()
+
+			registry := completion.New(mockLoggerFactory)
+			registry.AddResource(mockResource)
+
+			// Act
+			result, err := registry.Complete(ctx, newCompleteRequest(&mcp.ServerSession{}, tc.refType, tc.uri, tc.argument, ""))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Empty(t, result.Completion.Values)
+			assert.NotNil(t, result.Completion.Values, "Values should be an empty list rather than null")
+		})
+	}
+}
+
+func TestRegistry_Complete_NilRequest(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	registry := completion.New(mockLoggerFactory)
+
+	// Act
+	result, err := registry.Complete(t.Context(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Completion.Values)
+}
+
+func TestRegistry_AddResource_ResourceWithoutCompletions(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockResource := &resourcesmocks.MockResource{}
+	defer mockResource.AssertExpectations(t)
+
+	registry := completion.New(mockLoggerFactory)
+
+	// Act
+	registry.AddResource(mockResource)
+	result, err := registry.Complete(t.Context(), newCompleteRequest(&mcp.ServerSession{}, "ref/resource", testURITemplate, "name", ""))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Completion.Values)
+}
+
+func TestRegistry_Complete_LoggerFactoryError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockResource := &mocks.MockResourceWithCompletions{}
+	defer mockResource.AssertExpectations(t)
+
+	mockProvider := &baseresourcemocks.MockCompletionProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+	expectedError := messages.AnError
+
+	mockResource.EXPECT().
+		CompletionProviders().
+		Return(map[string]baseresource.CompletionProvider{"name": mockProvider}).
+		Once()
+
+	mockResource.EXPECT().
+		URITemplate().
+		Return(testURITemplate).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(nil, expectedError).
+		Once()
+
+	registry := completion.New(mockLoggerFactory)
+	registry.AddResource(mockResource)
+
+	// Act
+	result, err := registry.Complete(ctx, newCompleteRequest(session, "ref/resource", testURITemplate, "name", ""))
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
+
+func TestRegistry_Complete_ProviderError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockResource := &mocks.MockResourceWithCompletions{}
+	defer mockResource.AssertExpectations(t)
+
+	mockProvider := &baseresourcemocks.MockCompletionProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+
+	mockResource.EXPECT().
+		CompletionProviders().
+		Return(map[string]baseresource.CompletionProvider{"name": mockProvider}).
+		Once()
+
+	mockResource.EXPECT().
+		URITemplate().
+		Return(testURITemplate).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "plo").
+		Return(nil, assert.AnError).
+		Once()
+
+	registry := completion.New(mockLoggerFactory)
+	registry.AddResource(mockResource)
+
+	// Act
+	result, err := registry.Complete(ctx, newCompleteRequest(session, "ref/resource", testURITemplate, "name", "plo"))
+
+	// Assert
+	require.NoError(t, err, "A failing provider should not fail the completion request")
+	assert.Empty(t, result.Completion.Values)
+	assert.Contains(t, mockLogger.WarnLogs(), "Completion provider returned an error")
+}
+
+func newCompleteRequest(session *mcp.ServerSession, refType string, uri string, argument string, value string) *mcp.CompleteRequest {
+	return &mcp.CompleteRequest{
+		Session: session,
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: refType, URI: uri},
+			Argument: mcp.CompleteParamsArgument{Name: argument, Value: value},
+		},
+	}
+}
+
+func newPromptCompleteRequest(session *mcp.ServerSession, name string, argument string, value string) *mcp.CompleteRequest {
+	return &mcp.CompleteRequest{
+		Session: session,
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: name},
+			Argument: mcp.CompleteParamsArgument{Name: argument, Value: value},
+		},
+	}
+}
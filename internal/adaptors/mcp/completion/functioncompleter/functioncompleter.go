@@ -0,0 +1,120 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package functioncompleter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const completeFunction = "matlab_mcp.mcpCompleteFunction"
+
+// cacheTTL is how long the function names for a prefix are reused. Completions are requested on every keystroke,
+// so the cache saves a MATLAB call for each of them, while still picking up functions added to the path.
+const cacheTTL = 30 * time.Second
+
+type GlobalMATLAB interface {
+	BackgroundClient(logger entities.Logger) (entities.MATLABSessionClient, bool)
+}
+
+type cacheEntry struct {
+	names   []string
+	expires time.Time
+}
+
+// FunctionCompleter suggests the names of the functions that the global MATLAB session can call.
+// Completions are requested as the user types, so it only uses MATLAB when it is already running and idle, rather than starting it
+// or waiting behind tool calls.
+type FunctionCompleter struct {
+	globalMATLAB GlobalMATLAB
+
+	lock  sync.Mutex
+	cache map[string]cacheEntry
+}
+
+func New(
+	globalMATLAB GlobalMATLAB,
+) *FunctionCompleter {
+	return &FunctionCompleter{
+		globalMATLAB: globalMATLAB,
+		cache:        map[string]cacheEntry{},
+	}
+}
+
+func (c *FunctionCompleter) Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error) {
+	if names, ok := c.cached(value); ok {
+		return names, nil
+	}
+
+	client, ok := c.globalMATLAB.BackgroundClient(logger)
+	if !ok {
+		return []string{}, nil
+	}
+
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   completeFunction,
+		Arguments:  []string{value},
+		NumOutputs: 1,
+	})
+	if errors.Is(err, matlabsessionstore.ErrSessionBusy) {
+		// A tool call started since the client was returned
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return nil, fmt.Errorf("unexpected number of outputs from %s: %d", completeFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast output of %s to string", completeFunction)
+	}
+
+	names := []string{}
+	if err := json.Unmarshal([]byte(output), &names); err != nil {
+		return nil, fmt.Errorf("failed to parse output of %s: %w", completeFunction, err)
+	}
+
+	c.store(value, names)
+
+	return names, nil
+}
+
+func (c *FunctionCompleter) cached(prefix string) ([]string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.cache[prefix]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+
+	return entry.names, true
+}
+
+func (c *FunctionCompleter) store(prefix string, names []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for cachedPrefix, entry := range c.cache {
+		if now.After(entry.expires) {
+			delete(c.cache, cachedPrefix)
+		}
+	}
+
+	c.cache[prefix] = cacheEntry{
+		names:   names,
+		expires: now.Add(cacheTTL),
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package functioncompleter_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/functioncompleter"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/completion/functioncompleter"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	completer := functioncompleter.New(mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, completer)
+}
+
+func TestFunctionCompleter_Complete_HappyPath(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		BackgroundClient(mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Once()
+
+	mockMATLABSessionClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.mcpCompleteFunction",
+			Arguments:  []string{"plo"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`["plot","plot3","plotResults"]`}}, nil).
+		Once()
+
+	completer := functioncompleter.New(mockGlobalMATLAB)
+
+	// Act
+	names, err := completer.Complete(ctx, mockLogger, "plo")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"plot", "plot3", "plotResults"}, names)
+}
+
+func TestFunctionCompleter_Complete_UsesCache(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		BackgroundClient(mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Once()
+
+	mockMATLABSessionClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.mcpCompleteFunction",
+			Arguments:  []string{"dis"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`["disp","display"]`}}, nil).
+		Once()
+
+	completer := functioncompleter.New(mockGlobalMATLAB)
+
+	_, err := completer.Complete(ctx, mockLogger, "dis")
+	require.NoError(t, err)
+
+	// Act
+	names, err := completer.Complete(ctx, mockLogger, "dis")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"disp", "display"}, names)
+}
+
+func TestFunctionCompleter_Complete_MATLABNotRunning(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		BackgroundClient(mockLogger.AsMockArg()).
+		Return(nil, false).
+		Twice()
+
+	completer := functioncompleter.New(mockGlobalMATLAB)
+
+	_, err := completer.Complete(ctx, mockLogger, "plo")
+	require.NoError(t, err)
+
+	// Act
+	names, err := completer.Complete(ctx, mockLogger, "plo")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, names, "There should be no completions until MATLAB is running and idle")
+	assert.NotNil(t, names)
+}
+
+func TestFunctionCompleter_Complete_SessionBecameBusy(t *testing.T) {
+	// Arrange
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		BackgroundClient(mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Twice()
+
+	mockMATLABSessionClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.mcpCompleteFunction",
+			Arguments:  []string{"plo"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, matlabsessionstore.ErrSessionBusy).
+		Twice()
+
+	completer := functioncompleter.New(mockGlobalMATLAB)
+
+	_, err := completer.Complete(ctx, mockLogger, "plo")
+	require.NoError(t, err)
+
+	// Act
+	names, err := completer.Complete(ctx, mockLogger, "plo")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, names, "There should be no completions while a tool call uses MATLAB")
+	assert.NotNil(t, names)
+}
+
+func TestFunctionCompleter_Complete_FEvalErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		responseError error
+		expectedError string
+	}{
+		{
+			name:          "feval error",
+			responseError: assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs from matlab_mcp.mcpCompleteFunction: 0",
+		},
+		{
+			name:          "output is not a string",
+			response:      entities.FEvalResponse{Outputs: []any{42.0}},
+			expectedError: "failed to cast output of matlab_mcp.mcpCompleteFunction to string",
+		},
+		{
+			name:          "output is not JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not json"}},
+			expectedError: "failed to parse output of matlab_mcp.mcpCompleteFunction",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockMATLABSessionClient.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+
+			mockGlobalMATLAB.EXPECT().
+				BackgroundClient(mockLogger.AsMockArg()).
+				Return(mockMATLABSessionClient, true).
+				Once()
+
+			mockMATLABSessionClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.mcpCompleteFunction",
+					Arguments:  []string{"plo"},
+					NumOutputs: 1,
+				}).
+				Return(tc.response, tc.responseError).
+				Once()
+
+			completer := functioncompleter.New(mockGlobalMATLAB)
+
+			// Act
+			names, err := completer.Complete(ctx, mockLogger, "plo")
+
+			// Assert
+			require.ErrorContains(t, err, tc.expectedError)
+			assert.Nil(t, names)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package pathcompleter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type OSLayer interface {
+	ReadDir(name string) ([]os.DirEntry, error)
+}
+
+type RootStore interface {
+	GetRoots() []entities.MCPRoot
+}
+
+type RootPathResolver interface {
+	Resolve(root entities.MCPRoot) (string, error)
+}
+
+// PathCompleter suggests paths on disk. Absolute values complete from the folder they are in,
+// and other values complete from the MCP roots, so that suggestions are always absolute paths.
+type PathCompleter struct {
+	osLayer          OSLayer
+	rootStore        RootStore
+	rootPathResolver RootPathResolver
+}
+
+func New(
+	osLayer OSLayer,
+	rootStore RootStore,
+	rootPathResolver RootPathResolver,
+) *PathCompleter {
+	return &PathCompleter{
+		osLayer:          osLayer,
+		rootStore:        rootStore,
+		rootPathResolver: rootPathResolver,
+	}
+}
+
+// Files suggests files with one of the extensions, and folders. Without extensions, it suggests all files.
+func (c *PathCompleter) Files(extensions ...string) basetool.CompletionProvider {
+	return &provider{
+		pathCompleter: c,
+		extensions:    extensions,
+	}
+}
+
+type provider struct {
+	pathCompleter *PathCompleter
+	extensions    []string
+}
+
+func (p *provider) Complete(_ context.Context, logger entities.Logger, value string) ([]string, error) {
+	if filepath.IsAbs(value) {
+		return p.completeIn(logger, value), nil
+	}
+
+	suggestions := []string{}
+	for _, root := range p.pathCompleter.rootStore.GetRoots() {
+		rootDir, err := p.pathCompleter.rootPathResolver.Resolve(root)
+		if err != nil || rootDir == "" {
+			continue
+		}
+
+		// The value is appended rather than joined, because joining cleans the path, which drops a trailing separator or dot
+		// that the user has typed.
+		path := strings.TrimSuffix(rootDir, string(filepath.Separator)) + string(filepath.Separator) + value
+
+		suggestions = append(suggestions, p.completeIn(logger, path)...)
+	}
+
+	return suggestions, nil
+}
+
+// completeIn suggests the entries of the folder of path whose names start with the last element of path.
+func (p *provider) completeIn(logger entities.Logger, path string) []string {
+	folder, prefix := filepath.Split(path)
+	if strings.HasSuffix(path, string(filepath.Separator)) {
+		folder, prefix = path, ""
+	}
+
+	entries, err := p.pathCompleter.osLayer.ReadDir(folder)
+	if err != nil {
+		logger.WithError(err).With("folder", folder).Debug("failed to read folder for path completion")
+		return nil
+	}
+
+	suggestions := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !hasPrefixFold(name, prefix) {
+			continue
+		}
+
+		// Hidden entries are only suggested once the user starts typing their name.
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".") {
+			continue
+		}
+
+		switch {
+		case entry.IsDir():
+			suggestions = append(suggestions, filepath.Join(folder, name)+string(filepath.Separator))
+		case p.hasExtension(name):
+			suggestions = append(suggestions, filepath.Join(folder, name))
+		}
+	}
+
+	return suggestions
+}
+
+func (p *provider) hasExtension(name string) bool {
+	if len(p.extensions) == 0 {
+		return true
+	}
+
+	extension := filepath.Ext(name)
+	for _, candidate := range p.extensions {
+		if strings.EqualFold(extension, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring case.
+func hasPrefixFold(s string, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
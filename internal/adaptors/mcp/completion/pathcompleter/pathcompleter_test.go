@@ -0,0 +1,257 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package pathcompleter_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/pathcompleter"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/completion/pathcompleter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	// Act
+	completer := pathcompleter.New(mockOSLayer, mockRootStore, mockRootPathResolver)
+
+	// Assert
+	assert.NotNil(t, completer)
+}
+
+func TestPathCompleter_Files_RelativeValue(t *testing.T) {
+	testCases := []struct {
+		name       string
+		extensions []string
+		value      string
+		expected   []string
+	}{
+		{
+			name:       "empty value lists root",
+			extensions: []string{".m"},
+			value:      "",
+			expected:   []string{"analysis.m", "data" + string(filepath.Separator), "plotResults.M"},
+		},
+		{
+			name:       "prefix is case insensitive",
+			extensions: []string{".m"},
+			value:      "PLOT",
+			expected:   []string{"plotResults.M"},
+		},
+		{
+			name:       "value in subfolder",
+			extensions: []string{".m"},
+			value:      filepath.Join("data", "lo"),
+			expected:   []string{filepath.Join("data", "load_data.m")},
+		},
+		{
+			name:       "trailing separator lists folder",
+			extensions: []string{".m"},
+			value:      "data" + string(filepath.Separator),
+			expected:   []string{filepath.Join("data", "load_data.m")},
+		},
+		{
+			name:       "no extensions lists all files",
+			extensions: nil,
+			value:      "",
+			expected:   []string{"analysis.m", "data" + string(filepath.Separator), "model.slx", "plotResults.M"},
+		},
+		{
+			name:       "hidden entries when typed",
+			extensions: nil,
+			value:      ".",
+			expected:   []string{".hidden" + string(filepath.Separator)},
+		},
+		{
+			name:       "no match",
+			extensions: []string{".m"},
+			value:      "zzz",
+			expected:   []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRootStore := &mocks.MockRootStore{}
+			defer mockRootStore.AssertExpectations(t)
+
+			mockRootPathResolver := &mocks.MockRootPathResolver{}
+			defer mockRootPathResolver.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+
+			rootDir := t.TempDir()
+			writeFile(t, filepath.Join(rootDir, "analysis.m"))
+			writeFile(t, filepath.Join(rootDir, "plotResults.M"))
+			writeFile(t, filepath.Join(rootDir, "model.slx"))
+			writeFile(t, filepath.Join(rootDir, "data", "load_data.m"))
+			writeFile(t, filepath.Join(rootDir, ".hidden", "secret.m"))
+
+			root := entities.NewMCPRoot("file://"+rootDir, "root")
+
+			mockRootStore.EXPECT().
+				GetRoots().
+				Return([]entities.MCPRoot{root}).
+				Once()
+
+			mockRootPathResolver.EXPECT().
+				Resolve(root).
+				Return(rootDir, nil).
+				Once()
+
+			expected := make([]string, 0, len(tc.expected))
+			for _, path := range tc.expected {
+				expected = append(expected, filepath.Join(rootDir, path)+trailingSeparator(path))
+			}
+
+			completer := pathcompleter.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+			// Act
+			values, err := completer.Files(tc.extensions...).Complete(ctx, mockLogger, tc.value)
+
+			// Assert
+			require.NoError(t, err)
+			assert.ElementsMatch(t, expected, values)
+		})
+	}
+}
+
+func TestPathCompleter_Files_AbsoluteValue(t *testing.T) {
+	// Arrange
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	folder := t.TempDir()
+	writeFile(t, filepath.Join(folder, "Weather.prj"))
+	writeFile(t, filepath.Join(folder, "weather.m"))
+
+	completer := pathcompleter.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+	// Act
+	values, err := completer.Files(".prj").Complete(ctx, mockLogger, filepath.Join(folder, "w"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(folder, "Weather.prj")}, values)
+}
+
+func TestPathCompleter_Files_MultipleRoots(t *testing.T) {
+	// Arrange
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	firstRootDir := t.TempDir()
+	writeFile(t, filepath.Join(firstRootDir, "first.m"))
+
+	secondRootDir := t.TempDir()
+	writeFile(t, filepath.Join(secondRootDir, "second.m"))
+
+	firstRoot := entities.NewMCPRoot("file://"+firstRootDir, "first")
+	secondRoot := entities.NewMCPRoot("file://"+secondRootDir, "second")
+	unresolvableRoot := entities.NewMCPRoot("https://example.com", "remote")
+
+	mockRootStore.EXPECT().
+		GetRoots().
+		Return([]entities.MCPRoot{firstRoot, unresolvableRoot, secondRoot}).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(firstRoot).
+		Return(firstRootDir, nil).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(unresolvableRoot).
+		Return("", assert.AnError).
+		Once()
+
+	mockRootPathResolver.EXPECT().
+		Resolve(secondRoot).
+		Return(secondRootDir, nil).
+		Once()
+
+	completer := pathcompleter.New(osfacade.New(), mockRootStore, mockRootPathResolver)
+
+	// Act
+	values, err := completer.Files(".m").Complete(ctx, mockLogger, "")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(firstRootDir, "first.m"), filepath.Join(secondRootDir, "second.m")}, values)
+}
+
+func TestPathCompleter_Files_ReadDirError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockRootPathResolver := &mocks.MockRootPathResolver{}
+	defer mockRootPathResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	folder := filepath.Join(t.TempDir(), "missing")
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder+string(filepath.Separator)).
+		Return(nil, assert.AnError).
+		Once()
+
+	completer := pathcompleter.New(mockOSLayer, mockRootStore, mockRootPathResolver)
+
+	// Act
+	values, err := completer.Files(".m").Complete(ctx, mockLogger, filepath.Join(folder, "a"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, values)
+	assert.Contains(t, mockLogger.DebugLogs(), "failed to read folder for path completion")
+}
+
+func trailingSeparator(path string) string {
+	if len(path) > 0 && os.IsPathSeparator(path[len(path)-1]) {
+		return string(filepath.Separator)
+	}
+
+	return ""
+}
+
+func writeFile(t *testing.T, path string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte{}, 0o600))
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package completion
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// ValuesProvider suggests the values of a fixed list, such as the values of an enum, that start with the typed value.
+type ValuesProvider struct {
+	values []string
+}
+
+func NewValuesProvider(values ...string) *ValuesProvider {
+	return &ValuesProvider{
+		values: values,
+	}
+}
+
+func (p *ValuesProvider) Complete(_ context.Context, _ entities.Logger, value string) ([]string, error) {
+	matches := []string{}
+	for _, candidate := range p.values {
+		if hasPrefixFold(candidate, value) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	return matches, nil
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring case.
+func hasPrefixFold(s string, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
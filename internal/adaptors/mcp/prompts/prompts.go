@@ -0,0 +1,16 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package prompts
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Server interface {
+	AddPrompt(prompt *mcp.Prompt, handler mcp.PromptHandler)
+}
+
+type Prompt interface {
+	Name() string
+	AddToServer(server Server) error
+}
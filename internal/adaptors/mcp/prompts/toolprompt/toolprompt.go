@@ -0,0 +1,154 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolprompt
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Tool is a tool that suggests values for some of its arguments.
+type Tool interface {
+	Name() string
+	Title() string
+	GetInputSchema() (any, error)
+	CompletionProviders() map[string]basetool.CompletionProvider
+}
+
+// Prompt asks the model to call a tool with the arguments that the user gives.
+// MCP only defines completions for the arguments of prompts and resource templates,
+// so the prompt is how users get suggestions for the arguments of the tool as they type them.
+type Prompt struct {
+	toolName            string
+	prompt              *mcp.Prompt
+	completionProviders map[string]basetool.CompletionProvider
+}
+
+// New creates the prompt of a tool. The prompt has the name of the tool, and an argument for each argument of the tool.
+// ok is false when the tool does not suggest values for any argument, so that it needs no prompt.
+func New(tool Tool) (*Prompt, bool, error) {
+	completionProviders := tool.CompletionProviders()
+	if len(completionProviders) == 0 {
+		return nil, false, nil
+	}
+
+	inputSchema, err := tool.GetInputSchema()
+	if err != nil {
+		return nil, false, err
+	}
+
+	schema, ok := inputSchema.(*jsonschema.Schema)
+	if !ok || schema == nil {
+		return nil, false, fmt.Errorf("tool %s has no input schema to create a prompt from", tool.Name())
+	}
+
+	title := tool.Title()
+	if title == "" {
+		title = tool.Name()
+	}
+
+	return &Prompt{
+		toolName: tool.Name(),
+		prompt: &mcp.Prompt{
+			Name:        tool.Name(),
+			Title:       title,
+			Description: fmt.Sprintf("Asks the model to call the %s tool with the given arguments.", tool.Name()),
+			Arguments:   arguments(schema),
+		},
+		completionProviders: completionProviders,
+	}, true, nil
+}
+
+func (p *Prompt) Name() string {
+	return p.prompt.Name
+}
+
+// CompletionProviders returns the providers that suggest values for the arguments of the prompt, by argument name.
+func (p *Prompt) CompletionProviders() map[string]basetool.CompletionProvider {
+	return p.completionProviders
+}
+
+func (p *Prompt) AddToServer(server prompts.Server) error {
+	server.AddPrompt(p.prompt, p.handle)
+	return nil
+}
+
+func (p *Prompt) handle(_ context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	var values map[string]string
+	if req != nil && req.Params != nil {
+		values = req.Params.Arguments
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Call the `%s` tool", p.toolName)
+
+	given := 0
+	for _, argument := range p.prompt.Arguments {
+		value, ok := values[argument.Name]
+		if !ok || value == "" {
+			if argument.Required {
+				return nil, fmt.Errorf("missing required argument %s", argument.Name)
+			}
+			continue
+		}
+
+		if given == 0 {
+			text.WriteString(" with these arguments:\n")
+		}
+		fmt.Fprintf(&text, "- `%s`: %s\n", argument.Name, value)
+		given++
+	}
+
+	if given == 0 {
+		text.WriteString(".")
+	}
+
+	return &mcp.GetPromptResult{
+		Description: p.prompt.Description,
+		Messages: []*mcp.PromptMessage{
+			{
+				Role:    "user",
+				Content: &mcp.TextContent{Text: text.String()},
+			},
+		},
+	}, nil
+}
+
+// arguments returns an argument for each property of the input schema, in the order of the properties in the schema.
+func arguments(schema *jsonschema.Schema) []*mcp.PromptArgument {
+	names := slices.Clone(schema.PropertyOrder)
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	result := make([]*mcp.PromptArgument, 0, len(names))
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if !ok {
+			continue
+		}
+
+		argument := &mcp.PromptArgument{
+			Name:     name,
+			Required: slices.Contains(schema.Required, name),
+		}
+		if property != nil {
+			argument.Title = property.Title
+			argument.Description = property.Description
+		}
+
+		result = append(result, argument)
+	}
+
+	return result
+}
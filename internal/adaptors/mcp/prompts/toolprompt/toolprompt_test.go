@@ -0,0 +1,286 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolprompt_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts/toolprompt"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	promptsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/prompts"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/prompts/toolprompt"
+	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const toolName = "run_matlab_file"
+
+func newInputSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"script_path": {Type: "string", Description: "The path of the script"},
+			"arguments":   {Type: "string", Title: "Arguments"},
+			"timeout":     {Type: "integer"},
+		},
+		PropertyOrder: []string{"script_path", "arguments"},
+		Required:      []string{"script_path"},
+	}
+}
+
+func newTool(t *testing.T, title string) *mocks.MockTool {
+	t.Helper()
+
+	mockTool := &mocks.MockTool{}
+	mockTool.EXPECT().
+		CompletionProviders().
+		Return(map[string]basetool.CompletionProvider{"script_path": &basetoolmocks.MockCompletionProvider{}}).
+		Once()
+	mockTool.EXPECT().
+		GetInputSchema().
+		Return(newInputSchema(), nil).
+		Once()
+	mockTool.EXPECT().
+		Name().
+		Return(toolName)
+	mockTool.EXPECT().
+		Title().
+		Return(title).
+		Once()
+
+	return mockTool
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockTool := newTool(t, "Run MATLAB File")
+	defer mockTool.AssertExpectations(t)
+
+	// Act
+	prompt, ok, err := toolprompt.New(mockTool)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, toolName, prompt.Name())
+	assert.Contains(t, prompt.CompletionProviders(), "script_path")
+}
+
+func TestNew_NoCompletionProviders(t *testing.T) {
+	// Arrange
+	mockTool := &mocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	mockTool.EXPECT().
+		CompletionProviders().
+		Return(nil).
+		Once()
+
+	// Act
+	prompt, ok, err := toolprompt.New(mockTool)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, ok, "A tool without completions should not have a prompt")
+	assert.Nil(t, prompt)
+}
+
+func TestNew_GetInputSchemaError(t *testing.T) {
+	// Arrange
+	mockTool := &mocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockTool.EXPECT().
+		CompletionProviders().
+		Return(map[string]basetool.CompletionProvider{"script_path": &basetoolmocks.MockCompletionProvider{}}).
+		Once()
+
+	mockTool.EXPECT().
+		GetInputSchema().
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	prompt, ok, err := toolprompt.New(mockTool)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.False(t, ok)
+	assert.Nil(t, prompt)
+}
+
+func TestNew_InputSchemaIsNotASchema(t *testing.T) {
+	// Arrange
+	mockTool := &mocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	mockTool.EXPECT().
+		CompletionProviders().
+		Return(map[string]basetool.CompletionProvider{"script_path": &basetoolmocks.MockCompletionProvider{}}).
+		Once()
+
+	mockTool.EXPECT().
+		GetInputSchema().
+		Return(map[string]any{"type": "object"}, nil).
+		Once()
+
+	mockTool.EXPECT().
+		Name().
+		Return(toolName).
+		Once()
+
+	// Act
+	prompt, ok, err := toolprompt.New(mockTool)
+
+	// Assert
+	require.ErrorContains(t, err, "tool run_matlab_file has no input schema")
+	assert.False(t, ok)
+	assert.Nil(t, prompt)
+}
+
+func TestPrompt_AddToServer_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name          string
+		title         string
+		expectedTitle string
+	}{
+		{
+			name:          "tool with title",
+			title:         "Run MATLAB File",
+			expectedTitle: "Run MATLAB File",
+		},
+		{
+			name:          "tool without title uses its name",
+			title:         "",
+			expectedTitle: toolName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTool := newTool(t, tc.title)
+			defer mockTool.AssertExpectations(t)
+
+			mockServer := &promptsmocks.MockServer{}
+			defer mockServer.AssertExpectations(t)
+
+			mockServer.EXPECT().
+				AddPrompt(&mcp.Prompt{
+					Name:        toolName,
+					Title:       tc.expectedTitle,
+					Description: "Asks the model to call the run_matlab_file tool with the given arguments.",
+					Arguments: []*mcp.PromptArgument{
+						{Name: "script_path", Description: "The path of the script", Required: true},
+						{Name: "arguments", Title: "Arguments"},
+						{Name: "timeout"},
+					},
+				}, mock.AnythingOfType("mcp.PromptHandler")).
+				Return().
+				Once()
+
+			prompt, ok, err := toolprompt.New(mockTool)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			// Act
+			err = prompt.AddToServer(mockServer)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrompt_Handler(t *testing.T) {
+	testCases := []struct {
+		name         string
+		arguments    map[string]string
+		expectedText string
+	}{
+		{
+			name:         "required argument",
+			arguments:    map[string]string{"script_path": "/home/user/analysis.m"},
+			expectedText: "Call the `run_matlab_file` tool with these arguments:\n- `script_path`: /home/user/analysis.m\n",
+		},
+		{
+			name:         "arguments in schema order",
+			arguments:    map[string]string{"timeout": "60", "script_path": "/home/user/analysis.m", "arguments": ""},
+			expectedText: "Call the `run_matlab_file` tool with these arguments:\n- `script_path`: /home/user/analysis.m\n- `timeout`: 60\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTool := newTool(t, "Run MATLAB File")
+			defer mockTool.AssertExpectations(t)
+
+			mockServer := &promptsmocks.MockServer{}
+			defer mockServer.AssertExpectations(t)
+
+			var handler mcp.PromptHandler
+			mockServer.EXPECT().
+				AddPrompt(mock.Anything, mock.AnythingOfType("mcp.PromptHandler")).
+				Run(func(_ *mcp.Prompt, h mcp.PromptHandler) {
+					handler = h
+				}).
+				Return().
+				Once()
+
+			prompt, ok, err := toolprompt.New(mockTool)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.NoError(t, prompt.AddToServer(mockServer))
+
+			// Act
+			result, err := handler(t.Context(), &mcp.GetPromptRequest{
+				Params: &mcp.GetPromptParams{Name: toolName, Arguments: tc.arguments},
+			})
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, result.Messages, 1)
+			assert.Equal(t, mcp.Role("user"), result.Messages[0].Role)
+			assert.Equal(t, &mcp.TextContent{Text: tc.expectedText}, result.Messages[0].Content)
+		})
+	}
+}
+
+func TestPrompt_Handler_MissingRequiredArgument(t *testing.T) {
+	// Arrange
+	mockTool := newTool(t, "Run MATLAB File")
+	defer mockTool.AssertExpectations(t)
+
+	mockServer := &promptsmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	var handler mcp.PromptHandler
+	mockServer.EXPECT().
+		AddPrompt(mock.Anything, mock.AnythingOfType("mcp.PromptHandler")).
+		Run(func(_ *mcp.Prompt, h mcp.PromptHandler) {
+			handler = h
+		}).
+		Return().
+		Once()
+
+	prompt, ok, err := toolprompt.New(mockTool)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, prompt.AddToServer(mockServer))
+
+	// Act
+	result, err := handler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{Name: toolName, Arguments: map[string]string{"timeout": "60"}},
+	})
+
+	// Assert
+	require.EqualError(t, err, "missing required argument script_path")
+	assert.Nil(t, result)
+}
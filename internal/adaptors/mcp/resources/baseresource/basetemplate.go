@@ -0,0 +1,90 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package baseresource
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TemplateHandler reads the resource with the given URI, which matches the URI template of the resource template.
+type TemplateHandler func(ctx context.Context, logger entities.Logger, uri string) (*ReadResourceResult, error)
+
+// CompletionProvider suggests values for a variable of a URI template, given the value that the user has typed so far.
+type CompletionProvider interface {
+	Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error)
+}
+
+// NewTemplate creates a resource template, which serves all the resources whose URI matches an RFC 6570 URI template,
+// such as matlab-help://{name}.
+func NewTemplate(
+	name string,
+	title string,
+	description string,
+	mimeType string,
+	uriTemplate string,
+	loggerFactory LoggerFactory,
+	handler TemplateHandler,
+) *Template {
+	return &Template{
+		name:          name,
+		title:         title,
+		description:   description,
+		mimeType:      mimeType,
+		uriTemplate:   uriTemplate,
+		loggerFactory: loggerFactory,
+		handler:       handler,
+	}
+}
+
+type Template struct {
+	name          string
+	title         string
+	description   string
+	mimeType      string
+	uriTemplate   string
+	loggerFactory LoggerFactory
+	handler       TemplateHandler
+}
+
+func (t *Template) AddToServer(server resources.Server) error {
+	if err := validateMIMEType(t.mimeType); err != nil {
+		return err
+	}
+
+	server.AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        t.name,
+			Title:       t.title,
+			Description: t.description,
+			MIMEType:    t.mimeType,
+			URITemplate: t.uriTemplate,
+		},
+		newMCPResourceHandler(t.name, t.loggerFactory, t.handler),
+	)
+
+	return nil
+}
+
+func (t *Template) Name() string {
+	return t.name
+}
+
+func (t *Template) Title() string {
+	return t.title
+}
+
+func (t *Template) Description() string {
+	return t.description
+}
+
+func (t *Template) MimeType() string {
+	return t.mimeType
+}
+
+func (t *Template) URITemplate() string {
+	return t.uriTemplate
+}
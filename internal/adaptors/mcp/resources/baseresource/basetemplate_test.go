@@ -0,0 +1,195 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package baseresource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplate_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_template"
+		title       = "Test Template"
+		description = "A test resource template"
+		mimeType    = "text/markdown"
+		uriTemplate = "test://{name}"
+	)
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	// Act
+	template := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, handler)
+
+	// Assert
+	assert.NotNil(t, template)
+	assert.Equal(t, name, template.Name())
+	assert.Equal(t, title, template.Title())
+	assert.Equal(t, description, template.Description())
+	assert.Equal(t, mimeType, template.MimeType())
+	assert.Equal(t, uriTemplate, template.URITemplate())
+}
+
+func TestTemplate_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_template"
+		title       = "Test Template"
+		description = "A test resource template"
+		mimeType    = "text/markdown"
+		uriTemplate = "test://{name}"
+	)
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        name,
+			Title:       title,
+			Description: description,
+			MIMEType:    mimeType,
+			URITemplate: uriTemplate,
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Return().Once()
+
+	template := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, nil)
+
+	// Act
+	err := template.AddToServer(mockServer)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestTemplate_AddToServer_InvalidMimeType(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	template := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "markdown", "test://{name}", mockLoggerFactory, nil)
+
+	// Act
+	err := template.AddToServer(mockServer)
+
+	// Assert
+	require.ErrorContains(t, err, "must be in format type/subtype")
+}
+
+func TestTemplate_ResourceHandler_HappyPath(t *testing.T) {
+	// Arrange
+	const uri = "test://plot"
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger, nil).
+		Once()
+
+	var receivedURI string
+	handler := func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		receivedURI = uri
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{{MIMEType: "text/markdown", Text: "# plot"}},
+		}, nil
+	}
+
+	template := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/markdown", "test://{name}", mockLoggerFactory, handler)
+
+	var capturedHandler mcp.ResourceHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		mock.Anything,
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Run(func(_ *mcp.ResourceTemplate, h mcp.ResourceHandler) {
+		capturedHandler = h
+	}).Return()
+
+	require.NoError(t, template.AddToServer(mockServer))
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uri, receivedURI)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "# plot", result.Contents[0].Text)
+}
+
+func TestTemplate_ResourceHandler_HandlerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger, nil).
+		Once()
+
+	expectedError := assert.AnError
+	handler := func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		return nil, expectedError
+	}
+
+	template := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/markdown", "test://{name}", mockLoggerFactory, handler)
+
+	var capturedHandler mcp.ResourceHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		mock.Anything,
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Run(func(_ *mcp.ResourceTemplate, h mcp.ResourceHandler) {
+		capturedHandler = h
+	}).Return()
+
+	require.NoError(t, template.AddToServer(mockServer))
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://plot",
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp
+
+const (
+	name        = "matlab_help"
+	title       = "MATLAB Help"
+	description = "Provides the documentation of a MATLAB function, class or namespace, such as matlab-help://plot or matlab-help://matlab.unittest.TestCase, as markdown. It includes the syntax, the help text, the related functions and the product that provides it. Read this resource before calling a MATLAB function whose signature you are not sure of."
+	mimeType    = "text/markdown"
+	uriTemplate = "matlab-help://{name}"
+	uriPrefix   = "matlab-help://"
+
+	namespaceTitle       = "MATLAB Help for %s"
+	namespaceDescription = "Lists the functions, classes and namespaces in the %s namespace, with links to their documentation."
+)
+
+// commonNamespaces are listed as resources, so that clients can find the MATLAB help resource and browse from there.
+func commonNamespaces() []string {
+	return []string{
+		"matlab.buildtool",
+		"matlab.io",
+		"matlab.lang",
+		"matlab.net.http",
+		"matlab.project",
+		"matlab.unittest",
+	}
+}
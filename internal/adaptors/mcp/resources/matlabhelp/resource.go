@@ -0,0 +1,163 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Usecase interface {
+	Help(ctx context.Context, sessionLogger entities.Logger, globalMATLAB entities.GlobalMATLAB, name string) (matlabhelp.Help, error)
+}
+
+type FunctionCompleter interface {
+	Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error)
+}
+
+type Resource struct {
+	*baseresource.Template
+
+	loggerFactory     baseresource.LoggerFactory
+	handler           baseresource.TemplateHandler
+	functionCompleter FunctionCompleter
+}
+
+func New(
+	loggerFactory baseresource.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+	functionCompleter FunctionCompleter,
+) *Resource {
+	handler := Handler(usecase, globalMATLAB)
+
+	return &Resource{
+		Template: baseresource.NewTemplate(
+			name,
+			title,
+			description,
+			mimeType,
+			uriTemplate,
+			loggerFactory,
+			handler,
+		),
+		loggerFactory:     loggerFactory,
+		handler:           handler,
+		functionCompleter: functionCompleter,
+	}
+}
+
+// AddToServer adds the resource template, and a resource for each of the common namespaces, so that they are listed.
+func (r *Resource) AddToServer(server resources.Server) error {
+	if err := r.Template.AddToServer(server); err != nil {
+		return err
+	}
+
+	for _, namespace := range commonNamespaces() {
+		uri := uriPrefix + namespace
+		resource := baseresource.New(
+			namespace,
+			fmt.Sprintf(namespaceTitle, namespace),
+			fmt.Sprintf(namespaceDescription, namespace),
+			mimeType,
+			0,
+			uri,
+			r.loggerFactory,
+			func(ctx context.Context, logger entities.Logger) (*baseresource.ReadResourceResult, error) {
+				return r.handler(ctx, logger, uri)
+			},
+		)
+
+		if err := resource.AddToServer(server); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CompletionProviders suggests the names of MATLAB functions for the name in the URI template.
+func (r *Resource) CompletionProviders() map[string]baseresource.CompletionProvider {
+	return map[string]baseresource.CompletionProvider{
+		"name": r.functionCompleter,
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) baseresource.TemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		helpName := strings.TrimPrefix(uri, uriPrefix)
+		logger.With("name", helpName).Info("Returning MATLAB help resource")
+
+		help, err := usecase.Help(ctx, logger, globalMATLAB, helpName)
+		if errors.Is(err, matlabhelp.ErrNotFound) {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     toMarkdown(help),
+				},
+			},
+		}, nil
+	}
+}
+
+// toMarkdown writes the help as markdown. The help text is kept in a code block, as MATLAB lays it out with
+// indentation that markdown would otherwise turn into code blocks and lists of its own.
+func toMarkdown(help matlabhelp.Help) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# %s\n", help.Name)
+
+	if help.Summary != "" {
+		fmt.Fprintf(&builder, "\n%s\n", help.Summary)
+	}
+
+	if help.Product != "" || help.Release != "" {
+		builder.WriteString("\n")
+		if help.Product != "" {
+			fmt.Fprintf(&builder, "- **Product:** %s\n", help.Product)
+		}
+		if help.Release != "" {
+			fmt.Fprintf(&builder, "- **Release:** %s\n", help.Release)
+		}
+	}
+
+	if len(help.Syntax) > 0 {
+		fmt.Fprintf(&builder, "\n## Syntax\n\n```matlab\n%s\n```\n", strings.Join(help.Syntax, "\n"))
+	}
+
+	if help.Text != "" {
+		fmt.Fprintf(&builder, "\n## Description\n\n```text\n%s\n```\n", help.Text)
+	}
+
+	writeLinks(&builder, "Members", help.Members)
+	writeLinks(&builder, "See also", help.SeeAlso)
+
+	return builder.String()
+}
+
+// writeLinks writes a section that links to the MATLAB help resources of the given names.
+func writeLinks(builder *strings.Builder, heading string, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	fmt.Fprintf(builder, "\n## %s\n\n", heading)
+	for _, helpName := range names {
+		fmt.Fprintf(builder, "- [%s](%s%s)\n", helpName, uriPrefix, helpName)
+	}
+}
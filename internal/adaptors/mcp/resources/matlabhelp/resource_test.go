@@ -0,0 +1,247 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	matlabhelpusecase "github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	resourcesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/matlabhelp"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFunctionCompleter := &mocks.MockFunctionCompleter{}
+	defer mockFunctionCompleter.AssertExpectations(t)
+
+	// Act
+	resource := matlabhelp.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockFunctionCompleter)
+
+	// Assert
+	require.NotNil(t, resource)
+	assert.Equal(t, "matlab_help", resource.Name())
+	assert.Equal(t, "MATLAB Help", resource.Title())
+	assert.Equal(t, "text/markdown", resource.MimeType())
+	assert.Equal(t, "matlab-help://{name}", resource.URITemplate())
+	assert.Contains(t, resource.CompletionProviders(), "name")
+}
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFunctionCompleter := &mocks.MockFunctionCompleter{}
+	defer mockFunctionCompleter.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().
+		AddResourceTemplate(
+			mock.MatchedBy(func(template *mcp.ResourceTemplate) bool {
+				return template.URITemplate == "matlab-help://{name}"
+			}),
+			mock.AnythingOfType("mcp.ResourceHandler"),
+		).
+		Return().
+		Once()
+
+	var listedURIs []string
+	mockServer.EXPECT().
+		AddResource(mock.Anything, mock.AnythingOfType("mcp.ResourceHandler")).
+		Run(func(resource *mcp.Resource, _ mcp.ResourceHandler) {
+			listedURIs = append(listedURIs, resource.URI)
+		}).
+		Return()
+
+	resource := matlabhelp.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockFunctionCompleter)
+
+	// Act
+	err := resource.AddToServer(mockServer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, listedURIs, "matlab-help://matlab.unittest", "Common namespaces should be listed as resources")
+	for _, uri := range listedURIs {
+		assert.Regexp(t, `^matlab-help://matlab\.`, uri)
+	}
+}
+
+func TestResource_AddToServer_NamespaceHandler(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockFunctionCompleter := &mocks.MockFunctionCompleter{}
+	defer mockFunctionCompleter.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockServer.EXPECT().
+		AddResourceTemplate(mock.Anything, mock.AnythingOfType("mcp.ResourceHandler")).
+		Return().
+		Once()
+
+	handlers := map[string]mcp.ResourceHandler{}
+	mockServer.EXPECT().
+		AddResource(mock.Anything, mock.AnythingOfType("mcp.ResourceHandler")).
+		Run(func(resource *mcp.Resource, handler mcp.ResourceHandler) {
+			handlers[resource.URI] = handler
+		}).
+		Return()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Help(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, "matlab.unittest").
+		Return(matlabhelpusecase.Help{Name: "matlab.unittest", Members: []string{"matlab.unittest.TestCase"}}, nil).
+		Once()
+
+	resource := matlabhelp.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockFunctionCompleter)
+	require.NoError(t, resource.AddToServer(mockServer))
+	require.Contains(t, handlers, "matlab-help://matlab.unittest")
+
+	// Act
+	result, err := handlers["matlab-help://matlab.unittest"](ctx, &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: "matlab-help://matlab.unittest"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Contains(t, result.Contents[0].Text, "- [matlab.unittest.TestCase](matlab-help://matlab.unittest.TestCase)")
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Help(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, "matlab.unittest").
+		Return(matlabhelpusecase.Help{
+			Name:    "matlab.unittest",
+			Members: []string{"matlab.unittest.TestCase", "matlab.unittest.constraints"},
+			Product: "MATLAB",
+			Release: "R2025b",
+		}, nil).
+		Once()
+
+	handler := matlabhelp.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, "matlab-help://matlab.unittest")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/markdown", result.Contents[0].MIMEType)
+	assert.Equal(t, "# matlab.unittest\n"+
+		"\n"+
+		"- **Product:** MATLAB\n"+
+		"- **Release:** R2025b\n"+
+		"\n"+
+		"## Members\n"+
+		"\n"+
+		"- [matlab.unittest.TestCase](matlab-help://matlab.unittest.TestCase)\n"+
+		"- [matlab.unittest.constraints](matlab-help://matlab.unittest.constraints)\n",
+		result.Contents[0].Text)
+}
+
+func TestHandler_NotFound(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Help(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, "notAFunction").
+		Return(matlabhelpusecase.Help{}, matlabhelpusecase.ErrNotFound).
+		Once()
+
+	handler := matlabhelp.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, "matlab-help://notAFunction")
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, mcp.ResourceNotFoundError("matlab-help://notAFunction").Error(), err.Error())
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockUsecase.EXPECT().
+		Help(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, "plot").
+		Return(matlabhelpusecase.Help{}, expectedError).
+		Once()
+
+	handler := matlabhelp.Handler(mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, mockLogger, "matlab-help://plot")
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlaboutput
+
+const (
+	name        = "matlab_output"
+	title       = "MATLAB Output"
+	description = "Provides the full output of a tool call whose result was truncated, such as matlab-output://{callID}?offset=0&limit=65536. The truncated result gives the URI to read. Read the output in pages: each page starts at the given byte offset and holds at most limit bytes, and ends with the offset of the next page."
+	mimeType    = "text/plain"
+	uriTemplate = "matlab-output://{callID}{?offset,limit}"
+
+	defaultPageBytes = 64 * 1024
+	maxPageBytes     = 1024 * 1024
+
+	pageFooter = "\n\n[Bytes %d to %d of %d. Next page: %s%s?offset=%d&limit=%d]"
+	lastFooter = "\n\n[Bytes %d to %d of %d. End of output.]"
+)
@@ -0,0 +1,120 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlaboutput
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ErrInvalidQuery = errors.New("offset and limit must be non-negative integers")
+
+type OutputStore interface {
+	Read(callID string, offset int, limit int) (responseconverter.OutputPage, error)
+}
+
+type Resource struct {
+	*baseresource.Template
+}
+
+func New(
+	loggerFactory baseresource.LoggerFactory,
+	outputStore OutputStore,
+) *Resource {
+	return &Resource{
+		Template: baseresource.NewTemplate(
+			name,
+			title,
+			description,
+			mimeType,
+			uriTemplate,
+			loggerFactory,
+			Handler(outputStore),
+		),
+	}
+}
+
+func Handler(outputStore OutputStore) baseresource.TemplateHandler {
+	return func(_ context.Context, logger entities.Logger, uri string) (*baseresource.ReadResourceResult, error) {
+		callID, query, _ := strings.Cut(strings.TrimPrefix(uri, responseconverter.OutputURIPrefix), "?")
+
+		offset, limit, err := parsePage(query)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.
+			With("call-id", callID).
+			With("offset", offset).
+			With("limit", limit).
+			Info("Returning MATLAB output resource")
+
+		page, err := outputStore.Read(callID, offset, limit)
+		if errors.Is(err, responseconverter.ErrOutputNotFound) {
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     page.Text + footer(callID, page, limit),
+				},
+			},
+		}, nil
+	}
+}
+
+// parsePage reads the offset and limit from the query of the URI, using the first page when they are not given.
+func parsePage(query string) (int, int, error) {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return 0, 0, ErrInvalidQuery
+	}
+
+	offset, err := parseNonNegative(values.Get("offset"), 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	limit, err := parseNonNegative(values.Get("limit"), defaultPageBytes)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return offset, min(limit, maxPageBytes), nil
+}
+
+func parseNonNegative(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return 0, ErrInvalidQuery
+	}
+
+	return parsed, nil
+}
+
+// footer tells the client where the page sits in the output, and how to read the next one.
+func footer(callID string, page responseconverter.OutputPage, limit int) string {
+	if page.NextOffset >= page.TotalBytes {
+		return fmt.Sprintf(lastFooter, page.Offset, page.NextOffset, page.TotalBytes)
+	}
+
+	return fmt.Sprintf(pageFooter, page.Offset, page.NextOffset, page.TotalBytes, responseconverter.OutputURIPrefix, callID, page.NextOffset, limit)
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlaboutput_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlaboutput"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	baseresourcemocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources/matlaboutput"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	// Act
+	resource := matlaboutput.New(mockLoggerFactory, mockOutputStore)
+
+	// Assert
+	require.NotNil(t, resource)
+	assert.Equal(t, "matlab_output", resource.Name())
+	assert.Equal(t, "MATLAB Output", resource.Title())
+	assert.Equal(t, "text/plain", resource.MimeType())
+	assert.Equal(t, "matlab-output://{callID}{?offset,limit}", resource.URITemplate())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockOutputStore.EXPECT().
+		Read("abc", 100, 50).
+		Return(responseconverter.OutputPage{Text: "page", Offset: 100, NextOffset: 150, TotalBytes: 1000}, nil).
+		Once()
+
+	// Act
+	result, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, "matlab-output://abc?offset=100&limit=50")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t, "page\n\n[Bytes 100 to 150 of 1000. Next page: matlab-output://abc?offset=150&limit=50]", result.Contents[0].Text)
+}
+
+func TestHandler_LastPage(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockOutputStore.EXPECT().
+		Read("abc", 900, 200).
+		Return(responseconverter.OutputPage{Text: "end", Offset: 900, NextOffset: 1000, TotalBytes: 1000}, nil).
+		Once()
+
+	// Act
+	result, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, "matlab-output://abc?offset=900&limit=200")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "end\n\n[Bytes 900 to 1000 of 1000. End of output.]", result.Contents[0].Text)
+}
+
+func TestHandler_DefaultPage(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockOutputStore.EXPECT().
+		Read("abc", 0, 64*1024).
+		Return(responseconverter.OutputPage{Text: "all", NextOffset: 3, TotalBytes: 3}, nil).
+		Once()
+
+	// Act
+	_, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, "matlab-output://abc")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestHandler_LimitIsCapped(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockOutputStore.EXPECT().
+		Read("abc", 0, 1024*1024).
+		Return(responseconverter.OutputPage{Text: "all", NextOffset: 3, TotalBytes: 3}, nil).
+		Once()
+
+	// Act
+	_, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, "matlab-output://abc?limit=100000000")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestHandler_InvalidQuery(t *testing.T) {
+	testCases := []struct {
+		name string
+		uri  string
+	}{
+		{name: "negative offset", uri: "matlab-output://abc?offset=-1"},
+		{name: "non numeric limit", uri: "matlab-output://abc?limit=all"},
+		{name: "malformed query", uri: "matlab-output://abc?offset=%zz"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOutputStore := &mocks.MockOutputStore{}
+			defer mockOutputStore.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			// Act
+			result, err := matlaboutput.Handler(mockOutputStore)(t.Context(), mockLogger, tc.uri)
+
+			// Assert
+			require.ErrorIs(t, err, matlaboutput.ErrInvalidQuery)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestHandler_NotFound(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const uri = "matlab-output://evicted"
+
+	mockOutputStore.EXPECT().
+		Read("evicted", 0, 64*1024).
+		Return(responseconverter.OutputPage{}, responseconverter.ErrOutputNotFound).
+		Once()
+
+	// Act
+	result, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, uri)
+
+	// Assert
+	require.Error(t, err)
+	assert.Equal(t, mcp.ResourceNotFoundError(uri).Error(), err.Error())
+	assert.Nil(t, result)
+}
+
+func TestHandler_ReadError(t *testing.T) {
+	// Arrange
+	mockOutputStore := &mocks.MockOutputStore{}
+	defer mockOutputStore.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockOutputStore.EXPECT().
+		Read("abc", 5000, 64*1024).
+		Return(responseconverter.OutputPage{}, responseconverter.ErrInvalidPage).
+		Once()
+
+	// Act
+	result, err := matlaboutput.Handler(mockOutputStore)(ctx, mockLogger, "matlab-output://abc?offset=5000")
+
+	// Assert
+	require.ErrorIs(t, err, responseconverter.ErrInvalidPage)
+	assert.Nil(t, result)
+}
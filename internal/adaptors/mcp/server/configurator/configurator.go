@@ -7,19 +7,48 @@ import (
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts/toolprompt"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlaboutput"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
 	evalmatlabcodemultisession "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/closematlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/deleteworkspacesnapshot"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesession "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/getmatlabdebugstack"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listmatlabprojectfiles"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabprojectchecks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkgetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinklistblocks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkopenmodel"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkupdatediagram"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/snapshotworkspace"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/stepmatlabdebugger"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 )
 
@@ -46,6 +75,8 @@ type Configurator struct {
 	// Resources
 	codingGuidelinesResource            resources.Resource
 	plaintextlivecodegenerationResource resources.Resource
+	matlabHelpResource                  resources.Resource
+	matlabOutputResource                resources.Resource
 
 	// Custom tool dependencies
 	customToolFactory CustomToolFactory
@@ -65,10 +96,38 @@ func New(
 	checkMATLABCodeInGlobalMATLABSession *checkmatlabcode.Tool,
 	detectMATLABToolboxesInGlobalMATLABSessionTool *detectmatlabtoolboxes.Tool,
 	runMATLABFileInGlobalMATLABSessionTool *runmatlabfile.Tool,
+	runMATLABSectionsInGlobalMATLABSessionTool *runmatlabsections.Tool,
 	runMATLABTestFileInGlobalMATLABSessionTool *runmatlabtestfile.Tool,
+	setMATLABBreakpointInGlobalMATLABSessionTool *setmatlabbreakpoint.Tool,
+	clearMATLABBreakpointsInGlobalMATLABSessionTool *clearmatlabbreakpoints.Tool,
+	debugMATLABCodeInGlobalMATLABSessionTool *debugmatlabcode.Tool,
+	getMATLABDebugStackInGlobalMATLABSessionTool *getmatlabdebugstack.Tool,
+	stepMATLABDebuggerInGlobalMATLABSessionTool *stepmatlabdebugger.Tool,
+	profileMATLABCodeInGlobalMATLABSessionTool *profilematlabcode.Tool,
+	analyzeMATLABProjectInGlobalMATLABSessionTool *analyzematlabproject.Tool,
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool *analyzematlabdependencies.Tool,
+	convertLiveScriptInGlobalMATLABSessionTool *convertlivescript.Tool,
+	simulinkOpenModelInGlobalMATLABSessionTool *simulinkopenmodel.Tool,
+	simulinkListBlocksInGlobalMATLABSessionTool *simulinklistblocks.Tool,
+	simulinkGetBlockParamsInGlobalMATLABSessionTool *simulinkgetblockparams.Tool,
+	simulinkSetBlockParamsInGlobalMATLABSessionTool *simulinksetblockparams.Tool,
+	simulinkUpdateDiagramInGlobalMATLABSessionTool *simulinkupdatediagram.Tool,
+	simulinkSimInGlobalMATLABSessionTool *simulinksim.Tool,
+	openMATLABProjectInGlobalMATLABSessionTool *openmatlabproject.Tool,
+	closeMATLABProjectInGlobalMATLABSessionTool *closematlabproject.Tool,
+	listMATLABProjectFilesInGlobalMATLABSessionTool *listmatlabprojectfiles.Tool,
+	runMATLABProjectChecksInGlobalMATLABSessionTool *runmatlabprojectchecks.Tool,
+	snapshotWorkspaceInGlobalMATLABSessionTool *snapshotworkspace.Tool,
+	restoreWorkspaceInGlobalMATLABSessionTool *restoreworkspace.Tool,
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool *listworkspacesnapshots.Tool,
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool *deleteworkspacesnapshot.Tool,
+
+	matlabSessionStatusTool *matlabsessionstatus.Tool,
 
 	codingGuidelinesResource *codingguidelines.Resource,
 	plaintextlivecodegenerationResource *plaintextlivecodegeneration.Resource,
+	matlabHelpResource *matlabhelp.Resource,
+	matlabOutputResource *matlaboutput.Resource,
 
 	customToolFactory CustomToolFactory,
 ) *Configurator {
@@ -82,6 +141,7 @@ func New(
 			startMATLABSessionTool,
 			stopMATLABSessionTool,
 			evalInMATLABSessionTool,
+			matlabSessionStatusTool,
 		},
 
 		singleSessionTools: []tools.Tool{
@@ -89,11 +149,38 @@ func New(
 			checkMATLABCodeInGlobalMATLABSession,
 			detectMATLABToolboxesInGlobalMATLABSessionTool,
 			runMATLABFileInGlobalMATLABSessionTool,
+			runMATLABSectionsInGlobalMATLABSessionTool,
 			runMATLABTestFileInGlobalMATLABSessionTool,
+			setMATLABBreakpointInGlobalMATLABSessionTool,
+			clearMATLABBreakpointsInGlobalMATLABSessionTool,
+			debugMATLABCodeInGlobalMATLABSessionTool,
+			getMATLABDebugStackInGlobalMATLABSessionTool,
+			stepMATLABDebuggerInGlobalMATLABSessionTool,
+			profileMATLABCodeInGlobalMATLABSessionTool,
+			analyzeMATLABProjectInGlobalMATLABSessionTool,
+			analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+			convertLiveScriptInGlobalMATLABSessionTool,
+			simulinkOpenModelInGlobalMATLABSessionTool,
+			simulinkListBlocksInGlobalMATLABSessionTool,
+			simulinkGetBlockParamsInGlobalMATLABSessionTool,
+			simulinkSetBlockParamsInGlobalMATLABSessionTool,
+			simulinkUpdateDiagramInGlobalMATLABSessionTool,
+			simulinkSimInGlobalMATLABSessionTool,
+			openMATLABProjectInGlobalMATLABSessionTool,
+			closeMATLABProjectInGlobalMATLABSessionTool,
+			listMATLABProjectFilesInGlobalMATLABSessionTool,
+			runMATLABProjectChecksInGlobalMATLABSessionTool,
+			snapshotWorkspaceInGlobalMATLABSessionTool,
+			restoreWorkspaceInGlobalMATLABSessionTool,
+			listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+			deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+			matlabSessionStatusTool,
 		},
 
 		codingGuidelinesResource:            codingGuidelinesResource,
 		plaintextlivecodegenerationResource: plaintextlivecodegenerationResource,
+		matlabHelpResource:                  matlabHelpResource,
+		matlabOutputResource:                matlabOutputResource,
 
 		customToolFactory: customToolFactory,
 	}
@@ -173,13 +260,47 @@ func (c *Configurator) isBuiltInSingleSessionToolName(name string) bool {
 	return false
 }
 
+// GetPromptsToAdd returns a prompt for each tool that suggests values for its arguments,
+// since MCP only defines completions for the arguments of prompts and resource templates.
+func (c *Configurator) GetPromptsToAdd(toolsToAdd []tools.Tool) ([]prompts.Prompt, error) {
+	promptsToAdd := []prompts.Prompt{}
+
+	for _, tool := range toolsToAdd {
+		toolWithCompletions, ok := tool.(toolprompt.Tool)
+		if !ok {
+			continue
+		}
+
+		prompt, ok, err := toolprompt.New(toolWithCompletions)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		promptsToAdd = append(promptsToAdd, prompt)
+	}
+
+	return promptsToAdd, nil
+}
+
 func (c *Configurator) GetResourcesToAdd() []resources.Resource {
+	// Any tool can have its output truncated, so the full output is always available.
 	if !c.featuresProvider.Features().MATLAB.Enabled {
-		return []resources.Resource{}
+		return []resources.Resource{c.matlabOutputResource}
 	}
 
-	return []resources.Resource{
+	resourcesToAdd := []resources.Resource{
 		c.codingGuidelinesResource,
 		c.plaintextlivecodegenerationResource,
+		c.matlabOutputResource,
+	}
+
+	// The MATLAB help is read from the global MATLAB session, so it is only available in single session mode.
+	if cfg, err := c.configFactory.Config(); err == nil && cfg.UseSingleMATLABSession() {
+		resourcesToAdd = append(resourcesToAdd, c.matlabHelpResource)
 	}
+
+	return resourcesToAdd
 }
@@ -7,20 +7,48 @@ import (
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/pathcompleter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlaboutput"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
 	evalmatlabmultisession "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/closematlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/deleteworkspacesnapshot"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabsinglesession "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/getmatlabdebugstack"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listmatlabprojectfiles"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabprojectchecks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkgetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinklistblocks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkopenmodel"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkupdatediagram"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/snapshotworkspace"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/stepmatlabdebugger"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/server/configurator"
@@ -48,9 +76,36 @@ func TestNew_HappyPath(t *testing.T) {
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	// Act
 	result := configurator.New(
@@ -64,9 +119,36 @@ func TestNew_HappyPath(t *testing.T) {
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -96,9 +178,36 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -126,9 +235,36 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -142,6 +278,7 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		matlabSessionStatusTool,
 	}, "GetToolsToAdd should return all the injected tools for multi session")
 }
 
@@ -164,9 +301,36 @@ func TestConfigurator_GetToolsToAdd_ConfigError(t *testing.T) {
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedError := messages.AnError
 
@@ -191,9 +355,36 @@ func TestConfigurator_GetToolsToAdd_ConfigError(t *testing.T) {
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -227,9 +418,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -262,9 +480,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -277,8 +522,33 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
+		matlabSessionStatusTool,
 	}, "GetToolsToAdd should return all injected tools for single session")
 }
 
@@ -307,9 +577,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPat
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
 
@@ -353,9 +650,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithCustomTools_HappyPat
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -384,17 +708,46 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t
 	mockCustomTool := &toolsmocks.MockTool{}
 	defer mockCustomTool.AssertExpectations(t)
 
+	pathCompleter := pathcompleter.New(nil, nil, nil)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
-	evalInGlobalMATLABSessionTool := evalmatlabsinglesession.New(nil, nil, nil, nil)
-	checkMATLABCodeInGlobalMATLABSession := checkmatlabcode.New(nil, nil, nil)
-	detectMATLABToolboxesInSingleSessionTool := detectmatlabtoolboxes.New(nil, nil, nil)
-	runMATLABFileInGlobalMATLABSessionTool := runmatlabfile.New(nil, nil, nil, nil)
-	runMATLABTestFileInGlobalMATLABSessionTool := runmatlabtestfile.New(nil, nil, nil)
+	evalInGlobalMATLABSessionTool := evalmatlabsinglesession.New(nil, nil, nil, nil, nil)
+	checkMATLABCodeInGlobalMATLABSession := checkmatlabcode.New(nil, pathCompleter, nil, nil)
+	detectMATLABToolboxesInSingleSessionTool := detectmatlabtoolboxes.New(nil, nil, nil, nil, nil, nil)
+	runMATLABFileInGlobalMATLABSessionTool := runmatlabfile.New(nil, nil, pathCompleter, nil, nil, nil)
+	runMATLABSectionsInGlobalMATLABSessionTool := runmatlabsections.New(nil, nil, nil, nil, nil)
+	runMATLABTestFileInGlobalMATLABSessionTool := runmatlabtestfile.New(nil, nil, pathCompleter, nil, nil)
+	setMATLABBreakpointInGlobalMATLABSessionTool := setmatlabbreakpoint.New(nil, nil, pathCompleter, nil, nil)
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := clearmatlabbreakpoints.New(nil, nil, nil, nil)
+	debugMATLABCodeInGlobalMATLABSessionTool := debugmatlabcode.New(nil, nil, nil, nil)
+	getMATLABDebugStackInGlobalMATLABSessionTool := getmatlabdebugstack.New(nil, nil, nil)
+	stepMATLABDebuggerInGlobalMATLABSessionTool := stepmatlabdebugger.New(nil, nil, nil, nil)
+	profileMATLABCodeInGlobalMATLABSessionTool := profilematlabcode.New(nil, nil, nil, nil)
+	analyzeMATLABProjectInGlobalMATLABSessionTool := analyzematlabproject.New(nil, nil, nil, nil)
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := analyzematlabdependencies.New(nil, nil, nil)
+	convertLiveScriptInGlobalMATLABSessionTool := convertlivescript.New(nil, nil, pathCompleter, nil, nil)
+	simulinkOpenModelInGlobalMATLABSessionTool := simulinkopenmodel.New(nil, nil, nil, nil)
+	simulinkListBlocksInGlobalMATLABSessionTool := simulinklistblocks.New(nil, nil, nil)
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := simulinkgetblockparams.New(nil, nil, nil)
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := simulinksetblockparams.New(nil, nil, nil, nil)
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := simulinkupdatediagram.New(nil, nil, nil, nil)
+	simulinkSimInGlobalMATLABSessionTool := simulinksim.New(nil, nil, nil, nil)
+	openMATLABProjectInGlobalMATLABSessionTool := openmatlabproject.New(nil, nil, pathCompleter, nil, nil)
+	closeMATLABProjectInGlobalMATLABSessionTool := closematlabproject.New(nil, nil, nil, nil)
+	listMATLABProjectFilesInGlobalMATLABSessionTool := listmatlabprojectfiles.New(nil, nil, nil)
+	runMATLABProjectChecksInGlobalMATLABSessionTool := runmatlabprojectchecks.New(nil, nil, nil)
+	snapshotWorkspaceInGlobalMATLABSessionTool := snapshotworkspace.New(nil, nil, nil, nil)
+	restoreWorkspaceInGlobalMATLABSessionTool := restoreworkspace.New(nil, nil, nil, nil)
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := listworkspacesnapshots.New(nil, nil, nil)
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := deleteworkspacesnapshot.New(nil, nil, nil, nil)
+	matlabSessionStatusTool := matlabsessionstatus.New(nil, nil)
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
 	expectedConflictingToolName := "evaluate_matlab_code"
@@ -439,9 +792,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CustomToolNameConflict(t
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -485,9 +865,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CrossFileNameCollision(t
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedFilePathA := filepath.Join("config", "toolbox_a.json")
 	expectedFilePathB := filepath.Join("config", "toolbox_b.json")
@@ -544,9 +951,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_CrossFileNameCollision(t
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -591,9 +1025,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithMultipleExtensionFil
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedFilePathA := filepath.Join("config", "toolbox_a.json")
 	expectedFilePathB := filepath.Join("config", "toolbox_b.json")
@@ -649,9 +1110,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_WithMultipleExtensionFil
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -686,9 +1174,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedExtensionFilePath := filepath.Join("config", "tools.json")
 	expectedError := messages.AnError
@@ -729,9 +1244,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderError(t *testing.T
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -768,9 +1310,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderErrorOnSecondFile(
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	expectedFilePathA := filepath.Join("config", "toolbox_a.json")
 	expectedFilePathB := filepath.Join("config", "toolbox_b.json")
@@ -822,9 +1391,36 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderErrorOnSecondFile(
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -836,6 +1432,118 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_LoaderErrorOnSecondFile(
 	assert.Nil(t, toolsToAdd, "Tools should be nil when loader error occurs on second file")
 }
 
+func TestConfigurator_GetPromptsToAdd_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
+		mockCustomToolFactory,
+	)
+
+	pathCompleter := pathcompleter.New(nil, nil, nil)
+	toolWithPathCompletions := runmatlabfile.New(nil, nil, pathCompleter, nil, nil, nil)
+	toolWithEnumCompletions := stepmatlabdebugger.New(nil, nil, nil, nil)
+	toolWithoutCompletions := evalmatlabsinglesession.New(nil, nil, nil, nil, nil)
+
+	mockTool := &toolsmocks.MockTool{}
+	defer mockTool.AssertExpectations(t)
+
+	// Act
+	result, err := c.GetPromptsToAdd([]tools.Tool{toolWithPathCompletions, toolWithoutCompletions, mockTool, toolWithEnumCompletions})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result, 2, "Only tools that complete their arguments should have a prompt")
+	assert.Equal(t, toolWithPathCompletions.Name(), result[0].Name())
+	assert.Equal(t, toolWithEnumCompletions.Name(), result[1].Name())
+}
+
 func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
@@ -847,6 +1555,128 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
 	defer mockCustomToolFactory.AssertExpectations(t)
 
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
+		mockCustomToolFactory,
+	)
+
+	// Act
+	result := c.GetResourcesToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource, matlabOutputResource, matlabHelpResource}, result)
+}
+
+func TestConfigurator_GetResourcesToAdd_MultipleMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
@@ -855,15 +1685,163 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
 		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
 		Once()
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	c := configurator.New(
+		mockConfigFactory,
+		mockApplicationDefinition,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
+		codingGuidelinesResource,
+		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
+		mockCustomToolFactory,
+	)
+
+	// Act
+	result := c.GetResourcesToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource, matlabOutputResource}, result)
+}
+
+func TestConfigurator_GetResourcesToAdd_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockApplicationDefinition := &mocks.MockApplicationDefinition{}
+	defer mockApplicationDefinition.AssertExpectations(t)
+
+	mockCustomToolFactory := &mocks.MockCustomToolFactory{}
+	defer mockCustomToolFactory.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
+
+	mockApplicationDefinition.EXPECT().
+		Features().
+		Return(definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, messages.AnError).
+		Once()
+
 	c := configurator.New(
 		mockConfigFactory,
 		mockApplicationDefinition,
@@ -875,9 +1853,36 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -885,7 +1890,7 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	result := c.GetResourcesToAdd()
 
 	// Assert
-	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource}, result)
+	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, plaintextlivecodegenerationResource, matlabOutputResource}, result)
 }
 
 func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
@@ -907,9 +1912,36 @@ func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -927,9 +1959,36 @@ func TestConfigurator_GetToolsToAdd_MATLABFeatureDisabled(t *testing.T) {
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -960,9 +2019,36 @@ func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABSectionsInGlobalMATLABSessionTool := &runmatlabsections.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	setMATLABBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearMATLABBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugMATLABCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	getMATLABDebugStackInGlobalMATLABSessionTool := &getmatlabdebugstack.Tool{}
+	stepMATLABDebuggerInGlobalMATLABSessionTool := &stepmatlabdebugger.Tool{}
+	profileMATLABCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	analyzeMATLABProjectInGlobalMATLABSessionTool := &analyzematlabproject.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependencies.Tool{}
+	convertLiveScriptInGlobalMATLABSessionTool := &convertlivescript.Tool{}
+	simulinkOpenModelInGlobalMATLABSessionTool := &simulinkopenmodel.Tool{}
+	simulinkListBlocksInGlobalMATLABSessionTool := &simulinklistblocks.Tool{}
+	simulinkGetBlockParamsInGlobalMATLABSessionTool := &simulinkgetblockparams.Tool{}
+	simulinkSetBlockParamsInGlobalMATLABSessionTool := &simulinksetblockparams.Tool{}
+	simulinkUpdateDiagramInGlobalMATLABSessionTool := &simulinkupdatediagram.Tool{}
+	simulinkSimInGlobalMATLABSessionTool := &simulinksim.Tool{}
+	openMATLABProjectInGlobalMATLABSessionTool := &openmatlabproject.Tool{}
+	closeMATLABProjectInGlobalMATLABSessionTool := &closematlabproject.Tool{}
+	listMATLABProjectFilesInGlobalMATLABSessionTool := &listmatlabprojectfiles.Tool{}
+	runMATLABProjectChecksInGlobalMATLABSessionTool := &runmatlabprojectchecks.Tool{}
+	snapshotWorkspaceInGlobalMATLABSessionTool := &snapshotworkspace.Tool{}
+	restoreWorkspaceInGlobalMATLABSessionTool := &restoreworkspace.Tool{}
+	listWorkspaceSnapshotsInGlobalMATLABSessionTool := &listworkspacesnapshots.Tool{}
+	deleteWorkspaceSnapshotInGlobalMATLABSessionTool := &deleteworkspacesnapshot.Tool{}
+	matlabSessionStatusTool := &matlabsessionstatus.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	plaintextlivecodegenerationResource := &plaintextlivecodegeneration.Resource{}
+	matlabHelpResource := &matlabhelp.Resource{}
+	matlabOutputResource := &matlaboutput.Resource{}
 
 	mockApplicationDefinition.EXPECT().
 		Features().
@@ -980,9 +2066,36 @@ func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABSectionsInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		setMATLABBreakpointInGlobalMATLABSessionTool,
+		clearMATLABBreakpointsInGlobalMATLABSessionTool,
+		debugMATLABCodeInGlobalMATLABSessionTool,
+		getMATLABDebugStackInGlobalMATLABSessionTool,
+		stepMATLABDebuggerInGlobalMATLABSessionTool,
+		profileMATLABCodeInGlobalMATLABSessionTool,
+		analyzeMATLABProjectInGlobalMATLABSessionTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		convertLiveScriptInGlobalMATLABSessionTool,
+		simulinkOpenModelInGlobalMATLABSessionTool,
+		simulinkListBlocksInGlobalMATLABSessionTool,
+		simulinkGetBlockParamsInGlobalMATLABSessionTool,
+		simulinkSetBlockParamsInGlobalMATLABSessionTool,
+		simulinkUpdateDiagramInGlobalMATLABSessionTool,
+		simulinkSimInGlobalMATLABSessionTool,
+		openMATLABProjectInGlobalMATLABSessionTool,
+		closeMATLABProjectInGlobalMATLABSessionTool,
+		listMATLABProjectFilesInGlobalMATLABSessionTool,
+		runMATLABProjectChecksInGlobalMATLABSessionTool,
+		snapshotWorkspaceInGlobalMATLABSessionTool,
+		restoreWorkspaceInGlobalMATLABSessionTool,
+		listWorkspaceSnapshotsInGlobalMATLABSessionTool,
+		deleteWorkspaceSnapshotInGlobalMATLABSessionTool,
+		matlabSessionStatusTool,
 		codingGuidelinesResource,
 		plaintextlivecodegenerationResource,
+		matlabHelpResource,
+		matlabOutputResource,
 		mockCustomToolFactory,
 	)
 
@@ -990,5 +2103,5 @@ func TestConfigurator_GetResourcesToAdd_MATLABFeatureDisabled(t *testing.T) {
 	result := c.GetResourcesToAdd()
 
 	// Assert
-	assert.Empty(t, result)
+	assert.Equal(t, []resources.Resource{matlabOutputResource}, result, "Only the MATLAB output resource should be added")
 }
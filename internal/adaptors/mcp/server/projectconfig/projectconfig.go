@@ -0,0 +1,122 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package projectconfig finds the configuration file of a project in the roots of the MCP client.
+//
+// A project comes from the client, so the file can only set the limits of MATLAB sessions and tools. The server ignores
+// the other keys of the file, and logs each setting that the file changes.
+package projectconfig
+
+import (
+	"path/filepath"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// FileNames are the names of a project configuration file, in order of preference.
+func FileNames() []string {
+	return []string{".matlab-mcp-server.yaml", ".matlab-mcp-server.yml", ".matlab-mcp-server.toml"}
+}
+
+type RootStore interface {
+	UpdateRoots(roots []*mcp.Root)
+	GetRoots() []entities.MCPRoot
+}
+
+type RootPathResolver interface {
+	Resolve(root entities.MCPRoot) (string, error)
+}
+
+type ConfigFactory interface {
+	UseProjectConfigFile(path string) ([]entities.ConfigFileSetting, messages.Error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() (entities.Logger, messages.Error)
+}
+
+// ProjectConfig updates the roots of the root store, and then uses the configuration file of the first root that has one.
+type ProjectConfig struct {
+	rootStore        RootStore
+	rootPathResolver RootPathResolver
+	configFactory    ConfigFactory
+	osLayer          OSLayer
+	loggerFactory    LoggerFactory
+}
+
+func New(
+	rootStore RootStore,
+	rootPathResolver RootPathResolver,
+	configFactory ConfigFactory,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+) *ProjectConfig {
+	return &ProjectConfig{
+		rootStore:        rootStore,
+		rootPathResolver: rootPathResolver,
+		configFactory:    configFactory,
+		osLayer:          osLayer,
+		loggerFactory:    loggerFactory,
+	}
+}
+
+func (p *ProjectConfig) UpdateRoots(roots []*mcp.Root) {
+	p.rootStore.UpdateRoots(roots)
+
+	logger, messagesErr := p.loggerFactory.GetGlobalLogger()
+	if messagesErr != nil {
+		return
+	}
+
+	projectConfigFile := p.findConfigFile(logger)
+
+	settings, messagesErr := p.configFactory.UseProjectConfigFile(projectConfigFile)
+	if messagesErr != nil {
+		logger.
+			With("path", projectConfigFile).
+			WithError(messagesErr).
+			Warn("Failed to use project configuration file, keeping the current configuration")
+		return
+	}
+
+	if projectConfigFile == "" {
+		return
+	}
+
+	fileLogger := logger.With("path", projectConfigFile)
+	fileLogger.Info("Using project configuration file")
+
+	for _, setting := range settings {
+		if setting.Ignored {
+			fileLogger.With("key", setting.Key).Warn("Ignoring a setting that a project configuration file cannot change")
+			continue
+		}
+
+		fileLogger.With("key", setting.Key).With("value", setting.Value).Info("Project configuration file overrides a setting")
+	}
+}
+
+func (p *ProjectConfig) findConfigFile(logger entities.Logger) string {
+	for _, root := range p.rootStore.GetRoots() {
+		rootPath, err := p.rootPathResolver.Resolve(root)
+		if err != nil {
+			logger.With("uri", root.URI()).WithError(err).Debug("Skipping root when looking for a project configuration file")
+			continue
+		}
+
+		for _, fileName := range FileNames() {
+			path := filepath.Join(rootPath, fileName)
+			if info, err := p.osLayer.Stat(path); err == nil && !info.IsDir() {
+				return path
+			}
+		}
+	}
+
+	return ""
+}
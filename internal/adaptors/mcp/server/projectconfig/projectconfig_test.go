@@ -0,0 +1,312 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package projectconfig_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/projectconfig"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/server/projectconfig"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type projectConfigMocks struct {
+	rootStore        *mocks.MockRootStore
+	rootPathResolver *mocks.MockRootPathResolver
+	configFactory    *mocks.MockConfigFactory
+	osLayer          *mocks.MockOSLayer
+	loggerFactory    *mocks.MockLoggerFactory
+}
+
+func newProjectConfigMocks(t *testing.T) projectConfigMocks {
+	t.Helper()
+
+	m := projectConfigMocks{
+		rootStore:        &mocks.MockRootStore{},
+		rootPathResolver: &mocks.MockRootPathResolver{},
+		configFactory:    &mocks.MockConfigFactory{},
+		osLayer:          &mocks.MockOSLayer{},
+		loggerFactory:    &mocks.MockLoggerFactory{},
+	}
+	t.Cleanup(func() {
+		m.rootStore.AssertExpectations(t)
+		m.rootPathResolver.AssertExpectations(t)
+		m.configFactory.AssertExpectations(t)
+		m.osLayer.AssertExpectations(t)
+		m.loggerFactory.AssertExpectations(t)
+	})
+	return m
+}
+
+func (m projectConfigMocks) newProjectConfig() *projectconfig.ProjectConfig {
+	return projectconfig.New(m.rootStore, m.rootPathResolver, m.configFactory, m.osLayer, m.loggerFactory)
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	m := newProjectConfigMocks(t)
+
+	// Act
+	projectConfig := m.newProjectConfig()
+
+	// Assert
+	assert.NotNil(t, projectConfig)
+}
+
+func TestProjectConfig_UpdateRoots_UsesConfigFileOfFirstRootThatHasOne(t *testing.T) {
+	// Arrange
+	m := newProjectConfigMocks(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	roots := []*mcp.Root{
+		{URI: "https://example.com/project"},
+		{URI: "file:///home/user/docs"},
+		{URI: "file:///home/user/project"},
+	}
+	storedRoots := []entities.MCPRoot{
+		entities.NewMCPRoot(roots[0].URI, ""),
+		entities.NewMCPRoot(roots[1].URI, ""),
+		entities.NewMCPRoot(roots[2].URI, ""),
+	}
+	docsFolder := filepath.FromSlash("/home/user/docs")
+	projectFolder := filepath.FromSlash("/home/user/project")
+	expectedConfigFile := filepath.Join(projectFolder, ".matlab-mcp-server.yml")
+
+	m.rootStore.EXPECT().
+		UpdateRoots(roots).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	m.rootStore.EXPECT().
+		GetRoots().
+		Return(storedRoots).
+		Once()
+
+	m.rootPathResolver.EXPECT().
+		Resolve(storedRoots[0]).
+		Return("", assert.AnError).
+		Once()
+
+	m.rootPathResolver.EXPECT().
+		Resolve(storedRoots[1]).
+		Return(docsFolder, nil).
+		Once()
+
+	m.rootPathResolver.EXPECT().
+		Resolve(storedRoots[2]).
+		Return(projectFolder, nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(filepath.Join(docsFolder, ".matlab-mcp-server.yaml")).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(filepath.Join(docsFolder, ".matlab-mcp-server.yml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(filepath.Join(docsFolder, ".matlab-mcp-server.toml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(filepath.Join(projectFolder, ".matlab-mcp-server.yaml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(expectedConfigFile).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	m.configFactory.EXPECT().
+		UseProjectConfigFile(expectedConfigFile).
+		Return([]entities.ConfigFileSetting{
+			{Key: "matlab-idle-timeout", Value: "30m0s"},
+			{Key: "matlab-root", Ignored: true},
+		}, nil).
+		Once()
+
+	projectConfig := m.newProjectConfig()
+
+	// Act
+	projectConfig.UpdateRoots(roots)
+
+	// Assert
+	fields, found := mockLogger.InfoLogs()["Using project configuration file"]
+	require.True(t, found)
+	assert.Equal(t, expectedConfigFile, fields["path"])
+
+	fields, found = mockLogger.InfoLogs()["Project configuration file overrides a setting"]
+	require.True(t, found)
+	assert.Equal(t, expectedConfigFile, fields["path"])
+	assert.Equal(t, "matlab-idle-timeout", fields["key"])
+	assert.Equal(t, "30m0s", fields["value"])
+
+	fields, found = mockLogger.WarnLogs()["Ignoring a setting that a project configuration file cannot change"]
+	require.True(t, found)
+	assert.Equal(t, "matlab-root", fields["key"])
+}
+
+func TestProjectConfig_UpdateRoots_NoConfigFile(t *testing.T) {
+	// Arrange
+	m := newProjectConfigMocks(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	roots := []*mcp.Root{{URI: "file:///home/user/project"}}
+	storedRoots := []entities.MCPRoot{entities.NewMCPRoot(roots[0].URI, "")}
+	projectFolder := filepath.FromSlash("/home/user/project")
+
+	m.rootStore.EXPECT().
+		UpdateRoots(roots).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	m.rootStore.EXPECT().
+		GetRoots().
+		Return(storedRoots).
+		Once()
+
+	m.rootPathResolver.EXPECT().
+		Resolve(storedRoots[0]).
+		Return(projectFolder, nil).
+		Once()
+
+	for _, fileName := range projectconfig.FileNames() {
+		m.osLayer.EXPECT().
+			Stat(filepath.Join(projectFolder, fileName)).
+			Return(nil, assert.AnError).
+			Once()
+	}
+
+	m.configFactory.EXPECT().
+		UseProjectConfigFile("").
+		Return(nil, nil).
+		Once()
+
+	projectConfig := m.newProjectConfig()
+
+	// Act
+	projectConfig.UpdateRoots(roots)
+
+	// Assert
+	assert.Empty(t, mockLogger.InfoLogs())
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestProjectConfig_UpdateRoots_InvalidConfigFileKeepsConfig(t *testing.T) {
+	// Arrange
+	m := newProjectConfigMocks(t)
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	roots := []*mcp.Root{{URI: "file:///home/user/project"}}
+	storedRoots := []entities.MCPRoot{entities.NewMCPRoot(roots[0].URI, "")}
+	projectFolder := filepath.FromSlash("/home/user/project")
+	expectedConfigFile := filepath.Join(projectFolder, ".matlab-mcp-server.yaml")
+
+	m.rootStore.EXPECT().
+		UpdateRoots(roots).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	m.rootStore.EXPECT().
+		GetRoots().
+		Return(storedRoots).
+		Once()
+
+	m.rootPathResolver.EXPECT().
+		Resolve(storedRoots[0]).
+		Return(projectFolder, nil).
+		Once()
+
+	m.osLayer.EXPECT().
+		Stat(expectedConfigFile).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	m.configFactory.EXPECT().
+		UseProjectConfigFile(expectedConfigFile).
+		Return(nil, messages.AnError).
+		Once()
+
+	projectConfig := m.newProjectConfig()
+
+	// Act
+	projectConfig.UpdateRoots(roots)
+
+	// Assert
+	fields, found := mockLogger.WarnLogs()["Failed to use project configuration file, keeping the current configuration"]
+	require.True(t, found)
+	assert.Equal(t, expectedConfigFile, fields["path"])
+	assert.Empty(t, mockLogger.InfoLogs())
+}
+
+func TestProjectConfig_UpdateRoots_LoggerError(t *testing.T) {
+	// Arrange
+	m := newProjectConfigMocks(t)
+
+	roots := []*mcp.Root{{URI: "file:///home/user/project"}}
+
+	m.rootStore.EXPECT().
+		UpdateRoots(roots).
+		Once()
+
+	m.loggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(nil, messages.AnError).
+		Once()
+
+	projectConfig := m.newProjectConfig()
+
+	// Act
+	projectConfig.UpdateRoots(roots)
+
+	// Assert
+	// The roots are still updated, and no configuration is read without a logger to report problems.
+}
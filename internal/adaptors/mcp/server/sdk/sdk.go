@@ -39,10 +39,26 @@ type GlobalMATLAB interface {
 	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
 }
 
+type MATLABSessionPoolWarmer interface {
+	Warm(ctx context.Context, logger entities.Logger) error
+}
+
 type TelemetryFactory interface {
 	Telemetry() (telemetry.Telemetry, messages.Error)
 }
 
+type AuditLog interface {
+	Middleware(logger entities.Logger) (mcp.Middleware, messages.Error)
+}
+
+type OutputLimiter interface {
+	Middleware(logger entities.Logger, maxBytes int) mcp.Middleware
+}
+
+type Completer interface {
+	Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error)
+}
+
 type MCPSession interface {
 	InitializeParams() *mcp.InitializeParams
 	ListRoots(ctx context.Context, params *mcp.ListRootsParams) (*mcp.ListRootsResult, error)
@@ -55,6 +71,10 @@ type Factory struct {
 	loggerFactory    LoggerFactory
 	globalMATLAB     GlobalMATLAB
 	telemetryFactory TelemetryFactory
+	poolWarmer       MATLABSessionPoolWarmer
+	auditLog         AuditLog
+	completer        Completer
+	outputLimiter    OutputLimiter
 }
 
 type serverCallbackHandler struct {
@@ -64,6 +84,7 @@ type serverCallbackHandler struct {
 	rootStore    RootStore
 	globalMATLAB GlobalMATLAB
 	telemetry    telemetry.Telemetry
+	poolWarmer   MATLABSessionPoolWarmer
 }
 
 func NewFactory(
@@ -73,6 +94,10 @@ func NewFactory(
 	loggerFactory LoggerFactory,
 	globalMATLAB GlobalMATLAB,
 	telemetryFactory TelemetryFactory,
+	poolWarmer MATLABSessionPoolWarmer,
+	auditLog AuditLog,
+	completer Completer,
+	outputLimiter OutputLimiter,
 ) *Factory {
 	return &Factory{
 		configFactory:    configFactory,
@@ -81,10 +106,16 @@ func NewFactory(
 		loggerFactory:    loggerFactory,
 		globalMATLAB:     globalMATLAB,
 		telemetryFactory: telemetryFactory,
+		poolWarmer:       poolWarmer,
+		auditLog:         auditLog,
+		completer:        completer,
+		outputLimiter:    outputLimiter,
 	}
 }
 
-func (f *Factory) NewServer() (*mcp.Server, messages.Error) {
+// NewServer creates the MCP server. The tool middleware of the SDK runs inside the audit log and the output limiter,
+// so that calls that it answers itself are audited, and the output that it returns is limited.
+func (f *Factory) NewServer(toolMiddleware []mcp.Middleware) (*mcp.Server, messages.Error) {
 	cfg, err := f.configFactory.Config()
 	if err != nil {
 		return nil, err
@@ -100,6 +131,11 @@ func (f *Factory) NewServer() (*mcp.Server, messages.Error) {
 		return nil, err
 	}
 
+	auditMiddleware, err := f.auditLog.Middleware(logger)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &serverCallbackHandler{
 		config:       cfg,
 		logger:       logger,
@@ -107,6 +143,7 @@ func (f *Factory) NewServer() (*mcp.Server, messages.Error) {
 		rootStore:    f.rootStore,
 		globalMATLAB: f.globalMATLAB,
 		telemetry:    tel,
+		poolWarmer:   f.poolWarmer,
 	}
 
 	impl := &mcp.Implementation{
@@ -118,9 +155,21 @@ func (f *Factory) NewServer() (*mcp.Server, messages.Error) {
 		Instructions:            f.definition.Instructions(),
 		InitializedHandler:      s.handleInitialized,
 		RootsListChangedHandler: s.handleRootsListChanged,
+		CompletionHandler:       f.completer.Complete,
 	}
 
-	return mcp.NewServer(impl, options), nil
+	server := mcp.NewServer(impl, options)
+	server.AddReceivingMiddleware(
+		append(
+			[]mcp.Middleware{
+				auditMiddleware,
+				f.outputLimiter.Middleware(logger, cfg.MaxToolOutputBytes()),
+			},
+			toolMiddleware...,
+		)...,
+	)
+
+	return server, nil
 }
 
 func (s *serverCallbackHandler) handleInitialized(ctx context.Context, req *mcp.InitializedRequest) {
@@ -138,19 +187,34 @@ func (s *serverCallbackHandler) handleInitialized(ctx context.Context, req *mcp.
 			Warn("failed to update MCP roots, using fallback starting folder")
 	}
 
-	matlabEnabled := s.features.MATLAB.Enabled
-	if matlabEnabled && s.config.UseSingleMATLABSession() && s.config.InitializeMATLABOnStartup() {
-		go func() {
-			s.logger.Debug("Eagerly initializing MATLAB")
+	if !s.features.MATLAB.Enabled {
+		return
+	}
 
-			startMATLABCtx := context.WithoutCancel(ctx)
-			if _, err := s.globalMATLAB.Client(startMATLABCtx, s.logger); err != nil {
-				s.logger.
-					WithError(err).
-					Warn("MATLAB eager initialization failed")
-			}
-		}()
+	if s.config.UseSingleMATLABSession() {
+		if s.config.InitializeMATLABOnStartup() {
+			go func() {
+				s.logger.Debug("Eagerly initializing MATLAB")
+
+				startMATLABCtx := context.WithoutCancel(ctx)
+				if _, err := s.globalMATLAB.Client(startMATLABCtx, s.logger); err != nil {
+					s.logger.
+						WithError(err).
+						Warn("MATLAB eager initialization failed")
+				}
+			}()
+		}
+		return
 	}
+
+	go func() {
+		warmPoolCtx := context.WithoutCancel(ctx)
+		if err := s.poolWarmer.Warm(warmPoolCtx, s.logger); err != nil {
+			s.logger.
+				WithError(err).
+				Warn("Starting MATLAB sessions in advance failed")
+		}
+	}()
 }
 
 func (s *serverCallbackHandler) handleRootsListChanged(ctx context.Context, req *mcp.RootsListChangedRequest) {
@@ -12,8 +12,8 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-func HandleInitialized(cfg config.Config, logger entities.Logger, features definition.Features, rs RootStore, gm GlobalMATLAB, tel telemetry.Telemetry) func(context.Context, *mcp.InitializedRequest) {
-	s := &serverCallbackHandler{config: cfg, logger: logger, features: features, rootStore: rs, globalMATLAB: gm, telemetry: tel}
+func HandleInitialized(cfg config.Config, logger entities.Logger, features definition.Features, rs RootStore, gm GlobalMATLAB, tel telemetry.Telemetry, pw MATLABSessionPoolWarmer) func(context.Context, *mcp.InitializedRequest) {
+	s := &serverCallbackHandler{config: cfg, logger: logger, features: features, rootStore: rs, globalMATLAB: gm, telemetry: tel, poolWarmer: pw}
 	return s.handleInitialized
 }
 
@@ -4,6 +4,7 @@ package sdk_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,8 +43,17 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
 	defer mockTelemetryFactory.AssertExpectations(t)
 
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
 	// Act
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
 
 	// Assert
 	assert.NotNil(t, factory, "Factory should not be nil")
@@ -72,6 +82,15 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
 	defer mockTelemetryFactory.AssertExpectations(t)
 
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
@@ -80,6 +99,7 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 	expectedName := "test-server"
 	expectedTitle := "Test Server"
 	expectedInstructions := "test instructions"
+	expectedMaxToolOutputBytes := 2048
 
 	mockConfigFactory.EXPECT().
 		Config().
@@ -96,6 +116,21 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 		Return(mockTelemetry, nil).
 		Once()
 
+	mockAuditLog.EXPECT().
+		Middleware(mockLogger).
+		Return(func(next mcp.MethodHandler) mcp.MethodHandler { return next }, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxToolOutputBytes().
+		Return(expectedMaxToolOutputBytes).
+		Once()
+
+	mockOutputLimiter.EXPECT().
+		Middleware(mockLogger, expectedMaxToolOutputBytes).
+		Return(func(next mcp.MethodHandler) mcp.MethodHandler { return next }).
+		Once()
+
 	mockConfig.EXPECT().
 		Version().
 		Return(expectedVersion).
@@ -121,16 +156,327 @@ func TestFactory_NewServer_HappyPath(t *testing.T) {
 		Return(expectedInstructions).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
 
 	// Act
-	server, err := factory.NewServer()
+	server, err := factory.NewServer(nil)
 
 	// Assert
 	require.NoError(t, err, "NewServer should not return an error")
 	assert.NotNil(t, server, "Server should not be nil")
 }
 
+func TestFactory_NewServer_CompletionHandler(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDefinition := &mocks.MockDefinition{}
+	defer mockDefinition.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedVersion := "1.0.0"
+	expectedName := "test-server"
+	expectedTitle := "Test Server"
+	expectedInstructions := "test instructions"
+	expectedMaxToolOutputBytes := 2048
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockAuditLog.EXPECT().
+		Middleware(mockLogger).
+		Return(func(next mcp.MethodHandler) mcp.MethodHandler { return next }, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxToolOutputBytes().
+		Return(expectedMaxToolOutputBytes).
+		Once()
+
+	mockOutputLimiter.EXPECT().
+		Middleware(mockLogger, expectedMaxToolOutputBytes).
+		Return(func(next mcp.MethodHandler) mcp.MethodHandler { return next }).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return(expectedVersion).
+		Once()
+
+	mockDefinition.EXPECT().
+		Features().
+		Return(definition.Features{}).
+		Once()
+
+	mockDefinition.EXPECT().
+		Name().
+		Return(expectedName).
+		Once()
+
+	mockDefinition.EXPECT().
+		Title().
+		Return(expectedTitle).
+		Once()
+
+	mockDefinition.EXPECT().
+		Instructions().
+		Return(expectedInstructions).
+		Once()
+
+	ctx := t.Context()
+	expectedResult := &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{Values: []string{"plot", "plot3"}, Total: 2},
+	}
+
+	// The client connection is also initialized, which happens concurrently with the completion request.
+	mockTelemetry.EXPECT().
+		RecordClientConnection(mock.Anything, mock.Anything).
+		Return().
+		Maybe()
+
+	mockRootStore.EXPECT().
+		UpdateRoots(mock.Anything).
+		Return().
+		Maybe()
+
+	mockCompleter.EXPECT().
+		Complete(mock.Anything, mock.MatchedBy(func(req *mcp.CompleteRequest) bool {
+			return req.Params.Ref.URI == "matlab-help://{name}" && req.Params.Argument.Name == "name"
+		})).
+		Return(expectedResult, nil).
+		Once()
+
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
+
+	server, messagesErr := factory.NewServer(nil)
+	require.Nil(t, messagesErr)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Act
+	result, err := clientSession.Complete(ctx, &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlab-help://{name}"},
+		Argument: mcp.CompleteParamsArgument{Name: "name", Value: "plo"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult.Completion, result.Completion)
+	assert.NotNil(t, clientSession.InitializeResult().Capabilities.Completions, "Server should advertise the completions capability")
+}
+
+func TestFactory_NewServer_ToolMiddlewareRunsInsideAuditAndOutputLimiter(t *testing.T) {
+	// Arrange
+	recorder := &toolCallRecorder{}
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDefinition := &mocks.MockDefinition{}
+	defer mockDefinition.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedVersion := "1.0.0"
+	expectedName := "test-server"
+	expectedTitle := "Test Server"
+	expectedInstructions := "test instructions"
+	expectedMaxToolOutputBytes := 2048
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockAuditLog.EXPECT().
+		Middleware(mockLogger).
+		Return(recorder.middleware("audit"), nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxToolOutputBytes().
+		Return(expectedMaxToolOutputBytes).
+		Once()
+
+	mockOutputLimiter.EXPECT().
+		Middleware(mockLogger, expectedMaxToolOutputBytes).
+		Return(recorder.middleware("output-limiter")).
+		Once()
+
+	mockConfig.EXPECT().
+		Version().
+		Return(expectedVersion).
+		Once()
+
+	mockDefinition.EXPECT().
+		Features().
+		Return(definition.Features{}).
+		Once()
+
+	mockDefinition.EXPECT().
+		Name().
+		Return(expectedName).
+		Once()
+
+	mockDefinition.EXPECT().
+		Title().
+		Return(expectedTitle).
+		Once()
+
+	mockDefinition.EXPECT().
+		Instructions().
+		Return(expectedInstructions).
+		Once()
+
+	ctx := t.Context()
+
+	// The client connection is also initialized, which happens concurrently with the tool call.
+	mockTelemetry.EXPECT().
+		RecordClientConnection(mock.Anything, mock.Anything).
+		Return().
+		Maybe()
+
+	mockRootStore.EXPECT().
+		UpdateRoots(mock.Anything).
+		Return().
+		Maybe()
+
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
+
+	server, messagesErr := factory.NewServer([]mcp.Middleware{recorder.middleware("first"), recorder.middleware("second")})
+	require.Nil(t, messagesErr)
+
+	mcp.AddTool(server, &mcp.Tool{Name: "test-tool"}, func(_ context.Context, _ *mcp.CallToolRequest, _ struct{}) (*mcp.CallToolResult, any, error) {
+		recorder.record("tool")
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil, nil
+	})
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Act
+	result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: "test-tool", Arguments: map[string]any{}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, []string{"audit", "output-limiter", "first", "second", "tool"}, recorder.calls)
+}
+
+// toolCallRecorder records the order in which tool calls pass through middleware and tools.
+type toolCallRecorder struct {
+	mutex sync.Mutex
+	calls []string
+}
+
+func (r *toolCallRecorder) record(name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = append(r.calls, name)
+}
+
+func (r *toolCallRecorder) middleware(name string) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method == "tools/call" {
+				r.record(name)
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
 func TestFactory_NewServer_ConfigError(t *testing.T) {
 	// Arrange
 	mockConfigFactory := &mocks.MockConfigFactory{}
@@ -151,6 +497,15 @@ func TestFactory_NewServer_ConfigError(t *testing.T) {
 	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
 	defer mockTelemetryFactory.AssertExpectations(t)
 
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -158,10 +513,10 @@ func TestFactory_NewServer_ConfigError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
 
 	// Act
-	server, err := factory.NewServer()
+	server, err := factory.NewServer(nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -191,6 +546,15 @@ func TestFactory_NewServer_LoggerError(t *testing.T) {
 	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
 	defer mockTelemetryFactory.AssertExpectations(t)
 
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockConfigFactory.EXPECT().
@@ -203,10 +567,10 @@ func TestFactory_NewServer_LoggerError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
 
 	// Act
-	server, err := factory.NewServer()
+	server, err := factory.NewServer(nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -236,6 +600,78 @@ func TestFactory_NewServer_TelemetryError(t *testing.T) {
 	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
 	defer mockTelemetryFactory.AssertExpectations(t)
 
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockTelemetryFactory.EXPECT().
+		Telemetry().
+		Return(nil, expectedError).
+		Once()
+
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
+
+	// Act
+	server, err := factory.NewServer(nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, server, "Server should be nil when error occurs")
+}
+
+func TestFactory_NewServer_AuditLogError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDefinition := &mocks.MockDefinition{}
+	defer mockDefinition.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockTelemetryFactory := &mocks.MockTelemetryFactory{}
+	defer mockTelemetryFactory.AssertExpectations(t)
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	mockAuditLog := &mocks.MockAuditLog{}
+	defer mockAuditLog.AssertExpectations(t)
+
+	mockCompleter := &mocks.MockCompleter{}
+	defer mockCompleter.AssertExpectations(t)
+
+	mockOutputLimiter := &mocks.MockOutputLimiter{}
+	defer mockOutputLimiter.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedError := messages.AnError
 
@@ -251,13 +687,18 @@ func TestFactory_NewServer_TelemetryError(t *testing.T) {
 
 	mockTelemetryFactory.EXPECT().
 		Telemetry().
+		Return(mockTelemetry, nil).
+		Once()
+
+	mockAuditLog.EXPECT().
+		Middleware(mockLogger).
 		Return(nil, expectedError).
 		Once()
 
-	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory)
+	factory := sdk.NewFactory(mockConfigFactory, mockDefinition, mockRootStore, mockLoggerFactory, mockGlobalMATLAB, mockTelemetryFactory, nil, mockAuditLog, mockCompleter, mockOutputLimiter)
 
 	// Act
-	server, err := factory.NewServer()
+	server, err := factory.NewServer(nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -301,7 +742,7 @@ func TestHandleInitialized_EagerMATLABInit_HappyPath(t *testing.T) {
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
@@ -334,7 +775,7 @@ func TestHandleInitialized_NilRequest(t *testing.T) {
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, nil)
@@ -361,7 +802,7 @@ func TestHandleInitialized_NilSession(t *testing.T) {
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{})
@@ -468,7 +909,7 @@ func TestHandleInitialized_EagerMATLABInit_MATLABFeatureDisabled(t *testing.T) {
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
@@ -497,18 +938,80 @@ func TestHandleInitialized_EagerMATLABInit_MultipleSession(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockPoolWarmer := &mocks.MockMATLABSessionPoolWarmer{}
+	defer mockPoolWarmer.AssertExpectations(t)
+
+	warmed := make(chan struct{})
+
+	mockPoolWarmer.EXPECT().
+		Warm(mock.AnythingOfType("context.withoutCancelCtx"), mockLogger.AsMockArg()).
+		Run(func(_ context.Context, _ entities.Logger) {
+			close(warmed)
+		}).
+		Return(nil).
+		Once()
+
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, mockPoolWarmer)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
 
 	// Assert
+	select {
+	case <-warmed:
+		// Expected: the session pool was warmed
+	case <-time.After(time.Second):
+		t.Fatal("MATLAB session pool was not warmed")
+	}
 	mockGlobalMATLAB.AssertNotCalled(t, "Client")
 }
 
+func TestHandleInitialized_PoolWarmError_LogsWarning(t *testing.T) {
+	// Arrange
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockRootStore := &mocks.MockRootStore{}
+	defer mockRootStore.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	features := definition.Features{MATLAB: definition.MATLABFeature{Enabled: true}}
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockPoolWarmer := &mocks.MockMATLABSessionPoolWarmer{}
+	defer mockPoolWarmer.AssertExpectations(t)
+
+	mockPoolWarmer.EXPECT().
+		Warm(mock.AnythingOfType("context.withoutCancelCtx"), mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	mockTelemetry := &telemetrymocks.MockTelemetry{}
+	defer mockTelemetry.AssertExpectations(t)
+
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, mockPoolWarmer)
+
+	// Act
+	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
+
+	// Assert
+	require.Eventually(t, func() bool {
+		_, found := mockLogger.WarnLogs()["Starting MATLAB sessions in advance failed"]
+		return found
+	}, time.Second, time.Millisecond)
+}
+
 func TestHandleInitialized_EagerMATLABInit_InitializeMATLABOnStartupFalse(t *testing.T) {
 	// Arrange
 	mockConfig := &configmocks.MockConfig{}
@@ -537,7 +1040,7 @@ func TestHandleInitialized_EagerMATLABInit_InitializeMATLABOnStartupFalse(t *tes
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
@@ -580,7 +1083,7 @@ func TestHandleInitialized_EagerMATLABInit_ErrorLogsWarning(t *testing.T) {
 	mockTelemetry := &telemetrymocks.MockTelemetry{}
 	defer mockTelemetry.AssertExpectations(t)
 
-	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry)
+	handler := sdk.HandleInitialized(mockConfig, mockLogger, features, mockRootStore, mockGlobalMATLAB, mockTelemetry, nil)
 
 	// Act
 	handler(ctx, &mcp.InitializedRequest{Session: &mcp.ServerSession{}})
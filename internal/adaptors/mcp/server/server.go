@@ -5,6 +5,7 @@ package server
 import (
 	"context"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
@@ -22,19 +23,26 @@ type LifecycleSignaler interface {
 }
 
 type MCPSDKServerFactory interface {
-	NewServer() (*mcp.Server, messages.Error)
+	NewServer(toolMiddleware []mcp.Middleware) (*mcp.Server, messages.Error)
 }
 
 type MCPServerConfigurator interface {
 	GetToolsToAdd() ([]tools.Tool, error)
+	GetPromptsToAdd(toolsToAdd []tools.Tool) ([]prompts.Prompt, error)
 	GetResourcesToAdd() []resources.Resource
 }
 
+type CompletionRegistry interface {
+	AddPrompt(prompt prompts.Prompt)
+	AddResource(resource resources.Resource)
+}
+
 type Server struct {
 	mcpSDKServerFactory MCPSDKServerFactory
 	loggerFactory       LoggerFactory
 	lifecycleSignaler   LifecycleSignaler
 	configurator        MCPServerConfigurator
+	completionRegistry  CompletionRegistry
 	serverTransport     mcp.Transport
 }
 
@@ -43,23 +51,25 @@ func New(
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 	configurator MCPServerConfigurator,
+	completionRegistry CompletionRegistry,
 ) *Server {
 	return &Server{
 		mcpSDKServerFactory: mcpSDKServerfactory,
 		loggerFactory:       loggerFactory,
 		lifecycleSignaler:   lifecycleSignaler,
 		configurator:        configurator,
+		completionRegistry:  completionRegistry,
 		serverTransport:     &mcp.StdioTransport{},
 	}
 }
 
-func (s *Server) Run(sdkUserTools []tools.Tool) error {
+func (s *Server) Run(sdkUserTools []tools.Tool, sdkToolMiddleware []mcp.Middleware) error {
 	logger, messagesErr := s.loggerFactory.GetGlobalLogger()
 	if messagesErr != nil {
 		return messagesErr
 	}
 
-	mcpServer, messagesErr := s.mcpSDKServerFactory.NewServer()
+	mcpServer, messagesErr := s.mcpSDKServerFactory.NewServer(sdkToolMiddleware)
 	if messagesErr != nil {
 		return messagesErr
 	}
@@ -84,11 +94,26 @@ func (s *Server) Run(sdkUserTools []tools.Tool) error {
 	}
 	logger.With("count", len(sdkUserTools)).Info("Added additional tools to MCP SDK server")
 
+	promptsToAdd, err := s.configurator.GetPromptsToAdd(toolsToAdd)
+	if err != nil {
+		logger.WithError(err).Error("Failed to configure prompts")
+		return err
+	}
+
+	for _, prompt := range promptsToAdd {
+		if err := prompt.AddToServer(mcpServer); err != nil {
+			return err
+		}
+		s.completionRegistry.AddPrompt(prompt)
+	}
+	logger.With("count", len(promptsToAdd)).Info("Added prompts to MCP SDK server")
+
 	resourcesToAdd := s.configurator.GetResourcesToAdd()
 	for _, resource := range resourcesToAdd {
 		if err := resource.AddToServer(mcpServer); err != nil {
 			return err
 		}
+		s.completionRegistry.AddResource(resource)
 	}
 	logger.With("count", len(resourcesToAdd)).Info("Added resources to MCP SDK server")
 
@@ -5,11 +5,13 @@ package server_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	promptsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/prompts"
 	resourcemocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/resources"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/server"
 	toolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools"
@@ -33,8 +35,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	// Act
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Assert
 	assert.NotNil(t, svr, "Server should not be nil")
@@ -54,6 +59,9 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
@@ -66,8 +74,12 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockAdditionalTool := &toolsmocks.MockTool{}
 	defer mockAdditionalTool.AssertExpectations(t)
 
+	mockPrompt := &promptsmocks.MockPrompt{}
+	defer mockPrompt.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	toolMiddleware := func(next mcp.MethodHandler) mcp.MethodHandler { return next }
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -75,7 +87,7 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer(mock.MatchedBy(func(toolMiddleware []mcp.Middleware) bool { return len(toolMiddleware) == 1 })).
 		Return(expectedMCPServer, nil).
 		Once()
 
@@ -104,11 +116,31 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd([]tools.Tool{mockFirstTool, mockSecondTool}).
+		Return([]prompts.Prompt{mockPrompt}, nil).
+		Once()
+
+	mockPrompt.EXPECT().
+		AddToServer(expectedMCPServer).
+		Return(nil).
+		Once()
+
+	mockCompletionRegistry.EXPECT().
+		AddPrompt(mockPrompt).
+		Return().
+		Once()
+
 	mockResource.EXPECT().
 		AddToServer(expectedMCPServer).
 		Return(nil).
 		Once()
 
+	mockCompletionRegistry.EXPECT().
+		AddResource(mockResource).
+		Return().
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
@@ -118,14 +150,14 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	_, serverTransport := mcp.NewInMemoryTransports()
 	svr.SetServerTransport(serverTransport)
 
 	errC := make(chan error)
 	go func() {
-		errC <- svr.Run([]tools.Tool{mockAdditionalTool})
+		errC <- svr.Run([]tools.Tool{mockAdditionalTool}, []mcp.Middleware{toolMiddleware})
 	}()
 
 	capturedShutdownFunc := <-capturedShutdownFuncC
@@ -153,6 +185,9 @@ func TestServer_Run_GetGlobalLoggerError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	expectedError := messages.AnError
 
 	mockLoggerFactory.EXPECT().
@@ -160,10 +195,10 @@ func TestServer_Run_GetGlobalLoggerError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Act
-	err := svr.Run(nil)
+	err := svr.Run(nil, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Run should return the error from GetGlobalLogger")
@@ -183,6 +218,9 @@ func TestServer_Run_MCPSDKServerFactoryError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedError := messages.AnError
 
@@ -192,14 +230,14 @@ func TestServer_Run_MCPSDKServerFactoryError(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer([]mcp.Middleware(nil)).
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Act
-	err := svr.Run(nil)
+	err := svr.Run(nil, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Run should return the error from NewServer")
@@ -219,6 +257,9 @@ func TestServer_Run_ToolAddToServerReturnsError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockTool := &toolsmocks.MockTool{}
 	defer mockTool.AssertExpectations(t)
 
@@ -232,7 +273,7 @@ func TestServer_Run_ToolAddToServerReturnsError(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer([]mcp.Middleware(nil)).
 		Return(expectedMCPServer, nil).
 		Once()
 
@@ -246,10 +287,10 @@ func TestServer_Run_ToolAddToServerReturnsError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Act
-	err := svr.Run(nil)
+	err := svr.Run(nil, nil)
 
 	// Assert
 	require.Error(t, err, "Run should return an error")
@@ -270,6 +311,9 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
@@ -283,7 +327,7 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer([]mcp.Middleware(nil)).
 		Return(expectedMCPServer, nil).
 		Once()
 
@@ -292,6 +336,11 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 		Return(nil, nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd([]tools.Tool(nil)).
+		Return(nil, nil).
+		Once()
+
 	mockConfigurator.EXPECT().
 		GetResourcesToAdd().
 		Return([]resources.Resource{mockResource}).
@@ -302,16 +351,124 @@ func TestServer_Run_ResourceAddToServerReturnsError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Act
-	err := svr.Run(nil)
+	err := svr.Run(nil, nil)
 
 	// Assert
 	require.Error(t, err)
 	assert.Equal(t, expectedError, err)
 }
 
+func TestServer_Run_PromptAddToServerReturnsError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
+	mockPrompt := &promptsmocks.MockPrompt{}
+	defer mockPrompt.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer([]mcp.Middleware(nil)).
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd([]tools.Tool(nil)).
+		Return([]prompts.Prompt{mockPrompt}, nil).
+		Once()
+
+	mockPrompt.EXPECT().
+		AddToServer(expectedMCPServer).
+		Return(expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
+
+	// Act
+	err := svr.Run(nil, nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestServer_Run_GetPromptsToAddError(t *testing.T) {
+	// Arrange
+	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
+	defer mockMCPSDKServerFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger, nil).
+		Once()
+
+	mockMCPSDKServerFactory.EXPECT().
+		NewServer([]mcp.Middleware(nil)).
+		Return(expectedMCPServer, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd([]tools.Tool(nil)).
+		Return(nil, expectedError).
+		Once()
+
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
+
+	// Act
+	err := svr.Run(nil, nil)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the error from GetPromptsToAdd")
+}
+
 func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 	// Arrange
 	mockMCPSDKServerFactory := &mocks.MockMCPSDKServerFactory{}
@@ -326,6 +483,9 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
@@ -335,7 +495,7 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer([]mcp.Middleware(nil)).
 		Return(expectedMCPServer, nil).
 		Once()
 
@@ -344,6 +504,11 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 		Return(nil, nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd([]tools.Tool(nil)).
+		Return(nil, nil).
+		Once()
+
 	mockConfigurator.EXPECT().
 		GetResourcesToAdd().
 		Return(nil).
@@ -358,14 +523,14 @@ func TestServer_Run_HandlesNoToolsOrResources(t *testing.T) {
 		Return().
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	_, serverTransport := mcp.NewInMemoryTransports()
 	svr.SetServerTransport(serverTransport)
 
 	errC := make(chan error)
 	go func() {
-		errC <- svr.Run(nil)
+		errC <- svr.Run(nil, nil)
 	}()
 
 	capturedShutdownFunc := <-capturedShutdownFuncC
@@ -393,6 +558,9 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
+	mockCompletionRegistry := &mocks.MockCompletionRegistry{}
+	defer mockCompletionRegistry.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 	expectedError := assert.AnError
@@ -403,7 +571,7 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 		Once()
 
 	mockMCPSDKServerFactory.EXPECT().
-		NewServer().
+		NewServer([]mcp.Middleware(nil)).
 		Return(expectedMCPServer, nil).
 		Once()
 
@@ -412,10 +580,10 @@ func TestServer_Run_GetToolsToAddError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	svr := server.New(mockMCPSDKServerFactory, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockCompletionRegistry)
 
 	// Act
-	err := svr.Run(nil)
+	err := svr.Run(nil, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Run should return the error from GetToolsToAdd")
@@ -49,3 +49,14 @@ func NewDestructiveAnnotations() annotations {
 		openWorld:   true,
 	}
 }
+
+// NewWriteAnnotations creates annotations for tools that inspect without executing user code,
+// but can write a file that the caller names, such as a report.
+func NewWriteAnnotations() annotations {
+	return annotations{
+		readOnly:    false,
+		destructive: false,
+		idempotent:  true,
+		openWorld:   false,
+	}
+}
@@ -31,6 +31,17 @@ func TestNewDestructiveAnnotations(t *testing.T) {
 	assert.True(t, result.openWorld, "openWorld should be true")
 }
 
+func TestNewWriteAnnotations(t *testing.T) {
+	// Act
+	result := NewWriteAnnotations()
+
+	// Assert
+	assert.False(t, result.readOnly, "readOnly should be false")
+	assert.False(t, result.destructive, "destructive should be false")
+	assert.True(t, result.idempotent, "idempotent should be true")
+	assert.False(t, result.openWorld, "openWorld should be false")
+}
+
 func TestToToolAnnotations_ReadOnly(t *testing.T) {
 	// Arrange
 	annotations := NewReadOnlyAnnotations()
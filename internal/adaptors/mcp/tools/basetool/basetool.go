@@ -3,6 +3,9 @@
 package basetool
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
@@ -20,6 +23,22 @@ type LoggerFactory interface {
 	NewMCPSessionLogger(session *mcp.ServerSession) (entities.Logger, messages.Error)
 }
 
+// Confirmer asks the user to confirm a tool call before the tool handles it.
+type Confirmer interface {
+	Confirm(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, toolName string, action string) error
+}
+
+// CompletionProvider suggests values for an argument of a tool, given the value that the user has typed so far.
+type CompletionProvider interface {
+	Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error)
+}
+
+// PathCompleter creates completion providers for arguments that are paths on disk.
+type PathCompleter interface {
+	// Files suggests files with one of the extensions, and the folders that lead to them.
+	Files(extensions ...string) CompletionProvider
+}
+
 type ToolAdder[ToolInput, ToolOutput any] interface {
 	AddTool(server *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[ToolInput, ToolOutput])
 }
@@ -31,6 +50,13 @@ type tool[ToolInput any, ToolOutput any] struct {
 	annotations   AnnotationProvider
 	loggerFactory LoggerFactory
 	toolAdder     ToolAdder[ToolInput, ToolOutput]
+
+	confirmer Confirmer
+	// describeAction describes a call for the user to confirm, such as the code that the tool runs.
+	describeAction func(ToolInput) string
+
+	// completionProviders suggest values for the arguments of the tool, by JSON name of the argument.
+	completionProviders map[string]CompletionProvider
 }
 
 func (t tool[_, _]) Name() string {
@@ -52,3 +78,46 @@ func (t tool[_, _]) Annotations() AnnotationProvider {
 func (_ tool[ToolInput, _]) GetInputSchema() (any, error) {
 	return jsonschema.For[ToolInput](&jsonschema.ForOptions{})
 }
+
+// CompletionProviders returns the providers that suggest values for the arguments of the tool, by JSON name of the argument.
+func (t tool[_, _]) CompletionProviders() map[string]CompletionProvider {
+	return t.completionProviders
+}
+
+// confirm asks the user to confirm the call, if the tool requires confirmation.
+func (t tool[ToolInput, _]) confirm(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, input ToolInput) error {
+	if t.confirmer == nil || t.describeAction == nil {
+		return nil
+	}
+
+	return t.confirmer.Confirm(ctx, logger, session, t.name, t.describeAction(input))
+}
+
+// BeginCall returns a context in which a tool call keeps the MATLAB sessions that it uses until it ends, and the function that ends the call.
+// While the call waits for a busy session, a client that asked for progress receives the position of the call in the queue.
+func BeginCall(ctx context.Context, logger entities.Logger, req *mcp.CallToolRequest, toolName string) (context.Context, func()) {
+	var onPosition func(position int)
+
+	if req != nil && req.Session != nil && req.Params != nil {
+		if progressToken := req.Params.GetProgressToken(); progressToken != nil {
+			firstPosition := 0
+			onPosition = func(position int) {
+				if firstPosition == 0 {
+					firstPosition = position
+				}
+
+				err := req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+					ProgressToken: progressToken,
+					Message:       fmt.Sprintf("Waiting for the MATLAB session, position %d in the queue", position),
+					Progress:      float64(firstPosition - position),
+					Total:         float64(firstPosition),
+				})
+				if err != nil {
+					logger.WithError(err).Warn("Failed to report the queue position")
+				}
+			}
+		}
+	}
+
+	return entities.WithToolCall(ctx, toolName, onPosition)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package basetool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testContextKey struct{}
+
+// connectSession connects a client to a server in memory and returns the server side of the session.
+// The client sends the progress notifications that it receives to progress.
+func connectSession(t *testing.T, progress chan<- *mcp.ProgressNotificationParams) *mcp.ServerSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "server"}, nil)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+			progress <- req.Params
+		},
+	})
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = clientSession.Close()
+		_ = serverSession.Wait()
+	})
+
+	return serverSession
+}
+
+func TestBeginCall_HoldsResourcesUntilCallEnds(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	ctx, endCall := basetool.BeginCall(t.Context(), mockLogger, &mcp.CallToolRequest{}, "evaluate_matlab_code")
+
+	call, ok := entities.ToolCallFromContext(ctx)
+	require.True(t, ok, "The context should belong to the call")
+
+	released := false
+
+	// Act
+	release, err := call.Hold("session", func() (func(), error) {
+		return func() { released = true }, nil
+	})
+	require.NoError(t, err)
+	release()
+
+	// Assert
+	assert.Equal(t, "evaluate_matlab_code", call.Name())
+	assert.False(t, released, "The call should hold the resource until it ends")
+
+	endCall()
+	assert.True(t, released)
+}
+
+func TestBeginCall_ReportsQueuePositionAsProgress(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	progress := make(chan *mcp.ProgressNotificationParams, 1)
+	session := connectSession(t, progress)
+
+	req := &mcp.CallToolRequest{
+		Session: session,
+		Params: &mcp.CallToolParamsRaw{
+			Meta: mcp.Meta{"progressToken": "progress-token"},
+			Name: "evaluate_matlab_code",
+		},
+	}
+
+	ctx, endCall := basetool.BeginCall(t.Context(), mockLogger, req, "evaluate_matlab_code")
+	defer endCall()
+
+	call, ok := entities.ToolCallFromContext(ctx)
+	require.True(t, ok, "The context should belong to the call")
+	require.NotNil(t, call.OnQueuePosition())
+
+	// Act
+	call.OnQueuePosition()(1)
+
+	// Assert
+	notification := <-progress
+	assert.Equal(t, "progress-token", notification.ProgressToken)
+	assert.Equal(t, "Waiting for the MATLAB session, position 1 in the queue", notification.Message)
+	assert.InDelta(t, 0, notification.Progress, 0)
+	assert.InDelta(t, 1, notification.Total, 0)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_HoldsMATLABSessionDuringCall(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	expectedSession := &mcp.ServerSession{}
+	mockSessionLogger := testutils.NewInspectableLogger()
+	acquired, released := 0, false
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		call, ok := entities.ToolCallFromContext(ctx)
+		if !assert.True(t, ok, "The handler should run in the context of the call") {
+			return tools.RichContent{}, nil
+		}
+
+		// Two steps of the call, such as changing the folder and evaluating code
+		for range 2 {
+			release, err := call.Hold("session", func() (func(), error) {
+				acquired++
+				return func() { released = true }, nil
+			})
+			if err != nil {
+				return tools.RichContent{}, err
+			}
+			release()
+
+			assert.False(t, released, "The call should hold the session between its steps")
+		}
+		return tools.RichContent{TextContent: []string{"success"}}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	// Act
+	_, _, err := tool.Handler()(t.Context(), &mcp.CallToolRequest{Session: expectedSession}, TestUnstructuredInput{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, acquired, "The steps of the call should share the session")
+	assert.True(t, released, "The call should release the session when it ends")
+}
@@ -40,6 +40,20 @@ func NewToolWithStructuredContent[ToolInput, ToolOutput any](
 	}
 }
 
+// WithConfirmation returns a copy of the tool that asks the user, through the confirmer, to confirm each call before handling it.
+// describeAction describes a call for the user, such as the code that the tool runs.
+func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) WithConfirmation(confirmer Confirmer, describeAction func(ToolInput) string) ToolWithStructuredContentOutput[ToolInput, ToolOutput] {
+	t.confirmer = confirmer
+	t.describeAction = describeAction
+	return t
+}
+
+// WithCompletionProviders returns a copy of the tool that suggests values for its arguments with the providers, by JSON name of the argument.
+func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) WithCompletionProviders(providers map[string]CompletionProvider) ToolWithStructuredContentOutput[ToolInput, ToolOutput] {
+	t.completionProviders = providers
+	return t
+}
+
 func (t ToolWithStructuredContentOutput[_, _]) AddToServer(server *mcp.Server) error {
 	if t.annotations == nil {
 		return fmt.Errorf(UnexpectedErrorPrefixForLLM + "annotations must not be nil")
@@ -90,6 +104,14 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 			return nil, toolOutputZeroValue, err
 		}
 
+		if err := t.confirm(ctx, logger, req.Session, input); err != nil {
+			logger.WithError(err).Warn("Tool call was not confirmed")
+			return nil, toolOutputZeroValue, err
+		}
+
+		ctx, endCall := BeginCall(ctx, logger, req, t.name)
+		defer endCall()
+
 		toolOutput, err := t.structuredContentHandler(ctx, logger, input)
 		if err != nil {
 			logger.WithError(err).Warn("Structured handler returned an error")
@@ -275,16 +275,18 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 		handler,
 	)
 
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test value")
+
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
 	}
 
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), capturedContext, "Context should be propagated to handler")
+	assert.Equal(t, "test value", capturedContext.Value(testContextKey{}), "Context should be propagated to handler")
 }
 
 func TestToolWithStructuredContent_Annotations(t *testing.T) {
@@ -337,3 +339,139 @@ func TestToolWithStructuredContentOutput_AddToServer_NilAnnotationInterface(t *t
 	require.Error(t, err, "AddToServer should return an error for nil annotations")
 	assert.Contains(t, err.Error(), "annotations must not be nil", "Error message should indicate nil annotations")
 }
+
+func TestToolWithStructuredContentOutput_Handler_Confirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedOutput := TestOutput{Result: "processed: test message"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		return TestOutput{Result: "processed: " + input.Message}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "test-tool", "Process test message").
+		Return(nil).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewDestructiveAnnotations(),
+		mockLoggerFactory,
+		handler,
+	).WithConfirmation(mockConfirmer, func(input TestInput) string {
+		return "Process " + input.Message
+	})
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Nil(t, result, "Result should be nil for structured content output")
+	assert.Equal(t, expectedOutput, output, "Output should match expected output")
+}
+
+func TestToolWithStructuredContentOutput_Handler_NotConfirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedError := assert.AnError
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		t.Fatal("Handler should not run when the call is not confirmed")
+		return TestOutput{}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "test-tool", "Process test message").
+		Return(expectedError).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewDestructiveAnnotations(),
+		mockLoggerFactory,
+		handler,
+	).WithConfirmation(mockConfirmer, func(input TestInput) string {
+		return "Process " + input.Message
+	})
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+	assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+	assert.Equal(t, TestOutput{}, output, "Output should be the zero value when the call is not confirmed")
+}
+
+func TestToolWithStructuredContentOutput_WithCompletionProviders_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCompletionProvider := &mocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		return TestOutput{Result: "success"}, nil
+	}
+
+	tool := basetool.NewToolWithStructuredContent(
+		testToolName,
+		testToolTitle,
+		testToolDescription,
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	expectedProviders := map[string]basetool.CompletionProvider{"input": mockCompletionProvider}
+
+	// Act
+	toolWithCompletions := tool.WithCompletionProviders(expectedProviders)
+
+	// Assert
+	assert.Equal(t, expectedProviders, toolWithCompletions.CompletionProviders())
+	assert.Empty(t, tool.CompletionProviders(), "The original tool should not be changed")
+}
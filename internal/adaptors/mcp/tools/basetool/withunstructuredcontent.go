@@ -42,6 +42,20 @@ func NewToolWithUnstructuredContent[ToolInput any](
 	}
 }
 
+// WithConfirmation returns a copy of the tool that asks the user, through the confirmer, to confirm each call before handling it.
+// describeAction describes a call for the user, such as the code that the tool runs.
+func (t ToolWithUnstructuredContentOutput[ToolInput]) WithConfirmation(confirmer Confirmer, describeAction func(ToolInput) string) ToolWithUnstructuredContentOutput[ToolInput] {
+	t.confirmer = confirmer
+	t.describeAction = describeAction
+	return t
+}
+
+// WithCompletionProviders returns a copy of the tool that suggests values for its arguments with the providers, by JSON name of the argument.
+func (t ToolWithUnstructuredContentOutput[ToolInput]) WithCompletionProviders(providers map[string]CompletionProvider) ToolWithUnstructuredContentOutput[ToolInput] {
+	t.completionProviders = providers
+	return t
+}
+
 func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server) error {
 	if t.annotations == nil {
 		return fmt.Errorf(UnexpectedErrorPrefixForLLM + "annotations must not be nil")
@@ -85,6 +99,14 @@ func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerF
 			return nil, nil, err
 		}
 
+		if err := t.confirm(ctx, logger, req.Session, input); err != nil {
+			logger.WithError(err).Warn("Tool call was not confirmed")
+			return nil, nil, err
+		}
+
+		ctx, endCall := BeginCall(ctx, logger, req, t.name)
+		defer endCall()
+
 		richContent, err := t.unstructuredContentHandler(ctx, logger, input)
 		if err != nil {
 			logger.WithError(err).Warn("Unstructured handler returned an error")
@@ -124,7 +124,7 @@ func TestToolWithUnstructuredContentOutput_Handler_HappyPath(t *testing.T) {
 	expectedInput := TestUnstructuredInput{Query: "test query"}
 	expectedRichContent := tools.RichContent{
 		TextContent:  []string{"text response"},
-		ImageContent: []tools.PNGImageData{[]byte("image1")},
+		ImageContent: []tools.ImageData{[]byte("image1")},
 	}
 
 	mockSessionLogger := testutils.NewInspectableLogger()
@@ -231,7 +231,7 @@ func TestToolWithUnstructuredContentOutput_Handler_ImageContentOnly(t *testing.T
 	expectedSession := &mcp.ServerSession{}
 	expectedInput := TestUnstructuredInput{Query: "test query"}
 	expectedRichContent := tools.RichContent{
-		ImageContent: []tools.PNGImageData{
+		ImageContent: []tools.ImageData{
 			[]byte("image1"),
 			[]byte("image2"),
 		},
@@ -290,7 +290,7 @@ func TestToolWithUnstructuredContentOutput_Handler_NoContent(t *testing.T) {
 	expectedInput := TestUnstructuredInput{Query: "test query"}
 	expectedRichContent := tools.RichContent{
 		TextContent:  []string{},
-		ImageContent: []tools.PNGImageData{},
+		ImageContent: []tools.ImageData{},
 	}
 
 	mockSessionLogger := testutils.NewInspectableLogger()
@@ -440,16 +440,18 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 		handler,
 	)
 
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test value")
+
 	req := &mcp.CallToolRequest{
 		Session: expectedSession,
 	}
 
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), <-contextReceived, "Context should be propagated to handler")
+	assert.Equal(t, "test value", (<-contextReceived).Value(testContextKey{}), "Context should be propagated to handler")
 }
 
 func TestToolWithUnstructuredContent_Annotations(t *testing.T) {
@@ -506,3 +508,140 @@ func TestToolWithUnstructuredContentOutput_AddToServer_NilAnnotationInterface(t
 	require.Error(t, err, "AddToServer should return an error for nil annotations")
 	assert.Contains(t, err.Error(), "annotations must not be nil", "Error message should indicate nil annotations")
 }
+
+func TestToolWithUnstructuredContentOutput_Handler_Confirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{TextContent: []string{"test response"}}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "test-tool", "Run test query").
+		Return(nil).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewDestructiveAnnotations(),
+		mockLoggerFactory,
+		handler,
+	).WithConfirmation(mockConfirmer, func(input TestUnstructuredInput) string {
+		return "Run " + input.Query
+	})
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, _, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	require.NotNil(t, result, "Result should not be nil")
+	require.Len(t, result.Content, 1, "Should have 1 content item")
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_NotConfirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedError := assert.AnError
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		t.Fatal("Handler should not run when the call is not confirmed")
+		return tools.RichContent{}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "test-tool", "Run test query").
+		Return(expectedError).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		annotations.NewDestructiveAnnotations(),
+		mockLoggerFactory,
+		handler,
+	).WithConfirmation(mockConfirmer, func(input TestUnstructuredInput) string {
+		return "Run " + input.Query
+	})
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(ctx, req, expectedInput)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+	assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+	assert.Nil(t, output, "Output should be nil when the call is not confirmed")
+}
+
+func TestToolWithUnstructuredContentOutput_WithCompletionProviders_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockCompletionProvider := &mocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{
+			TextContent: []string{"test response"},
+		}, nil
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		testUnstructuredToolName,
+		testUnstructuredToolTitle,
+		testUnstructuredToolDescription,
+		annotations.NewReadOnlyAnnotations(),
+		mockLoggerFactory,
+		handler,
+	)
+
+	expectedProviders := map[string]basetool.CompletionProvider{"input": mockCompletionProvider}
+
+	// Act
+	toolWithCompletions := tool.WithCompletionProviders(expectedProviders)
+
+	// Assert
+	assert.Equal(t, expectedProviders, toolWithCompletions.CompletionProviders())
+	assert.Empty(t, tool.CompletionProviders(), "The original tool should not be changed")
+}
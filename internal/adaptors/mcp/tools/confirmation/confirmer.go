@@ -0,0 +1,152 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package confirmation asks the user, through an MCP elicitation request, to confirm tool calls before the server runs them.
+package confirmation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var (
+	ErrToolCallDeclined        = errors.New("tool call refused: the user declined the tool call")
+	ErrElicitationNotSupported = errors.New("tool call refused: the server requires the user to confirm this tool call, but the MCP client does not support elicitation")
+)
+
+// allowForSessionField is the field of the elicitation form that allows the tool for the rest of the session.
+const allowForSessionField = "allowForSession"
+
+const elicitActionAccept = "accept"
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type Confirmer struct {
+	configFactory ConfigFactory
+
+	lock sync.Mutex
+	// allowedTools holds, for each session, the names of the tools that the user allowed for the rest of the session.
+	allowedTools map[*mcp.ServerSession]map[string]struct{}
+}
+
+func New(configFactory ConfigFactory) *Confirmer {
+	return &Confirmer{
+		configFactory: configFactory,
+		allowedTools:  make(map[*mcp.ServerSession]map[string]struct{}),
+	}
+}
+
+// Confirm asks the user of the session to confirm the call to the tool, if confirmation is enabled.
+// The action describes the call, such as the code that the tool runs.
+// Confirm returns an error that wraps ErrToolCallDeclined or ErrElicitationNotSupported if the tool must not run.
+func (c *Confirmer) Confirm(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, toolName string, action string) error {
+	cfg, messagesErr := c.configFactory.Config()
+	if messagesErr != nil {
+		return messagesErr
+	}
+
+	if !cfg.ConfirmDestructiveTools() {
+		return nil
+	}
+
+	if c.isAllowedForSession(session, toolName) {
+		logger.Debug("Tool call allowed for this session")
+		return nil
+	}
+
+	if !supportsFormElicitation(session) {
+		logger.Warn("Cannot confirm tool call, because the client does not support elicitation")
+		return fmt.Errorf("%w, so %s did not run", ErrElicitationNotSupported, toolName)
+	}
+
+	logger.Info("Asking the user to confirm tool call")
+
+	result, err := session.Elicit(ctx, &mcp.ElicitParams{
+		Message:         fmt.Sprintf("Allow the call to %s?\n\n%s", toolName, action),
+		RequestedSchema: requestedSchema(toolName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to ask the user to confirm the call to %s: %w", toolName, err)
+	}
+
+	// The user can also decline or cancel, which both refuse the call
+	if result.Action != elicitActionAccept {
+		logger.With("action", result.Action).Info("User did not confirm tool call")
+		return fmt.Errorf("%w, so %s did not run", ErrToolCallDeclined, toolName)
+	}
+
+	if allowForSession, ok := result.Content[allowForSessionField].(bool); ok && allowForSession {
+		logger.Info("User allowed tool for this session")
+		c.allowForSession(session, toolName)
+	}
+
+	return nil
+}
+
+func (c *Confirmer) isAllowedForSession(session *mcp.ServerSession, toolName string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, allowed := c.allowedTools[session][toolName]
+	return allowed
+}
+
+func (c *Confirmer) allowForSession(session *mcp.ServerSession, toolName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.allowedTools[session] == nil {
+		c.allowedTools[session] = make(map[string]struct{})
+		go c.forgetWhenClosed(session)
+	}
+	c.allowedTools[session][toolName] = struct{}{}
+}
+
+// forgetWhenClosed removes the tools that the user allowed for the session once the session ends.
+func (c *Confirmer) forgetWhenClosed(session *mcp.ServerSession) {
+	_ = session.Wait()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.allowedTools, session)
+}
+
+func supportsFormElicitation(session *mcp.ServerSession) bool {
+	if session == nil {
+		return false
+	}
+
+	params := session.InitializeParams()
+	if params == nil || params.Capabilities == nil || params.Capabilities.Elicitation == nil {
+		return false
+	}
+
+	// A client that declares neither mode supports form elicitation
+	capabilities := params.Capabilities.Elicitation
+	return capabilities.Form != nil || capabilities.URL == nil
+}
+
+func requestedSchema(toolName string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			allowForSessionField: {
+				Type:        "boolean",
+				Title:       "Allow for this session",
+				Description: fmt.Sprintf("Run %s without asking again until the session ends.", toolName),
+				Default:     json.RawMessage("false"),
+			},
+		},
+	}
+}
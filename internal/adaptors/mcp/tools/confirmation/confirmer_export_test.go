@@ -0,0 +1,11 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package confirmation
+
+// SessionsWithAllowedTools returns the number of sessions for which the user allowed a tool.
+func (c *Confirmer) SessionsWithAllowedTools() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.allowedTools)
+}
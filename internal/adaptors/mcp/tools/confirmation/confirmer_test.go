@@ -0,0 +1,257 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package confirmation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/confirmation"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/confirmation"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	toolName = "evaluate_matlab_code"
+	action   = "delete('results.mat')"
+)
+
+type elicitationHandler func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error)
+
+// connectSession connects a client to a server in memory and returns the server side of the session.
+// If handler is nil, the client does not support elicitation.
+func connectSession(t *testing.T, handler elicitationHandler) *mcp.ServerSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "server"}, nil)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client"}, &mcp.ClientOptions{ElicitationHandler: handler})
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = clientSession.Close()
+		_ = serverSession.Wait()
+	})
+
+	return serverSession
+}
+
+func newConfirmer(t *testing.T, enabled bool, calls int) *confirmation.Confirmer {
+	t.Helper()
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	t.Cleanup(func() { mockConfigFactory.AssertExpectations(t) })
+
+	mockConfig := &configmocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Times(calls)
+
+	mockConfig.EXPECT().
+		ConfirmDestructiveTools().
+		Return(enabled).
+		Times(calls)
+
+	return confirmation.New(mockConfigFactory)
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	// Act
+	confirmer := confirmation.New(mockConfigFactory)
+
+	// Assert
+	assert.NotNil(t, confirmer)
+}
+
+func TestConfirmer_Confirm_Disabled(t *testing.T) {
+	// Arrange
+	confirmer := newConfirmer(t, false, 1)
+
+	// Act
+	err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), nil, toolName, action)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestConfirmer_Confirm_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	confirmer := confirmation.New(mockConfigFactory)
+
+	// Act
+	err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), nil, toolName, action)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestConfirmer_Confirm_Accepted(t *testing.T) {
+	// Arrange
+	var requests []*mcp.ElicitParams
+	session := connectSession(t, func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		requests = append(requests, req.Params)
+		return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"allowForSession": false}}, nil
+	})
+	confirmer := newConfirmer(t, true, 2)
+
+	// Act
+	firstErr := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action)
+	secondErr := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.Len(t, requests, 2, "Confirmer should ask for every call that the user did not allow for the session")
+	assert.Equal(t, "Allow the call to evaluate_matlab_code?\n\ndelete('results.mat')", requests[0].Message)
+	assert.NotNil(t, requests[0].RequestedSchema)
+}
+
+func TestConfirmer_Confirm_AllowedForSession(t *testing.T) {
+	// Arrange
+	var elicitMessages []string
+	handler := func(_ context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		elicitMessages = append(elicitMessages, req.Params.Message)
+		return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"allowForSession": true}}, nil
+	}
+	session := connectSession(t, handler)
+	otherSession := connectSession(t, handler)
+	confirmer := newConfirmer(t, true, 4)
+	logger := testutils.NewInspectableLogger()
+
+	// Act
+	firstErr := confirmer.Confirm(t.Context(), logger, session, toolName, action)
+	sameToolErr := confirmer.Confirm(t.Context(), logger, session, toolName, action)
+	otherToolErr := confirmer.Confirm(t.Context(), logger, session, "run_matlab_file", "Run the MATLAB file /tmp/script.m")
+	otherSessionErr := confirmer.Confirm(t.Context(), logger, otherSession, toolName, action)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, sameToolErr)
+	require.NoError(t, otherToolErr)
+	require.NoError(t, otherSessionErr)
+	assert.Len(t, elicitMessages, 3, "Confirmer should not ask again for a tool that the user allowed for the session")
+}
+
+func TestConfirmer_Confirm_ForgetsAllowedToolsWhenSessionCloses(t *testing.T) {
+	// Arrange
+	server := mcp.NewServer(&mcp.Implementation{Name: "server"}, nil)
+	client := mcp.NewClient(&mcp.Implementation{Name: "client"}, &mcp.ClientOptions{
+		ElicitationHandler: func(_ context.Context, _ *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"allowForSession": true}}, nil
+		},
+	})
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	session, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	confirmer := newConfirmer(t, true, 1)
+	require.NoError(t, confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action))
+	require.Equal(t, 1, confirmer.SessionsWithAllowedTools())
+
+	// Act
+	require.NoError(t, clientSession.Close())
+	_ = session.Wait()
+
+	// Assert
+	assert.Eventually(t, func() bool {
+		return confirmer.SessionsWithAllowedTools() == 0
+	}, time.Second, 10*time.Millisecond, "Confirmer should forget the allowed tools of a closed session")
+}
+
+func TestConfirmer_Confirm_NotAccepted(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+	}{
+		{"Declined", "decline"},
+		{"Cancelled", "cancel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			session := connectSession(t, func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+				return &mcp.ElicitResult{Action: tt.action}, nil
+			})
+			confirmer := newConfirmer(t, true, 1)
+
+			// Act
+			err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action)
+
+			// Assert
+			require.ErrorIs(t, err, confirmation.ErrToolCallDeclined)
+			assert.Contains(t, err.Error(), toolName)
+		})
+	}
+}
+
+func TestConfirmer_Confirm_ElicitationNotSupported(t *testing.T) {
+	// Arrange
+	session := connectSession(t, nil)
+	confirmer := newConfirmer(t, true, 1)
+
+	// Act
+	err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action)
+
+	// Assert
+	require.ErrorIs(t, err, confirmation.ErrElicitationNotSupported)
+}
+
+func TestConfirmer_Confirm_NilSession(t *testing.T) {
+	// Arrange
+	confirmer := newConfirmer(t, true, 1)
+
+	// Act
+	err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), nil, toolName, action)
+
+	// Assert
+	require.ErrorIs(t, err, confirmation.ErrElicitationNotSupported)
+}
+
+func TestConfirmer_Confirm_ElicitationError(t *testing.T) {
+	// Arrange
+	session := connectSession(t, func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+		return nil, assert.AnError
+	})
+	confirmer := newConfirmer(t, true, 1)
+
+	// Act
+	err := confirmer.Confirm(t.Context(), testutils.NewInspectableLogger(), session, toolName, action)
+
+	// Assert
+	require.Error(t, err)
+	require.NotErrorIs(t, err, confirmation.ErrToolCallDeclined)
+	require.NotErrorIs(t, err, confirmation.ErrElicitationNotSupported)
+}
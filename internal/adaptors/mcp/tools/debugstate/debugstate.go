@@ -0,0 +1,60 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package debugstate holds the output that the debugging tools share, to report where MATLAB is paused.
+package debugstate
+
+import (
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+const (
+	pausedNextSteps = "MATLAB is paused in debug mode at %s, line %d. Use step_matlab_debugger to step, continue or quit, before running other code."
+	notPaused       = "MATLAB is not paused in debug mode."
+)
+
+type ReturnArgs struct {
+	ConsoleOutput string     `json:"console_output"      jsonschema:"The output of the code that ran."`
+	Error         string     `json:"error,omitempty"     jsonschema:"The message of the error that the code raised, if any."`
+	Paused        bool       `json:"paused"              jsonschema:"Whether MATLAB is paused in debug mode, at the K>> prompt."`
+	Stack         []Frame    `json:"stack,omitempty"     jsonschema:"The paused functions, innermost first."`
+	Variables     []Variable `json:"variables,omitempty" jsonschema:"The local variables of the innermost paused function."`
+	Status        string     `json:"status"              jsonschema:"Where MATLAB is paused and what to do next."`
+}
+
+type Frame struct {
+	Name string `json:"name" jsonschema:"The name of the function."`
+	File string `json:"file" jsonschema:"The full path of the file of the function."`
+	Line int    `json:"line" jsonschema:"The line that runs next."`
+}
+
+type Variable struct {
+	Name  string `json:"name"            jsonschema:"The name of the variable."`
+	Class string `json:"class"           jsonschema:"The class of the variable, for example double."`
+	Size  string `json:"size"            jsonschema:"The size of the variable, for example 3x4."`
+	Value string `json:"value,omitempty" jsonschema:"The displayed value of the variable. Only small variables are displayed."`
+}
+
+func FromState(state debugmatlab.State) ReturnArgs {
+	returnArgs := ReturnArgs{
+		ConsoleOutput: state.ConsoleOutput,
+		Error:         state.Error,
+		Paused:        state.Paused,
+		Status:        notPaused,
+	}
+
+	for _, frame := range state.Stack {
+		returnArgs.Stack = append(returnArgs.Stack, Frame(frame))
+	}
+
+	for _, variable := range state.Variables {
+		returnArgs.Variables = append(returnArgs.Variables, Variable(variable))
+	}
+
+	if state.Paused && len(state.Stack) > 0 {
+		returnArgs.Status = fmt.Sprintf(pausedNextSteps, state.Stack[0].File, state.Stack[0].Line)
+	}
+
+	return returnArgs
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugstate_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromState_Paused(t *testing.T) {
+	// Arrange
+	state := debugmatlab.State{
+		ConsoleOutput: "starting",
+		Error:         "Index exceeds the number of array elements.",
+		Paused:        true,
+		Stack: []debugmatlab.Frame{
+			{Name: "helper", File: "/some/path/helper.m", Line: 5},
+			{Name: "myFunction", File: "/some/path/myFunction.m", Line: 12},
+		},
+		Variables: []debugmatlab.Variable{
+			{Name: "x", Class: "double", Size: "1x1", Value: "3"},
+		},
+	}
+
+	// Act
+	result := debugstate.FromState(state)
+
+	// Assert
+	assert.Equal(t, debugstate.ReturnArgs{
+		ConsoleOutput: "starting",
+		Error:         "Index exceeds the number of array elements.",
+		Paused:        true,
+		Stack: []debugstate.Frame{
+			{Name: "helper", File: "/some/path/helper.m", Line: 5},
+			{Name: "myFunction", File: "/some/path/myFunction.m", Line: 12},
+		},
+		Variables: []debugstate.Variable{
+			{Name: "x", Class: "double", Size: "1x1", Value: "3"},
+		},
+		Status: "MATLAB is paused in debug mode at /some/path/helper.m, line 5. Use step_matlab_debugger to step, continue or quit, before running other code.",
+	}, result)
+}
+
+func TestFromState_NotPaused(t *testing.T) {
+	// Act
+	result := debugstate.FromState(debugmatlab.State{ConsoleOutput: "ans = 7"})
+
+	// Assert
+	assert.Equal(t, debugstate.ReturnArgs{
+		ConsoleOutput: "ans = 7",
+		Status:        "MATLAB is not paused in debug mode.",
+	}, result)
+}
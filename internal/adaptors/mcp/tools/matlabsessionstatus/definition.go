@@ -0,0 +1,28 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstatus
+
+const (
+	name        = "matlab_session_status"
+	title       = "MATLAB Session Status"
+	description = "Show whether each running MATLAB session is busy or idle. For a busy session, show the tool call that is running, how long it has been running, and how many tool calls are waiting for the session. Tool calls run on a session one at a time, in the order they arrive. This tool does not wait for busy sessions."
+)
+
+const (
+	stateBusy = "busy"
+	stateIdle = "idle"
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Sessions []SessionStatus `json:"sessions" jsonschema:"The status of each running MATLAB session. Empty when no MATLAB session is running."`
+}
+
+type SessionStatus struct {
+	SessionID      int     `json:"session_id"                jsonschema:"The ID of the MATLAB session."`
+	State          string  `json:"state"                     jsonschema:"busy when the session is running a tool call, idle otherwise."`
+	RunningTool    string  `json:"running_tool,omitempty"    jsonschema:"The name of the tool that is running, when the session is busy."`
+	RunningSeconds float64 `json:"running_seconds,omitempty" jsonschema:"How long the running tool call has been running, in seconds."`
+	QueueLength    int     `json:"queue_length"              jsonschema:"The number of tool calls that wait for the session."`
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstatus
+
+import (
+	"context"
+	"math"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabsessionstatus"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) matlabsessionstatus.ReturnArgs
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing MATLAB session status tool")
+		defer sessionLogger.Info("Done - Executing MATLAB session status tool")
+
+		statuses := usecase.Execute(ctx, sessionLogger)
+
+		return convertToAnnotatedEquivalentType(statuses), nil
+	}
+}
+
+func convertToAnnotatedEquivalentType(statuses matlabsessionstatus.ReturnArgs) ReturnArgs {
+	sessions := make([]SessionStatus, len(statuses))
+	for i, status := range statuses {
+		sessions[i] = SessionStatus{
+			SessionID:   int(status.SessionID),
+			State:       stateIdle,
+			QueueLength: status.QueueLength,
+		}
+
+		if status.Busy {
+			sessions[i].State = stateBusy
+			sessions[i].RunningTool = status.ToolName
+			sessions[i].RunningSeconds = math.Round(status.RunningFor.Seconds()*10) / 10
+		}
+	}
+
+	return ReturnArgs{
+		Sessions: sessions,
+	}
+}
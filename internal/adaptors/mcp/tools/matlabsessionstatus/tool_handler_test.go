@@ -0,0 +1,102 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstatus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/matlabsessionstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := matlabsessionstatus.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	statuses := []entities.MATLABSessionStatus{
+		{SessionID: 1, Busy: true, ToolName: "evaluate_matlab_code", RunningFor: 2345 * time.Millisecond, QueueLength: 2},
+		{SessionID: 2},
+	}
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(statuses).
+		Once()
+
+	// Act
+	result, err := matlabsessionstatus.Handler(mockUsecase)(ctx, mockLogger, matlabsessionstatus.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, matlabsessionstatus.ReturnArgs{
+		Sessions: []matlabsessionstatus.SessionStatus{
+			{SessionID: 1, State: "busy", RunningTool: "evaluate_matlab_code", RunningSeconds: 2.3, QueueLength: 2},
+			{SessionID: 2, State: "idle"},
+		},
+	}, result)
+	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be created")
+}
+
+func TestTool_Handler_NoSessions(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	// Act
+	result, err := matlabsessionstatus.Handler(mockUsecase)(ctx, mockLogger, matlabsessionstatus.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Sessions, "Sessions should be an empty list rather than null")
+	assert.Empty(t, result.Sessions)
+}
+
+func TestMATLABSessionStatus_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	// Act
+	tool := matlabsessionstatus.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.Equal(t, annotations.NewReadOnlyAnnotations(), tool.Annotations(), "Tool should have read-only annotations")
+}
@@ -4,6 +4,7 @@ package evalmatlabcode
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
@@ -29,20 +30,30 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
 	configFactory ConfigFactory,
 	usecase Usecase,
 	matlabManager entities.MATLABManager,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, matlabManager)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, matlabManager)).WithConfirmation(confirmer, describeAction),
 	}
 }
 
+// describeAction describes a call for the user to confirm, with the exact code that the tool evaluates.
+func describeAction(inputs Args) string {
+	location := fmt.Sprintf("MATLAB session %d", inputs.SessionID)
+	if inputs.ProjectPath != "" {
+		location += ", in " + inputs.ProjectPath
+	}
+	return fmt.Sprintf("In %s:\n%s", location, inputs.Code)
+}
+
 func Handler(configFactory ConfigFactory, usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
 		sessionID := entities.SessionID(inputs.SessionID)
 
-		sessionLogger = sessionLogger.With("session_id", sessionID)
+		sessionLogger = sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID)
 
 		sessionLogger.Info("Executing Eval in MATLAB Session tool")
 		defer sessionLogger.Info("Done - Executing Eval in MATLAB Session tool")
@@ -15,7 +15,9 @@ import (
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/multisession/evalmatlabcode"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -34,7 +39,7 @@ func TestNew_HappyPath(t *testing.T) {
 	defer mockMATLABManager.AssertExpectations(t)
 
 	// Act
-	tool := evalmatlabcode.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
+	tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockMATLABManager)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -341,6 +346,9 @@ func TestEvalInMATLABSession_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -353,8 +361,71 @@ func TestEvalInMATLABSession_Annotations(t *testing.T) {
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
 	// Act
-	tool := evalmatlabcode.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockMATLABManager)
+	tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockMATLABManager)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
 }
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           evalmatlabcode.Args
+		expectedAction string
+	}{
+		{
+			name:           "WithoutProjectPath",
+			args:           evalmatlabcode.Args{SessionID: 2, Code: "x = 1;"},
+			expectedAction: "In MATLAB session 2:\nx = 1;",
+		},
+		{
+			name:           "WithProjectPath",
+			args:           evalmatlabcode.Args{SessionID: 2, Code: "x = 1;", ProjectPath: "/some/path"},
+			expectedAction: "In MATLAB session 2, in /some/path:\nx = 1;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "eval_in_matlab_session", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockMATLABManager)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
@@ -4,6 +4,7 @@ package stopmatlabsession
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
@@ -20,13 +21,19 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
 	usecase Usecase,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase)).WithConfirmation(confirmer, describeAction),
 	}
 }
 
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return fmt.Sprintf("Stop MATLAB session %d", inputs.SessionID)
+}
+
 func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
 		err := usecase.Execute(ctx, sessionLogger, entities.SessionID(inputs.SessionID))
@@ -11,7 +11,9 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/multisession/stopmatlabsession"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,11 +22,14 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	// Act
-	tool := stopmatlabsession.New(mockLoggerFactory, mockUsecase)
+	tool := stopmatlabsession.New(mockLoggerFactory, mockConfirmer, mockUsecase)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -86,14 +91,69 @@ func TestStopMATLABSession_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
 	// Act
-	tool := stopmatlabsession.New(mockLoggerFactory, mockUsecase)
+	tool := stopmatlabsession.New(mockLoggerFactory, mockConfirmer, mockUsecase)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
 }
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           stopmatlabsession.Args
+		expectedAction string
+	}{
+		{
+			name:           "SessionID",
+			args:           stopmatlabsession.Args{SessionID: 3},
+			expectedAction: "Stop MATLAB session 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "stop_matlab_session", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			tool := stopmatlabsession.New(mockLoggerFactory, mockConfirmer, mockUsecase)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
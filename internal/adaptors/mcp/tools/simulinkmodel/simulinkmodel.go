@@ -0,0 +1,36 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package simulinkmodel holds the output that the Simulink tools share, to report block parameters and diagnostics.
+package simulinkmodel
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type BlockParameter struct {
+	Name  string `json:"name"  jsonschema:"The name of the parameter, as used by get_param and set_param."`
+	Value string `json:"value" jsonschema:"The value of the parameter as text. Numeric values are written as MATLAB expressions."`
+}
+
+type Diagnostic struct {
+	Severity   string   `json:"severity"             jsonschema:"The severity of the diagnostic: error or warning."`
+	Message    string   `json:"message"              jsonschema:"The message of the diagnostic."`
+	Identifier string   `json:"identifier,omitempty" jsonschema:"The message identifier of the diagnostic."`
+	Blocks     []string `json:"blocks,omitempty"     jsonschema:"The paths of the blocks that the diagnostic is reported for."`
+}
+
+func FromBlockParameters(parameters []simulink.BlockParameter) []BlockParameter {
+	returnArgs := make([]BlockParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		returnArgs = append(returnArgs, BlockParameter(parameter))
+	}
+	return returnArgs
+}
+
+func FromDiagnostics(diagnostics []simulink.Diagnostic) []Diagnostic {
+	returnArgs := make([]Diagnostic, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		returnArgs = append(returnArgs, Diagnostic(diagnostic))
+	}
+	return returnArgs
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromBlockParameters_HappyPath(t *testing.T) {
+	// Arrange
+	parameters := []simulink.BlockParameter{
+		{Name: "Gain", Value: "2.5"},
+		{Name: "SampleTime", Value: "-1"},
+	}
+
+	// Act
+	result := simulinkmodel.FromBlockParameters(parameters)
+
+	// Assert
+	assert.Equal(t, []simulinkmodel.BlockParameter{
+		{Name: "Gain", Value: "2.5"},
+		{Name: "SampleTime", Value: "-1"},
+	}, result)
+}
+
+func TestFromBlockParameters_NoParameters(t *testing.T) {
+	// Act
+	result := simulinkmodel.FromBlockParameters(nil)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestFromDiagnostics_HappyPath(t *testing.T) {
+	// Arrange
+	diagnostics := []simulink.Diagnostic{
+		{Severity: simulink.SeverityError, Message: "Undefined variable Kp", Identifier: "Simulink:Parameters:InvParamSetting", Blocks: []string{"controller/Gain"}},
+		{Severity: simulink.SeverityWarning, Message: "Unconnected output port"},
+	}
+
+	// Act
+	result := simulinkmodel.FromDiagnostics(diagnostics)
+
+	// Assert
+	assert.Equal(t, []simulinkmodel.Diagnostic{
+		{Severity: "error", Message: "Undefined variable Kp", Identifier: "Simulink:Parameters:InvParamSetting", Blocks: []string{"controller/Gain"}},
+		{Severity: "warning", Message: "Unconnected output port"},
+	}, result)
+}
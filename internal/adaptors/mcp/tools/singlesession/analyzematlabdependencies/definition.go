@@ -0,0 +1,35 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+const (
+	name        = "analyze_matlab_dependencies"
+	title       = "Analyze MATLAB Dependencies"
+	description = "Find what MATLAB code depends on, for a MATLAB code file, or for all MATLAB code files (.m and .mlx) in a folder or MATLAB project and its subfolders (`path`), in an existing MATLAB session. Returns the other user files that the code needs, the MathWorks products that it needs with their versions, and the functions that it calls but that MATLAB cannot find, such as functions from toolboxes that are not installed or files that are missing. Each required product is compared with the products installed in the MATLAB session, and the products that are not installed are listed in missing_products. Use this tool before sharing code, or to check that code only calls functions that are available. This is a read-only operation that does not execute the code."
+)
+
+type Args struct {
+	Path string `json:"path" jsonschema:"The full absolute path to a MATLAB code file (.m or .mlx), or to a folder or MATLAB project root folder. Example: C:\\Users\\username\\matlab-project\\main.m or /home/user/research."`
+}
+
+type ReturnArgs struct {
+	Path                string               `json:"path"                 jsonschema:"The file or folder that was analyzed."`
+	FileCount           int                  `json:"file_count"           jsonschema:"The number of MATLAB code files that were analyzed."`
+	RequiredFiles       []string             `json:"required_files"       jsonschema:"The other user files that the code depends on."`
+	RequiredProducts    []RequiredProduct    `json:"required_products"    jsonschema:"The MathWorks products that the code depends on."`
+	MissingProducts     []string             `json:"missing_products"     jsonschema:"The names of the required products that are not installed in the MATLAB session."`
+	UnresolvedFunctions []UnresolvedFunction `json:"unresolved_functions" jsonschema:"The functions that the code calls but that MATLAB cannot find. Only .m files are checked."`
+}
+
+type RequiredProduct struct {
+	Name          string `json:"name"           jsonschema:"The name of the product, for example Signal Processing Toolbox."`
+	Version       string `json:"version"        jsonschema:"The version of the product."`
+	ProductNumber int    `json:"product_number" jsonschema:"The MathWorks product number."`
+	Certain       bool   `json:"certain"        jsonschema:"False when MATLAB could not determine for sure that the code uses the product."`
+	Installed     bool   `json:"installed"      jsonschema:"Whether the product is installed in the MATLAB session."`
+}
+
+type UnresolvedFunction struct {
+	Name  string   `json:"name"  jsonschema:"The name of the function."`
+	Files []string `json:"files" jsonschema:"The analyzed files that call the function."`
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request analyzematlabdependencies.Args) (analyzematlabdependencies.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Analyze MATLAB Dependencies tool")
+		defer sessionLogger.Info("Done - Executing Analyze MATLAB Dependencies tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			RequiredFiles:       []string{},
+			RequiredProducts:    []RequiredProduct{},
+			MissingProducts:     []string{},
+			UnresolvedFunctions: []UnresolvedFunction{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, analyzematlabdependencies.Args{
+			Path: inputs.Path,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		result := mcpCompliantZeroValue
+		result.Path = response.Path
+		result.FileCount = len(response.Files)
+		result.RequiredFiles = append(result.RequiredFiles, response.RequiredFiles...)
+		result.MissingProducts = append(result.MissingProducts, response.MissingProducts...)
+
+		for _, product := range response.RequiredProducts {
+			result.RequiredProducts = append(result.RequiredProducts, RequiredProduct(product))
+		}
+
+		for _, function := range response.UnresolvedFunctions {
+			result.UnresolvedFunctions = append(result.UnresolvedFunctions, UnresolvedFunction(function))
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabdependencies_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	analyzematlabdependenciesusecase "github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := analyzematlabdependencies.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/path/to/project"
+	usecaseResponse := analyzematlabdependenciesusecase.ReturnArgs{
+		Path:          projectPath,
+		Files:         []string{"/path/to/project/main.m", "/path/to/project/helper.m"},
+		RequiredFiles: []string{"/path/to/shared/loadData.m"},
+		RequiredProducts: []analyzematlabdependenciesusecase.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false, Installed: false},
+		},
+		MissingProducts: []string{"Signal Processing Toolbox"},
+		UnresolvedFunctions: []analyzematlabdependenciesusecase.UnresolvedFunction{
+			{Name: "fancyFilter", Files: []string{"/path/to/project/main.m"}},
+		},
+	}
+	expectedResult := analyzematlabdependencies.ReturnArgs{
+		Path:          projectPath,
+		FileCount:     2,
+		RequiredFiles: []string{"/path/to/shared/loadData.m"},
+		RequiredProducts: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false, Installed: false},
+		},
+		MissingProducts: []string{"Signal Processing Toolbox"},
+		UnresolvedFunctions: []analyzematlabdependencies.UnresolvedFunction{
+			{Name: "fancyFilter", Files: []string{"/path/to/project/main.m"}},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabdependenciesusecase.Args{Path: projectPath}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, analyzematlabdependencies.Args{Path: projectPath})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match")
+}
+
+func TestTool_Handler_NoDependencies(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/path/to/script.m"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabdependenciesusecase.Args{Path: scriptPath}).
+		Return(analyzematlabdependenciesusecase.ReturnArgs{Path: scriptPath, Files: []string{scriptPath}}, nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, analyzematlabdependencies.Args{Path: scriptPath})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, 1, result.FileCount, "File count should match")
+	assert.NotNil(t, result.RequiredFiles, "Required files should not be nil")
+	assert.NotNil(t, result.RequiredProducts, "Required products should not be nil")
+	assert.NotNil(t, result.MissingProducts, "Missing products should not be nil")
+	assert.NotNil(t, result.UnresolvedFunctions, "Unresolved functions should not be nil")
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, analyzematlabdependencies.Args{Path: "/path/to/project"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.RequiredProducts, "Required products should not be nil")
+	assert.Empty(t, result.RequiredProducts, "Required products should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/path/to/project"
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabdependenciesusecase.Args{Path: projectPath}).
+		Return(analyzematlabdependenciesusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, analyzematlabdependencies.Args{Path: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.UnresolvedFunctions, "Unresolved functions should not be nil")
+	assert.Empty(t, result.UnresolvedFunctions, "Unresolved functions should be empty on error")
+}
+
+func TestAnalyzeMATLABDependencies_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewReadOnlyAnnotations()
+
+	// Act
+	tool := analyzematlabdependencies.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabproject
+
+const (
+	name        = "analyze_matlab_project"
+	title       = "Analyze MATLAB Project"
+	description = "Perform static code analysis on all MATLAB code files (.m and .mlx) in a folder or MATLAB project (`folder_path`) and its subfolders, using MATLAB's built-in Code Analyzer in an existing MATLAB session. If the folder has a Code Analyzer configuration file (resources/codeAnalyzerConfiguration.json), the analysis uses it. Returns each issue with its file, location, check ID, severity and whether MATLAB can fix it automatically. Hidden folders, such as .git, are skipped. This is a non-destructive operation that does not execute any code. To also get the issues as a SARIF 2.1.0 report for code scanning tools, set `sarif_file`. To analyze a single file, use check_matlab_code instead."
+)
+
+type Args struct {
+	FolderPath string `json:"folder_path"          jsonschema:"The full absolute path to the folder or MATLAB project root folder to analyze. Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	SARIFFile  string `json:"sarif_file,omitempty" jsonschema:"Optional. The full absolute path of a .sarif file to write the issues to, as a SARIF 2.1.0 report. The tool creates or replaces the file. Example: /home/user/research/codeissues.sarif."`
+}
+
+type ReturnArgs struct {
+	FolderPath        string      `json:"folder_path"                  jsonschema:"The folder that was analyzed."`
+	ConfigurationFile string      `json:"configuration_file,omitempty" jsonschema:"The Code Analyzer configuration file that the analysis used, if the folder has one."`
+	FileCount         int         `json:"file_count"                   jsonschema:"The number of files that were analyzed."`
+	CodeIssues        []CodeIssue `json:"code_issues"                  jsonschema:"The issues found, sorted by file, line and column."`
+	SARIFFile         string      `json:"sarif_file,omitempty"         jsonschema:"The SARIF report that the tool wrote, if sarif_file was set."`
+}
+
+type CodeIssue struct {
+	File        string `json:"file"               jsonschema:"The full path of the file that has the issue."`
+	CheckID     string `json:"check_id,omitempty" jsonschema:"The ID of the Code Analyzer check, for example NASGU. Use it to suppress the check with a %#ok<ID> comment or in the configuration file."`
+	Description string `json:"description"        jsonschema:"Description of the code issue."`
+	Line        int    `json:"line"               jsonschema:"Line number where the issue occurs."`
+	StartColumn int    `json:"start_column"       jsonschema:"Starting column position of the issue."`
+	EndColumn   int    `json:"end_column"         jsonschema:"Ending column position of the issue."`
+	Severity    string `json:"severity"           jsonschema:"Severity level of the issue (e.g., info, warning, error)."`
+	Fixable     bool   `json:"fixable"            jsonschema:"Whether the issue can be automatically fixed using MATLAB in-built 'fix' method."`
+}
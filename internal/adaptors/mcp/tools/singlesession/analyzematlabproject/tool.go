@@ -0,0 +1,91 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabproject
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request analyzematlabproject.Args) (analyzematlabproject.ReturnArgs, error)
+}
+
+type SARIFWriter interface {
+	WriteSARIF(path string, result analyzematlabproject.ReturnArgs) error
+}
+
+const sarifFileExt = ".sarif"
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+	sarifWriter SARIFWriter,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewWriteAnnotations(), loggerFactory, Handler(usecase, globalMATLAB, sarifWriter)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB, sarifWriter SARIFWriter) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Analyze MATLAB Project tool")
+		defer sessionLogger.Info("Done - Executing Analyze MATLAB Project tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			CodeIssues: []CodeIssue{},
+		}
+
+		// Check the SARIF file before the analysis, which can take a while
+		if inputs.SARIFFile != "" {
+			if !filepath.IsAbs(inputs.SARIFFile) || !strings.EqualFold(filepath.Ext(inputs.SARIFFile), sarifFileExt) {
+				return mcpCompliantZeroValue, fmt.Errorf("the SARIF file must be an absolute path to a %s file: %s", sarifFileExt, inputs.SARIFFile)
+			}
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, analyzematlabproject.Args{
+			FolderPath: inputs.FolderPath,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		result := ReturnArgs{
+			FolderPath:        response.FolderPath,
+			ConfigurationFile: response.ConfigurationFile,
+			FileCount:         len(response.Files),
+			CodeIssues:        make([]CodeIssue, len(response.Issues)),
+		}
+
+		for i, issue := range response.Issues {
+			result.CodeIssues[i] = CodeIssue(issue)
+		}
+
+		if inputs.SARIFFile != "" {
+			if err := sarifWriter.WriteSARIF(inputs.SARIFFile, response); err != nil {
+				return mcpCompliantZeroValue, err
+			}
+			result.SARIFFile = inputs.SARIFFile
+		}
+
+		return result, nil
+	}
+}
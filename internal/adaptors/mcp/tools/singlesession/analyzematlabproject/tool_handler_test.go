@@ -0,0 +1,367 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabproject_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	analyzematlabprojectusecase "github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/analyzematlabproject"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	// Act
+	tool := analyzematlabproject.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockSARIFWriter)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const folderPath = "/path/to/project"
+	usecaseResponse := analyzematlabprojectusecase.ReturnArgs{
+		FolderPath:        folderPath,
+		ConfigurationFile: "/path/to/project/resources/codeAnalyzerConfiguration.json",
+		Files:             []string{"/path/to/project/a.m", "/path/to/project/b.m"},
+		Issues: []analyzematlabprojectusecase.CodeIssue{
+			{
+				File:        "/path/to/project/a.m",
+				CheckID:     "NASGU",
+				Description: "Warning message",
+				Line:        1,
+				StartColumn: 1,
+				EndColumn:   10,
+				Severity:    "warning",
+				Fixable:     true,
+			},
+		},
+	}
+	expectedResult := analyzematlabproject.ReturnArgs{
+		FolderPath:        folderPath,
+		ConfigurationFile: "/path/to/project/resources/codeAnalyzerConfiguration.json",
+		FileCount:         2,
+		CodeIssues: []analyzematlabproject.CodeIssue{
+			{
+				File:        "/path/to/project/a.m",
+				CheckID:     "NASGU",
+				Description: "Warning message",
+				Line:        1,
+				StartColumn: 1,
+				EndColumn:   10,
+				Severity:    "warning",
+				Fixable:     true,
+			},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabprojectusecase.Args{FolderPath: folderPath}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: folderPath})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result, "Result should match")
+}
+
+func TestTool_Handler_NoIssues(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const folderPath = "/path/to/project"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabprojectusecase.Args{FolderPath: folderPath}).
+		Return(analyzematlabprojectusecase.ReturnArgs{FolderPath: folderPath, Files: []string{"/path/to/project/a.m"}}, nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: folderPath})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, 1, result.FileCount, "File count should match")
+	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
+	assert.Empty(t, result.CodeIssues, "Code issues should be empty")
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: "/path/to/project"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
+	assert.Empty(t, result.CodeIssues, "Code issues should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const folderPath = "/path/to/project"
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabprojectusecase.Args{FolderPath: folderPath}).
+		Return(analyzematlabprojectusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: folderPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
+	assert.Empty(t, result.CodeIssues, "Code issues should be empty on error")
+}
+
+func TestTool_Handler_WritesSARIFFile(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	folderPath := filepath.Join(string(filepath.Separator)+"path", "to", "project")
+	sarifFile := filepath.Join(folderPath, "codeissues.sarif")
+	usecaseResponse := analyzematlabprojectusecase.ReturnArgs{
+		FolderPath: folderPath,
+		Files:      []string{filepath.Join(folderPath, "a.m")},
+		Issues: []analyzematlabprojectusecase.CodeIssue{
+			{File: filepath.Join(folderPath, "a.m"), CheckID: "NASGU", Description: "Warning message", Line: 1, StartColumn: 1, EndColumn: 10, Severity: "warning"},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabprojectusecase.Args{FolderPath: folderPath}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	mockSARIFWriter.EXPECT().
+		WriteSARIF(sarifFile, usecaseResponse).
+		Return(nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: folderPath, SARIFFile: sarifFile})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, sarifFile, result.SARIFFile, "Result should name the SARIF file")
+	assert.Len(t, result.CodeIssues, 1, "Result should still have the code issues")
+}
+
+func TestTool_Handler_SARIFFileWriteError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	folderPath := filepath.Join(string(filepath.Separator)+"path", "to", "project")
+	sarifFile := filepath.Join(folderPath, "codeissues.sarif")
+	usecaseResponse := analyzematlabprojectusecase.ReturnArgs{FolderPath: folderPath}
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabprojectusecase.Args{FolderPath: folderPath}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	mockSARIFWriter.EXPECT().
+		WriteSARIF(sarifFile, usecaseResponse).
+		Return(expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(ctx, mockLogger, analyzematlabproject.Args{FolderPath: folderPath, SARIFFile: sarifFile})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result.SARIFFile, "Result should not name a SARIF file on error")
+}
+
+func TestTool_Handler_InvalidSARIFFile(t *testing.T) {
+	folderPath := filepath.Join(string(filepath.Separator)+"path", "to", "project")
+
+	testCases := []struct {
+		name      string
+		sarifFile string
+	}{
+		{name: "relative path", sarifFile: "codeissues.sarif"},
+		{name: "not a SARIF file", sarifFile: filepath.Join(folderPath, "codeissues.json")},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockSARIFWriter := &mocks.MockSARIFWriter{}
+			defer mockSARIFWriter.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			// Act
+			result, err := analyzematlabproject.Handler(mockUsecase, mockGlobalMATLAB, mockSARIFWriter)(t.Context(), mockLogger, analyzematlabproject.Args{FolderPath: folderPath, SARIFFile: testCase.sarifFile})
+
+			// Assert
+			require.Error(t, err, "Handler should reject the SARIF file before the analysis")
+			assert.Contains(t, err.Error(), testCase.sarifFile, "Error should name the SARIF file")
+			assert.NotNil(t, result.CodeIssues, "Code issues should not be nil")
+		})
+	}
+}
+
+func TestAnalyzeMATLABProject_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockSARIFWriter := &mocks.MockSARIFWriter{}
+	defer mockSARIFWriter.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewWriteAnnotations()
+
+	// Act
+	tool := analyzematlabproject.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockSARIFWriter)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have write annotations")
+}
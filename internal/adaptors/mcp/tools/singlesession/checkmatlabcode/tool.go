@@ -21,11 +21,15 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	pathCompleter basetool.PathCompleter,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"script_path": pathCompleter.Files(".m", ".mlx"),
+			}),
 	}
 }
 
@@ -21,17 +21,30 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m", ".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := checkmatlabcode.New(mockLoggerFactory, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Len(t, tool.CompletionProviders(), 1)
+	assert.Contains(t, tool.CompletionProviders(), "script_path")
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -226,6 +239,12 @@ func TestCheckMATLABCode_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -234,8 +253,13 @@ func TestCheckMATLABCode_Annotations(t *testing.T) {
 
 	expectedAnnotations := annotations.NewReadOnlyAnnotations()
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m", ".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := checkmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := checkmatlabcode.New(mockLoggerFactory, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package clearmatlabbreakpoints
+
+const (
+	name        = "clear_matlab_breakpoints"
+	title       = "Clear MATLAB Breakpoints"
+	description = "Clear breakpoints in an existing MATLAB session. Clears the breakpoint at `line` of the MATLAB file `file_path`, all breakpoints of `file_path` when `line` is not set, the breakpoints that stop on errors when `stop_on` is set, or all breakpoints when no argument is set. Returns the breakpoints that remain set."
+)
+
+type Args struct {
+	FilePath string `json:"file_path,omitempty" jsonschema:"(Optional) The full absolute path to the MATLAB file to clear breakpoints in. Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/matlab/myFunction.m."`
+	Line     int    `json:"line,omitempty"      jsonschema:"(Optional) The 1-based line of the breakpoint to clear, in file_path."`
+	StopOn   string `json:"stop_on,omitempty"   jsonschema:"(Optional) Clear the breakpoints that stop on errors instead: error for uncaught errors, or caught_error for errors raised inside a try block."`
+}
+
+type ReturnArgs struct {
+	Breakpoints string `json:"breakpoints" jsonschema:"The breakpoints that remain set, as displayed by dbstatus."`
+}
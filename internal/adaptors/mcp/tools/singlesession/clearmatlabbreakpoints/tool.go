@@ -0,0 +1,71 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package clearmatlabbreakpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+type Usecase interface {
+	ClearBreakpoints(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request debugmatlab.ClearBreakpointsArgs) (debugmatlab.BreakpointsReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	switch {
+	case inputs.StopOn != "":
+		return "Clear the breakpoints that stop on " + inputs.StopOn
+	case inputs.FilePath != "" && inputs.Line > 0:
+		return fmt.Sprintf("Clear the breakpoint in %s at line %d", inputs.FilePath, inputs.Line)
+	case inputs.FilePath != "":
+		return "Clear the breakpoints in " + inputs.FilePath
+	default:
+		return "Clear all breakpoints"
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Clear MATLAB Breakpoints tool")
+		defer sessionLogger.Info("Done - Executing Clear MATLAB Breakpoints tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.ClearBreakpoints(ctx, sessionLogger, client, debugmatlab.ClearBreakpointsArgs{
+			Trigger:  debugmatlab.BreakpointTrigger(inputs.StopOn),
+			FilePath: inputs.FilePath,
+			Line:     inputs.Line,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Breakpoints: response.Breakpoints,
+		}, nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package clearmatlabbreakpoints_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := clearmatlabbreakpoints.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "clear_matlab_breakpoints", tool.Name())
+	assert.Equal(t, annotations.NewDestructiveAnnotations(), tool.Annotations(), "Tool should have destructive annotations")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const remaining = "Stop if error."
+	args := clearmatlabbreakpoints.Args{
+		FilePath: "/some/path/myFunction.m",
+		Line:     12,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ClearBreakpoints(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlab.ClearBreakpointsArgs{
+			FilePath: "/some/path/myFunction.m",
+			Line:     12,
+		}).
+		Return(debugmatlab.BreakpointsReturnArgs{Breakpoints: remaining}, nil).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, clearmatlabbreakpoints.ReturnArgs{Breakpoints: remaining}, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, clearmatlabbreakpoints.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ClearBreakpoints(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlab.ClearBreakpointsArgs{Trigger: debugmatlab.BreakpointTriggerError}).
+		Return(debugmatlab.BreakpointsReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, clearmatlabbreakpoints.Args{StopOn: "error"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
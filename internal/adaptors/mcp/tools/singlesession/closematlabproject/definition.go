@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package closematlabproject
+
+const (
+	name        = "close_matlab_project"
+	title       = "Close MATLAB Project"
+	description = "Close the MATLAB project that is open in an existing MATLAB session. Closing a project runs its shutdown tasks and removes its folders from the MATLAB path. To switch to another project, use `open_matlab_project` instead, which closes the open project. Returns the name and root folder of the closed project, and the number of files it contains."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Name       string `json:"name"        jsonschema:"The name of the closed project."`
+	RootFolder string `json:"root_folder" jsonschema:"The full path of the root folder of the closed project."`
+	FileCount  int    `json:"file_count"  jsonschema:"The number of files in the closed project."`
+}
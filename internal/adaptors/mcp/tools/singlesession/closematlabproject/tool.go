@@ -0,0 +1,55 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package closematlabproject
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+)
+
+type Usecase interface {
+	Close(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (matlabproject.Project, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm. Closing a project runs its shutdown tasks.
+func describeAction(Args) string {
+	return "Close the open MATLAB project and run its shutdown tasks"
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Close MATLAB Project tool")
+		defer sessionLogger.Info("Done - Executing Close MATLAB Project tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		project, err := usecase.Close(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs(project), nil
+	}
+}
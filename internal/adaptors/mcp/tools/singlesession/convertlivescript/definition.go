@@ -0,0 +1,21 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package convertlivescript
+
+const (
+	name        = "convert_live_script"
+	title       = "Convert Live Script"
+	description = "Export a MATLAB live script (`live_script_path`, a .mlx file) with the Live Editor of an existing MATLAB session, to plain text live code (`format` m), HTML (`format` html), or PDF (`format` pdf). The output file is written next to the live script with the extension of the format, unless `output_path` is set. An existing output file is only replaced when `overwrite` is true. The live script is not run. Returns the path of the output file."
+)
+
+type Args struct {
+	LiveScriptPath string `json:"live_script_path"      jsonschema:"The full absolute path to the live script to convert. Must be a .mlx file that exists. Example: C:\\Users\\username\\matlab\\report.mlx or /home/user/matlab/report.mlx."`
+	Format         string `json:"format"                jsonschema:"The format to convert to: m for plain text live code, html, or pdf."`
+	OutputPath     string `json:"output_path,omitempty" jsonschema:"(Optional) The full absolute path of the output file, with the extension of the format. Its folder must exist. Defaults to the live script path with the extension of the format."`
+	Overwrite      bool   `json:"overwrite,omitempty"   jsonschema:"(Optional) Replace the output file if it already exists. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	OutputPath string `json:"output_path" jsonschema:"The full absolute path of the output file."`
+	Format     string `json:"format"      jsonschema:"The format that the live script was converted to."`
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package convertlivescript
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/convertlivescript"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request convertlivescript.Args) (convertlivescript.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	pathCompleter basetool.PathCompleter,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).
+			WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"live_script_path": pathCompleter.Files(".mlx"),
+				"format":           completion.NewValuesProvider(convertlivescript.FormatM, convertlivescript.FormatHTML, convertlivescript.FormatPDF),
+			}),
+	}
+}
+
+// describeAction describes a call for the user to confirm, with the file that the tool writes.
+func describeAction(inputs Args) string {
+	action := "Convert the live script " + inputs.LiveScriptPath
+	if inputs.Format != "" {
+		action += " to " + inputs.Format
+	}
+	if inputs.OutputPath != "" {
+		action += " and save it as " + inputs.OutputPath
+	}
+	if inputs.Overwrite {
+		action += ", overwriting an existing file"
+	}
+	return action
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Convert Live Script tool")
+		defer sessionLogger.Info("Done - Executing Convert Live Script tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, convertlivescript.Args{
+			LiveScriptPath: inputs.LiveScriptPath,
+			Format:         inputs.Format,
+			OutputPath:     inputs.OutputPath,
+			Overwrite:      inputs.Overwrite,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			OutputPath: response.OutputPath,
+			Format:     response.Format,
+		}, nil
+	}
+}
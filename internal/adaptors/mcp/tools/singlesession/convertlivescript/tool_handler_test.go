@@ -0,0 +1,189 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package convertlivescript_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	convertlivescriptusecase "github.com/matlab/matlab-mcp-server/internal/usecases/convertlivescript"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/convertlivescript"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockPathCompleter.EXPECT().
+		Files([]string{".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
+	// Act
+	tool := convertlivescript.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Len(t, tool.CompletionProviders(), 2)
+	assert.Contains(t, tool.CompletionProviders(), "live_script_path")
+	assert.Contains(t, tool.CompletionProviders(), "format")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const liveScriptPath = "/path/to/report.mlx"
+	const outputPath = "/path/to/exports/report.pdf"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, convertlivescriptusecase.Args{
+			LiveScriptPath: liveScriptPath,
+			Format:         "pdf",
+			OutputPath:     outputPath,
+			Overwrite:      true,
+		}).
+		Return(convertlivescriptusecase.ReturnArgs{OutputPath: outputPath, Format: "pdf"}, nil).
+		Once()
+
+	// Act
+	result, err := convertlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         "pdf",
+		OutputPath:     outputPath,
+		Overwrite:      true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, convertlivescript.ReturnArgs{OutputPath: outputPath, Format: "pdf"}, result, "Result should match")
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := convertlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, convertlivescript.Args{LiveScriptPath: "/path/to/report.mlx", Format: "html"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const liveScriptPath = "/path/to/report.mlx"
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, convertlivescriptusecase.Args{LiveScriptPath: liveScriptPath, Format: "html"}).
+		Return(convertlivescriptusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := convertlivescript.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, convertlivescript.Args{LiveScriptPath: liveScriptPath, Format: "html"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestConvertLiveScript_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	mockPathCompleter.EXPECT().
+		Files([]string{".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
+	// Act
+	tool := convertlivescript.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations because it writes files")
+}
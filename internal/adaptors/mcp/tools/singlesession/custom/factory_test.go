@@ -64,7 +64,7 @@ func TestFactory_LoadTools_HappyPath(t *testing.T) {
 		Return(validatedTools, nil).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockConfigFactory)
+	factory := custom.NewFactory(mockLoader, mockLoggerFactory, nil, nil, mockUsecase, mockGlobalMATLAB, mockConfigFactory)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
@@ -88,7 +88,7 @@ func TestFactory_LoadTools_EmptyList(t *testing.T) {
 		Return([]definition.ValidatedTool{}, nil).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil)
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil, nil)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
@@ -111,7 +111,7 @@ func TestFactory_LoadTools_LoaderError_ReturnsError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil)
+	factory := custom.NewFactory(mockLoader, nil, nil, nil, nil, nil, nil)
 
 	// Act
 	tools, err := factory.LoadTools(expectedFilePath)
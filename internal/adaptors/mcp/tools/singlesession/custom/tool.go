@@ -4,8 +4,10 @@ package custom
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
@@ -13,6 +15,7 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/facades/mcpfacade"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool/functioncall"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -29,6 +32,10 @@ type Usecase interface {
 	) (entities.EvalResponse, error)
 }
 
+type FunctionCallAssembler interface {
+	Assemble(args functioncall.Args) (string, error)
+}
+
 type Tool struct {
 	validatedTool definition.ValidatedTool
 	handler       mcp.ToolHandlerFor[map[string]any, any]
@@ -38,13 +45,15 @@ type Tool struct {
 func NewTool(
 	validatedTool definition.ValidatedTool,
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	functionCallAssembler FunctionCallAssembler,
 	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
 		validatedTool: validatedTool,
-		handler:       Handler(validatedTool, loggerFactory, configFactory, usecase, globalMATLAB),
+		handler:       Handler(validatedTool, loggerFactory, confirmer, functionCallAssembler, configFactory, usecase, globalMATLAB),
 		toolAdder:     mcpfacade.NewToolAdder[map[string]any, any](),
 	}
 }
@@ -53,6 +62,18 @@ func (t *Tool) Name() string {
 	return t.validatedTool.Definition().Name
 }
 
+func (t *Tool) Title() string {
+	return t.validatedTool.Definition().Title
+}
+
+func (t *Tool) Description() string {
+	return t.validatedTool.Definition().Description
+}
+
+func (t *Tool) GetInputSchema() (any, error) {
+	return t.validatedTool.Definition().InputSchema, nil
+}
+
 func (t *Tool) AddToServer(server *mcp.Server) error {
 	toolDef := t.validatedTool.Definition()
 	t.toolAdder.AddTool(
@@ -69,15 +90,44 @@ func (t *Tool) AddToServer(server *mcp.Server) error {
 	return nil
 }
 
+// CompletionProviders suggests the values of the arguments whose input schema has an enum.
+func (t *Tool) CompletionProviders() map[string]basetool.CompletionProvider {
+	inputSchema := t.validatedTool.Definition().InputSchema
+	if inputSchema == nil {
+		return nil
+	}
+
+	providers := map[string]basetool.CompletionProvider{}
+	for name, prop := range inputSchema.Properties {
+		if prop == nil || len(prop.Enum) == 0 {
+			continue
+		}
+
+		values := make([]string, 0, len(prop.Enum))
+		for _, value := range prop.Enum {
+			values = append(values, fmt.Sprint(value))
+		}
+
+		providers[name] = completion.NewValuesProvider(values...)
+	}
+
+	return providers
+}
+
+// Handler returns the handler of the custom tool. If the tool definition sets destructiveHint to true,
+// the handler asks the user to confirm the function call before running it.
 func Handler(
 	validatedTool definition.ValidatedTool,
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	functionCallAssembler FunctionCallAssembler,
 	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) mcp.ToolHandlerFor[map[string]any, any] {
 	toolDef := validatedTool.Definition()
 	toolSig := validatedTool.Signature()
+	requiresConfirmation := isDestructive(toolDef.Annotations)
 
 	return func(ctx context.Context, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 		logger, messagesErr := loggerFactory.NewMCPSessionLogger(req.Session)
@@ -96,6 +146,26 @@ func Handler(
 			}
 		}
 
+		if requiresConfirmation {
+			functionCall, err := functionCallAssembler.Assemble(functioncall.Args{
+				Function:      toolSig.Function,
+				Order:         toolSig.Input.Order,
+				ArgumentTypes: argumentTypes,
+				Arguments:     args,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if err := confirmer.Confirm(ctx, logger, req.Session, toolDef.Name, functionCall); err != nil {
+				logger.WithError(err).Warn("Custom tool call was not confirmed")
+				return nil, nil, err
+			}
+		}
+
+		ctx, endCall := basetool.BeginCall(ctx, logger, req, toolDef.Name)
+		defer endCall()
+
 		cfg, cfgErr := configFactory.Config()
 		if cfgErr != nil {
 			return nil, nil, cfgErr
@@ -122,3 +192,8 @@ func Handler(
 		), nil, nil
 	}
 }
+
+// isDestructive reports whether the tool definition explicitly sets destructiveHint to true.
+func isDestructive(toolAnnotations *mcp.ToolAnnotations) bool {
+	return toolAnnotations != nil && toolAnnotations.DestructiveHint != nil && *toolAnnotations.DestructiveHint
+}
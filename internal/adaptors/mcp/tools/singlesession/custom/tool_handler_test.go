@@ -12,6 +12,7 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	evalcustomtoolusecase "github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool/functioncall"
 	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	custommocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/custom"
@@ -19,6 +20,7 @@ import (
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -121,13 +123,13 @@ func TestHandler_HappyPath(t *testing.T) {
 				Once()
 
 			mockGlobalMATLAB.EXPECT().
-				Client(ctx, mockSessionLogger.AsMockArg()).
+				Client(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg()).
 				Return(mockMATLABSessionClient, nil).
 				Once()
 
 			mockUsecase.EXPECT().
 				Execute(
-					ctx,
+					mock.AnythingOfType("*context.valueCtx"),
 					mockSessionLogger.AsMockArg(),
 					mockMATLABSessionClient,
 					evalcustomtoolusecase.Args{
@@ -141,7 +143,7 @@ func TestHandler_HappyPath(t *testing.T) {
 				Return(tt.expectedResponse, nil).
 				Once()
 
-			handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+			handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 			// Act
 			result, _, err := handler(ctx, req, args)
@@ -254,13 +256,13 @@ func TestHandler_NoArguments_HappyPath(t *testing.T) {
 				Once()
 
 			mockGlobalMATLAB.EXPECT().
-				Client(ctx, mockSessionLogger.AsMockArg()).
+				Client(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg()).
 				Return(mockMATLABSessionClient, nil).
 				Once()
 
 			mockUsecase.EXPECT().
 				Execute(
-					ctx,
+					mock.AnythingOfType("*context.valueCtx"),
 					mockSessionLogger.AsMockArg(),
 					mockMATLABSessionClient,
 					evalcustomtoolusecase.Args{
@@ -274,7 +276,7 @@ func TestHandler_NoArguments_HappyPath(t *testing.T) {
 				Return(expectedResponse, nil).
 				Once()
 
-			handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+			handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 			// Act
 			result, _, err := handler(ctx, req, args)
@@ -348,7 +350,7 @@ func TestHandler_ConfigError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Act
 	_, _, err := handler(ctx, req, args)
@@ -418,11 +420,11 @@ func TestHandler_ClientError(t *testing.T) {
 		Once()
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockSessionLogger.AsMockArg()).
+		Client(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg()).
 		Return(nil, expectedError).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Act
 	_, _, err := handler(ctx, req, args)
@@ -505,13 +507,13 @@ func TestHandler_UsecaseError(t *testing.T) {
 		Once()
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockSessionLogger.AsMockArg()).
+		Client(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
 	mockUsecase.EXPECT().
 		Execute(
-			ctx,
+			mock.AnythingOfType("*context.valueCtx"),
 			mockSessionLogger.AsMockArg(),
 			mockMATLABSessionClient,
 			evalcustomtoolusecase.Args{
@@ -525,7 +527,7 @@ func TestHandler_UsecaseError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Act
 	_, _, err := handler(ctx, req, args)
@@ -573,7 +575,7 @@ func TestHandler_LoggerFactoryError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, nil, nil, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Act
 	_, _, err := handler(ctx, req, args)
@@ -581,3 +583,257 @@ func TestHandler_LoggerFactoryError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 }
+
+func TestHandler_DestructiveTool_Confirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &custommocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	destructive := true
+	expectedDefinition := definition.Tool{
+		Name:        "delete_results",
+		Annotations: &mcp.ToolAnnotations{DestructiveHint: &destructive},
+		InputSchema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"folder": {Type: "string"},
+			},
+		},
+	}
+	expectedSignature := definition.Signature{
+		Function: "deleteResults",
+		Input:    definition.SignatureInput{Order: []string{"folder"}},
+	}
+	args := map[string]any{"folder": "results"}
+	expectedFunctionCall := `deleteResults("results")`
+	expectedResponse := entities.EvalResponse{ConsoleOutput: "Deleted"}
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(functioncall.Args{
+			Function:      "deleteResults",
+			Order:         []string{"folder"},
+			ArgumentTypes: map[string]string{"folder": "string"},
+			Arguments:     args,
+		}).
+		Return(expectedFunctionCall, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "delete_results", expectedFunctionCall).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(mock.AnythingOfType("*context.valueCtx"), mockSessionLogger.AsMockArg(), mockMATLABSessionClient, evalcustomtoolusecase.Args{
+			Function:      "deleteResults",
+			Order:         []string{"folder"},
+			ArgumentTypes: map[string]string{"folder": "string"},
+			Arguments:     args,
+			CaptureOutput: true,
+		}).
+		Return(expectedResponse, nil).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfirmer, mockFunctionCallAssembler, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+}
+
+func TestHandler_DestructiveTool_NotConfirmed(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &custommocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	mockConfigFactory := &custommocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &custommocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	destructive := true
+	expectedDefinition := definition.Tool{
+		Name:        "delete_results",
+		Annotations: &mcp.ToolAnnotations{DestructiveHint: &destructive},
+	}
+	expectedSignature := definition.Signature{
+		Function: "deleteResults",
+	}
+	args := map[string]any{}
+	expectedFunctionCall := "deleteResults()"
+	expectedError := assert.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(functioncall.Args{Function: "deleteResults", Arguments: args}).
+		Return(expectedFunctionCall, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockSessionLogger.AsMockArg(), expectedSession, "delete_results", expectedFunctionCall).
+		Return(expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfirmer, mockFunctionCallAssembler, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_DestructiveTool_AssembleError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &custommocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	mockSessionLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSession := &mcp.ServerSession{}
+	destructive := true
+	expectedDefinition := definition.Tool{
+		Name:        "delete_results",
+		Annotations: &mcp.ToolAnnotations{DestructiveHint: &destructive},
+	}
+	expectedSignature := definition.Signature{
+		Function: "deleteResults",
+	}
+	args := map[string]any{"unexpected": 1}
+	expectedError := assert.AnError
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(expectedDefinition).
+		Once()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(expectedSignature).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger, nil).
+		Once()
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(functioncall.Args{Function: "deleteResults", Arguments: args}).
+		Return("", expectedError).
+		Once()
+
+	handler := custom.Handler(mockValidatedTool, mockLoggerFactory, mockConfirmer, mockFunctionCallAssembler, nil, nil, nil)
+
+	// Act
+	result, _, err := handler(ctx, req, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, result)
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/definition"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	definitionmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/custom/definition"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -71,7 +73,7 @@ func TestTool_AddToServer_HappyPath(t *testing.T) {
 		).
 		Once()
 
-	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil)
+	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil, nil, nil)
 	tool.SetToolAdder(mockAdder)
 
 	// Act
@@ -80,3 +82,108 @@ func TestTool_AddToServer_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 }
+
+func TestTool_CompletionProviders_HappyPath(t *testing.T) {
+	// Arrange
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{
+			Name: testToolName,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"method": {Type: "string", Enum: []any{"linear", "spline", "Nearest"}},
+					"order":  {Type: "number", Enum: []any{1, 2}},
+					"n":      {Type: "number"},
+				},
+			},
+		}).
+		Twice()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil, nil, nil)
+
+	// Act
+	providers := tool.CompletionProviders()
+
+	// Assert
+	require.Len(t, providers, 2)
+	require.NotContains(t, providers, "n")
+
+	methodValues, err := providers["method"].Complete(ctx, mockLogger, "n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Nearest"}, methodValues)
+
+	orderValues, err := providers["order"].Complete(ctx, mockLogger, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, orderValues)
+}
+
+func TestTool_CompletionProviders_NoInputSchema(t *testing.T) {
+	// Arrange
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{Name: testToolName}).
+		Twice()
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil, nil, nil)
+
+	// Act
+	providers := tool.CompletionProviders()
+
+	// Assert
+	assert.Empty(t, providers)
+}
+
+func TestTool_Definition_HappyPath(t *testing.T) {
+	// Arrange
+	mockValidatedTool := &definitionmocks.MockValidatedTool{}
+	defer mockValidatedTool.AssertExpectations(t)
+
+	expectedInputSchema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"n": {Type: "number"},
+		},
+	}
+
+	mockValidatedTool.EXPECT().
+		Definition().
+		Return(definition.Tool{
+			Name:        testToolName,
+			Title:       testToolTitle,
+			Description: testToolDescription,
+			InputSchema: expectedInputSchema,
+		})
+	mockValidatedTool.EXPECT().
+		Signature().
+		Return(definition.Signature{}).
+		Once()
+
+	tool := custom.NewTool(mockValidatedTool, nil, nil, nil, nil, nil, nil)
+
+	// Act
+	inputSchema, err := tool.GetInputSchema()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedInputSchema, inputSchema)
+	assert.Equal(t, testToolTitle, tool.Title())
+	assert.Equal(t, testToolDescription, tool.Description())
+}
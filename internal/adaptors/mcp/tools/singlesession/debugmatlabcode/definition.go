@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlabcode
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+
+const (
+	name        = "debug_matlab_code"
+	title       = "Debug MATLAB Code"
+	description = "Run MATLAB code in an existing MATLAB session until it completes or pauses at a breakpoint set with `set_matlab_breakpoint`. When the code pauses, returns the stack with the file and line of each paused function, and the local variables of the innermost one. While MATLAB is paused, the code runs in the workspace of the paused function, which lets you inspect or change its variables. Use `step_matlab_debugger` to step, continue or quit once paused."
+)
+
+type Args struct {
+	Code string `json:"code" jsonschema:"The MATLAB code to run. Example: myFunction(3, 4)."`
+}
+
+type ReturnArgs = debugstate.ReturnArgs
@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+type Usecase interface {
+	Run(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, code string) (debugmatlab.State, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm, with the exact code that the tool runs.
+func describeAction(inputs Args) string {
+	return inputs.Code
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Debug MATLAB Code tool")
+		defer sessionLogger.Info("Done - Executing Debug MATLAB Code tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		state, err := usecase.Run(ctx, sessionLogger, client, inputs.Code)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return debugstate.FromState(state), nil
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := debugmatlabcode.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "debug_matlab_code", tool.Name())
+	assert.Equal(t, annotations.NewDestructiveAnnotations(), tool.Annotations(), "Tool should have destructive annotations")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const code = "myFunction(3, 4)"
+	state := debugmatlab.State{
+		Paused:    true,
+		Stack:     []debugmatlab.Frame{{Name: "myFunction", File: "/some/path/myFunction.m", Line: 12}},
+		Variables: []debugmatlab.Variable{{Name: "x", Class: "double", Size: "1x1", Value: "3"}},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Run(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, code).
+		Return(state, nil).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, debugmatlabcode.Args{Code: code})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugstate.FromState(state), result)
+	assert.True(t, result.Paused)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, debugmatlabcode.Args{Code: "x = 1;"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Run(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, "x = 1;").
+		Return(debugmatlab.State{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, debugmatlabcode.Args{Code: "x = 1;"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+	const code = "myFunction(3, 4)"
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mock.Anything, session, "debug_matlab_code", code).
+		Return(assert.AnError).
+		Once()
+
+	tool := debugmatlabcode.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, debugmatlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError, "Handler should return the confirmation error")
+	assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+}
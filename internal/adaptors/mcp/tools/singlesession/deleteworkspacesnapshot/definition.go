@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package deleteworkspacesnapshot
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+
+const (
+	name        = "delete_workspace_snapshot"
+	title       = "Delete MATLAB Workspace Snapshot"
+	description = "Delete a workspace snapshot of an existing MATLAB session, taken with `snapshot_workspace`, to make room for new snapshots. The variables in the workspace are not changed. Returns the deleted snapshot."
+)
+
+type Args struct {
+	Name string `json:"name" jsonschema:"The name of the snapshot to delete. Use list_workspace_snapshots to see the snapshots."`
+}
+
+type ReturnArgs = workspacesnapshotinfo.Snapshot
@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package deleteworkspacesnapshot
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+type Usecase interface {
+	Delete(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string) (workspacesnapshot.Snapshot, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return "Delete workspace snapshot " + inputs.Name
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Delete Workspace Snapshot tool")
+		defer sessionLogger.Info("Done - Executing Delete Workspace Snapshot tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		snapshot, err := usecase.Delete(ctx, sessionLogger, client, inputs.Name)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return workspacesnapshotinfo.FromSnapshot(snapshot), nil
+	}
+}
@@ -5,12 +5,25 @@ package detectmatlabtoolboxes
 const (
 	name        = "detect_matlab_toolboxes"
 	title       = "Detect MATLAB Toolboxes"
-	description = "Returns information about installed MATLAB and toolboxes, including version numbers."
+	description = "Returns information about installed MATLAB and toolboxes, including version numbers, releases and product base codes. The information is read from the MATLAB installation without starting MATLAB, unless the server is attached to an existing MATLAB session. When read from an installation, matlab_root names it; a MATLAB session that the server attaches to later may use a different installation."
 )
 
 type Args struct {
 }
 
 type ReturnArgs struct {
-	InstallationInfo string `json:"installation_info" jsonschema:"MATLAB installation information including MATLAB version and installed toolboxes with their versions."`
+	InstallationInfo string    `json:"installation_info"     jsonschema:"MATLAB installation information including MATLAB version and installed toolboxes with their versions."`
+	MATLABVersion    string    `json:"matlab_version"        jsonschema:"Full version number of MATLAB, for example 24.2.0.2712019."`
+	MATLABRelease    string    `json:"matlab_release"        jsonschema:"MATLAB release, for example R2024b."`
+	UpdateLevel      int       `json:"update_level"          jsonschema:"Update level of the MATLAB release, or 0 if no update is installed."`
+	Products         []Product `json:"products"              jsonschema:"Installed products, including MATLAB itself."`
+	Source           string    `json:"source"                jsonschema:"Where the information was read from: installation for the product metadata of the MATLAB installation, or session for the ver command in the running MATLAB session."`
+	MATLABRoot       string    `json:"matlab_root,omitempty" jsonschema:"Root folder of the MATLAB installation the information was read from. Only set when source is installation."`
+}
+
+type Product struct {
+	Name     string `json:"name"                jsonschema:"Product name, for example Signal Processing Toolbox."`
+	Version  string `json:"version"             jsonschema:"Product version, for example 24.2."`
+	Release  string `json:"release"             jsonschema:"MATLAB release the product belongs to, for example R2024b."`
+	BaseCode string `json:"base_code,omitempty" jsonschema:"Product base code, for example SG. Only available when read from the MATLAB installation."`
 }
@@ -5,14 +5,29 @@ package detectmatlabtoolboxes
 import (
 	"context"
 
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
 )
 
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type MATLABRootSelector interface {
+	SelectMATLABRoot(ctx context.Context, logger entities.Logger) (string, error)
+}
+
+type GlobalMATLABSession interface {
+	IsAttachedToExistingSession() bool
+}
+
 type Usecase interface {
 	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (detectmatlabtoolboxes.ReturnArgs, error)
+	ExecuteForInstallation(ctx context.Context, sessionLogger entities.Logger, matlabRoot string) (detectmatlabtoolboxes.ReturnArgs, error)
 }
 
 type Tool struct {
@@ -21,11 +36,14 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	configFactory ConfigFactory,
+	matlabRootSelector MATLABRootSelector,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
+	globalMATLABSession GlobalMATLABSession,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(configFactory, matlabRootSelector, usecase, globalMATLAB, globalMATLABSession)),
 	}
 }
 
@@ -37,24 +55,67 @@ func (Tool) Description() string {
 	return description
 }
 
-func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+func Handler(configFactory ConfigFactory, matlabRootSelector MATLABRootSelector, usecase Usecase, globalMATLAB entities.GlobalMATLAB, globalMATLABSession GlobalMATLABSession) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
 		sessionLogger.Info("Executing detect MATLAB toolboxes tool")
 		defer sessionLogger.Info("Done - Executing detect MATLAB toolboxes tool")
 
+		config, messagesErr := configFactory.Config()
+		if messagesErr != nil {
+			return ReturnArgs{}, messagesErr
+		}
+
+		// An existing session may not match any local installation, so only ask it directly.
+		// In auto mode, the server may have attached to an existing session too.
+		if config.MATLABSessionMode() != entities.MATLABSessionModeExisting && !globalMATLABSession.IsAttachedToExistingSession() {
+			tbxInfo, err := detectFromInstallation(ctx, sessionLogger, matlabRootSelector, usecase)
+			if err == nil {
+				return toReturnArgs(tbxInfo), nil
+			}
+			sessionLogger.WithError(err).Warn("Unable to read the MATLAB installation, falling back to the MATLAB session")
+		}
+
 		client, err := globalMATLAB.Client(ctx, sessionLogger)
 		if err != nil {
 			return ReturnArgs{}, err
 		}
 
 		tbxInfo, err := usecase.Execute(ctx, sessionLogger, client)
-
 		if err != nil {
 			return ReturnArgs{}, err
 		}
 
-		return ReturnArgs{
-			InstallationInfo: tbxInfo.Toolboxes,
-		}, nil
+		return toReturnArgs(tbxInfo), nil
+	}
+}
+
+func detectFromInstallation(ctx context.Context, sessionLogger entities.Logger, matlabRootSelector MATLABRootSelector, usecase Usecase) (detectmatlabtoolboxes.ReturnArgs, error) {
+	matlabRoot, err := matlabRootSelector.SelectMATLABRoot(ctx, sessionLogger)
+	if err != nil {
+		return detectmatlabtoolboxes.ReturnArgs{}, err
+	}
+
+	return usecase.ExecuteForInstallation(ctx, sessionLogger, matlabRoot)
+}
+
+func toReturnArgs(tbxInfo detectmatlabtoolboxes.ReturnArgs) ReturnArgs {
+	products := make([]Product, len(tbxInfo.Installation.Products))
+	for i, product := range tbxInfo.Installation.Products {
+		products[i] = Product{
+			Name:     product.Name,
+			Version:  product.Version,
+			Release:  product.Release,
+			BaseCode: product.BaseCode,
+		}
+	}
+
+	return ReturnArgs{
+		InstallationInfo: tbxInfo.Toolboxes,
+		MATLABVersion:    tbxInfo.Installation.Version,
+		MATLABRelease:    tbxInfo.Installation.Release,
+		UpdateLevel:      tbxInfo.Installation.UpdateLevel,
+		Products:         products,
+		Source:           tbxInfo.Source,
+		MATLABRoot:       tbxInfo.MATLABRoot,
 	}
 }
@@ -3,12 +3,16 @@
 package detectmatlabtoolboxes_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	detectmatlabtoolboxesusecase "github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
@@ -21,14 +25,23 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
 	// Act
-	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -36,22 +49,134 @@ func TestNew_HappyPath(t *testing.T) {
 
 func TestTool_Handler_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	ctx := t.Context()
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	usecaseResponse := detectmatlabtoolboxesusecase.ReturnArgs{
+		Toolboxes: "Toolbox list",
+		Installation: entities.MATLABInstallation{
+			Version:     "24.2.0.2712019",
+			Release:     "R2024b",
+			UpdateLevel: 1,
+			Products: []entities.MATLABProduct{
+				{Name: "MATLAB", Version: "24.2", Release: "R2024b", BaseCode: "ML"},
+			},
+		},
+		Source:     detectmatlabtoolboxesusecase.SourceInstallation,
+		MATLABRoot: matlabRoot,
+	}
+
+	expectedResult := detectmatlabtoolboxes.ReturnArgs{
+		InstallationInfo: "Toolbox list",
+		MATLABVersion:    "24.2.0.2712019",
+		MATLABRelease:    "R2024b",
+		UpdateLevel:      1,
+		Products: []detectmatlabtoolboxes.Product{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", BaseCode: "ML"},
+		},
+		Source:     detectmatlabtoolboxesusecase.SourceInstallation,
+		MATLABRoot: matlabRoot,
+	}
+
+	args := detectmatlabtoolboxes.Args{}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeNew).
+		Once()
+
+	mockGlobalMATLABSession.EXPECT().
+		IsAttachedToExistingSession().
+		Return(false).
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return(matlabRoot, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		ExecuteForInstallation(ctx, mockLogger.AsMockArg(), matlabRoot).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ExistingSessionUsesVer(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
 	mockLogger := testutils.NewInspectableLogger()
+
 	ctx := t.Context()
+
 	expectedResponse := detectmatlabtoolboxesusecase.ReturnArgs{
 		Toolboxes: "Toolbox list",
+		Source:    detectmatlabtoolboxesusecase.SourceSession,
 	}
+
 	args := detectmatlabtoolboxes.Args{}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
@@ -63,36 +188,257 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, args)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
 	assert.Equal(t, expectedResponse.Toolboxes, result.InstallationInfo, "Text content should match")
+	assert.Equal(t, detectmatlabtoolboxesusecase.SourceSession, result.Source)
+	assert.Empty(t, result.Products)
 }
 
-func TestTool_Handler_ClientReturnsError(t *testing.T) {
+func TestTool_Handler_AttachedInAutoModeUsesVer(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
 	mockLogger := testutils.NewInspectableLogger()
+
+	ctx := t.Context()
+
+	expectedResponse := detectmatlabtoolboxesusecase.ReturnArgs{
+		Toolboxes: "Toolbox list",
+		Source:    detectmatlabtoolboxesusecase.SourceSession,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeAuto).
+		Once()
+
+	mockGlobalMATLABSession.EXPECT().
+		IsAttachedToExistingSession().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(expectedResponse, nil).
+		Once()
+
+	// Act
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, detectmatlabtoolboxes.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, detectmatlabtoolboxesusecase.SourceSession, result.Source, "An attached session should be asked directly rather than the local installation")
+}
+
+func TestTool_Handler_FallsBackToSessionWhenInstallationCannotBeRead(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		selectRootError      error
+		readInstallationFail bool
+	}{
+		{
+			name:            "MATLAB root selection fails",
+			selectRootError: assert.AnError,
+		},
+		{
+			name:                 "reading installation fails",
+			readInstallationFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockConfig := &configmocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+			defer mockMATLABRootSelector.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+			defer mockGlobalMATLABSession.AssertExpectations(t)
+
+			mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockMATLABSessionClient.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			ctx := t.Context()
+			matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+			expectedResponse := detectmatlabtoolboxesusecase.ReturnArgs{
+				Toolboxes: "Toolbox list",
+				Source:    detectmatlabtoolboxesusecase.SourceSession,
+			}
+
+			mockConfigFactory.EXPECT().
+				Config().
+				Return(mockConfig, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionMode().
+				Return(entities.MATLABSessionModeAuto).
+				Once()
+
+			mockGlobalMATLABSession.EXPECT().
+				IsAttachedToExistingSession().
+				Return(false).
+				Once()
+
+			mockMATLABRootSelector.EXPECT().
+				SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+				Return(matlabRoot, tc.selectRootError).
+				Once()
+
+			if tc.readInstallationFail {
+				mockUsecase.EXPECT().
+					ExecuteForInstallation(ctx, mockLogger.AsMockArg(), matlabRoot).
+					Return(detectmatlabtoolboxesusecase.ReturnArgs{}, assert.AnError).
+					Once()
+			}
+
+			mockGlobalMATLAB.EXPECT().
+				Client(ctx, mockLogger.AsMockArg()).
+				Return(mockMATLABSessionClient, nil).
+				Once()
+
+			mockUsecase.EXPECT().
+				Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+				Return(expectedResponse, nil).
+				Once()
+
+			// Act
+			result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, detectmatlabtoolboxes.Args{})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedResponse.Toolboxes, result.InstallationInfo)
+			assert.Len(t, mockLogger.WarnLogs(), 1, "Falling back to the MATLAB session should trigger a warning log")
+		})
+	}
+}
+
+func TestTool_Handler_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	ctx := t.Context()
+	expectedError := messages.AnError
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, detectmatlabtoolboxes.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
 	ctx := t.Context()
 	expectedError := assert.AnError
+
 	args := detectmatlabtoolboxes.Args{}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(nil, expectedError).
 		Once()
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError, "Handler should return an error")
@@ -101,20 +447,44 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 
 func TestTool_Handler_UsecaseError(t *testing.T) {
 	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
 	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockMATLABSessionClient.AssertExpectations(t)
 
 	mockLogger := testutils.NewInspectableLogger()
+
 	ctx := t.Context()
 	expectedError := assert.AnError
+
 	args := detectmatlabtoolboxes.Args{}
 
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABSessionMode().
+		Return(entities.MATLABSessionModeExisting).
+		Once()
+
 	mockGlobalMATLAB.EXPECT().
 		Client(ctx, mockLogger.AsMockArg()).
 		Return(mockMATLABSessionClient, nil).
@@ -126,7 +496,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := detectmatlabtoolboxes.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+	result, err := detectmatlabtoolboxes.Handler(mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)(ctx, mockLogger, args)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -138,16 +508,25 @@ func TestDetectMATLABToolboxes_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockGlobalMATLABSession := &mocks.MockGlobalMATLABSession{}
+	defer mockGlobalMATLABSession.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	expectedAnnotations := annotations.NewReadOnlyAnnotations()
 
 	// Act
-	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := detectmatlabtoolboxes.New(mockLoggerFactory, mockConfigFactory, mockMATLABRootSelector, mockUsecase, mockGlobalMATLAB, mockGlobalMATLABSession)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
@@ -5,10 +5,15 @@ package evalmatlabcode
 const (
 	name        = "evaluate_matlab_code"
 	title       = "Evaluate MATLAB Code"
-	description = "Evaluate a string of MATLAB code (`code`) in an existing MATLAB session. Optionally specify a project folder (`project_path`) to set as the current working folder before execution. Returns the command window output from code execution."
+	description = "Evaluate a string of MATLAB code (`code`) in an existing MATLAB session. Optionally specify a project folder (`project_path`) to set as the current working folder before execution. Returns the command window output from code execution, and the figures that the code creates. Optionally set the format, size, resolution and largest size in bytes of the figures."
 )
 
 type Args struct {
-	ProjectPath string `json:"project_path,omitempty" jsonschema:"(Optional) Absolute path to the project folder. When provided, MATLAB sets this as the current working folder. If omitted, code runs in MATLAB's current working folder. Example: C:\\Users\\username\\matlab-project or /home/user/research."`
-	Code        string `json:"code"                   jsonschema:"The MATLAB code to evaluate."`
+	ProjectPath    string `json:"project_path,omitempty"     jsonschema:"(Optional) Absolute path to the project folder. When provided, MATLAB sets this as the current working folder. If omitted, code runs in MATLAB's current working folder. Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code           string `json:"code"                       jsonschema:"The MATLAB code to evaluate."`
+	FigureFormat   string `json:"figure_format,omitempty"    jsonschema:"(Optional) The format of the figures: png, jpeg, or svg. SVG figures are returned as embedded resources. When any figure option is set, the figures that the code creates or changes are exported with exportgraphics instead of captured by the Live Editor. Defaults to png."`
+	FigureWidth    int    `json:"figure_width,omitempty"     jsonschema:"(Optional) The width of the figures, in pixels. Defaults to the width of each figure."`
+	FigureHeight   int    `json:"figure_height,omitempty"    jsonschema:"(Optional) The height of the figures, in pixels. Defaults to the height of each figure."`
+	FigureDPI      int    `json:"figure_dpi,omitempty"       jsonschema:"(Optional) The resolution of png and jpeg figures, in dots per inch. Defaults to the resolution of the screen."`
+	MaxFigureBytes int    `json:"max_figure_bytes,omitempty" jsonschema:"(Optional) The largest size of each figure, in bytes. Larger png and jpeg figures are compressed and downscaled to fit, and figures that cannot fit are left out. Defaults to no limit."`
 }
@@ -4,11 +4,13 @@ package evalmatlabcode
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/figurefitter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
@@ -29,15 +31,24 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
 	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
 	}
 }
 
+// describeAction describes a call for the user to confirm, with the exact code that the tool evaluates.
+func describeAction(inputs Args) string {
+	if inputs.ProjectPath == "" {
+		return inputs.Code
+	}
+	return fmt.Sprintf("In %s:\n%s", inputs.ProjectPath, inputs.Code)
+}
+
 func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
 		sessionLogger.Info("Executing Eval tool")
@@ -57,11 +68,17 @@ func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities
 			Code:          inputs.Code,
 			ProjectPath:   inputs.ProjectPath,
 			CaptureOutput: !config.ShouldShowMATLABDesktop(),
+			Figures: entities.FigureOptions{
+				Format: entities.FigureFormat(inputs.FigureFormat),
+				Width:  inputs.FigureWidth,
+				Height: inputs.FigureHeight,
+				DPI:    inputs.FigureDPI,
+			},
 		})
 		if err != nil {
 			return tools.RichContent{}, err
 		}
 
-		return responseconverter.ConvertEvalResponseToRichContent(response), nil
+		return figurefitter.FitRichContent(responseconverter.ConvertEvalResponseToRichContent(response), inputs.MaxFigureBytes), nil
 	}
 }
@@ -15,7 +15,9 @@ import (
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/evalmatlabcode"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -34,7 +39,7 @@ func TestNew_HappyPath(t *testing.T) {
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
 	// Act
-	tool := evalmatlabcode.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.NotNil(t, tool)
@@ -333,6 +338,9 @@ func TestEvaluateMATLABCode_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -345,8 +353,140 @@ func TestEvaluateMATLABCode_Annotations(t *testing.T) {
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
 	// Act
-	tool := evalmatlabcode.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
 }
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           evalmatlabcode.Args
+		expectedAction string
+	}{
+		{
+			name:           "WithoutProjectPath",
+			args:           evalmatlabcode.Args{Code: "x = 1;"},
+			expectedAction: "x = 1;",
+		},
+		{
+			name:           "WithProjectPath",
+			args:           evalmatlabcode.Args{Code: "x = 1;", ProjectPath: "/some/path"},
+			expectedAction: "In /some/path:\nx = 1;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "evaluate_matlab_code", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			tool := evalmatlabcode.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
+
+func TestTool_Handler_FigureOptions(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const code = "plot(1:10); figure; bar(1:3)"
+	smallFigure := []byte("<svg/>")
+	largeFigure := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><path d="M0 0 L100 100"/></svg>`)
+	args := evalmatlabcode.Args{
+		Code:           code,
+		FigureFormat:   "svg",
+		FigureWidth:    640,
+		FigureHeight:   480,
+		MaxFigureBytes: 20,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			evalmatlabcodeusecase.Args{
+				Code:          code,
+				CaptureOutput: true,
+				Figures:       entities.FigureOptions{Format: entities.FigureFormatSVG, Width: 640, Height: 480},
+			},
+		).
+		Return(entities.EvalResponse{Images: [][]byte{largeFigure, smallFigure}}, nil).
+		Once()
+
+	// Act
+	result, err := evalmatlabcode.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.ImageContent, 1, "The figure larger than max_figure_bytes should be left out")
+	assert.Equal(t, smallFigure, []byte(result.ImageContent[0]))
+	assert.Equal(t, []string{"", "Figure 1 was left out: it cannot be made smaller than 20 bytes."}, result.TextContent)
+}
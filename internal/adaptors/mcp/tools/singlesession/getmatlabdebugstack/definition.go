@@ -0,0 +1,16 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabdebugstack
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+
+const (
+	name        = "get_matlab_debug_stack"
+	title       = "Get MATLAB Debug Stack"
+	description = "Report whether an existing MATLAB session is paused in debug mode and, if it is, the stack with the file and line of each paused function, and the local variables of the innermost one."
+)
+
+type Args struct {
+}
+
+type ReturnArgs = debugstate.ReturnArgs
@@ -0,0 +1,50 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package getmatlabdebugstack
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+type Usecase interface {
+	Stack(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (debugmatlab.State, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get MATLAB Debug Stack tool")
+		defer sessionLogger.Info("Done - Executing Get MATLAB Debug Stack tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		state, err := usecase.Stack(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return debugstate.FromState(state), nil
+	}
+}
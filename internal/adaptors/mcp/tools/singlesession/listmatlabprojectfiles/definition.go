@@ -0,0 +1,25 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabprojectfiles
+
+const (
+	name        = "list_matlab_project_files"
+	title       = "List MATLAB Project Files"
+	description = "List the files of the MATLAB project that is open in an existing MATLAB session, with the labels attached to each file, such as the Classification labels Design, Test or Artifact. Use `open_matlab_project` first if no project is open. Returns the path of each file and its labels."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Files []File `json:"files" jsonschema:"The files of the project."`
+}
+
+type File struct {
+	Path   string  `json:"path"   jsonschema:"The full path of the file."`
+	Labels []Label `json:"labels" jsonschema:"The labels attached to the file."`
+}
+
+type Label struct {
+	Category string `json:"category" jsonschema:"The category of the label. Example: Classification."`
+	Name     string `json:"name"     jsonschema:"The name of the label. Example: Test."`
+}
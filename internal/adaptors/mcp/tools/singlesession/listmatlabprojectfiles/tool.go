@@ -0,0 +1,62 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listmatlabprojectfiles
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+)
+
+type Usecase interface {
+	ListFiles(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.File, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing List MATLAB Project Files tool")
+		defer sessionLogger.Info("Done - Executing List MATLAB Project Files tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		files, err := usecase.ListFiles(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		returnArgs := ReturnArgs{Files: make([]File, 0, len(files))}
+		for _, file := range files {
+			labels := make([]Label, 0, len(file.Labels))
+			for _, label := range file.Labels {
+				labels = append(labels, Label(label))
+			}
+
+			returnArgs.Files = append(returnArgs.Files, File{
+				Path:   file.Path,
+				Labels: labels,
+			})
+		}
+
+		return returnArgs, nil
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listworkspacesnapshots
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+
+const (
+	name        = "list_workspace_snapshots"
+	title       = "List MATLAB Workspace Snapshots"
+	description = "List the workspace snapshots of an existing MATLAB session, taken with `snapshot_workspace`, oldest first. Returns the name, time, size and variables of each snapshot, and their total size."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Snapshots      []workspacesnapshotinfo.Snapshot `json:"snapshots"        jsonschema:"The snapshots of the MATLAB session, oldest first."`
+	TotalSizeBytes int64                            `json:"total_size_bytes" jsonschema:"The total size of the snapshots, in bytes."`
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listworkspacesnapshots
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+type Usecase interface {
+	List(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]workspacesnapshot.Snapshot, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing List Workspace Snapshots tool")
+		defer sessionLogger.Info("Done - Executing List Workspace Snapshots tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		snapshots, err := usecase.List(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		returnArgs := ReturnArgs{Snapshots: make([]workspacesnapshotinfo.Snapshot, 0, len(snapshots))}
+		for _, snapshot := range snapshots {
+			returnArgs.Snapshots = append(returnArgs.Snapshots, workspacesnapshotinfo.FromSnapshot(snapshot))
+			returnArgs.TotalSizeBytes += snapshot.SizeBytes
+		}
+
+		return returnArgs, nil
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package listworkspacesnapshots_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := listworkspacesnapshots.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	snapshots := []workspacesnapshot.Snapshot{
+		{Name: "before-fit", CreatedAt: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC), SizeBytes: 2048, Variables: []string{"x"}},
+		{Name: "after-fit", CreatedAt: time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC), SizeBytes: 4096},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		List(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(snapshots, nil).
+		Once()
+
+	// Act
+	result, err := listworkspacesnapshots.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listworkspacesnapshots.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listworkspacesnapshots.ReturnArgs{
+		Snapshots: []workspacesnapshotinfo.Snapshot{
+			{Name: "before-fit", CreatedAt: "2026-03-04T10:30:00Z", SizeBytes: 2048, Variables: []string{"x"}},
+			{Name: "after-fit", CreatedAt: "2026-03-04T11:00:00Z", SizeBytes: 4096, Variables: []string{}},
+		},
+		TotalSizeBytes: 6144,
+	}, result)
+}
+
+func TestTool_Handler_NoSnapshots(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		List(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return([]workspacesnapshot.Snapshot{}, nil).
+		Once()
+
+	// Act
+	result, err := listworkspacesnapshots.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listworkspacesnapshots.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listworkspacesnapshots.ReturnArgs{Snapshots: []workspacesnapshotinfo.Snapshot{}}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := listworkspacesnapshots.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listworkspacesnapshots.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := workspacesnapshot.ErrNoSessionFolder
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		List(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := listworkspacesnapshots.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listworkspacesnapshots.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestListWorkspaceSnapshots_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewReadOnlyAnnotations()
+
+	// Act
+	tool := listworkspacesnapshots.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have read-only annotations")
+}
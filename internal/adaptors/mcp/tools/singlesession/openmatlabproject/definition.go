@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package openmatlabproject
+
+const (
+	name        = "open_matlab_project"
+	title       = "Open MATLAB Project"
+	description = "Open a MATLAB project (`project_path`, a .prj file or the root folder of the project) in an existing MATLAB session. Opening a project closes the project that is open, if any, and runs the startup tasks of the new project, such as adding its folders to the MATLAB path. Without `project_path`, opens the MATLAB project found in the roots of the AI application. Open the project of a repository before running its code or tests. Returns the name and root folder of the project, and the number of files it contains."
+)
+
+type Args struct {
+	ProjectPath string `json:"project_path,omitempty" jsonschema:"(Optional) The full absolute path to the .prj file of the project, or to its root folder. Example: C:\\Users\\username\\weather\\Weather.prj or /home/user/weather. Defaults to the project found in the roots of the AI application."`
+}
+
+type ReturnArgs struct {
+	Name       string `json:"name"        jsonschema:"The name of the project."`
+	RootFolder string `json:"root_folder" jsonschema:"The full path of the root folder of the project."`
+	FileCount  int    `json:"file_count"  jsonschema:"The number of files in the project."`
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package openmatlabproject
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+)
+
+type Usecase interface {
+	Open(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	pathCompleter basetool.PathCompleter,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).
+			WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"project_path": pathCompleter.Files(".prj"),
+			}),
+	}
+}
+
+// describeAction describes a call for the user to confirm. Opening a project runs its startup tasks.
+func describeAction(inputs Args) string {
+	if inputs.ProjectPath == "" {
+		return "Open the MATLAB project found in the roots and run its startup tasks"
+	}
+	return "Open the MATLAB project " + inputs.ProjectPath + " and run its startup tasks"
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Open MATLAB Project tool")
+		defer sessionLogger.Info("Done - Executing Open MATLAB Project tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		project, err := usecase.Open(ctx, sessionLogger, client, inputs.ProjectPath)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs(project), nil
+	}
+}
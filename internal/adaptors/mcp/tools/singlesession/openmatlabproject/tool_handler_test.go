@@ -0,0 +1,178 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package openmatlabproject_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/openmatlabproject"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockPathCompleter.EXPECT().
+		Files([]string{".prj"}).
+		Return(mockCompletionProvider).
+		Once()
+
+	// Act
+	tool := openmatlabproject.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Len(t, tool.CompletionProviders(), 1)
+	assert.Contains(t, tool.CompletionProviders(), "project_path")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/home/user/weather/Weather.prj"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Open(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, projectPath).
+		Return(matlabproject.Project{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}, nil).
+		Once()
+
+	// Act
+	result, err := openmatlabproject.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, openmatlabproject.Args{ProjectPath: projectPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, openmatlabproject.ReturnArgs{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/home/user/weather/Weather.prj"
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := openmatlabproject.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, openmatlabproject.Args{ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/home/user/weather/Weather.prj"
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Open(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, projectPath).
+		Return(matlabproject.Project{}, expectedError).
+		Once()
+
+	// Act
+	result, err := openmatlabproject.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, openmatlabproject.Args{ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestOpenMATLABProject_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	mockPathCompleter.EXPECT().
+		Files([]string{".prj"}).
+		Return(mockCompletionProvider).
+		Once()
+
+	// Act
+	tool := openmatlabproject.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations because opening a project runs its startup tasks")
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode
+
+const (
+	name        = "profile_matlab_code"
+	title       = "Profile MATLAB Code"
+	description = "Run MATLAB code (`code`) or a MATLAB script file (`script_path`) in an existing MATLAB session under the MATLAB profiler, and return where the time is spent: the slowest functions by self time and by total time, with their call counts, and the slowest lines with their source text. Set `include_memory` to also return the memory that each function allocates and frees. Use this tool instead of timing code with tic and toc when speeding up MATLAB code."
+)
+
+type Args struct {
+	Code          string `json:"code,omitempty"           jsonschema:"(Optional) The MATLAB code to profile, when not profiling a script file. Example: results = simulate(1000);."`
+	ScriptPath    string `json:"script_path,omitempty"    jsonschema:"(Optional) The full absolute path to the MATLAB script file to profile. The script runs from its folder. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	TopN          int    `json:"top_n,omitempty"          jsonschema:"(Optional) The number of functions and lines to return, from 1 to 100. Defaults to 10."`
+	IncludeMemory bool   `json:"include_memory,omitempty" jsonschema:"(Optional) Also return memory statistics of each function. Profiling memory slows down the code. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	ConsoleOutput        string          `json:"console_output"          jsonschema:"The output of the profiled code."`
+	Error                string          `json:"error,omitempty"         jsonschema:"The message of the error that the code raised, if any. The results cover the code that ran before the error."`
+	TotalTime            float64         `json:"total_time"              jsonschema:"The total time of the profiled code, in seconds."`
+	FunctionCount        int             `json:"function_count"          jsonschema:"The number of functions that the profiled code called."`
+	FunctionsBySelfTime  []FunctionStats `json:"functions_by_self_time"  jsonschema:"The functions that spent the most time in their own code, excluding the functions they call, slowest first."`
+	FunctionsByTotalTime []FunctionStats `json:"functions_by_total_time" jsonschema:"The functions that took the most time, including the functions they call, slowest first."`
+	Lines                []LineStats     `json:"lines"                   jsonschema:"The lines that took the most time, slowest first."`
+}
+
+type FunctionStats struct {
+	Name      string       `json:"name"             jsonschema:"The name of the function."`
+	File      string       `json:"file,omitempty"   jsonschema:"The full path of the file of the function."`
+	Type      string       `json:"type"             jsonschema:"The type of the function, for example M-function, M-script or MEX-function."`
+	Calls     int          `json:"calls"            jsonschema:"The number of times the function was called."`
+	TotalTime float64      `json:"total_time"       jsonschema:"The time spent in the function, including the functions it calls, in seconds."`
+	SelfTime  float64      `json:"self_time"        jsonschema:"The time spent in the function, excluding the functions it calls, in seconds."`
+	Memory    *MemoryStats `json:"memory,omitempty" jsonschema:"The memory statistics of the function, when include_memory is true."`
+}
+
+type MemoryStats struct {
+	AllocatedBytes float64 `json:"allocated_bytes" jsonschema:"The memory that the function allocated, in bytes."`
+	FreedBytes     float64 `json:"freed_bytes"     jsonschema:"The memory that the function freed, in bytes."`
+	PeakBytes      float64 `json:"peak_bytes"      jsonschema:"The peak memory that the function used, in bytes."`
+}
+
+type LineStats struct {
+	Function string  `json:"function"         jsonschema:"The name of the function that the line belongs to."`
+	File     string  `json:"file,omitempty"   jsonschema:"The full path of the file of the line."`
+	Line     int     `json:"line"             jsonschema:"The line number."`
+	Calls    int     `json:"calls"            jsonschema:"The number of times the line ran."`
+	Time     float64 `json:"time"             jsonschema:"The time spent on the line, in seconds."`
+	Source   string  `json:"source,omitempty" jsonschema:"The source text of the line."`
+}
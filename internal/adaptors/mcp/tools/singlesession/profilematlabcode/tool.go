@@ -0,0 +1,99 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/profilematlabcode"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request profilematlabcode.Args) (profilematlabcode.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+func describeAction(inputs Args) string {
+	if inputs.ScriptPath != "" {
+		return "Profile the MATLAB file " + inputs.ScriptPath
+	}
+	return inputs.Code
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Profile MATLAB Code tool")
+		defer sessionLogger.Info("Done - Executing Profile MATLAB Code tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, profilematlabcode.Args{
+			Code:          inputs.Code,
+			ScriptPath:    inputs.ScriptPath,
+			TopN:          inputs.TopN,
+			IncludeMemory: inputs.IncludeMemory,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return toReturnArgs(response), nil
+	}
+}
+
+func toReturnArgs(response profilematlabcode.ReturnArgs) ReturnArgs {
+	returnArgs := ReturnArgs{
+		ConsoleOutput:        response.ConsoleOutput,
+		Error:                response.Error,
+		TotalTime:            response.TotalTime,
+		FunctionCount:        response.FunctionCount,
+		FunctionsBySelfTime:  toFunctionStats(response.FunctionsBySelfTime),
+		FunctionsByTotalTime: toFunctionStats(response.FunctionsByTotalTime),
+		Lines:                make([]LineStats, len(response.Lines)),
+	}
+
+	for i, line := range response.Lines {
+		returnArgs.Lines[i] = LineStats(line)
+	}
+
+	return returnArgs
+}
+
+func toFunctionStats(functions []profilematlabcode.FunctionStats) []FunctionStats {
+	stats := make([]FunctionStats, len(functions))
+	for i, function := range functions {
+		stats[i] = FunctionStats{
+			Name:      function.Name,
+			File:      function.File,
+			Type:      function.Type,
+			Calls:     function.Calls,
+			TotalTime: function.TotalTime,
+			SelfTime:  function.SelfTime,
+		}
+		if function.Memory != nil {
+			memory := MemoryStats(*function.Memory)
+			stats[i].Memory = &memory
+		}
+	}
+	return stats
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	profilematlabcodeusecase "github.com/matlab/matlab-mcp-server/internal/usecases/profilematlabcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/profilematlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := profilematlabcode.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "profile_matlab_code", tool.Name())
+	assert.Equal(t, annotations.NewDestructiveAnnotations(), tool.Annotations(), "Tool should have destructive annotations")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := profilematlabcode.Args{Code: "simulate()", TopN: 5, IncludeMemory: true}
+	step := profilematlabcodeusecase.FunctionStats{
+		Name:      "step",
+		File:      "/work/step.m",
+		Type:      "M-function",
+		Calls:     10,
+		TotalTime: 2,
+		SelfTime:  2,
+		Memory:    &profilematlabcodeusecase.MemoryStats{AllocatedBytes: 2048, FreedBytes: 1024, PeakBytes: 256},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, profilematlabcodeusecase.Args{Code: "simulate()", TopN: 5, IncludeMemory: true}).
+		Return(profilematlabcodeusecase.ReturnArgs{
+			ConsoleOutput:        "done",
+			TotalTime:            2,
+			FunctionCount:        1,
+			FunctionsBySelfTime:  []profilematlabcodeusecase.FunctionStats{step},
+			FunctionsByTotalTime: []profilematlabcodeusecase.FunctionStats{step},
+			Lines: []profilematlabcodeusecase.LineStats{
+				{Function: "step", File: "/work/step.m", Line: 2, Calls: 10, Time: 1.9, Source: "x = x * 2;"},
+			},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+
+	expectedStep := profilematlabcode.FunctionStats{
+		Name:      "step",
+		File:      "/work/step.m",
+		Type:      "M-function",
+		Calls:     10,
+		TotalTime: 2,
+		SelfTime:  2,
+		Memory:    &profilematlabcode.MemoryStats{AllocatedBytes: 2048, FreedBytes: 1024, PeakBytes: 256},
+	}
+	assert.Equal(t, profilematlabcode.ReturnArgs{
+		ConsoleOutput:        "done",
+		TotalTime:            2,
+		FunctionCount:        1,
+		FunctionsBySelfTime:  []profilematlabcode.FunctionStats{expectedStep},
+		FunctionsByTotalTime: []profilematlabcode.FunctionStats{expectedStep},
+		Lines: []profilematlabcode.LineStats{
+			{Function: "step", File: "/work/step.m", Line: 2, Calls: 10, Time: 1.9, Source: "x = x * 2;"},
+		},
+	}, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, profilematlabcode.Args{Code: "x = 1;"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, profilematlabcodeusecase.Args{Code: "x = 1;"}).
+		Return(profilematlabcodeusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, profilematlabcode.Args{Code: "x = 1;"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+	const code = "simulate()"
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mock.Anything, session, "profile_matlab_code", code).
+		Return(assert.AnError).
+		Once()
+
+	tool := profilematlabcode.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError, "Handler should return the confirmation error")
+	assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+}
+
+func TestTool_Handler_AsksForConfirmationOfScriptPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	session := &mcp.ServerSession{}
+	const scriptPath = "/work/analysis.m"
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(session).
+		Return(mockLogger, nil).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mock.Anything, session, "profile_matlab_code", "Profile the MATLAB file "+scriptPath).
+		Return(assert.AnError).
+		Once()
+
+	tool := profilematlabcode.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Act
+	result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, profilematlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError, "Handler should return the confirmation error")
+	assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+}
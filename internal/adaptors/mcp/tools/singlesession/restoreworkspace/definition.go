@@ -0,0 +1,21 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package restoreworkspace
+
+const (
+	name        = "restore_workspace"
+	title       = "Restore MATLAB Workspace"
+	description = "Load the variables of a snapshot taken with `snapshot_workspace` into the base workspace of an existing MATLAB session. In merge mode (default), the variables of the snapshot replace the variables with the same names, and the other variables are kept. In replace mode, the workspace is cleared first, so that it holds exactly the variables of the snapshot. The snapshot is kept, so you can restore it again. Returns the variables that were restored."
+)
+
+type Args struct {
+	Name string `json:"name"           jsonschema:"The name of the snapshot to restore. Use list_workspace_snapshots to see the snapshots."`
+	Mode string `json:"mode,omitempty" jsonschema:"(Optional) merge, to keep the variables that are not in the snapshot, or replace, to clear the workspace first. Defaults to merge."`
+}
+
+type ReturnArgs struct {
+	Name      string   `json:"name"       jsonschema:"The name of the restored snapshot."`
+	CreatedAt string   `json:"created_at" jsonschema:"When the snapshot was taken, in RFC 3339 format."`
+	Mode      string   `json:"mode"       jsonschema:"How the snapshot was restored: merge or replace."`
+	Variables []string `json:"variables"  jsonschema:"The names of the variables that were restored."`
+}
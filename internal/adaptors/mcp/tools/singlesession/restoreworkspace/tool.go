@@ -0,0 +1,72 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package restoreworkspace
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+type Usecase interface {
+	Restore(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string, mode workspacesnapshot.RestoreMode) (workspacesnapshot.Snapshot, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm. Restoring a snapshot overwrites variables of the workspace.
+func describeAction(inputs Args) string {
+	if workspacesnapshot.RestoreMode(inputs.Mode) == workspacesnapshot.RestoreModeReplace {
+		return "Clear the workspace and restore workspace snapshot " + inputs.Name
+	}
+
+	return "Restore workspace snapshot " + inputs.Name + ", overwriting the variables with the same names"
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Restore Workspace tool")
+		defer sessionLogger.Info("Done - Executing Restore Workspace tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		mode := workspacesnapshot.RestoreMode(inputs.Mode)
+		if mode == "" {
+			mode = workspacesnapshot.RestoreModeMerge
+		}
+
+		snapshot, err := usecase.Restore(ctx, sessionLogger, client, inputs.Name, mode)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		info := workspacesnapshotinfo.FromSnapshot(snapshot)
+
+		return ReturnArgs{
+			Name:      info.Name,
+			CreatedAt: info.CreatedAt,
+			Mode:      string(mode),
+			Variables: info.Variables,
+		}, nil
+	}
+}
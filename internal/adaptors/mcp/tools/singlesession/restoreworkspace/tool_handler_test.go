@@ -0,0 +1,196 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package restoreworkspace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/restoreworkspace"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := restoreworkspace.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	snapshot := workspacesnapshot.Snapshot{
+		Name:      "before-fit",
+		CreatedAt: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		SizeBytes: 2048,
+		Variables: []string{"x", "y"},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Restore(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, "before-fit", workspacesnapshot.RestoreModeReplace).
+		Return(snapshot, nil).
+		Once()
+
+	// Act
+	result, err := restoreworkspace.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, restoreworkspace.Args{Name: "before-fit", Mode: "replace"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, restoreworkspace.ReturnArgs{Name: "before-fit", CreatedAt: "2026-03-04T10:30:00Z", Mode: "replace", Variables: []string{"x", "y"}}, result)
+}
+
+func TestTool_Handler_DefaultsToMerge(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	snapshot := workspacesnapshot.Snapshot{
+		Name:      "before-fit",
+		CreatedAt: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		SizeBytes: 2048,
+		Variables: []string{"x", "y"},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Restore(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, "before-fit", workspacesnapshot.RestoreModeMerge).
+		Return(snapshot, nil).
+		Once()
+
+	// Act
+	result, err := restoreworkspace.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, restoreworkspace.Args{Name: "before-fit"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, restoreworkspace.ReturnArgs{Name: "before-fit", CreatedAt: "2026-03-04T10:30:00Z", Mode: "merge", Variables: []string{"x", "y"}}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := restoreworkspace.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, restoreworkspace.Args{Name: "missing"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := workspacesnapshot.ErrSnapshotNotFound
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Restore(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, "missing", workspacesnapshot.RestoreModeMerge).
+		Return(workspacesnapshot.Snapshot{}, expectedError).
+		Once()
+
+	// Act
+	result, err := restoreworkspace.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, restoreworkspace.Args{Name: "missing"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestRestoreWorkspace_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	// Act
+	tool := restoreworkspace.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
+}
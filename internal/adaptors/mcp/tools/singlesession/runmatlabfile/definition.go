@@ -5,9 +5,14 @@ package runmatlabfile
 const (
 	name        = "run_matlab_file"
 	title       = "Run MATLAB File"
-	description = "Execute a MATLAB script file (`script_path`) in an existing MATLAB session and capture its command window output. The script runs with the working folder automatically set to the script's location. The script must exist and be a valid .m file. Returns the command window output or a success message if no output is generated."
+	description = "Execute a MATLAB script file (`script_path`) in an existing MATLAB session and capture its command window output. The script runs with the working folder automatically set to the script's location. The script must exist and be a .m file or a live script (.mlx). A live script runs as its code, without updating the outputs saved in the live script. Returns the command window output or a success message if no output is generated, and the figures that the script creates. Optionally set the format, size, resolution and largest size in bytes of the figures."
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to execute. Must be a .m file that exists. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	ScriptPath     string `json:"script_path"                jsonschema:"The full absolute path to the MATLAB script file to execute. Must be a .m or .mlx file that exists. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/report.mlx."`
+	FigureFormat   string `json:"figure_format,omitempty"    jsonschema:"(Optional) The format of the figures: png, jpeg, or svg. SVG figures are returned as embedded resources. When any figure option is set, the figures that the code creates or changes are exported with exportgraphics instead of captured by the Live Editor. Defaults to png."`
+	FigureWidth    int    `json:"figure_width,omitempty"     jsonschema:"(Optional) The width of the figures, in pixels. Defaults to the width of each figure."`
+	FigureHeight   int    `json:"figure_height,omitempty"    jsonschema:"(Optional) The height of the figures, in pixels. Defaults to the height of each figure."`
+	FigureDPI      int    `json:"figure_dpi,omitempty"       jsonschema:"(Optional) The resolution of png and jpeg figures, in dots per inch. Defaults to the resolution of the screen."`
+	MaxFigureBytes int    `json:"max_figure_bytes,omitempty" jsonschema:"(Optional) The largest size of each figure, in bytes. Larger png and jpeg figures are compressed and downscaled to fit, and figures that cannot fit are left out. Defaults to no limit."`
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/figurefitter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/messages"
@@ -29,15 +30,25 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	pathCompleter basetool.PathCompleter,
 	configFactory ConfigFactory,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"script_path": pathCompleter.Files(".m", ".mlx"),
+			}),
 	}
 }
 
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return "Run the MATLAB file " + inputs.ScriptPath
+}
+
 func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
 		sessionLogger.Info("Executing Run MATLAB File tool")
@@ -56,11 +67,17 @@ func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities
 		response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabfile.Args{
 			ScriptPath:    inputs.ScriptPath,
 			CaptureOutput: !config.ShouldShowMATLABDesktop(),
+			Figures: entities.FigureOptions{
+				Format: entities.FigureFormat(inputs.FigureFormat),
+				Width:  inputs.FigureWidth,
+				Height: inputs.FigureHeight,
+				DPI:    inputs.FigureDPI,
+			},
 		})
 		if err != nil {
 			return tools.RichContent{}, err
 		}
 
-		return responseconverter.ConvertEvalResponseToRichContent(response), nil
+		return figurefitter.FitRichContent(responseconverter.ConvertEvalResponseToRichContent(response), inputs.MaxFigureBytes), nil
 	}
 }
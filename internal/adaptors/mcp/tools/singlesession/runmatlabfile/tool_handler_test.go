@@ -15,7 +15,9 @@ import (
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/runmatlabfile"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,15 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -33,11 +44,18 @@ func TestNew_HappyPath(t *testing.T) {
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m", ".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := runmatlabfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Len(t, tool.CompletionProviders(), 1)
+	assert.Contains(t, tool.CompletionProviders(), "script_path")
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -303,6 +321,15 @@ func TestRunMATLABFile_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockConfigFactory := &mocks.MockConfigFactory{}
 	defer mockConfigFactory.AssertExpectations(t)
 
@@ -314,9 +341,149 @@ func TestRunMATLABFile_Annotations(t *testing.T) {
 
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m", ".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := runmatlabfile.New(mockLoggerFactory, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
 }
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           runmatlabfile.Args
+		expectedAction string
+	}{
+		{
+			name:           "ScriptPath",
+			args:           runmatlabfile.Args{ScriptPath: "/some/path/script.m"},
+			expectedAction: "Run the MATLAB file /some/path/script.m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+			defer mockPathCompleter.AssertExpectations(t)
+
+			mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+			defer mockCompletionProvider.AssertExpectations(t)
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "run_matlab_file", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			mockPathCompleter.EXPECT().
+				Files([]string{".m", ".mlx"}).
+				Return(mockCompletionProvider).
+				Once()
+
+			tool := runmatlabfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
+
+func TestTool_Handler_FigureOptions(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/path/to/report.m"
+	jpegFigure := []byte("\xff\xd8\xff\xe0jpeg")
+	args := runmatlabfile.Args{
+		ScriptPath:   scriptPath,
+		FigureFormat: "jpeg",
+		FigureDPI:    150,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabfileusecase.Args{
+				ScriptPath:    scriptPath,
+				CaptureOutput: true,
+				Figures:       entities.FigureOptions{Format: entities.FigureFormatJPEG, DPI: 150},
+			},
+		).
+		Return(entities.EvalResponse{ConsoleOutput: "done", Images: [][]byte{jpegFigure}}, nil).
+		Once()
+
+	// Act
+	result, err := runmatlabfile.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"done"}, result.TextContent)
+	require.Len(t, result.ImageContent, 1)
+	assert.Equal(t, "image/jpeg", result.ImageContent[0].MIMEType())
+}
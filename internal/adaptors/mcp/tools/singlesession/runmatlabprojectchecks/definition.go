@@ -0,0 +1,23 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabprojectchecks
+
+const (
+	name        = "run_matlab_project_checks"
+	title       = "Run MATLAB Project Checks"
+	description = "Run the checks of the MATLAB project that is open in an existing MATLAB session, the same checks as the Check Project tool of MATLAB, such as that the project folders are on the path and that the project files are under source control. Use `open_matlab_project` first if no project is open. Returns, for each check, whether it passed and the files that make it fail."
+)
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Passed bool          `json:"passed" jsonschema:"Whether all the checks passed."`
+	Checks []CheckResult `json:"checks" jsonschema:"The results of the checks."`
+}
+
+type CheckResult struct {
+	ID           string   `json:"id"            jsonschema:"The identifier of the check."`
+	Description  string   `json:"description"   jsonschema:"The description of the check."`
+	Passed       bool     `json:"passed"        jsonschema:"Whether the check passed."`
+	ProblemFiles []string `json:"problem_files" jsonschema:"The full paths of the files that make the check fail."`
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabprojectchecks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+)
+
+type Usecase interface {
+	RunChecks(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.CheckResult, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Run MATLAB Project Checks tool")
+		defer sessionLogger.Info("Done - Executing Run MATLAB Project Checks tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		results, err := usecase.RunChecks(ctx, sessionLogger, client)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		returnArgs := ReturnArgs{
+			Passed: true,
+			Checks: make([]CheckResult, 0, len(results)),
+		}
+		for _, result := range results {
+			returnArgs.Passed = returnArgs.Passed && result.Passed
+			returnArgs.Checks = append(returnArgs.Checks, CheckResult(result))
+		}
+
+		return returnArgs, nil
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections
+
+const (
+	name        = "run_matlab_sections"
+	title       = "Run MATLAB Sections"
+	description = "Run a range of sections of a MATLAB script in an existing MATLAB session, in order, and return the results of each section separately. Either specify a script file (`script_path`), which is split into sections at its `%%` section breaks and runs from the folder of the script, or an explicit list of code cells (`cells`). Sections run from `start_section` to `end_section`, and running stops at the first section that errors unless `continue_on_error` is true. Errors report line numbers of the original file. Use this tool to rerun only the sections of a long script that follow a failure."
+)
+
+type Args struct {
+	ScriptPath      string   `json:"script_path,omitempty"       jsonschema:"(Optional) The full absolute path to the MATLAB script file to split into sections. Must be a .m file that exists. Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	Cells           []string `json:"cells,omitempty"             jsonschema:"(Optional) The MATLAB code of each section to run, when not running a script file."`
+	StartSection    int      `json:"start_section,omitempty"     jsonschema:"(Optional) The 1-based number of the first section to run. Defaults to the first section."`
+	EndSection      int      `json:"end_section,omitempty"       jsonschema:"(Optional) The 1-based number of the last section to run. Defaults to the last section."`
+	ContinueOnError bool     `json:"continue_on_error,omitempty" jsonschema:"(Optional) Run the remaining sections after a section errors. Defaults to false."`
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
+)
+
+type ConfigFactory interface {
+	Config() (config.Config, messages.Error)
+}
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request runmatlabsections.Args) (runmatlabsections.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithUnstructuredContentOutput[Args]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	configFactory ConfigFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(configFactory, usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm, with the exact code of each cell when the call does not run a script file.
+func describeAction(inputs Args) string {
+	sections := "all sections"
+	if inputs.StartSection > 0 || inputs.EndSection > 0 {
+		sections = fmt.Sprintf("sections %s to %s", sectionBound(inputs.StartSection, "first"), sectionBound(inputs.EndSection, "last"))
+	}
+
+	if inputs.ScriptPath != "" {
+		return fmt.Sprintf("Run %s of the MATLAB file %s", sections, inputs.ScriptPath)
+	}
+	return fmt.Sprintf("Run %s of this code:\n%s", sections, strings.Join(inputs.Cells, "\n%%\n"))
+}
+
+func sectionBound(section int, defaultBound string) string {
+	if section <= 0 {
+		return defaultBound
+	}
+	return strconv.Itoa(section)
+}
+
+func Handler(configFactory ConfigFactory, usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
+		sessionLogger.Info("Executing Run MATLAB Sections tool")
+		defer sessionLogger.Info("Done - Executing Run MATLAB Sections tool")
+
+		config, messagesErr := configFactory.Config()
+		if messagesErr != nil {
+			return tools.RichContent{}, messagesErr
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, runmatlabsections.Args{
+			ScriptPath:      inputs.ScriptPath,
+			Cells:           inputs.Cells,
+			StartSection:    inputs.StartSection,
+			EndSection:      inputs.EndSection,
+			ContinueOnError: inputs.ContinueOnError,
+			CaptureOutput:   !config.ShouldShowMATLABDesktop(),
+		})
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		return convertToRichContent(response), nil
+	}
+}
+
+// convertToRichContent returns one text content per section, in the order the sections ran.
+// Figures follow the text, in the same order.
+func convertToRichContent(response runmatlabsections.ReturnArgs) tools.RichContent {
+	content := tools.RichContent{
+		TextContent:  []string{},
+		ImageContent: []tools.ImageData{},
+	}
+
+	for _, result := range response.Results {
+		var text strings.Builder
+
+		fmt.Fprintf(&text, "Section %d of %d", result.Number, response.TotalSections)
+		if result.Section.Title != "" {
+			fmt.Fprintf(&text, ": %s", result.Section.Title)
+		}
+		fmt.Fprintf(&text, " (lines %d-%d)\n", result.Section.StartLine, result.Section.EndLine)
+
+		if result.ConsoleOutput != "" {
+			text.WriteString(result.ConsoleOutput)
+			text.WriteString("\n")
+		}
+
+		if len(result.Images) > 0 {
+			fmt.Fprintf(&text, "Figures: %d\n", len(result.Images))
+		}
+
+		if result.Error != "" {
+			fmt.Fprintf(&text, "Error in section %d (lines %d-%d):\n%s\n", result.Number, result.Section.StartLine, result.Section.EndLine, result.Error)
+		}
+
+		content.TextContent = append(content.TextContent, strings.TrimSuffix(text.String(), "\n"))
+
+		for _, image := range result.Images {
+			content.ImageContent = append(content.ImageContent, tools.ImageData(image))
+		}
+	}
+
+	if response.NotRun > 0 {
+		firstNotRun := response.Results[len(response.Results)-1].Number + 1
+		lastNotRun := firstNotRun + response.NotRun - 1
+
+		notRun := fmt.Sprintf("Section %d was", firstNotRun)
+		if lastNotRun > firstNotRun {
+			notRun = fmt.Sprintf("Sections %d-%d were", firstNotRun, lastNotRun)
+		}
+
+		content.TextContent = append(content.TextContent, fmt.Sprintf("Stopped at the first error. %s not run. Set continue_on_error to run them anyway.", notRun))
+	}
+
+	return content
+}
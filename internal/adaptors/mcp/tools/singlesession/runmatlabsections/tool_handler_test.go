@@ -0,0 +1,321 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	runmatlabsectionsusecase "github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/runmatlabsections"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := runmatlabsections.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, annotations.NewDestructiveAnnotations(), tool.Annotations(), "Tool should have destructive annotations")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const scriptPath = "/some/script/analysis.m"
+	args := runmatlabsections.Args{
+		ScriptPath:   scriptPath,
+		StartSection: 2,
+		EndSection:   5,
+	}
+
+	usecaseResponse := runmatlabsectionsusecase.ReturnArgs{
+		TotalSections: 6,
+		Results: []runmatlabsectionsusecase.SectionResult{
+			{
+				Number:        2,
+				Section:       runmatlabsectionsusecase.Section{Title: "Compute", StartLine: 3, EndLine: 4},
+				ConsoleOutput: "y = 2",
+			},
+			{
+				Number:  3,
+				Section: runmatlabsectionsusecase.Section{Title: "Plot", StartLine: 5, EndLine: 7},
+				Images:  [][]byte{[]byte("figure")},
+				Error:   "Undefined function 'plott'.",
+			},
+		},
+		NotRun: 2,
+	}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(false).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabsectionsusecase.Args{ScriptPath: scriptPath, StartSection: 2, EndSection: 5, CaptureOutput: true},
+		).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := runmatlabsections.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Section 2 of 6: Compute (lines 3-4)\ny = 2",
+		"Section 3 of 6: Plot (lines 5-7)\nFigures: 1\nError in section 3 (lines 5-7):\nUndefined function 'plott'.",
+		"Stopped at the first error. Sections 4-5 were not run. Set continue_on_error to run them anyway.",
+	}, result.TextContent)
+	require.Len(t, result.ImageContent, 1)
+	assert.Equal(t, "figure", string(result.ImageContent[0]))
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := runmatlabsections.Args{Cells: []string{"x = 1;"}}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := runmatlabsections.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockConfig := &configmocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := runmatlabsections.Args{Cells: []string{"x = 1;"}}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(mockConfig, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ShouldShowMATLABDesktop().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(
+			ctx,
+			mockLogger.AsMockArg(),
+			mockMATLABSessionClient,
+			runmatlabsectionsusecase.Args{Cells: []string{"x = 1;"}},
+		).
+		Return(runmatlabsectionsusecase.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := runmatlabsections.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_ConfigError(t *testing.T) {
+	// Arrange
+	mockConfigFactory := &mocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := messages.New_StartupErrors_BadFlag_Error("flag", "value", "reason")
+	args := runmatlabsections.Args{Cells: []string{"x = 1;"}}
+
+	mockConfigFactory.EXPECT().
+		Config().
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := runmatlabsections.Handler(mockConfigFactory, mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           runmatlabsections.Args
+		expectedAction string
+	}{
+		{
+			name:           "AllSectionsOfScript",
+			args:           runmatlabsections.Args{ScriptPath: "/some/path/script.m"},
+			expectedAction: "Run all sections of the MATLAB file /some/path/script.m",
+		},
+		{
+			name:           "SectionRangeOfScript",
+			args:           runmatlabsections.Args{ScriptPath: "/some/path/script.m", StartSection: 2, EndSection: 3},
+			expectedAction: "Run sections 2 to 3 of the MATLAB file /some/path/script.m",
+		},
+		{
+			name:           "OpenEndedSectionRange",
+			args:           runmatlabsections.Args{ScriptPath: "/some/path/script.m", StartSection: 2},
+			expectedAction: "Run sections 2 to last of the MATLAB file /some/path/script.m",
+		},
+		{
+			name:           "Cells",
+			args:           runmatlabsections.Args{Cells: []string{"x = 1;", "disp(x)"}},
+			expectedAction: "Run all sections of this code:\nx = 1;\n%%\ndisp(x)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockConfigFactory := &mocks.MockConfigFactory{}
+			defer mockConfigFactory.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "run_matlab_sections", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			tool := runmatlabsections.New(mockLoggerFactory, mockConfirmer, mockConfigFactory, mockUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
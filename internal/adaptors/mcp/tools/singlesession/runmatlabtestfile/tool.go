@@ -23,14 +23,24 @@ type Tool struct {
 
 func New(
 	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	pathCompleter basetool.PathCompleter,
 	usecase Usecase,
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"script_path": pathCompleter.Files(".m"),
+			}),
 	}
 }
 
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return "Run the tests in the MATLAB file " + inputs.ScriptPath
+}
+
 func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
 	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
 		sessionLogger.Info("Executing Run MATLAB Test File tool")
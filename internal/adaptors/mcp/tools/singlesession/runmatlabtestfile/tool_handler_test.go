@@ -13,7 +13,9 @@ import (
 	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,17 +24,33 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockUsecase := &mocks.MockUsecase{}
 	defer mockUsecase.AssertExpectations(t)
 
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := runmatlabtestfile.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Len(t, tool.CompletionProviders(), 1)
+	assert.Contains(t, tool.CompletionProviders(), "script_path")
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -207,6 +225,15 @@ func TestRunMATLABTestFile_Annotations(t *testing.T) {
 	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
 	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
 	defer mockGlobalMATLAB.AssertExpectations(t)
 
@@ -215,9 +242,80 @@ func TestRunMATLABTestFile_Annotations(t *testing.T) {
 
 	expectedAnnotations := annotations.NewDestructiveAnnotations()
 
+	mockPathCompleter.EXPECT().
+		Files([]string{".m"}).
+		Return(mockCompletionProvider).
+		Once()
+
 	// Act
-	tool := runmatlabtestfile.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+	tool := runmatlabtestfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
 
 	// Assert
 	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
 }
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           runmatlabtestfile.Args
+		expectedAction string
+	}{
+		{
+			name:           "ScriptPath",
+			args:           runmatlabtestfile.Args{ScriptPath: "/some/path/testScript.m"},
+			expectedAction: "Run the tests in the MATLAB file /some/path/testScript.m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+			defer mockPathCompleter.AssertExpectations(t)
+
+			mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+			defer mockCompletionProvider.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "run_matlab_test_file", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			mockPathCompleter.EXPECT().
+				Files([]string{".m"}).
+				Return(mockCompletionProvider).
+				Once()
+
+			tool := runmatlabtestfile.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package setmatlabbreakpoint
+
+const (
+	name        = "set_matlab_breakpoint"
+	title       = "Set MATLAB Breakpoint"
+	description = "Set a breakpoint in an existing MATLAB session, so that code run with `debug_matlab_code` pauses there. Either stop at a line of a MATLAB file (`file_path` and `line`), optionally only when `condition` is true, or stop wherever an error is raised (`stop_on` set to `error` for uncaught errors, or `caught_error` for errors raised inside a try block), optionally only for errors with the identifier `error_identifier`. Returns the breakpoints that are set."
+)
+
+type Args struct {
+	FilePath        string `json:"file_path,omitempty"        jsonschema:"(Optional) The full absolute path to the MATLAB file to stop in. Required unless stop_on is set. Example: C:\\Users\\username\\matlab\\myFunction.m or /home/user/matlab/myFunction.m."`
+	Line            int    `json:"line,omitempty"             jsonschema:"(Optional) The 1-based line to stop at. Defaults to the first executable line of the file."`
+	Condition       string `json:"condition,omitempty"        jsonschema:"(Optional) A MATLAB expression. The code only pauses at the line when the expression is true. Example: i > 10."`
+	StopOn          string `json:"stop_on,omitempty"          jsonschema:"(Optional) Stop wherever an error is raised instead of at a line: error for uncaught errors, or caught_error for errors raised inside a try block."`
+	ErrorIdentifier string `json:"error_identifier,omitempty" jsonschema:"(Optional) With stop_on, only stop on errors with this identifier. Example: MATLAB:badsubscript."`
+}
+
+type ReturnArgs struct {
+	Breakpoints string `json:"breakpoints" jsonschema:"The breakpoints that are set, as displayed by dbstatus."`
+}
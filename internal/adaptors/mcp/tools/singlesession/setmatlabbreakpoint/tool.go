@@ -0,0 +1,85 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package setmatlabbreakpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+type Usecase interface {
+	SetBreakpoint(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request debugmatlab.BreakpointArgs) (debugmatlab.BreakpointsReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	pathCompleter basetool.PathCompleter,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).
+			WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"file_path": pathCompleter.Files(".m", ".mlx"),
+				"stop_on":   completion.NewValuesProvider(string(debugmatlab.BreakpointTriggerError), string(debugmatlab.BreakpointTriggerCaughtError)),
+			}),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	if inputs.StopOn != "" {
+		if inputs.ErrorIdentifier != "" {
+			return fmt.Sprintf("Set a breakpoint that stops on %s with identifier %s", inputs.StopOn, inputs.ErrorIdentifier)
+		}
+		return "Set a breakpoint that stops on " + inputs.StopOn
+	}
+
+	action := "Set a breakpoint in " + inputs.FilePath
+	if inputs.Line > 0 {
+		action += fmt.Sprintf(" at line %d", inputs.Line)
+	}
+	if inputs.Condition != "" {
+		action += " when " + inputs.Condition
+	}
+	return action
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Set MATLAB Breakpoint tool")
+		defer sessionLogger.Info("Done - Executing Set MATLAB Breakpoint tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.SetBreakpoint(ctx, sessionLogger, client, debugmatlab.BreakpointArgs{
+			Trigger:         debugmatlab.BreakpointTrigger(inputs.StopOn),
+			FilePath:        inputs.FilePath,
+			Line:            inputs.Line,
+			Condition:       inputs.Condition,
+			ErrorIdentifier: inputs.ErrorIdentifier,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Breakpoints: response.Breakpoints,
+		}, nil
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package setmatlabbreakpoint_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockPathCompleter := &basetoolsmocks.MockPathCompleter{}
+	defer mockPathCompleter.AssertExpectations(t)
+
+	mockCompletionProvider := &basetoolsmocks.MockCompletionProvider{}
+	defer mockCompletionProvider.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockPathCompleter.EXPECT().
+		Files([]string{".m", ".mlx"}).
+		Return(mockCompletionProvider).
+		Once()
+
+	// Act
+	tool := setmatlabbreakpoint.New(mockLoggerFactory, mockConfirmer, mockPathCompleter, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "set_matlab_breakpoint", tool.Name())
+	assert.Equal(t, annotations.NewDestructiveAnnotations(), tool.Annotations(), "Tool should have destructive annotations")
+	assert.Len(t, tool.CompletionProviders(), 2)
+	assert.Contains(t, tool.CompletionProviders(), "file_path")
+	assert.Contains(t, tool.CompletionProviders(), "stop_on")
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const breakpoints = "Breakpoint for myFunction is on line 12."
+	args := setmatlabbreakpoint.Args{
+		FilePath:  "/some/path/myFunction.m",
+		Line:      12,
+		Condition: "i > 10",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		SetBreakpoint(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlab.BreakpointArgs{
+			FilePath:  "/some/path/myFunction.m",
+			Line:      12,
+			Condition: "i > 10",
+		}).
+		Return(debugmatlab.BreakpointsReturnArgs{Breakpoints: breakpoints}, nil).
+		Once()
+
+	// Act
+	result, err := setmatlabbreakpoint.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, setmatlabbreakpoint.ReturnArgs{Breakpoints: breakpoints}, result)
+}
+
+func TestTool_Handler_StopOnError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := setmatlabbreakpoint.Args{
+		StopOn:          "caught_error",
+		ErrorIdentifier: "MATLAB:badsubscript",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		SetBreakpoint(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlab.BreakpointArgs{
+			Trigger:         debugmatlab.BreakpointTriggerCaughtError,
+			ErrorIdentifier: "MATLAB:badsubscript",
+		}).
+		Return(debugmatlab.BreakpointsReturnArgs{Breakpoints: "Stop if caught error (MATLAB:badsubscript)."}, nil).
+		Once()
+
+	// Act
+	result, err := setmatlabbreakpoint.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Stop if caught error (MATLAB:badsubscript).", result.Breakpoints)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := setmatlabbreakpoint.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setmatlabbreakpoint.Args{StopOn: "error"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		SetBreakpoint(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlab.BreakpointArgs{Trigger: debugmatlab.BreakpointTriggerError}).
+		Return(debugmatlab.BreakpointsReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := setmatlabbreakpoint.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setmatlabbreakpoint.Args{StopOn: "error"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkgetblockparams
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+
+const (
+	name        = "simulink_get_block_params"
+	title       = "Get Simulink Block Parameters"
+	description = "Get the values of the parameters of a block (`block_path`) of a Simulink model in an existing MATLAB session. Returns the parameters named in `names`, or all the dialog parameters of the block when `names` is empty. Values are returned as text, as they appear in the block dialog."
+)
+
+type Args struct {
+	BlockPath string   `json:"block_path"      jsonschema:"The full path of the block, starting with the model name. Example: controller/Plant/Gain."`
+	Names     []string `json:"names,omitempty" jsonschema:"(Optional) The names of the parameters to get. Example: [\"Gain\", \"SampleTime\"]. Defaults to all the dialog parameters of the block."`
+}
+
+type ReturnArgs struct {
+	Parameters []simulinkmodel.BlockParameter `json:"parameters" jsonschema:"The parameters of the block."`
+}
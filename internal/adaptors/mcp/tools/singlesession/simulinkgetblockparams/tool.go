@@ -0,0 +1,55 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkgetblockparams
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type Usecase interface {
+	GetBlockParams(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulink.GetBlockParamsArgs) ([]simulink.BlockParameter, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Get Simulink Block Parameters tool")
+		defer sessionLogger.Info("Done - Executing Get Simulink Block Parameters tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		parameters, err := usecase.GetBlockParams(ctx, sessionLogger, client, simulink.GetBlockParamsArgs{
+			BlockPath: inputs.BlockPath,
+			Names:     inputs.Names,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Parameters: simulinkmodel.FromBlockParameters(parameters),
+		}, nil
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinklistblocks
+
+const (
+	name        = "simulink_list_blocks"
+	title       = "List Simulink Blocks"
+	description = "List the blocks of a Simulink model, or of a subsystem in it (`system`), in an existing MATLAB session. A model that is on the MATLAB path is loaded if needed. Only the top level of the system is listed, unless `recursive` is true. Blocks under masks and in linked libraries are included. Returns the path, name and type of each block. Use the block paths with `simulink_get_block_params` and `simulink_set_block_params`."
+)
+
+type Args struct {
+	System    string `json:"system"              jsonschema:"The name of the model, or the path of a subsystem in it. Example: controller or controller/Plant."`
+	Recursive bool   `json:"recursive,omitempty" jsonschema:"(Optional) Also list the blocks inside subsystems. Defaults to false."`
+}
+
+type ReturnArgs struct {
+	Blocks []Block `json:"blocks" jsonschema:"The blocks of the system."`
+}
+
+type Block struct {
+	Path      string `json:"path"       jsonschema:"The full path of the block. Example: controller/Plant/Gain."`
+	Name      string `json:"name"       jsonschema:"The name of the block."`
+	BlockType string `json:"block_type" jsonschema:"The type of the block. Example: Gain, SubSystem or Scope."`
+}
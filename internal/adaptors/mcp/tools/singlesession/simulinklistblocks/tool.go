@@ -0,0 +1,57 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinklistblocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type Usecase interface {
+	ListBlocks(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulink.ListBlocksArgs) ([]simulink.Block, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewReadOnlyAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing List Simulink Blocks tool")
+		defer sessionLogger.Info("Done - Executing List Simulink Blocks tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		blocks, err := usecase.ListBlocks(ctx, sessionLogger, client, simulink.ListBlocksArgs{
+			System:    inputs.System,
+			Recursive: inputs.Recursive,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		returnArgs := ReturnArgs{Blocks: make([]Block, 0, len(blocks))}
+		for _, block := range blocks {
+			returnArgs.Blocks = append(returnArgs.Blocks, Block(block))
+		}
+
+		return returnArgs, nil
+	}
+}
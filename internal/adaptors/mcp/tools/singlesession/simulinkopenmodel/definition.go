@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkopenmodel
+
+const (
+	name        = "simulink_open_model"
+	title       = "Open Simulink Model"
+	description = "Load a Simulink model (`model_path`, a .slx or .mdl file) in an existing MATLAB session, without opening it in the Simulink Editor. Loading a model runs its load callbacks. Returns the name of the model, which the other Simulink tools use to refer to it, and the number of blocks it contains."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path" jsonschema:"The full absolute path to the Simulink model to load. Must be a .slx or .mdl file that exists. Example: C:\\Users\\username\\models\\controller.slx or /home/user/models/controller.slx."`
+}
+
+type ReturnArgs struct {
+	Model      string `json:"model"       jsonschema:"The name of the loaded model."`
+	FileName   string `json:"file_name"   jsonschema:"The full path of the model file."`
+	BlockCount int    `json:"block_count" jsonschema:"The number of blocks in the model, including the blocks inside subsystems."`
+}
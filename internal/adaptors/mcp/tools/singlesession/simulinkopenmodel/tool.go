@@ -0,0 +1,59 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkopenmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type Usecase interface {
+	OpenModel(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, modelPath string) (simulink.Model, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return "Load the Simulink model " + inputs.ModelPath
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Open Simulink Model tool")
+		defer sessionLogger.Info("Done - Executing Open Simulink Model tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		model, err := usecase.OpenModel(ctx, sessionLogger, client, inputs.ModelPath)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Model:      model.Name,
+			FileName:   model.FileName,
+			BlockCount: model.BlockCount,
+		}, nil
+	}
+}
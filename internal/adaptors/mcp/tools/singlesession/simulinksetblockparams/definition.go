@@ -0,0 +1,20 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinksetblockparams
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+
+const (
+	name        = "simulink_set_block_params"
+	title       = "Set Simulink Block Parameters"
+	description = "Set parameters of a block (`block_path`) of a Simulink model in an existing MATLAB session, as set_param does. Values are text, as typed in the block dialog, and Simulink evaluates them as MATLAB expressions. The model is changed in memory and is not saved. Returns the values of the parameters after setting them."
+)
+
+type Args struct {
+	BlockPath  string                         `json:"block_path" jsonschema:"The full path of the block, starting with the model name. Example: controller/Plant/Gain."`
+	Parameters []simulinkmodel.BlockParameter `json:"parameters" jsonschema:"The parameters to set, in order. Example: [{\"name\": \"Gain\", \"value\": \"2.5\"}]."`
+}
+
+type ReturnArgs struct {
+	Parameters []simulinkmodel.BlockParameter `json:"parameters" jsonschema:"The parameters that were set, with their values after setting them."`
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinksetblockparams
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type Usecase interface {
+	SetBlockParams(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulink.SetBlockParamsArgs) ([]simulink.BlockParameter, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm, with each parameter and its new value.
+func describeAction(inputs Args) string {
+	settings := make([]string, len(inputs.Parameters))
+	for i, parameter := range inputs.Parameters {
+		settings[i] = fmt.Sprintf("%s = %s", parameter.Name, parameter.Value)
+	}
+	return fmt.Sprintf("Set the parameters of the Simulink block %s: %s", inputs.BlockPath, strings.Join(settings, ", "))
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Set Simulink Block Parameters tool")
+		defer sessionLogger.Info("Done - Executing Set Simulink Block Parameters tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		parameters := make([]simulink.BlockParameter, 0, len(inputs.Parameters))
+		for _, parameter := range inputs.Parameters {
+			parameters = append(parameters, simulink.BlockParameter(parameter))
+		}
+
+		result, err := usecase.SetBlockParams(ctx, sessionLogger, client, simulink.SetBlockParamsArgs{
+			BlockPath:  inputs.BlockPath,
+			Parameters: parameters,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Parameters: simulinkmodel.FromBlockParameters(result),
+		}, nil
+	}
+}
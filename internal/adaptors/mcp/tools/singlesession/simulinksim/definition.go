@@ -0,0 +1,14 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinksim
+
+const (
+	name        = "simulink_sim"
+	title       = "Simulate Simulink Model"
+	description = "Simulate a loaded Simulink model (`model`) in an existing MATLAB session, optionally up to `stop_time` instead of the stop time of the model. Signal logging and the data logging of the scopes are enabled for the simulation only, without changing the model. Returns a summary of each logged signal and each signal displayed by a scope (number of samples, time range, minimum, maximum, mean and final value), the errors and warnings of the simulation, and a PNG image that plots the data of the scopes."
+)
+
+type Args struct {
+	Model    string `json:"model"               jsonschema:"The name of the model to simulate. Example: controller."`
+	StopTime string `json:"stop_time,omitempty" jsonschema:"(Optional) The time to stop the simulation at, as a MATLAB expression. Example: 10. Defaults to the stop time of the model."`
+}
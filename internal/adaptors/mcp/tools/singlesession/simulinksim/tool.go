@@ -0,0 +1,116 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinksim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+)
+
+type Usecase interface {
+	Simulate(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulink.SimulateArgs) (simulink.SimulateReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithUnstructuredContentOutput[Args]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	if inputs.StopTime != "" {
+		return fmt.Sprintf("Simulate the Simulink model %s until %s", inputs.Model, inputs.StopTime)
+	}
+	return "Simulate the Simulink model " + inputs.Model
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithUnstructuredContentOutput[Args] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (tools.RichContent, error) {
+		sessionLogger.Info("Executing Simulate Simulink Model tool")
+		defer sessionLogger.Info("Done - Executing Simulate Simulink Model tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		result, err := usecase.Simulate(ctx, sessionLogger, client, simulink.SimulateArgs{
+			Model:    inputs.Model,
+			StopTime: inputs.StopTime,
+		})
+		if err != nil {
+			return tools.RichContent{}, err
+		}
+
+		return convertToRichContent(result), nil
+	}
+}
+
+// convertToRichContent returns the summary of the simulation as text, followed by the plot of the scopes, if any.
+func convertToRichContent(result simulink.SimulateReturnArgs) tools.RichContent {
+	var text strings.Builder
+
+	fmt.Fprintf(&text, "Simulated %s until t = %g\n", result.Model, result.SimulationTime)
+
+	if len(result.Signals) == 0 {
+		text.WriteString("No signals were logged.\n")
+	} else {
+		text.WriteString("Signals:\n")
+		for _, signal := range result.Signals {
+			fmt.Fprintf(&text, "- %s (%s): %d samples from t = %g to %g, min %g, max %g, mean %g, final %s\n",
+				signal.Name, signal.BlockPath, signal.Samples, signal.StartTime, signal.EndTime, signal.Min, signal.Max, signal.Mean, formatValues(signal.Final))
+		}
+	}
+
+	if len(result.Diagnostics) > 0 {
+		text.WriteString("Diagnostics:\n")
+		for _, diagnostic := range result.Diagnostics {
+			fmt.Fprintf(&text, "- %s: %s", diagnostic.Severity, diagnostic.Message)
+			if len(diagnostic.Blocks) > 0 {
+				fmt.Fprintf(&text, " (%s)", strings.Join(diagnostic.Blocks, ", "))
+			}
+			text.WriteString("\n")
+		}
+	}
+
+	content := tools.RichContent{
+		TextContent:  []string{strings.TrimSuffix(text.String(), "\n")},
+		ImageContent: []tools.ImageData{},
+	}
+
+	if len(result.Image) > 0 {
+		content.ImageContent = append(content.ImageContent, tools.ImageData(result.Image))
+	}
+
+	return content
+}
+
+// formatValues formats the final value of a signal, with one value per element of the signal.
+func formatValues(values []float64) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("%g", values[0])
+	}
+
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = fmt.Sprintf("%g", value)
+	}
+	return "[" + strings.Join(formatted, " ") + "]"
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinksim_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+	basetoolsmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/singlesession/simulinksim"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	// Act
+	tool := simulinksim.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   simulink.SimulateReturnArgs
+		expected tools.RichContent
+	}{
+		{
+			name: "SignalsAndScopes",
+			result: simulink.SimulateReturnArgs{
+				Model:          "controller",
+				SimulationTime: 10,
+				Signals: []simulink.SignalSummary{
+					{Name: "error", BlockPath: "controller/Sum", Samples: 101, StartTime: 0, EndTime: 10, Min: -0.5, Max: 1, Mean: 0.125, Final: []float64{0.01}},
+					{Name: "states", BlockPath: "controller/Scope", Samples: 101, StartTime: 0, EndTime: 10, Min: 0, Max: 2, Mean: 1, Final: []float64{1, 2}},
+				},
+				Diagnostics: []simulink.Diagnostic{
+					{Severity: simulink.SeverityWarning, Message: "Output port 1 is not connected", Blocks: []string{"controller/Plant"}},
+				},
+				Image: []byte("image"),
+			},
+			expected: tools.RichContent{
+				TextContent: []string{"Simulated controller until t = 10\n" +
+					"Signals:\n" +
+					"- error (controller/Sum): 101 samples from t = 0 to 10, min -0.5, max 1, mean 0.125, final 0.01\n" +
+					"- states (controller/Scope): 101 samples from t = 0 to 10, min 0, max 2, mean 1, final [1 2]\n" +
+					"Diagnostics:\n" +
+					"- warning: Output port 1 is not connected (controller/Plant)"},
+				ImageContent: []tools.ImageData{tools.ImageData("image")},
+			},
+		},
+		{
+			name: "NoSignals",
+			result: simulink.SimulateReturnArgs{
+				Model:          "controller",
+				SimulationTime: 10,
+				Signals:        []simulink.SignalSummary{},
+				Diagnostics:    []simulink.Diagnostic{},
+			},
+			expected: tools.RichContent{
+				TextContent:  []string{"Simulated controller until t = 10\nNo signals were logged."},
+				ImageContent: []tools.ImageData{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockMATLABSessionClient.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+
+			mockGlobalMATLAB.EXPECT().
+				Client(ctx, mockLogger.AsMockArg()).
+				Return(mockMATLABSessionClient, nil).
+				Once()
+
+			mockUsecase.EXPECT().
+				Simulate(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, simulink.SimulateArgs{Model: "controller", StopTime: "10"}).
+				Return(tt.result, nil).
+				Once()
+
+			// Act
+			result, err := simulinksim.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulinksim.Args{Model: "controller", StopTime: "10"})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := simulinksim.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulinksim.Args{Model: "controller"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Simulate(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, simulink.SimulateArgs{Model: "controller"}).
+		Return(simulink.SimulateReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := simulinksim.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulinksim.Args{Model: "controller"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty on error")
+}
+
+func TestSimulinkSim_Annotations(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfirmer := &basetoolsmocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	expectedAnnotations := annotations.NewDestructiveAnnotations()
+
+	// Act
+	tool := simulinksim.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool should have destructive annotations")
+}
+
+func TestTool_Handler_AsksForConfirmation(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           simulinksim.Args
+		expectedAction string
+	}{
+		{
+			name:           "DefaultStopTime",
+			args:           simulinksim.Args{Model: "controller"},
+			expectedAction: "Simulate the Simulink model controller",
+		},
+		{
+			name:           "StopTime",
+			args:           simulinksim.Args{Model: "controller", StopTime: "10"},
+			expectedAction: "Simulate the Simulink model controller until 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockConfirmer := &basetoolsmocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			mockUsecase := &mocks.MockUsecase{}
+			defer mockUsecase.AssertExpectations(t)
+
+			mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+			session := &mcp.ServerSession{}
+			expectedError := assert.AnError
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(session).
+				Return(mockLogger, nil).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mock.Anything, session, "simulink_sim", tt.expectedAction).
+				Return(expectedError).
+				Once()
+
+			tool := simulinksim.New(mockLoggerFactory, mockConfirmer, mockUsecase, mockGlobalMATLAB)
+
+			// Act
+			result, _, err := tool.Handler()(ctx, &mcp.CallToolRequest{Session: session}, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, expectedError, "Handler should return the confirmation error")
+			assert.Nil(t, result, "Result should be nil when the call is not confirmed")
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulinkupdatediagram
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/simulinkmodel"
+
+const (
+	name        = "simulink_update_diagram"
+	title       = "Update Simulink Diagram"
+	description = "Update the diagram of a loaded Simulink model (`model`) in an existing MATLAB session, which compiles the model without simulating it, as Ctrl+D does in the Simulink Editor. Returns whether the update succeeded, and its errors and warnings as diagnostics, each with the blocks that it is reported for. Use this tool to check a model after changing it with `simulink_set_block_params`."
+)
+
+type Args struct {
+	Model string `json:"model" jsonschema:"The name of the model to update. Example: controller."`
+}
+
+type ReturnArgs struct {
+	Model       string                     `json:"model"       jsonschema:"The name of the model."`
+	Succeeded   bool                       `json:"succeeded"   jsonschema:"Whether the model compiled without errors."`
+	Diagnostics []simulinkmodel.Diagnostic `json:"diagnostics" jsonschema:"The errors and warnings of the update."`
+}
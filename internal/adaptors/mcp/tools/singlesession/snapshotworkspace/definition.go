@@ -0,0 +1,18 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package snapshotworkspace
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+
+const (
+	name        = "snapshot_workspace"
+	title       = "Snapshot MATLAB Workspace"
+	description = "Save the variables of the base workspace of an existing MATLAB session as a named snapshot, so that you can restore them with `restore_workspace` after experimenting. Take a snapshot before running code that could overwrite or clear variables that took long to compute. Snapshots are kept until you delete them or the MATLAB session stops. Snapshots of a session have a maximum size, each and in total. Returns the name, time, size and variables of the snapshot."
+)
+
+type Args struct {
+	Name      string `json:"name,omitempty"      jsonschema:"(Optional) The name of the snapshot: up to 64 letters, digits, underscores and hyphens, starting with a letter or digit. Example: before-fit. Defaults to a name made of the current time."`
+	Overwrite bool   `json:"overwrite,omitempty" jsonschema:"(Optional) Replace the snapshot with the same name, if any. Defaults to false."`
+}
+
+type ReturnArgs = workspacesnapshotinfo.Snapshot
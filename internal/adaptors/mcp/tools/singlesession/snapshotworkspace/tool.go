@@ -0,0 +1,63 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package snapshotworkspace
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+type Usecase interface {
+	Snapshot(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string, overwrite bool) (workspacesnapshot.Snapshot, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).WithConfirmation(confirmer, describeAction),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	switch {
+	case inputs.Name == "":
+		return "Save the workspace as a new snapshot"
+	case inputs.Overwrite:
+		return "Save the workspace as snapshot " + inputs.Name + ", replacing the snapshot with the same name"
+	default:
+		return "Save the workspace as snapshot " + inputs.Name
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Snapshot Workspace tool")
+		defer sessionLogger.Info("Done - Executing Snapshot Workspace tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		snapshot, err := usecase.Snapshot(ctx, sessionLogger, client, inputs.Name, inputs.Overwrite)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return workspacesnapshotinfo.FromSnapshot(snapshot), nil
+	}
+}
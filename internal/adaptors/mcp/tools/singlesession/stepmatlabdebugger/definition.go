@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package stepmatlabdebugger
+
+import "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+
+const (
+	name        = "step_matlab_debugger"
+	title       = "Step MATLAB Debugger"
+	description = "Control the code that is paused in debug mode in an existing MATLAB session. The `action` is one of: step, to run the next line; step_in, to step into the function called on the next line; step_out, to run the rest of the current function; continue, to run until the next breakpoint or the end of the code; quit, to stop debugging and return to the >> prompt. Returns where MATLAB is paused afterwards, with the stack and local variables, or that it is not paused anymore. Always quit or continue to the end before running code with other tools."
+)
+
+type Args struct {
+	Action string `json:"action" jsonschema:"What to do: step, step_in, step_out, continue or quit."`
+}
+
+type ReturnArgs = debugstate.ReturnArgs
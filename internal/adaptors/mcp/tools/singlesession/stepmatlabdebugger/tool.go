@@ -0,0 +1,67 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package stepmatlabdebugger
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/annotations"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/debugstate"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+)
+
+type Usecase interface {
+	Step(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, action debugmatlab.StepAction) (debugmatlab.State, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	confirmer basetool.Confirmer,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations.NewDestructiveAnnotations(), loggerFactory, Handler(usecase, globalMATLAB)).
+			WithConfirmation(confirmer, describeAction).
+			WithCompletionProviders(map[string]basetool.CompletionProvider{
+				"action": completion.NewValuesProvider(
+					string(debugmatlab.StepActionStep),
+					string(debugmatlab.StepActionStepIn),
+					string(debugmatlab.StepActionStepOut),
+					string(debugmatlab.StepActionContinue),
+					string(debugmatlab.StepActionQuit),
+				),
+			}),
+	}
+}
+
+// describeAction describes a call for the user to confirm.
+func describeAction(inputs Args) string {
+	return "Run the debugger action " + inputs.Action + " on the paused code"
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Step MATLAB Debugger tool")
+		defer sessionLogger.Info("Done - Executing Step MATLAB Debugger tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		state, err := usecase.Step(ctx, sessionLogger, client, debugmatlab.StepAction(inputs.Action))
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return debugstate.FromState(state), nil
+	}
+}
@@ -3,17 +3,41 @@
 package tools
 
 import (
+	"bytes"
+
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-type PNGImageData []byte
+const (
+	MIMETypePNG  = "image/png"
+	MIMETypeJPEG = "image/jpeg"
+	MIMETypeSVG  = "image/svg+xml"
+)
+
+// ImageData is an encoded PNG, JPEG or SVG image.
+type ImageData []byte
+
+// MIMEType reads the format of the image from its first bytes.
+// Images default to PNG, the format that the Live Editor captures figures in.
+func (d ImageData) MIMEType() string {
+	start := bytes.TrimLeft(bytes.TrimPrefix(d, []byte("\xef\xbb\xbf")), " \t\r\n")
+
+	switch {
+	case bytes.HasPrefix(d, []byte{0xff, 0xd8, 0xff}):
+		return MIMETypeJPEG
+	case bytes.HasPrefix(start, []byte("<?xml")), bytes.HasPrefix(start, []byte("<svg")), bytes.HasPrefix(start, []byte("<!DOCTYPE svg")):
+		return MIMETypeSVG
+	default:
+		return MIMETypePNG
+	}
+}
 
 // RichContent is used as a tool output, when unstructured content should be used.
 // That is, the tool will have no output schema and `structuredContent` will be `nil`.
 // This should only be used when the tool needs to return content like images, sound, or resources.
 type RichContent struct {
 	TextContent  []string
-	ImageContent []PNGImageData
+	ImageContent []ImageData
 }
 
 type Tool interface {
@@ -0,0 +1,36 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageData_MIMEType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     tools.ImageData
+		expected string
+	}{
+		{name: "png", data: tools.ImageData("\x89PNG\r\n\x1a\n...."), expected: "image/png"},
+		{name: "jpeg", data: tools.ImageData("\xff\xd8\xff\xe0...."), expected: "image/jpeg"},
+		{name: "svg with xml declaration", data: tools.ImageData(`<?xml version="1.0"?><svg></svg>`), expected: "image/svg+xml"},
+		{name: "svg with leading whitespace", data: tools.ImageData("\n  <svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), expected: "image/svg+xml"},
+		{name: "svg with doctype", data: tools.ImageData("<!DOCTYPE svg><svg></svg>"), expected: "image/svg+xml"},
+		{name: "svg with byte order mark", data: tools.ImageData("\xef\xbb\xbf<svg></svg>"), expected: "image/svg+xml"},
+		{name: "unknown data defaults to png", data: tools.ImageData("image1"), expected: "image/png"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			mimeType := tc.data.MIMEType()
+
+			// Assert
+			assert.Equal(t, tc.expected, mimeType)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package figurefitter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"math"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+)
+
+const (
+	// minDimension is the size below which figures are not shrunk further, as they would no longer be readable.
+	minDimension = 16
+	jpegQuality  = 75
+	// shrinkMargin makes each downscale a little smaller than the size estimated from the number of bytes,
+	// so that most figures fit after one or two tries.
+	shrinkMargin = 0.9
+
+	omittedFigureNote = "Figure %d was left out: it cannot be made smaller than %d bytes."
+)
+
+var (
+	ErrCannotFit   = errors.New("the figure cannot be made small enough")
+	ErrUnsupported = errors.New("the figure cannot be downscaled")
+)
+
+// Fit returns the image re-encoded, and downscaled if needed, so that it takes at most maxBytes bytes.
+// The image keeps its format. SVG images are vector images, so they cannot be downscaled.
+// A maxBytes of zero or less leaves the image as it is.
+func Fit(data tools.ImageData, maxBytes int) (tools.ImageData, error) {
+	if maxBytes <= 0 || len(data) <= maxBytes {
+		return data, nil
+	}
+
+	mimeType := data.MIMEType()
+	if mimeType == tools.MIMETypeSVG {
+		return nil, ErrUnsupported
+	}
+
+	decoded, err := decode(data, mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	source := toRGBA(decoded)
+	current := source
+
+	for {
+		encoded, err := encode(current, mimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(encoded) <= maxBytes {
+			return encoded, nil
+		}
+
+		width, height := current.Bounds().Dx(), current.Bounds().Dy()
+		if width <= minDimension && height <= minDimension {
+			return nil, ErrCannotFit
+		}
+
+		// The size of an encoded image grows with its area, so each side shrinks with the square root of the ratio.
+		scale := min(math.Sqrt(float64(maxBytes)/float64(len(encoded)))*shrinkMargin, shrinkMargin)
+		current = resize(source, max(int(float64(width)*scale), 1), max(int(float64(height)*scale), 1))
+	}
+}
+
+// FitRichContent fits each image of the content in maxBytes bytes.
+// Images that cannot fit are left out, with a note in the text content.
+func FitRichContent(content tools.RichContent, maxBytes int) tools.RichContent {
+	if maxBytes <= 0 {
+		return content
+	}
+
+	fitted := tools.RichContent{
+		TextContent:  content.TextContent,
+		ImageContent: make([]tools.ImageData, 0, len(content.ImageContent)),
+	}
+
+	for i, imageData := range content.ImageContent {
+		fittedImage, err := Fit(imageData, maxBytes)
+		if err != nil {
+			fitted.TextContent = append(fitted.TextContent, fmt.Sprintf(omittedFigureNote, i+1, maxBytes))
+			continue
+		}
+
+		fitted.ImageContent = append(fitted.ImageContent, fittedImage)
+	}
+
+	return fitted
+}
+
+func decode(data tools.ImageData, mimeType string) (image.Image, error) {
+	var (
+		decoded image.Image
+		err     error
+	)
+
+	if mimeType == tools.MIMETypeJPEG {
+		decoded, err = jpeg.Decode(bytes.NewReader(data))
+	} else {
+		decoded, err = png.Decode(bytes.NewReader(data))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode figure: %w", err)
+	}
+
+	return decoded, nil
+}
+
+func encode(img image.Image, mimeType string) (tools.ImageData, error) {
+	var buffer bytes.Buffer
+
+	var err error
+	if mimeType == tools.MIMETypeJPEG {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(&buffer, img)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode figure: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+
+	return rgba
+}
+
+// resize scales the image down with a box filter: each pixel is the average of the pixels it covers,
+// which keeps the thin lines and text of figures visible.
+func resize(source *image.RGBA, width int, height int) *image.RGBA {
+	sourceWidth, sourceHeight := source.Bounds().Dx(), source.Bounds().Dy()
+	resized := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := range height {
+		y0 := y * sourceHeight / height
+		y1 := max((y+1)*sourceHeight/height, y0+1)
+
+		for x := range width {
+			x0 := x * sourceWidth / width
+			x1 := max((x+1)*sourceWidth/width, x0+1)
+
+			var sums [4]int
+			for sourceY := y0; sourceY < y1; sourceY++ {
+				offset := source.PixOffset(x0, sourceY)
+				for sourceX := x0; sourceX < x1; sourceX++ {
+					for channel := range sums {
+						sums[channel] += int(source.Pix[offset+channel])
+					}
+					offset += 4
+				}
+			}
+
+			count := (y1 - y0) * (x1 - x0)
+			offset := resized.PixOffset(x, y)
+			for channel, sum := range sums {
+				resized.Pix[offset+channel] = uint8(sum / count) //nolint:gosec // The average of bytes fits in a byte
+			}
+		}
+	}
+
+	return resized
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package figurefitter_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/figurefitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFit_UnderBudget(t *testing.T) {
+	// Arrange
+	data := newPNG(t, 64, 48)
+
+	// Act
+	fitted, err := figurefitter.Fit(data, len(data))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, data, fitted, "An image that fits should be left as it is")
+}
+
+func TestFit_NoBudget(t *testing.T) {
+	// Arrange
+	data := newPNG(t, 64, 48)
+
+	// Act
+	fitted, err := figurefitter.Fit(data, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, data, fitted)
+}
+
+func TestFit_DownscalesPNG(t *testing.T) {
+	// Arrange
+	data := newPNG(t, 400, 300)
+	maxBytes := len(data) / 4
+
+	// Act
+	fitted, err := figurefitter.Fit(data, maxBytes)
+
+	// Assert
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(fitted), maxBytes)
+	assert.Equal(t, "image/png", fitted.MIMEType(), "The image should stay a PNG image")
+
+	config, err := png.DecodeConfig(bytes.NewReader(fitted))
+	require.NoError(t, err)
+	assert.Less(t, config.Width, 400)
+	assert.InDelta(t, 4.0/3.0, float64(config.Width)/float64(config.Height), 0.05, "The aspect ratio should be kept")
+}
+
+func TestFit_DownscalesJPEG(t *testing.T) {
+	// Arrange
+	data := newJPEG(t, 400, 300)
+	maxBytes := len(data) / 4
+
+	// Act
+	fitted, err := figurefitter.Fit(data, maxBytes)
+
+	// Assert
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(fitted), maxBytes)
+	assert.Equal(t, "image/jpeg", fitted.MIMEType(), "The image should stay a JPEG image")
+
+	config, err := jpeg.DecodeConfig(bytes.NewReader(fitted))
+	require.NoError(t, err)
+	assert.Less(t, config.Width, 400)
+}
+
+func TestFit_ReencodesBeforeDownscaling(t *testing.T) {
+	// Arrange
+	uncompressed := newUncompressedPNG(t, 200, 100)
+
+	// Act
+	fitted, err := figurefitter.Fit(uncompressed, len(uncompressed)-1)
+
+	// Assert
+	require.NoError(t, err)
+
+	config, err := png.DecodeConfig(bytes.NewReader(fitted))
+	require.NoError(t, err)
+	assert.Equal(t, 200, config.Width, "Compressing the image should be enough to fit it")
+	assert.Equal(t, 100, config.Height)
+}
+
+func TestFit_CannotFit(t *testing.T) {
+	// Arrange
+	data := newPNG(t, 100, 100)
+
+	// Act
+	fitted, err := figurefitter.Fit(data, 10)
+
+	// Assert
+	require.ErrorIs(t, err, figurefitter.ErrCannotFit)
+	assert.Nil(t, fitted)
+}
+
+func TestFit_SVG(t *testing.T) {
+	// Arrange
+	data := tools.ImageData(`<svg xmlns="http://www.w3.org/2000/svg"><path d="M0 0 L100 100"/></svg>`)
+
+	// Act
+	fitted, err := figurefitter.Fit(data, 10)
+
+	// Assert
+	require.ErrorIs(t, err, figurefitter.ErrUnsupported)
+	assert.Nil(t, fitted)
+}
+
+func TestFit_InvalidImage(t *testing.T) {
+	// Arrange
+	data := tools.ImageData("\x89PNG\r\n\x1a\nnot really a png")
+
+	// Act
+	fitted, err := figurefitter.Fit(data, 10)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, fitted)
+}
+
+func TestFitRichContent_HappyPath(t *testing.T) {
+	// Arrange
+	large := newPNG(t, 400, 300)
+	small := newPNG(t, 8, 8)
+	maxBytes := len(large) / 4
+
+	content := tools.RichContent{
+		TextContent:  []string{"ans = 1"},
+		ImageContent: []tools.ImageData{large, small},
+	}
+
+	// Act
+	fitted := figurefitter.FitRichContent(content, maxBytes)
+
+	// Assert
+	assert.Equal(t, []string{"ans = 1"}, fitted.TextContent)
+	require.Len(t, fitted.ImageContent, 2)
+	assert.LessOrEqual(t, len(fitted.ImageContent[0]), maxBytes)
+	assert.Equal(t, small, fitted.ImageContent[1])
+}
+
+func TestFitRichContent_LeavesOutFiguresThatCannotFit(t *testing.T) {
+	// Arrange
+	svg := tools.ImageData(`<svg xmlns="http://www.w3.org/2000/svg"><path d="M0 0` + strings.Repeat(" L100 100", 100) + `"/></svg>`)
+	small := newPNG(t, 2, 2)
+
+	content := tools.RichContent{
+		TextContent:  []string{"ans = 1"},
+		ImageContent: []tools.ImageData{svg, small},
+	}
+
+	// Act
+	fitted := figurefitter.FitRichContent(content, len(small))
+
+	// Assert
+	assert.Equal(t, []tools.ImageData{small}, fitted.ImageContent)
+	assert.Equal(t, []string{"ans = 1", "Figure 1 was left out: it cannot be made smaller than " + strconv.Itoa(len(small)) + " bytes."}, fitted.TextContent)
+}
+
+func TestFitRichContent_NoBudget(t *testing.T) {
+	// Arrange
+	content := tools.RichContent{
+		TextContent:  []string{"ans = 1"},
+		ImageContent: []tools.ImageData{tools.ImageData("image1")},
+	}
+
+	// Act
+	fitted := figurefitter.FitRichContent(content, 0)
+
+	// Assert
+	assert.Equal(t, content, fitted)
+}
+
+// newFigure draws a plot-like image: a white background, a grid and a noisy line, so that it does not compress to nothing.
+func newFigure(width int, height int) *image.RGBA {
+	random := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // Test data does not need a secure random generator
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			shade := uint8(235 + random.IntN(20)) //nolint:gosec // Less than 255
+			img.Set(x, y, color.RGBA{R: shade, G: shade, B: shade, A: 255})
+			if x%20 == 0 || y%20 == 0 {
+				img.Set(x, y, color.RGBA{R: 200, G: 200, B: 200, A: 255})
+			}
+		}
+
+		x := (y*7 + random.IntN(5)) % width
+		img.Set(x, y, color.RGBA{B: 255, A: 255})
+	}
+
+	return img
+}
+
+func newPNG(t *testing.T, width int, height int) tools.ImageData {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, newFigure(width, height)))
+
+	return buffer.Bytes()
+}
+
+func newUncompressedPNG(t *testing.T, width int, height int) tools.ImageData {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.NoCompression}
+	require.NoError(t, encoder.Encode(&buffer, newFigure(width, height)))
+
+	return buffer.Bytes()
+}
+
+func newJPEG(t *testing.T, width int, height int) tools.ImageData {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, newFigure(width, height), &jpeg.Options{Quality: 95}))
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package responseconverter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	toolsCallMethod = "tools/call"
+
+	// OutputURIPrefix is the prefix of the URIs of the resources that serve the full outputs.
+	OutputURIPrefix = "matlab-output://"
+
+	truncationMarker = "\n\n[... %d of %d bytes of output omitted. To read the full output, read the resource %s%s in pages, for example %s%s?offset=0&limit=%d ...]\n\n"
+)
+
+// OutputLimiter truncates the text of tool results to a budget, and keeps the full text in an OutputStore.
+type OutputLimiter struct {
+	outputStore *OutputStore
+}
+
+func NewOutputLimiter(
+	outputStore *OutputStore,
+) *OutputLimiter {
+	return &OutputLimiter{
+		outputStore: outputStore,
+	}
+}
+
+// Middleware returns an MCP middleware that limits the text of each tool result to maxBytes, whether the tool is
+// built in, custom, or added through the SDK. If maxBytes is zero, the middleware does nothing.
+func (l *OutputLimiter) Middleware(logger entities.Logger, maxBytes int) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		if maxBytes <= 0 {
+			return next
+		}
+
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			result, err := next(ctx, method, req)
+			if err != nil || method != toolsCallMethod {
+				return result, err
+			}
+
+			callToolResult, ok := result.(*mcp.CallToolResult)
+			if !ok || callToolResult == nil {
+				return result, nil
+			}
+
+			if callIDs := l.Limit(callToolResult, maxBytes); len(callIDs) > 0 {
+				logger.
+					With("call-ids", callIDs).
+					With("max-bytes", maxBytes).
+					Debug("Truncated tool output")
+			}
+
+			return callToolResult, nil
+		}
+	}
+}
+
+// Limit truncates the text of a tool result that is longer than maxBytes to its start and end, with a marker that
+// tells how to read the full text, and returns the IDs of the stored full texts. The text contents of the result are
+// joined into one, and other contents are kept. In structured results, the string fields are truncated instead, so
+// that the result stays valid, and the text contents that mirror the structured result are updated to match.
+func (l *OutputLimiter) Limit(result *mcp.CallToolResult, maxBytes int) []string {
+	if result.StructuredContent == nil {
+		return l.limitText(result, maxBytes)
+	}
+
+	structuredJSON, err := json.Marshal(result.StructuredContent)
+	if err != nil || len(structuredJSON) <= maxBytes {
+		return l.limitText(result, maxBytes)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(structuredJSON))
+	decoder.UseNumber()
+
+	var structured any
+	if err := decoder.Decode(&structured); err != nil {
+		return l.limitText(result, maxBytes)
+	}
+
+	var callIDs []string
+	structured = l.limitStrings(structured, fieldBudget(collectStrings(structured, nil), maxBytes), &callIDs)
+	if len(callIDs) == 0 {
+		return l.limitText(result, maxBytes)
+	}
+
+	limitedJSON, err := json.Marshal(structured)
+	if err != nil {
+		return l.limitText(result, maxBytes)
+	}
+
+	result.StructuredContent = json.RawMessage(limitedJSON)
+
+	if len(result.Content) == 1 {
+		if textContent, ok := result.Content[0].(*mcp.TextContent); ok && textContent.Text == string(structuredJSON) {
+			result.Content = []mcp.Content{&mcp.TextContent{Text: string(limitedJSON)}}
+			return callIDs
+		}
+	}
+
+	return append(callIDs, l.limitText(result, maxBytes)...)
+}
+
+func (l *OutputLimiter) limitText(result *mcp.CallToolResult, maxBytes int) []string {
+	var texts []string
+	for _, content := range result.Content {
+		if textContent, ok := content.(*mcp.TextContent); ok {
+			texts = append(texts, textContent.Text)
+		}
+	}
+
+	fullText := strings.Join(texts, "\n")
+	if len(fullText) <= maxBytes {
+		return nil
+	}
+
+	callID := l.outputStore.Store(fullText)
+	truncatedText := truncate(fullText, maxBytes, callID)
+
+	contents := make([]mcp.Content, 0, len(result.Content))
+	textAdded := false
+	for _, content := range result.Content {
+		if _, ok := content.(*mcp.TextContent); !ok {
+			contents = append(contents, content)
+			continue
+		}
+
+		if !textAdded {
+			contents = append(contents, &mcp.TextContent{Text: truncatedText})
+			textAdded = true
+		}
+	}
+	result.Content = contents
+
+	return []string{callID}
+}
+
+// limitStrings truncates the strings of a decoded JSON value that are longer than maxBytes, and stores their full text.
+func (l *OutputLimiter) limitStrings(value any, maxBytes int, callIDs *[]string) any {
+	switch typed := value.(type) {
+	case string:
+		if len(typed) <= maxBytes {
+			return typed
+		}
+
+		callID := l.outputStore.Store(typed)
+		*callIDs = append(*callIDs, callID)
+		return truncate(typed, maxBytes, callID)
+	case map[string]any:
+		for key, field := range typed {
+			typed[key] = l.limitStrings(field, maxBytes, callIDs)
+		}
+	case []any:
+		for i, element := range typed {
+			typed[i] = l.limitStrings(element, maxBytes, callIDs)
+		}
+	}
+
+	return value
+}
+
+// collectStrings appends the lengths of the strings of a decoded JSON value.
+func collectStrings(value any, lengths []int) []int {
+	switch typed := value.(type) {
+	case string:
+		lengths = append(lengths, len(typed))
+	case map[string]any:
+		for _, field := range typed {
+			lengths = collectStrings(field, lengths)
+		}
+	case []any:
+		for _, element := range typed {
+			lengths = collectStrings(element, lengths)
+		}
+	}
+
+	return lengths
+}
+
+// fieldBudget shares maxBytes between strings, so that short strings are kept whole and the long ones share the rest
+// evenly. It returns the length that strings are truncated to.
+func fieldBudget(lengths []int, maxBytes int) int {
+	slices.Sort(lengths)
+
+	remaining := maxBytes
+	for i, length := range lengths {
+		share := remaining / (len(lengths) - i)
+		if length > share {
+			return share
+		}
+
+		remaining -= length
+	}
+
+	return maxBytes
+}
+
+// truncate keeps the start and the end of the text, each half of maxBytes. The cuts are moved to line breaks
+// that are close, so that lines of console output are kept whole.
+func truncate(text string, maxBytes int, callID string) string {
+	headEnd := runeStart(text, maxBytes/2)
+	if lineEnd := strings.LastIndexByte(text[:headEnd], '\n'); lineEnd >= headEnd/2 {
+		headEnd = lineEnd + 1
+	}
+
+	tailStart := runeStart(text, max(len(text)-maxBytes/2, headEnd))
+	if lineStart := strings.IndexByte(text[tailStart:], '\n'); lineStart >= 0 && lineStart < (len(text)-tailStart)/2 {
+		tailStart += lineStart + 1
+	}
+
+	omitted := tailStart - headEnd
+	marker := fmt.Sprintf(truncationMarker, omitted, len(text), OutputURIPrefix, callID, OutputURIPrefix, callID, maxBytes)
+
+	return text[:headEnd] + marker + text[tailStart:]
+}
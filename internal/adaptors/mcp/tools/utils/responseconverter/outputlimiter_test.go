@@ -0,0 +1,311 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package responseconverter_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputLimiter_Limit_UnderBudget(t *testing.T) {
+	// Arrange
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	result := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ans = 42"}}}
+
+	// Act
+	callIDs := limiter.Limit(result, 100)
+
+	// Assert
+	assert.Empty(t, callIDs)
+	assert.Equal(t, []mcp.Content{&mcp.TextContent{Text: "ans = 42"}}, result.Content)
+}
+
+func TestOutputLimiter_Limit_TruncatesHeadAndTail(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	limiter := responseconverter.NewOutputLimiter(store)
+
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = "line " + strings.Repeat("x", 14)
+	}
+	lines[0] = "first line ........"
+	lines[99] = "last line ........."
+	output := strings.Join(lines, "\n")
+
+	image := &mcp.ImageContent{MIMEType: "image/png", Data: []byte("figure")}
+	result := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: output}, image}}
+
+	// Act
+	callIDs := limiter.Limit(result, 200)
+
+	// Assert
+	require.Len(t, callIDs, 1)
+	callID := callIDs[0]
+	require.Len(t, result.Content, 2)
+	assert.Equal(t, image, result.Content[1], "Images should be kept")
+
+	text := result.Content[0].(*mcp.TextContent).Text //nolint:forcetypeassert // Checked by the test
+	assert.True(t, strings.HasPrefix(text, "first line ........\n"), "The start of the output should be kept")
+	assert.True(t, strings.HasSuffix(text, "\nlast line ........."), "The end of the output should be kept")
+	assert.Contains(t, text, "matlab-output://"+callID)
+	assert.Contains(t, text, "of 1999 bytes of output omitted")
+	assert.Less(t, len(text), 500)
+
+	page, err := store.Read(callID, 0, len(output))
+	require.NoError(t, err)
+	assert.Equal(t, output, page.Text, "The full output should be stored")
+}
+
+func TestOutputLimiter_Limit_CutsAtCharacters(t *testing.T) {
+	// Arrange
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	result := &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: strings.Repeat("é", 100)}}}
+
+	// Act
+	callIDs := limiter.Limit(result, 51)
+
+	// Assert
+	require.Len(t, callIDs, 1)
+	text := result.Content[0].(*mcp.TextContent).Text //nolint:forcetypeassert // Checked by the test
+	assert.True(t, strings.HasPrefix(text, strings.Repeat("é", 13)+"\n"))
+	assert.True(t, strings.HasSuffix(text, "\n"+strings.Repeat("é", 12)))
+}
+
+func TestOutputLimiter_Limit_JoinsTextContents(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	limiter := responseconverter.NewOutputLimiter(store)
+	result := &mcp.CallToolResult{Content: []mcp.Content{
+		&mcp.TextContent{Text: strings.Repeat("a", 50)},
+		&mcp.TextContent{Text: strings.Repeat("b", 50)},
+	}}
+
+	// Act
+	callIDs := limiter.Limit(result, 20)
+
+	// Assert
+	require.Len(t, callIDs, 1)
+	require.Len(t, result.Content, 1)
+
+	page, err := store.Read(callIDs[0], 0, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", 50)+"\n"+strings.Repeat("b", 50), page.Text)
+}
+
+func TestOutputLimiter_Limit_StructuredContent(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	limiter := responseconverter.NewOutputLimiter(store)
+
+	consoleOutput := "first line\n" + strings.Repeat("x", 1000) + "\nlast line"
+	structuredJSON := `{"console_output":"first line\n` + strings.Repeat("x", 1000) + `\nlast line","status":"ok","count":3}`
+	result := &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: structuredJSON}},
+		StructuredContent: json.RawMessage(structuredJSON),
+	}
+
+	// Act
+	callIDs := limiter.Limit(result, 200)
+
+	// Assert
+	require.Len(t, callIDs, 1)
+
+	var structured struct {
+		ConsoleOutput string `json:"console_output"`
+		Status        string `json:"status"`
+		Count         int    `json:"count"`
+	}
+	limitedJSON, ok := result.StructuredContent.(json.RawMessage)
+	require.True(t, ok, "The structured result should stay JSON")
+	require.NoError(t, json.Unmarshal(limitedJSON, &structured), "The structured result should stay valid")
+
+	assert.Equal(t, "ok", structured.Status, "Short fields should be kept")
+	assert.Equal(t, 3, structured.Count)
+	assert.True(t, strings.HasPrefix(structured.ConsoleOutput, "first line\n"), "The start of the field should be kept")
+	assert.True(t, strings.HasSuffix(structured.ConsoleOutput, "\nlast line"), "The end of the field should be kept")
+	assert.Contains(t, structured.ConsoleOutput, "matlab-output://"+callIDs[0])
+	assert.Less(t, len(structured.ConsoleOutput), 500)
+
+	assert.Equal(t, []mcp.Content{&mcp.TextContent{Text: string(limitedJSON)}}, result.Content, "The text mirror should match the structured result")
+
+	page, err := store.Read(callIDs[0], 0, len(consoleOutput))
+	require.NoError(t, err)
+	assert.Equal(t, consoleOutput, page.Text, "The full field should be stored")
+}
+
+func TestOutputLimiter_Limit_StructuredContent_SharesBudget(t *testing.T) {
+	// Arrange
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	result := &mcp.CallToolResult{
+		StructuredContent: map[string]any{
+			"console_output": strings.Repeat("a", 1000),
+			"errors":         []string{strings.Repeat("b", 1000), "short"},
+		},
+	}
+
+	// Act
+	callIDs := limiter.Limit(result, 400)
+
+	// Assert
+	assert.Len(t, callIDs, 2, "Each long field should be stored")
+
+	var structured struct {
+		ConsoleOutput string   `json:"console_output"`
+		Errors        []string `json:"errors"`
+	}
+	limitedJSON, ok := result.StructuredContent.(json.RawMessage)
+	require.True(t, ok)
+	require.NoError(t, json.Unmarshal(limitedJSON, &structured))
+
+	require.Len(t, structured.Errors, 2)
+	assert.Equal(t, "short", structured.Errors[1])
+	assert.Less(t, len(structured.ConsoleOutput), 500)
+	assert.Less(t, len(structured.Errors[0]), 500)
+}
+
+func TestOutputLimiter_Limit_StructuredContent_UnderBudget(t *testing.T) {
+	// Arrange
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	structured := map[string]any{"console_output": "ans = 42"}
+	result := &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: `{"console_output":"ans = 42"}`}},
+		StructuredContent: structured,
+	}
+
+	// Act
+	callIDs := limiter.Limit(result, 100)
+
+	// Assert
+	assert.Empty(t, callIDs)
+	assert.Equal(t, structured, result.StructuredContent)
+}
+
+func TestOutputLimiter_Limit_StructuredContent_SeparateText(t *testing.T) {
+	// Arrange
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	result := &mcp.CallToolResult{
+		Content:           []mcp.Content{&mcp.TextContent{Text: strings.Repeat("t", 1000)}},
+		StructuredContent: map[string]any{"console_output": strings.Repeat("s", 1000)},
+	}
+
+	// Act
+	callIDs := limiter.Limit(result, 200)
+
+	// Assert
+	assert.Len(t, callIDs, 2, "The structured field and the text should both be stored")
+	text := result.Content[0].(*mcp.TextContent).Text //nolint:forcetypeassert // Checked by the test
+	assert.Less(t, len(text), 500)
+}
+
+func TestOutputLimiter_Middleware_TruncatesStructuredToolCalls(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	structuredJSON := `{"console_output":"` + strings.Repeat("x", 1000) + `"}`
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{
+			Content:           []mcp.Content{&mcp.TextContent{Text: structuredJSON}},
+			StructuredContent: json.RawMessage(structuredJSON),
+		}, nil
+	}
+
+	handler := limiter.Middleware(mockLogger, 100)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	callToolResult := result.(*mcp.CallToolResult)                                                     //nolint:forcetypeassert // Checked by the test
+	assert.Contains(t, string(callToolResult.StructuredContent.(json.RawMessage)), "matlab-output://") //nolint:forcetypeassert // Checked by the test
+	assert.Contains(t, callToolResult.Content[0].(*mcp.TextContent).Text, "matlab-output://")          //nolint:forcetypeassert // Checked by the test
+}
+
+func TestOutputLimiter_Middleware_TruncatesToolCalls(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: strings.Repeat("x", 1000)}}}, nil
+	}
+
+	handler := limiter.Middleware(mockLogger, 100)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	text := result.(*mcp.CallToolResult).Content[0].(*mcp.TextContent).Text //nolint:forcetypeassert // Checked by the test
+	assert.Contains(t, text, "matlab-output://")
+}
+
+func TestOutputLimiter_Middleware_OtherMethods(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	expectedResult := &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{Text: strings.Repeat("x", 1000)}}}
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return expectedResult, nil
+	}
+
+	handler := limiter.Middleware(mockLogger, 100)(next)
+
+	// Act
+	result, err := handler(t.Context(), "resources/read", &mcp.ReadResourceRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Same(t, expectedResult, result)
+}
+
+func TestOutputLimiter_Middleware_Disabled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+	output := strings.Repeat("x", 1000)
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: output}}}, nil
+	}
+
+	handler := limiter.Middleware(mockLogger, 0)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, output, result.(*mcp.CallToolResult).Content[0].(*mcp.TextContent).Text) //nolint:forcetypeassert // Checked by the test
+}
+
+func TestOutputLimiter_Middleware_Error(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	limiter := responseconverter.NewOutputLimiter(responseconverter.NewOutputStore())
+
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, assert.AnError
+	}
+
+	handler := limiter.Middleware(mockLogger, 100)(next)
+
+	// Act
+	result, err := handler(t.Context(), "tools/call", &mcp.CallToolRequest{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package responseconverter
+
+import (
+	"container/list"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// maxStoredOutputs and maxStoredBytes bound the memory that stored outputs use. When either is exceeded,
+	// the least recently used outputs are evicted.
+	maxStoredOutputs = 32
+	maxStoredBytes   = 64 << 20 // 64 MB
+)
+
+var (
+	ErrOutputNotFound = errors.New("no stored output with this ID, the output may have been evicted to make room for newer outputs")
+	ErrInvalidPage    = errors.New("invalid page, offset and limit must be zero or positive, and offset must not be past the end of the output")
+)
+
+// OutputPage is a page of a stored output.
+type OutputPage struct {
+	Text string
+	// Offset is the byte offset of the page in the output, which is moved forward to the start of a character if needed.
+	Offset int
+	// NextOffset is the byte offset of the next page, which is TotalBytes after the last page.
+	NextOffset int
+	TotalBytes int
+}
+
+type storedOutput struct {
+	callID string
+	text   string
+}
+
+// OutputStore keeps the full outputs of the tool calls whose output is truncated, so that they can be read in pages.
+// It evicts the least recently used outputs.
+type OutputStore struct {
+	maxOutputs int
+	maxBytes   int
+
+	lock       sync.Mutex
+	order      *list.List // Front is the most recently used output.
+	outputs    map[string]*list.Element
+	totalBytes int
+}
+
+func NewOutputStore() *OutputStore {
+	return newOutputStore(maxStoredOutputs, maxStoredBytes)
+}
+
+func newOutputStore(maxOutputs int, maxBytes int) *OutputStore {
+	return &OutputStore{
+		maxOutputs: maxOutputs,
+		maxBytes:   maxBytes,
+		order:      list.New(),
+		outputs:    map[string]*list.Element{},
+	}
+}
+
+// Store keeps an output, and returns the ID to read it with. The ID is random, so that outputs cannot be guessed.
+func (s *OutputStore) Store(text string) string {
+	callID := rand.Text()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.outputs[callID] = s.order.PushFront(&storedOutput{callID: callID, text: text})
+	s.totalBytes += len(text)
+
+	// An output that is larger than maxBytes on its own is kept until the next one is stored.
+	for s.order.Len() > s.maxOutputs || (s.totalBytes > s.maxBytes && s.order.Len() > 1) {
+		s.evict(s.order.Back())
+	}
+
+	return callID
+}
+
+// Read returns up to limit bytes of an output, from offset. Pages are cut at the start of characters, so that
+// they are valid UTF-8 when the output is.
+func (s *OutputStore) Read(callID string, offset int, limit int) (OutputPage, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	element, ok := s.outputs[callID]
+	if !ok {
+		return OutputPage{}, ErrOutputNotFound
+	}
+
+	s.order.MoveToFront(element)
+	text := element.Value.(*storedOutput).text //nolint:forcetypeassert // The list only holds stored outputs
+
+	if offset < 0 || limit < 0 || offset > len(text) {
+		return OutputPage{}, ErrInvalidPage
+	}
+
+	start := runeStart(text, offset)
+	end := min(start+limit, len(text))
+	for end > start && end < len(text) && !utf8.RuneStart(text[end]) {
+		end--
+	}
+
+	if end == start && limit > 0 {
+		// Always make progress, even when the limit is smaller than a character.
+		end = runeStart(text, min(start+1, len(text)))
+	}
+
+	return OutputPage{
+		Text:       text[start:end],
+		Offset:     start,
+		NextOffset: end,
+		TotalBytes: len(text),
+	}, nil
+}
+
+func (s *OutputStore) evict(element *list.Element) {
+	output := s.order.Remove(element).(*storedOutput) //nolint:forcetypeassert // The list only holds stored outputs
+	delete(s.outputs, output.callID)
+	s.totalBytes -= len(output.text)
+}
+
+// runeStart moves offset forward to the start of a character.
+func runeStart(text string, offset int) int {
+	for offset < len(text) && !utf8.RuneStart(text[offset]) {
+		offset++
+	}
+
+	return offset
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package responseconverter_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputStore_Read_HappyPath(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	callID := store.Store("0123456789")
+
+	// Act
+	page, err := store.Read(callID, 2, 5)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, responseconverter.OutputPage{Text: "23456", Offset: 2, NextOffset: 7, TotalBytes: 10}, page)
+}
+
+func TestOutputStore_Read_LastPage(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	callID := store.Store("0123456789")
+
+	// Act
+	page, err := store.Read(callID, 8, 5)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, responseconverter.OutputPage{Text: "89", Offset: 8, NextOffset: 10, TotalBytes: 10}, page)
+}
+
+func TestOutputStore_Read_CutsAtCharacters(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+	callID := store.Store("aéb") // é is 2 bytes long
+
+	// Act
+	firstPage, firstErr := store.Read(callID, 0, 2)
+	secondPage, secondErr := store.Read(callID, 2, 10)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, "a", firstPage.Text, "The page should end before a character that does not fit")
+	assert.Equal(t, 1, firstPage.NextOffset)
+	assert.Equal(t, "b", secondPage.Text, "The page should start at the next character")
+	assert.Equal(t, 3, secondPage.Offset)
+}
+
+func TestOutputStore_Read_InvalidPage(t *testing.T) {
+	testCases := []struct {
+		name   string
+		offset int
+		limit  int
+	}{
+		{name: "negative offset", offset: -1, limit: 5},
+		{name: "negative limit", offset: 0, limit: -1},
+		{name: "offset past the end", offset: 11, limit: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			store := responseconverter.NewOutputStore()
+			callID := store.Store("0123456789")
+
+			// Act
+			_, err := store.Read(callID, tc.offset, tc.limit)
+
+			// Assert
+			require.ErrorIs(t, err, responseconverter.ErrInvalidPage)
+		})
+	}
+}
+
+func TestOutputStore_Read_UnknownCallID(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+
+	// Act
+	_, err := store.Read("unknown", 0, 10)
+
+	// Assert
+	require.ErrorIs(t, err, responseconverter.ErrOutputNotFound)
+}
+
+func TestOutputStore_Store_UniqueCallIDs(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStore()
+
+	// Act
+	firstCallID := store.Store("first")
+	secondCallID := store.Store("second")
+
+	// Assert
+	assert.NotEqual(t, firstCallID, secondCallID)
+}
+
+func TestOutputStore_Store_EvictsLeastRecentlyUsedOutput(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStoreWithLimits(2, 1000)
+	firstCallID := store.Store("first")
+	secondCallID := store.Store("second")
+
+	_, err := store.Read(firstCallID, 0, 10)
+	require.NoError(t, err)
+
+	// Act
+	thirdCallID := store.Store("third")
+
+	// Assert
+	_, err = store.Read(secondCallID, 0, 10)
+	require.ErrorIs(t, err, responseconverter.ErrOutputNotFound, "The least recently used output should be evicted")
+
+	_, err = store.Read(firstCallID, 0, 10)
+	require.NoError(t, err, "An output that was read recently should be kept")
+
+	_, err = store.Read(thirdCallID, 0, 10)
+	require.NoError(t, err)
+}
+
+func TestOutputStore_Store_EvictsToStayUnderMaxBytes(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStoreWithLimits(10, 10)
+	firstCallID := store.Store("123456")
+
+	// Act
+	secondCallID := store.Store("789012")
+
+	// Assert
+	_, err := store.Read(firstCallID, 0, 10)
+	require.ErrorIs(t, err, responseconverter.ErrOutputNotFound)
+
+	_, err = store.Read(secondCallID, 0, 10)
+	require.NoError(t, err)
+}
+
+func TestOutputStore_Store_KeepsOutputLargerThanMaxBytes(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStoreWithLimits(10, 10)
+
+	// Act
+	callID := store.Store(strings.Repeat("x", 20))
+
+	// Assert
+	page, err := store.Read(callID, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 20, page.TotalBytes)
+}
+
+func TestOutputStore_ConcurrentAccess(t *testing.T) {
+	// Arrange
+	store := responseconverter.NewOutputStoreWithLimits(4, 1000)
+
+	var wg sync.WaitGroup
+
+	// Act
+	for range 20 {
+		wg.Go(func() {
+			callID := store.Store("output")
+			_, _ = store.Read(callID, 0, 3)
+		})
+	}
+	wg.Wait()
+
+	// Assert
+	callID := store.Store("last")
+	page, err := store.Read(callID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "last", page.Text)
+}
@@ -3,15 +3,20 @@
 package responseconverter
 
 import (
+	"fmt"
+
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// svgFigureURI names the SVG figures of a result, which are sent as embedded resources as they are not images that clients can display.
+const svgFigureURI = "matlab-figure://figure%d.svg"
+
 func ConvertEvalResponseToRichContent(response entities.EvalResponse) tools.RichContent {
-	imageData := make([]tools.PNGImageData, len(response.Images))
+	imageData := make([]tools.ImageData, len(response.Images))
 	for i := range response.Images {
-		imageData[i] = tools.PNGImageData(response.Images[i])
+		imageData[i] = tools.ImageData(response.Images[i])
 	}
 	return tools.RichContent{
 		TextContent:  []string{response.ConsoleOutput},
@@ -26,9 +31,21 @@ func ConvertRichContentToCallToolResult(content tools.RichContent) *mcp.CallTool
 	for _, text := range content.TextContent {
 		result.Content = append(result.Content, &mcp.TextContent{Text: text})
 	}
-	for _, imageData := range content.ImageContent {
+	for i, imageData := range content.ImageContent {
+		mimeType := imageData.MIMEType()
+		if mimeType == tools.MIMETypeSVG {
+			result.Content = append(result.Content, &mcp.EmbeddedResource{
+				Resource: &mcp.ResourceContents{
+					URI:      fmt.Sprintf(svgFigureURI, i+1),
+					MIMEType: mimeType,
+					Text:     string(imageData),
+				},
+			})
+			continue
+		}
+
 		result.Content = append(result.Content, &mcp.ImageContent{
-			MIMEType: "image/png",
+			MIMEType: mimeType,
 			Data:     imageData,
 		})
 	}
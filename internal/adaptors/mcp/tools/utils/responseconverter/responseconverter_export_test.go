@@ -0,0 +1,7 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package responseconverter
+
+func NewOutputStoreWithLimits(maxOutputs int, maxBytes int) *OutputStore {
+	return newOutputStore(maxOutputs, maxBytes)
+}
@@ -28,7 +28,7 @@ func TestConvertEvalResponseToRichContent_HappyPath(t *testing.T) {
 			},
 			expected: tools.RichContent{
 				TextContent:  []string{""},
-				ImageContent: []tools.PNGImageData{},
+				ImageContent: []tools.ImageData{},
 			},
 		},
 		{
@@ -39,7 +39,7 @@ func TestConvertEvalResponseToRichContent_HappyPath(t *testing.T) {
 			},
 			expected: tools.RichContent{
 				TextContent:  []string{"Hello World"},
-				ImageContent: []tools.PNGImageData{},
+				ImageContent: []tools.ImageData{},
 			},
 		},
 		{
@@ -50,7 +50,7 @@ func TestConvertEvalResponseToRichContent_HappyPath(t *testing.T) {
 			},
 			expected: tools.RichContent{
 				TextContent:  []string{""},
-				ImageContent: []tools.PNGImageData{tools.PNGImageData("image1"), tools.PNGImageData("image2")},
+				ImageContent: []tools.ImageData{tools.ImageData("image1"), tools.ImageData("image2")},
 			},
 		},
 		{
@@ -61,7 +61,7 @@ func TestConvertEvalResponseToRichContent_HappyPath(t *testing.T) {
 			},
 			expected: tools.RichContent{
 				TextContent:  []string{"Processing complete"},
-				ImageContent: []tools.PNGImageData{tools.PNGImageData("chart")},
+				ImageContent: []tools.ImageData{tools.ImageData("chart")},
 			},
 		},
 	}
@@ -89,7 +89,7 @@ func TestConvertRichContentToCallToolResult_HappyPath(t *testing.T) {
 			name: "EmptyContent",
 			content: tools.RichContent{
 				TextContent:  []string{},
-				ImageContent: []tools.PNGImageData{},
+				ImageContent: []tools.ImageData{},
 			},
 			expectedContent: []mcp.Content{},
 		},
@@ -97,7 +97,7 @@ func TestConvertRichContentToCallToolResult_HappyPath(t *testing.T) {
 			name: "TextContentOnly",
 			content: tools.RichContent{
 				TextContent:  []string{"Hello World"},
-				ImageContent: []tools.PNGImageData{},
+				ImageContent: []tools.ImageData{},
 			},
 			expectedContent: []mcp.Content{
 				&mcp.TextContent{Text: "Hello World"},
@@ -107,7 +107,7 @@ func TestConvertRichContentToCallToolResult_HappyPath(t *testing.T) {
 			name: "ImageContentOnly",
 			content: tools.RichContent{
 				TextContent:  []string{},
-				ImageContent: []tools.PNGImageData{tools.PNGImageData("image1"), tools.PNGImageData("image2")},
+				ImageContent: []tools.ImageData{tools.ImageData("image1"), tools.ImageData("image2")},
 			},
 			expectedContent: []mcp.Content{
 				&mcp.ImageContent{MIMEType: "image/png", Data: []byte("image1")},
@@ -118,18 +118,30 @@ func TestConvertRichContentToCallToolResult_HappyPath(t *testing.T) {
 			name: "BothTextAndImageContent",
 			content: tools.RichContent{
 				TextContent:  []string{"Processing complete"},
-				ImageContent: []tools.PNGImageData{tools.PNGImageData("chart")},
+				ImageContent: []tools.ImageData{tools.ImageData("chart")},
 			},
 			expectedContent: []mcp.Content{
 				&mcp.TextContent{Text: "Processing complete"},
 				&mcp.ImageContent{MIMEType: "image/png", Data: []byte("chart")},
 			},
 		},
+		{
+			name: "JPEGAndSVGImages",
+			content: tools.RichContent{
+				TextContent:  []string{"Exported"},
+				ImageContent: []tools.ImageData{tools.ImageData("\xff\xd8\xff\xe0jpeg"), tools.ImageData("<svg></svg>")},
+			},
+			expectedContent: []mcp.Content{
+				&mcp.TextContent{Text: "Exported"},
+				&mcp.ImageContent{MIMEType: "image/jpeg", Data: []byte("\xff\xd8\xff\xe0jpeg")},
+				&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{URI: "matlab-figure://figure2.svg", MIMEType: "image/svg+xml", Text: "<svg></svg>"}},
+			},
+		},
 		{
 			name: "MultipleTextEntries",
 			content: tools.RichContent{
 				TextContent:  []string{"line1", "line2"},
-				ImageContent: []tools.PNGImageData{},
+				ImageContent: []tools.ImageData{},
 			},
 			expectedContent: []mcp.Content{
 				&mcp.TextContent{Text: "line1"},
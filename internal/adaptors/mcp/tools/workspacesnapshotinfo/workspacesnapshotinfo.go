@@ -0,0 +1,31 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package workspacesnapshotinfo holds the output that the workspace snapshot tools share, to describe a snapshot.
+package workspacesnapshotinfo
+
+import (
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+)
+
+type Snapshot struct {
+	Name      string   `json:"name"       jsonschema:"The name of the snapshot."`
+	CreatedAt string   `json:"created_at" jsonschema:"When the snapshot was taken, in RFC 3339 format."`
+	SizeBytes int64    `json:"size_bytes" jsonschema:"The size of the snapshot file, in bytes."`
+	Variables []string `json:"variables"  jsonschema:"The names of the variables in the snapshot."`
+}
+
+func FromSnapshot(snapshot workspacesnapshot.Snapshot) Snapshot {
+	variables := snapshot.Variables
+	if variables == nil {
+		variables = []string{}
+	}
+
+	return Snapshot{
+		Name:      snapshot.Name,
+		CreatedAt: snapshot.CreatedAt.UTC().Format(time.RFC3339),
+		SizeBytes: snapshot.SizeBytes,
+		Variables: variables,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package workspacesnapshotinfo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/workspacesnapshotinfo"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSnapshot_HappyPath(t *testing.T) {
+	// Arrange
+	snapshot := workspacesnapshot.Snapshot{
+		Name:      "before-fit",
+		CreatedAt: time.Date(2026, 3, 14, 9, 26, 53, 589000000, time.FixedZone("CET", 3600)),
+		SizeBytes: 2048,
+		Variables: []string{"data", "model"},
+	}
+
+	// Act
+	result := workspacesnapshotinfo.FromSnapshot(snapshot)
+
+	// Assert
+	assert.Equal(t, workspacesnapshotinfo.Snapshot{
+		Name:      "before-fit",
+		CreatedAt: "2026-03-14T08:26:53Z",
+		SizeBytes: 2048,
+		Variables: []string{"data", "model"},
+	}, result)
+}
+
+func TestFromSnapshot_NoVariables(t *testing.T) {
+	// Act
+	result := workspacesnapshotinfo.FromSnapshot(workspacesnapshot.Snapshot{Name: "empty"})
+
+	// Assert
+	assert.Equal(t, []string{}, result.Variables, "Variables should be an empty list, not null")
+}
@@ -3,11 +3,37 @@
 package tools
 
 import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/pathcompleter"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/closematlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/deleteworkspacesnapshot"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/getmatlabdebugstack"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listmatlabprojectfiles"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabprojectchecks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkgetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinklistblocks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkopenmodel"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkupdatediagram"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/snapshotworkspace"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/stepmatlabdebugger"
 )
 
 type Definition struct {
@@ -16,17 +42,70 @@ type Definition struct {
 }
 
 func Definitions() []Definition {
-	checkCode := checkmatlabcode.New(nil, nil, nil)
-	detectToolboxes := detectmatlabtoolboxes.New(nil, nil, nil)
-	evalCode := evalmatlabcode.New(nil, nil, nil, nil)
-	runFile := runmatlabfile.New(nil, nil, nil, nil)
-	runTestFile := runmatlabtestfile.New(nil, nil, nil)
+	// The path completer is only used by the tools for completions, so it needs no dependencies to describe the tools.
+	pathCompleter := pathcompleter.New(nil, nil, nil)
+
+	checkCode := checkmatlabcode.New(nil, pathCompleter, nil, nil)
+	detectToolboxes := detectmatlabtoolboxes.New(nil, nil, nil, nil, nil, nil)
+	evalCode := evalmatlabcode.New(nil, nil, nil, nil, nil)
+	runFile := runmatlabfile.New(nil, nil, pathCompleter, nil, nil, nil)
+	runSections := runmatlabsections.New(nil, nil, nil, nil, nil)
+	runTestFile := runmatlabtestfile.New(nil, nil, pathCompleter, nil, nil)
+	setBreakpoint := setmatlabbreakpoint.New(nil, nil, pathCompleter, nil, nil)
+	clearBreakpoints := clearmatlabbreakpoints.New(nil, nil, nil, nil)
+	debugCode := debugmatlabcode.New(nil, nil, nil, nil)
+	getDebugStack := getmatlabdebugstack.New(nil, nil, nil)
+	stepDebugger := stepmatlabdebugger.New(nil, nil, nil, nil)
+	profileCode := profilematlabcode.New(nil, nil, nil, nil)
+	analyzeProject := analyzematlabproject.New(nil, nil, nil, nil)
+	analyzeDependencies := analyzematlabdependencies.New(nil, nil, nil)
+	convertLiveScript := convertlivescript.New(nil, nil, pathCompleter, nil, nil)
+	simulinkOpenModel := simulinkopenmodel.New(nil, nil, nil, nil)
+	simulinkListBlocks := simulinklistblocks.New(nil, nil, nil)
+	simulinkGetBlockParams := simulinkgetblockparams.New(nil, nil, nil)
+	simulinkSetBlockParams := simulinksetblockparams.New(nil, nil, nil, nil)
+	simulinkUpdateDiagram := simulinkupdatediagram.New(nil, nil, nil, nil)
+	simulinkSim := simulinksim.New(nil, nil, nil, nil)
+	openMATLABProject := openmatlabproject.New(nil, nil, pathCompleter, nil, nil)
+	closeMATLABProject := closematlabproject.New(nil, nil, nil, nil)
+	listMATLABProjectFiles := listmatlabprojectfiles.New(nil, nil, nil)
+	runMATLABProjectChecks := runmatlabprojectchecks.New(nil, nil, nil)
+	snapshotWorkspace := snapshotworkspace.New(nil, nil, nil, nil)
+	restoreWorkspace := restoreworkspace.New(nil, nil, nil, nil)
+	listWorkspaceSnapshots := listworkspacesnapshots.New(nil, nil, nil)
+	deleteWorkspaceSnapshot := deleteworkspacesnapshot.New(nil, nil, nil, nil)
+	matlabSessionStatus := matlabsessionstatus.New(nil, nil)
 
 	return []Definition{
 		{Name: checkCode.Name(), Description: checkCode.Description()},
 		{Name: detectToolboxes.Name(), Description: detectToolboxes.Description()},
 		{Name: evalCode.Name(), Description: evalCode.Description()},
 		{Name: runFile.Name(), Description: runFile.Description()},
+		{Name: runSections.Name(), Description: runSections.Description()},
 		{Name: runTestFile.Name(), Description: runTestFile.Description()},
+		{Name: setBreakpoint.Name(), Description: setBreakpoint.Description()},
+		{Name: clearBreakpoints.Name(), Description: clearBreakpoints.Description()},
+		{Name: debugCode.Name(), Description: debugCode.Description()},
+		{Name: getDebugStack.Name(), Description: getDebugStack.Description()},
+		{Name: stepDebugger.Name(), Description: stepDebugger.Description()},
+		{Name: profileCode.Name(), Description: profileCode.Description()},
+		{Name: analyzeProject.Name(), Description: analyzeProject.Description()},
+		{Name: analyzeDependencies.Name(), Description: analyzeDependencies.Description()},
+		{Name: convertLiveScript.Name(), Description: convertLiveScript.Description()},
+		{Name: simulinkOpenModel.Name(), Description: simulinkOpenModel.Description()},
+		{Name: simulinkListBlocks.Name(), Description: simulinkListBlocks.Description()},
+		{Name: simulinkGetBlockParams.Name(), Description: simulinkGetBlockParams.Description()},
+		{Name: simulinkSetBlockParams.Name(), Description: simulinkSetBlockParams.Description()},
+		{Name: simulinkUpdateDiagram.Name(), Description: simulinkUpdateDiagram.Description()},
+		{Name: simulinkSim.Name(), Description: simulinkSim.Description()},
+		{Name: openMATLABProject.Name(), Description: openMATLABProject.Description()},
+		{Name: closeMATLABProject.Name(), Description: closeMATLABProject.Description()},
+		{Name: listMATLABProjectFiles.Name(), Description: listMATLABProjectFiles.Description()},
+		{Name: runMATLABProjectChecks.Name(), Description: runMATLABProjectChecks.Description()},
+		{Name: snapshotWorkspace.Name(), Description: snapshotWorkspace.Description()},
+		{Name: restoreWorkspace.Name(), Description: restoreWorkspace.Description()},
+		{Name: listWorkspaceSnapshots.Name(), Description: listWorkspaceSnapshots.Description()},
+		{Name: deleteWorkspaceSnapshot.Name(), Description: deleteWorkspaceSnapshot.Description()},
+		{Name: matlabSessionStatus.Name(), Description: matlabSessionStatus.Description()},
 	}
 }
@@ -20,14 +20,39 @@ func TestDefinitions_HappyPath(t *testing.T) {
 	})
 
 	// Assert
-	require.Len(t, defs, 5)
+	require.Len(t, defs, 30)
 
 	expectedNames := []string{
 		"check_matlab_code",
 		"detect_matlab_toolboxes",
 		"evaluate_matlab_code",
 		"run_matlab_file",
+		"run_matlab_sections",
 		"run_matlab_test_file",
+		"set_matlab_breakpoint",
+		"clear_matlab_breakpoints",
+		"debug_matlab_code",
+		"get_matlab_debug_stack",
+		"step_matlab_debugger",
+		"profile_matlab_code",
+		"analyze_matlab_project",
+		"analyze_matlab_dependencies",
+		"convert_live_script",
+		"simulink_open_model",
+		"simulink_list_blocks",
+		"simulink_get_block_params",
+		"simulink_set_block_params",
+		"simulink_update_diagram",
+		"simulink_sim",
+		"open_matlab_project",
+		"close_matlab_project",
+		"list_matlab_project_files",
+		"run_matlab_project_checks",
+		"snapshot_workspace",
+		"restore_workspace",
+		"list_workspace_snapshots",
+		"delete_workspace_snapshot",
+		"matlab_session_status",
 	}
 
 	for i, expectedName := range expectedNames {
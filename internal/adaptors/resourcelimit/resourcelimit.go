@@ -15,6 +15,7 @@ type LoggerFactory interface {
 type SyscallLayer interface {
 	Getrlimit(resource int, rlim *unixfacade.Rlimit) error
 	Setrlimit(resource int, rlim *unixfacade.Rlimit) error
+	Prlimit(pid int, resource int, newLimit *unixfacade.Rlimit, old *unixfacade.Rlimit) error
 }
 
 type Manager struct {
@@ -47,3 +47,23 @@ func (m *Manager) CapOpenFilesLimit(limit uint64) (func() error, error) {
 		return nil
 	}, nil
 }
+
+// LimitMemory caps the address space (RLIMIT_AS) of the process pid at limit bytes, for both the soft and the hard limit.
+// Processes that pid starts afterwards inherit the limit.
+func (m *Manager) LimitMemory(pid int, limit uint64) error {
+	var current unixfacade.Rlimit
+	if err := m.syscallLayer.Prlimit(pid, unix.RLIMIT_AS, nil, &current); err != nil {
+		return fmt.Errorf("failed to get RLIMIT_AS of process %d: %w", pid, err)
+	}
+
+	if current.Max < limit {
+		return fmt.Errorf("failed to set RLIMIT_AS of process %d to %d bytes: the hard limit is %d bytes", pid, limit, current.Max)
+	}
+
+	limited := unixfacade.Rlimit{Cur: limit, Max: limit}
+	if err := m.syscallLayer.Prlimit(pid, unix.RLIMIT_AS, &limited, nil); err != nil {
+		return fmt.Errorf("failed to set RLIMIT_AS of process %d to %d bytes: %w", pid, limit, err)
+	}
+
+	return nil
+}
@@ -348,3 +348,118 @@ func TestCapOpenFilesLimit_ResetFails(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestLimitMemory_HappyPath(t *testing.T) {
+	// Arrange
+	const pid = 1234
+	const limit uint64 = 8 << 30
+
+	mockLoggerFactory := resourcelimitmocks.NewMockLoggerFactory(t)
+	mockSyscall := resourcelimitmocks.NewMockSyscallLayer(t)
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, (*unixfacade.Rlimit)(nil), mock.AnythingOfType("*unix.Rlimit")).
+		RunAndReturn(func(_ int, _ int, _ *unixfacade.Rlimit, old *unixfacade.Rlimit) error {
+			old.Cur = unix.RLIM_INFINITY
+			old.Max = unix.RLIM_INFINITY
+			return nil
+		}).
+		Once()
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, &unixfacade.Rlimit{Cur: limit, Max: limit}, (*unixfacade.Rlimit)(nil)).
+		Return(nil).
+		Once()
+
+	manager := resourcelimit.New(mockLoggerFactory, mockSyscall)
+
+	// Act
+	err := manager.LimitMemory(pid, limit)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestLimitMemory_GetFails(t *testing.T) {
+	// Arrange
+	const pid = 1234
+	const limit uint64 = 8 << 30
+
+	mockLoggerFactory := resourcelimitmocks.NewMockLoggerFactory(t)
+	mockSyscall := resourcelimitmocks.NewMockSyscallLayer(t)
+
+	expectedErr := errors.New("prlimit error")
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, (*unixfacade.Rlimit)(nil), mock.AnythingOfType("*unix.Rlimit")).
+		Return(expectedErr).
+		Once()
+
+	manager := resourcelimit.New(mockLoggerFactory, mockSyscall)
+
+	// Act
+	err := manager.LimitMemory(pid, limit)
+
+	// Assert
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestLimitMemory_HardLimitBelowLimit(t *testing.T) {
+	// Arrange
+	const pid = 1234
+	const limit uint64 = 8 << 30
+	const rlimMax uint64 = 4 << 30
+
+	mockLoggerFactory := resourcelimitmocks.NewMockLoggerFactory(t)
+	mockSyscall := resourcelimitmocks.NewMockSyscallLayer(t)
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, (*unixfacade.Rlimit)(nil), mock.AnythingOfType("*unix.Rlimit")).
+		RunAndReturn(func(_ int, _ int, _ *unixfacade.Rlimit, old *unixfacade.Rlimit) error {
+			old.Cur = rlimMax
+			old.Max = rlimMax
+			return nil
+		}).
+		Once()
+
+	manager := resourcelimit.New(mockLoggerFactory, mockSyscall)
+
+	// Act
+	err := manager.LimitMemory(pid, limit)
+
+	// Assert
+	require.ErrorContains(t, err, "hard limit")
+}
+
+func TestLimitMemory_SetFails(t *testing.T) {
+	// Arrange
+	const pid = 1234
+	const limit uint64 = 8 << 30
+
+	mockLoggerFactory := resourcelimitmocks.NewMockLoggerFactory(t)
+	mockSyscall := resourcelimitmocks.NewMockSyscallLayer(t)
+
+	expectedErr := errors.New("prlimit error")
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, (*unixfacade.Rlimit)(nil), mock.AnythingOfType("*unix.Rlimit")).
+		RunAndReturn(func(_ int, _ int, _ *unixfacade.Rlimit, old *unixfacade.Rlimit) error {
+			old.Cur = unix.RLIM_INFINITY
+			old.Max = unix.RLIM_INFINITY
+			return nil
+		}).
+		Once()
+
+	mockSyscall.EXPECT().
+		Prlimit(pid, unix.RLIMIT_AS, &unixfacade.Rlimit{Cur: limit, Max: limit}, (*unixfacade.Rlimit)(nil)).
+		Return(expectedErr).
+		Once()
+
+	manager := resourcelimit.New(mockLoggerFactory, mockSyscall)
+
+	// Act
+	err := manager.LimitMemory(pid, limit)
+
+	// Assert
+	require.ErrorIs(t, err, expectedErr)
+}
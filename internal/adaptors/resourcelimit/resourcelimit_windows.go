@@ -3,7 +3,16 @@
 
 package resourcelimit
 
+import (
+	"errors"
+	"fmt"
+)
+
 func (m *Manager) CapOpenFilesLimit(_ uint64) (func() error, error) {
 	// no-op
 	return func() error { return nil }, nil
 }
+
+func (m *Manager) LimitMemory(pid int, _ uint64) error {
+	return fmt.Errorf("failed to limit the memory of process %d: %w", pid, errors.ErrUnsupported)
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package sarif writes the Code Analyzer issues of a folder as a SARIF 2.1.0 log, for code scanning tools.
+package sarif
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+)
+
+const filePermissions = 0o600
+
+type OSLayer interface {
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+// FileWriter writes SARIF logs to files.
+type FileWriter struct {
+	osLayer OSLayer
+}
+
+func NewFileWriter(
+	osLayer OSLayer,
+) *FileWriter {
+	return &FileWriter{
+		osLayer: osLayer,
+	}
+}
+
+// WriteSARIF creates or replaces the file at path with the SARIF log of the analysis.
+func (w *FileWriter) WriteSARIF(path string, result analyzematlabproject.ReturnArgs) error {
+	var content bytes.Buffer
+	if err := Encode(&content, result); err != nil {
+		return err
+	}
+
+	if err := w.osLayer.WriteFile(path, content.Bytes(), filePermissions); err != nil {
+		return fmt.Errorf("failed to write the SARIF report %s: %w", path, err)
+	}
+
+	return nil
+}
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	sarifToolName           = "MATLAB Code Analyzer"
+	sarifToolInformationURI = "https://www.mathworks.com/help/matlab/ref/codeissues.html"
+
+	// sarifSourceRoot is the base of the locations of the results, so that they do not depend on where the folder is.
+	sarifSourceRoot = "SRCROOT"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                   `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactURI `json:"originalUriBaseIds"`
+	Results            []sarifResult               `json:"results"`
+	Properties         *sarifRunProperties         `json:"properties,omitempty"`
+}
+
+type sarifRunProperties struct {
+	ConfigurationFile string `json:"configurationFile"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID     string                `json:"ruleId,omitempty"`
+	RuleIndex  *int                  `json:"ruleIndex,omitempty"`
+	Level      string                `json:"level"`
+	Message    sarifMessage          `json:"message"`
+	Locations  []sarifLocation       `json:"locations"`
+	Properties sarifResultProperties `json:"properties"`
+}
+
+type sarifResultProperties struct {
+	Fixable bool `json:"fixable"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactURI `json:"artifactLocation"`
+	Region           sarifRegion      `json:"region"`
+}
+
+type sarifArtifactURI struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// Encode writes the SARIF log of the analysis to writer, as indented JSON.
+func Encode(writer io.Writer, result analyzematlabproject.ReturnArgs) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(newSARIFLog(result))
+}
+
+func newSARIFLog(result analyzematlabproject.ReturnArgs) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           sarifToolName,
+				InformationURI: sarifToolInformationURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		OriginalURIBaseIDs: map[string]sarifArtifactURI{
+			sarifSourceRoot: {URI: folderURI(result.FolderPath)},
+		},
+		Results: make([]sarifResult, 0, len(result.Issues)),
+	}
+
+	if result.ConfigurationFile != "" {
+		run.Properties = &sarifRunProperties{ConfigurationFile: result.ConfigurationFile}
+	}
+
+	ruleIndexes := map[string]int{}
+
+	for _, issue := range result.Issues {
+		sarifIssue := sarifResult{
+			RuleID:  issue.CheckID,
+			Level:   sarifLevel(issue.Severity),
+			Message: sarifMessage{Text: issue.Description},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: artifactLocation(result.FolderPath, issue.File),
+					Region:           sarifRegion{StartLine: issue.Line, StartColumn: issue.StartColumn},
+				},
+			}},
+			Properties: sarifResultProperties{Fixable: issue.Fixable},
+		}
+
+		// The Code Analyzer reports the last column of an issue, SARIF the column after it
+		if issue.EndColumn >= issue.StartColumn && issue.StartColumn > 0 {
+			sarifIssue.Locations[0].PhysicalLocation.Region.EndColumn = issue.EndColumn + 1
+		}
+
+		if issue.CheckID != "" {
+			ruleIndex, ok := ruleIndexes[issue.CheckID]
+			if !ok {
+				ruleIndex = len(run.Tool.Driver.Rules)
+				ruleIndexes[issue.CheckID] = ruleIndex
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+					ID:               issue.CheckID,
+					ShortDescription: sarifMessage{Text: issue.Description},
+				})
+			}
+			sarifIssue.RuleIndex = &ruleIndex
+		}
+
+		run.Results = append(run.Results, sarifIssue)
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+}
+
+func sarifLevel(severity string) string {
+	switch severity {
+	case analyzematlabproject.SeverityError:
+		return "error"
+	case analyzematlabproject.SeverityInfo:
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+// artifactLocation returns the location of a file relative to the analyzed folder, or its absolute location if it is outside the folder.
+func artifactLocation(folder string, file string) sarifArtifactURI {
+	relative, ok := relativeToFolder(folder, file)
+	if !ok {
+		return sarifArtifactURI{URI: fileURI(file)}
+	}
+
+	return sarifArtifactURI{
+		URI:       (&url.URL{Path: relative}).String(),
+		URIBaseID: sarifSourceRoot,
+	}
+}
+
+func folderURI(folder string) string {
+	uri := fileURI(folder)
+	if !strings.HasSuffix(uri, "/") {
+		uri += "/"
+	}
+	return uri
+}
+
+// fileURI returns the file URI of an absolute path, such as file:///C:/work/a.m on Windows.
+func fileURI(path string) string {
+	slashPath := filepath.ToSlash(path)
+	if !strings.HasPrefix(slashPath, "/") {
+		slashPath = "/" + slashPath
+	}
+	return (&url.URL{Scheme: "file", Path: slashPath}).String()
+}
+
+// relativeToFolder returns the path of a file relative to a folder, with forward slashes, and whether the file is in the folder.
+func relativeToFolder(folder string, file string) (string, bool) {
+	relative, err := filepath.Rel(folder, file)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(relative), true
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sarif_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sarif"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sarif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sarifReport struct {
+	Version string `json:"version"`
+	Runs    []struct {
+		Results []struct {
+			RuleID    string `json:"ruleId"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI       string `json:"uri"`
+						URIBaseID string `json:"uriBaseId"`
+					} `json:"artifactLocation"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+func analysisResult() analyzematlabproject.ReturnArgs {
+	folder := filepath.Join("work", "project")
+	return analyzematlabproject.ReturnArgs{
+		FolderPath: folder,
+		Files:      []string{filepath.Join(folder, "main.m")},
+		Issues: []analyzematlabproject.CodeIssue{
+			{File: filepath.Join(folder, "main.m"), CheckID: "NASGU", Description: "Variable 'x' might be unused.", Line: 2, StartColumn: 5, EndColumn: 5, Severity: analyzematlabproject.SeverityWarning},
+		},
+	}
+}
+
+func TestNewFileWriter_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	writer := sarif.NewFileWriter(mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, writer)
+}
+
+func TestFileWriter_WriteSARIF_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	path := filepath.Join("work", "codeissues.sarif")
+
+	var written []byte
+	mockOSLayer.EXPECT().
+		WriteFile(path, mock.Anything, os.FileMode(0o600)).
+		Run(func(_ string, data []byte, _ os.FileMode) {
+			written = data
+		}).
+		Return(nil).
+		Once()
+
+	// Act
+	err := sarif.NewFileWriter(mockOSLayer).WriteSARIF(path, analysisResult())
+
+	// Assert
+	require.NoError(t, err)
+
+	var report sarifReport
+	require.NoError(t, json.Unmarshal(written, &report), "Report should be valid JSON")
+	assert.Equal(t, "2.1.0", report.Version)
+	require.Len(t, report.Runs, 1)
+	require.Len(t, report.Runs[0].Results, 1)
+
+	result := report.Runs[0].Results[0]
+	assert.Equal(t, "NASGU", result.RuleID)
+	require.Len(t, result.Locations, 1)
+	assert.Equal(t, "main.m", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "SRCROOT", result.Locations[0].PhysicalLocation.ArtifactLocation.URIBaseID)
+}
+
+func TestFileWriter_WriteSARIF_WriteError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	path := filepath.Join("work", "codeissues.sarif")
+
+	mockOSLayer.EXPECT().
+		WriteFile(path, mock.Anything, os.FileMode(0o600)).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	err := sarif.NewFileWriter(mockOSLayer).WriteSARIF(path, analysisResult())
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), path, "Error should name the file")
+}
+
+func TestEncode_NoIssues(t *testing.T) {
+	// Arrange
+	result := analysisResult()
+	result.Issues = nil
+
+	var output bytes.Buffer
+
+	// Act
+	err := sarif.Encode(&output, result)
+
+	// Assert
+	require.NoError(t, err)
+
+	var report sarifReport
+	require.NoError(t, json.Unmarshal(output.Bytes(), &report), "Report should be valid JSON")
+	require.Len(t, report.Runs, 1)
+	assert.NotNil(t, report.Runs[0].Results, "Results should be an empty list, not null")
+	assert.Empty(t, report.Runs[0].Results)
+}
+
+func TestEncode_FileOutsideFolder(t *testing.T) {
+	// Arrange
+	result := analysisResult()
+	result.Issues[0].File = filepath.Join(string(filepath.Separator)+"other", "outside.m")
+
+	var output bytes.Buffer
+
+	// Act
+	err := sarif.Encode(&output, result)
+
+	// Assert
+	require.NoError(t, err)
+
+	var report sarifReport
+	require.NoError(t, json.Unmarshal(output.Bytes(), &report), "Report should be valid JSON")
+	location := report.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation
+	assert.Equal(t, "file:///other/outside.m", location.URI, "Files outside the folder should have an absolute location")
+	assert.Empty(t, location.URIBaseID)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package lifecyclehookresources
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/config"
+	publictypes "github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type LoggerFactory interface {
+	New(logger entities.Logger) publictypes.Logger
+}
+
+type ConfigFactory interface {
+	New(
+		internalConfig config.InternalConfig,
+		internalMessageCatalog config.InternalMessageCatalog,
+	) publictypes.Config
+}
+
+type Factory[Dependencies any] struct {
+	loggerFactory LoggerFactory
+	configFactory ConfigFactory
+}
+
+func NewFactory[Dependencies any](
+	loggerFactory LoggerFactory,
+	configFactory ConfigFactory,
+) *Factory[Dependencies] {
+	return &Factory[Dependencies]{
+		loggerFactory: loggerFactory,
+		configFactory: configFactory,
+	}
+}
+
+func (f *Factory[Dependencies]) New(
+	internal definition.LifecycleHookResources,
+) publictypes.LifecycleHookResources[Dependencies] {
+	var dependencies Dependencies
+	if internal.Dependencies != nil {
+		castDependencies, ok := internal.Dependencies.(Dependencies)
+		if ok {
+			dependencies = castDependencies
+		} else {
+			internal.Logger.Error("Dependencies type cast failed, using zero value")
+		}
+	}
+
+	return &lifecycleHookResourcesAdaptor[Dependencies]{
+		logger:       f.loggerFactory.New(internal.Logger),
+		config:       f.configFactory.New(internal.Config, internal.MessageCatalog),
+		dependencies: dependencies,
+	}
+}
+
+type lifecycleHookResourcesAdaptor[Dependencies any] struct {
+	logger       publictypes.Logger
+	config       publictypes.Config
+	dependencies Dependencies
+}
+
+func (r *lifecycleHookResourcesAdaptor[Dependencies]) Logger() publictypes.Logger {
+	return r.logger
+}
+
+func (r *lifecycleHookResourcesAdaptor[Dependencies]) Config() publictypes.Config {
+	return r.config
+}
+
+func (r *lifecycleHookResourcesAdaptor[Dependencies]) Dependencies() Dependencies {
+	return r.dependencies
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package lifecyclehookresources_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/lifecyclehookresources"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	definitionmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/definition"
+	lifecyclehookresourcesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/lifecyclehookresources"
+	publictypesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/publictypes"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/require"
+)
+
+type TestDependencies struct {
+	Value string
+}
+
+func TestNewFactory_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &lifecyclehookresourcesmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &lifecyclehookresourcesmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	// Act
+	factory := lifecyclehookresources.NewFactory[*TestDependencies](mockLoggerFactory, mockConfigFactory)
+
+	// Assert
+	require.NotNil(t, factory)
+}
+
+func TestFactory_New_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &lifecyclehookresourcesmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &lifecyclehookresourcesmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockInternalLogger := &entitiesmocks.MockLogger{}
+	defer mockInternalLogger.AssertExpectations(t)
+
+	mockInternalConfig := &configmocks.MockGenericConfig{}
+	defer mockInternalConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	expectedLogger := &publictypesmocks.MockLogger{}
+	defer expectedLogger.AssertExpectations(t)
+
+	expectedConfig := &publictypesmocks.MockConfig{}
+	defer expectedConfig.AssertExpectations(t)
+
+	expectedDependencies := &TestDependencies{Value: "test"}
+
+	mockLoggerFactory.EXPECT().
+		New(mockInternalLogger).
+		Return(expectedLogger).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		New(mockInternalConfig, mockMessageCatalog).
+		Return(expectedConfig).
+		Once()
+
+	internalResources := definition.NewLifecycleHookResources(
+		mockInternalLogger,
+		mockInternalConfig,
+		mockMessageCatalog,
+		expectedDependencies,
+	)
+
+	// Act
+	resources := lifecyclehookresources.NewFactory[*TestDependencies](mockLoggerFactory, mockConfigFactory).New(internalResources)
+
+	// Assert
+	require.Equal(t, expectedLogger, resources.Logger())
+	require.Equal(t, expectedConfig, resources.Config())
+	require.Equal(t, expectedDependencies, resources.Dependencies())
+}
+
+func TestFactory_New_Dependencies_Nil(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &lifecyclehookresourcesmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &lifecyclehookresourcesmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockInternalLogger := &entitiesmocks.MockLogger{}
+	defer mockInternalLogger.AssertExpectations(t)
+
+	mockInternalConfig := &configmocks.MockGenericConfig{}
+	defer mockInternalConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		New(mockInternalLogger).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		New(mockInternalConfig, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	internalResources := definition.NewLifecycleHookResources(
+		mockInternalLogger,
+		mockInternalConfig,
+		mockMessageCatalog,
+		nil,
+	)
+
+	// Act
+	resources := lifecyclehookresources.NewFactory[*TestDependencies](mockLoggerFactory, mockConfigFactory).New(internalResources)
+
+	// Assert
+	require.NotNil(t, resources)
+	require.Nil(t, resources.Dependencies())
+}
+
+func TestFactory_New_Dependencies_CastFailure(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &lifecyclehookresourcesmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfigFactory := &lifecyclehookresourcesmocks.MockConfigFactory{}
+	defer mockConfigFactory.AssertExpectations(t)
+
+	mockInternalLogger := &entitiesmocks.MockLogger{}
+	defer mockInternalLogger.AssertExpectations(t)
+
+	mockInternalConfig := &configmocks.MockGenericConfig{}
+	defer mockInternalConfig.AssertExpectations(t)
+
+	mockMessageCatalog := &definitionmocks.MockMessageCatalog{}
+	defer mockMessageCatalog.AssertExpectations(t)
+
+	mockInternalLogger.EXPECT().
+		Error("Dependencies type cast failed, using zero value").
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		New(mockInternalLogger).
+		Return(nil).
+		Once()
+
+	mockConfigFactory.EXPECT().
+		New(mockInternalConfig, mockMessageCatalog).
+		Return(nil).
+		Once()
+
+	internalResources := definition.NewLifecycleHookResources(
+		mockInternalLogger,
+		mockInternalConfig,
+		mockMessageCatalog,
+		"wrong type",
+	)
+
+	// Act
+	resources := lifecyclehookresources.NewFactory[*TestDependencies](mockLoggerFactory, mockConfigFactory).New(internalResources)
+
+	// Assert
+	require.Nil(t, resources.Dependencies())
+}
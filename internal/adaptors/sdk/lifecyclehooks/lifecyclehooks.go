@@ -0,0 +1,54 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package lifecyclehooks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+)
+
+type ResourcesFactory[Dependencies any] interface {
+	New(internal definition.LifecycleHookResources) publictypes.LifecycleHookResources[Dependencies]
+}
+
+type Factory[Dependencies any] struct {
+	resourcesFactory ResourcesFactory[Dependencies]
+}
+
+func NewFactory[Dependencies any](
+	resourcesFactory ResourcesFactory[Dependencies],
+) *Factory[Dependencies] {
+	return &Factory[Dependencies]{
+		resourcesFactory: resourcesFactory,
+	}
+}
+
+func (f *Factory[Dependencies]) New(hooks []publictypes.LifecycleHook[Dependencies]) []definition.LifecycleHook {
+	internalHooks := make([]definition.LifecycleHook, 0, len(hooks))
+	for _, hook := range hooks {
+		internalHooks = append(internalHooks, definition.LifecycleHook{
+			Name:       hook.Name,
+			OnStart:    f.adapt(hook.OnStart),
+			OnShutdown: f.adapt(hook.OnShutdown),
+		})
+	}
+
+	return internalHooks
+}
+
+func (f *Factory[Dependencies]) adapt(hookFunc publictypes.LifecycleHookFunc[Dependencies]) definition.LifecycleHookFunc {
+	if hookFunc == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, internalResources definition.LifecycleHookResources) error {
+		resources := f.resourcesFactory.New(internalResources)
+		if err := hookFunc(ctx, resources); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package lifecyclehooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/lifecyclehooks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	lifecyclehooksmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/lifecyclehooks"
+	publictypesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/publictypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TestDependencies struct{}
+
+func TestNewFactory_HappyPath(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &lifecyclehooksmocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	// Act
+	factory := lifecyclehooks.NewFactory[*TestDependencies](mockResourcesFactory)
+
+	// Assert
+	require.NotNil(t, factory)
+}
+
+func TestFactory_New_HappyPath(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &lifecyclehooksmocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockResources := &publictypesmocks.MockLifecycleHookResources[*TestDependencies]{}
+	defer mockResources.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedInternalResources := definition.LifecycleHookResources{
+		Logger: testutils.NewInspectableLogger(),
+	}
+
+	mockResourcesFactory.EXPECT().
+		New(expectedInternalResources).
+		Return(mockResources).
+		Twice()
+
+	var events []string
+	hooks := []publictypes.LifecycleHook[*TestDependencies]{
+		{
+			Name: "database",
+			OnStart: func(_ context.Context, resources publictypes.LifecycleHookResources[*TestDependencies]) publictypes.Error {
+				assert.Equal(t, mockResources, resources)
+				events = append(events, "start")
+				return nil
+			},
+			OnShutdown: func(_ context.Context, resources publictypes.LifecycleHookResources[*TestDependencies]) publictypes.Error {
+				assert.Equal(t, mockResources, resources)
+				events = append(events, "shutdown")
+				return nil
+			},
+		},
+	}
+
+	// Act
+	internalHooks := lifecyclehooks.NewFactory[*TestDependencies](mockResourcesFactory).New(hooks)
+
+	// Assert
+	require.Len(t, internalHooks, 1)
+	assert.Equal(t, "database", internalHooks[0].Name)
+	require.NoError(t, internalHooks[0].OnStart(ctx, expectedInternalResources))
+	require.NoError(t, internalHooks[0].OnShutdown(ctx, expectedInternalResources))
+	assert.Equal(t, []string{"start", "shutdown"}, events)
+}
+
+func TestFactory_New_NilFunctions(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &lifecyclehooksmocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	hooks := []publictypes.LifecycleHook[*TestDependencies]{
+		{Name: "nothing"},
+	}
+
+	// Act
+	internalHooks := lifecyclehooks.NewFactory[*TestDependencies](mockResourcesFactory).New(hooks)
+
+	// Assert
+	require.Len(t, internalHooks, 1)
+	assert.Nil(t, internalHooks[0].OnStart)
+	assert.Nil(t, internalHooks[0].OnShutdown)
+}
+
+func TestFactory_New_NilHooks(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &lifecyclehooksmocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	// Act
+	internalHooks := lifecyclehooks.NewFactory[*TestDependencies](mockResourcesFactory).New(nil)
+
+	// Assert
+	require.Empty(t, internalHooks)
+}
+
+func TestFactory_New_Error(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &lifecyclehooksmocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockResources := &publictypesmocks.MockLifecycleHookResources[*TestDependencies]{}
+	defer mockResources.AssertExpectations(t)
+
+	expectedError := anI18nError
+	expectedInternalResources := definition.LifecycleHookResources{
+		Logger: testutils.NewInspectableLogger(),
+	}
+
+	mockResourcesFactory.EXPECT().
+		New(expectedInternalResources).
+		Return(mockResources).
+		Once()
+
+	hooks := []publictypes.LifecycleHook[*TestDependencies]{
+		{
+			Name: "database",
+			OnStart: func(_ context.Context, _ publictypes.LifecycleHookResources[*TestDependencies]) publictypes.Error {
+				return expectedError
+			},
+		},
+	}
+
+	// Act
+	internalHooks := lifecyclehooks.NewFactory[*TestDependencies](mockResourcesFactory).New(hooks)
+	err := internalHooks[0].OnStart(t.Context(), expectedInternalResources)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+var anI18nError = &i18nError{} //nolint:gochecknoglobals // anI18nError is an error
+
+type i18nError struct{}
+
+func (e *i18nError) Error() string { return "" }
+
+func (e *i18nError) MWMarker() {}
@@ -0,0 +1,19 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package publictypes
+
+import "context"
+
+type LifecycleHookResources[Dependencies any] interface {
+	Logger() Logger
+	Config() Config
+	Dependencies() Dependencies
+}
+
+type LifecycleHookFunc[Dependencies any] func(ctx context.Context, resources LifecycleHookResources[Dependencies]) Error
+
+type LifecycleHook[Dependencies any] struct {
+	Name       string
+	OnStart    LifecycleHookFunc[Dependencies]
+	OnShutdown LifecycleHookFunc[Dependencies]
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package publictypes
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type ToolCall interface {
+	ToolCallRequest
+	Name() string
+	Arguments() json.RawMessage
+	WithArguments(arguments json.RawMessage) ToolCall
+}
+
+type ToolResult interface {
+	IsError() bool
+	TextContent() []string
+}
+
+type ToolHandler func(ctx context.Context, call ToolCall) (ToolResult, Error)
+
+type ToolMiddleware func(next ToolHandler) ToolHandler
+
+type ToolMiddlewareProvider[Dependencies any] func(ToolsProviderResources[Dependencies]) []ToolMiddleware
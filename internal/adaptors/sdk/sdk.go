@@ -9,11 +9,14 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/dependenciesprovider"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/dependenciesproviderresources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/features"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/lifecyclehookresources"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/lifecyclehooks"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/logger"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/messages"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/parameters"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/toolcallrequest"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/toolmiddleware"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/toolsprovider"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/toolsproviderresources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/watchdog"
@@ -46,6 +49,17 @@ func NewServer[Dependencies any](
 		toolsProviderResourcesFactory,
 		toolCallRequestFactory,
 	)
+	toolMiddlewareFactory := toolmiddleware.NewFactory(
+		toolsProviderResourcesFactory,
+		toolCallRequestFactory,
+	)
+	lifecycleHookResourcesFactory := lifecyclehookresources.NewFactory[Dependencies](
+		loggerFactory,
+		configFactory,
+	)
+	lifecycleHooksFactory := lifecyclehooks.NewFactory(
+		lifecycleHookResourcesFactory,
+	)
 	parametersFactory := parameters.NewFactory()
 	featuresFactory := features.NewFactory()
 	applicationFactory := adaptor.NewFactory()
@@ -56,6 +70,8 @@ func NewServer[Dependencies any](
 		parametersFactory,
 		dependenciesProviderFactory,
 		toolsProviderFactory,
+		toolMiddlewareFactory,
+		lifecycleHooksFactory,
 		applicationFactory,
 		os.Stderr,
 	)
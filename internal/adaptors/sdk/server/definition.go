@@ -4,6 +4,7 @@ package server
 
 import (
 	"slices"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
 )
@@ -20,6 +21,11 @@ type Definition[Dependencies any] struct {
 	DependenciesProvider publictypes.DependenciesProvider[Dependencies]
 
 	ToolsProvider publictypes.ToolsProvider[Dependencies]
+
+	ToolMiddlewareProvider publictypes.ToolMiddlewareProvider[Dependencies]
+
+	LifecycleHooks       []publictypes.LifecycleHook[Dependencies]
+	LifecycleHookTimeout time.Duration
 }
 
 func NewDefinition[Dependencies any](
@@ -30,6 +36,9 @@ func NewDefinition[Dependencies any](
 	parameters []publictypes.Parameter,
 	dependenciesProvider publictypes.DependenciesProvider[Dependencies],
 	toolsProvider publictypes.ToolsProvider[Dependencies],
+	toolMiddlewareProvider publictypes.ToolMiddlewareProvider[Dependencies],
+	lifecycleHooks []publictypes.LifecycleHook[Dependencies],
+	lifecycleHookTimeout time.Duration,
 ) Definition[Dependencies] {
 	return Definition[Dependencies]{
 		Name:         name,
@@ -43,5 +52,10 @@ func NewDefinition[Dependencies any](
 		DependenciesProvider: dependenciesProvider,
 
 		ToolsProvider: toolsProvider,
+
+		ToolMiddlewareProvider: toolMiddlewareProvider,
+
+		LifecycleHooks:       slices.Clone(lifecycleHooks),
+		LifecycleHookTimeout: lifecycleHookTimeout,
 	}
 }
@@ -4,6 +4,7 @@ package server_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
 	internalserver "github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/server"
@@ -23,6 +24,8 @@ func TestNewDefinition_HappyPath(t *testing.T) {
 		MATLAB: publictypes.MATLABFeature{Enabled: true},
 	}
 	expectedParameters := []publictypes.Parameter{mockParameter}
+	expectedLifecycleHooks := []publictypes.LifecycleHook[struct{}]{{Name: "database"}}
+	expectedLifecycleHookTimeout := 5 * time.Second
 
 	// Act
 	definition := internalserver.NewDefinition[struct{}](
@@ -33,6 +36,9 @@ func TestNewDefinition_HappyPath(t *testing.T) {
 		expectedParameters,
 		nil,
 		nil,
+		nil,
+		expectedLifecycleHooks,
+		expectedLifecycleHookTimeout,
 	)
 
 	// Assert
@@ -43,6 +49,9 @@ func TestNewDefinition_HappyPath(t *testing.T) {
 	require.Equal(t, expectedParameters, definition.Parameters)
 	require.Nil(t, definition.DependenciesProvider)
 	require.Nil(t, definition.ToolsProvider)
+	require.Nil(t, definition.ToolMiddlewareProvider)
+	require.Equal(t, expectedLifecycleHooks, definition.LifecycleHooks)
+	require.Equal(t, expectedLifecycleHookTimeout, definition.LifecycleHookTimeout)
 }
 
 func TestNewDefinition_ClonesParametersToPreventMutation(t *testing.T) {
@@ -65,6 +74,9 @@ func TestNewDefinition_ClonesParametersToPreventMutation(t *testing.T) {
 		parameters,
 		nil,
 		nil,
+		nil,
+		nil,
+		0,
 	)
 
 	// Mutate original slice to verify defensive copy
@@ -21,6 +21,14 @@ type ToolsProviderFactory[Dependencies any] interface {
 	New(provider toolsprovider.ToolsProvider[Dependencies]) internaldefinition.ToolsProvider
 }
 
+type ToolMiddlewareFactory[Dependencies any] interface {
+	New(provider publictypes.ToolMiddlewareProvider[Dependencies]) internaldefinition.ToolMiddlewareProvider
+}
+
+type LifecycleHooksFactory[Dependencies any] interface {
+	New(hooks []publictypes.LifecycleHook[Dependencies]) []internaldefinition.LifecycleHook
+}
+
 type ParametersFactory interface {
 	New(parameters []publictypes.Parameter) []entities.Parameter
 }
@@ -39,6 +47,8 @@ type Server[Dependencies any] struct {
 	parametersFactory           ParametersFactory
 	dependenciesProviderFactory DependenciesProviderFactory[Dependencies]
 	toolsProviderFactory        ToolsProviderFactory[Dependencies]
+	toolMiddlewareFactory       ToolMiddlewareFactory[Dependencies]
+	lifecycleHooksFactory       LifecycleHooksFactory[Dependencies]
 	applicationFactory          ApplicationFactory
 	errorWriter                 entities.Writer
 }
@@ -49,6 +59,8 @@ func New[Dependencies any](
 	parametersFactory ParametersFactory,
 	dependenciesProviderFactory DependenciesProviderFactory[Dependencies],
 	toolsProviderFactory ToolsProviderFactory[Dependencies],
+	toolMiddlewareFactory ToolMiddlewareFactory[Dependencies],
+	lifecycleHooksFactory LifecycleHooksFactory[Dependencies],
 	applicationFactory ApplicationFactory,
 	errorWriter entities.Writer,
 ) *Server[Dependencies] {
@@ -58,6 +70,8 @@ func New[Dependencies any](
 		parametersFactory:           parametersFactory,
 		dependenciesProviderFactory: dependenciesProviderFactory,
 		toolsProviderFactory:        toolsProviderFactory,
+		toolMiddlewareFactory:       toolMiddlewareFactory,
+		lifecycleHooksFactory:       lifecycleHooksFactory,
 		applicationFactory:          applicationFactory,
 		errorWriter:                 errorWriter,
 	}
@@ -80,6 +94,13 @@ func (s *Server[Dependencies]) StartAndWaitForCompletion(ctx context.Context) in
 		s.toolsProviderFactory.New(
 			s.serverDefinition.ToolsProvider,
 		),
+		s.toolMiddlewareFactory.New(
+			s.serverDefinition.ToolMiddlewareProvider,
+		),
+		s.lifecycleHooksFactory.New(
+			s.serverDefinition.LifecycleHooks,
+		),
+		s.serverDefinition.LifecycleHookTimeout,
 	)
 
 	application := s.applicationFactory.New(serverDefinition)
@@ -3,7 +3,9 @@
 package server_test
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
@@ -17,6 +19,7 @@ import (
 	servermocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/server"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	adaptormocks "github.com/matlab/matlab-mcp-server/mocks/wire/adaptor"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -30,6 +33,12 @@ func TestServer_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	mockToolsProviderFactory := &servermocks.MockToolsProviderFactory[struct{}]{}
 	defer mockToolsProviderFactory.AssertExpectations(t)
 
+	mockToolMiddlewareFactory := &servermocks.MockToolMiddlewareFactory[struct{}]{}
+	defer mockToolMiddlewareFactory.AssertExpectations(t)
+
+	mockLifecycleHooksFactory := &servermocks.MockLifecycleHooksFactory[struct{}]{}
+	defer mockLifecycleHooksFactory.AssertExpectations(t)
+
 	mockParametersFactory := &servermocks.MockParametersFactory{}
 	defer mockParametersFactory.AssertExpectations(t)
 
@@ -74,6 +83,9 @@ func TestServer_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		0,
 	)
 
 	mockFeaturesFactory.EXPECT().
@@ -96,6 +108,16 @@ func TestServer_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockToolMiddlewareFactory.EXPECT().
+		New(mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLifecycleHooksFactory.EXPECT().
+		New([]publictypes.LifecycleHook[struct{}](nil)).
+		Return(nil).
+		Once()
+
 	mockApplicationFactory.EXPECT().
 		New(expectedDefinition).
 		Return(mockApplication).
@@ -111,7 +133,7 @@ func TestServer_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
-	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockApplicationFactory, mockErrorWriter)
+	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockToolMiddlewareFactory, mockLifecycleHooksFactory, mockApplicationFactory, mockErrorWriter)
 
 	// Act
 	exitCode := s.StartAndWaitForCompletion(ctx)
@@ -128,6 +150,12 @@ func TestServer_StartAndWaitForCompletion_WithParameters(t *testing.T) {
 	mockToolsProviderFactory := &servermocks.MockToolsProviderFactory[struct{}]{}
 	defer mockToolsProviderFactory.AssertExpectations(t)
 
+	mockToolMiddlewareFactory := &servermocks.MockToolMiddlewareFactory[struct{}]{}
+	defer mockToolMiddlewareFactory.AssertExpectations(t)
+
+	mockLifecycleHooksFactory := &servermocks.MockLifecycleHooksFactory[struct{}]{}
+	defer mockLifecycleHooksFactory.AssertExpectations(t)
+
 	mockParametersFactory := &servermocks.MockParametersFactory{}
 	defer mockParametersFactory.AssertExpectations(t)
 
@@ -173,6 +201,9 @@ func TestServer_StartAndWaitForCompletion_WithParameters(t *testing.T) {
 		expectedInternalParameters,
 		nil,
 		nil,
+		nil,
+		nil,
+		0,
 	)
 
 	mockFeaturesFactory.EXPECT().
@@ -195,6 +226,16 @@ func TestServer_StartAndWaitForCompletion_WithParameters(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockToolMiddlewareFactory.EXPECT().
+		New(mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLifecycleHooksFactory.EXPECT().
+		New([]publictypes.LifecycleHook[struct{}](nil)).
+		Return(nil).
+		Once()
+
 	mockApplicationFactory.EXPECT().
 		New(expectedDefinition).
 		Return(mockApplication).
@@ -210,7 +251,7 @@ func TestServer_StartAndWaitForCompletion_WithParameters(t *testing.T) {
 		Return(nil).
 		Once()
 
-	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockApplicationFactory, mockErrorWriter)
+	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockToolMiddlewareFactory, mockLifecycleHooksFactory, mockApplicationFactory, mockErrorWriter)
 
 	// Act
 	exitCode := s.StartAndWaitForCompletion(ctx)
@@ -227,6 +268,12 @@ func TestServer_StartAndWaitForCompletion_WithProviders(t *testing.T) {
 	mockToolsProviderFactory := &servermocks.MockToolsProviderFactory[struct{}]{}
 	defer mockToolsProviderFactory.AssertExpectations(t)
 
+	mockToolMiddlewareFactory := &servermocks.MockToolMiddlewareFactory[struct{}]{}
+	defer mockToolMiddlewareFactory.AssertExpectations(t)
+
+	mockLifecycleHooksFactory := &servermocks.MockLifecycleHooksFactory[struct{}]{}
+	defer mockLifecycleHooksFactory.AssertExpectations(t)
+
 	mockParametersFactory := &servermocks.MockParametersFactory{}
 	defer mockParametersFactory.AssertExpectations(t)
 
@@ -262,11 +309,19 @@ func TestServer_StartAndWaitForCompletion_WithProviders(t *testing.T) {
 	expectedToolsProvider := definition.ToolsProvider(func(resources definition.ToolsProviderResources) []tools.Tool {
 		return expectedTools
 	})
+	expectedToolMiddleware := []mcp.Middleware{func(next mcp.MethodHandler) mcp.MethodHandler { return next }}
+	expectedToolMiddlewareProvider := definition.ToolMiddlewareProvider(func(resources definition.ToolsProviderResources) []mcp.Middleware {
+		return expectedToolMiddleware
+	})
+	expectedHooks := []definition.LifecycleHook{{Name: "database"}}
+	expectedHookTimeout := 5 * time.Second
 
 	serverDefinition := server.Definition[struct{}]{
-		Name:         expectedName,
-		Title:        expectedTitle,
-		Instructions: expectedInstructions,
+		Name:                 expectedName,
+		Title:                expectedTitle,
+		Instructions:         expectedInstructions,
+		LifecycleHooks:       []publictypes.LifecycleHook[struct{}]{{Name: "database"}},
+		LifecycleHookTimeout: expectedHookTimeout,
 	}
 
 	mockFeaturesFactory.EXPECT().
@@ -289,6 +344,16 @@ func TestServer_StartAndWaitForCompletion_WithProviders(t *testing.T) {
 		Return(expectedToolsProvider).
 		Once()
 
+	mockToolMiddlewareFactory.EXPECT().
+		New(mock.Anything).
+		Return(expectedToolMiddlewareProvider).
+		Once()
+
+	mockLifecycleHooksFactory.EXPECT().
+		New(serverDefinition.LifecycleHooks).
+		Return(expectedHooks).
+		Once()
+
 	mockApplicationFactory.EXPECT().
 		New(mock.MatchedBy(func(def adaptor.ApplicationDefinition) bool {
 			deps, depsErr := def.Dependencies(definition.DependenciesProviderResources{})
@@ -306,7 +371,11 @@ func TestServer_StartAndWaitForCompletion_WithProviders(t *testing.T) {
 				}
 			}
 
-			return true
+			if len(def.ToolMiddleware(definition.ToolsProviderResources{})) != len(expectedToolMiddleware) {
+				return false
+			}
+
+			return reflect.DeepEqual(def.LifecycleHooks(), expectedHooks) && def.LifecycleHookTimeout() == expectedHookTimeout
 		})).
 		Return(mockApplication).
 		Once()
@@ -321,7 +390,7 @@ func TestServer_StartAndWaitForCompletion_WithProviders(t *testing.T) {
 		Return(nil).
 		Once()
 
-	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockApplicationFactory, mockErrorWriter)
+	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockToolMiddlewareFactory, mockLifecycleHooksFactory, mockApplicationFactory, mockErrorWriter)
 
 	// Act
 	exitCode := s.StartAndWaitForCompletion(ctx)
@@ -338,6 +407,12 @@ func TestServer_StartAndWaitForCompletion_KnownError(t *testing.T) {
 	mockToolsProviderFactory := &servermocks.MockToolsProviderFactory[struct{}]{}
 	defer mockToolsProviderFactory.AssertExpectations(t)
 
+	mockToolMiddlewareFactory := &servermocks.MockToolMiddlewareFactory[struct{}]{}
+	defer mockToolMiddlewareFactory.AssertExpectations(t)
+
+	mockLifecycleHooksFactory := &servermocks.MockLifecycleHooksFactory[struct{}]{}
+	defer mockLifecycleHooksFactory.AssertExpectations(t)
+
 	mockParametersFactory := &servermocks.MockParametersFactory{}
 	defer mockParametersFactory.AssertExpectations(t)
 
@@ -387,6 +462,9 @@ func TestServer_StartAndWaitForCompletion_KnownError(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		0,
 	)
 
 	mockFeaturesFactory.EXPECT().
@@ -409,6 +487,16 @@ func TestServer_StartAndWaitForCompletion_KnownError(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockToolMiddlewareFactory.EXPECT().
+		New(mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLifecycleHooksFactory.EXPECT().
+		New([]publictypes.LifecycleHook[struct{}](nil)).
+		Return(nil).
+		Once()
+
 	mockApplicationFactory.EXPECT().
 		New(expectedDefinition).
 		Return(mockApplication).
@@ -439,7 +527,7 @@ func TestServer_StartAndWaitForCompletion_KnownError(t *testing.T) {
 		Return(len(expectedErrorMessage)+1, nil).
 		Once()
 
-	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockApplicationFactory, mockErrorWriter)
+	s := server.New(serverDefinition, mockFeaturesFactory, mockParametersFactory, mockDependenciesProviderFactory, mockToolsProviderFactory, mockToolMiddlewareFactory, mockLifecycleHooksFactory, mockApplicationFactory, mockErrorWriter)
 
 	// Act
 	exitCode := s.StartAndWaitForCompletion(ctx)
@@ -0,0 +1,203 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolmiddleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	pkgtools "github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const toolsCallMethod = "tools/call"
+
+type ToolMiddlewareProvider[Dependencies any] = publictypes.ToolMiddlewareProvider[Dependencies]
+
+type ToolCallRequestFactory = pkgtools.ToolCallRequestFactory
+
+type ResourcesFactory[Dependencies any] interface {
+	New(internal definition.ToolsProviderResources) publictypes.ToolsProviderResources[Dependencies]
+}
+
+type Factory[Dependencies any] struct {
+	resourcesFactory       ResourcesFactory[Dependencies]
+	toolCallRequestFactory ToolCallRequestFactory
+}
+
+func NewFactory[Dependencies any](
+	resourcesFactory ResourcesFactory[Dependencies],
+	toolCallRequestFactory ToolCallRequestFactory,
+) *Factory[Dependencies] {
+	return &Factory[Dependencies]{
+		resourcesFactory:       resourcesFactory,
+		toolCallRequestFactory: toolCallRequestFactory,
+	}
+}
+
+func (f *Factory[Dependencies]) New(provider ToolMiddlewareProvider[Dependencies]) definition.ToolMiddlewareProvider {
+	return func(internalResources definition.ToolsProviderResources) []mcp.Middleware {
+		if provider == nil {
+			return nil
+		}
+
+		resources := f.resourcesFactory.New(internalResources)
+		middlewares := provider(resources)
+
+		internalMiddlewares := []mcp.Middleware{}
+		for _, middleware := range middlewares {
+			if middleware == nil {
+				internalResources.Logger.Error("Tool middleware is nil, skipping")
+				continue
+			}
+
+			internalMiddlewares = append(internalMiddlewares, f.adapt(internalResources, middleware))
+		}
+
+		return internalMiddlewares
+	}
+}
+
+// adapt turns a tool middleware into an MCP middleware, that leaves every request other than tool calls as it is.
+func (f *Factory[Dependencies]) adapt(resources definition.ToolsProviderResources, middleware publictypes.ToolMiddleware) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			callToolRequest, ok := req.(*mcp.CallToolRequest)
+			if method != toolsCallMethod || !ok || callToolRequest.Params == nil {
+				return next(ctx, method, req)
+			}
+
+			logger, messagesErr := resources.LoggerFactory.NewMCPSessionLogger(callToolRequest.Session)
+			if messagesErr != nil {
+				return nil, messagesErr
+			}
+
+			logger = logger.With("tool-name", callToolRequest.Params.Name)
+
+			handler := middleware(func(ctx context.Context, call publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+				result, err := next(ctx, method, newRequest(callToolRequest, call))
+				if err != nil {
+					return nil, &protocolError{err}
+				}
+
+				callToolResult, ok := result.(*mcp.CallToolResult)
+				if !ok || callToolResult == nil {
+					return nil, &protocolError{fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM + "tool call returned no result")}
+				}
+
+				return &toolResult{result: callToolResult}, nil
+			})
+
+			call := &toolCall{
+				ToolCallRequest: f.toolCallRequestFactory.New(logger, resources.Config, resources.MessageCatalog),
+				name:            callToolRequest.Params.Name,
+				arguments:       callToolRequest.Params.Arguments,
+			}
+
+			result, err := handler(ctx, call)
+			if err != nil {
+				var protocolErr *protocolError
+				if errors.As(err, &protocolErr) {
+					return nil, protocolErr.error
+				}
+
+				logger.WithError(err).Warn("Tool middleware returned an error")
+				callToolResult := &mcp.CallToolResult{}
+				callToolResult.SetError(err)
+				return callToolResult, nil
+			}
+
+			if result == nil {
+				return nil, fmt.Errorf(basetool.UnexpectedErrorPrefixForLLM + "tool middleware returned no result")
+			}
+
+			return toCallToolResult(result), nil
+		}
+	}
+}
+
+// newRequest builds the request that the rest of the chain handles, with the name and arguments of the call,
+// which the middleware may have rewritten.
+func newRequest(original *mcp.CallToolRequest, call publictypes.ToolCall) *mcp.CallToolRequest {
+	params := *original.Params
+	params.Name = call.Name()
+	params.Arguments = call.Arguments()
+
+	return &mcp.CallToolRequest{
+		Session: original.Session,
+		Params:  &params,
+		Extra:   original.Extra,
+	}
+}
+
+// toCallToolResult converts the result that a middleware returns. A result of the rest of the chain is copied,
+// so that a middleware that caches it keeps it as it was, whatever the outer middleware do to the copy.
+func toCallToolResult(result publictypes.ToolResult) *mcp.CallToolResult {
+	if internalResult, ok := result.(*toolResult); ok {
+		callToolResult := *internalResult.result
+		return &callToolResult
+	}
+
+	callToolResult := &mcp.CallToolResult{
+		Content: []mcp.Content{},
+		IsError: result.IsError(),
+	}
+	for _, text := range result.TextContent() {
+		callToolResult.Content = append(callToolResult.Content, &mcp.TextContent{Text: text})
+	}
+
+	return callToolResult
+}
+
+type toolCall struct {
+	publictypes.ToolCallRequest
+	name      string
+	arguments json.RawMessage
+}
+
+func (c *toolCall) Name() string {
+	return c.name
+}
+
+func (c *toolCall) Arguments() json.RawMessage {
+	return c.arguments
+}
+
+func (c *toolCall) WithArguments(arguments json.RawMessage) publictypes.ToolCall {
+	return &toolCall{
+		ToolCallRequest: c.ToolCallRequest,
+		name:            c.name,
+		arguments:       arguments,
+	}
+}
+
+type toolResult struct {
+	result *mcp.CallToolResult
+}
+
+func (r *toolResult) IsError() bool {
+	return r.result.IsError
+}
+
+func (r *toolResult) TextContent() []string {
+	texts := []string{}
+	for _, content := range r.result.Content {
+		if textContent, ok := content.(*mcp.TextContent); ok {
+			texts = append(texts, textContent.Text)
+		}
+	}
+
+	return texts
+}
+
+// protocolError carries an error of the rest of the chain through a middleware, so that it is returned as it was.
+type protocolError struct {
+	error
+}
+
+func (e *protocolError) MWMarker() {}
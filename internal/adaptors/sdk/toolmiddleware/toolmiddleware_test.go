@@ -0,0 +1,473 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package toolmiddleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/toolmiddleware"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	configmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/config"
+	definitionmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/application/definition"
+	basetoolmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/mcp/tools/basetool"
+	publictypesmocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/publictypes"
+	toolmiddlewaremocks "github.com/matlab/matlab-mcp-server/mocks/adaptors/sdk/toolmiddleware"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type TestDependencies struct{}
+
+type greetInput struct {
+	Name string `json:"name"`
+}
+
+func TestNewFactory_HappyPath(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	// Act
+	factory := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory)
+
+	// Assert
+	require.NotNil(t, factory)
+}
+
+func TestFactory_New_NilProvider(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	// Act
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(nil)
+	result := internalProvider(definition.ToolsProviderResources{})
+
+	// Assert
+	require.Nil(t, result)
+}
+
+func TestFactory_New_Composition(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockResources := &publictypesmocks.MockToolsProviderResources[*TestDependencies]{}
+	defer mockResources.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	internalResources := newInternalResources(t)
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(mockResources).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mock.Anything, internalResources.Config, internalResources.MessageCatalog).
+		Return(mockCallRequest).
+		Twice()
+
+	var events []string
+	recording := func(name string) publictypes.ToolMiddleware {
+		return func(next publictypes.ToolHandler) publictypes.ToolHandler {
+			return func(ctx context.Context, call publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+				assert.Equal(t, "greet", call.Name())
+				events = append(events, name+" before")
+				result, err := next(ctx, call)
+				events = append(events, name+" after")
+				return result, err
+			}
+		}
+	}
+
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(resources publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		assert.Equal(t, mockResources, resources)
+		return []publictypes.ToolMiddleware{recording("first"), recording("second")}
+	})
+
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), func(_ string) {
+		events = append(events, "tool")
+	})
+
+	// Act
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "Hello Ada", textOf(t, result))
+	assert.Equal(t, []string{"first before", "second before", "tool", "second after", "first after"}, events)
+}
+
+func TestFactory_New_RewritesArguments(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	internalResources := newInternalResources(t)
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mock.Anything, internalResources.Config, internalResources.MessageCatalog).
+		Return(mockCallRequest).
+		Once()
+
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{
+			func(next publictypes.ToolHandler) publictypes.ToolHandler {
+				return func(ctx context.Context, call publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+					var input greetInput
+					require.NoError(t, json.Unmarshal(call.Arguments(), &input))
+
+					arguments, err := json.Marshal(greetInput{Name: "Dr. " + input.Name})
+					require.NoError(t, err)
+
+					return next(ctx, call.WithArguments(arguments))
+				}
+			},
+		}
+	})
+
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), nil)
+
+	// Act
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Hello Dr. Ada", textOf(t, result))
+}
+
+func TestFactory_New_ErrorIsReturnedAsToolError(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	internalResources := newInternalResources(t)
+
+	expectedError := &i18nError{message: "access denied"}
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mock.Anything, internalResources.Config, internalResources.MessageCatalog).
+		Return(mockCallRequest).
+		Once()
+
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{
+			func(_ publictypes.ToolHandler) publictypes.ToolHandler {
+				return func(_ context.Context, _ publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+					return nil, expectedError
+				}
+			},
+		}
+	})
+
+	toolCalled := false
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), func(_ string) {
+		toolCalled = true
+	})
+
+	// Act
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, expectedError.message, textOf(t, result))
+	assert.False(t, toolCalled, "The tool should not be called when a middleware returns an error")
+}
+
+func TestFactory_New_CachedResult(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	internalResources := newInternalResources(t)
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mock.Anything, internalResources.Config, internalResources.MessageCatalog).
+		Return(mockCallRequest).
+		Times(3)
+
+	cache := map[string]publictypes.ToolResult{}
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{
+			func(next publictypes.ToolHandler) publictypes.ToolHandler {
+				return func(ctx context.Context, call publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+					key := call.Name() + string(call.Arguments())
+					if result, ok := cache[key]; ok {
+						return result, nil
+					}
+
+					result, err := next(ctx, call)
+					if err == nil && !result.IsError() {
+						cache[key] = result
+					}
+					return result, err
+				}
+			},
+		}
+	})
+
+	toolCalls := 0
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), func(_ string) {
+		toolCalls++
+	})
+
+	// Act
+	first, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+	require.NoError(t, err)
+
+	second, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+	require.NoError(t, err)
+
+	third, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Grace"}})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 2, toolCalls, "The cached call should not reach the tool")
+	assert.Equal(t, "Hello Ada", textOf(t, first))
+	assert.Equal(t, "Hello Ada", textOf(t, second))
+	assert.Equal(t, "Hello Grace", textOf(t, third))
+}
+
+func TestFactory_New_CustomResult(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockCallRequest := &publictypesmocks.MockToolCallRequest{}
+	defer mockCallRequest.AssertExpectations(t)
+
+	mockResult := &publictypesmocks.MockToolResult{}
+	defer mockResult.AssertExpectations(t)
+
+	internalResources := newInternalResources(t)
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	mockToolCallRequestFactory.EXPECT().
+		New(mock.Anything, internalResources.Config, internalResources.MessageCatalog).
+		Return(mockCallRequest).
+		Once()
+
+	mockResult.EXPECT().
+		IsError().
+		Return(true).
+		Once()
+
+	mockResult.EXPECT().
+		TextContent().
+		Return([]string{"Tool is under maintenance"}).
+		Once()
+
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{
+			func(_ publictypes.ToolHandler) publictypes.ToolHandler {
+				return func(_ context.Context, _ publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+					return mockResult, nil
+				}
+			},
+		}
+	})
+
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), nil)
+
+	// Act
+	result, err := session.CallTool(t.Context(), &mcp.CallToolParams{Name: "greet", Arguments: map[string]any{"name": "Ada"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "Tool is under maintenance", textOf(t, result))
+}
+
+func TestFactory_New_OtherRequestsPassThrough(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	internalResources := definition.ToolsProviderResources{}
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	middlewareCalled := false
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{
+			func(next publictypes.ToolHandler) publictypes.ToolHandler {
+				return func(ctx context.Context, call publictypes.ToolCall) (publictypes.ToolResult, publictypes.Error) {
+					middlewareCalled = true
+					return next(ctx, call)
+				}
+			},
+		}
+	})
+
+	internalProvider := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)
+	session := newClientSession(t, internalProvider(internalResources), nil)
+
+	// Act
+	result, err := session.ListTools(t.Context(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, result.Tools, 1)
+	assert.False(t, middlewareCalled, "Tool middleware should only handle tool calls")
+}
+
+func TestFactory_New_NilMiddlewareIsSkipped(t *testing.T) {
+	// Arrange
+	mockResourcesFactory := &toolmiddlewaremocks.MockResourcesFactory[*TestDependencies]{}
+	defer mockResourcesFactory.AssertExpectations(t)
+
+	mockToolCallRequestFactory := &toolmiddlewaremocks.MockToolCallRequestFactory{}
+	defer mockToolCallRequestFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	internalResources := definition.ToolsProviderResources{
+		Logger: mockLogger,
+	}
+
+	mockResourcesFactory.EXPECT().
+		New(internalResources).
+		Return(nil).
+		Once()
+
+	provider := publictypes.ToolMiddlewareProvider[*TestDependencies](func(_ publictypes.ToolsProviderResources[*TestDependencies]) []publictypes.ToolMiddleware {
+		return []publictypes.ToolMiddleware{nil}
+	})
+
+	// Act
+	result := toolmiddleware.NewFactory(mockResourcesFactory, mockToolCallRequestFactory).New(provider)(internalResources)
+
+	// Assert
+	assert.Empty(t, result)
+	_, found := mockLogger.ErrorLogs()["Tool middleware is nil, skipping"]
+	assert.True(t, found, "Expected error log for the nil middleware")
+}
+
+// newInternalResources returns tool provider resources whose logger factory returns a session logger.
+func newInternalResources(t *testing.T) definition.ToolsProviderResources {
+	mockLoggerFactory := &basetoolmocks.MockLoggerFactory{}
+	t.Cleanup(func() { mockLoggerFactory.AssertExpectations(t) })
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(testutils.NewInspectableLogger(), nil)
+
+	return definition.ToolsProviderResources{
+		Logger:         testutils.NewInspectableLogger(),
+		Config:         &configmocks.MockGenericConfig{},
+		MessageCatalog: &definitionmocks.MockMessageCatalog{},
+		LoggerFactory:  mockLoggerFactory,
+	}
+}
+
+// newClientSession connects a client to a server that has a greet tool and the middleware.
+// onCall, if not nil, is called with the name that the tool receives.
+func newClientSession(t *testing.T, middleware []mcp.Middleware, onCall func(name string)) *mcp.ClientSession {
+	ctx := t.Context()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "greet"}, func(_ context.Context, _ *mcp.CallToolRequest, input greetInput) (*mcp.CallToolResult, any, error) {
+		if onCall != nil {
+			onCall(input.Name)
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "Hello " + input.Name}}}, nil, nil
+	})
+	server.AddReceivingMiddleware(middleware...)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
+
+func textOf(t *testing.T, result *mcp.CallToolResult) string {
+	require.Len(t, result.Content, 1)
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	return textContent.Text
+}
+
+type i18nError struct {
+	message string
+}
+
+func (e *i18nError) Error() string { return e.message }
+
+func (e *i18nError) MWMarker() {}
@@ -14,3 +14,10 @@ type Parameter interface {
 	GetRecordToLog() bool
 	GetPIISafe() bool
 }
+
+// ConfigFileSetting is a setting of a configuration file. The server ignores a setting that the file is not allowed to set.
+type ConfigFileSetting struct {
+	Key     string
+	Value   string
+	Ignored bool
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+type FigureFormat string
+
+const (
+	FigureFormatPNG  FigureFormat = "png"
+	FigureFormatJPEG FigureFormat = "jpeg"
+	FigureFormatSVG  FigureFormat = "svg"
+)
+
+// FigureOptions sets how MATLAB exports the figures of a captured evaluation.
+// The zero value keeps the PNG images that the Live Editor captures.
+type FigureOptions struct {
+	Format FigureFormat
+	// Width and Height are the size of the figures, in pixels. Zero keeps the size of the figure.
+	Width  int
+	Height int
+	// DPI is the resolution of PNG and JPEG images. Zero uses the resolution of the screen.
+	DPI int
+}
+
+// IsSet reports whether any option differs from how the Live Editor captures figures.
+func (o FigureOptions) IsSet() bool {
+	return o != FigureOptions{}
+}
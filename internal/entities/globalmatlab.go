@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package entities
 
@@ -6,4 +6,6 @@ import "context"
 
 type GlobalMATLAB interface {
 	Client(ctx context.Context, logger Logger) (MATLABSessionClient, error)
+	// SessionID returns the ID of the global MATLAB session. ok is false until MATLAB starts.
+	SessionID() (sessionID SessionID, ok bool)
 }
@@ -1,4 +1,4 @@
-// Copyright 2025 The MathWorks, Inc.
+// Copyright 2025-2026 The MathWorks, Inc.
 
 package entities
 
@@ -11,6 +11,10 @@ const (
 	LogLevelError LogLevel = "error"
 )
 
+// MATLABSessionIDLogKey is the key of the MATLAB session ID in log messages about a MATLAB session,
+// so that the messages about one session can be filtered, or written to a log file for the session.
+const MATLABSessionIDLogKey = "matlab-session-id"
+
 type Logger interface {
 	Debug(msg string)
 	Info(msg string)
@@ -0,0 +1,21 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+type MATLABInstallationReader interface {
+	Read(logger Logger, matlabRoot string) (MATLABInstallation, error)
+}
+
+type MATLABInstallation struct {
+	Version     string
+	Release     string
+	UpdateLevel int
+	Products    []MATLABProduct
+}
+
+type MATLABProduct struct {
+	Name     string
+	Version  string
+	Release  string
+	BaseCode string
+}
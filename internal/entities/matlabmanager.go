@@ -46,12 +46,30 @@ func (a AttachToExistingSession) interfacelock() {}
 type EvalRequest struct {
 	Code     string
 	HotLinks bool
+	// Figures only applies to captured evaluations.
+	Figures FigureOptions
 }
 
+// Prompt types that MATLAB reports after an evaluation.
+const (
+	// PromptTypeCommand is the >> prompt.
+	PromptTypeCommand = 0
+	// PromptTypeDebug is the K>> prompt, shown while MATLAB is paused in debug mode.
+	PromptTypeDebug = 1
+)
+
 type EvalResponse struct {
 	ConsoleOutput string
 	Images        [][]byte
 	PromptType    int
+	// Errors holds the messages of errors raised by the code.
+	// Only captured evaluations report them, other evaluations return an error instead.
+	Errors []string
+}
+
+// IsPausedInDebugger reports whether MATLAB showed the K>> prompt after the evaluation.
+func (r EvalResponse) IsPausedInDebugger() bool {
+	return r.PromptType == PromptTypeDebug
 }
 
 type FEvalRequest struct {
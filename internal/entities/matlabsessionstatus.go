@@ -0,0 +1,15 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+import "time"
+
+// MATLABSessionStatus describes the tool call that a MATLAB session is running and the calls that wait for it.
+type MATLABSessionStatus struct {
+	SessionID SessionID
+	Busy      bool
+	// ToolName and RunningFor describe the running call, when the session is busy.
+	ToolName    string
+	RunningFor  time.Duration
+	QueueLength int
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+type ReportFormat string
+
+const (
+	ReportFormatText ReportFormat = "text"
+	ReportFormatJSON ReportFormat = "json"
+	// ReportFormatSARIF is the Static Analysis Results Interchange Format 2.1.0, for code analysis results.
+	ReportFormatSARIF ReportFormat = "sarif"
+)
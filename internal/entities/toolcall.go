@@ -0,0 +1,85 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package entities
+
+import (
+	"context"
+	"sync"
+)
+
+type toolCallContextKey struct{}
+
+// ToolCall is the tool call that a context belongs to.
+// The call keeps the resources that its steps use, such as the queue of a MATLAB session, until it ends,
+// so that the steps run without calls from other clients in between.
+type ToolCall struct {
+	name            string
+	onQueuePosition func(position int)
+
+	lock  sync.Mutex
+	held  map[any]func()
+	ended bool
+}
+
+// WithToolCall returns a context for a tool call, and the function that ends the call.
+// While the call waits for a resource, onQueuePosition receives its position in the queue. It can be nil.
+func WithToolCall(ctx context.Context, name string, onQueuePosition func(position int)) (context.Context, func()) {
+	call := &ToolCall{
+		name:            name,
+		onQueuePosition: onQueuePosition,
+		held:            map[any]func(){},
+	}
+
+	return context.WithValue(ctx, toolCallContextKey{}, call), call.end
+}
+
+// ToolCallFromContext returns the tool call that a context belongs to. ok is false when the context does not belong to a tool call.
+func ToolCallFromContext(ctx context.Context) (*ToolCall, bool) {
+	call, ok := ctx.Value(toolCallContextKey{}).(*ToolCall)
+	return call, ok
+}
+
+func (c *ToolCall) Name() string {
+	return c.name
+}
+
+// OnQueuePosition returns the function that receives the position of the call in a queue. It can be nil.
+func (c *ToolCall) OnQueuePosition() func(position int) {
+	return c.onQueuePosition
+}
+
+// Hold keeps a resource until the call ends. The first time the call holds the resource, Hold calls acquire,
+// and later steps of the call reuse it. The returned function does nothing while the call keeps the resource.
+// A call that already ended does not keep the resource, so the returned function releases it.
+func (c *ToolCall) Hold(resource any, acquire func() (func(), error)) (func(), error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, held := c.held[resource]; held {
+		return func() {}, nil
+	}
+
+	release, err := acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ended {
+		return release, nil
+	}
+
+	c.held[resource] = release
+	return func() {}, nil
+}
+
+func (c *ToolCall) end() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, release := range c.held {
+		release()
+	}
+
+	c.held = map[any]func(){}
+	c.ended = true
+}
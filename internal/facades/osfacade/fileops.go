@@ -48,6 +48,7 @@ func (*OsFacade) Stat(name string) (FileInfo, error) {
 type File interface {
 	Write(b []byte) (int, error)
 	Read(b []byte) (int, error)
+	ReadAt(b []byte, off int64) (int, error)
 	Close() error
 	Name() string
 	Fd() uintptr
@@ -99,6 +100,11 @@ func (osw *OsFacade) ReadFile(filePath string) ([]byte, error) {
 	return os.ReadFile(filePath) //nolint:gosec // Intentional os.ReadFile usage in facade
 }
 
+// ReadDir wraps the os.ReadDir function to list the entries of a directory, sorted by name.
+func (osw *OsFacade) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
 // WriteFile wraps the os.WriteFile function to write content to a file.
 func (osw *OsFacade) WriteFile(name string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(name, data, perm)
@@ -128,3 +134,18 @@ func (osw *OsFacade) CreateTemp(dir string, pattern string) (File, error) {
 
 	return &FileWrapper{file}, nil
 }
+
+// OpenFile wraps the os.OpenFile function to open a file with the given flags.
+func (osw *OsFacade) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	file, err := os.OpenFile(name, flag, perm) //nolint:gosec // Intentional os.OpenFile usage in facade
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWrapper{file}, nil
+}
+
+// Rename wraps the os.Rename function to rename a file.
+func (osw *OsFacade) Rename(oldPath string, newPath string) error {
+	return os.Rename(oldPath, newPath)
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 The MathWorks, Inc.
+//go:build linux
+
+package unix
+
+import "golang.org/x/sys/unix"
+
+func (uf *UnixFacade) Prlimit(pid int, resource int, newLimit *Rlimit, old *Rlimit) error {
+	return unix.Prlimit(pid, resource, newLimit, old)
+}
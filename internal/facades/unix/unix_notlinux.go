@@ -0,0 +1,11 @@
+// Copyright 2026 The MathWorks, Inc.
+//go:build !linux
+
+package unix
+
+import "errors"
+
+// Prlimit is only available on Linux.
+func (uf *UnixFacade) Prlimit(_ int, _ int, _ *Rlimit, _ *Rlimit) error {
+	return errors.ErrUnsupported
+}
@@ -28,6 +28,54 @@ func New_AddonManagerErrors_InstallFailed_Error(
 	}
 }
 
+// StartupErrors_AnalysisFailed_Error defines an error corresponding to the "StartupErrors_AnalysisFailed" message catalog message
+type StartupErrors_AnalysisFailed_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_AnalysisFailed_Error satisfy the error interface.
+func (e *StartupErrors_AnalysisFailed_Error) Error() string {
+	return "StartupErrors_AnalysisFailed_Error"
+}
+
+func (*StartupErrors_AnalysisFailed_Error) marker() {}
+
+// New_StartupErrors_AnalysisFailed_Error makes a new StartupErrors_AnalysisFailed_Error error.
+func New_StartupErrors_AnalysisFailed_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_AnalysisFailed_Error {
+	return &StartupErrors_AnalysisFailed_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
+// StartupErrors_AnalysisFoundErrors_Error defines an error corresponding to the "StartupErrors_AnalysisFoundErrors" message catalog message
+type StartupErrors_AnalysisFoundErrors_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_AnalysisFoundErrors_Error satisfy the error interface.
+func (e *StartupErrors_AnalysisFoundErrors_Error) Error() string {
+	return "StartupErrors_AnalysisFoundErrors_Error"
+}
+
+func (*StartupErrors_AnalysisFoundErrors_Error) marker() {}
+
+// New_StartupErrors_AnalysisFoundErrors_Error makes a new StartupErrors_AnalysisFoundErrors_Error error.
+func New_StartupErrors_AnalysisFoundErrors_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_AnalysisFoundErrors_Error {
+	return &StartupErrors_AnalysisFoundErrors_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_ArgumentNotAllowedInSessionMode_Error defines an error corresponding to the "StartupErrors_ArgumentNotAllowedInSessionMode" message catalog message
 type StartupErrors_ArgumentNotAllowedInSessionMode_Error struct {
 	Attr0 string
@@ -130,6 +178,33 @@ func New_StartupErrors_BadValue_Error(
 	}
 }
 
+// StartupErrors_BadValueForConfigFileKey_Error defines an error corresponding to the "StartupErrors_BadValueForConfigFileKey" message catalog message
+type StartupErrors_BadValueForConfigFileKey_Error struct {
+	Attr0 string
+	Attr1 string
+	Attr2 string
+}
+
+// Error makes StartupErrors_BadValueForConfigFileKey_Error satisfy the error interface.
+func (e *StartupErrors_BadValueForConfigFileKey_Error) Error() string {
+	return "StartupErrors_BadValueForConfigFileKey_Error"
+}
+
+func (*StartupErrors_BadValueForConfigFileKey_Error) marker() {}
+
+// New_StartupErrors_BadValueForConfigFileKey_Error makes a new StartupErrors_BadValueForConfigFileKey_Error error.
+func New_StartupErrors_BadValueForConfigFileKey_Error(
+	attr0 string,
+	attr1 string,
+	attr2 string,
+) *StartupErrors_BadValueForConfigFileKey_Error {
+	return &StartupErrors_BadValueForConfigFileKey_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+		Attr2: attr2,
+	}
+}
+
 // StartupErrors_BadValueForEnvVar_Error defines an error corresponding to the "StartupErrors_BadValueForEnvVar" message catalog message
 type StartupErrors_BadValueForEnvVar_Error struct {
 	Attr0 string
@@ -205,6 +280,33 @@ func New_StartupErrors_CustomToolNameConflict_Error(
 	}
 }
 
+// StartupErrors_DoctorChecksFailed_Error defines an error corresponding to the "StartupErrors_DoctorChecksFailed" message catalog message
+type StartupErrors_DoctorChecksFailed_Error struct {
+	Attr0 string
+	Attr1 string
+	Attr2 string
+}
+
+// Error makes StartupErrors_DoctorChecksFailed_Error satisfy the error interface.
+func (e *StartupErrors_DoctorChecksFailed_Error) Error() string {
+	return "StartupErrors_DoctorChecksFailed_Error"
+}
+
+func (*StartupErrors_DoctorChecksFailed_Error) marker() {}
+
+// New_StartupErrors_DoctorChecksFailed_Error makes a new StartupErrors_DoctorChecksFailed_Error error.
+func New_StartupErrors_DoctorChecksFailed_Error(
+	attr0 string,
+	attr1 string,
+	attr2 string,
+) *StartupErrors_DoctorChecksFailed_Error {
+	return &StartupErrors_DoctorChecksFailed_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+		Attr2: attr2,
+	}
+}
+
 // StartupErrors_DuplicateParameter_Error defines an error corresponding to the "StartupErrors_DuplicateParameter" message catalog message
 type StartupErrors_DuplicateParameter_Error struct {
 	Attr0 string
@@ -356,6 +458,27 @@ func New_StartupErrors_FailedToGetExecutablePath_Error() *StartupErrors_FailedTo
 	return &StartupErrors_FailedToGetExecutablePath_Error{}
 }
 
+// StartupErrors_FailedToOpenAuditLog_Error defines an error corresponding to the "StartupErrors_FailedToOpenAuditLog" message catalog message
+type StartupErrors_FailedToOpenAuditLog_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_FailedToOpenAuditLog_Error satisfy the error interface.
+func (e *StartupErrors_FailedToOpenAuditLog_Error) Error() string {
+	return "StartupErrors_FailedToOpenAuditLog_Error"
+}
+
+func (*StartupErrors_FailedToOpenAuditLog_Error) marker() {}
+
+// New_StartupErrors_FailedToOpenAuditLog_Error makes a new StartupErrors_FailedToOpenAuditLog_Error error.
+func New_StartupErrors_FailedToOpenAuditLog_Error(
+	attr0 string,
+) *StartupErrors_FailedToOpenAuditLog_Error {
+	return &StartupErrors_FailedToOpenAuditLog_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_FailedToParseExtensionFile_Error defines an error corresponding to the "StartupErrors_FailedToParseExtensionFile" message catalog message
 type StartupErrors_FailedToParseExtensionFile_Error struct {
 	Attr0 string
@@ -377,6 +500,48 @@ func New_StartupErrors_FailedToParseExtensionFile_Error(
 	}
 }
 
+// StartupErrors_FailedToReadCodePolicyFile_Error defines an error corresponding to the "StartupErrors_FailedToReadCodePolicyFile" message catalog message
+type StartupErrors_FailedToReadCodePolicyFile_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_FailedToReadCodePolicyFile_Error satisfy the error interface.
+func (e *StartupErrors_FailedToReadCodePolicyFile_Error) Error() string {
+	return "StartupErrors_FailedToReadCodePolicyFile_Error"
+}
+
+func (*StartupErrors_FailedToReadCodePolicyFile_Error) marker() {}
+
+// New_StartupErrors_FailedToReadCodePolicyFile_Error makes a new StartupErrors_FailedToReadCodePolicyFile_Error error.
+func New_StartupErrors_FailedToReadCodePolicyFile_Error(
+	attr0 string,
+) *StartupErrors_FailedToReadCodePolicyFile_Error {
+	return &StartupErrors_FailedToReadCodePolicyFile_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_FailedToReadConfigFile_Error defines an error corresponding to the "StartupErrors_FailedToReadConfigFile" message catalog message
+type StartupErrors_FailedToReadConfigFile_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_FailedToReadConfigFile_Error satisfy the error interface.
+func (e *StartupErrors_FailedToReadConfigFile_Error) Error() string {
+	return "StartupErrors_FailedToReadConfigFile_Error"
+}
+
+func (*StartupErrors_FailedToReadConfigFile_Error) marker() {}
+
+// New_StartupErrors_FailedToReadConfigFile_Error makes a new StartupErrors_FailedToReadConfigFile_Error error.
+func New_StartupErrors_FailedToReadConfigFile_Error(
+	attr0 string,
+) *StartupErrors_FailedToReadConfigFile_Error {
+	return &StartupErrors_FailedToReadConfigFile_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_FailedToReadExtensionFile_Error defines an error corresponding to the "StartupErrors_FailedToReadExtensionFile" message catalog message
 type StartupErrors_FailedToReadExtensionFile_Error struct {
 	Attr0 string
@@ -430,6 +595,96 @@ func New_StartupErrors_GenericInitializeFailure_Error() *StartupErrors_GenericIn
 	return &StartupErrors_GenericInitializeFailure_Error{}
 }
 
+// StartupErrors_InvalidAnalyzeFormat_Error defines an error corresponding to the "StartupErrors_InvalidAnalyzeFormat" message catalog message
+type StartupErrors_InvalidAnalyzeFormat_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidAnalyzeFormat_Error satisfy the error interface.
+func (e *StartupErrors_InvalidAnalyzeFormat_Error) Error() string {
+	return "StartupErrors_InvalidAnalyzeFormat_Error"
+}
+
+func (*StartupErrors_InvalidAnalyzeFormat_Error) marker() {}
+
+// New_StartupErrors_InvalidAnalyzeFormat_Error makes a new StartupErrors_InvalidAnalyzeFormat_Error error.
+func New_StartupErrors_InvalidAnalyzeFormat_Error(
+	attr0 string,
+) *StartupErrors_InvalidAnalyzeFormat_Error {
+	return &StartupErrors_InvalidAnalyzeFormat_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidAuditLogMaxSize_Error defines an error corresponding to the "StartupErrors_InvalidAuditLogMaxSize" message catalog message
+type StartupErrors_InvalidAuditLogMaxSize_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidAuditLogMaxSize_Error satisfy the error interface.
+func (e *StartupErrors_InvalidAuditLogMaxSize_Error) Error() string {
+	return "StartupErrors_InvalidAuditLogMaxSize_Error"
+}
+
+func (*StartupErrors_InvalidAuditLogMaxSize_Error) marker() {}
+
+// New_StartupErrors_InvalidAuditLogMaxSize_Error makes a new StartupErrors_InvalidAuditLogMaxSize_Error error.
+func New_StartupErrors_InvalidAuditLogMaxSize_Error(
+	attr0 string,
+) *StartupErrors_InvalidAuditLogMaxSize_Error {
+	return &StartupErrors_InvalidAuditLogMaxSize_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidCodePolicyFile_Error defines an error corresponding to the "StartupErrors_InvalidCodePolicyFile" message catalog message
+type StartupErrors_InvalidCodePolicyFile_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_InvalidCodePolicyFile_Error satisfy the error interface.
+func (e *StartupErrors_InvalidCodePolicyFile_Error) Error() string {
+	return "StartupErrors_InvalidCodePolicyFile_Error"
+}
+
+func (*StartupErrors_InvalidCodePolicyFile_Error) marker() {}
+
+// New_StartupErrors_InvalidCodePolicyFile_Error makes a new StartupErrors_InvalidCodePolicyFile_Error error.
+func New_StartupErrors_InvalidCodePolicyFile_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_InvalidCodePolicyFile_Error {
+	return &StartupErrors_InvalidCodePolicyFile_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
+// StartupErrors_InvalidConfigFile_Error defines an error corresponding to the "StartupErrors_InvalidConfigFile" message catalog message
+type StartupErrors_InvalidConfigFile_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_InvalidConfigFile_Error satisfy the error interface.
+func (e *StartupErrors_InvalidConfigFile_Error) Error() string {
+	return "StartupErrors_InvalidConfigFile_Error"
+}
+
+func (*StartupErrors_InvalidConfigFile_Error) marker() {}
+
+// New_StartupErrors_InvalidConfigFile_Error makes a new StartupErrors_InvalidConfigFile_Error error.
+func New_StartupErrors_InvalidConfigFile_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_InvalidConfigFile_Error {
+	return &StartupErrors_InvalidConfigFile_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_InvalidDisplayMode_Error defines an error corresponding to the "StartupErrors_InvalidDisplayMode" message catalog message
 type StartupErrors_InvalidDisplayMode_Error struct {
 	Attr0 string
@@ -451,6 +706,27 @@ func New_StartupErrors_InvalidDisplayMode_Error(
 	}
 }
 
+// StartupErrors_InvalidDoctorFormat_Error defines an error corresponding to the "StartupErrors_InvalidDoctorFormat" message catalog message
+type StartupErrors_InvalidDoctorFormat_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidDoctorFormat_Error satisfy the error interface.
+func (e *StartupErrors_InvalidDoctorFormat_Error) Error() string {
+	return "StartupErrors_InvalidDoctorFormat_Error"
+}
+
+func (*StartupErrors_InvalidDoctorFormat_Error) marker() {}
+
+// New_StartupErrors_InvalidDoctorFormat_Error makes a new StartupErrors_InvalidDoctorFormat_Error error.
+func New_StartupErrors_InvalidDoctorFormat_Error(
+	attr0 string,
+) *StartupErrors_InvalidDoctorFormat_Error {
+	return &StartupErrors_InvalidDoctorFormat_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_InvalidLogLevel_Error defines an error corresponding to the "StartupErrors_InvalidLogLevel" message catalog message
 type StartupErrors_InvalidLogLevel_Error struct {
 	Attr0 string
@@ -472,6 +748,195 @@ func New_StartupErrors_InvalidLogLevel_Error(
 	}
 }
 
+// StartupErrors_InvalidLogMaxAge_Error defines an error corresponding to the "StartupErrors_InvalidLogMaxAge" message catalog message
+type StartupErrors_InvalidLogMaxAge_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidLogMaxAge_Error satisfy the error interface.
+func (e *StartupErrors_InvalidLogMaxAge_Error) Error() string {
+	return "StartupErrors_InvalidLogMaxAge_Error"
+}
+
+func (*StartupErrors_InvalidLogMaxAge_Error) marker() {}
+
+// New_StartupErrors_InvalidLogMaxAge_Error makes a new StartupErrors_InvalidLogMaxAge_Error error.
+func New_StartupErrors_InvalidLogMaxAge_Error(
+	attr0 string,
+) *StartupErrors_InvalidLogMaxAge_Error {
+	return &StartupErrors_InvalidLogMaxAge_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidLogMaxFiles_Error defines an error corresponding to the "StartupErrors_InvalidLogMaxFiles" message catalog message
+type StartupErrors_InvalidLogMaxFiles_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidLogMaxFiles_Error satisfy the error interface.
+func (e *StartupErrors_InvalidLogMaxFiles_Error) Error() string {
+	return "StartupErrors_InvalidLogMaxFiles_Error"
+}
+
+func (*StartupErrors_InvalidLogMaxFiles_Error) marker() {}
+
+// New_StartupErrors_InvalidLogMaxFiles_Error makes a new StartupErrors_InvalidLogMaxFiles_Error error.
+func New_StartupErrors_InvalidLogMaxFiles_Error(
+	attr0 string,
+) *StartupErrors_InvalidLogMaxFiles_Error {
+	return &StartupErrors_InvalidLogMaxFiles_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidLogMaxSize_Error defines an error corresponding to the "StartupErrors_InvalidLogMaxSize" message catalog message
+type StartupErrors_InvalidLogMaxSize_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidLogMaxSize_Error satisfy the error interface.
+func (e *StartupErrors_InvalidLogMaxSize_Error) Error() string {
+	return "StartupErrors_InvalidLogMaxSize_Error"
+}
+
+func (*StartupErrors_InvalidLogMaxSize_Error) marker() {}
+
+// New_StartupErrors_InvalidLogMaxSize_Error makes a new StartupErrors_InvalidLogMaxSize_Error error.
+func New_StartupErrors_InvalidLogMaxSize_Error(
+	attr0 string,
+) *StartupErrors_InvalidLogMaxSize_Error {
+	return &StartupErrors_InvalidLogMaxSize_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABEnvironmentVariable_Error defines an error corresponding to the "StartupErrors_InvalidMATLABEnvironmentVariable" message catalog message
+type StartupErrors_InvalidMATLABEnvironmentVariable_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABEnvironmentVariable_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABEnvironmentVariable_Error) Error() string {
+	return "StartupErrors_InvalidMATLABEnvironmentVariable_Error"
+}
+
+func (*StartupErrors_InvalidMATLABEnvironmentVariable_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABEnvironmentVariable_Error makes a new StartupErrors_InvalidMATLABEnvironmentVariable_Error error.
+func New_StartupErrors_InvalidMATLABEnvironmentVariable_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABEnvironmentVariable_Error {
+	return &StartupErrors_InvalidMATLABEnvironmentVariable_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABIdleTimeout_Error defines an error corresponding to the "StartupErrors_InvalidMATLABIdleTimeout" message catalog message
+type StartupErrors_InvalidMATLABIdleTimeout_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABIdleTimeout_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABIdleTimeout_Error) Error() string {
+	return "StartupErrors_InvalidMATLABIdleTimeout_Error"
+}
+
+func (*StartupErrors_InvalidMATLABIdleTimeout_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABIdleTimeout_Error makes a new StartupErrors_InvalidMATLABIdleTimeout_Error error.
+func New_StartupErrors_InvalidMATLABIdleTimeout_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABIdleTimeout_Error {
+	return &StartupErrors_InvalidMATLABIdleTimeout_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABMemoryLimit_Error defines an error corresponding to the "StartupErrors_InvalidMATLABMemoryLimit" message catalog message
+type StartupErrors_InvalidMATLABMemoryLimit_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABMemoryLimit_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABMemoryLimit_Error) Error() string {
+	return "StartupErrors_InvalidMATLABMemoryLimit_Error"
+}
+
+func (*StartupErrors_InvalidMATLABMemoryLimit_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABMemoryLimit_Error makes a new StartupErrors_InvalidMATLABMemoryLimit_Error error.
+func New_StartupErrors_InvalidMATLABMemoryLimit_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABMemoryLimit_Error {
+	return &StartupErrors_InvalidMATLABMemoryLimit_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABQueueMaxDepth_Error defines an error corresponding to the "StartupErrors_InvalidMATLABQueueMaxDepth" message catalog message
+type StartupErrors_InvalidMATLABQueueMaxDepth_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABQueueMaxDepth_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABQueueMaxDepth_Error) Error() string {
+	return "StartupErrors_InvalidMATLABQueueMaxDepth_Error"
+}
+
+func (*StartupErrors_InvalidMATLABQueueMaxDepth_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABQueueMaxDepth_Error makes a new StartupErrors_InvalidMATLABQueueMaxDepth_Error error.
+func New_StartupErrors_InvalidMATLABQueueMaxDepth_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABQueueMaxDepth_Error {
+	return &StartupErrors_InvalidMATLABQueueMaxDepth_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABQueueWaitTimeout_Error defines an error corresponding to the "StartupErrors_InvalidMATLABQueueWaitTimeout" message catalog message
+type StartupErrors_InvalidMATLABQueueWaitTimeout_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABQueueWaitTimeout_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABQueueWaitTimeout_Error) Error() string {
+	return "StartupErrors_InvalidMATLABQueueWaitTimeout_Error"
+}
+
+func (*StartupErrors_InvalidMATLABQueueWaitTimeout_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABQueueWaitTimeout_Error makes a new StartupErrors_InvalidMATLABQueueWaitTimeout_Error error.
+func New_StartupErrors_InvalidMATLABQueueWaitTimeout_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABQueueWaitTimeout_Error {
+	return &StartupErrors_InvalidMATLABQueueWaitTimeout_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABRelease_Error defines an error corresponding to the "StartupErrors_InvalidMATLABRelease" message catalog message
+type StartupErrors_InvalidMATLABRelease_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABRelease_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABRelease_Error) Error() string {
+	return "StartupErrors_InvalidMATLABRelease_Error"
+}
+
+func (*StartupErrors_InvalidMATLABRelease_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABRelease_Error makes a new StartupErrors_InvalidMATLABRelease_Error error.
+func New_StartupErrors_InvalidMATLABRelease_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABRelease_Error {
+	return &StartupErrors_InvalidMATLABRelease_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_InvalidMATLABSessionMode_Error defines an error corresponding to the "StartupErrors_InvalidMATLABSessionMode" message catalog message
 type StartupErrors_InvalidMATLABSessionMode_Error struct {
 	Attr0 string
@@ -493,6 +958,69 @@ func New_StartupErrors_InvalidMATLABSessionMode_Error(
 	}
 }
 
+// StartupErrors_InvalidMATLABSessionPoolSize_Error defines an error corresponding to the "StartupErrors_InvalidMATLABSessionPoolSize" message catalog message
+type StartupErrors_InvalidMATLABSessionPoolSize_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABSessionPoolSize_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABSessionPoolSize_Error) Error() string {
+	return "StartupErrors_InvalidMATLABSessionPoolSize_Error"
+}
+
+func (*StartupErrors_InvalidMATLABSessionPoolSize_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABSessionPoolSize_Error makes a new StartupErrors_InvalidMATLABSessionPoolSize_Error error.
+func New_StartupErrors_InvalidMATLABSessionPoolSize_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABSessionPoolSize_Error {
+	return &StartupErrors_InvalidMATLABSessionPoolSize_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMATLABStartupFlag_Error defines an error corresponding to the "StartupErrors_InvalidMATLABStartupFlag" message catalog message
+type StartupErrors_InvalidMATLABStartupFlag_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMATLABStartupFlag_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMATLABStartupFlag_Error) Error() string {
+	return "StartupErrors_InvalidMATLABStartupFlag_Error"
+}
+
+func (*StartupErrors_InvalidMATLABStartupFlag_Error) marker() {}
+
+// New_StartupErrors_InvalidMATLABStartupFlag_Error makes a new StartupErrors_InvalidMATLABStartupFlag_Error error.
+func New_StartupErrors_InvalidMATLABStartupFlag_Error(
+	attr0 string,
+) *StartupErrors_InvalidMATLABStartupFlag_Error {
+	return &StartupErrors_InvalidMATLABStartupFlag_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidMaxToolOutputBytes_Error defines an error corresponding to the "StartupErrors_InvalidMaxToolOutputBytes" message catalog message
+type StartupErrors_InvalidMaxToolOutputBytes_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidMaxToolOutputBytes_Error satisfy the error interface.
+func (e *StartupErrors_InvalidMaxToolOutputBytes_Error) Error() string {
+	return "StartupErrors_InvalidMaxToolOutputBytes_Error"
+}
+
+func (*StartupErrors_InvalidMaxToolOutputBytes_Error) marker() {}
+
+// New_StartupErrors_InvalidMaxToolOutputBytes_Error makes a new StartupErrors_InvalidMaxToolOutputBytes_Error error.
+func New_StartupErrors_InvalidMaxToolOutputBytes_Error(
+	attr0 string,
+) *StartupErrors_InvalidMaxToolOutputBytes_Error {
+	return &StartupErrors_InvalidMaxToolOutputBytes_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_InvalidParameterKey_Error defines an error corresponding to the "StartupErrors_InvalidParameterKey" message catalog message
 type StartupErrors_InvalidParameterKey_Error struct {
 	Attr0 string
@@ -607,6 +1135,117 @@ func New_StartupErrors_InvalidToolSignature_Error(
 	}
 }
 
+// StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error defines an error corresponding to the "StartupErrors_InvalidWorkspaceSnapshotMaxSize" message catalog message
+type StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error satisfy the error interface.
+func (e *StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error) Error() string {
+	return "StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error"
+}
+
+func (*StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error) marker() {}
+
+// New_StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error makes a new StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error error.
+func New_StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error(
+	attr0 string,
+) *StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error {
+	return &StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error defines an error corresponding to the "StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize" message catalog message
+type StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error satisfy the error interface.
+func (e *StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error) Error() string {
+	return "StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error"
+}
+
+func (*StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error) marker() {}
+
+// New_StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error makes a new StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error error.
+func New_StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error(
+	attr0 string,
+) *StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error {
+	return &StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_LifecycleHookFailed_Error defines an error corresponding to the "StartupErrors_LifecycleHookFailed" message catalog message
+type StartupErrors_LifecycleHookFailed_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_LifecycleHookFailed_Error satisfy the error interface.
+func (e *StartupErrors_LifecycleHookFailed_Error) Error() string {
+	return "StartupErrors_LifecycleHookFailed_Error"
+}
+
+func (*StartupErrors_LifecycleHookFailed_Error) marker() {}
+
+// New_StartupErrors_LifecycleHookFailed_Error makes a new StartupErrors_LifecycleHookFailed_Error error.
+func New_StartupErrors_LifecycleHookFailed_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_LifecycleHookFailed_Error {
+	return &StartupErrors_LifecycleHookFailed_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
+// StartupErrors_LifecycleHookTimedOut_Error defines an error corresponding to the "StartupErrors_LifecycleHookTimedOut" message catalog message
+type StartupErrors_LifecycleHookTimedOut_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_LifecycleHookTimedOut_Error satisfy the error interface.
+func (e *StartupErrors_LifecycleHookTimedOut_Error) Error() string {
+	return "StartupErrors_LifecycleHookTimedOut_Error"
+}
+
+func (*StartupErrors_LifecycleHookTimedOut_Error) marker() {}
+
+// New_StartupErrors_LifecycleHookTimedOut_Error makes a new StartupErrors_LifecycleHookTimedOut_Error error.
+func New_StartupErrors_LifecycleHookTimedOut_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_LifecycleHookTimedOut_Error {
+	return &StartupErrors_LifecycleHookTimedOut_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
+// StartupErrors_MATLABMemoryLimitTooSmall_Error defines an error corresponding to the "StartupErrors_MATLABMemoryLimitTooSmall" message catalog message
+type StartupErrors_MATLABMemoryLimitTooSmall_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_MATLABMemoryLimitTooSmall_Error satisfy the error interface.
+func (e *StartupErrors_MATLABMemoryLimitTooSmall_Error) Error() string {
+	return "StartupErrors_MATLABMemoryLimitTooSmall_Error"
+}
+
+func (*StartupErrors_MATLABMemoryLimitTooSmall_Error) marker() {}
+
+// New_StartupErrors_MATLABMemoryLimitTooSmall_Error makes a new StartupErrors_MATLABMemoryLimitTooSmall_Error error.
+func New_StartupErrors_MATLABMemoryLimitTooSmall_Error(
+	attr0 string,
+) *StartupErrors_MATLABMemoryLimitTooSmall_Error {
+	return &StartupErrors_MATLABMemoryLimitTooSmall_Error{
+		Attr0: attr0,
+	}
+}
+
 // StartupErrors_MissingToolSignature_Error defines an error corresponding to the "StartupErrors_MissingToolSignature" message catalog message
 type StartupErrors_MissingToolSignature_Error struct {
 	Attr0 string
@@ -652,6 +1291,30 @@ func New_StartupErrors_MissingValue_Error(
 	}
 }
 
+// StartupErrors_MutuallyExclusiveArguments_Error defines an error corresponding to the "StartupErrors_MutuallyExclusiveArguments" message catalog message
+type StartupErrors_MutuallyExclusiveArguments_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_MutuallyExclusiveArguments_Error satisfy the error interface.
+func (e *StartupErrors_MutuallyExclusiveArguments_Error) Error() string {
+	return "StartupErrors_MutuallyExclusiveArguments_Error"
+}
+
+func (*StartupErrors_MutuallyExclusiveArguments_Error) marker() {}
+
+// New_StartupErrors_MutuallyExclusiveArguments_Error makes a new StartupErrors_MutuallyExclusiveArguments_Error error.
+func New_StartupErrors_MutuallyExclusiveArguments_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_MutuallyExclusiveArguments_Error {
+	return &StartupErrors_MutuallyExclusiveArguments_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
 // StartupErrors_ParseFailed_Error defines an error corresponding to the "StartupErrors_ParseFailed" message catalog message
 type StartupErrors_ParseFailed_Error struct {
 	Attr0 string
@@ -692,6 +1355,67 @@ func New_StartupErrors_TelemetryInitializationFailed_Error() *StartupErrors_Tele
 	return &StartupErrors_TelemetryInitializationFailed_Error{}
 }
 
+// StartupErrors_UnknownConfigFileKey_Error defines an error corresponding to the "StartupErrors_UnknownConfigFileKey" message catalog message
+type StartupErrors_UnknownConfigFileKey_Error struct {
+	Attr0 string
+	Attr1 string
+}
+
+// Error makes StartupErrors_UnknownConfigFileKey_Error satisfy the error interface.
+func (e *StartupErrors_UnknownConfigFileKey_Error) Error() string {
+	return "StartupErrors_UnknownConfigFileKey_Error"
+}
+
+func (*StartupErrors_UnknownConfigFileKey_Error) marker() {}
+
+// New_StartupErrors_UnknownConfigFileKey_Error makes a new StartupErrors_UnknownConfigFileKey_Error error.
+func New_StartupErrors_UnknownConfigFileKey_Error(
+	attr0 string,
+	attr1 string,
+) *StartupErrors_UnknownConfigFileKey_Error {
+	return &StartupErrors_UnknownConfigFileKey_Error{
+		Attr0: attr0,
+		Attr1: attr1,
+	}
+}
+
+// StartupErrors_UnsupportedConfigFileFormat_Error defines an error corresponding to the "StartupErrors_UnsupportedConfigFileFormat" message catalog message
+type StartupErrors_UnsupportedConfigFileFormat_Error struct {
+	Attr0 string
+}
+
+// Error makes StartupErrors_UnsupportedConfigFileFormat_Error satisfy the error interface.
+func (e *StartupErrors_UnsupportedConfigFileFormat_Error) Error() string {
+	return "StartupErrors_UnsupportedConfigFileFormat_Error"
+}
+
+func (*StartupErrors_UnsupportedConfigFileFormat_Error) marker() {}
+
+// New_StartupErrors_UnsupportedConfigFileFormat_Error makes a new StartupErrors_UnsupportedConfigFileFormat_Error error.
+func New_StartupErrors_UnsupportedConfigFileFormat_Error(
+	attr0 string,
+) *StartupErrors_UnsupportedConfigFileFormat_Error {
+	return &StartupErrors_UnsupportedConfigFileFormat_Error{
+		Attr0: attr0,
+	}
+}
+
+// StartupErrors_UnsupportedMATLABMemoryLimit_Error defines an error corresponding to the "StartupErrors_UnsupportedMATLABMemoryLimit" message catalog message
+type StartupErrors_UnsupportedMATLABMemoryLimit_Error struct {
+}
+
+// Error makes StartupErrors_UnsupportedMATLABMemoryLimit_Error satisfy the error interface.
+func (e *StartupErrors_UnsupportedMATLABMemoryLimit_Error) Error() string {
+	return "StartupErrors_UnsupportedMATLABMemoryLimit_Error"
+}
+
+func (*StartupErrors_UnsupportedMATLABMemoryLimit_Error) marker() {}
+
+// New_StartupErrors_UnsupportedMATLABMemoryLimit_Error makes a new StartupErrors_UnsupportedMATLABMemoryLimit_Error error.
+func New_StartupErrors_UnsupportedMATLABMemoryLimit_Error() *StartupErrors_UnsupportedMATLABMemoryLimit_Error {
+	return &StartupErrors_UnsupportedMATLABMemoryLimit_Error{}
+}
+
 // StartupErrors_WriteError_Error defines an error corresponding to the "StartupErrors_WriteError" message catalog message
 type StartupErrors_WriteError_Error struct {
 	Attr0 string
@@ -18,6 +18,20 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_AnalysisFailed_Error:
+		msg := catalog.Get(StartupErrors_AnalysisFailed)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
+	case *StartupErrors_AnalysisFoundErrors_Error:
+		msg := catalog.Get(StartupErrors_AnalysisFoundErrors)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_ArgumentNotAllowedInSessionMode_Error:
 		msg := catalog.Get(StartupErrors_ArgumentNotAllowedInSessionMode)
 		return fmt.Sprintf(
@@ -48,6 +62,14 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_BadValueForConfigFileKey_Error:
+		msg := catalog.Get(StartupErrors_BadValueForConfigFileKey)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+			e.Attr2,
+		)
 	case *StartupErrors_BadValueForEnvVar_Error:
 		msg := catalog.Get(StartupErrors_BadValueForEnvVar)
 		return fmt.Sprintf(
@@ -70,6 +92,14 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_DoctorChecksFailed_Error:
+		msg := catalog.Get(StartupErrors_DoctorChecksFailed)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+			e.Attr2,
+		)
 	case *StartupErrors_DuplicateParameter_Error:
 		msg := catalog.Get(StartupErrors_DuplicateParameter)
 		return fmt.Sprintf(
@@ -112,12 +142,30 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 	case *StartupErrors_FailedToGetExecutablePath_Error:
 		msg := catalog.Get(StartupErrors_FailedToGetExecutablePath)
 		return msg
+	case *StartupErrors_FailedToOpenAuditLog_Error:
+		msg := catalog.Get(StartupErrors_FailedToOpenAuditLog)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_FailedToParseExtensionFile_Error:
 		msg := catalog.Get(StartupErrors_FailedToParseExtensionFile)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_FailedToReadCodePolicyFile_Error:
+		msg := catalog.Get(StartupErrors_FailedToReadCodePolicyFile)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_FailedToReadConfigFile_Error:
+		msg := catalog.Get(StartupErrors_FailedToReadConfigFile)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_FailedToReadExtensionFile_Error:
 		msg := catalog.Get(StartupErrors_FailedToReadExtensionFile)
 		return fmt.Sprintf(
@@ -130,24 +178,128 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 	case *StartupErrors_GenericInitializeFailure_Error:
 		msg := catalog.Get(StartupErrors_GenericInitializeFailure)
 		return msg
+	case *StartupErrors_InvalidAnalyzeFormat_Error:
+		msg := catalog.Get(StartupErrors_InvalidAnalyzeFormat)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidAuditLogMaxSize_Error:
+		msg := catalog.Get(StartupErrors_InvalidAuditLogMaxSize)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidCodePolicyFile_Error:
+		msg := catalog.Get(StartupErrors_InvalidCodePolicyFile)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
+	case *StartupErrors_InvalidConfigFile_Error:
+		msg := catalog.Get(StartupErrors_InvalidConfigFile)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_InvalidDisplayMode_Error:
 		msg := catalog.Get(StartupErrors_InvalidDisplayMode)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_InvalidDoctorFormat_Error:
+		msg := catalog.Get(StartupErrors_InvalidDoctorFormat)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_InvalidLogLevel_Error:
 		msg := catalog.Get(StartupErrors_InvalidLogLevel)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_InvalidLogMaxAge_Error:
+		msg := catalog.Get(StartupErrors_InvalidLogMaxAge)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidLogMaxFiles_Error:
+		msg := catalog.Get(StartupErrors_InvalidLogMaxFiles)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidLogMaxSize_Error:
+		msg := catalog.Get(StartupErrors_InvalidLogMaxSize)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABEnvironmentVariable_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABEnvironmentVariable)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABIdleTimeout_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABIdleTimeout)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABMemoryLimit_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABMemoryLimit)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABQueueMaxDepth_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABQueueMaxDepth)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABQueueWaitTimeout_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABQueueWaitTimeout)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABRelease_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABRelease)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_InvalidMATLABSessionMode_Error:
 		msg := catalog.Get(StartupErrors_InvalidMATLABSessionMode)
 		return fmt.Sprintf(
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_InvalidMATLABSessionPoolSize_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABSessionPoolSize)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMATLABStartupFlag_Error:
+		msg := catalog.Get(StartupErrors_InvalidMATLABStartupFlag)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidMaxToolOutputBytes_Error:
+		msg := catalog.Get(StartupErrors_InvalidMaxToolOutputBytes)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_InvalidParameterKey_Error:
 		msg := catalog.Get(StartupErrors_InvalidParameterKey)
 		return fmt.Sprintf(
@@ -181,6 +333,38 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			e.Attr0,
 			e.Attr1,
 		)
+	case *StartupErrors_InvalidWorkspaceSnapshotMaxSize_Error:
+		msg := catalog.Get(StartupErrors_InvalidWorkspaceSnapshotMaxSize)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize_Error:
+		msg := catalog.Get(StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_LifecycleHookFailed_Error:
+		msg := catalog.Get(StartupErrors_LifecycleHookFailed)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
+	case *StartupErrors_LifecycleHookTimedOut_Error:
+		msg := catalog.Get(StartupErrors_LifecycleHookTimedOut)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
+	case *StartupErrors_MATLABMemoryLimitTooSmall_Error:
+		msg := catalog.Get(StartupErrors_MATLABMemoryLimitTooSmall)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
 	case *StartupErrors_MissingToolSignature_Error:
 		msg := catalog.Get(StartupErrors_MissingToolSignature)
 		return fmt.Sprintf(
@@ -194,6 +378,13 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 			msg,
 			e.Attr0,
 		)
+	case *StartupErrors_MutuallyExclusiveArguments_Error:
+		msg := catalog.Get(StartupErrors_MutuallyExclusiveArguments)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
 	case *StartupErrors_ParseFailed_Error:
 		msg := catalog.Get(StartupErrors_ParseFailed)
 		return fmt.Sprintf(
@@ -204,6 +395,22 @@ func FromError(catalog LocaleSpecificCatalog, err Error) string {
 	case *StartupErrors_TelemetryInitializationFailed_Error:
 		msg := catalog.Get(StartupErrors_TelemetryInitializationFailed)
 		return msg
+	case *StartupErrors_UnknownConfigFileKey_Error:
+		msg := catalog.Get(StartupErrors_UnknownConfigFileKey)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+			e.Attr1,
+		)
+	case *StartupErrors_UnsupportedConfigFileFormat_Error:
+		msg := catalog.Get(StartupErrors_UnsupportedConfigFileFormat)
+		return fmt.Sprintf(
+			msg,
+			e.Attr0,
+		)
+	case *StartupErrors_UnsupportedMATLABMemoryLimit_Error:
+		msg := catalog.Get(StartupErrors_UnsupportedMATLABMemoryLimit)
+		return msg
 	case *StartupErrors_WriteError_Error:
 		msg := catalog.Get(StartupErrors_WriteError)
 		return fmt.Sprintf(
@@ -6,28 +6,62 @@ type messageKey string
 
 const (
 	AddonManagerErrors_InstallFailed                        messageKey = "AddonManagerErrors_InstallFailed"
+	CLIMessages_AnalyzeFolderDescription                    messageKey = "CLIMessages_AnalyzeFolderDescription"
+	CLIMessages_AnalyzeFormatDescription                    messageKey = "CLIMessages_AnalyzeFormatDescription"
+	CLIMessages_AuditLogFolderDescription                   messageKey = "CLIMessages_AuditLogFolderDescription"
+	CLIMessages_AuditLogHashChainDescription                messageKey = "CLIMessages_AuditLogHashChainDescription"
+	CLIMessages_AuditLogMaxSizeDescription                  messageKey = "CLIMessages_AuditLogMaxSizeDescription"
 	CLIMessages_BaseDirDescription                          messageKey = "CLIMessages_BaseDirDescription"
+	CLIMessages_CodePolicyFileDescription                   messageKey = "CLIMessages_CodePolicyFileDescription"
+	CLIMessages_ConfigFileDescription                       messageKey = "CLIMessages_ConfigFileDescription"
+	CLIMessages_ConfirmDestructiveToolsDescription          messageKey = "CLIMessages_ConfirmDestructiveToolsDescription"
 	CLIMessages_DisableTelemetryDescription                 messageKey = "CLIMessages_DisableTelemetryDescription"
 	CLIMessages_DisplayModeDescription                      messageKey = "CLIMessages_DisplayModeDescription"
+	CLIMessages_DoctorDescription                           messageKey = "CLIMessages_DoctorDescription"
+	CLIMessages_DoctorFormatDescription                     messageKey = "CLIMessages_DoctorFormatDescription"
 	CLIMessages_ExtensionFileDescription                    messageKey = "CLIMessages_ExtensionFileDescription"
 	CLIMessages_HelpDescription                             messageKey = "CLIMessages_HelpDescription"
 	CLIMessages_InitializeMATLABOnStartupDescription        messageKey = "CLIMessages_InitializeMATLABOnStartupDescription"
 	CLIMessages_InternalUseDescription                      messageKey = "CLIMessages_InternalUseDescription"
 	CLIMessages_LogLevelDescription                         messageKey = "CLIMessages_LogLevelDescription"
+	CLIMessages_LogMaxAgeDescription                        messageKey = "CLIMessages_LogMaxAgeDescription"
+	CLIMessages_LogMaxFilesDescription                      messageKey = "CLIMessages_LogMaxFilesDescription"
+	CLIMessages_LogMaxSizeDescription                       messageKey = "CLIMessages_LogMaxSizeDescription"
+	CLIMessages_LogPerSessionFilesDescription               messageKey = "CLIMessages_LogPerSessionFilesDescription"
+	CLIMessages_MATLABEnvironmentVariablesDescription       messageKey = "CLIMessages_MATLABEnvironmentVariablesDescription"
+	CLIMessages_MATLABIdleTimeoutDescription                messageKey = "CLIMessages_MATLABIdleTimeoutDescription"
+	CLIMessages_MATLABMemoryLimitDescription                messageKey = "CLIMessages_MATLABMemoryLimitDescription"
+	CLIMessages_MATLABPathDescription                       messageKey = "CLIMessages_MATLABPathDescription"
+	CLIMessages_MATLABQueueMaxDepthDescription              messageKey = "CLIMessages_MATLABQueueMaxDepthDescription"
+	CLIMessages_MATLABQueueWaitTimeoutDescription           messageKey = "CLIMessages_MATLABQueueWaitTimeoutDescription"
+	CLIMessages_MATLABSearchFoldersDescription              messageKey = "CLIMessages_MATLABSearchFoldersDescription"
 	CLIMessages_MATLABSessionModeDescription                messageKey = "CLIMessages_MATLABSessionModeDescription"
+	CLIMessages_MATLABSessionPoolSizeDescription            messageKey = "CLIMessages_MATLABSessionPoolSizeDescription"
+	CLIMessages_MATLABStartupFlagsDescription               messageKey = "CLIMessages_MATLABStartupFlagsDescription"
+	CLIMessages_MATLABStartupScriptDescription              messageKey = "CLIMessages_MATLABStartupScriptDescription"
+	CLIMessages_MaxToolOutputBytesDescription               messageKey = "CLIMessages_MaxToolOutputBytesDescription"
+	CLIMessages_OpenMATLABProjectDescription                messageKey = "CLIMessages_OpenMATLABProjectDescription"
 	CLIMessages_PreferredLocalMATLABRootDescription         messageKey = "CLIMessages_PreferredLocalMATLABRootDescription"
+	CLIMessages_PreferredMATLABReleaseDescription           messageKey = "CLIMessages_PreferredMATLABReleaseDescription"
 	CLIMessages_PreferredMATLABStartingDirectoryDescription messageKey = "CLIMessages_PreferredMATLABStartingDirectoryDescription"
+	CLIMessages_PrintConfigDescription                      messageKey = "CLIMessages_PrintConfigDescription"
 	CLIMessages_SetupMATLABDescription                      messageKey = "CLIMessages_SetupMATLABDescription"
 	CLIMessages_SuccessfullySetupMATLAB                     messageKey = "CLIMessages_SuccessfullySetupMATLAB"
 	CLIMessages_UseSingleMATLABSessionDescription           messageKey = "CLIMessages_UseSingleMATLABSessionDescription"
 	CLIMessages_VersionDescription                          messageKey = "CLIMessages_VersionDescription"
+	CLIMessages_WorkspaceSnapshotMaxSizeDescription         messageKey = "CLIMessages_WorkspaceSnapshotMaxSizeDescription"
+	CLIMessages_WorkspaceSnapshotMaxTotalSizeDescription    messageKey = "CLIMessages_WorkspaceSnapshotMaxTotalSizeDescription"
+	StartupErrors_AnalysisFailed                            messageKey = "StartupErrors_AnalysisFailed"
+	StartupErrors_AnalysisFoundErrors                       messageKey = "StartupErrors_AnalysisFoundErrors"
 	StartupErrors_ArgumentNotAllowedInSessionMode           messageKey = "StartupErrors_ArgumentNotAllowedInSessionMode"
 	StartupErrors_BadFlag                                   messageKey = "StartupErrors_BadFlag"
 	StartupErrors_BadSyntax                                 messageKey = "StartupErrors_BadSyntax"
 	StartupErrors_BadValue                                  messageKey = "StartupErrors_BadValue"
+	StartupErrors_BadValueForConfigFileKey                  messageKey = "StartupErrors_BadValueForConfigFileKey"
 	StartupErrors_BadValueForEnvVar                         messageKey = "StartupErrors_BadValueForEnvVar"
 	StartupErrors_CustomToolNameCollisionAcrossFiles        messageKey = "StartupErrors_CustomToolNameCollisionAcrossFiles"
 	StartupErrors_CustomToolNameConflict                    messageKey = "StartupErrors_CustomToolNameConflict"
+	StartupErrors_DoctorChecksFailed                        messageKey = "StartupErrors_DoctorChecksFailed"
 	StartupErrors_DuplicateParameter                        messageKey = "StartupErrors_DuplicateParameter"
 	StartupErrors_DuplicateToolName                         messageKey = "StartupErrors_DuplicateToolName"
 	StartupErrors_FailedToCreateDirectory                   messageKey = "StartupErrors_FailedToCreateDirectory"
@@ -35,22 +69,51 @@ const (
 	StartupErrors_FailedToCreateLogFile                     messageKey = "StartupErrors_FailedToCreateLogFile"
 	StartupErrors_FailedToCreateSubdirectory                messageKey = "StartupErrors_FailedToCreateSubdirectory"
 	StartupErrors_FailedToGetExecutablePath                 messageKey = "StartupErrors_FailedToGetExecutablePath"
+	StartupErrors_FailedToOpenAuditLog                      messageKey = "StartupErrors_FailedToOpenAuditLog"
 	StartupErrors_FailedToParseExtensionFile                messageKey = "StartupErrors_FailedToParseExtensionFile"
+	StartupErrors_FailedToReadCodePolicyFile                messageKey = "StartupErrors_FailedToReadCodePolicyFile"
+	StartupErrors_FailedToReadConfigFile                    messageKey = "StartupErrors_FailedToReadConfigFile"
 	StartupErrors_FailedToReadExtensionFile                 messageKey = "StartupErrors_FailedToReadExtensionFile"
 	StartupErrors_FailedToStartWatchdogProcess              messageKey = "StartupErrors_FailedToStartWatchdogProcess"
 	StartupErrors_GenericInitializeFailure                  messageKey = "StartupErrors_GenericInitializeFailure"
+	StartupErrors_InvalidAnalyzeFormat                      messageKey = "StartupErrors_InvalidAnalyzeFormat"
+	StartupErrors_InvalidAuditLogMaxSize                    messageKey = "StartupErrors_InvalidAuditLogMaxSize"
+	StartupErrors_InvalidCodePolicyFile                     messageKey = "StartupErrors_InvalidCodePolicyFile"
+	StartupErrors_InvalidConfigFile                         messageKey = "StartupErrors_InvalidConfigFile"
 	StartupErrors_InvalidDisplayMode                        messageKey = "StartupErrors_InvalidDisplayMode"
+	StartupErrors_InvalidDoctorFormat                       messageKey = "StartupErrors_InvalidDoctorFormat"
 	StartupErrors_InvalidLogLevel                           messageKey = "StartupErrors_InvalidLogLevel"
+	StartupErrors_InvalidLogMaxAge                          messageKey = "StartupErrors_InvalidLogMaxAge"
+	StartupErrors_InvalidLogMaxFiles                        messageKey = "StartupErrors_InvalidLogMaxFiles"
+	StartupErrors_InvalidLogMaxSize                         messageKey = "StartupErrors_InvalidLogMaxSize"
+	StartupErrors_InvalidMATLABEnvironmentVariable          messageKey = "StartupErrors_InvalidMATLABEnvironmentVariable"
+	StartupErrors_InvalidMATLABIdleTimeout                  messageKey = "StartupErrors_InvalidMATLABIdleTimeout"
+	StartupErrors_InvalidMATLABMemoryLimit                  messageKey = "StartupErrors_InvalidMATLABMemoryLimit"
+	StartupErrors_InvalidMATLABQueueMaxDepth                messageKey = "StartupErrors_InvalidMATLABQueueMaxDepth"
+	StartupErrors_InvalidMATLABQueueWaitTimeout             messageKey = "StartupErrors_InvalidMATLABQueueWaitTimeout"
+	StartupErrors_InvalidMATLABRelease                      messageKey = "StartupErrors_InvalidMATLABRelease"
 	StartupErrors_InvalidMATLABSessionMode                  messageKey = "StartupErrors_InvalidMATLABSessionMode"
+	StartupErrors_InvalidMATLABSessionPoolSize              messageKey = "StartupErrors_InvalidMATLABSessionPoolSize"
+	StartupErrors_InvalidMATLABStartupFlag                  messageKey = "StartupErrors_InvalidMATLABStartupFlag"
+	StartupErrors_InvalidMaxToolOutputBytes                 messageKey = "StartupErrors_InvalidMaxToolOutputBytes"
 	StartupErrors_InvalidParameterKey                       messageKey = "StartupErrors_InvalidParameterKey"
 	StartupErrors_InvalidParameterType                      messageKey = "StartupErrors_InvalidParameterType"
 	StartupErrors_InvalidToolDefinition                     messageKey = "StartupErrors_InvalidToolDefinition"
 	StartupErrors_InvalidToolInputSchema                    messageKey = "StartupErrors_InvalidToolInputSchema"
 	StartupErrors_InvalidToolSignature                      messageKey = "StartupErrors_InvalidToolSignature"
+	StartupErrors_InvalidWorkspaceSnapshotMaxSize           messageKey = "StartupErrors_InvalidWorkspaceSnapshotMaxSize"
+	StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize      messageKey = "StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize"
+	StartupErrors_LifecycleHookFailed                       messageKey = "StartupErrors_LifecycleHookFailed"
+	StartupErrors_LifecycleHookTimedOut                     messageKey = "StartupErrors_LifecycleHookTimedOut"
+	StartupErrors_MATLABMemoryLimitTooSmall                 messageKey = "StartupErrors_MATLABMemoryLimitTooSmall"
 	StartupErrors_MissingToolSignature                      messageKey = "StartupErrors_MissingToolSignature"
 	StartupErrors_MissingValue                              messageKey = "StartupErrors_MissingValue"
+	StartupErrors_MutuallyExclusiveArguments                messageKey = "StartupErrors_MutuallyExclusiveArguments"
 	StartupErrors_ParseFailed                               messageKey = "StartupErrors_ParseFailed"
 	StartupErrors_TelemetryInitializationFailed             messageKey = "StartupErrors_TelemetryInitializationFailed"
+	StartupErrors_UnknownConfigFileKey                      messageKey = "StartupErrors_UnknownConfigFileKey"
+	StartupErrors_UnsupportedConfigFileFormat               messageKey = "StartupErrors_UnsupportedConfigFileFormat"
+	StartupErrors_UnsupportedMATLABMemoryLimit              messageKey = "StartupErrors_UnsupportedMATLABMemoryLimit"
 	StartupErrors_WriteError                                messageKey = "StartupErrors_WriteError"
 )
 
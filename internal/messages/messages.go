@@ -8,28 +8,62 @@ type localeMap map[localeKey]messageMap
 
 var messages_en_US = messageMap{
 	AddonManagerErrors_InstallFailed:                        `Failed to install MATLAB Add-On. For details, see the server log in "%[1]s".`,
+	CLIMessages_AnalyzeFolderDescription:                    `Analyze the MATLAB code files in a folder or MATLAB project and its subfolders with the Code Analyzer, print the issues, and then exit. If the folder has a resources/codeAnalyzerConfiguration.json file, the analysis uses it.`,
+	CLIMessages_AnalyzeFormatDescription:                    `Format of the issues that --analyze prints. Valid values are 'text' (default), 'json', and 'sarif' for SARIF 2.1.0.`,
+	CLIMessages_AuditLogFolderDescription:                   `Folder for an audit log of tool calls. For each call, the server appends a JSON line to audit.jsonl in the folder, with the client, tool, MCP session, the exact MATLAB code or function calls that ran, the MATLAB process, the working folder, the duration, the outcome, and the output size. By default, the server does not write an audit log.`,
+	CLIMessages_AuditLogHashChainDescription:                `Add a SHA-256 hash chain to the audit log, so that changes to the log are detectable. Each entry records the hash of the previous entry and its own hash. By default, entries are not hashed.`,
+	CLIMessages_AuditLogMaxSizeDescription:                  `Size of audit.jsonl at which the server renames it with a timestamp and starts a new file, for example 100MB or 1GB. The server never deletes audit log files. By default, the size is 100MB.`,
 	CLIMessages_BaseDirDescription:                          `The folder where this MCP server stores log files. If not specified, the server uses the default temp folder of your operating system.`,
+	CLIMessages_CodePolicyFileDescription:                   `Path to a JSON code policy file. Before the server runs MATLAB code, it checks the code against the policy, which can deny functions and commands, restrict the folders that file functions can access, and limit the length of the code. By default, the server does not check code.`,
+	CLIMessages_ConfigFileDescription:                       `Path to a YAML (.yaml or .yml) or TOML (.toml) configuration file. Each key of the file is the name of a flag without the leading dashes, such as matlab-root, and each value is a string, number, Boolean, or list of strings. Environment variables and flags override the file. By default, the server does not read a configuration file.`,
+	CLIMessages_ConfirmDestructiveToolsDescription:          `Ask the user to confirm each call to a tool that can modify data, such as evaluate_matlab_code, before the server runs it. The server shows the code or function call in an MCP elicitation request, which the AI application presents to the user. The user can allow the tool for the rest of the session. If the AI application does not support elicitation, these tool calls fail. By default, the server does not ask for confirmation.`,
 	CLIMessages_DisableTelemetryDescription:                 `This MCP server can collect fully anonymized information about your usage of the server and send it to MathWorks. This data collection helps MathWorks improve products and is on by default. To opt out of data collection, set the argument --disable-telemetry to true.`,
 	CLIMessages_DisplayModeDescription:                      `Specify whether to show the MATLAB desktop. Use 'desktop' mode (default) to show the MATLAB desktop or 'nodesktop' mode to use MATLAB only from your AI application, without the MATLAB desktop. `,
+	CLIMessages_DoctorDescription:                           `Check the MATLAB installations, folders, toolbox, shared MATLAB session, extension files, and watchdog that the server uses, print a report of the checks, and then exit. The checks include a test start of MATLAB.`,
+	CLIMessages_DoctorFormatDescription:                     `Format of the report of --doctor. Valid values are 'text' (default) and 'json'.`,
 	CLIMessages_ExtensionFileDescription:                    `Use custom MCP tools by providing the path to a JSON extension file that defines the tools. Each tool maps to a MATLAB function. You can use the argument multiple times to specify multiple extension files. If you do not specify an extension file, the MCP server does not load any custom tools.`,
 	CLIMessages_HelpDescription:                             `Show this help text`,
 	CLIMessages_InitializeMATLABOnStartupDescription:        `To initialize MATLAB as soon as you start the server, set this argument to true. By default, MATLAB only starts when the first tool is called. `,
 	CLIMessages_InternalUseDescription:                      `INTERNAL USE ONLY`,
 	CLIMessages_LogLevelDescription:                         `The log levels of this MCP server. Valid values, in order of decreasing verbosity, are 'debug', 'info', 'warn', and 'error'.`,
+	CLIMessages_LogMaxAgeDescription:                        `Time after which the server renames a log file with a timestamp and starts a new file, for example 24h. By default, the server does not rotate log files by age.`,
+	CLIMessages_LogMaxFilesDescription:                      `Number of rotated files to keep for each log file. The server deletes older rotated files. Specify 0 to keep all files. By default, the server keeps 5 files.`,
+	CLIMessages_LogMaxSizeDescription:                       `Size of a log file at which the server renames it with a timestamp and starts a new file, for example 10MB or 1GB. By default, the server does not rotate log files by size.`,
+	CLIMessages_LogPerSessionFilesDescription:               `Also write the log messages about each MATLAB session, such as when it starts, stops, or exits unexpectedly, to a separate log file for the session. By default, the server writes all log messages to one file.`,
+	CLIMessages_MATLABEnvironmentVariablesDescription:       `Environment variable to set for MATLAB when the server starts it, in the form NAME=VALUE, for example a license server or proxy setting. You can use the argument multiple times to specify multiple variables.`,
+	CLIMessages_MATLABIdleTimeoutDescription:                `Time after which the server stops a MATLAB session that has not run any code, for example 30m or 2h. With a single MATLAB session, the server starts MATLAB again on the next tool call. By default, sessions run until the server shuts down.`,
+	CLIMessages_MATLABMemoryLimitDescription:                `Maximum address space of each MATLAB process that the server starts, for example 8GB or 16384MB. If MATLAB exceeds the limit, its memory allocations fail and MATLAB can exit. Supported on Linux only. By default, there is no limit.`,
+	CLIMessages_MATLABPathDescription:                       `Folder to add to the MATLAB path after MATLAB starts. You can use the argument multiple times to specify multiple folders.`,
+	CLIMessages_MATLABQueueMaxDepthDescription:              `Maximum number of tool calls that wait for a busy MATLAB session. The server runs the calls on a session one at a time, in the order they arrive, and rejects calls when the queue is full. Specify 0 to queue any number of calls. By default, the maximum is 16.`,
+	CLIMessages_MATLABQueueWaitTimeoutDescription:           `Time that a tool call waits for a busy MATLAB session before the server rejects it, for example 30s or 10m. Specify 0 to wait until the session is free. By default, calls wait up to 5m.`,
+	CLIMessages_MATLABSearchFoldersDescription:              `Additional folder in which to search for MATLAB installations. The folder can be a MATLAB root or contain MATLAB roots. You can use the argument multiple times to specify multiple folders. The server also searches the system PATH, the MATLAB_ROOT environment variable, and the standard installation folders.`,
 	CLIMessages_MATLABSessionModeDescription:                `Specify whether the MCP server connects to new or existing MATLAB sessions. In 'new' mode, the MCP server starts a new MATLAB session. In 'existing' mode, the server connects to an existing MATLAB session. You must configure the MATLAB session to use this mode, using the instructions in the README. In 'auto' mode (default), the server tries to connect to an existing MATLAB session as in 'existing' mode, and if unable to find one, it starts a new one.`,
-	CLIMessages_PreferredLocalMATLABRootDescription:         `Full path specifying which MATLAB to start. Do not include /bin in the path. By default, the server tries to find the first MATLAB on the system PATH.`,
+	CLIMessages_MATLABSessionPoolSizeDescription:            `Number of MATLAB sessions to start in advance when the server manages multiple MATLAB sessions, so that starting a session returns immediately. By default, the server does not start sessions in advance.`,
+	CLIMessages_MATLABStartupFlagsDescription:               `Additional command-line flag to pass to MATLAB when the server starts it, for example -singleCompThread or -logfile. Separate several flags in one value with spaces, and quote a flag that contains spaces. You can use the argument multiple times to specify multiple flags.`,
+	CLIMessages_MATLABStartupScriptDescription:              `Path to a MATLAB script, such as a project startup.m, that MATLAB runs after it starts and before the first tool call.`,
+	CLIMessages_MaxToolOutputBytesDescription:               `Maximum number of bytes of text that a tool call returns. The server shortens longer output to its start and end, and keeps the full output as a matlab-output:// resource that you can read in pages. Specify 0 to return all output. By default, the maximum is 100000 bytes.`,
+	CLIMessages_OpenMATLABProjectDescription:                `Open the MATLAB project (.prj) found in the roots of the AI application after MATLAB starts, so that its path, startup files and shortcuts are set up before the first tool call. The server does not open projects in MATLAB sessions that it attaches to. The server searches the roots and their subfolders, up to three levels deep, for a folder that contains a project. By default, the server does not open projects.`,
+	CLIMessages_PreferredLocalMATLABRootDescription:         `Full path specifying which MATLAB to start. Do not include /bin in the path. By default, the server tries to find the first MATLAB on the system PATH, then in the MATLAB_ROOT environment variable, any MATLAB search folders and the standard installation folders.`,
+	CLIMessages_PreferredMATLABReleaseDescription:           `MATLAB release to start when several are installed. Specify an exact release such as R2024b, "latest" for the newest installed release, or a minimum release such as ">=R2023b". By default, the server uses the first MATLAB found.`,
 	CLIMessages_PreferredMATLABStartingDirectoryDescription: `Specify the folder where MATLAB starts. If you do not provide the argument, MATLAB starts in these locations: Linux: /home/username, Windows: C:\Users\username\Documents, Mac: /Users/username/Documents.`,
+	CLIMessages_PrintConfigDescription:                      `Print the value of each setting and where the value comes from, such as a default, a configuration file, an environment variable, or a flag, and then exit.`,
 	CLIMessages_SetupMATLABDescription:                      `Set up a MATLAB installation for use with the MATLAB MCP Server.`,
 	CLIMessages_SuccessfullySetupMATLAB:                     `Successfully setup MATLAB.`,
 	CLIMessages_UseSingleMATLABSessionDescription:           `By default, this MCP server starts a single MATLAB session, and stops the session when the server shuts down. To allow the server to manage multiple MATLAB sessions, set this argument to false. `,
 	CLIMessages_VersionDescription:                          `Display the version of this MCP server.`,
+	CLIMessages_WorkspaceSnapshotMaxSizeDescription:         `Maximum size of each workspace snapshot, for example 512MB or 2GB. The server deletes a snapshot that is larger and reports an error. By default, the maximum is 1GB.`,
+	CLIMessages_WorkspaceSnapshotMaxTotalSizeDescription:    `Maximum total size of the workspace snapshots of a MATLAB session, for example 2GB or 10GB. Delete snapshots to make room for new ones. By default, the maximum is 4GB.`,
+	StartupErrors_AnalysisFailed:                            `Failed to analyze the MATLAB code in "%[1]s": %[2]s`,
+	StartupErrors_AnalysisFoundErrors:                       `The Code Analyzer found %[1]s errors in "%[2]s".`,
 	StartupErrors_ArgumentNotAllowedInSessionMode:           `Error with supplied arguments: option "%[1]s" is not compatible with MATLAB session mode set to "%[2]s".`,
 	StartupErrors_BadFlag:                                   `Error with supplied arguments: non-existent option %[1]s.%[2]s%[3]s`,
 	StartupErrors_BadSyntax:                                 `Error with supplied arguments: invalid syntax %[1]s.%[2]s%[3]s`,
 	StartupErrors_BadValue:                                  `Error with supplied arguments: invalid value %[1]s for option %[2]s.`,
+	StartupErrors_BadValueForConfigFileKey:                  `Error with configuration file "%[1]s": invalid value %[2]s for setting "%[3]s".`,
 	StartupErrors_BadValueForEnvVar:                         `Error with supplied environment variable: invalid value %[1]s for environment variable %[2]s.`,
 	StartupErrors_CustomToolNameCollisionAcrossFiles:        `Tool name "%[1]s" is defined in multiple extension files: "%[2]s", "%[3]s".`,
 	StartupErrors_CustomToolNameConflict:                    `Custom tool name "%[1]s" in extension file "%[2]s" conflicts with a built-in tool. Choose a different name.`,
+	StartupErrors_DoctorChecksFailed:                        `%[1]s of %[2]s checks failed. For details, see the report and the server log in "%[3]s".`,
 	StartupErrors_DuplicateParameter:                        `Found duplicate parameter "%[1]s": %[2]s with value "%[3]s" is already defined.`,
 	StartupErrors_DuplicateToolName:                         `Duplicate tool name "%[1]s" in "%[2]s". Choose a different name.`,
 	StartupErrors_FailedToCreateDirectory:                   `Failed to create directory "%[1]s".`,
@@ -37,22 +71,51 @@ var messages_en_US = messageMap{
 	StartupErrors_FailedToCreateLogFile:                     `Failed to create the log file "%[1]s".`,
 	StartupErrors_FailedToCreateSubdirectory:                `Failed to create subdirectory in "%[1]s".`,
 	StartupErrors_FailedToGetExecutablePath:                 `Failed to get executable path.`,
+	StartupErrors_FailedToOpenAuditLog:                      `Failed to open the audit log in folder "%[1]s". Check that the folder is writable.`,
 	StartupErrors_FailedToParseExtensionFile:                `Failed to parse extension file "%[1]s". File must contain valid JSON.`,
+	StartupErrors_FailedToReadCodePolicyFile:                `Failed to read code policy file "%[1]s". Check that the file exists and is readable.`,
+	StartupErrors_FailedToReadConfigFile:                    `Failed to read configuration file "%[1]s". Check that the file exists and is readable.`,
 	StartupErrors_FailedToReadExtensionFile:                 `Failed to read extension file "%[1]s". Check that file is valid.`,
 	StartupErrors_FailedToStartWatchdogProcess:              `Failed to start watchdog process.`,
 	StartupErrors_GenericInitializeFailure:                  `Failed to initialize MCP Server. For details, see the MCP server log in your AI application.`,
+	StartupErrors_InvalidAnalyzeFormat:                      `Error with supplied arguments: invalid analysis report format %[1]s.`,
+	StartupErrors_InvalidAuditLogMaxSize:                    `Error with supplied arguments: invalid audit log maximum size "%[1]s". Specify a size such as 100MB or 1GB.`,
+	StartupErrors_InvalidCodePolicyFile:                     `Invalid code policy file "%[1]s": %[2]s`,
+	StartupErrors_InvalidConfigFile:                         `Invalid configuration file "%[1]s": %[2]s`,
 	StartupErrors_InvalidDisplayMode:                        `Error with supplied arguments: invalid display mode %[1]s.`,
+	StartupErrors_InvalidDoctorFormat:                       `Error with supplied arguments: invalid doctor report format %[1]s.`,
 	StartupErrors_InvalidLogLevel:                           `Error with supplied arguments: invalid log level %[1]s.`,
+	StartupErrors_InvalidLogMaxAge:                          `Error with supplied arguments: invalid log maximum age %[1]s. Specify zero or a positive duration, for example 24h.`,
+	StartupErrors_InvalidLogMaxFiles:                        `Error with supplied arguments: invalid number of log files %[1]s. Specify zero or a positive number.`,
+	StartupErrors_InvalidLogMaxSize:                         `Error with supplied arguments: invalid log maximum size "%[1]s". Specify a size such as 10MB or 1GB.`,
+	StartupErrors_InvalidMATLABEnvironmentVariable:          `Error with supplied arguments: invalid MATLAB environment variable "%[1]s". Specify the variable in the form NAME=VALUE.`,
+	StartupErrors_InvalidMATLABIdleTimeout:                  `Error with supplied arguments: invalid MATLAB idle timeout %[1]s. Specify zero or a positive duration, for example 30m.`,
+	StartupErrors_InvalidMATLABMemoryLimit:                  `Error with supplied arguments: invalid MATLAB memory limit "%[1]s". Specify a size such as 8GB or 16384MB.`,
+	StartupErrors_InvalidMATLABQueueMaxDepth:                `Error with supplied arguments: invalid MATLAB queue maximum depth %[1]s. Specify zero or a positive number.`,
+	StartupErrors_InvalidMATLABQueueWaitTimeout:             `Error with supplied arguments: invalid MATLAB queue wait timeout %[1]s. Specify zero or a positive duration, for example 5m.`,
+	StartupErrors_InvalidMATLABRelease:                      `Error with supplied arguments: invalid MATLAB release %[1]s. Specify a release such as R2024b, "latest", or a minimum release such as ">=R2023b".`,
 	StartupErrors_InvalidMATLABSessionMode:                  `Error with supplied arguments: invalid MATLAB session mode %[1]s.`,
+	StartupErrors_InvalidMATLABSessionPoolSize:              `Error with supplied arguments: invalid MATLAB session pool size %[1]s. Specify zero or a positive number.`,
+	StartupErrors_InvalidMATLABStartupFlag:                  `Error with supplied arguments: invalid MATLAB startup flag "%[1]s". Close each quote in the value.`,
+	StartupErrors_InvalidMaxToolOutputBytes:                 `Error with supplied arguments: invalid maximum tool output size %[1]s. Specify zero or a positive number of bytes.`,
 	StartupErrors_InvalidParameterKey:                       `Invalid key "%[1]s" in configuration.`,
 	StartupErrors_InvalidParameterType:                      `Invalid type for key "%[1]s" in configuration, expected "%[2]s".`,
 	StartupErrors_InvalidToolDefinition:                     `Invalid custom tool definition in "%[1]s". Tool must match the tool schema specified by MCP.`,
 	StartupErrors_InvalidToolInputSchema:                    `Invalid input schema for tool "%[1]s" in "%[2]s".`,
 	StartupErrors_InvalidToolSignature:                      `Invalid signature for tool "%[1]s" in "%[2]s".`,
+	StartupErrors_InvalidWorkspaceSnapshotMaxSize:           `Error with supplied arguments: invalid workspace snapshot maximum size "%[1]s". Specify a size such as 512MB or 2GB.`,
+	StartupErrors_InvalidWorkspaceSnapshotMaxTotalSize:      `Error with supplied arguments: invalid workspace snapshot maximum total size "%[1]s". Specify a size such as 2GB or 10GB.`,
+	StartupErrors_LifecycleHookFailed:                       `Lifecycle hook "%[1]s" failed to start: %[2]s`,
+	StartupErrors_LifecycleHookTimedOut:                     `Lifecycle hook "%[1]s" did not start within %[2]s.`,
+	StartupErrors_MATLABMemoryLimitTooSmall:                 `Error with supplied arguments: the MATLAB memory limit "%[1]s" is too small. Specify at least 1MB.`,
 	StartupErrors_MissingToolSignature:                      `Missing signature for tool "%[1]s" in "%[2]s".`,
 	StartupErrors_MissingValue:                              `Error with supplied arguments: value required for option %[1]s.`,
+	StartupErrors_MutuallyExclusiveArguments:                `Error with supplied arguments: options "%[1]s" and "%[2]s" cannot be used together.`,
 	StartupErrors_ParseFailed:                               `Error with supplied arguments: parse failed.%[1]s%[2]s`,
 	StartupErrors_TelemetryInitializationFailed:             `Failed to initialize telemetry.`,
+	StartupErrors_UnknownConfigFileKey:                      `Unknown setting "%[1]s" in configuration file "%[2]s". Use the name of a flag without the leading dashes, as listed by --help.`,
+	StartupErrors_UnsupportedConfigFileFormat:               `Unsupported configuration file "%[1]s". Use a YAML file with the extension .yaml or .yml, or a TOML file with the extension .toml.`,
+	StartupErrors_UnsupportedMATLABMemoryLimit:              `Error with supplied arguments: the MATLAB memory limit is supported on Linux only. Remove the matlab-memory-limit argument.`,
 	StartupErrors_WriteError:                                `Failed to display %[1]s information. Error: %[2]s`,
 }
 
@@ -0,0 +1,286 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/codefiles"
+)
+
+// BatchSize is the number of files that each call to MATLAB analyzes, to keep requests to MATLAB small.
+const BatchSize = 50
+
+var (
+	ErrNoMATLABFiles = errors.New("no MATLAB code files found in folder")
+
+	identifierRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+)
+
+type Args struct {
+	// Path is a MATLAB code file, or a folder or MATLAB project root folder.
+	Path string
+}
+
+type ReturnArgs struct {
+	Path string
+	// Files are the analyzed MATLAB code files.
+	Files []string
+	// RequiredFiles are the other user files that the analyzed files depend on, sorted.
+	RequiredFiles []string
+	// RequiredProducts are the MathWorks products that the analyzed files depend on, sorted by name.
+	RequiredProducts []RequiredProduct
+	// MissingProducts are the names of the required products that are not installed in the session.
+	MissingProducts []string
+	// UnresolvedFunctions are the functions that the analyzed files call but that MATLAB cannot find, sorted by name.
+	UnresolvedFunctions []UnresolvedFunction
+}
+
+type RequiredProduct struct {
+	Name          string
+	Version       string
+	ProductNumber int
+	// Certain is false when MATLAB could not determine for sure that the code uses the product.
+	Certain bool
+	// Installed is true when the product is installed in the MATLAB session.
+	Installed bool
+}
+
+type UnresolvedFunction struct {
+	Name string
+	// Files are the analyzed files that call the function.
+	Files []string
+}
+
+// Requirements are the files and products that MATLAB code depends on.
+type Requirements struct {
+	Files    []string
+	Products []RequiredProduct
+}
+
+// FileReferences are the names that a MATLAB code file calls as functions and the names it defines.
+type FileReferences struct {
+	File  string
+	Calls []string
+	// Definitions are the functions, arguments and variables that the file defines.
+	Definitions []string
+}
+
+type PathValidator interface {
+	ValidateMATLABCodeFile(filePath string) (string, error)
+	ValidateFolderPath(folderPath string) (string, error)
+}
+
+type OSLayer interface {
+	codefiles.OSLayer
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type DependencyAnalyzer interface {
+	RequiredFilesAndProducts(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) (Requirements, error)
+	FunctionReferences(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) ([]FileReferences, error)
+	UndefinedFunctions(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, names []string) ([]string, error)
+}
+
+type ProductDetector interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (detectmatlabtoolboxes.ReturnArgs, error)
+}
+
+type Usecase struct {
+	pathValidator      PathValidator
+	osLayer            OSLayer
+	dependencyAnalyzer DependencyAnalyzer
+	productDetector    ProductDetector
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+	dependencyAnalyzer DependencyAnalyzer,
+	productDetector ProductDetector,
+) *Usecase {
+	return &Usecase{
+		pathValidator:      pathValidator,
+		osLayer:            osLayer,
+		dependencyAnalyzer: dependencyAnalyzer,
+		productDetector:    productDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering AnalyzeMATLABDependencies Usecase")
+	defer sessionLogger.Debug("Exiting AnalyzeMATLABDependencies Usecase")
+
+	path, files, err := u.filesToAnalyze(request.Path)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger.With("files", len(files)).Debug("Analyzing MATLAB dependencies")
+
+	requiredFiles := map[string]bool{}
+	requiredProducts := map[string]RequiredProduct{}
+	var references []FileReferences
+
+	for batch := range slices.Chunk(files, BatchSize) {
+		requirements, err := u.dependencyAnalyzer.RequiredFilesAndProducts(ctx, sessionLogger, client, batch)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		for _, file := range requirements.Files {
+			requiredFiles[file] = true
+		}
+		for _, product := range requirements.Products {
+			if existing, ok := requiredProducts[product.Name]; ok {
+				product.Certain = product.Certain || existing.Certain
+			}
+			requiredProducts[product.Name] = product
+		}
+
+		// Live scripts are not plain text, so only the function calls of .m files are checked
+		mFiles := slices.DeleteFunc(slices.Clone(batch), func(file string) bool { return filepath.Ext(file) != ".m" })
+		if len(mFiles) == 0 {
+			continue
+		}
+
+		batchReferences, err := u.dependencyAnalyzer.FunctionReferences(ctx, sessionLogger, client, mFiles)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+		references = append(references, batchReferences...)
+	}
+
+	for _, file := range files {
+		delete(requiredFiles, file)
+	}
+
+	unresolvedFunctions, err := u.unresolvedFunctions(ctx, sessionLogger, client, references, files, slices.Collect(maps.Keys(requiredFiles)))
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	result := ReturnArgs{
+		Path:                path,
+		Files:               files,
+		RequiredFiles:       slices.Sorted(maps.Keys(requiredFiles)),
+		RequiredProducts:    []RequiredProduct{},
+		MissingProducts:     []string{},
+		UnresolvedFunctions: unresolvedFunctions,
+	}
+
+	if len(requiredProducts) == 0 {
+		return result, nil
+	}
+
+	installedProducts, err := u.installedProducts(ctx, sessionLogger, client)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(requiredProducts)) {
+		product := requiredProducts[name]
+		product.Installed = installedProducts[name]
+		if !product.Installed {
+			result.MissingProducts = append(result.MissingProducts, name)
+		}
+		result.RequiredProducts = append(result.RequiredProducts, product)
+	}
+
+	return result, nil
+}
+
+// filesToAnalyze returns the validated path and the MATLAB code files to analyze, which are the file itself or the code files of the folder.
+func (u *Usecase) filesToAnalyze(path string) (string, []string, error) {
+	fileInfo, err := u.osLayer.Stat(filepath.Clean(path))
+	if err != nil || !fileInfo.IsDir() {
+		filePath, err := u.pathValidator.ValidateMATLABCodeFile(path)
+		if err != nil {
+			return "", nil, fmt.Errorf("path validation failed: %w", err)
+		}
+		return filePath, []string{filePath}, nil
+	}
+
+	folderPath, err := u.pathValidator.ValidateFolderPath(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	files, err := codefiles.Find(u.osLayer, folderPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(files) == 0 {
+		return "", nil, fmt.Errorf("%w: %s", ErrNoMATLABFiles, folderPath)
+	}
+
+	return folderPath, files, nil
+}
+
+// unresolvedFunctions returns the functions that the files call, that are neither defined in the calling file nor in the analyzed
+// or required files, and that MATLAB cannot find.
+func (u *Usecase) unresolvedFunctions(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, references []FileReferences, files []string, requiredFiles []string) ([]UnresolvedFunction, error) {
+	knownNames := map[string]bool{}
+	for _, file := range slices.Concat(files, requiredFiles) {
+		knownNames[strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))] = true
+	}
+
+	callers := map[string][]string{}
+	for _, fileReferences := range references {
+		definitions := map[string]bool{}
+		for _, definition := range fileReferences.Definitions {
+			definitions[definition] = true
+		}
+
+		for _, call := range fileReferences.Calls {
+			if !identifierRegexp.MatchString(call) || definitions[call] || knownNames[call] || slices.Contains(callers[call], fileReferences.File) {
+				continue
+			}
+			callers[call] = append(callers[call], fileReferences.File)
+		}
+	}
+
+	unresolvedFunctions := []UnresolvedFunction{}
+	if len(callers) == 0 {
+		return unresolvedFunctions, nil
+	}
+
+	undefinedFunctions, err := u.dependencyAnalyzer.UndefinedFunctions(ctx, sessionLogger, client, slices.Sorted(maps.Keys(callers)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range undefinedFunctions {
+		unresolvedFunctions = append(unresolvedFunctions, UnresolvedFunction{
+			Name:  name,
+			Files: callers[name],
+		})
+	}
+
+	return unresolvedFunctions, nil
+}
+
+func (u *Usecase) installedProducts(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (map[string]bool, error) {
+	toolboxes, err := u.productDetector.Execute(ctx, sessionLogger, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect the installed products: %w", err)
+	}
+
+	installedProducts := map[string]bool{}
+	for _, product := range toolboxes.Installation.Products {
+		installedProducts[product.Name] = true
+	}
+
+	return installedProducts, nil
+}
@@ -0,0 +1,579 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabdependencies_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	analyzematlabdependenciesmocks "github.com/matlab/matlab-mcp-server/mocks/usecases/analyzematlabdependencies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	// Act
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_Folder_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	folder := filepath.Join("work", "project")
+	mainFile := filepath.Join(folder, "main.m")
+	helperFile := filepath.Join(folder, "helper.m")
+	liveScript := filepath.Join(folder, "report.mlx")
+	files := []string{helperFile, mainFile, liveScript}
+	sharedFile := filepath.Join("work", "shared", "loadData.m")
+
+	requirements := analyzematlabdependencies.Requirements{
+		Files: []string{helperFile, mainFile, liveScript, sharedFile},
+		Products: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false},
+		},
+	}
+	references := []analyzematlabdependencies.FileReferences{
+		{File: helperFile, Calls: []string{"helper", "zeros", "x"}, Definitions: []string{"helper", "x"}},
+		{File: mainFile, Calls: []string{"helper", "loadData", "plot", "fancyFilter", "fancyFilter", "localStep", "", "pkg.fn"}, Definitions: []string{"main", "localStep"}},
+	}
+	installedProducts := detectmatlabtoolboxes.ReturnArgs{
+		Installation: entities.MATLABInstallation{
+			Products: []entities.MATLABProduct{{Name: "MATLAB", Version: "24.2", Release: "R2024b"}},
+		},
+	}
+
+	expectedResult := analyzematlabdependencies.ReturnArgs{
+		Path:          folder,
+		Files:         files,
+		RequiredFiles: []string{sharedFile},
+		RequiredProducts: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false, Installed: false},
+		},
+		MissingProducts: []string{"Signal Processing Toolbox"},
+		UnresolvedFunctions: []analyzematlabdependencies.UnresolvedFunction{
+			{Name: "fancyFilter", Files: []string{mainFile}},
+		},
+	}
+
+	mockOSLayer.EXPECT().
+		Stat(folder).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"helper.m", "main.m", "report.mlx", "data.mat"}), nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, files).
+		Return(requirements, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, []string{helperFile, mainFile}).
+		Return(references, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		UndefinedFunctions(ctx, mockLogger.AsMockArg(), mockClient, []string{"fancyFilter", "plot", "zeros"}).
+		Return([]string{"fancyFilter"}, nil).
+		Once()
+
+	mockProductDetector.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(installedProducts, nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: folder})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestUsecase_Execute_File_NoDependencies(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	file := filepath.Join("work", "script.m")
+
+	mockOSLayer.EXPECT().
+		Stat(file).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(file).
+		Return(file, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return(analyzematlabdependencies.Requirements{Files: []string{file}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return([]analyzematlabdependencies.FileReferences{{File: file, Definitions: []string{"x"}}}, nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: file})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, file, result.Path)
+	assert.Equal(t, []string{file}, result.Files)
+	assert.Empty(t, result.RequiredFiles)
+	assert.NotNil(t, result.RequiredProducts)
+	assert.Empty(t, result.RequiredProducts)
+	assert.NotNil(t, result.MissingProducts)
+	assert.NotNil(t, result.UnresolvedFunctions)
+	assert.Empty(t, result.UnresolvedFunctions)
+}
+
+func TestUsecase_Execute_AnalyzesFilesInBatches(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	folder := filepath.Join("work", "project")
+	fileCount := analyzematlabdependencies.BatchSize + 1
+
+	fileNames := make([]string, fileCount)
+	for i := range fileNames {
+		fileNames[i] = fmt.Sprintf("file%03d.m", i)
+	}
+	lastFile := filepath.Join(folder, fileNames[fileCount-1])
+	isFullBatch := mock.MatchedBy(func(files []string) bool { return len(files) == analyzematlabdependencies.BatchSize })
+
+	mockOSLayer.EXPECT().
+		Stat(folder).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, fileNames), nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, isFullBatch).
+		Return(analyzematlabdependencies.Requirements{Products: []analyzematlabdependencies.RequiredProduct{{Name: "MATLAB", Certain: false}}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, []string{lastFile}).
+		Return(analyzematlabdependencies.Requirements{Products: []analyzematlabdependencies.RequiredProduct{{Name: "MATLAB", Certain: true}}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, isFullBatch).
+		Return(nil, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, []string{lastFile}).
+		Return(nil, nil).
+		Once()
+
+	mockProductDetector.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(detectmatlabtoolboxes.ReturnArgs{Installation: entities.MATLABInstallation{Products: []entities.MATLABProduct{{Name: "MATLAB"}}}}, nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: folder})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Len(t, result.Files, fileCount)
+	assert.Equal(t, []analyzematlabdependencies.RequiredProduct{{Name: "MATLAB", Certain: true, Installed: true}}, result.RequiredProducts, "Products required by several batches should be merged")
+	assert.Empty(t, result.MissingProducts)
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	file := filepath.Join("work", "missing.m")
+	expectedError := fmt.Errorf("resource not found")
+
+	mockOSLayer.EXPECT().
+		Stat(file).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(file).
+		Return("", expectedError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabdependencies.Args{Path: file})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_NoMATLABFiles(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	folder := filepath.Join("work", "project")
+
+	mockOSLayer.EXPECT().
+		Stat(folder).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"data.csv"}), nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabdependencies.Args{Path: folder})
+
+	// Assert
+	require.ErrorIs(t, err, analyzematlabdependencies.ErrNoMATLABFiles)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_RequiredFilesAndProductsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	file := filepath.Join("work", "script.m")
+	expectedError := fmt.Errorf("analysis failed")
+
+	mockOSLayer.EXPECT().
+		Stat(file).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(file).
+		Return(file, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return(analyzematlabdependencies.Requirements{}, expectedError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: file})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UndefinedFunctionsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	file := filepath.Join("work", "script.m")
+	expectedError := fmt.Errorf("exist failed")
+
+	mockOSLayer.EXPECT().
+		Stat(file).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(file).
+		Return(file, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return(analyzematlabdependencies.Requirements{Files: []string{file}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return([]analyzematlabdependencies.FileReferences{{File: file, Calls: []string{"plot"}}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		UndefinedFunctions(ctx, mockLogger.AsMockArg(), mockClient, []string{"plot"}).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: file})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ProductDetectorError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabdependenciesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockDependencyAnalyzer := &analyzematlabdependenciesmocks.MockDependencyAnalyzer{}
+	defer mockDependencyAnalyzer.AssertExpectations(t)
+
+	mockProductDetector := &analyzematlabdependenciesmocks.MockProductDetector{}
+	defer mockProductDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	file := filepath.Join("work", "script.m")
+	expectedError := fmt.Errorf("ver failed")
+
+	mockOSLayer.EXPECT().
+		Stat(file).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(file).
+		Return(file, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		RequiredFilesAndProducts(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return(analyzematlabdependencies.Requirements{Products: []analyzematlabdependencies.RequiredProduct{{Name: "MATLAB"}}}, nil).
+		Once()
+
+	mockDependencyAnalyzer.EXPECT().
+		FunctionReferences(ctx, mockLogger.AsMockArg(), mockClient, []string{file}).
+		Return(nil, nil).
+		Once()
+
+	mockProductDetector.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(detectmatlabtoolboxes.ReturnArgs{}, expectedError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator, mockOSLayer, mockDependencyAnalyzer, mockProductDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: file})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+// dirEntries returns the entries of a folder with the given files, sorted by name as os.ReadDir returns them.
+func dirEntries(t *testing.T, files []string) []os.DirEntry {
+	t.Helper()
+
+	folderFS := fstest.MapFS{}
+	for _, file := range files {
+		folderFS[file] = &fstest.MapFile{}
+	}
+
+	entries, err := fs.ReadDir(folderFS, ".")
+	require.NoError(t, err)
+
+	return entries
+}
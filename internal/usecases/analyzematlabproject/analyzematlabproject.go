@@ -0,0 +1,149 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabproject
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/codefiles"
+)
+
+const (
+	// BatchSize is the number of files that each call to the Code Analyzer analyzes, to keep requests to MATLAB small.
+	BatchSize = 50
+
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+
+	configurationFolder   = "resources"
+	configurationFileName = "codeAnalyzerConfiguration.json"
+)
+
+var ErrNoMATLABFiles = errors.New("no MATLAB code files found in folder")
+
+// CodeIssue is an issue that the Code Analyzer found in a file.
+type CodeIssue struct {
+	File string
+	// CheckID identifies the Code Analyzer check, for example NASGU. It is empty when MATLAB does not report it.
+	CheckID     string
+	Description string
+	Line        int
+	StartColumn int
+	EndColumn   int
+	Severity    string
+	Fixable     bool
+}
+
+type Args struct {
+	FolderPath string
+}
+
+type ReturnArgs struct {
+	FolderPath string
+	// ConfigurationFile is the Code Analyzer configuration that the analysis used, if the folder has one.
+	ConfigurationFile string
+	Files             []string
+	// Issues are sorted by file, line and column.
+	Issues []CodeIssue
+}
+
+type PathValidator interface {
+	ValidateFolderPath(folderPath string) (string, error)
+}
+
+type OSLayer interface {
+	ReadDir(name string) ([]os.DirEntry, error)
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type CodeAnalyzer interface {
+	AnalyzeFiles(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string, configurationFile string) ([]CodeIssue, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+	codeAnalyzer  CodeAnalyzer
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+	codeAnalyzer CodeAnalyzer,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+		codeAnalyzer:  codeAnalyzer,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering AnalyzeMATLABProject Usecase")
+	defer sessionLogger.Debug("Exiting AnalyzeMATLABProject Usecase")
+
+	folderPath, err := u.pathValidator.ValidateFolderPath(request.FolderPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	files, err := codefiles.Find(u.osLayer, folderPath)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(files) == 0 {
+		return ReturnArgs{}, fmt.Errorf("%w: %s", ErrNoMATLABFiles, folderPath)
+	}
+
+	result := ReturnArgs{
+		FolderPath:        folderPath,
+		ConfigurationFile: u.findConfigurationFile(folderPath),
+		Files:             files,
+		Issues:            []CodeIssue{},
+	}
+
+	sessionLogger.
+		With("files", len(files)).
+		With("configuration", result.ConfigurationFile).
+		Debug("Analyzing MATLAB files")
+
+	for batch := range slices.Chunk(files, BatchSize) {
+		issues, err := u.codeAnalyzer.AnalyzeFiles(ctx, sessionLogger, client, batch, result.ConfigurationFile)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+		result.Issues = append(result.Issues, issues...)
+	}
+
+	slices.SortStableFunc(result.Issues, func(a, b CodeIssue) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.StartColumn, b.StartColumn),
+		)
+	})
+
+	return result, nil
+}
+
+// findConfigurationFile returns the Code Analyzer configuration file that MATLAB applies to the folder, from its resources folder.
+func (u *Usecase) findConfigurationFile(folderPath string) string {
+	configurationFile := filepath.Join(folderPath, configurationFolder, configurationFileName)
+
+	fileInfo, err := u.osLayer.Stat(configurationFile)
+	if err != nil || fileInfo.IsDir() {
+		return ""
+	}
+
+	return configurationFile
+}
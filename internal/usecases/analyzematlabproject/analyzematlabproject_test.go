@@ -0,0 +1,410 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package analyzematlabproject_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	analyzematlabprojectmocks "github.com/matlab/matlab-mcp-server/mocks/usecases/analyzematlabproject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	// Act
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockConfigurationFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockConfigurationFileInfo.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	requestedFolder := filepath.Join("path", "to", "project")
+	validatedFolder := filepath.Join("validated", "project")
+	subFolder := filepath.Join(validatedFolder, "sub")
+	configurationFile := filepath.Join(validatedFolder, "resources", "codeAnalyzerConfiguration.json")
+
+	mainFile := filepath.Join(validatedFolder, "main.m")
+	liveScript := filepath.Join(validatedFolder, "notes.mlx")
+	helperFile := filepath.Join(subFolder, "helper.m")
+	expectedFiles := []string{mainFile, liveScript, helperFile}
+
+	analyzerIssues := []analyzematlabproject.CodeIssue{
+		{File: mainFile, CheckID: "NOPRT", Line: 7, StartColumn: 1, Severity: analyzematlabproject.SeverityWarning},
+		{File: helperFile, CheckID: "NASGU", Line: 2, StartColumn: 5, Severity: analyzematlabproject.SeverityWarning},
+		{File: mainFile, CheckID: "SAGROW", Line: 3, StartColumn: 9, Severity: analyzematlabproject.SeverityInfo},
+		{File: mainFile, Line: 3, StartColumn: 2, Severity: analyzematlabproject.SeverityError},
+	}
+	expectedIssues := []analyzematlabproject.CodeIssue{
+		analyzerIssues[3],
+		analyzerIssues[2],
+		analyzerIssues[0],
+		analyzerIssues[1],
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(requestedFolder).
+		Return(validatedFolder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(validatedFolder).
+		Return(dirEntries(t, []string{"main.m", "notes.mlx", "data.mat", "readme.txt"}, []string{".git", "sub"}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(subFolder).
+		Return(dirEntries(t, []string{"helper.m"}, nil), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(configurationFile).
+		Return(mockConfigurationFileInfo, nil).
+		Once()
+
+	mockConfigurationFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeFiles(ctx, mockLogger.AsMockArg(), mockClient, expectedFiles, configurationFile).
+		Return(analyzerIssues, nil).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabproject.Args{FolderPath: requestedFolder})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, validatedFolder, result.FolderPath, "FolderPath should be the validated folder")
+	assert.Equal(t, configurationFile, result.ConfigurationFile, "ConfigurationFile should be the configuration of the folder")
+	assert.Equal(t, expectedFiles, result.Files, "Files should contain the MATLAB code files of the folder and its subfolders")
+	assert.Equal(t, expectedIssues, result.Issues, "Issues should be sorted by file, line and column")
+}
+
+func TestUsecase_Execute_AnalyzesFilesInBatches(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	folder := filepath.Join("validated", "project")
+	fileCount := analyzematlabproject.BatchSize + 1
+
+	fileNames := make([]string, fileCount)
+	for i := range fileNames {
+		fileNames[i] = fmt.Sprintf("file%03d.m", i)
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, fileNames, nil), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(folder, "resources", "codeAnalyzerConfiguration.json")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeFiles(ctx, mockLogger.AsMockArg(), mockClient, mock.MatchedBy(func(files []string) bool { return len(files) == analyzematlabproject.BatchSize }), "").
+		Return([]analyzematlabproject.CodeIssue{{File: filepath.Join(folder, fileNames[0]), Line: 1}}, nil).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeFiles(ctx, mockLogger.AsMockArg(), mockClient, []string{filepath.Join(folder, fileNames[fileCount-1])}, "").
+		Return([]analyzematlabproject.CodeIssue{{File: filepath.Join(folder, fileNames[fileCount-1]), Line: 1}}, nil).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Empty(t, result.ConfigurationFile, "ConfigurationFile should be empty when the folder has no configuration")
+	assert.Len(t, result.Files, fileCount, "Files should contain every MATLAB code file")
+	assert.Len(t, result.Issues, 2, "Issues should contain the issues of every batch")
+}
+
+func TestUsecase_Execute_NoIssues(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	folder := filepath.Join("validated", "project")
+	file := filepath.Join(folder, "main.m")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"main.m"}, nil), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(folder, "resources", "codeAnalyzerConfiguration.json")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeFiles(ctx, mockLogger.AsMockArg(), mockClient, []string{file}, "").
+		Return(nil, nil).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.NotNil(t, result.Issues, "Issues should not be nil")
+	assert.Empty(t, result.Issues, "Issues should be empty")
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	folder := filepath.Join("path", "to", "project")
+	expectedError := fmt.Errorf("not a folder")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return("", expectedError).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty when there's an error")
+}
+
+func TestUsecase_Execute_ReadDirError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	folder := filepath.Join("validated", "project")
+	expectedError := os.ErrPermission
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty when there's an error")
+}
+
+func TestUsecase_Execute_NoMATLABFiles(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	folder := filepath.Join("validated", "project")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"data.csv"}, []string{".hidden"}), nil).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.ErrorIs(t, err, analyzematlabproject.ErrNoMATLABFiles)
+	assert.Empty(t, result, "Result should be empty when there's an error")
+}
+
+func TestUsecase_Execute_AnalyzeFilesError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabprojectmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &analyzematlabprojectmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodeAnalyzer := &analyzematlabprojectmocks.MockCodeAnalyzer{}
+	defer mockCodeAnalyzer.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	folder := filepath.Join("validated", "project")
+	expectedError := fmt.Errorf("code analysis failed")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(folder).
+		Return(folder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"main.m"}, nil), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(folder, "resources", "codeAnalyzerConfiguration.json")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockCodeAnalyzer.EXPECT().
+		AnalyzeFiles(ctx, mockLogger.AsMockArg(), mockClient, []string{filepath.Join(folder, "main.m")}, "").
+		Return(nil, expectedError).
+		Once()
+
+	usecase := analyzematlabproject.New(mockPathValidator, mockOSLayer, mockCodeAnalyzer)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabproject.Args{FolderPath: folder})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty when there's an error")
+}
+
+// dirEntries returns the entries of a folder with the given files and subfolders, sorted by name as os.ReadDir returns them.
+func dirEntries(t *testing.T, files []string, folders []string) []os.DirEntry {
+	t.Helper()
+
+	folderFS := fstest.MapFS{}
+	for _, file := range files {
+		folderFS[file] = &fstest.MapFile{}
+	}
+	for _, folder := range folders {
+		folderFS[folder] = &fstest.MapFile{Mode: fs.ModeDir}
+	}
+
+	entries, err := fs.ReadDir(folderFS, ".")
+	require.NoError(t, err)
+
+	return entries
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package convertlivescript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+)
+
+// Formats that a live script can be converted to. Each format is also the extension of the output file.
+const (
+	FormatM    = "m"
+	FormatHTML = "html"
+	FormatPDF  = "pdf"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported format")
+	ErrOutputFileExists  = errors.New("output file already exists")
+)
+
+type Args struct {
+	LiveScriptPath string
+	Format         string
+	// OutputPath defaults to the live script path, with the extension of the format.
+	OutputPath string
+	Overwrite  bool
+}
+
+type ReturnArgs struct {
+	OutputPath string
+	Format     string
+}
+
+type PathValidator interface {
+	ValidateLiveScript(filePath string) (string, error)
+	ValidateFolderPath(filePath string) (string, error)
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type LiveScriptConverter interface {
+	Convert(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string, outputPath string) error
+}
+
+type Usecase struct {
+	pathValidator       PathValidator
+	osLayer             OSLayer
+	liveScriptConverter LiveScriptConverter
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+	liveScriptConverter LiveScriptConverter,
+) *Usecase {
+	return &Usecase{
+		pathValidator:       pathValidator,
+		osLayer:             osLayer,
+		liveScriptConverter: liveScriptConverter,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ConvertLiveScript Usecase")
+	defer sessionLogger.Debug("Exiting ConvertLiveScript Usecase")
+
+	liveScriptPath, err := u.pathValidator.ValidateLiveScript(request.LiveScriptPath)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	format := strings.ToLower(request.Format)
+	if !slices.Contains([]string{FormatM, FormatHTML, FormatPDF}, format) {
+		return ReturnArgs{}, fmt.Errorf("%w: %q, expected %s, %s or %s", ErrUnsupportedFormat, request.Format, FormatM, FormatHTML, FormatPDF)
+	}
+
+	outputPath, err := u.outputPath(liveScriptPath, request.OutputPath, format)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if !request.Overwrite {
+		if err := u.ensureDoesNotExist(outputPath); err != nil {
+			return ReturnArgs{}, err
+		}
+	}
+
+	if err := u.liveScriptConverter.Convert(ctx, sessionLogger, client, liveScriptPath, outputPath); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return ReturnArgs{
+		OutputPath: outputPath,
+		Format:     format,
+	}, nil
+}
+
+func (u *Usecase) outputPath(liveScriptPath string, requestedPath string, format string) (string, error) {
+	extension := "." + format
+
+	if requestedPath == "" {
+		return strings.TrimSuffix(liveScriptPath, filepath.Ext(liveScriptPath)) + extension, nil
+	}
+
+	outputFolder, err := u.pathValidator.ValidateFolderPath(filepath.Dir(filepath.Clean(requestedPath)))
+	if err != nil {
+		return "", fmt.Errorf("output path validation failed: %w", err)
+	}
+
+	outputPath := filepath.Join(outputFolder, filepath.Base(requestedPath))
+	if !strings.EqualFold(filepath.Ext(outputPath), extension) {
+		return "", fmt.Errorf("output path must have the %s extension for the %s format: %s", extension, format, outputPath)
+	}
+
+	return outputPath, nil
+}
+
+func (u *Usecase) ensureDoesNotExist(outputPath string) error {
+	_, err := u.osLayer.Stat(outputPath)
+	if err == nil {
+		return fmt.Errorf("%w: %s", ErrOutputFileExists, outputPath)
+	}
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("error accessing output file: %w", err)
+	}
+	return nil
+}
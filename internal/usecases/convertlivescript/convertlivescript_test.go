@@ -0,0 +1,454 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package convertlivescript_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/convertlivescript"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	osfacademocks "github.com/matlab/matlab-mcp-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/convertlivescript"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	// Act
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_DefaultOutputPath(t *testing.T) {
+	testCases := []struct {
+		format         string
+		expectedFormat string
+		expectedFile   string
+	}{
+		{format: "m", expectedFormat: convertlivescript.FormatM, expectedFile: "report.m"},
+		{format: "html", expectedFormat: convertlivescript.FormatHTML, expectedFile: "report.html"},
+		{format: "PDF", expectedFormat: convertlivescript.FormatPDF, expectedFile: "report.pdf"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.format, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+			defer mockLiveScriptConverter.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			folder := filepath.Join("home", "user", "matlab")
+			liveScriptPath := filepath.Join(folder, "report.mlx")
+			expectedOutputPath := filepath.Join(folder, testCase.expectedFile)
+
+			mockPathValidator.EXPECT().
+				ValidateLiveScript(liveScriptPath).
+				Return(liveScriptPath, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Stat(expectedOutputPath).
+				Return(nil, os.ErrNotExist).
+				Once()
+
+			mockLiveScriptConverter.EXPECT().
+				Convert(ctx, mockLogger.AsMockArg(), mockClient, liveScriptPath, expectedOutputPath).
+				Return(nil).
+				Once()
+
+			usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, convertlivescript.Args{
+				LiveScriptPath: liveScriptPath,
+				Format:         testCase.format,
+			})
+
+			// Assert
+			require.NoError(t, err, "Execute should not return an error")
+			assert.Equal(t, convertlivescript.ReturnArgs{OutputPath: expectedOutputPath, Format: testCase.expectedFormat}, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_OutputPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	liveScriptPath := filepath.Join("home", "user", "matlab", "report.mlx")
+	outputFolder := filepath.Join("home", "user", "exports")
+	outputPath := filepath.Join(outputFolder, "weekly.html")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(outputFolder).
+		Return(outputFolder, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(outputPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Convert(ctx, mockLogger.AsMockArg(), mockClient, liveScriptPath, outputPath).
+		Return(nil).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatHTML,
+		OutputPath:     outputPath,
+	})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, convertlivescript.ReturnArgs{OutputPath: outputPath, Format: convertlivescript.FormatHTML}, result)
+}
+
+func TestUsecase_Execute_OutputPathWithWrongExtension(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	liveScriptPath := filepath.Join("home", "user", "matlab", "report.mlx")
+	outputFolder := filepath.Join("home", "user", "exports")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(outputFolder).
+		Return(outputFolder, nil).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatPDF,
+		OutputPath:     filepath.Join(outputFolder, "weekly.html"),
+	})
+
+	// Assert
+	require.ErrorContains(t, err, "output path must have the .pdf extension")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_OutputFolderValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	liveScriptPath := filepath.Join("home", "user", "matlab", "report.mlx")
+	outputFolder := filepath.Join("home", "user", "missing")
+	expectedError := fmt.Errorf("resource not found")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(outputFolder).
+		Return("", expectedError).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatM,
+		OutputPath:     filepath.Join(outputFolder, "report.m"),
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_OutputFileExists(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	folder := filepath.Join("home", "user", "matlab")
+	liveScriptPath := filepath.Join(folder, "report.mlx")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(folder, "report.m")).
+		Return(mockFileInfo, nil).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatM,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, convertlivescript.ErrOutputFileExists)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_Overwrite(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	folder := filepath.Join("home", "user", "matlab")
+	liveScriptPath := filepath.Join(folder, "report.mlx")
+	outputPath := filepath.Join(folder, "report.m")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Convert(ctx, mockLogger.AsMockArg(), mockClient, liveScriptPath, outputPath).
+		Return(nil).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatM,
+		Overwrite:      true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, outputPath, result.OutputPath)
+}
+
+func TestUsecase_Execute_UnsupportedFormat(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	liveScriptPath := filepath.Join("home", "user", "matlab", "report.mlx")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         "docx",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, convertlivescript.ErrUnsupportedFormat)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	liveScriptPath := filepath.Join("home", "user", "matlab", "script.m")
+	expectedError := fmt.Errorf("file must be a MATLAB live script .mlx file")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatHTML,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ConvertError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	folder := filepath.Join("home", "user", "matlab")
+	liveScriptPath := filepath.Join(folder, "report.mlx")
+	outputPath := filepath.Join(folder, "report.pdf")
+	expectedError := fmt.Errorf("export failed")
+
+	mockPathValidator.EXPECT().
+		ValidateLiveScript(liveScriptPath).
+		Return(liveScriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(outputPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Convert(ctx, mockLogger.AsMockArg(), mockClient, liveScriptPath, outputPath).
+		Return(expectedError).
+		Once()
+
+	usecase := convertlivescript.New(mockPathValidator, mockOSLayer, mockLiveScriptConverter)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, convertlivescript.Args{
+		LiveScriptPath: liveScriptPath,
+		Format:         convertlivescript.FormatPDF,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
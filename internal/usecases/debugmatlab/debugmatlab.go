@@ -0,0 +1,299 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
+)
+
+var (
+	ErrNoFilePath               = errors.New("provide the path of the file to stop in")
+	ErrInvalidLine              = errors.New("line numbers start at 1")
+	ErrLineWithoutFilePath      = errors.New("provide the path of the file that the line belongs to")
+	ErrLocationWithErrorTrigger = errors.New("breakpoints that stop on errors apply to all files, do not provide a file path or a line")
+	ErrIdentifierWithoutTrigger = errors.New("an error identifier only applies to breakpoints that stop on errors")
+	ErrInvalidTrigger           = errors.New("invalid breakpoint trigger")
+	ErrInvalidStepAction        = errors.New("invalid debugger action")
+	ErrNoCode                   = errors.New("provide the code to run")
+	ErrNotPaused                = errors.New("MATLAB is not paused in debug mode")
+)
+
+type BreakpointTrigger string
+
+const (
+	// BreakpointTriggerLine stops at a line of a file.
+	BreakpointTriggerLine BreakpointTrigger = ""
+	// BreakpointTriggerError stops where an uncaught error is raised.
+	BreakpointTriggerError BreakpointTrigger = "error"
+	// BreakpointTriggerCaughtError stops where an error is raised inside a try block.
+	BreakpointTriggerCaughtError BreakpointTrigger = "caught_error"
+)
+
+type StepAction string
+
+const (
+	StepActionStep     StepAction = "step"
+	StepActionStepIn   StepAction = "step_in"
+	StepActionStepOut  StepAction = "step_out"
+	StepActionContinue StepAction = "continue"
+	StepActionQuit     StepAction = "quit"
+)
+
+type BreakpointArgs struct {
+	Trigger  BreakpointTrigger
+	FilePath string
+	// Line is the 1-based line to stop at. Zero means the first executable line of the file.
+	Line int
+	// Condition is a MATLAB expression. The breakpoint stops only when the expression is true.
+	Condition string
+	// ErrorIdentifier restricts a breakpoint that stops on errors to errors with that identifier.
+	ErrorIdentifier string
+}
+
+type ClearBreakpointsArgs struct {
+	Trigger  BreakpointTrigger
+	FilePath string
+	Line     int
+}
+
+type BreakpointsReturnArgs struct {
+	// Breakpoints is the list of breakpoints that remain set, as displayed by dbstatus.
+	Breakpoints string
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	Check(code string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+	}
+}
+
+// SetBreakpoint sets a breakpoint with dbstop, and returns the breakpoints that are set.
+func (u *Usecase) SetBreakpoint(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request BreakpointArgs) (BreakpointsReturnArgs, error) {
+	sessionLogger.Debug("Entering SetBreakpoint Usecase")
+	defer sessionLogger.Debug("Exiting SetBreakpoint Usecase")
+
+	var arguments []string
+
+	switch request.Trigger {
+	case BreakpointTriggerLine:
+		if request.FilePath == "" {
+			return BreakpointsReturnArgs{}, ErrNoFilePath
+		}
+		if request.ErrorIdentifier != "" {
+			return BreakpointsReturnArgs{}, ErrIdentifierWithoutTrigger
+		}
+
+		location, err := u.location(request.FilePath, request.Line)
+		if err != nil {
+			return BreakpointsReturnArgs{}, err
+		}
+		arguments = location
+
+		if request.Condition != "" {
+			if err := u.codePolicy.Check(request.Condition); err != nil {
+				sessionLogger.WithError(err).Warn("Code rejected by code policy")
+				return BreakpointsReturnArgs{}, err
+			}
+			arguments = append(arguments, "if", request.Condition)
+		}
+	case BreakpointTriggerError, BreakpointTriggerCaughtError:
+		if request.FilePath != "" || request.Line != 0 {
+			return BreakpointsReturnArgs{}, ErrLocationWithErrorTrigger
+		}
+
+		arguments = []string{"if", errorCondition(request.Trigger)}
+		if request.ErrorIdentifier != "" {
+			arguments = append(arguments, request.ErrorIdentifier)
+		}
+	default:
+		return BreakpointsReturnArgs{}, fmt.Errorf("%w: %s", ErrInvalidTrigger, request.Trigger)
+	}
+
+	if _, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: matlabCall("dbstop", arguments)}); err != nil {
+		return BreakpointsReturnArgs{}, err
+	}
+
+	return listBreakpoints(ctx, sessionLogger, client)
+}
+
+// ClearBreakpoints clears breakpoints with dbclear, and returns the breakpoints that remain set.
+// Without a trigger or a file path, it clears all breakpoints.
+func (u *Usecase) ClearBreakpoints(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request ClearBreakpointsArgs) (BreakpointsReturnArgs, error) {
+	sessionLogger.Debug("Entering ClearBreakpoints Usecase")
+	defer sessionLogger.Debug("Exiting ClearBreakpoints Usecase")
+
+	var arguments []string
+
+	switch request.Trigger {
+	case BreakpointTriggerLine:
+		switch {
+		case request.FilePath != "":
+			location, err := u.location(request.FilePath, request.Line)
+			if err != nil {
+				return BreakpointsReturnArgs{}, err
+			}
+			arguments = location
+		case request.Line != 0:
+			return BreakpointsReturnArgs{}, ErrLineWithoutFilePath
+		default:
+			arguments = []string{"all"}
+		}
+	case BreakpointTriggerError, BreakpointTriggerCaughtError:
+		if request.FilePath != "" || request.Line != 0 {
+			return BreakpointsReturnArgs{}, ErrLocationWithErrorTrigger
+		}
+		arguments = []string{"if", errorCondition(request.Trigger)}
+	default:
+		return BreakpointsReturnArgs{}, fmt.Errorf("%w: %s", ErrInvalidTrigger, request.Trigger)
+	}
+
+	if _, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: matlabCall("dbclear", arguments)}); err != nil {
+		return BreakpointsReturnArgs{}, err
+	}
+
+	return listBreakpoints(ctx, sessionLogger, client)
+}
+
+// Run runs code until it completes or MATLAB pauses at a breakpoint.
+// If MATLAB is already paused, the code runs in the workspace of the paused function.
+func (u *Usecase) Run(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, code string) (State, error) {
+	sessionLogger.Debug("Entering RunInDebugger Usecase")
+	defer sessionLogger.Debug("Exiting RunInDebugger Usecase")
+
+	if code == "" {
+		return State{}, ErrNoCode
+	}
+
+	if err := u.codePolicy.Check(code); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return State{}, err
+	}
+
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: code})
+
+	return stateAfter(ctx, sessionLogger, client, response, err)
+}
+
+// Step steps, continues or quits the paused code. It returns ErrNotPaused if MATLAB is not paused.
+func (u *Usecase) Step(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, action StepAction) (State, error) {
+	sessionLogger.Debug("Entering StepInDebugger Usecase")
+	defer sessionLogger.Debug("Exiting StepInDebugger Usecase")
+
+	code, err := stepCode(action)
+	if err != nil {
+		return State{}, err
+	}
+
+	_, paused, err := readStack(ctx, sessionLogger, client)
+	if err != nil {
+		return State{}, err
+	}
+	if !paused {
+		return State{}, ErrNotPaused
+	}
+
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: code})
+
+	return stateAfter(ctx, sessionLogger, client, response, err)
+}
+
+// Stack returns the stack and the local variables of the paused code.
+// If MATLAB is not paused, the state only reports that.
+func (u *Usecase) Stack(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (State, error) {
+	sessionLogger.Debug("Entering DebugStack Usecase")
+	defer sessionLogger.Debug("Exiting DebugStack Usecase")
+
+	var state State
+	if err := readPausedState(ctx, sessionLogger, client, &state); err != nil {
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+func (u *Usecase) location(filePath string, line int) ([]string, error) {
+	if line < 0 {
+		return nil, ErrInvalidLine
+	}
+
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	location := []string{"in", validatedPath}
+	if line > 0 {
+		location = append(location, "at", strconv.Itoa(line))
+	}
+
+	return location, nil
+}
+
+func errorCondition(trigger BreakpointTrigger) string {
+	if trigger == BreakpointTriggerCaughtError {
+		return "caught error"
+	}
+	return "error"
+}
+
+func stepCode(action StepAction) (string, error) {
+	switch action {
+	case StepActionStep:
+		return "dbstep", nil
+	case StepActionStepIn:
+		return "dbstep in", nil
+	case StepActionStepOut:
+		return "dbstep out", nil
+	case StepActionContinue:
+		return "dbcont", nil
+	case StepActionQuit:
+		return debugmode.QuitCode, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidStepAction, action)
+	}
+}
+
+// matlabCall returns code that calls a function with text arguments, such as dbstop('in', 'file.m', 'at', '3').
+func matlabCall(function string, arguments []string) string {
+	code := function + "("
+	for i, argument := range arguments {
+		if i > 0 {
+			code += ", "
+		}
+		code += "'" + matlabstring.EscapeSingleQuotes(argument) + "'"
+	}
+	return code + ")"
+}
+
+func listBreakpoints(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (BreakpointsReturnArgs, error) {
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "dbstatus"})
+	if err != nil {
+		return BreakpointsReturnArgs{}, err
+	}
+
+	return BreakpointsReturnArgs{Breakpoints: response.ConsoleOutput}, nil
+}
@@ -0,0 +1,728 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/debugmatlab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stackCode     = "disp(jsonencode(dbstack('-completenames')))"
+	variablesCode = "disp(jsonencode(whos))"
+	quitCode      = "dbquit('all')"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_SetBreakpoint_HappyPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      debugmatlab.BreakpointArgs
+		validatePath bool
+		expectedCode string
+	}{
+		{
+			name:         "Line",
+			request:      debugmatlab.BreakpointArgs{FilePath: "/some/path/myFunction.m", Line: 12},
+			validatePath: true,
+			expectedCode: "dbstop('in', '/some/path/myFunction.m', 'at', '12')",
+		},
+		{
+			name:         "FirstExecutableLine",
+			request:      debugmatlab.BreakpointArgs{FilePath: "/some/path/myFunction.m"},
+			validatePath: true,
+			expectedCode: "dbstop('in', '/some/path/myFunction.m')",
+		},
+		{
+			name:         "Condition",
+			request:      debugmatlab.BreakpointArgs{FilePath: "/some/path/myFunction.m", Line: 12, Condition: "name == 'it''s'"},
+			validatePath: true,
+			expectedCode: "dbstop('in', '/some/path/myFunction.m', 'at', '12', 'if', 'name == ''it''''s''')",
+		},
+		{
+			name:         "Error",
+			request:      debugmatlab.BreakpointArgs{Trigger: debugmatlab.BreakpointTriggerError},
+			expectedCode: "dbstop('if', 'error')",
+		},
+		{
+			name:         "CaughtErrorWithIdentifier",
+			request:      debugmatlab.BreakpointArgs{Trigger: debugmatlab.BreakpointTriggerCaughtError, ErrorIdentifier: "MATLAB:badsubscript"},
+			expectedCode: "dbstop('if', 'caught error', 'MATLAB:badsubscript')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			const breakpoints = "Breakpoints for myFunction are on line 12."
+
+			if tt.validatePath {
+				mockPathValidator.EXPECT().
+					ValidateMATLABScript(tt.request.FilePath).
+					Return(tt.request.FilePath, nil).
+					Once()
+			}
+
+			if tt.request.Condition != "" {
+				mockCodePolicy.EXPECT().
+					Check(tt.request.Condition).
+					Return(nil).
+					Once()
+			}
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: tt.expectedCode}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "dbstatus"}).
+				Return(entities.EvalResponse{ConsoleOutput: breakpoints}, nil).
+				Once()
+
+			usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			result, err := usecase.SetBreakpoint(ctx, mockLogger, mockClient, tt.request)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, breakpoints, result.Breakpoints)
+		})
+	}
+}
+
+func TestUsecase_SetBreakpoint_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       debugmatlab.BreakpointArgs
+		expectedError error
+	}{
+		{
+			name:          "NoFilePath",
+			request:       debugmatlab.BreakpointArgs{Line: 3},
+			expectedError: debugmatlab.ErrNoFilePath,
+		},
+		{
+			name:          "NegativeLine",
+			request:       debugmatlab.BreakpointArgs{FilePath: "/some/path/myFunction.m", Line: -1},
+			expectedError: debugmatlab.ErrInvalidLine,
+		},
+		{
+			name:          "IdentifierWithoutTrigger",
+			request:       debugmatlab.BreakpointArgs{FilePath: "/some/path/myFunction.m", ErrorIdentifier: "MATLAB:badsubscript"},
+			expectedError: debugmatlab.ErrIdentifierWithoutTrigger,
+		},
+		{
+			name:          "LocationWithErrorTrigger",
+			request:       debugmatlab.BreakpointArgs{Trigger: debugmatlab.BreakpointTriggerError, FilePath: "/some/path/myFunction.m"},
+			expectedError: debugmatlab.ErrLocationWithErrorTrigger,
+		},
+		{
+			name:          "UnknownTrigger",
+			request:       debugmatlab.BreakpointArgs{Trigger: "warning"},
+			expectedError: debugmatlab.ErrInvalidTrigger,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			result, err := usecase.SetBreakpoint(t.Context(), mockLogger, mockClient, tt.request)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_SetBreakpoint_ConditionRejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const filePath = "/some/path/myFunction.m"
+	const condition = "system('rm file') == 0"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(filePath).
+		Return(filePath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(condition).
+		Return(assert.AnError).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	result, err := usecase.SetBreakpoint(t.Context(), mockLogger, mockClient, debugmatlab.BreakpointArgs{FilePath: filePath, Line: 4, Condition: condition})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_ClearBreakpoints_HappyPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      debugmatlab.ClearBreakpointsArgs
+		validatePath bool
+		expectedCode string
+	}{
+		{
+			name:         "All",
+			request:      debugmatlab.ClearBreakpointsArgs{},
+			expectedCode: "dbclear('all')",
+		},
+		{
+			name:         "File",
+			request:      debugmatlab.ClearBreakpointsArgs{FilePath: "/some/path/myFunction.m"},
+			validatePath: true,
+			expectedCode: "dbclear('in', '/some/path/myFunction.m')",
+		},
+		{
+			name:         "Line",
+			request:      debugmatlab.ClearBreakpointsArgs{FilePath: "/some/path/myFunction.m", Line: 7},
+			validatePath: true,
+			expectedCode: "dbclear('in', '/some/path/myFunction.m', 'at', '7')",
+		},
+		{
+			name:         "CaughtError",
+			request:      debugmatlab.ClearBreakpointsArgs{Trigger: debugmatlab.BreakpointTriggerCaughtError},
+			expectedCode: "dbclear('if', 'caught error')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			if tt.validatePath {
+				mockPathValidator.EXPECT().
+					ValidateMATLABScript(tt.request.FilePath).
+					Return(tt.request.FilePath, nil).
+					Once()
+			}
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: tt.expectedCode}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "dbstatus"}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			result, err := usecase.ClearBreakpoints(ctx, mockLogger, mockClient, tt.request)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Empty(t, result.Breakpoints)
+		})
+	}
+}
+
+func TestUsecase_ClearBreakpoints_LineWithoutFilePath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	result, err := usecase.ClearBreakpoints(t.Context(), mockLogger, mockClient, debugmatlab.ClearBreakpointsArgs{Line: 7})
+
+	// Assert
+	require.ErrorIs(t, err, debugmatlab.ErrLineWithoutFilePath)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Run_Completes(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "myFunction(3, 4)"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{ConsoleOutput: "ans = 7", PromptType: entities.PromptTypeCommand}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugmatlab.State{ConsoleOutput: "ans = 7"}, state)
+}
+
+func TestUsecase_Run_PausesAtBreakpoint(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "myFunction(3, 4)"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{ConsoleOutput: "starting", PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+		Return(entities.EvalResponse{
+			ConsoleOutput: `[{"file":"/some/path/helper.m","name":"helper","line":5},{"file":"/some/path/myFunction.m","name":"myFunction","line":12}]` + "\n",
+			PromptType:    entities.PromptTypeDebug,
+		}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: variablesCode}).
+		Return(entities.EvalResponse{
+			ConsoleOutput: `[{"name":"big","size":[1000,1000],"bytes":8000000,"class":"double"},{"name":"x","size":[1,1],"bytes":8,"class":"double"}]`,
+			PromptType:    entities.PromptTypeDebug,
+		}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "disp(x)"}).
+		Return(entities.EvalResponse{ConsoleOutput: "     3\n", PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugmatlab.State{
+		ConsoleOutput: "starting",
+		Paused:        true,
+		Stack: []debugmatlab.Frame{
+			{Name: "helper", File: "/some/path/helper.m", Line: 5},
+			{Name: "myFunction", File: "/some/path/myFunction.m", Line: 12},
+		},
+		Variables: []debugmatlab.Variable{
+			{Name: "big", Class: "double", Size: "1000x1000"},
+			{Name: "x", Class: "double", Size: "1x1", Value: "3"},
+		},
+	}, state)
+}
+
+func TestUsecase_Run_PausesOnError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "myFunction(3, 4)"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeDebug}, assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+		Return(entities.EvalResponse{
+			ConsoleOutput: `{"file":"/some/path/myFunction.m","name":"myFunction","line":3}`,
+			PromptType:    entities.PromptTypeDebug,
+		}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: variablesCode}).
+		Return(entities.EvalResponse{ConsoleOutput: "[]", PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, assert.AnError.Error(), state.Error)
+	assert.True(t, state.Paused)
+	assert.Equal(t, []debugmatlab.Frame{{Name: "myFunction", File: "/some/path/myFunction.m", Line: 3}}, state.Stack)
+	assert.Empty(t, state.Variables)
+}
+
+func TestUsecase_Run_QuitsDebuggingWhenStateCannotBeRead(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "myFunction(3, 4)"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+		Return(entities.EvalResponse{ConsoleOutput: "not json", PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: quitCode}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeCommand}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, state)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to read the state of the paused code, quitting debug mode")
+}
+
+func TestUsecase_Run_ContextCancelled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	const code = "myFunction(3, 4)"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, context.Canceled).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, state)
+}
+
+func TestUsecase_Run_RejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const code = "delete('*')"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(assert.AnError).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Run(t.Context(), mockLogger, mockClient, code)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, state)
+}
+
+func TestUsecase_Step_HappyPath(t *testing.T) {
+	tests := []struct {
+		action       debugmatlab.StepAction
+		expectedCode string
+	}{
+		{action: debugmatlab.StepActionStep, expectedCode: "dbstep"},
+		{action: debugmatlab.StepActionStepIn, expectedCode: "dbstep in"},
+		{action: debugmatlab.StepActionStepOut, expectedCode: "dbstep out"},
+		{action: debugmatlab.StepActionContinue, expectedCode: "dbcont"},
+		{action: debugmatlab.StepActionQuit, expectedCode: quitCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+				Return(entities.EvalResponse{
+					ConsoleOutput: `{"file":"/some/path/myFunction.m","name":"myFunction","line":3}`,
+					PromptType:    entities.PromptTypeDebug,
+				}, nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: tt.expectedCode}).
+				Return(entities.EvalResponse{ConsoleOutput: "done", PromptType: entities.PromptTypeCommand}, nil).
+				Once()
+
+			usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			state, err := usecase.Step(ctx, mockLogger, mockClient, tt.action)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, debugmatlab.State{ConsoleOutput: "done"}, state)
+		})
+	}
+}
+
+func TestUsecase_Step_NotPaused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+		Return(entities.EvalResponse{ConsoleOutput: "[]", PromptType: entities.PromptTypeCommand}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Step(ctx, mockLogger, mockClient, debugmatlab.StepActionContinue)
+
+	// Assert
+	require.ErrorIs(t, err, debugmatlab.ErrNotPaused)
+	assert.Empty(t, state)
+}
+
+func TestUsecase_Step_InvalidAction(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Step(t.Context(), mockLogger, mockClient, "jump")
+
+	// Assert
+	require.ErrorIs(t, err, debugmatlab.ErrInvalidStepAction)
+	assert.Empty(t, state)
+}
+
+func TestUsecase_Stack_NotPaused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: stackCode}).
+		Return(entities.EvalResponse{ConsoleOutput: "[]", PromptType: entities.PromptTypeCommand}, nil).
+		Once()
+
+	usecase := debugmatlab.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	state, err := usecase.Stack(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugmatlab.State{}, state)
+}
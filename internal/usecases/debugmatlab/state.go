@@ -0,0 +1,203 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmatlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+)
+
+const (
+	stackCode     = "disp(jsonencode(dbstack('-completenames')))"
+	variablesCode = "disp(jsonencode(whos))"
+
+	// Only the values of small variables are displayed, and only up to a limit, to keep the state short.
+	maxValueBytes     = 1024
+	maxValueLength    = 500
+	maxVariableValues = 50
+)
+
+type State struct {
+	ConsoleOutput string
+	// Error is the message of the error the code raised, if any.
+	Error string
+	// Paused is true when MATLAB shows the K>> prompt.
+	Paused bool
+	// Stack lists the paused functions, innermost first. It is empty when MATLAB is not paused.
+	Stack []Frame
+	// Variables are the variables of the innermost paused function.
+	Variables []Variable
+}
+
+type Frame struct {
+	Name string
+	File string
+	Line int
+}
+
+type Variable struct {
+	Name  string
+	Class string
+	Size  string
+	// Value is the displayed value, for small variables only.
+	Value string
+}
+
+type stackEntry struct {
+	File string `json:"file"`
+	Name string `json:"name"`
+	Line int    `json:"line"`
+}
+
+type whosEntry struct {
+	Name  string `json:"name"`
+	Size  []int  `json:"size"`
+	Bytes int    `json:"bytes"`
+	Class string `json:"class"`
+}
+
+// stateAfter returns the state of MATLAB after an evaluation. If MATLAB is paused but its state cannot be read,
+// it quits debugging, so that the session does not stay in debug mode without the caller knowing.
+func stateAfter(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, response entities.EvalResponse, evalErr error) (State, error) {
+	if evalErr != nil && ctx.Err() != nil {
+		return State{}, evalErr
+	}
+
+	state := State{
+		ConsoleOutput: response.ConsoleOutput,
+	}
+
+	// Errors raised by the code are returned as errors, but leave MATLAB responsive
+	if evalErr != nil {
+		state.Error = evalErr.Error()
+	}
+
+	if !response.IsPausedInDebugger() {
+		return state, nil
+	}
+
+	if err := readPausedState(ctx, sessionLogger, client, &state); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to read the state of the paused code, quitting debug mode")
+		debugmode.Leave(ctx, sessionLogger, client)
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+func readPausedState(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, state *State) error {
+	frames, paused, err := readStack(ctx, sessionLogger, client)
+	if err != nil {
+		return err
+	}
+
+	state.Paused = paused
+	if !paused {
+		return nil
+	}
+
+	variables, err := readVariables(ctx, sessionLogger, client)
+	if err != nil {
+		return err
+	}
+
+	state.Stack = frames
+	state.Variables = variables
+
+	return nil
+}
+
+// readStack returns the paused functions, and whether MATLAB is paused, from the prompt it shows.
+func readStack(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]Frame, bool, error) {
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: stackCode})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !response.IsPausedInDebugger() {
+		return nil, false, nil
+	}
+
+	var entries []stackEntry
+	if err := decodeStructArray(response.ConsoleOutput, &entries); err != nil {
+		return nil, false, fmt.Errorf("failed to read the stack: %w", err)
+	}
+
+	frames := make([]Frame, len(entries))
+	for i, entry := range entries {
+		frames[i] = Frame{Name: entry.Name, File: entry.File, Line: entry.Line}
+	}
+
+	return frames, true, nil
+}
+
+func readVariables(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]Variable, error) {
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: variablesCode})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []whosEntry
+	if err := decodeStructArray(response.ConsoleOutput, &entries); err != nil {
+		return nil, fmt.Errorf("failed to read the variables: %w", err)
+	}
+
+	variables := make([]Variable, len(entries))
+	displayed := 0
+
+	for i, entry := range entries {
+		variables[i] = Variable{
+			Name:  entry.Name,
+			Class: entry.Class,
+			Size:  formatSize(entry.Size),
+		}
+
+		if entry.Bytes > maxValueBytes || displayed >= maxVariableValues {
+			continue
+		}
+		displayed++
+
+		value, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: fmt.Sprintf("disp(%s)", entry.Name)})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			sessionLogger.WithError(err).With("variable", entry.Name).Debug("Failed to display variable")
+			continue
+		}
+
+		variables[i].Value = truncate(strings.TrimSpace(value.ConsoleOutput), maxValueLength)
+	}
+
+	return variables, nil
+}
+
+// decodeStructArray decodes the JSON of a MATLAB struct array, which jsonencode writes as an object when it has one element.
+func decodeStructArray(text string, target any) error {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "{") {
+		text = "[" + text + "]"
+	}
+	return json.Unmarshal([]byte(text), target)
+}
+
+func formatSize(size []int) string {
+	dimensions := make([]string, len(size))
+	for i, dimension := range size {
+		dimensions[i] = strconv.Itoa(dimension)
+	}
+	return strings.Join(dimensions, "x")
+}
+
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return text[:maxLength] + "..."
+}
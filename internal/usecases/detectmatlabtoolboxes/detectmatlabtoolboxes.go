@@ -4,21 +4,45 @@ package detectmatlabtoolboxes
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 )
 
+const (
+	SourceInstallation = "installation"
+	SourceSession      = "session"
+)
+
+var (
+	verHeaderRegexp  = regexp.MustCompile(`^MATLAB Version:\s*(\S+)\s+\((R\d{4}[ab])\)(?:\s+Update\s+(\d+))?`)
+	verProductRegexp = regexp.MustCompile(`^(\S.*?)\s+Version\s+(\S+)\s+\((R\d{4}[ab])\)\s*$`)
+)
+
 type Usecase struct {
+	installationReader entities.MATLABInstallationReader
 }
 
-func New() *Usecase {
-	return &Usecase{}
+func New(
+	installationReader entities.MATLABInstallationReader,
+) *Usecase {
+	return &Usecase{
+		installationReader: installationReader,
+	}
 }
 
 type ReturnArgs struct {
-	Toolboxes string
+	Toolboxes    string
+	Installation entities.MATLABInstallation
+	Source       string
+	// MATLABRoot is the installation that was read. It is empty when the session was asked.
+	MATLABRoot string
 }
 
+// Execute runs ver in the MATLAB session and parses its output.
 func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (ReturnArgs, error) {
 	sessionLogger.Debug("Entering DetectMATLABToolboxes Usecase")
 	defer sessionLogger.Debug("Exiting DetectMATLABToolboxes Usecase")
@@ -33,6 +57,77 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	}
 
 	return ReturnArgs{
-		Toolboxes: ver.ConsoleOutput,
+		Toolboxes:    ver.ConsoleOutput,
+		Installation: parseVer(ver.ConsoleOutput),
+		Source:       SourceSession,
+	}, nil
+}
+
+// ExecuteForInstallation reads the MATLAB installation in matlabRoot, without starting MATLAB.
+func (u *Usecase) ExecuteForInstallation(_ context.Context, sessionLogger entities.Logger, matlabRoot string) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering DetectMATLABToolboxes Usecase for installation")
+	defer sessionLogger.Debug("Exiting DetectMATLABToolboxes Usecase for installation")
+
+	installation, err := u.installationReader.Read(sessionLogger, matlabRoot)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	return ReturnArgs{
+		Toolboxes:    formatInstallation(installation),
+		Installation: installation,
+		Source:       SourceInstallation,
+		MATLABRoot:   matlabRoot,
 	}, nil
 }
+
+func parseVer(output string) entities.MATLABInstallation {
+	installation := entities.MATLABInstallation{
+		Products: []entities.MATLABProduct{},
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := verHeaderRegexp.FindStringSubmatch(line); match != nil {
+			installation.Version = match[1]
+			installation.Release = match[2]
+			if match[3] != "" {
+				installation.UpdateLevel, _ = strconv.Atoi(match[3])
+			}
+			continue
+		}
+
+		if match := verProductRegexp.FindStringSubmatch(line); match != nil {
+			installation.Products = append(installation.Products, entities.MATLABProduct{
+				Name:    match[1],
+				Version: match[2],
+				Release: match[3],
+			})
+		}
+	}
+
+	return installation
+}
+
+// formatInstallation renders the installation in the same layout as the ver command.
+func formatInstallation(installation entities.MATLABInstallation) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "MATLAB Version: %s (%s)", installation.Version, installation.Release)
+	if installation.UpdateLevel > 0 {
+		fmt.Fprintf(&builder, " Update %d", installation.UpdateLevel)
+	}
+	builder.WriteString("\n")
+
+	nameWidth := 0
+	for _, product := range installation.Products {
+		nameWidth = max(nameWidth, len(product.Name))
+	}
+
+	for _, product := range installation.Products {
+		fmt.Fprintf(&builder, "%-*s  Version %s (%s)\n", nameWidth, product.Name, product.Version, product.Release)
+	}
+
+	return builder.String()
+}
@@ -3,6 +3,7 @@
 package detectmatlabtoolboxes_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/internal/entities"
@@ -15,9 +16,11 @@ import (
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
 
 	// Act
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -27,6 +30,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -41,6 +47,10 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	expectedResponse := detectmatlabtoolboxes.ReturnArgs{
 		Toolboxes: evalResponse.ConsoleOutput,
+		Installation: entities.MATLABInstallation{
+			Products: []entities.MATLABProduct{},
+		},
+		Source: detectmatlabtoolboxes.SourceSession,
 	}
 
 	ctx := t.Context()
@@ -50,7 +60,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(evalResponse, nil).
 		Once()
 
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient)
@@ -60,10 +70,61 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedResponse, response, "Response should match expected value")
 }
 
+func TestUsecase_Execute_ParsesVerOutput(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	verOutput := "-----------------------------------------------------------------------------------------------------\n" +
+		"MATLAB Version: 24.2.0.2712019 (R2024b) Update 1\n" +
+		"MATLAB License Number: 123456\n" +
+		"Operating System: Linux 6.8.0 #1 SMP x86_64\n" +
+		"Java Version: Java 1.8.0_202-b08 with Oracle Corporation Java HotSpot(TM) 64-Bit Server VM mixed mode\n" +
+		"-----------------------------------------------------------------------------------------------------\n" +
+		"MATLAB                                                Version 24.2        (R2024b)\n" +
+		"Signal Processing Toolbox                             Version 24.2        (R2024b)\n"
+
+	expectedInstallation := entities.MATLABInstallation{
+		Version:     "24.2.0.2712019",
+		Release:     "R2024b",
+		UpdateLevel: 1,
+		Products: []entities.MATLABProduct{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b"},
+			{Name: "Signal Processing Toolbox", Version: "24.2", Release: "R2024b"},
+		},
+	}
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "ver"}).
+		Return(entities.EvalResponse{ConsoleOutput: verOutput}, nil).
+		Once()
+
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, verOutput, response.Toolboxes, "Raw ver output should be returned")
+	assert.Equal(t, expectedInstallation, response.Installation)
+	assert.Equal(t, detectmatlabtoolboxes.SourceSession, response.Source)
+}
+
 func TestUsecase_Execute_EvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -80,7 +141,7 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
 		Once()
 
-	usecase := detectmatlabtoolboxes.New()
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient)
@@ -89,3 +150,71 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Error should be the original error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
+
+func TestUsecase_ExecuteForInstallation_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+
+	installation := entities.MATLABInstallation{
+		Version:     "24.2.0.2712019",
+		Release:     "R2024b",
+		UpdateLevel: 1,
+		Products: []entities.MATLABProduct{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", BaseCode: "ML"},
+			{Name: "Signal Processing Toolbox", Version: "24.2", Release: "R2024b", BaseCode: "SG"},
+		},
+	}
+
+	expectedToolboxes := "MATLAB Version: 24.2.0.2712019 (R2024b) Update 1\n" +
+		"MATLAB                     Version 24.2 (R2024b)\n" +
+		"Signal Processing Toolbox  Version 24.2 (R2024b)\n"
+
+	mockInstallationReader.EXPECT().
+		Read(mockLogger.AsMockArg(), matlabRoot).
+		Return(installation, nil).
+		Once()
+
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
+
+	// Act
+	response, err := usecase.ExecuteForInstallation(t.Context(), mockLogger, matlabRoot)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, detectmatlabtoolboxes.ReturnArgs{
+		Toolboxes:    expectedToolboxes,
+		Installation: installation,
+		Source:       detectmatlabtoolboxes.SourceInstallation,
+		MATLABRoot:   matlabRoot,
+	}, response)
+}
+
+func TestUsecase_ExecuteForInstallation_ReadError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockInstallationReader := &entitiesmocks.MockMATLABInstallationReader{}
+	defer mockInstallationReader.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedError := assert.AnError
+
+	mockInstallationReader.EXPECT().
+		Read(mockLogger.AsMockArg(), matlabRoot).
+		Return(entities.MATLABInstallation{}, expectedError).
+		Once()
+
+	usecase := detectmatlabtoolboxes.New(mockInstallationReader)
+
+	// Act
+	response, err := usecase.ExecuteForInstallation(t.Context(), mockLogger, matlabRoot)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
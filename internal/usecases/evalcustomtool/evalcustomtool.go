@@ -13,6 +13,10 @@ type FunctionCallAssembler interface {
 	Assemble(args functioncall.Args) (string, error)
 }
 
+type CodePolicy interface {
+	Check(code string) error
+}
+
 type Args struct {
 	Function      string
 	Order         []string
@@ -23,11 +27,13 @@ type Args struct {
 
 type Usecase struct {
 	functionCallAssembler FunctionCallAssembler
+	codePolicy            CodePolicy
 }
 
-func New(functionCallAssembler FunctionCallAssembler) *Usecase {
+func New(functionCallAssembler FunctionCallAssembler, codePolicy CodePolicy) *Usecase {
 	return &Usecase{
 		functionCallAssembler: functionCallAssembler,
+		codePolicy:            codePolicy,
 	}
 }
 
@@ -45,6 +51,11 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 		return entities.EvalResponse{}, err
 	}
 
+	if err := u.codePolicy.Check(code); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return entities.EvalResponse{}, err
+	}
+
 	evalRequest := entities.EvalRequest{
 		Code: code,
 	}
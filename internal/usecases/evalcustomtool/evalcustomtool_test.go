@@ -20,8 +20,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	// Act
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Assert
 	assert.NotNil(t, usecase)
@@ -37,6 +40,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
@@ -55,12 +61,17 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(code, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
@@ -85,6 +96,9 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
@@ -103,12 +117,17 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 		Return(code, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
@@ -134,6 +153,9 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
@@ -150,12 +172,17 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(code, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
@@ -180,6 +207,9 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
@@ -196,12 +226,17 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 		Return(code, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
@@ -227,6 +262,9 @@ func TestUsecase_Execute_AssembleError(t *testing.T) {
 	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
 	defer mockFunctionCallAssembler.AssertExpectations(t)
 
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	expectedFunctionCallArgs := functioncall.Args{
 		Function:      "magic",
 		Order:         []string{"n"},
@@ -242,7 +280,7 @@ func TestUsecase_Execute_AssembleError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	usecase := evalcustomtool.New(mockFunctionCallAssembler)
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
@@ -256,3 +294,52 @@ func TestUsecase_Execute_AssembleError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response)
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockFunctionCallAssembler := &evalcustomtoolmocks.MockFunctionCallAssembler{}
+	defer mockFunctionCallAssembler.AssertExpectations(t)
+
+	mockCodePolicy := &evalcustomtoolmocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	expectedFunctionCallArgs := functioncall.Args{
+		Function:      "readData",
+		Order:         []string{"file"},
+		ArgumentTypes: map[string]string{"file": "string"},
+		Arguments:     map[string]any{"file": "/etc/passwd"},
+	}
+	code := `readData("/etc/passwd")`
+	expectedError := assert.AnError
+
+	ctx := t.Context()
+
+	mockFunctionCallAssembler.EXPECT().
+		Assemble(expectedFunctionCallArgs).
+		Return(code, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(expectedError).
+		Once()
+
+	usecase := evalcustomtool.New(mockFunctionCallAssembler, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalcustomtool.Args{
+		Function:      "readData",
+		Order:         []string{"file"},
+		ArgumentTypes: map[string]string{"file": "string"},
+		Arguments:     map[string]any{"file": "/etc/passwd"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/figureoptions"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
 )
 
@@ -14,21 +16,29 @@ type Args struct {
 	Code          string
 	ProjectPath   string
 	CaptureOutput bool
+	Figures       entities.FigureOptions
 }
 
 type PathValidator interface {
 	ValidateFolderPath(filePath string) (string, error)
 }
 
+type CodePolicy interface {
+	Check(code string) error
+}
+
 type Usecase struct {
 	pathValidator PathValidator
+	codePolicy    CodePolicy
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
 	}
 }
 
@@ -36,6 +46,15 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering EvalInlMATLAB Usecase")
 	defer sessionLogger.Debug("Exiting EvalInMATLAB Usecase")
 
+	if err := figureoptions.Validate(request.Figures); err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	if err := u.codePolicy.Check(request.Code); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return entities.EvalResponse{}, err
+	}
+
 	if request.ProjectPath != "" {
 		validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
 		if err != nil {
@@ -57,7 +76,11 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	}
 
 	if request.CaptureOutput {
-		return client.EvalWithCapture(ctx, sessionLogger, evalRequest)
+		evalRequest.Figures = request.Figures
+		response, err := client.EvalWithCapture(ctx, sessionLogger, evalRequest)
+		return debugmode.LeaveIfPausedAfterCapture(ctx, sessionLogger, client, response, err)
 	}
-	return client.Eval(ctx, sessionLogger, evalRequest)
+
+	response, err := client.Eval(ctx, sessionLogger, evalRequest)
+	return debugmode.LeaveIfPaused(ctx, sessionLogger, client, response, err)
 }
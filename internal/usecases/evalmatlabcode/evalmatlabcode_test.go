@@ -9,9 +9,12 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/figureoptions"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/evalmatlabcode"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,8 +23,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	// Act
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -34,6 +40,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -69,7 +78,12 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -86,6 +100,9 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -120,7 +137,12 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -137,6 +159,9 @@ func TestUsecase_Execute_EmptyProjectPath_SkipsCd(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -157,7 +182,12 @@ func TestUsecase_Execute_EmptyProjectPath_SkipsCd(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -174,6 +204,9 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -191,7 +224,12 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -208,6 +246,9 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -235,7 +276,12 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -252,6 +298,9 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -284,7 +333,12 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -301,6 +355,9 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -337,7 +394,17 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -354,6 +421,9 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -386,7 +456,17 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -395,3 +475,164 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError, "Error should be the original error")
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	evalRequest := evalmatlabcode.Args{
+		ProjectPath: filepath.Join("some", "path"),
+		Code:        "system('ls')",
+	}
+
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(expectedError).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the code policy error")
+	assert.Empty(t, response, "Response should be empty when the code is rejected")
+}
+
+func TestUsecase_Execute_CaptureOutput_FigureOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	figures := entities.FigureOptions{Format: entities.FigureFormatJPEG, Width: 800, Height: 600, DPI: 150}
+
+	evalRequest := evalmatlabcode.Args{
+		Code:          "plot(1:10)",
+		CaptureOutput: true,
+		Figures:       figures,
+	}
+
+	expectedResponse := entities.EvalResponse{
+		Images: [][]byte{[]byte("jpeg")},
+	}
+
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: evalRequest.Code, Figures: figures}).
+		Return(expectedResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestUsecase_Execute_InvalidFigureOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	evalRequest := evalmatlabcode.Args{
+		Code:          "plot(1:10)",
+		CaptureOutput: true,
+		Figures:       entities.FigureOptions{Format: "gif"},
+	}
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, figureoptions.ErrInvalidFormat)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_PausedInDebugger_QuitsDebugMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	evalRequest := evalmatlabcode.Args{
+		Code: "myFunctionWithBreakpoint()",
+	}
+
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		Check(evalRequest.Code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: evalRequest.Code}).
+		Return(entities.EvalResponse{ConsoleOutput: "before breakpoint", PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "before breakpoint\n"+debugmode.PausedNote, response.ConsoleOutput)
+	assert.False(t, response.IsPausedInDebugger())
+}
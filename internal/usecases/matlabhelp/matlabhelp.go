@@ -0,0 +1,160 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+var (
+	ErrInvalidName = errors.New("invalid MATLAB name, use the name of a function, class or namespace, such as plot or matlab.unittest.TestCase")
+	ErrNotFound    = errors.New("no MATLAB function, class or namespace with this name")
+)
+
+// maxCachedHelps bounds the number of cached helps, across releases.
+const maxCachedHelps = 256
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z]\w*(\.[A-Za-z]\w*)*$`)
+
+// Help is the documentation of a MATLAB function, class or namespace.
+type Help struct {
+	Name string
+	// Summary is the first line of the help text, without the name.
+	Summary string
+	// Text is the rest of the help text, without the syntax section and the related functions.
+	Text    string
+	Syntax  []string
+	SeeAlso []string
+	// Members are the functions, classes and namespaces in a namespace.
+	Members []string
+	Product string
+	Release string
+}
+
+type HelpReader interface {
+	Read(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, name string) (Help, error)
+}
+
+type ReleaseGetter interface {
+	Release(ctx context.Context, logger entities.Logger, sessionID entities.SessionID, client entities.MATLABSessionClient) (string, error)
+}
+
+type cacheKey struct {
+	release string
+	name    string
+}
+
+type cachedHelp struct {
+	key  cacheKey
+	help Help
+}
+
+type Usecase struct {
+	helpReader    HelpReader
+	releaseGetter ReleaseGetter
+	maxEntries    int
+
+	lock    sync.Mutex
+	order   *list.List // Front is the most recently used help.
+	entries map[cacheKey]*list.Element
+}
+
+func New(
+	helpReader HelpReader,
+	releaseGetter ReleaseGetter,
+) *Usecase {
+	return newUsecase(helpReader, releaseGetter, maxCachedHelps)
+}
+
+func newUsecase(helpReader HelpReader, releaseGetter ReleaseGetter, maxEntries int) *Usecase {
+	return &Usecase{
+		helpReader:    helpReader,
+		releaseGetter: releaseGetter,
+		maxEntries:    maxEntries,
+		order:         list.New(),
+		entries:       map[cacheKey]*list.Element{},
+	}
+}
+
+// Help returns the documentation of a MATLAB function, class or namespace. The documentation only changes
+// between MATLAB releases, so it is cached per release of the MATLAB session, and the least recently used
+// entries are evicted.
+func (u *Usecase) Help(ctx context.Context, sessionLogger entities.Logger, globalMATLAB entities.GlobalMATLAB, name string) (Help, error) {
+	sessionLogger.Debug("Entering MATLAB Help Usecase")
+	defer sessionLogger.Debug("Exiting MATLAB Help Usecase")
+
+	if !nameRegexp.MatchString(name) {
+		return Help{}, fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+
+	client, err := globalMATLAB.Client(ctx, sessionLogger)
+	if err != nil {
+		return Help{}, err
+	}
+
+	var release string
+	if sessionID, ok := globalMATLAB.SessionID(); ok {
+		release, err = u.releaseGetter.Release(ctx, sessionLogger, sessionID, client)
+		if err != nil {
+			sessionLogger.WithError(err).Debug("Failed to get the MATLAB release, MATLAB help is not cached")
+		}
+	}
+
+	key := cacheKey{release: release, name: name}
+	if release != "" {
+		if help, ok := u.cached(key); ok {
+			return help, nil
+		}
+	}
+
+	help, err := u.helpReader.Read(ctx, sessionLogger, client, name)
+	if err != nil {
+		return Help{}, err
+	}
+
+	if release != "" {
+		help.Release = release
+		u.store(key, help)
+	}
+
+	return help, nil
+}
+
+func (u *Usecase) cached(key cacheKey) (Help, bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	element, ok := u.entries[key]
+	if !ok {
+		return Help{}, false
+	}
+
+	u.order.MoveToFront(element)
+	return element.Value.(*cachedHelp).help, true //nolint:forcetypeassert // The list only holds cached helps
+}
+
+func (u *Usecase) store(key cacheKey, help Help) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if element, ok := u.entries[key]; ok {
+		element.Value.(*cachedHelp).help = help //nolint:forcetypeassert // The list only holds cached helps
+		u.order.MoveToFront(element)
+		return
+	}
+
+	u.entries[key] = u.order.PushFront(&cachedHelp{key: key, help: help})
+
+	for u.order.Len() > u.maxEntries {
+		oldest := u.order.Back()
+		u.order.Remove(oldest)
+		delete(u.entries, oldest.Value.(*cachedHelp).key) //nolint:forcetypeassert // The list only holds cached helps
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp
+
+func NewWithCacheSize(helpReader HelpReader, releaseGetter ReleaseGetter, maxEntries int) *Usecase {
+	return newUsecase(helpReader, releaseGetter, maxEntries)
+}
@@ -0,0 +1,396 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabhelp_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/matlabhelp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sessionID = entities.SessionID(7)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	// Act
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_Help_HappyPath(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const name = "plot"
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("R2025b", nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(sessionID, true).
+		Once()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, name).
+		Return(matlabhelp.Help{Name: name, Summary: "2-D line plot", Product: "MATLAB"}, nil).
+		Once()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	help, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, matlabhelp.Help{Name: name, Summary: "2-D line plot", Product: "MATLAB", Release: "R2025b"}, help)
+}
+
+func TestUsecase_Help_CachesPerRelease(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const name = "plot"
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("R2025a", nil).
+		Twice()
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("R2025b", nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Times(3)
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(sessionID, true).
+		Times(3)
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, name).
+		Return(matlabhelp.Help{Name: name}, nil).
+		Twice()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	first, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	require.NoError(t, err)
+
+	second, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	require.NoError(t, err)
+
+	third, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "R2025a", first.Release)
+	assert.Equal(t, first, second, "The second lookup should be served from the cache")
+	assert.Equal(t, "R2025b", third.Release, "A new release should not be served from the cache")
+}
+
+func TestUsecase_Help_ReleaseErrorSkipsCache(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const name = "plot"
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("", assert.AnError).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(sessionID, true).
+		Twice()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, name).
+		Return(matlabhelp.Help{Name: name}, nil).
+		Twice()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	_, firstErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	help, secondErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, matlabhelp.Help{Name: name}, help)
+}
+
+func TestUsecase_Help_NoSessionIDSkipsCache(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const name = "plot"
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(0, false).
+		Twice()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, name).
+		Return(matlabhelp.Help{Name: name}, nil).
+		Twice()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	_, firstErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	help, secondErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, matlabhelp.Help{Name: name}, help)
+}
+
+func TestUsecase_Help_InvalidName(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	for _, name := range []string{"", "1plot", "plot; delete(x)", "matlab..io", "../plot"} {
+		// Act
+		_, err := usecase.Help(t.Context(), mockLogger, mockGlobalMATLAB, name)
+
+		// Assert
+		require.ErrorIs(t, err, matlabhelp.ErrInvalidName, name)
+	}
+}
+
+func TestUsecase_Help_ClientError(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	_, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, "plot")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Help_ReaderErrorIsNotCached(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const name = "plot"
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("R2025b", nil).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Twice()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(sessionID, true).
+		Twice()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, name).
+		Return(matlabhelp.Help{}, matlabhelp.ErrNotFound).
+		Twice()
+
+	usecase := matlabhelp.New(mockHelpReader, mockReleaseGetter)
+
+	// Act
+	_, firstErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+	_, secondErr := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+
+	// Assert
+	require.ErrorIs(t, firstErr, matlabhelp.ErrNotFound)
+	require.ErrorIs(t, secondErr, matlabhelp.ErrNotFound)
+}
+
+func TestUsecase_Help_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Arrange
+	mockHelpReader := &mocks.MockHelpReader{}
+	defer mockHelpReader.AssertExpectations(t)
+
+	mockReleaseGetter := &mocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockClient, nil).
+		Times(5)
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(sessionID, true).
+		Times(5)
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, mockLogger.AsMockArg(), sessionID, mockClient).
+		Return("R2025b", nil).
+		Times(5)
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, "plot").
+		Return(matlabhelp.Help{Name: "plot"}, nil).
+		Once()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, "trapz").
+		Return(matlabhelp.Help{Name: "trapz"}, nil).
+		Twice()
+
+	mockHelpReader.EXPECT().
+		Read(ctx, mockLogger.AsMockArg(), mockClient, "sort").
+		Return(matlabhelp.Help{Name: "sort"}, nil).
+		Once()
+
+	usecase := matlabhelp.NewWithCacheSize(mockHelpReader, mockReleaseGetter, 2)
+
+	// Act
+	for _, name := range []string{"plot", "trapz", "plot", "sort", "trapz"} {
+		_, err := usecase.Help(ctx, mockLogger, mockGlobalMATLAB, name)
+		require.NoError(t, err)
+	}
+
+	// Assert
+	// The mocks assert that storing sort evicted trapz, the least recently used help, so that it was read again.
+}
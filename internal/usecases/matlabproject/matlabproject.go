@@ -0,0 +1,115 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabproject
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+var ErrNoProjectFound = errors.New("no MATLAB project found in the roots of the AI application, provide the path of the project")
+
+type Project struct {
+	Name       string
+	RootFolder string
+	FileCount  int
+}
+
+type Label struct {
+	// Category is the label category, such as Classification.
+	Category string
+	Name     string
+}
+
+type File struct {
+	Path   string
+	Labels []Label
+}
+
+type CheckResult struct {
+	ID          string
+	Description string
+	Passed      bool
+	// ProblemFiles are the files that make the check fail.
+	ProblemFiles []string
+}
+
+type PathValidator interface {
+	ValidateMATLABProject(projectPath string) (string, error)
+}
+
+type MATLABProjectDetector interface {
+	DetectMATLABProject(logger entities.Logger) string
+}
+
+type ProjectManager interface {
+	Open(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, projectPath string) (Project, error)
+	Close(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (Project, error)
+	ListFiles(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]File, error)
+	RunChecks(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]CheckResult, error)
+}
+
+type Usecase struct {
+	pathValidator         PathValidator
+	matlabProjectDetector MATLABProjectDetector
+	projectManager        ProjectManager
+}
+
+func New(
+	pathValidator PathValidator,
+	matlabProjectDetector MATLABProjectDetector,
+	projectManager ProjectManager,
+) *Usecase {
+	return &Usecase{
+		pathValidator:         pathValidator,
+		matlabProjectDetector: matlabProjectDetector,
+		projectManager:        projectManager,
+	}
+}
+
+// Open opens a MATLAB project, which closes the project that is open, if any.
+// An empty projectPath opens the project found in the roots of the AI application.
+func (u *Usecase) Open(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string) (Project, error) {
+	sessionLogger.Debug("Entering Open MATLAB Project Usecase")
+	defer sessionLogger.Debug("Exiting Open MATLAB Project Usecase")
+
+	if projectPath == "" {
+		projectPath = u.matlabProjectDetector.DetectMATLABProject(sessionLogger)
+		if projectPath == "" {
+			return Project{}, ErrNoProjectFound
+		}
+	}
+
+	validatedPath, err := u.pathValidator.ValidateMATLABProject(projectPath)
+	if err != nil {
+		return Project{}, err
+	}
+
+	return u.projectManager.Open(ctx, sessionLogger, client, validatedPath)
+}
+
+// Close closes the open MATLAB project.
+func (u *Usecase) Close(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (Project, error) {
+	sessionLogger.Debug("Entering Close MATLAB Project Usecase")
+	defer sessionLogger.Debug("Exiting Close MATLAB Project Usecase")
+
+	return u.projectManager.Close(ctx, sessionLogger, client)
+}
+
+// ListFiles lists the files of the open MATLAB project with their labels.
+func (u *Usecase) ListFiles(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]File, error) {
+	sessionLogger.Debug("Entering List MATLAB Project Files Usecase")
+	defer sessionLogger.Debug("Exiting List MATLAB Project Files Usecase")
+
+	return u.projectManager.ListFiles(ctx, sessionLogger, client)
+}
+
+// RunChecks runs the checks of the open MATLAB project.
+func (u *Usecase) RunChecks(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]CheckResult, error) {
+	sessionLogger.Debug("Entering Run MATLAB Project Checks Usecase")
+	defer sessionLogger.Debug("Exiting Run MATLAB Project Checks Usecase")
+
+	return u.projectManager.RunChecks(ctx, sessionLogger, client)
+}
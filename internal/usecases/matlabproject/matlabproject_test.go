@@ -0,0 +1,316 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabproject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/matlabproject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	// Act
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_Open_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const projectPath = "/home/user/weather/Weather.prj"
+	expectedProject := matlabproject.Project{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABProject(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockProjectManager.EXPECT().
+		Open(ctx, mockLogger.AsMockArg(), mockClient, projectPath).
+		Return(expectedProject, nil).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	project, err := usecase.Open(ctx, mockLogger, mockClient, projectPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedProject, project)
+}
+
+func TestUsecase_Open_DetectsProject(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const detectedProjectPath = "/home/user/weather/Weather.prj"
+	expectedProject := matlabproject.Project{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}
+
+	mockMATLABProjectDetector.EXPECT().
+		DetectMATLABProject(mockLogger.AsMockArg()).
+		Return(detectedProjectPath).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABProject(detectedProjectPath).
+		Return(detectedProjectPath, nil).
+		Once()
+
+	mockProjectManager.EXPECT().
+		Open(ctx, mockLogger.AsMockArg(), mockClient, detectedProjectPath).
+		Return(expectedProject, nil).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	project, err := usecase.Open(ctx, mockLogger, mockClient, "")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedProject, project)
+}
+
+func TestUsecase_Open_NoProjectFound(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABProjectDetector.EXPECT().
+		DetectMATLABProject(mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	project, err := usecase.Open(t.Context(), mockLogger, mockClient, "")
+
+	// Assert
+	require.ErrorIs(t, err, matlabproject.ErrNoProjectFound)
+	assert.Empty(t, project)
+}
+
+func TestUsecase_Open_InvalidPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	const projectPath = "/home/user/weather/forecast.m"
+	expectedError := errors.New("path must be a MATLAB project .prj file or the root folder of a MATLAB project")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABProject(projectPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	project, err := usecase.Open(t.Context(), mockLogger, mockClient, projectPath)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, project)
+}
+
+func TestUsecase_Close_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedProject := matlabproject.Project{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}
+
+	mockProjectManager.EXPECT().
+		Close(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(expectedProject, nil).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	project, err := usecase.Close(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedProject, project)
+}
+
+func TestUsecase_ListFiles_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedFiles := []matlabproject.File{
+		{Path: "/home/user/weather/forecast.m", Labels: []matlabproject.Label{{Category: "Classification", Name: "Design"}}},
+	}
+
+	mockProjectManager.EXPECT().
+		ListFiles(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(expectedFiles, nil).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	files, err := usecase.ListFiles(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFiles, files)
+}
+
+func TestUsecase_RunChecks_Error(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := errors.New("No MATLAB project is open. Open a project first.")
+
+	mockProjectManager.EXPECT().
+		RunChecks(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil, expectedError).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	results, err := usecase.RunChecks(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, results)
+}
+
+func TestUsecase_RunChecks_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockMATLABProjectDetector := &mocks.MockMATLABProjectDetector{}
+	defer mockMATLABProjectDetector.AssertExpectations(t)
+
+	mockProjectManager := &mocks.MockProjectManager{}
+	defer mockProjectManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedResults := []matlabproject.CheckResult{
+		{ID: "Project:Checks:UnsavedFiles", Description: "All project files are saved.", Passed: false, ProblemFiles: []string{"/home/user/weather/forecast.m"}},
+	}
+
+	mockProjectManager.EXPECT().
+		RunChecks(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(expectedResults, nil).
+		Once()
+
+	usecase := matlabproject.New(mockPathValidator, mockMATLABProjectDetector, mockProjectManager)
+
+	// Act
+	results, err := usecase.RunChecks(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResults, results)
+}
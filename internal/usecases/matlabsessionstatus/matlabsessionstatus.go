@@ -0,0 +1,35 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstatus
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+type SessionStatusReader interface {
+	Statuses() []entities.MATLABSessionStatus
+}
+
+type Usecase struct {
+	sessionStatusReader SessionStatusReader
+}
+
+type ReturnArgs []entities.MATLABSessionStatus
+
+func New(
+	sessionStatusReader SessionStatusReader,
+) *Usecase {
+	return &Usecase{
+		sessionStatusReader: sessionStatusReader,
+	}
+}
+
+// Execute returns the status of each running MATLAB session, without waiting for busy sessions.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) ReturnArgs {
+	sessionLogger.Debug("Entering MATLABSessionStatus Usecase")
+	defer sessionLogger.Debug("Exiting MATLABSessionStatus Usecase")
+
+	return u.sessionStatusReader.Statuses()
+}
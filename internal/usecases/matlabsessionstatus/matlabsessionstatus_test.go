@@ -0,0 +1,52 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package matlabsessionstatus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabsessionstatus"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/matlabsessionstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSessionStatusReader := &mocks.MockSessionStatusReader{}
+	defer mockSessionStatusReader.AssertExpectations(t)
+
+	// Act
+	usecase := matlabsessionstatus.New(mockSessionStatusReader)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionStatusReader := &mocks.MockSessionStatusReader{}
+	defer mockSessionStatusReader.AssertExpectations(t)
+
+	expectedStatuses := []entities.MATLABSessionStatus{
+		{SessionID: 1, Busy: true, ToolName: "evaluate_matlab_code", RunningFor: 3 * time.Second, QueueLength: 2},
+		{SessionID: 2},
+	}
+
+	mockSessionStatusReader.EXPECT().
+		Statuses().
+		Return(expectedStatuses).
+		Once()
+
+	usecase := matlabsessionstatus.New(mockSessionStatusReader)
+
+	// Act
+	result := usecase.Execute(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, matlabsessionstatus.ReturnArgs(expectedStatuses), result)
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+// profileInfo is the part of the output of profile('info') that the results use.
+type profileInfo struct {
+	FunctionTable json.RawMessage `json:"FunctionTable"`
+}
+
+type functionEntry struct {
+	FunctionName      string          `json:"FunctionName"`
+	FileName          string          `json:"FileName"`
+	Type              string          `json:"Type"`
+	NumCalls          int             `json:"NumCalls"`
+	TotalTime         float64         `json:"TotalTime"`
+	Children          json.RawMessage `json:"Children"`
+	ExecutedLines     json.RawMessage `json:"ExecutedLines"`
+	TotalMemAllocated float64         `json:"TotalMemAllocated"`
+	TotalMemFreed     float64         `json:"TotalMemFreed"`
+	PeakMem           float64         `json:"PeakMem"`
+}
+
+type childEntry struct {
+	TotalTime float64 `json:"TotalTime"`
+}
+
+type profiledFunction struct {
+	stats FunctionStats
+	lines []LineStats
+}
+
+func parseProfileInfo(text string) ([]profiledFunction, error) {
+	var info profileInfo
+	if err := json.Unmarshal([]byte(strings.TrimSpace(text)), &info); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProfileInfo, err)
+	}
+
+	var entries []functionEntry
+	if err := decodeStructArray(info.FunctionTable, &entries); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProfileInfo, err)
+	}
+
+	functions := make([]profiledFunction, len(entries))
+	for i, entry := range entries {
+		var children []childEntry
+		if err := decodeStructArray(entry.Children, &children); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidProfileInfo, err)
+		}
+
+		childrenTime := 0.0
+		for _, child := range children {
+			childrenTime += child.TotalTime
+		}
+
+		executedLines, err := decodeExecutedLines(entry.ExecutedLines)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidProfileInfo, err)
+		}
+
+		function := profiledFunction{
+			stats: FunctionStats{
+				Name:      entry.FunctionName,
+				File:      entry.FileName,
+				Type:      entry.Type,
+				Calls:     entry.NumCalls,
+				TotalTime: entry.TotalTime,
+				SelfTime:  max(entry.TotalTime-childrenTime, 0),
+				Memory: &MemoryStats{
+					AllocatedBytes: entry.TotalMemAllocated,
+					FreedBytes:     entry.TotalMemFreed,
+					PeakBytes:      entry.PeakMem,
+				},
+			},
+		}
+
+		// Each row of ExecutedLines is the line number, the number of calls, and the time spent on the line
+		for _, row := range executedLines {
+			if len(row) < 3 {
+				continue
+			}
+			function.lines = append(function.lines, LineStats{
+				Function: entry.FunctionName,
+				File:     entry.FileName,
+				Line:     int(row[0]),
+				Calls:    int(row[1]),
+				Time:     row[2],
+			})
+		}
+
+		functions[i] = function
+	}
+
+	return functions, nil
+}
+
+func (u *Usecase) summarize(sessionLogger entities.Logger, result *ReturnArgs, functions []profiledFunction, topN int, includeMemory bool) {
+	stats := make([]FunctionStats, len(functions))
+	var lines []LineStats
+
+	for i, function := range functions {
+		stats[i] = function.stats
+		if !includeMemory {
+			stats[i].Memory = nil
+		}
+
+		result.TotalTime += function.stats.SelfTime
+		lines = append(lines, function.lines...)
+	}
+
+	result.FunctionCount = len(stats)
+
+	bySelfTime := slices.Clone(stats)
+	slices.SortStableFunc(bySelfTime, func(a, b FunctionStats) int { return cmp.Compare(b.SelfTime, a.SelfTime) })
+	result.FunctionsBySelfTime = bySelfTime[:min(topN, len(bySelfTime))]
+
+	byTotalTime := slices.Clone(stats)
+	slices.SortStableFunc(byTotalTime, func(a, b FunctionStats) int { return cmp.Compare(b.TotalTime, a.TotalTime) })
+	result.FunctionsByTotalTime = byTotalTime[:min(topN, len(byTotalTime))]
+
+	slices.SortStableFunc(lines, func(a, b LineStats) int { return cmp.Compare(b.Time, a.Time) })
+	result.Lines = lines[:min(topN, len(lines))]
+
+	sources := map[string][]string{}
+	for i, line := range result.Lines {
+		result.Lines[i].Source = u.sourceLine(sessionLogger, sources, line.File, line.Line)
+	}
+}
+
+// sourceLine returns the text of a line of a file, reading each file once.
+func (u *Usecase) sourceLine(sessionLogger entities.Logger, sources map[string][]string, file string, line int) string {
+	if file == "" {
+		return ""
+	}
+
+	fileLines, ok := sources[file]
+	if !ok {
+		content, err := u.osLayer.ReadFile(file)
+		if err != nil {
+			sessionLogger.WithError(err).With("file", file).Debug("Failed to read profiled file")
+		} else {
+			fileLines = strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+		}
+		sources[file] = fileLines
+	}
+
+	if line < 1 || line > len(fileLines) {
+		return ""
+	}
+
+	return strings.TrimSpace(fileLines[line-1])
+}
+
+// decodeStructArray decodes the JSON of a MATLAB struct array, which jsonencode writes as an object when it has one element.
+func decodeStructArray(raw json.RawMessage, target any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, target)
+}
+
+// decodeExecutedLines decodes an N-by-3 matrix, which jsonencode writes as a flat array when it has one row.
+func decodeExecutedLines(raw json.RawMessage) ([][]float64, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var rows [][]float64
+	if err := json.Unmarshal(raw, &rows); err == nil {
+		return rows, nil
+	}
+
+	var row []float64
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return nil, err
+	}
+	return [][]float64{row}, nil
+}
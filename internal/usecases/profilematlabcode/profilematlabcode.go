@@ -0,0 +1,210 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathextractor"
+)
+
+const (
+	DefaultTopN = 10
+	MaxTopN     = 100
+
+	startCode       = "profile('off'); profile('clear'); profile('on')"
+	startMemoryCode = "profile('off'); profile('clear'); profile('-memory', 'on')"
+	infoCode        = "profile('off'); disp(jsonencode(profile('info')))"
+	cleanupCode     = "profile('off'); profile('clear')"
+)
+
+var (
+	ErrNoCode             = errors.New("provide either a script path or code")
+	ErrScriptPathAndCode  = errors.New("provide either a script path or code, not both")
+	ErrInvalidTopN        = errors.New("invalid number of functions and lines to return")
+	ErrInvalidProfileInfo = errors.New("failed to read the profiler results")
+)
+
+type Args struct {
+	Code       string
+	ScriptPath string
+	// TopN is the number of functions and lines to return. Zero means DefaultTopN.
+	TopN          int
+	IncludeMemory bool
+}
+
+type ReturnArgs struct {
+	ConsoleOutput string
+	// Error is the message of the error the code raised, if any. The results cover the code that ran before the error.
+	Error string
+	// TotalTime is the sum of the self time of all profiled functions, in seconds.
+	TotalTime     float64
+	FunctionCount int
+	// FunctionsBySelfTime and FunctionsByTotalTime hold the top functions, slowest first.
+	FunctionsBySelfTime  []FunctionStats
+	FunctionsByTotalTime []FunctionStats
+	// Lines holds the top lines by time across all functions, slowest first.
+	Lines []LineStats
+}
+
+type FunctionStats struct {
+	Name      string
+	File      string
+	Type      string
+	Calls     int
+	TotalTime float64
+	SelfTime  float64
+	// Memory is only set when memory statistics are requested.
+	Memory *MemoryStats
+}
+
+type MemoryStats struct {
+	AllocatedBytes float64
+	FreedBytes     float64
+	PeakBytes      float64
+}
+
+type LineStats struct {
+	Function string
+	File     string
+	Line     int
+	Calls    int
+	Time     float64
+	// Source is the text of the line, when the file can be read.
+	Source string
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type CodePolicy interface {
+	Check(code string) error
+	CheckFile(filePath string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+	codePolicy    CodePolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+		codePolicy:    codePolicy,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ProfileMATLABCode Usecase")
+	defer sessionLogger.Debug("Exiting ProfileMATLABCode Usecase")
+
+	topN := request.TopN
+	if topN == 0 {
+		topN = DefaultTopN
+	}
+	if topN < 1 || topN > MaxTopN {
+		return ReturnArgs{}, fmt.Errorf("%w: %d, must be between 1 and %d", ErrInvalidTopN, topN, MaxTopN)
+	}
+
+	code, err := u.codeToProfile(ctx, sessionLogger, client, request)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	start := startCode
+	if request.IncludeMemory {
+		start = startMemoryCode
+	}
+
+	if _, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: start}); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	// Stop and clear the profiler whatever happens to the code, even when the request was cancelled.
+	// The cleanup runs before the call ends, so that it keeps the session queue and no other call runs under the profiler.
+	defer cleanUp(context.WithoutCancel(ctx), sessionLogger, client)
+
+	result := ReturnArgs{}
+
+	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: code})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ReturnArgs{}, err
+		}
+
+		// The results still cover the code that ran before the error
+		result.Error = err.Error()
+	}
+	result.ConsoleOutput = response.ConsoleOutput
+
+	info, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: infoCode})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	functions, err := parseProfileInfo(info.ConsoleOutput)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	u.summarize(sessionLogger, &result, functions, topN, request.IncludeMemory)
+
+	return result, nil
+}
+
+func cleanUp(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) {
+	if _, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: cleanupCode}); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to clear the profiler")
+	}
+}
+
+func (u *Usecase) codeToProfile(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (string, error) {
+	switch {
+	case request.ScriptPath != "" && request.Code != "":
+		return "", ErrScriptPathAndCode
+	case request.Code != "":
+		if err := u.codePolicy.Check(request.Code); err != nil {
+			sessionLogger.WithError(err).Warn("Code rejected by code policy")
+			return "", err
+		}
+		return request.Code, nil
+	case request.ScriptPath == "":
+		return "", ErrNoCode
+	}
+
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	if err != nil {
+		return "", err
+	}
+
+	if err := u.codePolicy.CheckFile(validatedPath); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return "", err
+	}
+
+	// Run the script from its folder, as run_matlab_file does
+	scriptDir, scriptName := pathextractor.ExtractPathComponents(validatedPath)
+	_, err = client.Eval(ctx, sessionLogger, entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", matlabstring.EscapeSingleQuotes(scriptDir)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return scriptName, nil
+}
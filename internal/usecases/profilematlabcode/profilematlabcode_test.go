@@ -0,0 +1,547 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package profilematlabcode_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/profilematlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/profilematlabcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	startCode       = "profile('off'); profile('clear'); profile('on')"
+	startMemoryCode = "profile('off'); profile('clear'); profile('-memory', 'on')"
+	infoCode        = "profile('off'); disp(jsonencode(profile('info')))"
+	cleanupCode     = "profile('off'); profile('clear')"
+
+	// profileInfo has two functions: simulate, which spends 2 of its 2.5 seconds in step, and step.
+	// The executed lines of step have a single row, which jsonencode writes as a flat array.
+	profileInfo = `{"FunctionTable":[` +
+		`{"FunctionName":"simulate","FileName":"/work/simulate.m","Type":"M-function","NumCalls":1,"TotalTime":2.5,` +
+		`"Children":[{"Index":2,"NumCalls":10,"TotalTime":2}],"ExecutedLines":[[3,1,0.4],[4,10,2.05]],` +
+		`"TotalMemAllocated":1024,"TotalMemFreed":512,"PeakMem":768},` +
+		`{"FunctionName":"step","FileName":"/work/step.m","Type":"M-function","NumCalls":10,"TotalTime":2,` +
+		`"Children":[],"ExecutedLines":[2,10,1.9],"TotalMemAllocated":2048,"TotalMemFreed":2048,"PeakMem":256}]}`
+
+	simulateSource = "function simulate()\n% Simulate\nx = setup();\nfor i = 1:10, step(x); end\n"
+	stepSource     = "function step(x)\nx = x * 2;\n"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_Execute_Code_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "simulate()"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{ConsoleOutput: "done"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: infoCode}).
+		Return(entities.EvalResponse{ConsoleOutput: profileInfo + "\n"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/work/simulate.m").
+		Return([]byte(simulateSource), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/work/step.m").
+		Return([]byte(stepSource), nil).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code, TopN: 2})
+
+	// Assert
+	require.NoError(t, err)
+
+	simulate := profilematlabcode.FunctionStats{Name: "simulate", File: "/work/simulate.m", Type: "M-function", Calls: 1, TotalTime: 2.5, SelfTime: 0.5}
+	step := profilematlabcode.FunctionStats{Name: "step", File: "/work/step.m", Type: "M-function", Calls: 10, TotalTime: 2, SelfTime: 2}
+
+	assert.Equal(t, profilematlabcode.ReturnArgs{
+		ConsoleOutput:        "done",
+		TotalTime:            2.5,
+		FunctionCount:        2,
+		FunctionsBySelfTime:  []profilematlabcode.FunctionStats{step, simulate},
+		FunctionsByTotalTime: []profilematlabcode.FunctionStats{simulate, step},
+		Lines: []profilematlabcode.LineStats{
+			{Function: "simulate", File: "/work/simulate.m", Line: 4, Calls: 10, Time: 2.05, Source: "for i = 1:10, step(x); end"},
+			{Function: "step", File: "/work/step.m", Line: 2, Calls: 10, Time: 1.9, Source: "x = x * 2;"},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_IncludeMemory(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "step(1)"
+	// A single function, which jsonencode writes as an object
+	const singleFunctionInfo = `{"FunctionTable":{"FunctionName":"step","FileName":"/work/step.m","Type":"M-function","NumCalls":1,` +
+		`"TotalTime":0.2,"Children":[],"ExecutedLines":[2,1,0.2],"TotalMemAllocated":2048,"TotalMemFreed":1024,"PeakMem":256}}`
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startMemoryCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: infoCode}).
+		Return(entities.EvalResponse{ConsoleOutput: singleFunctionInfo}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile("/work/step.m").
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code, IncludeMemory: true})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.FunctionsBySelfTime, 1)
+	assert.Equal(t, &profilematlabcode.MemoryStats{AllocatedBytes: 2048, FreedBytes: 1024, PeakBytes: 256}, result.FunctionsBySelfTime[0].Memory)
+	assert.Equal(t, []profilematlabcode.LineStats{
+		{Function: "step", File: "/work/step.m", Line: 2, Calls: 1, Time: 0.2},
+	}, result.Lines, "Lines should have no source when the file cannot be read")
+}
+
+func TestUsecase_Execute_ScriptPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const scriptPath = "/work/o'brien/analysis.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('/work/o''brien')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "analysis"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: infoCode}).
+		Return(entities.EvalResponse{ConsoleOutput: `{"FunctionTable":[]}`}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, result.FunctionCount)
+	assert.Empty(t, result.Lines)
+}
+
+func TestUsecase_Execute_CodeErrorStillReturnsResults(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "simulate()"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{ConsoleOutput: "partial"}, assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: infoCode}).
+		Return(entities.EvalResponse{ConsoleOutput: profileInfo}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(mock.Anything).
+		Return(nil, assert.AnError)
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, assert.AnError.Error(), result.Error)
+	assert.Equal(t, "partial", result.ConsoleOutput)
+	assert.Equal(t, 2, result.FunctionCount)
+	assert.Len(t, result.Lines, 3)
+}
+
+func TestUsecase_Execute_InvalidProfileInfo(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "simulate()"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: infoCode}).
+		Return(entities.EvalResponse{ConsoleOutput: "not json"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, profilematlabcode.ErrInvalidProfileInfo)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_StartFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const code = "simulate()"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ContextCancelled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	const code = "simulate()"
+	cleanedUp := false
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: startCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Run(func(context.Context, entities.Logger, entities.EvalRequest) { cancel() }).
+		Return(entities.EvalResponse{}, context.Canceled).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: cleanupCode}).
+		Run(func(cleanupCtx context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			assert.NoError(t, cleanupCtx.Err(), "The cleanup should run even when the request was cancelled")
+			cleanedUp = true
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, result)
+	assert.True(t, cleanedUp, "The cleanup should run before the call ends")
+}
+
+func TestUsecase_Execute_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     profilematlabcode.Args
+		expectedErr error
+	}{
+		{name: "NoCode", request: profilematlabcode.Args{}, expectedErr: profilematlabcode.ErrNoCode},
+		{name: "ScriptPathAndCode", request: profilematlabcode.Args{Code: "x = 1;", ScriptPath: "/work/analysis.m"}, expectedErr: profilematlabcode.ErrScriptPathAndCode},
+		{name: "NegativeTopN", request: profilematlabcode.Args{Code: "x = 1;", TopN: -1}, expectedErr: profilematlabcode.ErrInvalidTopN},
+		{name: "TopNTooLarge", request: profilematlabcode.Args{Code: "x = 1;", TopN: profilematlabcode.MaxTopN + 1}, expectedErr: profilematlabcode.ErrInvalidTopN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, tt.request)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_RejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	const code = "delete('*')"
+
+	mockCodePolicy.EXPECT().
+		Check(code).
+		Return(assert.AnError).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, profilematlabcode.Args{Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
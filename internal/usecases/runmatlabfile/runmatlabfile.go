@@ -5,30 +5,51 @@ package runmatlabfile
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/figureoptions"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathextractor"
 )
 
+const liveScriptExtension = ".mlx"
+
 type Args struct {
 	ScriptPath    string
 	CaptureOutput bool
+	Figures       entities.FigureOptions
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABCodeFile(filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	Check(code string) error
+	CheckFile(filePath string) error
+}
+
+type LiveScriptConverter interface {
+	Code(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string) (string, error)
 }
 
 type Usecase struct {
-	pathValidator PathValidator
+	pathValidator       PathValidator
+	codePolicy          CodePolicy
+	liveScriptConverter LiveScriptConverter
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
+	liveScriptConverter LiveScriptConverter,
 ) *Usecase {
 	return &Usecase{
-		pathValidator: pathValidator,
+		pathValidator:       pathValidator,
+		codePolicy:          codePolicy,
+		liveScriptConverter: liveScriptConverter,
 	}
 }
 
@@ -36,13 +57,34 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering RunMATLABFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	if err := figureoptions.Validate(request.Figures); err != nil {
+		return entities.EvalResponse{}, err
+	}
+
+	validatedPath, err := u.pathValidator.ValidateMATLABCodeFile(request.ScriptPath)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
 	scriptDir, scriptName := pathextractor.ExtractPathComponents(validatedPath)
 
+	// Live scripts are run as the code that the Live Editor extracts from them,
+	// so that the code policy checks that code and its outputs are captured like any other code.
+	code := scriptName
+	if filepath.Ext(validatedPath) == liveScriptExtension {
+		code, err = u.liveScriptConverter.Code(ctx, sessionLogger, client, validatedPath)
+		if err != nil {
+			return entities.EvalResponse{}, err
+		}
+		err = u.codePolicy.Check(code)
+	} else {
+		err = u.codePolicy.CheckFile(validatedPath)
+	}
+	if err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return entities.EvalResponse{}, err
+	}
+
 	_, err = client.Eval(ctx, sessionLogger, entities.EvalRequest{
 		Code: fmt.Sprintf("cd('%s')", matlabstring.EscapeSingleQuotes(scriptDir)),
 	})
@@ -51,11 +93,15 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	}
 
 	runCodeRequest := entities.EvalRequest{
-		Code: scriptName,
+		Code: code,
 	}
 
 	if request.CaptureOutput {
-		return client.EvalWithCapture(ctx, sessionLogger, runCodeRequest)
+		runCodeRequest.Figures = request.Figures
+		response, err := client.EvalWithCapture(ctx, sessionLogger, runCodeRequest)
+		return debugmode.LeaveIfPausedAfterCapture(ctx, sessionLogger, client, response, err)
 	}
-	return client.Eval(ctx, sessionLogger, runCodeRequest)
+
+	response, err := client.Eval(ctx, sessionLogger, runCodeRequest)
+	return debugmode.LeaveIfPaused(ctx, sessionLogger, client, response, err)
 }
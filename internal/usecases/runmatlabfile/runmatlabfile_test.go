@@ -11,6 +11,7 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/figureoptions"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/runmatlabfile"
 	"github.com/stretchr/testify/assert"
@@ -22,8 +23,14 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	// Act
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -36,6 +43,12 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -60,10 +73,15 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -74,7 +92,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -91,6 +109,12 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -116,10 +140,15 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -130,7 +159,7 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -140,13 +169,19 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 	assert.Equal(t, expectedResponse, response, "Response should match expected value")
 }
 
-func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
+func TestUsecase_Execute_ValidateMATLABCodeFileError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -159,11 +194,11 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return("", expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -180,6 +215,12 @@ func TestUsecase_Execute_CdEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -196,16 +237,21 @@ func TestUsecase_Execute_CdEvalError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -222,6 +268,12 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -242,10 +294,15 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -256,7 +313,7 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -273,6 +330,12 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -301,10 +364,15 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -315,7 +383,12 @@ func TestUsecase_Execute_CaptureOutput_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -332,6 +405,12 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -356,10 +435,15 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABCodeFile(scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
 		Return(entities.EvalResponse{}, nil).
@@ -370,7 +454,12 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabfile.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -379,3 +468,308 @@ func TestUsecase_Execute_CaptureOutput_EvalWithCaptureError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response, "Response should be empty")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "file.m")
+	expectedError := assert.AnError
+
+	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the code policy error")
+	assert.Empty(t, response, "Response should be empty when the file is rejected")
+}
+
+func TestUsecase_Execute_LiveScript_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptDir := filepath.Join("some", "path", "to")
+	scriptPath := filepath.Join(scriptDir, "report.mlx")
+	const liveScriptCode = "%% Results\nx = magic(3)\nplot(x)\n"
+
+	usecaseRequest := runmatlabfile.Args{
+		ScriptPath:    scriptPath,
+		CaptureOutput: true,
+	}
+
+	expectedCdRequest := entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", scriptDir),
+	}
+
+	expectedEvalRequest := entities.EvalRequest{
+		Code: liveScriptCode,
+	}
+
+	expectedResponse := entities.EvalResponse{
+		ConsoleOutput: "x =\n     8     1     6",
+		Images:        [][]byte{[]byte("png")},
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Code(ctx, mockLogger.AsMockArg(), mockClient, scriptPath).
+		Return(liveScriptCode, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(liveScriptCode).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
+		Return(expectedResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, expectedResponse, response, "Response should match expected value")
+}
+
+func TestUsecase_Execute_LiveScript_CodeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "report.mlx")
+	expectedError := fmt.Errorf("unable to open the live script")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Code(ctx, mockLogger.AsMockArg(), mockClient, scriptPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Execute should return the conversion error")
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_LiveScript_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "report.mlx")
+	const liveScriptCode = "system('rm -rf /')"
+	expectedError := fmt.Errorf("code policy violation")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockLiveScriptConverter.EXPECT().
+		Code(ctx, mockLogger.AsMockArg(), mockClient, scriptPath).
+		Return(liveScriptCode, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(liveScriptCode).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Execute should return the code policy error")
+	assert.Empty(t, response, "Response should be empty")
+}
+
+func TestUsecase_Execute_CaptureOutput_FigureOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptDir := filepath.Join("some", "path", "to")
+	scriptPath := filepath.Join(scriptDir, "report.m")
+	figures := entities.FigureOptions{Format: entities.FigureFormatSVG, Width: 640, Height: 480}
+
+	usecaseRequest := runmatlabfile.Args{
+		ScriptPath:    scriptPath,
+		CaptureOutput: true,
+		Figures:       figures,
+	}
+
+	expectedResponse := entities.EvalResponse{
+		Images: [][]byte{[]byte("<svg/>")},
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABCodeFile(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: fmt.Sprintf("cd('%s')", scriptDir)}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "report", Figures: figures}).
+		Return(expectedResponse, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestUsecase_Execute_InvalidFigureOptions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockLiveScriptConverter := &mocks.MockLiveScriptConverter{}
+	defer mockLiveScriptConverter.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecaseRequest := runmatlabfile.Args{
+		ScriptPath:    filepath.Join("some", "path", "to", "report.m"),
+		CaptureOutput: true,
+		Figures:       entities.FigureOptions{Width: -1},
+	}
+
+	usecase := runmatlabfile.New(mockPathValidator, mockCodePolicy, mockLiveScriptConverter)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorIs(t, err, figureoptions.ErrInvalidSize)
+	assert.Empty(t, response)
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathextractor"
+)
+
+var (
+	ErrNoCode              = errors.New("provide either a script path or a list of cells")
+	ErrScriptPathAndCells  = errors.New("provide either a script path or a list of cells, not both")
+	ErrNoSections          = errors.New("the code has no sections to run")
+	ErrInvalidSectionRange = errors.New("invalid section range")
+)
+
+type Args struct {
+	ScriptPath string
+	Cells      []string
+	// StartSection and EndSection select the 1-based, inclusive range of sections to run.
+	// Zero means the first and the last section respectively.
+	StartSection    int
+	EndSection      int
+	ContinueOnError bool
+	CaptureOutput   bool
+}
+
+type SectionResult struct {
+	// Number is the 1-based position of the section in the script or list of cells.
+	Number        int
+	Section       Section
+	ConsoleOutput string
+	Images        [][]byte
+	// Error is the message of the error the section raised, if any.
+	Error string
+}
+
+type ReturnArgs struct {
+	TotalSections int
+	Results       []SectionResult
+	// NotRun is the number of sections in the range that did not run because of an error.
+	NotRun int
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type CodePolicy interface {
+	Check(code string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+	codePolicy    CodePolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+		codePolicy:    codePolicy,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering RunMATLABSections Usecase")
+	defer sessionLogger.Debug("Exiting RunMATLABSections Usecase")
+
+	sections, err := u.loadSections(ctx, sessionLogger, client, request)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	first, last, err := sectionRange(len(sections), request.StartSection, request.EndSection)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	result := ReturnArgs{
+		TotalSections: len(sections),
+	}
+
+	for number := first; number <= last; number++ {
+		sectionResult, err := runSection(ctx, sessionLogger, client, number, sections[number-1], request.CaptureOutput)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		result.Results = append(result.Results, sectionResult)
+
+		if sectionResult.Error != "" && !request.ContinueOnError {
+			result.NotRun = last - number
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (u *Usecase) loadSections(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) ([]Section, error) {
+	switch {
+	case request.ScriptPath != "" && len(request.Cells) > 0:
+		return nil, ErrScriptPathAndCells
+	case len(request.Cells) > 0:
+		for i, cell := range request.Cells {
+			if err := u.codePolicy.Check(cell); err != nil {
+				sessionLogger.WithError(err).Warn("Code rejected by code policy")
+				return nil, fmt.Errorf("cell %d: %w", i+1, err)
+			}
+		}
+		return SectionsFromCells(request.Cells), nil
+	case request.ScriptPath == "":
+		return nil, ErrNoCode
+	}
+
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := u.osLayer.ReadFile(validatedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", validatedPath, err)
+	}
+
+	// The whole script is checked, so that line numbers in violations match the file
+	if err := u.codePolicy.Check(string(script)); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return nil, fmt.Errorf("%s: %w", validatedPath, err)
+	}
+
+	sections := SplitSections(string(script))
+	if len(sections) == 0 {
+		return nil, ErrNoSections
+	}
+
+	// Run the sections from the folder of the script, as run_matlab_file does
+	scriptDir, _ := pathextractor.ExtractPathComponents(validatedPath)
+	_, err = client.Eval(ctx, sessionLogger, entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", matlabstring.EscapeSingleQuotes(scriptDir)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+func sectionRange(total, start, end int) (int, int, error) {
+	if start == 0 {
+		start = 1
+	}
+	if end == 0 {
+		end = total
+	}
+
+	if start < 1 || end > total || start > end {
+		return 0, 0, fmt.Errorf("%w: sections %d to %d, the code has %d sections", ErrInvalidSectionRange, start, end, total)
+	}
+
+	return start, end, nil
+}
+
+func runSection(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, number int, section Section, captureOutput bool) (SectionResult, error) {
+	sessionLogger.With("section", number).Debug("Running MATLAB section")
+
+	// Pad the code with blank lines, so that the line numbers MATLAB reports match the lines of the script
+	request := entities.EvalRequest{
+		Code: strings.Repeat("\n", section.StartLine-1) + section.Code,
+	}
+
+	result := SectionResult{
+		Number:  number,
+		Section: section,
+	}
+
+	if captureOutput {
+		response, err := client.EvalWithCapture(ctx, sessionLogger, request)
+		if err != nil {
+			return SectionResult{}, err
+		}
+
+		result.ConsoleOutput = response.ConsoleOutput
+		result.Images = response.Images
+		result.Error = strings.Join(response.Errors, "\n")
+
+		return result, nil
+	}
+
+	response, err := client.Eval(ctx, sessionLogger, request)
+	if err != nil {
+		if ctx.Err() != nil {
+			return SectionResult{}, err
+		}
+
+		// Without capture, errors raised by the code are returned as errors
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	result.ConsoleOutput = response.ConsoleOutput
+	result.Images = response.Images
+
+	return result, nil
+}
@@ -0,0 +1,570 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/runmatlabsections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const script = "%% Load\nx = 1;\n%% Compute\ny = x + 1;\n%% Plot\nplot(y)\n"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_Execute_ScriptPath_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptDir := filepath.Join("some", "path", "to")
+	scriptPath := filepath.Join(scriptDir, "analysis.m")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(script).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: fmt.Sprintf("cd('%s')", scriptDir)}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "\n\n%% Compute\ny = x + 1;"}).
+		Return(entities.EvalResponse{ConsoleOutput: "y = 2"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "\n\n\n\n%% Plot\nplot(y)"}).
+		Return(entities.EvalResponse{Images: [][]byte{[]byte("figure")}}, nil).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabsections.Args{
+		ScriptPath:    scriptPath,
+		StartSection:  2,
+		CaptureOutput: true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runmatlabsections.ReturnArgs{
+		TotalSections: 3,
+		Results: []runmatlabsections.SectionResult{
+			{
+				Number:        2,
+				Section:       runmatlabsections.Section{Title: "Compute", StartLine: 3, EndLine: 4, Code: "%% Compute\ny = x + 1;"},
+				ConsoleOutput: "y = 2",
+			},
+			{
+				Number:  3,
+				Section: runmatlabsections.Section{Title: "Plot", StartLine: 5, EndLine: 6, Code: "%% Plot\nplot(y)"},
+				Images:  [][]byte{[]byte("figure")},
+			},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_StopsAtFirstError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	cells := []string{"x = 1;", "error('failed')", "y = 2;"}
+
+	for _, cell := range cells {
+		mockCodePolicy.EXPECT().
+			Check(cell).
+			Return(nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[0]}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[1]}).
+		Return(entities.EvalResponse{ConsoleOutput: "failed", Errors: []string{"failed"}}, nil).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabsections.Args{
+		Cells:         cells,
+		CaptureOutput: true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "failed", result.Results[1].Error)
+	assert.Equal(t, 1, result.NotRun)
+}
+
+func TestUsecase_Execute_ContinueOnError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	cells := []string{"error('failed')", "y = 2;"}
+
+	for _, cell := range cells {
+		mockCodePolicy.EXPECT().
+			Check(cell).
+			Return(nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[0]}).
+		Return(entities.EvalResponse{Errors: []string{"failed"}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[1]}).
+		Return(entities.EvalResponse{ConsoleOutput: "y = 2"}, nil).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabsections.Args{
+		Cells:           cells,
+		ContinueOnError: true,
+		CaptureOutput:   true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "failed", result.Results[0].Error)
+	assert.Empty(t, result.Results[1].Error)
+	assert.Zero(t, result.NotRun)
+}
+
+func TestUsecase_Execute_WithoutCapture_EvalErrorIsSectionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	cells := []string{"error('failed')", "y = 2;"}
+
+	for _, cell := range cells {
+		mockCodePolicy.EXPECT().
+			Check(cell).
+			Return(nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[0]}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabsections.Args{
+		Cells: cells,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, assert.AnError.Error(), result.Results[0].Error)
+	assert.Equal(t, 1, result.NotRun)
+}
+
+func TestUsecase_Execute_EvalWithCaptureError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	cells := []string{"x = 1;"}
+
+	for _, cell := range cells {
+		mockCodePolicy.EXPECT().
+			Check(cell).
+			Return(nil).
+			Once()
+	}
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: cells[0]}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabsections.Args{
+		Cells:         cells,
+		CaptureOutput: true,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          runmatlabsections.Args
+		expectedError error
+	}{
+		{
+			name:          "NoCode",
+			args:          runmatlabsections.Args{},
+			expectedError: runmatlabsections.ErrNoCode,
+		},
+		{
+			name:          "ScriptPathAndCells",
+			args:          runmatlabsections.Args{ScriptPath: filepath.Join("path", "to", "script.m"), Cells: []string{"x = 1;"}},
+			expectedError: runmatlabsections.ErrScriptPathAndCells,
+		},
+		{
+			name:          "StartAfterEnd",
+			args:          runmatlabsections.Args{Cells: []string{"x = 1;", "y = 2;"}, StartSection: 2, EndSection: 1},
+			expectedError: runmatlabsections.ErrInvalidSectionRange,
+		},
+		{
+			name:          "EndAfterLastSection",
+			args:          runmatlabsections.Args{Cells: []string{"x = 1;"}, EndSection: 2},
+			expectedError: runmatlabsections.ErrInvalidSectionRange,
+		},
+		{
+			name:          "NegativeStart",
+			args:          runmatlabsections.Args{Cells: []string{"x = 1;"}, StartSection: -1},
+			expectedError: runmatlabsections.ErrInvalidSectionRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			if tt.args.ScriptPath == "" {
+				for _, cell := range tt.args.Cells {
+					mockCodePolicy.EXPECT().
+						Check(cell).
+						Return(nil).
+						Once()
+				}
+			}
+
+			usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+			// Act
+			_, err := usecase.Execute(t.Context(), mockLogger, mockClient, tt.args)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestUsecase_Execute_PathValidatorError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("path", "to", "script.txt")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabsections.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_ReadFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("path", "to", "script.m")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabsections.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Execute_EmptyScript(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("path", "to", "script.m")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte("\n"), nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check("\n").
+		Return(nil).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabsections.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, runmatlabsections.ErrNoSections)
+}
+
+func TestUsecase_Execute_ScriptCodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("path", "to", "script.m")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(scriptPath).
+		Return([]byte(script), nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(script).
+		Return(assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabsections.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), scriptPath)
+}
+
+func TestUsecase_Execute_CellCodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	cells := []string{"x = 1;", "system('ls')", "y = 2;"}
+
+	mockCodePolicy.EXPECT().
+		Check(cells[0]).
+		Return(nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check(cells[1]).
+		Return(assert.AnError).
+		Once()
+
+	usecase := runmatlabsections.New(mockPathValidator, mockOSLayer, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockClient, runmatlabsections.Args{Cells: cells})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "cell 2")
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections
+
+import (
+	"strings"
+)
+
+// Section is a part of a MATLAB script that runs on its own.
+// Line numbers are 1-based and refer to the script the section comes from.
+type Section struct {
+	Title     string
+	StartLine int
+	EndLine   int
+	Code      string
+}
+
+// SplitSections splits a MATLAB script on its "%%" section breaks.
+// Code before the first section break is a section of its own, unless it is blank.
+// Section breaks inside block comments are ignored, as MATLAB does.
+func SplitSections(script string) []Section {
+	script = strings.TrimSuffix(strings.ReplaceAll(script, "\r\n", "\n"), "\n")
+	lines := strings.Split(script, "\n")
+
+	var sections []Section
+	current := Section{StartLine: 1}
+	var currentLines []string
+	blockCommentDepth := 0
+
+	flush := func(endLine int) {
+		code := strings.Join(currentLines, "\n")
+		if current.StartLine == 1 && current.Title == "" && strings.TrimSpace(code) == "" {
+			return
+		}
+
+		current.EndLine = endLine
+		current.Code = code
+		sections = append(sections, current)
+	}
+
+	for i, line := range lines {
+		lineNumber := i + 1
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "%{":
+			blockCommentDepth++
+		case trimmed == "%}" && blockCommentDepth > 0:
+			blockCommentDepth--
+		case blockCommentDepth == 0:
+			if title, isBreak := sectionBreakTitle(trimmed); isBreak {
+				if lineNumber > 1 {
+					flush(lineNumber - 1)
+				}
+				current = Section{Title: title, StartLine: lineNumber}
+				currentLines = nil
+			}
+		}
+
+		currentLines = append(currentLines, line)
+	}
+
+	flush(len(lines))
+
+	return sections
+}
+
+// SectionsFromCells turns code cells into sections. Line numbers of each section refer to its own cell.
+func SectionsFromCells(cells []string) []Section {
+	sections := make([]Section, len(cells))
+	for i, cell := range cells {
+		sections[i] = Section{
+			StartLine: 1,
+			EndLine:   strings.Count(cell, "\n") + 1,
+			Code:      cell,
+		}
+	}
+	return sections
+}
+
+func sectionBreakTitle(trimmedLine string) (string, bool) {
+	if trimmedLine == "%%" {
+		return "", true
+	}
+
+	if strings.HasPrefix(trimmedLine, "%% ") || strings.HasPrefix(trimmedLine, "%%\t") {
+		return strings.TrimSpace(trimmedLine[2:]), true
+	}
+
+	return "", false
+}
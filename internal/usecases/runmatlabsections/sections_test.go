@@ -0,0 +1,109 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package runmatlabsections_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		expected []runmatlabsections.Section
+	}{
+		{
+			name:   "NoSectionBreaks",
+			script: "x = 1;\ny = 2;\n",
+			expected: []runmatlabsections.Section{
+				{StartLine: 1, EndLine: 2, Code: "x = 1;\ny = 2;"},
+			},
+		},
+		{
+			name:   "SectionsWithTitles",
+			script: "%% Load\nx = 1;\n%% Plot\nplot(x)\n",
+			expected: []runmatlabsections.Section{
+				{Title: "Load", StartLine: 1, EndLine: 2, Code: "%% Load\nx = 1;"},
+				{Title: "Plot", StartLine: 3, EndLine: 4, Code: "%% Plot\nplot(x)"},
+			},
+		},
+		{
+			name:   "CodeBeforeFirstSectionBreak",
+			script: "clear\n%%\nx = 1;",
+			expected: []runmatlabsections.Section{
+				{StartLine: 1, EndLine: 1, Code: "clear"},
+				{StartLine: 2, EndLine: 3, Code: "%%\nx = 1;"},
+			},
+		},
+		{
+			name:   "BlankLinesBeforeFirstSectionBreak",
+			script: "\n  \n%% First\nx = 1;",
+			expected: []runmatlabsections.Section{
+				{Title: "First", StartLine: 3, EndLine: 4, Code: "%% First\nx = 1;"},
+			},
+		},
+		{
+			name:   "IndentedSectionBreak",
+			script: "x = 1;\n    %% Indented\ny = 2;",
+			expected: []runmatlabsections.Section{
+				{StartLine: 1, EndLine: 1, Code: "x = 1;"},
+				{Title: "Indented", StartLine: 2, EndLine: 3, Code: "    %% Indented\ny = 2;"},
+			},
+		},
+		{
+			name:   "NotASectionBreak",
+			script: "x = 1; %% trailing comment\n%%%not a break\ny = 2;",
+			expected: []runmatlabsections.Section{
+				{StartLine: 1, EndLine: 3, Code: "x = 1; %% trailing comment\n%%%not a break\ny = 2;"},
+			},
+		},
+		{
+			name:   "SectionBreakInsideBlockComment",
+			script: "%% First\n%{\n%% commented out\n%}\nx = 1;\n%% Second\ny = 2;",
+			expected: []runmatlabsections.Section{
+				{Title: "First", StartLine: 1, EndLine: 5, Code: "%% First\n%{\n%% commented out\n%}\nx = 1;"},
+				{Title: "Second", StartLine: 6, EndLine: 7, Code: "%% Second\ny = 2;"},
+			},
+		},
+		{
+			name:   "WindowsLineEndings",
+			script: "%% A\r\nx = 1;\r\n%% B\r\ny = 2;\r\n",
+			expected: []runmatlabsections.Section{
+				{Title: "A", StartLine: 1, EndLine: 2, Code: "%% A\nx = 1;"},
+				{Title: "B", StartLine: 3, EndLine: 4, Code: "%% B\ny = 2;"},
+			},
+		},
+		{
+			name:     "EmptyScript",
+			script:   "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			sections := runmatlabsections.SplitSections(tt.script)
+
+			// Assert
+			assert.Equal(t, tt.expected, sections)
+		})
+	}
+}
+
+func TestSectionsFromCells_HappyPath(t *testing.T) {
+	// Arrange
+	cells := []string{"x = 1;", "y = 2;\nz = 3;"}
+
+	// Act
+	sections := runmatlabsections.SectionsFromCells(cells)
+
+	// Assert
+	assert.Equal(t, []runmatlabsections.Section{
+		{StartLine: 1, EndLine: 1, Code: "x = 1;"},
+		{StartLine: 1, EndLine: 2, Code: "y = 2;\nz = 3;"},
+	}, sections)
+}
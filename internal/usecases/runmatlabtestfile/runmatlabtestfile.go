@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/matlabstring"
 )
 
@@ -18,15 +19,22 @@ type PathValidator interface {
 	ValidateMATLABScript(filePath string) (string, error)
 }
 
+type CodePolicy interface {
+	CheckFile(filePath string) error
+}
+
 type Usecase struct {
 	pathValidator PathValidator
+	codePolicy    CodePolicy
 }
 
 func New(
 	pathValidator PathValidator,
+	codePolicy CodePolicy,
 ) *Usecase {
 	return &Usecase{
 		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
 	}
 }
 
@@ -39,11 +47,17 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 		return entities.EvalResponse{}, err
 	}
 
+	if err := u.codePolicy.CheckFile(validatedPath); err != nil {
+		sessionLogger.WithError(err).Warn("Code rejected by code policy")
+		return entities.EvalResponse{}, err
+	}
+
 	runCodeRequest := entities.EvalRequest{
 		Code: fmt.Sprintf("runtests('%s')", matlabstring.EscapeSingleQuotes(validatedPath)),
 	}
 
 	response, err := client.EvalWithCapture(ctx, sessionLogger, runCodeRequest)
+	response, err = debugmode.LeaveIfPausedAfterCapture(ctx, sessionLogger, client, response, err)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
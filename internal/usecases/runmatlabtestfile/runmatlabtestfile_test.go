@@ -10,9 +10,11 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
 	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
 	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/runmatlabtestfile"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,8 +23,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	// Act
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -35,6 +40,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -62,12 +70,22 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(mockResponse, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -84,6 +102,9 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -111,12 +132,22 @@ func TestUsecase_Execute_EscapesSingleQuotesInPath(t *testing.T) {
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(mockResponse, nil).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -133,6 +164,9 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -147,7 +181,7 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -164,6 +198,9 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -182,12 +219,22 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 		Return(scriptPath, nil).
 		Once()
 
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		EvalWithCapture(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := runmatlabtestfile.New(mockPathValidator)
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
@@ -196,3 +243,94 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, response, "Response should be empty")
 }
+
+func TestUsecase_Execute_CodePolicyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+	expectedError := assert.AnError
+
+	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(expectedError).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the code policy error")
+	assert.Empty(t, response, "Response should be empty when the file is rejected")
+}
+
+func TestUsecase_Execute_PausedInDebugger_QuitsDebugMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	scriptPath := filepath.Join("some", "path", "to", "testFile.m")
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		CheckFile(scriptPath).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		EvalWithCapture(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: fmt.Sprintf("runtests('%s')", scriptPath)}).
+		Return(entities.EvalResponse{ConsoleOutput: "Running typeTests"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	usecase := runmatlabtestfile.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, runmatlabtestfile.Args{ScriptPath: scriptPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Running typeTests\n"+debugmode.PausedNote, response.ConsoleOutput)
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const simulinkFunction = "matlab_mcp.mcpSimulink"
+
+// Actions of the Simulink helper function that the usecase calls.
+const (
+	actionOpenModel      = "openModel"
+	actionListBlocks     = "listBlocks"
+	actionGetBlockParams = "getBlockParams"
+	actionSetBlockParams = "setBlockParams"
+	actionUpdateDiagram  = "updateDiagram"
+	actionSimulate       = "simulate"
+)
+
+// Severities of diagnostics.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+var (
+	ErrNoSystem        = errors.New("provide the name of a loaded model, or the path of a subsystem in it")
+	ErrNoModel         = errors.New("provide the name of a loaded model")
+	ErrNoBlockPath     = errors.New("provide the path of the block, starting with the model name")
+	ErrNoParameters    = errors.New("provide at least one block parameter to set")
+	ErrNoParameterName = errors.New("block parameter names must not be empty")
+)
+
+type Model struct {
+	// Name is the name of the model, with which the other Simulink tools refer to it.
+	Name       string
+	FileName   string
+	BlockCount int
+}
+
+type ListBlocksArgs struct {
+	// System is a model name, or the path of a subsystem in a model.
+	System string
+	// Recursive also lists the blocks inside subsystems.
+	Recursive bool
+}
+
+type Block struct {
+	Path      string
+	Name      string
+	BlockType string
+}
+
+type GetBlockParamsArgs struct {
+	BlockPath string
+	// Names restricts the parameters to return. Empty means all dialog parameters of the block.
+	Names []string
+}
+
+type BlockParameter struct {
+	Name string
+	// Value is the value of the parameter as text. Numeric values are written with mat2str.
+	Value string
+}
+
+type SetBlockParamsArgs struct {
+	BlockPath  string
+	Parameters []BlockParameter
+}
+
+type Diagnostic struct {
+	Severity   string
+	Message    string
+	Identifier string
+	// Blocks are the paths of the blocks that Simulink reports the diagnostic for.
+	Blocks []string
+}
+
+type UpdateDiagramReturnArgs struct {
+	Model       string
+	Succeeded   bool
+	Diagnostics []Diagnostic
+}
+
+type SimulateArgs struct {
+	Model string
+	// StopTime overrides the stop time of the model, as a MATLAB expression. Empty means the stop time of the model.
+	StopTime string
+}
+
+// SignalSummary summarizes a logged signal over all its samples and elements.
+type SignalSummary struct {
+	Name string
+	// BlockPath is the block that logs the signal, or the scope that displays it.
+	BlockPath string
+	Samples   int
+	StartTime float64
+	EndTime   float64
+	Min       float64
+	Max       float64
+	Mean      float64
+	Final     []float64
+}
+
+type SimulateReturnArgs struct {
+	Model string
+	// SimulationTime is the last time step that the simulation reached.
+	SimulationTime float64
+	Signals        []SignalSummary
+	Diagnostics    []Diagnostic
+	// Image is a PNG image that plots the data of the scopes of the model. It is empty when the model has no scopes.
+	Image []byte
+}
+
+type PathValidator interface {
+	ValidateSimulinkModel(filePath string) (string, error)
+}
+
+type CodePolicy interface {
+	Check(code string) error
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+	codePolicy    CodePolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codePolicy CodePolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+		codePolicy:    codePolicy,
+	}
+}
+
+// OpenModel loads a model without opening it in the Simulink Editor.
+func (u *Usecase) OpenModel(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, modelPath string) (Model, error) {
+	sessionLogger.Debug("Entering OpenModel Usecase")
+	defer sessionLogger.Debug("Exiting OpenModel Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateSimulinkModel(modelPath)
+	if err != nil {
+		return Model{}, err
+	}
+
+	var model Model
+	if err := callSimulink(ctx, sessionLogger, client, &model, actionOpenModel, validatedPath); err != nil {
+		return Model{}, err
+	}
+
+	return model, nil
+}
+
+// ListBlocks lists the blocks of a model or a subsystem. It loads the model when it is on the MATLAB path but not loaded.
+func (u *Usecase) ListBlocks(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request ListBlocksArgs) ([]Block, error) {
+	sessionLogger.Debug("Entering ListBlocks Usecase")
+	defer sessionLogger.Debug("Exiting ListBlocks Usecase")
+
+	if request.System == "" {
+		return nil, ErrNoSystem
+	}
+
+	blocks := []Block{}
+	if err := callSimulink(ctx, sessionLogger, client, &blocks, actionListBlocks, request.System, strconv.FormatBool(request.Recursive)); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// GetBlockParams returns the values of the parameters of a block.
+func (u *Usecase) GetBlockParams(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request GetBlockParamsArgs) ([]BlockParameter, error) {
+	sessionLogger.Debug("Entering GetBlockParams Usecase")
+	defer sessionLogger.Debug("Exiting GetBlockParams Usecase")
+
+	if request.BlockPath == "" {
+		return nil, ErrNoBlockPath
+	}
+
+	names := request.Names
+	if names == nil {
+		names = []string{}
+	}
+
+	namesJSON, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := []BlockParameter{}
+	if err := callSimulink(ctx, sessionLogger, client, &parameters, actionGetBlockParams, request.BlockPath, string(namesJSON)); err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// SetBlockParams sets parameters of a block, and returns their values after setting them.
+// Simulink evaluates parameter values as MATLAB expressions, so each value is checked against the code policy.
+func (u *Usecase) SetBlockParams(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request SetBlockParamsArgs) ([]BlockParameter, error) {
+	sessionLogger.Debug("Entering SetBlockParams Usecase")
+	defer sessionLogger.Debug("Exiting SetBlockParams Usecase")
+
+	if request.BlockPath == "" {
+		return nil, ErrNoBlockPath
+	}
+
+	if len(request.Parameters) == 0 {
+		return nil, ErrNoParameters
+	}
+
+	for _, parameter := range request.Parameters {
+		if parameter.Name == "" {
+			return nil, ErrNoParameterName
+		}
+
+		if err := u.codePolicy.Check(parameter.Value); err != nil {
+			sessionLogger.WithError(err).Warn("Code rejected by code policy")
+			return nil, err
+		}
+	}
+
+	parametersJSON, err := json.Marshal(request.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := []BlockParameter{}
+	if err := callSimulink(ctx, sessionLogger, client, &parameters, actionSetBlockParams, request.BlockPath, string(parametersJSON)); err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// UpdateDiagram compiles a model, and returns the errors and warnings of the compilation as diagnostics.
+// A model that fails to compile is not an error of the usecase: its diagnostics describe the failure.
+func (u *Usecase) UpdateDiagram(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, model string) (UpdateDiagramReturnArgs, error) {
+	sessionLogger.Debug("Entering UpdateDiagram Usecase")
+	defer sessionLogger.Debug("Exiting UpdateDiagram Usecase")
+
+	if model == "" {
+		return UpdateDiagramReturnArgs{}, ErrNoModel
+	}
+
+	var result UpdateDiagramReturnArgs
+	if err := callSimulink(ctx, sessionLogger, client, &result, actionUpdateDiagram, model); err != nil {
+		return UpdateDiagramReturnArgs{}, err
+	}
+
+	if result.Diagnostics == nil {
+		result.Diagnostics = []Diagnostic{}
+	}
+
+	return result, nil
+}
+
+// Simulate simulates a model, and returns summaries of its logged signals and of the data of its scopes.
+// The model itself is not changed: logging is enabled for the simulation only.
+func (u *Usecase) Simulate(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request SimulateArgs) (SimulateReturnArgs, error) {
+	sessionLogger.Debug("Entering Simulate Usecase")
+	defer sessionLogger.Debug("Exiting Simulate Usecase")
+
+	if request.Model == "" {
+		return SimulateReturnArgs{}, ErrNoModel
+	}
+
+	if request.StopTime != "" {
+		if err := u.codePolicy.Check(request.StopTime); err != nil {
+			sessionLogger.WithError(err).Warn("Code rejected by code policy")
+			return SimulateReturnArgs{}, err
+		}
+	}
+
+	var result SimulateReturnArgs
+	if err := callSimulink(ctx, sessionLogger, client, &result, actionSimulate, request.Model, request.StopTime); err != nil {
+		return SimulateReturnArgs{}, err
+	}
+
+	if result.Signals == nil {
+		result.Signals = []SignalSummary{}
+	}
+	if result.Diagnostics == nil {
+		result.Diagnostics = []Diagnostic{}
+	}
+
+	return result, nil
+}
+
+// callSimulink runs an action of the Simulink helper function, and unmarshals the JSON that it returns into target.
+func callSimulink(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, target any, action string, arguments ...string) error {
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   simulinkFunction,
+		Arguments:  append([]string{action}, arguments...),
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs from %s: %d", simulinkFunction, len(response.Outputs))
+	}
+
+	output, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("failed to cast output of %s to string", simulinkFunction)
+	}
+
+	if err := json.Unmarshal([]byte(output), target); err != nil {
+		return fmt.Errorf("failed to parse output of %s %s: %w", simulinkFunction, action, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,591 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulink_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/simulink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const simulinkFunction = "matlab_mcp.mcpSimulink"
+
+func simulinkRequest(arguments ...string) entities.FEvalRequest {
+	return entities.FEvalRequest{
+		Function:   simulinkFunction,
+		Arguments:  arguments,
+		NumOutputs: 1,
+	}
+}
+
+func simulinkResponse(output string) entities.FEvalResponse {
+	return entities.FEvalResponse{Outputs: []any{output}}
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	// Act
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_OpenModel_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const modelPath = "/some/path/controller.slx"
+
+	mockPathValidator.EXPECT().
+		ValidateSimulinkModel(modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("openModel", modelPath)).
+		Return(simulinkResponse(`{"Name":"controller","FileName":"/some/path/controller.slx","BlockCount":12}`), nil).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	model, err := usecase.OpenModel(ctx, mockLogger, mockClient, modelPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.Model{Name: "controller", FileName: modelPath, BlockCount: 12}, model)
+}
+
+func TestUsecase_OpenModel_InvalidPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	const modelPath = "/some/path/controller.m"
+	expectedError := errors.New("file must be a Simulink model .slx or .mdl file")
+
+	mockPathValidator.EXPECT().
+		ValidateSimulinkModel(modelPath).
+		Return("", expectedError).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.OpenModel(t.Context(), mockLogger, mockClient, modelPath)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestUsecase_ListBlocks_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("listBlocks", "controller/Plant", "true")).
+		Return(simulinkResponse(`[{"Path":"controller/Plant/Gain","Name":"Gain","BlockType":"Gain"},{"Path":"controller/Plant/Out1","Name":"Out1","BlockType":"Outport"}]`), nil).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	blocks, err := usecase.ListBlocks(ctx, mockLogger, mockClient, simulink.ListBlocksArgs{System: "controller/Plant", Recursive: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []simulink.Block{
+		{Path: "controller/Plant/Gain", Name: "Gain", BlockType: "Gain"},
+		{Path: "controller/Plant/Out1", Name: "Out1", BlockType: "Outport"},
+	}, blocks)
+}
+
+func TestUsecase_ListBlocks_NoSystem(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.ListBlocks(t.Context(), testutils.NewInspectableLogger(), mockClient, simulink.ListBlocksArgs{})
+
+	// Assert
+	require.ErrorIs(t, err, simulink.ErrNoSystem)
+}
+
+func TestUsecase_GetBlockParams_HappyPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		names         []string
+		expectedNames string
+	}{
+		{
+			name:          "AllDialogParameters",
+			expectedNames: "[]",
+		},
+		{
+			name:          "SomeParameters",
+			names:         []string{"Gain", "SampleTime"},
+			expectedNames: `["Gain","SampleTime"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("getBlockParams", "controller/Gain", tt.expectedNames)).
+				Return(simulinkResponse(`[{"Name":"Gain","Value":"2.5"},{"Name":"SampleTime","Value":"-1"}]`), nil).
+				Once()
+
+			usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			parameters, err := usecase.GetBlockParams(ctx, mockLogger, mockClient, simulink.GetBlockParamsArgs{BlockPath: "controller/Gain", Names: tt.names})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []simulink.BlockParameter{
+				{Name: "Gain", Value: "2.5"},
+				{Name: "SampleTime", Value: "-1"},
+			}, parameters)
+		})
+	}
+}
+
+func TestUsecase_GetBlockParams_NoBlockPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.GetBlockParams(t.Context(), testutils.NewInspectableLogger(), mockClient, simulink.GetBlockParamsArgs{})
+
+	// Assert
+	require.ErrorIs(t, err, simulink.ErrNoBlockPath)
+}
+
+func TestUsecase_SetBlockParams_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		Check("Kp * 2").
+		Return(nil).
+		Once()
+
+	mockCodePolicy.EXPECT().
+		Check("0.01").
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("setBlockParams", "controller/Gain", `[{"Name":"Gain","Value":"Kp * 2"},{"Name":"SampleTime","Value":"0.01"}]`)).
+		Return(simulinkResponse(`[{"Name":"Gain","Value":"Kp * 2"},{"Name":"SampleTime","Value":"0.01"}]`), nil).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	parameters := []simulink.BlockParameter{
+		{Name: "Gain", Value: "Kp * 2"},
+		{Name: "SampleTime", Value: "0.01"},
+	}
+
+	// Act
+	result, err := usecase.SetBlockParams(ctx, mockLogger, mockClient, simulink.SetBlockParamsArgs{BlockPath: "controller/Gain", Parameters: parameters})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, parameters, result)
+}
+
+func TestUsecase_SetBlockParams_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       simulink.SetBlockParamsArgs
+		expectedError error
+	}{
+		{
+			name:          "NoBlockPath",
+			request:       simulink.SetBlockParamsArgs{Parameters: []simulink.BlockParameter{{Name: "Gain", Value: "2"}}},
+			expectedError: simulink.ErrNoBlockPath,
+		},
+		{
+			name:          "NoParameters",
+			request:       simulink.SetBlockParamsArgs{BlockPath: "controller/Gain"},
+			expectedError: simulink.ErrNoParameters,
+		},
+		{
+			name:          "NoParameterName",
+			request:       simulink.SetBlockParamsArgs{BlockPath: "controller/Gain", Parameters: []simulink.BlockParameter{{Value: "2"}}},
+			expectedError: simulink.ErrNoParameterName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			_, err := usecase.SetBlockParams(t.Context(), testutils.NewInspectableLogger(), mockClient, tt.request)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestUsecase_SetBlockParams_RejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := errors.New("code rejected")
+
+	mockCodePolicy.EXPECT().
+		Check("system('rm -rf /')").
+		Return(expectedError).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.SetBlockParams(t.Context(), testutils.NewInspectableLogger(), mockClient, simulink.SetBlockParamsArgs{
+		BlockPath:  "controller/Gain",
+		Parameters: []simulink.BlockParameter{{Name: "Gain", Value: "system('rm -rf /')"}},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestUsecase_UpdateDiagram_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("updateDiagram", "controller")).
+		Return(simulinkResponse(`{"Model":"controller","Succeeded":false,"Diagnostics":[{"Severity":"error","Message":"Undefined variable Kp","Identifier":"Simulink:Parameters:InvParamSetting","Blocks":["controller/Gain"]}]}`), nil).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	result, err := usecase.UpdateDiagram(ctx, mockLogger, mockClient, "controller")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.UpdateDiagramReturnArgs{
+		Model:     "controller",
+		Succeeded: false,
+		Diagnostics: []simulink.Diagnostic{{
+			Severity:   simulink.SeverityError,
+			Message:    "Undefined variable Kp",
+			Identifier: "Simulink:Parameters:InvParamSetting",
+			Blocks:     []string{"controller/Gain"},
+		}},
+	}, result)
+}
+
+func TestUsecase_UpdateDiagram_NoModel(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.UpdateDiagram(t.Context(), testutils.NewInspectableLogger(), mockClient, "")
+
+	// Assert
+	require.ErrorIs(t, err, simulink.ErrNoModel)
+}
+
+func TestUsecase_Simulate_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockCodePolicy.EXPECT().
+		Check("5").
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("simulate", "controller", "5")).
+		Return(simulinkResponse(`{"Model":"controller","SimulationTime":5,"Signals":[{"Name":"error","BlockPath":"controller/Sum","Samples":51,"StartTime":0,"EndTime":5,"Min":-0.5,"Max":1,"Mean":0.1,"Final":[0.01]}],"Diagnostics":[],"Image":"iVBORw=="}`), nil).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	result, err := usecase.Simulate(ctx, mockLogger, mockClient, simulink.SimulateArgs{Model: "controller", StopTime: "5"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.SimulateReturnArgs{
+		Model:          "controller",
+		SimulationTime: 5,
+		Signals: []simulink.SignalSummary{{
+			Name:      "error",
+			BlockPath: "controller/Sum",
+			Samples:   51,
+			StartTime: 0,
+			EndTime:   5,
+			Min:       -0.5,
+			Max:       1,
+			Mean:      0.1,
+			Final:     []float64{0.01},
+		}},
+		Diagnostics: []simulink.Diagnostic{},
+		Image:       []byte{0x89, 'P', 'N', 'G'},
+	}, result)
+}
+
+func TestUsecase_Simulate_RejectedByCodePolicy(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	expectedError := errors.New("code rejected")
+
+	mockCodePolicy.EXPECT().
+		Check("delete('*')").
+		Return(expectedError).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Simulate(t.Context(), testutils.NewInspectableLogger(), mockClient, simulink.SimulateArgs{Model: "controller", StopTime: "delete('*')"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestUsecase_Simulate_NoModel(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.Simulate(t.Context(), testutils.NewInspectableLogger(), mockClient, simulink.SimulateArgs{})
+
+	// Assert
+	require.ErrorIs(t, err, simulink.ErrNoModel)
+}
+
+func TestUsecase_UpdateDiagram_FEvalError(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := errors.New("undefined function 'load_system'")
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("updateDiagram", "controller")).
+		Return(entities.FEvalResponse{}, expectedError).
+		Once()
+
+	usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+	// Act
+	_, err := usecase.UpdateDiagram(ctx, mockLogger, mockClient, "controller")
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestUsecase_ListBlocks_InvalidOutput(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      entities.FEvalResponse
+		expectedError string
+	}{
+		{
+			name:          "NoOutput",
+			response:      entities.FEvalResponse{},
+			expectedError: "unexpected number of outputs",
+		},
+		{
+			name:          "NotText",
+			response:      entities.FEvalResponse{Outputs: []any{42.0}},
+			expectedError: "failed to cast output",
+		},
+		{
+			name:          "NotJSON",
+			response:      simulinkResponse("not json"),
+			expectedError: "failed to parse output",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodePolicy := &mocks.MockCodePolicy{}
+			defer mockCodePolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), simulinkRequest("listBlocks", "controller", "false")).
+				Return(tt.response, nil).
+				Once()
+
+			usecase := simulink.New(mockPathValidator, mockCodePolicy)
+
+			// Act
+			_, err := usecase.ListBlocks(ctx, mockLogger, mockClient, simulink.ListBlocksArgs{System: "controller"})
+
+			// Assert
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
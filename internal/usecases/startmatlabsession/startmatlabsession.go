@@ -35,7 +35,7 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, re
 		return ReturnArgs{}, err
 	}
 
-	sessionLogger = sessionLogger.With("session_id", sessionID)
+	sessionLogger = sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID)
 
 	sessionLogger.Debug("Getting the session client")
 	client, err := u.matlabManager.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
@@ -21,7 +21,7 @@ func New(
 }
 
 func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
-	sessionLogger = sessionLogger.With("session_id", sessionID)
+	sessionLogger = sessionLogger.With(entities.MATLABSessionIDLogKey, sessionID)
 	sessionLogger.Debug("Entering StopMATLABSession Usecase")
 	defer sessionLogger.Debug("Exiting StopMATLABSession Usecase")
 
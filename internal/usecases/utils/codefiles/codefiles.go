@@ -0,0 +1,56 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codefiles
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type OSLayer interface {
+	ReadDir(name string) ([]os.DirEntry, error)
+}
+
+// Find returns the MATLAB code files in a folder and its subfolders, skipping hidden folders such as .git.
+func Find(osLayer OSLayer, folderPath string) ([]string, error) {
+	entries, err := osLayer.ReadDir(folderPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folder %s: %w", folderPath, err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		path := filepath.Join(folderPath, entry.Name())
+
+		if entry.IsDir() {
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+
+			subfolderFiles, err := Find(osLayer, path)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, subfolderFiles...)
+			continue
+		}
+
+		if IsCodeFile(entry.Name()) {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// IsCodeFile reports whether a file is a MATLAB code file, that is a .m file or a live script or function.
+func IsCodeFile(name string) bool {
+	switch filepath.Ext(name) {
+	case ".m", ".mlx":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package codefiles_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/codefiles"
+	codefilesmocks "github.com/matlab/matlab-mcp-server/mocks/usecases/utils/codefiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &codefilesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	folder := filepath.Join("work", "project")
+	subFolder := filepath.Join(folder, "sub")
+
+	expectedFiles := []string{
+		filepath.Join(folder, "main.m"),
+		filepath.Join(folder, "notes.mlx"),
+		filepath.Join(subFolder, "helper.m"),
+	}
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"main.m", "notes.mlx", "data.mat", "model.slx"}, []string{".git", "sub"}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(subFolder).
+		Return(dirEntries(t, []string{"helper.m"}, nil), nil).
+		Once()
+
+	// Act
+	files, err := codefiles.Find(mockOSLayer, folder)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFiles, files)
+}
+
+func TestFind_ReadDirError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &codefilesmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	folder := filepath.Join("work", "project")
+	subFolder := filepath.Join(folder, "sub")
+
+	mockOSLayer.EXPECT().
+		ReadDir(folder).
+		Return(dirEntries(t, []string{"main.m"}, []string{"sub"}), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadDir(subFolder).
+		Return(nil, os.ErrPermission).
+		Once()
+
+	// Act
+	files, err := codefiles.Find(mockOSLayer, folder)
+
+	// Assert
+	require.ErrorIs(t, err, os.ErrPermission)
+	assert.Nil(t, files)
+}
+
+func TestIsCodeFile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "script.m", expected: true},
+		{name: "live.mlx", expected: true},
+		{name: "data.mat", expected: false},
+		{name: "model.slx", expected: false},
+		{name: "m", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			result := codefiles.IsCodeFile(testCase.name)
+
+			// Assert
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func dirEntries(t *testing.T, files []string, folders []string) []os.DirEntry {
+	t.Helper()
+
+	folderFS := fstest.MapFS{}
+	for _, file := range files {
+		folderFS[file] = &fstest.MapFile{}
+	}
+	for _, folder := range folders {
+		folderFS[folder] = &fstest.MapFile{Mode: fs.ModeDir}
+	}
+
+	entries, err := fs.ReadDir(folderFS, ".")
+	require.NoError(t, err)
+
+	return entries
+}
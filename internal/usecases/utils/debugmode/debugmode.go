@@ -0,0 +1,78 @@
+// Copyright 2026 The MathWorks, Inc.
+
+// Package debugmode quits debug mode when code that a tool runs pauses at a breakpoint,
+// so that MATLAB does not stay at the K>> prompt and run the next tool calls in the paused function.
+package debugmode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const (
+	QuitCode = "dbquit('all')"
+
+	// promptCode does nothing, so that its response only reports the prompt that MATLAB shows.
+	promptCode = ""
+
+	PausedNote = "MATLAB paused in debug mode, at a breakpoint or an error, so debug mode was quit. To pause the code and inspect it, run it with the debugging tools instead."
+)
+
+// Leave quits debug mode, even when the request was cancelled.
+func Leave(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) {
+	if _, err := client.Eval(context.WithoutCancel(ctx), sessionLogger, entities.EvalRequest{Code: QuitCode}); err != nil {
+		sessionLogger.WithError(err).Warn("Failed to quit debug mode")
+	}
+}
+
+// LeaveIfPaused quits debug mode if an evaluation left MATLAB paused, and adds PausedNote to its output or error.
+func LeaveIfPaused(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, response entities.EvalResponse, evalErr error) (entities.EvalResponse, error) {
+	if evalErr != nil && ctx.Err() != nil {
+		return response, evalErr
+	}
+
+	if !response.IsPausedInDebugger() {
+		return response, evalErr
+	}
+
+	return leave(ctx, sessionLogger, client, response, evalErr)
+}
+
+// LeaveIfPausedAfterCapture is LeaveIfPaused for a captured evaluation. Captured evaluations do not report the prompt,
+// so it evaluates code that does nothing to read the prompt.
+func LeaveIfPausedAfterCapture(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, response entities.EvalResponse, evalErr error) (entities.EvalResponse, error) {
+	if evalErr != nil && ctx.Err() != nil {
+		return response, evalErr
+	}
+
+	promptResponse, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: promptCode})
+	if err != nil {
+		sessionLogger.WithError(err).Debug("Failed to read the prompt of MATLAB")
+		return response, evalErr
+	}
+
+	if !promptResponse.IsPausedInDebugger() {
+		return response, evalErr
+	}
+
+	return leave(ctx, sessionLogger, client, response, evalErr)
+}
+
+func leave(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, response entities.EvalResponse, evalErr error) (entities.EvalResponse, error) {
+	sessionLogger.Warn("Code paused in debug mode, quitting debug mode")
+	Leave(ctx, sessionLogger, client)
+
+	response.PromptType = entities.PromptTypeCommand
+	if evalErr != nil {
+		return response, fmt.Errorf("%w\n\n%s", evalErr, PausedNote)
+	}
+
+	if response.ConsoleOutput != "" {
+		response.ConsoleOutput += "\n"
+	}
+	response.ConsoleOutput += PausedNote
+
+	return response, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package debugmode_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/debugmode"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeave_QuitsDebugModeEvenWhenCancelled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Run(func(ctx context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			assert.NoError(t, ctx.Err(), "Debug mode should be quit even when the request was cancelled")
+		}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	debugmode.Leave(ctx, mockLogger, mockClient)
+
+	// Assert
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestLeave_LogsError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	// Act
+	debugmode.Leave(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	_, found := mockLogger.WarnLogs()["Failed to quit debug mode"]
+	assert.True(t, found)
+}
+
+func TestLeaveIfPaused_NotPaused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{ConsoleOutput: "ans = 3", PromptType: entities.PromptTypeCommand}
+
+	// Act
+	result, err := debugmode.LeaveIfPaused(t.Context(), mockLogger, mockClient, response, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, response, result)
+}
+
+func TestLeaveIfPaused_Paused_QuitsDebugMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{ConsoleOutput: "before breakpoint", PromptType: entities.PromptTypeDebug}
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	result, err := debugmode.LeaveIfPaused(t.Context(), mockLogger, mockClient, response, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "before breakpoint\n"+debugmode.PausedNote, result.ConsoleOutput)
+	assert.False(t, result.IsPausedInDebugger())
+}
+
+func TestLeaveIfPaused_PausedOnError_AddsNoteToError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{PromptType: entities.PromptTypeDebug}
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	_, err := debugmode.LeaveIfPaused(t.Context(), mockLogger, mockClient, response, assert.AnError)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), debugmode.PausedNote)
+}
+
+func TestLeaveIfPaused_CancelledEvaluation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	response := entities.EvalResponse{PromptType: entities.PromptTypeDebug}
+
+	// Act
+	_, err := debugmode.LeaveIfPaused(ctx, mockLogger, mockClient, response, context.Canceled)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLeaveIfPausedAfterCapture_NotPaused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{ConsoleOutput: "ans = 3"}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeCommand}, nil).
+		Once()
+
+	// Act
+	result, err := debugmode.LeaveIfPausedAfterCapture(t.Context(), mockLogger, mockClient, response, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, response, result)
+}
+
+func TestLeaveIfPausedAfterCapture_Paused_QuitsDebugMode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{PromptType: entities.PromptTypeDebug}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: debugmode.QuitCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	// Act
+	result, err := debugmode.LeaveIfPausedAfterCapture(t.Context(), mockLogger, mockClient, response, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugmode.PausedNote, result.ConsoleOutput)
+	_, found := mockLogger.WarnLogs()["Code paused in debug mode, quitting debug mode"]
+	assert.True(t, found)
+}
+
+func TestLeaveIfPausedAfterCapture_PromptError_KeepsResponse(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	response := entities.EvalResponse{ConsoleOutput: "ans = 3"}
+
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: ""}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := debugmode.LeaveIfPausedAfterCapture(t.Context(), mockLogger, mockClient, response, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, response, result)
+}
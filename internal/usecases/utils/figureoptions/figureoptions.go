@@ -0,0 +1,49 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package figureoptions
+
+import (
+	"errors"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+const (
+	// maxPixels is the largest width or height of a figure, which keeps exports within the memory of MATLAB.
+	maxPixels = 10000
+	maxDPI    = 1200
+)
+
+var (
+	ErrInvalidFormat = errors.New("invalid figure format, use png, jpeg or svg")
+	ErrInvalidSize   = errors.New("figure width and height must be between 1 and 10000 pixels")
+	ErrInvalidDPI    = errors.New("figure resolution must be between 1 and 1200 DPI")
+	ErrDPIWithSVG    = errors.New("figure resolution only applies to png and jpeg figures")
+)
+
+// Validate checks the figure options of a tool call. Zero values are left for MATLAB to choose.
+func Validate(options entities.FigureOptions) error {
+	switch options.Format {
+	case "", entities.FigureFormatPNG, entities.FigureFormatJPEG, entities.FigureFormatSVG:
+	default:
+		return ErrInvalidFormat
+	}
+
+	if !isValidOptional(options.Width, maxPixels) || !isValidOptional(options.Height, maxPixels) {
+		return ErrInvalidSize
+	}
+
+	if !isValidOptional(options.DPI, maxDPI) {
+		return ErrInvalidDPI
+	}
+
+	if options.DPI != 0 && options.Format == entities.FigureFormatSVG {
+		return ErrDPIWithSVG
+	}
+
+	return nil
+}
+
+func isValidOptional(value int, maxValue int) bool {
+	return value >= 0 && value <= maxValue
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package figureoptions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/figureoptions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options entities.FigureOptions
+	}{
+		{name: "no options", options: entities.FigureOptions{}},
+		{name: "png with size and resolution", options: entities.FigureOptions{Format: entities.FigureFormatPNG, Width: 800, Height: 600, DPI: 150}},
+		{name: "jpeg", options: entities.FigureOptions{Format: entities.FigureFormatJPEG}},
+		{name: "svg with size", options: entities.FigureOptions{Format: entities.FigureFormatSVG, Width: 640}},
+		{name: "resolution only", options: entities.FigureOptions{DPI: 300}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			err := figureoptions.Validate(tc.options)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidate_InvalidOptions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       entities.FigureOptions
+		expectedError error
+	}{
+		{name: "unknown format", options: entities.FigureOptions{Format: "gif"}, expectedError: figureoptions.ErrInvalidFormat},
+		{name: "negative width", options: entities.FigureOptions{Width: -1}, expectedError: figureoptions.ErrInvalidSize},
+		{name: "height too large", options: entities.FigureOptions{Height: 10001}, expectedError: figureoptions.ErrInvalidSize},
+		{name: "negative resolution", options: entities.FigureOptions{DPI: -72}, expectedError: figureoptions.ErrInvalidDPI},
+		{name: "resolution too large", options: entities.FigureOptions{DPI: 1201}, expectedError: figureoptions.ErrInvalidDPI},
+		{name: "resolution with svg", options: entities.FigureOptions{Format: entities.FigureFormatSVG, DPI: 300}, expectedError: figureoptions.ErrDPIWithSVG},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			err := figureoptions.Validate(tc.options)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
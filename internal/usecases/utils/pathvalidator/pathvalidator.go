@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"slices"
 
 	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
 )
@@ -28,14 +28,51 @@ func New(
 }
 
 func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
+	return v.validateFile(filePath, "MATLAB .m file", ".m")
+}
+
+// ValidateMATLABCodeFile accepts both MATLAB code files (.m) and live scripts (.mlx).
+func (v *PathValidator) ValidateMATLABCodeFile(filePath string) (string, error) {
+	return v.validateFile(filePath, "MATLAB .m or .mlx file", ".m", ".mlx")
+}
+
+func (v *PathValidator) ValidateLiveScript(filePath string) (string, error) {
+	return v.validateFile(filePath, "MATLAB live script .mlx file", ".mlx")
+}
+
+// ValidateSimulinkModel accepts Simulink models saved in either the SLX (.slx) or the MDL (.mdl) format.
+func (v *PathValidator) ValidateSimulinkModel(filePath string) (string, error) {
+	return v.validateFile(filePath, "Simulink model .slx or .mdl file", ".slx", ".mdl")
+}
+
+// ValidateMATLABProject accepts either the .prj file of a MATLAB project or the root folder of the project.
+func (v *PathValidator) ValidateMATLABProject(projectPath string) (string, error) {
+	absPath, err := resolveAbsolutePath(projectPath)
+	if err != nil {
+		return "", err
+	}
+
+	projectInfo, err := v.getResourceInfo(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	if !projectInfo.IsDir() && filepath.Ext(absPath) != ".prj" {
+		return "", fmt.Errorf("path must be a MATLAB project .prj file or the root folder of a MATLAB project: %s", absPath)
+	}
+
+	return absPath, nil
+}
+
+func (v *PathValidator) validateFile(filePath string, fileKind string, extensions ...string) (string, error) {
 	absPath, err := resolveAbsolutePath(filePath)
 	if err != nil {
 		return "", err
 	}
 
-	// Check if it's a .m file before doing any file system operations
-	if !strings.HasSuffix(absPath, ".m") {
-		return "", fmt.Errorf("file must be a MATLAB .m file: %s", absPath)
+	// Check the extension before doing any file system operations
+	if !slices.Contains(extensions, filepath.Ext(absPath)) {
+		return "", fmt.Errorf("file must be a %s: %s", fileKind, absPath)
 	}
 
 	fileInfo, err := v.getResourceInfo(absPath)
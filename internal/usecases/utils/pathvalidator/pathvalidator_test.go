@@ -245,6 +245,266 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateMATLABCodeFile_HappyPath(t *testing.T) {
+	for _, fileName := range []string{"script.m", "liveScript.mlx"} {
+		t.Run(fileName, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer)
+
+			testPath, absErr := filepath.Abs(fileName)
+			require.NoError(t, absErr)
+
+			mockOsLayer.EXPECT().
+				Stat(testPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileInfo.EXPECT().
+				IsDir().
+				Return(false).
+				Once()
+
+			// Act
+			result, err := validator.ValidateMATLABCodeFile(testPath)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testPath, result)
+		})
+	}
+}
+
+func TestValidator_ValidateMATLABCodeFile_NotMATLABCodeFile(t *testing.T) {
+	for _, fileName := range []string{"file.txt", "model.slx", "script.MLX"} {
+		t.Run(fileName, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer)
+
+			filePath, absErr := filepath.Abs(fileName)
+			require.NoError(t, absErr)
+
+			// Act
+			_, err := validator.ValidateMATLABCodeFile(filePath)
+
+			// Assert
+			require.ErrorContains(t, err, "file must be a MATLAB .m or .mlx file")
+		})
+	}
+}
+
+func TestValidator_ValidateLiveScript_HappyPath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	testPath, absErr := filepath.Abs("liveScript.mlx")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	result, err := validator.ValidateLiveScript(testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateLiveScript_NotLiveScript(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	filePath, absErr := filepath.Abs("script.m")
+	require.NoError(t, absErr)
+
+	// Act
+	_, err := validator.ValidateLiveScript(filePath)
+
+	// Assert
+	require.ErrorContains(t, err, "file must be a MATLAB live script .mlx file")
+}
+
+func TestValidator_ValidateSimulinkModel_HappyPath(t *testing.T) {
+	for _, fileName := range []string{"model.slx", "model.mdl"} {
+		t.Run(fileName, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer)
+
+			testPath, absErr := filepath.Abs(fileName)
+			require.NoError(t, absErr)
+
+			mockOsLayer.EXPECT().
+				Stat(testPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileInfo.EXPECT().
+				IsDir().
+				Return(false).
+				Once()
+
+			// Act
+			result, err := validator.ValidateSimulinkModel(testPath)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testPath, result)
+		})
+	}
+}
+
+func TestValidator_ValidateSimulinkModel_NotSimulinkModel(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	filePath, absErr := filepath.Abs("script.m")
+	require.NoError(t, absErr)
+
+	// Act
+	_, err := validator.ValidateSimulinkModel(filePath)
+
+	// Assert
+	require.ErrorContains(t, err, "file must be a Simulink model .slx or .mdl file")
+}
+
+func TestValidator_ValidateMATLABProject_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name  string
+		path  string
+		isDir bool
+	}{
+		{
+			name:  "project file",
+			path:  "Weather.prj",
+			isDir: false,
+		},
+		{
+			name:  "project root folder",
+			path:  "weather",
+			isDir: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer)
+
+			testPath, absErr := filepath.Abs(tc.path)
+			require.NoError(t, absErr)
+
+			mockOsLayer.EXPECT().
+				Stat(testPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileInfo.EXPECT().
+				IsDir().
+				Return(tc.isDir).
+				Once()
+
+			// Act
+			result, err := validator.ValidateMATLABProject(testPath)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testPath, result)
+		})
+	}
+}
+
+func TestValidator_ValidateMATLABProject_NotMATLABProject(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	filePath, absErr := filepath.Abs("script.m")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(filePath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	// Act
+	_, err := validator.ValidateMATLABProject(filePath)
+
+	// Assert
+	require.ErrorContains(t, err, "path must be a MATLAB project .prj file or the root folder of a MATLAB project")
+}
+
+func TestValidator_ValidateMATLABProject_StatFails(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	testPath, absErr := filepath.Abs("Weather.prj")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	validator := pathvalidator.New(mockOsLayer)
+
+	// Act
+	_, err := validator.ValidateMATLABProject(testPath)
+
+	// Assert
+	require.ErrorContains(t, err, "resource not found")
+}
+
 func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
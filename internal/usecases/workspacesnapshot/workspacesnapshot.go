@@ -0,0 +1,179 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package workspacesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+)
+
+var (
+	ErrNoSessionFolder      = errors.New("workspace snapshots are only available in MATLAB sessions that the server started")
+	ErrInvalidSnapshotName  = errors.New("invalid snapshot name, use up to 64 letters, digits, underscores and hyphens, starting with a letter or digit")
+	ErrSnapshotExists       = errors.New("a snapshot with this name already exists, choose another name or overwrite it")
+	ErrSnapshotNotFound     = errors.New("no snapshot with this name, list the snapshots to see their names")
+	ErrSnapshotTooLarge     = errors.New("the workspace is larger than the maximum size of a snapshot, clear large variables before taking the snapshot")
+	ErrSnapshotLimitReached = errors.New("the snapshots would exceed the maximum total size of the workspace snapshots, delete snapshots to make room")
+	ErrInvalidRestoreMode   = errors.New("invalid restore mode")
+)
+
+type RestoreMode string
+
+const (
+	// RestoreModeMerge loads the variables of the snapshot into the workspace, and keeps the other variables.
+	RestoreModeMerge RestoreMode = "merge"
+	// RestoreModeReplace clears the workspace before it loads the variables of the snapshot.
+	RestoreModeReplace RestoreMode = "replace"
+)
+
+type Snapshot struct {
+	Name      string
+	CreatedAt time.Time
+	SizeBytes int64
+	Variables []string
+}
+
+type WorkspaceManager interface {
+	SessionFolder(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (string, error)
+	Size(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (int64, error)
+	Save(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string) ([]string, error)
+	Load(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string, mode RestoreMode) ([]string, error)
+}
+
+type SnapshotStore interface {
+	Reserve(sessionFolder string, name string, overwrite bool) (string, string, error)
+	CheckSize(sizeBytes int64) error
+	Commit(sessionFolder string, name string, variables []string) (Snapshot, error)
+	Discard(sessionFolder string, name string) error
+	Get(sessionFolder string, name string) (Snapshot, string, error)
+	List(sessionFolder string) ([]Snapshot, error)
+	Delete(sessionFolder string, name string) (Snapshot, error)
+}
+
+type Usecase struct {
+	workspaceManager WorkspaceManager
+	snapshotStore    SnapshotStore
+}
+
+func New(
+	workspaceManager WorkspaceManager,
+	snapshotStore SnapshotStore,
+) *Usecase {
+	return &Usecase{
+		workspaceManager: workspaceManager,
+		snapshotStore:    snapshotStore,
+	}
+}
+
+// Snapshot saves the variables of the base workspace as a snapshot. An empty name uses a name made of the current time.
+// A workspace that is larger in memory than the maximum size of a snapshot is refused before it is saved.
+func (u *Usecase) Snapshot(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string, overwrite bool) (Snapshot, error) {
+	sessionLogger.Debug("Entering Snapshot Workspace Usecase")
+	defer sessionLogger.Debug("Exiting Snapshot Workspace Usecase")
+
+	sessionFolder, err := u.sessionFolder(ctx, sessionLogger, client)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	name, filePath, err := u.snapshotStore.Reserve(sessionFolder, name, overwrite)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	size, err := u.workspaceManager.Size(ctx, sessionLogger, client)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := u.snapshotStore.CheckSize(size); err != nil {
+		return Snapshot{}, err
+	}
+
+	variables, err := u.workspaceManager.Save(ctx, sessionLogger, client, filePath)
+	if err != nil {
+		if discardErr := u.snapshotStore.Discard(sessionFolder, name); discardErr != nil {
+			sessionLogger.WithError(discardErr).Warn("Failed to delete the partial workspace snapshot")
+		}
+		return Snapshot{}, err
+	}
+
+	return u.snapshotStore.Commit(sessionFolder, name, variables)
+}
+
+// Restore loads the variables of a snapshot into the base workspace, and returns the snapshot with the variables that were loaded.
+func (u *Usecase) Restore(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string, mode RestoreMode) (Snapshot, error) {
+	sessionLogger.Debug("Entering Restore Workspace Usecase")
+	defer sessionLogger.Debug("Exiting Restore Workspace Usecase")
+
+	if mode == "" {
+		mode = RestoreModeMerge
+	}
+
+	if mode != RestoreModeMerge && mode != RestoreModeReplace {
+		return Snapshot{}, fmt.Errorf("%w: %s, use merge or replace", ErrInvalidRestoreMode, mode)
+	}
+
+	sessionFolder, err := u.sessionFolder(ctx, sessionLogger, client)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot, filePath, err := u.snapshotStore.Get(sessionFolder, name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	variables, err := u.workspaceManager.Load(ctx, sessionLogger, client, filePath, mode)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot.Variables = variables
+	return snapshot, nil
+}
+
+// List returns the snapshots of the MATLAB session, oldest first.
+func (u *Usecase) List(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) ([]Snapshot, error) {
+	sessionLogger.Debug("Entering List Workspace Snapshots Usecase")
+	defer sessionLogger.Debug("Exiting List Workspace Snapshots Usecase")
+
+	sessionFolder, err := u.sessionFolder(ctx, sessionLogger, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.snapshotStore.List(sessionFolder)
+}
+
+// Delete deletes a snapshot, and returns it.
+func (u *Usecase) Delete(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, name string) (Snapshot, error) {
+	sessionLogger.Debug("Entering Delete Workspace Snapshot Usecase")
+	defer sessionLogger.Debug("Exiting Delete Workspace Snapshot Usecase")
+
+	sessionFolder, err := u.sessionFolder(ctx, sessionLogger, client)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return u.snapshotStore.Delete(sessionFolder, name)
+}
+
+// sessionFolder returns the session directory of the MATLAB session, which holds its snapshots.
+// Sessions that the server did not start have no session directory.
+func (u *Usecase) sessionFolder(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (string, error) {
+	sessionFolder, err := u.workspaceManager.SessionFolder(ctx, sessionLogger, client)
+	if err != nil {
+		return "", err
+	}
+
+	if sessionFolder == "" {
+		return "", ErrNoSessionFolder
+	}
+
+	return sessionFolder, nil
+}
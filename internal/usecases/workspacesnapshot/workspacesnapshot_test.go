@@ -0,0 +1,613 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package workspacesnapshot_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/workspacesnapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	// Act
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
+
+func TestUsecase_Snapshot_HappyPath(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.partial.mat"
+	variables := []string{"x", "y"}
+	expectedSnapshot := workspacesnapshot.Snapshot{
+		Name:      name,
+		CreatedAt: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		SizeBytes: 2048,
+		Variables: variables,
+	}
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, name, false).
+		Return(name, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Size(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(int64(4096), nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		CheckSize(int64(4096)).
+		Return(nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Save(ctx, mockLogger.AsMockArg(), mockClient, filePath).
+		Return(variables, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Commit(sessionFolder, name, variables).
+		Return(expectedSnapshot, nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	snapshot, err := usecase.Snapshot(ctx, mockLogger, mockClient, name, false)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSnapshot, snapshot)
+}
+
+func TestUsecase_Snapshot_NoSessionFolder(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return("", nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, "before-fit", false)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrNoSessionFolder)
+}
+
+func TestUsecase_Snapshot_ReserveError(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, "before-fit", false).
+		Return("", "", workspacesnapshot.ErrSnapshotExists).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, "before-fit", false)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotExists)
+}
+
+func TestUsecase_Snapshot_WorkspaceTooLarge(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.partial.mat"
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, name, false).
+		Return(name, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Size(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(int64(8<<30), nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		CheckSize(int64(8 << 30)).
+		Return(workspacesnapshot.ErrSnapshotTooLarge).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, name, false)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotTooLarge, "The workspace should not be saved")
+}
+
+func TestUsecase_Snapshot_SizeError(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.partial.mat"
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, name, false).
+		Return(name, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Size(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(0, assert.AnError).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, name, false)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUsecase_Snapshot_SaveErrorDiscardsSnapshot(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "snapshot-20260304-103000"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/snapshot-20260304-103000.partial.mat"
+	expectedError := errors.New("unable to write file")
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, "", false).
+		Return(name, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Size(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(int64(4096), nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		CheckSize(int64(4096)).
+		Return(nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Save(ctx, mockLogger.AsMockArg(), mockClient, filePath).
+		Return(nil, expectedError).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Discard(sessionFolder, name).
+		Return(nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, "", false)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestUsecase_Snapshot_DiscardErrorIsLogged(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.partial.mat"
+	expectedError := errors.New("unable to write file")
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Reserve(sessionFolder, name, true).
+		Return(name, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Size(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(int64(4096), nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		CheckSize(int64(4096)).
+		Return(nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Save(ctx, mockLogger.AsMockArg(), mockClient, filePath).
+		Return(nil, expectedError).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Discard(sessionFolder, name).
+		Return(errors.New("permission denied")).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Snapshot(ctx, mockLogger, mockClient, name, true)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to delete the partial workspace snapshot")
+}
+
+func TestUsecase_Restore_HappyPath(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.mat"
+	storedSnapshot := workspacesnapshot.Snapshot{
+		Name:      name,
+		CreatedAt: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		SizeBytes: 2048,
+		Variables: []string{"x", "y"},
+	}
+	loadedVariables := []string{"x", "y", "z"}
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Get(sessionFolder, name).
+		Return(storedSnapshot, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Load(ctx, mockLogger.AsMockArg(), mockClient, filePath, workspacesnapshot.RestoreModeReplace).
+		Return(loadedVariables, nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	snapshot, err := usecase.Restore(ctx, mockLogger, mockClient, name, workspacesnapshot.RestoreModeReplace)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, name, snapshot.Name)
+	assert.Equal(t, storedSnapshot.CreatedAt, snapshot.CreatedAt)
+	assert.Equal(t, loadedVariables, snapshot.Variables, "The snapshot should list the variables that were loaded")
+}
+
+func TestUsecase_Restore_DefaultsToMerge(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	const name = "before-fit"
+	const filePath = "/tmp/matlab-session-1/workspace_snapshots/before-fit.mat"
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Get(sessionFolder, name).
+		Return(workspacesnapshot.Snapshot{Name: name}, filePath, nil).
+		Once()
+
+	mockWorkspaceManager.EXPECT().
+		Load(ctx, mockLogger.AsMockArg(), mockClient, filePath, workspacesnapshot.RestoreModeMerge).
+		Return([]string{"x"}, nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Restore(ctx, mockLogger, mockClient, name, "")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestUsecase_Restore_InvalidMode(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Restore(t.Context(), mockLogger, mockClient, "before-fit", "overwrite")
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrInvalidRestoreMode)
+	assert.Contains(t, err.Error(), "overwrite")
+}
+
+func TestUsecase_Restore_SnapshotNotFound(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Get(sessionFolder, "missing").
+		Return(workspacesnapshot.Snapshot{}, "", workspacesnapshot.ErrSnapshotNotFound).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.Restore(ctx, mockLogger, mockClient, "missing", workspacesnapshot.RestoreModeMerge)
+
+	// Assert
+	require.ErrorIs(t, err, workspacesnapshot.ErrSnapshotNotFound)
+}
+
+func TestUsecase_List_HappyPath(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	expectedSnapshots := []workspacesnapshot.Snapshot{
+		{Name: "before-fit", SizeBytes: 2048, Variables: []string{"x"}},
+		{Name: "after-fit", SizeBytes: 4096, Variables: []string{"x", "model"}},
+	}
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		List(sessionFolder).
+		Return(expectedSnapshots, nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	snapshots, err := usecase.List(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSnapshots, snapshots)
+}
+
+func TestUsecase_List_SessionFolderError(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := errors.New("MATLAB session is not responding")
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return("", expectedError).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	_, err := usecase.List(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestUsecase_Delete_HappyPath(t *testing.T) {
+	// Arrange
+	mockWorkspaceManager := &mocks.MockWorkspaceManager{}
+	defer mockWorkspaceManager.AssertExpectations(t)
+
+	mockSnapshotStore := &mocks.MockSnapshotStore{}
+	defer mockSnapshotStore.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionFolder = "/tmp/matlab-session-1"
+	expectedSnapshot := workspacesnapshot.Snapshot{Name: "before-fit", SizeBytes: 2048}
+
+	mockWorkspaceManager.EXPECT().
+		SessionFolder(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(sessionFolder, nil).
+		Once()
+
+	mockSnapshotStore.EXPECT().
+		Delete(sessionFolder, "before-fit").
+		Return(expectedSnapshot, nil).
+		Once()
+
+	usecase := workspacesnapshot.New(mockWorkspaceManager, mockSnapshotStore)
+
+	// Act
+	snapshot, err := usecase.Delete(ctx, mockLogger, mockClient, "before-fit")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSnapshot, snapshot)
+}
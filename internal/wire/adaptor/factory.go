@@ -3,10 +3,13 @@
 package adaptor
 
 import (
+	"time"
+
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/entities"
 	"github.com/matlab/matlab-mcp-server/internal/wire"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type ApplicationDefinition interface {
@@ -17,6 +20,9 @@ type ApplicationDefinition interface {
 	Parameters() []entities.Parameter
 	Dependencies(resources definition.DependenciesProviderResources) (any, error)
 	Tools(resources definition.ToolsProviderResources) []tools.Tool
+	ToolMiddleware(resources definition.ToolsProviderResources) []mcp.Middleware
+	LifecycleHooks() []definition.LifecycleHook
+	LifecycleHookTimeout() time.Duration
 }
 
 type Application interface {
@@ -5,29 +5,44 @@
 package wire
 
 import (
+	"time"
+
 	"github.com/google/wire"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/directory"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/lifecyclesignaler"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/analyze"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/doctor"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/setupmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/orchestrator"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters/selector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/audit"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/buildinfo"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/codepolicy"
 	files "github.com/matlab/matlab-mcp-server/internal/adaptors/filesystem/files"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/filesystem/snapshotstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager/matlabprojectdetector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager/matlabstartingdirselector"
 	httpclient "github.com/matlab/matlab-mcp-server/internal/adaptors/http/client"
 	httpserver "github.com/matlab/matlab-mcp-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/codeanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/dependencyanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/helpreader"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/livescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabinstallation"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabrootselector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/projectmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/workspacemanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/addonmanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/addonmanager/installationsteps"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	localmatlabsessiondirectory "github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
@@ -38,34 +53,70 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionpool"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery/appdatadir"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/functioncompleter"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/pathcompleter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/codingguidelines"
+	matlabhelpresource "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlaboutput"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/projectconfig"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/rootpathresolver"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/rootstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/sdk"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/confirmation"
+	matlabsessionstatustool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
 	evalmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabstool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
 	startmatlabsessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependenciessinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	analyzematlabprojectsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
 	checkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	clearmatlabbreakpointssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	closematlabprojectsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/closematlabproject"
+	convertlivescriptsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom"
 	customloader "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/loader"
 	customvalidator "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/loader/validator"
+	debugmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	deleteworkspacesnapshotsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/deleteworkspacesnapshot"
 	detectmatlabtoolboxessinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	getmatlabdebugstacksinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/getmatlabdebugstack"
+	listmatlabprojectfilessinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listmatlabprojectfiles"
+	listworkspacesnapshotssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	openmatlabprojectsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	profilematlabcodesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	restoreworkspacesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
 	runmatlabfilesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	runmatlabprojectcheckssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabprojectchecks"
+	runmatlabsectionssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
 	runmatlabtestfilesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	setmatlabbreakpointsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	simulinkgetblockparamssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkgetblockparams"
+	simulinklistblockssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinklistblocks"
+	simulinkopenmodelsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkopenmodel"
+	simulinksetblockparamssinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksetblockparams"
+	simulinksimsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	simulinkupdatediagramsinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkupdatediagram"
+	snapshotworkspacesinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/snapshotworkspace"
+	stepmatlabdebuggersinglesessiontool "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/stepmatlabdebugger"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/messagecatalog"
 	osadaptor "github.com/matlab/matlab-mcp-server/internal/adaptors/os"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/resourcelimit"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sarif"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry/otel/instruments"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry/otel/meter/exporter"
@@ -78,24 +129,35 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
 	"github.com/matlab/matlab-mcp-server/internal/facades/registryfacade"
 	unixfacade "github.com/matlab/matlab-mcp-server/internal/facades/unix"
-	"github.com/matlab/matlab-mcp-server/internal/adaptors/resourcelimit"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool/functioncall"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabsessionstatus"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/profilematlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/stopmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
 	watchdogprocess "github.com/matlab/matlab-mcp-server/internal/watchdog"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/processhandler"
 	transportclient "github.com/matlab/matlab-mcp-server/internal/watchdog/transport/client"
 	transportserver "github.com/matlab/matlab-mcp-server/internal/watchdog/transport/server"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/transport/server/handler"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/transport/socket"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type Application struct {
@@ -117,6 +179,9 @@ type ApplicationDefinition interface {
 	Parameters() []entities.Parameter
 	Dependencies(resources definition.DependenciesProviderResources) (any, error)
 	Tools(resources definition.ToolsProviderResources) []tools.Tool
+	ToolMiddleware(resources definition.ToolsProviderResources) []mcp.Middleware
+	LifecycleHooks() []definition.LifecycleHook
+	LifecycleHookTimeout() time.Duration
 }
 
 func Initialize(serverDefinition ApplicationDefinition) *Application {
@@ -135,6 +200,8 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(modeselector.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(modeselector.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(modeselector.SetupMATLAB), new(*setupmatlab.Mode)),
+		wire.Bind(new(modeselector.Doctor), new(*doctor.Mode)),
+		wire.Bind(new(modeselector.Analyze), new(*analyze.Mode)),
 
 		// Setup MATLAB
 		setupmatlab.New,
@@ -146,6 +213,35 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(setupmatlab.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(setupmatlab.AddonManager), new(*addonmanager.AddonManager)),
 
+		// Doctor
+		doctor.New,
+		wire.Bind(new(doctor.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(doctor.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(doctor.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(doctor.DirectoryFactory), new(*directory.Factory)),
+		wire.Bind(new(doctor.MATLABManager), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(doctor.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(doctor.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
+		wire.Bind(new(doctor.SessionDiscoverer), new(*sessiondiscovery.SessionDiscoverer)),
+		wire.Bind(new(doctor.ProcessManager), new(*osadaptor.ProcessManager)),
+		wire.Bind(new(doctor.HTTPClientFactory), new(*httpclient.Factory)),
+		wire.Bind(new(doctor.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
+		wire.Bind(new(doctor.WatchdogClient), new(*watchdogclient.Watchdog)),
+		wire.Bind(new(doctor.ExtensionLoader), new(*customloader.Loader)),
+
+		// Analyze
+		analyze.New,
+		wire.Bind(new(analyze.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(analyze.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(analyze.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(analyze.WatchdogClient), new(*watchdogclient.Watchdog)),
+		wire.Bind(new(analyze.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(analyze.Usecase), new(*analyzematlabproject.Usecase)),
+
+		// SARIF
+		sarif.NewFileWriter,
+		wire.Bind(new(sarif.OSLayer), new(*osfacade.OsFacade)),
+
 		// Add-On Manager
 		addonmanager.New,
 		wire.Bind(new(addonmanager.InstallationSteps), new(*installationsteps.InstallationSteps)),
@@ -219,6 +315,7 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(server.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(server.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(server.MCPServerConfigurator), new(*configurator.Configurator)),
+		wire.Bind(new(server.CompletionRegistry), new(*completion.Registry)),
 
 		// RootStore
 		rootstore.New,
@@ -227,14 +324,47 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		rootpathresolver.New,
 		wire.Bind(new(rootpathresolver.OSLayer), new(*osfacade.OsFacade)),
 
+		// Project Config
+		projectconfig.New,
+		wire.Bind(new(projectconfig.RootStore), new(*rootstore.RootStore)),
+		wire.Bind(new(projectconfig.RootPathResolver), new(*rootpathresolver.RootPathResolver)),
+		wire.Bind(new(projectconfig.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(projectconfig.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(projectconfig.LoggerFactory), new(*logger.Factory)),
+
 		// MCP Server (SDK)
 		sdk.NewFactory,
 		wire.Bind(new(sdk.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(sdk.Definition), new(ApplicationDefinition)),
-		wire.Bind(new(sdk.RootStore), new(*rootstore.RootStore)),
+		wire.Bind(new(sdk.RootStore), new(*projectconfig.ProjectConfig)),
 		wire.Bind(new(sdk.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(sdk.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(sdk.TelemetryFactory), new(*telemetry.Factory)),
+		wire.Bind(new(sdk.MATLABSessionPoolWarmer), new(*matlabsessionpool.Warmer)),
+		wire.Bind(new(sdk.AuditLog), new(*audit.Log)),
+		wire.Bind(new(sdk.Completer), new(*completion.Registry)),
+		wire.Bind(new(sdk.OutputLimiter), new(*responseconverter.OutputLimiter)),
+
+		// Tool Output
+		responseconverter.NewOutputStore,
+		responseconverter.NewOutputLimiter,
+
+		// Completions
+		completion.New,
+		wire.Bind(new(completion.LoggerFactory), new(*logger.Factory)),
+		pathcompleter.New,
+		wire.Bind(new(pathcompleter.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(pathcompleter.RootStore), new(*rootstore.RootStore)),
+		wire.Bind(new(pathcompleter.RootPathResolver), new(*rootpathresolver.RootPathResolver)),
+
+		// Audit Log
+		audit.New,
+		wire.Bind(new(audit.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(audit.OSLayer), new(*osfacade.OsFacade)),
+		audit.NewGlobalMATLAB,
+		wire.Bind(new(audit.GlobalMATLABAdaptor), new(*globalmatlab.GlobalMATLAB)),
+		audit.NewMATLABManager,
+		wire.Bind(new(audit.MATLABManagerAdaptor), new(*matlabmanager.MATLABManager)),
 
 		// MCP Server Configurator
 		configurator.New,
@@ -244,12 +374,23 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		// Tools
 		wire.Bind(new(basetool.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(basetool.Confirmer), new(*confirmation.Confirmer)),
+		wire.Bind(new(basetool.PathCompleter), new(*pathcompleter.PathCompleter)),
+
+		confirmation.New,
+		wire.Bind(new(confirmation.ConfigFactory), new(*config.Factory)),
 
 		listavailablematlabstool.New,
 		wire.Bind(new(listavailablematlabstool.Usecase), new(*listavailablematlabs.Usecase)),
 
 		listavailablematlabs.New,
 
+		matlabsessionstatustool.New,
+		wire.Bind(new(matlabsessionstatustool.Usecase), new(*matlabsessionstatus.Usecase)),
+
+		matlabsessionstatus.New,
+		wire.Bind(new(matlabsessionstatus.SessionStatusReader), new(*matlabsessionstore.Store)),
+
 		startmatlabsessiontool.New,
 		wire.Bind(new(startmatlabsessiontool.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(startmatlabsessiontool.Usecase), new(*startmatlabsession.Usecase)),
@@ -271,6 +412,7 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		evalmatlabcode.New,
 		wire.Bind(new(evalmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(evalmatlabcode.CodePolicy), new(*codepolicy.Enforcer)),
 
 		checkmatlabcodesinglesessiontool.New,
 		wire.Bind(new(checkmatlabcodesinglesessiontool.Usecase), new(*checkmatlabcode.Usecase)),
@@ -279,12 +421,113 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(checkmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
 		wire.Bind(new(checkmatlabcode.CodeAnalyzer), new(*codeanalyzer.Analyzer)),
 
+		analyzematlabprojectsinglesessiontool.New,
+		wire.Bind(new(analyzematlabprojectsinglesessiontool.Usecase), new(*analyzematlabproject.Usecase)),
+		wire.Bind(new(analyzematlabprojectsinglesessiontool.SARIFWriter), new(*sarif.FileWriter)),
+
+		analyzematlabproject.New,
+		wire.Bind(new(analyzematlabproject.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(analyzematlabproject.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(analyzematlabproject.CodeAnalyzer), new(*codeanalyzer.Analyzer)),
+
 		codeanalyzer.New,
 
+		analyzematlabdependenciessinglesessiontool.New,
+		wire.Bind(new(analyzematlabdependenciessinglesessiontool.Usecase), new(*analyzematlabdependencies.Usecase)),
+
+		analyzematlabdependencies.New,
+		wire.Bind(new(analyzematlabdependencies.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(analyzematlabdependencies.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(analyzematlabdependencies.DependencyAnalyzer), new(*dependencyanalyzer.Analyzer)),
+		wire.Bind(new(analyzematlabdependencies.ProductDetector), new(*detectmatlabtoolboxes.Usecase)),
+
+		dependencyanalyzer.New,
+
+		convertlivescriptsinglesessiontool.New,
+		wire.Bind(new(convertlivescriptsinglesessiontool.Usecase), new(*convertlivescript.Usecase)),
+
+		convertlivescript.New,
+		wire.Bind(new(convertlivescript.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(convertlivescript.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(convertlivescript.LiveScriptConverter), new(*livescript.Converter)),
+
+		livescript.New,
+
+		simulinkopenmodelsinglesessiontool.New,
+		wire.Bind(new(simulinkopenmodelsinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulinklistblockssinglesessiontool.New,
+		wire.Bind(new(simulinklistblockssinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulinkgetblockparamssinglesessiontool.New,
+		wire.Bind(new(simulinkgetblockparamssinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulinksetblockparamssinglesessiontool.New,
+		wire.Bind(new(simulinksetblockparamssinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulinkupdatediagramsinglesessiontool.New,
+		wire.Bind(new(simulinkupdatediagramsinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulinksimsinglesessiontool.New,
+		wire.Bind(new(simulinksimsinglesessiontool.Usecase), new(*simulink.Usecase)),
+
+		simulink.New,
+		wire.Bind(new(simulink.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(simulink.CodePolicy), new(*codepolicy.Enforcer)),
+
+		openmatlabprojectsinglesessiontool.New,
+		wire.Bind(new(openmatlabprojectsinglesessiontool.Usecase), new(*matlabproject.Usecase)),
+
+		closematlabprojectsinglesessiontool.New,
+		wire.Bind(new(closematlabprojectsinglesessiontool.Usecase), new(*matlabproject.Usecase)),
+
+		listmatlabprojectfilessinglesessiontool.New,
+		wire.Bind(new(listmatlabprojectfilessinglesessiontool.Usecase), new(*matlabproject.Usecase)),
+
+		runmatlabprojectcheckssinglesessiontool.New,
+		wire.Bind(new(runmatlabprojectcheckssinglesessiontool.Usecase), new(*matlabproject.Usecase)),
+
+		matlabproject.New,
+		wire.Bind(new(matlabproject.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(matlabproject.MATLABProjectDetector), new(*matlabprojectdetector.MATLABProjectDetector)),
+		wire.Bind(new(matlabproject.ProjectManager), new(*projectmanager.Manager)),
+
+		projectmanager.New,
+
+		snapshotworkspacesinglesessiontool.New,
+		wire.Bind(new(snapshotworkspacesinglesessiontool.Usecase), new(*workspacesnapshot.Usecase)),
+
+		restoreworkspacesinglesessiontool.New,
+		wire.Bind(new(restoreworkspacesinglesessiontool.Usecase), new(*workspacesnapshot.Usecase)),
+
+		listworkspacesnapshotssinglesessiontool.New,
+		wire.Bind(new(listworkspacesnapshotssinglesessiontool.Usecase), new(*workspacesnapshot.Usecase)),
+
+		deleteworkspacesnapshotsinglesessiontool.New,
+		wire.Bind(new(deleteworkspacesnapshotsinglesessiontool.Usecase), new(*workspacesnapshot.Usecase)),
+
+		workspacesnapshot.New,
+		wire.Bind(new(workspacesnapshot.WorkspaceManager), new(*workspacemanager.Manager)),
+		wire.Bind(new(workspacesnapshot.SnapshotStore), new(*snapshotstore.Store)),
+
+		workspacemanager.New,
+
+		snapshotstore.New,
+		wire.Bind(new(snapshotstore.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(snapshotstore.OSLayer), new(*osfacade.OsFacade)),
+
 		detectmatlabtoolboxessinglesessiontool.New,
+		wire.Bind(new(detectmatlabtoolboxessinglesessiontool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(detectmatlabtoolboxessinglesessiontool.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
 		wire.Bind(new(detectmatlabtoolboxessinglesessiontool.Usecase), new(*detectmatlabtoolboxes.Usecase)),
+		wire.Bind(new(detectmatlabtoolboxessinglesessiontool.GlobalMATLABSession), new(*globalmatlab.GlobalMATLAB)),
 
 		detectmatlabtoolboxes.New,
+		wire.Bind(new(entities.MATLABInstallationReader), new(*matlabinstallation.Reader)),
+
+		matlabinstallation.New,
+		wire.Bind(new(matlabinstallation.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabinstallation.FileLayer), new(*filefacade.FileFacade)),
 
 		runmatlabfilesinglesessiontool.New,
 		wire.Bind(new(runmatlabfilesinglesessiontool.ConfigFactory), new(*config.Factory)),
@@ -292,17 +535,62 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		runmatlabfile.New,
 		wire.Bind(new(runmatlabfile.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabfile.CodePolicy), new(*codepolicy.Enforcer)),
+		wire.Bind(new(runmatlabfile.LiveScriptConverter), new(*livescript.Converter)),
+
+		runmatlabsectionssinglesessiontool.New,
+		wire.Bind(new(runmatlabsectionssinglesessiontool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(runmatlabsectionssinglesessiontool.Usecase), new(*runmatlabsections.Usecase)),
+
+		runmatlabsections.New,
+		wire.Bind(new(runmatlabsections.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabsections.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(runmatlabsections.CodePolicy), new(*codepolicy.Enforcer)),
 
 		runmatlabtestfilesinglesessiontool.New,
 		wire.Bind(new(runmatlabtestfilesinglesessiontool.Usecase), new(*runmatlabtestfile.Usecase)),
 
 		runmatlabtestfile.New,
 		wire.Bind(new(runmatlabtestfile.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(runmatlabtestfile.CodePolicy), new(*codepolicy.Enforcer)),
+
+		setmatlabbreakpointsinglesessiontool.New,
+		wire.Bind(new(setmatlabbreakpointsinglesessiontool.Usecase), new(*debugmatlab.Usecase)),
+
+		clearmatlabbreakpointssinglesessiontool.New,
+		wire.Bind(new(clearmatlabbreakpointssinglesessiontool.Usecase), new(*debugmatlab.Usecase)),
+
+		debugmatlabcodesinglesessiontool.New,
+		wire.Bind(new(debugmatlabcodesinglesessiontool.Usecase), new(*debugmatlab.Usecase)),
+
+		getmatlabdebugstacksinglesessiontool.New,
+		wire.Bind(new(getmatlabdebugstacksinglesessiontool.Usecase), new(*debugmatlab.Usecase)),
+
+		stepmatlabdebuggersinglesessiontool.New,
+		wire.Bind(new(stepmatlabdebuggersinglesessiontool.Usecase), new(*debugmatlab.Usecase)),
+
+		debugmatlab.New,
+		wire.Bind(new(debugmatlab.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(debugmatlab.CodePolicy), new(*codepolicy.Enforcer)),
+
+		profilematlabcodesinglesessiontool.New,
+		wire.Bind(new(profilematlabcodesinglesessiontool.Usecase), new(*profilematlabcode.Usecase)),
+
+		profilematlabcode.New,
+		wire.Bind(new(profilematlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(profilematlabcode.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(profilematlabcode.CodePolicy), new(*codepolicy.Enforcer)),
+
+		// Code Policy
+		codepolicy.New,
+		wire.Bind(new(codepolicy.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(codepolicy.OSLayer), new(*osfacade.OsFacade)),
 
 		// Custom Tool Factory
 		custom.NewFactory,
 		wire.Bind(new(custom.Loader), new(*customloader.Loader)),
 		wire.Bind(new(custom.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(custom.FunctionCallAssembler), new(*functioncall.Assembler)),
 
 		// Custom Tool Loader
 		customloader.NewLoader,
@@ -314,6 +602,7 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		// EvalCustomTool Use Case
 		evalcustomtool.New,
 		wire.Bind(new(evalcustomtool.FunctionCallAssembler), new(*functioncall.Assembler)),
+		wire.Bind(new(evalcustomtool.CodePolicy), new(*codepolicy.Enforcer)),
 		functioncall.NewAssembler,
 		wire.Bind(new(custom.Usecase), new(*evalcustomtool.Usecase)),
 
@@ -323,6 +612,22 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		codingguidelines.New,
 		plaintextlivecodegeneration.New,
 
+		matlabhelpresource.New,
+		wire.Bind(new(matlabhelpresource.Usecase), new(*matlabhelp.Usecase)),
+		wire.Bind(new(matlabhelpresource.FunctionCompleter), new(*functioncompleter.FunctionCompleter)),
+
+		functioncompleter.New,
+		wire.Bind(new(functioncompleter.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+
+		matlabhelp.New,
+		wire.Bind(new(matlabhelp.HelpReader), new(*helpreader.Reader)),
+		wire.Bind(new(matlabhelp.ReleaseGetter), new(*helpreader.Reader)),
+
+		matlaboutput.New,
+		wire.Bind(new(matlaboutput.OutputStore), new(*responseconverter.OutputStore)),
+
+		helpreader.New,
+
 		// Watchdog Client
 		watchdogclient.New,
 		wire.Bind(new(watchdogclient.WatchdogProcess), new(*process.Factory)),
@@ -366,9 +671,15 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(matlabstartingdirselector.RootStore), new(*rootstore.RootStore)),
 		wire.Bind(new(matlabstartingdirselector.RootPathResolver), new(*rootpathresolver.RootPathResolver)),
 
+		// MATLAB Project Detector
+		matlabprojectdetector.New,
+		wire.Bind(new(matlabprojectdetector.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(matlabprojectdetector.RootStore), new(*rootstore.RootStore)),
+		wire.Bind(new(matlabprojectdetector.RootPathResolver), new(*rootpathresolver.RootPathResolver)),
+
 		// Entities
-		wire.Bind(new(entities.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
-		wire.Bind(new(entities.MATLABManager), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(entities.GlobalMATLAB), new(*audit.GlobalMATLAB)),
+		wire.Bind(new(entities.MATLABManager), new(*audit.MATLABManager)),
 
 		// MATLAB Manager
 		matlabmanager.New,
@@ -377,6 +688,24 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		wire.Bind(new(matlabmanager.MATLABSessionStore), new(*matlabsessionstore.Store)),
 		wire.Bind(new(matlabmanager.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
 		wire.Bind(new(matlabmanager.SessionSelector), new(*sessionselector.SessionSelector)),
+		wire.Bind(new(matlabmanager.LaunchSettingsProvider), new(*launchsettings.Provider)),
+		wire.Bind(new(matlabmanager.MATLABSessionPool), new(*matlabsessionpool.Pool)),
+		wire.Bind(new(matlabmanager.MATLABProjectOpener), new(*matlabproject.Usecase)),
+
+		// MATLAB Launch Settings
+		launchsettings.New,
+		wire.Bind(new(launchsettings.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(launchsettings.OSLayer), new(*osfacade.OsFacade)),
+
+		// MATLAB Session Pool
+		matlabsessionpool.New,
+		wire.Bind(new(matlabsessionpool.ConfigFactory), new(*config.Factory)),
+		wire.Bind(new(matlabsessionpool.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabsessionpool.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(matlabsessionpool.MATLABSessionLauncher), new(*matlabservices.MATLABServices)),
+		matlabsessionpool.NewWarmer,
+		wire.Bind(new(matlabsessionpool.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
+		wire.Bind(new(matlabsessionpool.MATLABManager), new(*matlabmanager.MATLABManager)),
 
 		// Session Selector
 		sessionselector.New,
@@ -397,6 +726,7 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 		matlabroot.New,
 		wire.Bind(new(matlabroot.OSLayer), new(*osfacade.OsFacade)),
 		wire.Bind(new(matlabroot.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(matlabroot.ConfigFactory), new(*config.Factory)),
 
 		// MATLAB Version Getter
 		matlabversion.New,
@@ -426,9 +756,11 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 
 		// Local MATLAB Process Launcher
 		processlauncher.New,
+		wire.Bind(new(processlauncher.MemoryLimiter), new(*resourcelimit.Manager)),
 
 		// MATLAB Session Store
 		matlabsessionstore.New,
+		wire.Bind(new(matlabsessionstore.ConfigFactory), new(*config.Factory)),
 		wire.Bind(new(matlabsessionstore.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(matlabsessionstore.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
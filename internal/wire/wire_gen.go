@@ -12,23 +12,36 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/directory"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/lifecyclesignaler"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/analyze"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/doctor"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/modeselector/modes/setupmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/orchestrator"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/defaultparameters/selector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/parameter/parser"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/audit"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/buildinfo"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/codepolicy"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/filesystem/files"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/filesystem/snapshotstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager/matlabprojectdetector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/globalmatlab/sessionmanager/matlabstartingdirselector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/http/client"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/http/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/codeanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/dependencyanalyzer"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/helpreader"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/livescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabinstallation"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/matlabrootselector"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/projectmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/workspacemanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/addonmanager"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/addonmanager/installationsteps"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	directory2 "github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directory"
@@ -39,33 +52,68 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionpool"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/sessionselector/sessiondiscovery/appdatadir"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/functioncompleter"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/completion/pathcompleter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/codingguidelines"
+	matlabhelp2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlaboutput"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/plaintextlivecodegeneration"
 	server3 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/projectconfig"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/rootpathresolver"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/rootstore"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/server/sdk"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/confirmation"
+	matlabsessionstatus2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/matlabsessionstatus"
 	evalmatlabcode2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabs2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
 	startmatlabsession2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsession2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependencies2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	analyzematlabproject2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/analyzematlabproject"
 	checkmatlabcode2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/closematlabproject"
+	convertlivescript2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/convertlivescript"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/loader"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/custom/loader/validator"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/deleteworkspacesnapshot"
 	detectmatlabtoolboxes2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcode3 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/getmatlabdebugstack"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listmatlabprojectfiles"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/listworkspacesnapshots"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/openmatlabproject"
+	profilematlabcode2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/restoreworkspace"
 	runmatlabfile2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabprojectchecks"
+	runmatlabsections2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabsections"
 	runmatlabtestfile2 "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkgetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinklistblocks"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkopenmodel"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksetblockparams"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinksim"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/simulinkupdatediagram"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/snapshotworkspace"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/singlesession/stepmatlabdebugger"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/messagecatalog"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/os"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/resourcelimit"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sarif"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry/otel/instruments"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/telemetry/otel/meter/exporter"
@@ -78,23 +126,36 @@ import (
 	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
 	"github.com/matlab/matlab-mcp-server/internal/facades/registryfacade"
 	"github.com/matlab/matlab-mcp-server/internal/facades/unix"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/convertlivescript"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/debugmatlab"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalcustomtool/functioncall"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabsessionstatus"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/profilematlabcode"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabsections"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/stopmatlabsession"
 	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/processhandler"
 	client2 "github.com/matlab/matlab-mcp-server/internal/watchdog/transport/client"
 	server2 "github.com/matlab/matlab-mcp-server/internal/watchdog/transport/server"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/transport/server/handler"
 	"github.com/matlab/matlab-mcp-server/internal/watchdog/transport/socket"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"time"
 )
 
 // Injectors from wire.go:
@@ -124,68 +185,131 @@ func Initialize(serverDefinition ApplicationDefinition) *Application {
 	socketFactory := socket.NewFactory(directoryFactory, osFacade)
 	watchdogWatchdog := watchdog.New(loggerFactory, osFacade, processHandler, processManager, handlerFactory, factory2, socketFactory)
 	rootStore := rootstore.New()
+	rootPathResolver := rootpathresolver.New(osFacade)
+	projectConfig := projectconfig.New(rootStore, rootPathResolver, factory, osFacade, loggerFactory)
 	fileFacade := filefacade.New()
-	getter := matlabroot.New(osFacade, fileFacade)
+	getter := matlabroot.New(osFacade, fileFacade, factory)
 	ioFacade := iofacade.New()
 	matlabversionGetter := matlabversion.New(osFacade, ioFacade)
 	matlabLocator := matlablocator.New(getter, matlabversionGetter)
 	matlabFiles := matlabfiles.New()
 	factory3 := directory2.NewFactory(osFacade, directoryFactory, matlabFiles, factory)
 	processDetails := processdetails.New(osFacade)
-	matlabProcessLauncher := processlauncher.New()
+	unixFacade := unix.New()
+	manager := resourcelimit.New(loggerFactory, unixFacade)
+	matlabProcessLauncher := processlauncher.New(manager)
 	processFactory := process.New(osFacade, loggerFactory, directoryFactory, factory)
 	clientFactory := client.NewFactory()
 	factory4 := client2.NewFactory(osFacade, loggerFactory, clientFactory)
 	watchdog3 := watchdog2.New(processFactory, factory4, loggerFactory, socketFactory)
 	starter := localmatlabsession.NewStarter(factory3, processDetails, matlabProcessLauncher, watchdog3)
 	matlabServices := matlabservices.New(matlabLocator, starter)
-	store := matlabsessionstore.New(loggerFactory, lifecycleSignaler)
+	store := matlabsessionstore.New(factory, loggerFactory, lifecycleSignaler)
 	matlabsessionclientFactory := matlabsessionclient.NewFactory(clientFactory)
 	appdatadirGetter := appdatadir.New(osFacade)
 	sessionDiscoverer := sessiondiscovery.New(appdatadirGetter, osFacade)
 	sessionSelector := sessionselector.New(factory, sessionDiscoverer)
-	matlabManager := matlabmanager.New(factory, matlabServices, store, matlabsessionclientFactory, sessionSelector)
+	launchsettingsProvider := launchsettings.New(factory, osFacade)
+	pool := matlabsessionpool.New(factory, loggerFactory, lifecycleSignaler, matlabServices)
+	pathValidator := pathvalidator.New(osFacade)
+	matlabProjectDetector := matlabprojectdetector.New(osFacade, rootStore, rootPathResolver)
+	projectmanagerManager := projectmanager.New()
+	usecase := matlabproject.New(pathValidator, matlabProjectDetector, projectmanagerManager)
+	matlabManager := matlabmanager.New(factory, matlabServices, store, matlabsessionclientFactory, sessionSelector, launchsettingsProvider, pool, usecase)
 	matlabRootSelector := matlabrootselector.New(factory, matlabManager)
-	rootPathResolver := rootpathresolver.New(osFacade)
 	matlabStartingDirSelector := matlabstartingdirselector.New(factory, osFacade, rootStore, rootPathResolver)
 	sessionManager := sessionmanager.New(matlabManager, factory, matlabRootSelector, matlabStartingDirSelector)
 	globalMATLAB := globalmatlab.New(sessionManager)
-	sdkFactory := sdk.NewFactory(factory, serverDefinition, rootStore, loggerFactory, globalMATLAB, telemetryFactory)
-	usecase := listavailablematlabs.New(matlabManager)
-	tool := listavailablematlabs2.New(loggerFactory, usecase)
-	startmatlabsessionUsecase := startmatlabsession.New(matlabManager)
+	warmer := matlabsessionpool.NewWarmer(factory, matlabRootSelector, matlabManager)
+	log := audit.New(factory, osFacade)
+	registry := completion.New(loggerFactory)
+	outputStore := responseconverter.NewOutputStore()
+	outputLimiter := responseconverter.NewOutputLimiter(outputStore)
+	sdkFactory := sdk.NewFactory(factory, serverDefinition, projectConfig, loggerFactory, globalMATLAB, telemetryFactory, warmer, log, registry, outputLimiter)
+	auditMATLABManager := audit.NewMATLABManager(matlabManager)
+	listavailablematlabsUsecase := listavailablematlabs.New(auditMATLABManager)
+	tool := listavailablematlabs2.New(loggerFactory, listavailablematlabsUsecase)
+	startmatlabsessionUsecase := startmatlabsession.New(auditMATLABManager)
 	startmatlabsessionTool := startmatlabsession2.New(loggerFactory, factory, startmatlabsessionUsecase)
-	stopmatlabsessionUsecase := stopmatlabsession.New(matlabManager)
-	stopmatlabsessionTool := stopmatlabsession2.New(loggerFactory, stopmatlabsessionUsecase)
-	pathValidator := pathvalidator.New(osFacade)
-	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator)
-	evalmatlabcodeTool := evalmatlabcode2.New(loggerFactory, factory, evalmatlabcodeUsecase, matlabManager)
-	tool2 := evalmatlabcode3.New(loggerFactory, factory, evalmatlabcodeUsecase, globalMATLAB)
+	confirmer := confirmation.New(factory)
+	stopmatlabsessionUsecase := stopmatlabsession.New(auditMATLABManager)
+	stopmatlabsessionTool := stopmatlabsession2.New(loggerFactory, confirmer, stopmatlabsessionUsecase)
+	enforcer := codepolicy.New(factory, osFacade)
+	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator, enforcer)
+	evalmatlabcodeTool := evalmatlabcode2.New(loggerFactory, confirmer, factory, evalmatlabcodeUsecase, auditMATLABManager)
+	auditGlobalMATLAB := audit.NewGlobalMATLAB(globalMATLAB)
+	tool2 := evalmatlabcode3.New(loggerFactory, confirmer, factory, evalmatlabcodeUsecase, auditGlobalMATLAB)
+	pathCompleter := pathcompleter.New(osFacade, rootStore, rootPathResolver)
 	analyzer := codeanalyzer.New()
 	checkmatlabcodeUsecase := checkmatlabcode.New(pathValidator, analyzer)
-	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, checkmatlabcodeUsecase, globalMATLAB)
-	detectmatlabtoolboxesUsecase := detectmatlabtoolboxes.New()
-	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(loggerFactory, detectmatlabtoolboxesUsecase, globalMATLAB)
-	runmatlabfileUsecase := runmatlabfile.New(pathValidator)
-	runmatlabfileTool := runmatlabfile2.New(loggerFactory, factory, runmatlabfileUsecase, globalMATLAB)
-	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator)
-	runmatlabtestfileTool := runmatlabtestfile2.New(loggerFactory, runmatlabtestfileUsecase, globalMATLAB)
+	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, pathCompleter, checkmatlabcodeUsecase, auditGlobalMATLAB)
+	reader := matlabinstallation.New(osFacade, fileFacade)
+	detectmatlabtoolboxesUsecase := detectmatlabtoolboxes.New(reader)
+	detectmatlabtoolboxesTool := detectmatlabtoolboxes2.New(loggerFactory, factory, matlabRootSelector, detectmatlabtoolboxesUsecase, auditGlobalMATLAB, globalMATLAB)
+	converter := livescript.New()
+	runmatlabfileUsecase := runmatlabfile.New(pathValidator, enforcer, converter)
+	runmatlabfileTool := runmatlabfile2.New(loggerFactory, confirmer, pathCompleter, factory, runmatlabfileUsecase, auditGlobalMATLAB)
+	runmatlabsectionsUsecase := runmatlabsections.New(pathValidator, osFacade, enforcer)
+	runmatlabsectionsTool := runmatlabsections2.New(loggerFactory, confirmer, factory, runmatlabsectionsUsecase, auditGlobalMATLAB)
+	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator, enforcer)
+	runmatlabtestfileTool := runmatlabtestfile2.New(loggerFactory, confirmer, pathCompleter, runmatlabtestfileUsecase, auditGlobalMATLAB)
+	debugmatlabUsecase := debugmatlab.New(pathValidator, enforcer)
+	setmatlabbreakpointTool := setmatlabbreakpoint.New(loggerFactory, confirmer, pathCompleter, debugmatlabUsecase, auditGlobalMATLAB)
+	clearmatlabbreakpointsTool := clearmatlabbreakpoints.New(loggerFactory, confirmer, debugmatlabUsecase, auditGlobalMATLAB)
+	debugmatlabcodeTool := debugmatlabcode.New(loggerFactory, confirmer, debugmatlabUsecase, auditGlobalMATLAB)
+	getmatlabdebugstackTool := getmatlabdebugstack.New(loggerFactory, debugmatlabUsecase, auditGlobalMATLAB)
+	stepmatlabdebuggerTool := stepmatlabdebugger.New(loggerFactory, confirmer, debugmatlabUsecase, auditGlobalMATLAB)
+	profilematlabcodeUsecase := profilematlabcode.New(pathValidator, osFacade, enforcer)
+	profilematlabcodeTool := profilematlabcode2.New(loggerFactory, confirmer, profilematlabcodeUsecase, auditGlobalMATLAB)
+	analyzematlabprojectUsecase := analyzematlabproject.New(pathValidator, osFacade, analyzer)
+	fileWriter := sarif.NewFileWriter(osFacade)
+	analyzematlabprojectTool := analyzematlabproject2.New(loggerFactory, analyzematlabprojectUsecase, auditGlobalMATLAB, fileWriter)
+	dependencyanalyzerAnalyzer := dependencyanalyzer.New()
+	analyzematlabdependenciesUsecase := analyzematlabdependencies.New(pathValidator, osFacade, dependencyanalyzerAnalyzer, detectmatlabtoolboxesUsecase)
+	analyzematlabdependenciesTool := analyzematlabdependencies2.New(loggerFactory, analyzematlabdependenciesUsecase, auditGlobalMATLAB)
+	convertlivescriptUsecase := convertlivescript.New(pathValidator, osFacade, converter)
+	convertlivescriptTool := convertlivescript2.New(loggerFactory, confirmer, pathCompleter, convertlivescriptUsecase, auditGlobalMATLAB)
+	simulinkUsecase := simulink.New(pathValidator, enforcer)
+	simulinkopenmodelTool := simulinkopenmodel.New(loggerFactory, confirmer, simulinkUsecase, auditGlobalMATLAB)
+	simulinklistblocksTool := simulinklistblocks.New(loggerFactory, simulinkUsecase, auditGlobalMATLAB)
+	simulinkgetblockparamsTool := simulinkgetblockparams.New(loggerFactory, simulinkUsecase, auditGlobalMATLAB)
+	simulinksetblockparamsTool := simulinksetblockparams.New(loggerFactory, confirmer, simulinkUsecase, auditGlobalMATLAB)
+	simulinkupdatediagramTool := simulinkupdatediagram.New(loggerFactory, confirmer, simulinkUsecase, auditGlobalMATLAB)
+	simulinksimTool := simulinksim.New(loggerFactory, confirmer, simulinkUsecase, auditGlobalMATLAB)
+	openmatlabprojectTool := openmatlabproject.New(loggerFactory, confirmer, pathCompleter, usecase, auditGlobalMATLAB)
+	closematlabprojectTool := closematlabproject.New(loggerFactory, confirmer, usecase, auditGlobalMATLAB)
+	listmatlabprojectfilesTool := listmatlabprojectfiles.New(loggerFactory, usecase, auditGlobalMATLAB)
+	runmatlabprojectchecksTool := runmatlabprojectchecks.New(loggerFactory, usecase, auditGlobalMATLAB)
+	workspacemanagerManager := workspacemanager.New()
+	snapshotstoreStore := snapshotstore.New(factory, osFacade)
+	workspacesnapshotUsecase := workspacesnapshot.New(workspacemanagerManager, snapshotstoreStore)
+	snapshotworkspaceTool := snapshotworkspace.New(loggerFactory, confirmer, workspacesnapshotUsecase, auditGlobalMATLAB)
+	restoreworkspaceTool := restoreworkspace.New(loggerFactory, confirmer, workspacesnapshotUsecase, auditGlobalMATLAB)
+	listworkspacesnapshotsTool := listworkspacesnapshots.New(loggerFactory, workspacesnapshotUsecase, auditGlobalMATLAB)
+	deleteworkspacesnapshotTool := deleteworkspacesnapshot.New(loggerFactory, confirmer, workspacesnapshotUsecase, auditGlobalMATLAB)
+	matlabsessionstatusUsecase := matlabsessionstatus.New(store)
+	matlabsessionstatusTool := matlabsessionstatus2.New(loggerFactory, matlabsessionstatusUsecase)
 	resource := codingguidelines.New(loggerFactory)
 	plaintextlivecodegenerationResource := plaintextlivecodegeneration.New(loggerFactory)
+	helpreaderReader := helpreader.New()
+	matlabhelpUsecase := matlabhelp.New(helpreaderReader, helpreaderReader)
+	functionCompleter := functioncompleter.New(globalMATLAB)
+	matlabhelpResource := matlabhelp2.New(loggerFactory, matlabhelpUsecase, auditGlobalMATLAB, functionCompleter)
+	matlaboutputResource := matlaboutput.New(loggerFactory, outputStore)
 	validatorValidator := validator.NewValidator()
 	loaderLoader := loader.NewLoader(osFacade, loggerFactory, validatorValidator)
 	assembler := functioncall.NewAssembler()
-	evalcustomtoolUsecase := evalcustomtool.New(assembler)
-	customFactory := custom.NewFactory(loaderLoader, loggerFactory, evalcustomtoolUsecase, globalMATLAB, factory)
-	configuratorConfigurator := configurator.New(factory, serverDefinition, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, resource, plaintextlivecodegenerationResource, customFactory)
-	serverServer := server3.New(sdkFactory, loggerFactory, lifecycleSignaler, configuratorConfigurator)
-	unixFacade := unix.New()
-	manager := resourcelimit.New(loggerFactory, unixFacade)
+	evalcustomtoolUsecase := evalcustomtool.New(assembler, enforcer)
+	customFactory := custom.NewFactory(loaderLoader, loggerFactory, confirmer, assembler, evalcustomtoolUsecase, auditGlobalMATLAB, factory)
+	configuratorConfigurator := configurator.New(factory, serverDefinition, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabsectionsTool, runmatlabtestfileTool, setmatlabbreakpointTool, clearmatlabbreakpointsTool, debugmatlabcodeTool, getmatlabdebugstackTool, stepmatlabdebuggerTool, profilematlabcodeTool, analyzematlabprojectTool, analyzematlabdependenciesTool, convertlivescriptTool, simulinkopenmodelTool, simulinklistblocksTool, simulinkgetblockparamsTool, simulinksetblockparamsTool, simulinkupdatediagramTool, simulinksimTool, openmatlabprojectTool, closematlabprojectTool, listmatlabprojectfilesTool, runmatlabprojectchecksTool, snapshotworkspaceTool, restoreworkspaceTool, listworkspacesnapshotsTool, deleteworkspacesnapshotTool, matlabsessionstatusTool, resource, plaintextlivecodegenerationResource, matlabhelpResource, matlaboutputResource, customFactory)
+	serverServer := server3.New(sdkFactory, loggerFactory, lifecycleSignaler, configuratorConfigurator, registry)
 	orchestratorOrchestrator := orchestrator.New(messageCatalog, lifecycleSignaler, serverDefinition, factory, serverServer, watchdog3, loggerFactory, processManager, directoryFactory, manager)
 	installationSteps := installationsteps.New()
 	addonManager := addonmanager.New(installationSteps)
 	mode := setupmatlab.New(osFacade, messageCatalog, loggerFactory, directoryFactory, watchdog3, globalMATLAB, addonManager)
-	modeSelector := modeselector.New(factory, parserParser, telemetryFactory, watchdogWatchdog, orchestratorOrchestrator, osFacade, lifecycleSignaler, loggerFactory, mode)
+	doctorMode := doctor.New(factory, osFacade, loggerFactory, directoryFactory, matlabManager, matlabversionGetter, matlabRootSelector, sessionDiscoverer, processManager, clientFactory, matlabsessionclientFactory, watchdog3, loaderLoader)
+	analyzeMode := analyze.New(factory, osFacade, loggerFactory, watchdog3, globalMATLAB, analyzematlabprojectUsecase)
+	modeSelector := modeselector.New(factory, parserParser, telemetryFactory, watchdogWatchdog, orchestratorOrchestrator, osFacade, lifecycleSignaler, loggerFactory, mode, doctorMode, analyzeMode)
 	application := &Application{
 		ModeSelector:              modeSelector,
 		MessageCatalog:            messageCatalog,
@@ -219,4 +343,7 @@ type ApplicationDefinition interface {
 	Parameters() []entities.Parameter
 	Dependencies(resources definition.DependenciesProviderResources) (any, error)
 	Tools(resources definition.ToolsProviderResources) []tools.Tool
+	ToolMiddleware(resources definition.ToolsProviderResources) []mcp.Middleware
+	LifecycleHooks() []definition.LifecycleHook
+	LifecycleHookTimeout() time.Duration
 }
@@ -39,6 +39,94 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// AnalyzeFolder provides a mock function for the type MockConfig
+func (_mock *MockConfig) AnalyzeFolder() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeFolder")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_AnalyzeFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeFolder'
+type MockConfig_AnalyzeFolder_Call struct {
+	*mock.Call
+}
+
+// AnalyzeFolder is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AnalyzeFolder() *MockConfig_AnalyzeFolder_Call {
+	return &MockConfig_AnalyzeFolder_Call{Call: _e.mock.On("AnalyzeFolder")}
+}
+
+func (_c *MockConfig_AnalyzeFolder_Call) Run(run func()) *MockConfig_AnalyzeFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_AnalyzeFolder_Call) Return(s string) *MockConfig_AnalyzeFolder_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_AnalyzeFolder_Call) RunAndReturn(run func() string) *MockConfig_AnalyzeFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnalyzeFormat provides a mock function for the type MockConfig
+func (_mock *MockConfig) AnalyzeFormat() entities.ReportFormat {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeFormat")
+	}
+
+	var r0 entities.ReportFormat
+	if returnFunc, ok := ret.Get(0).(func() entities.ReportFormat); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.ReportFormat)
+	}
+	return r0
+}
+
+// MockConfig_AnalyzeFormat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeFormat'
+type MockConfig_AnalyzeFormat_Call struct {
+	*mock.Call
+}
+
+// AnalyzeFormat is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AnalyzeFormat() *MockConfig_AnalyzeFormat_Call {
+	return &MockConfig_AnalyzeFormat_Call{Call: _e.mock.On("AnalyzeFormat")}
+}
+
+func (_c *MockConfig_AnalyzeFormat_Call) Run(run func()) *MockConfig_AnalyzeFormat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_AnalyzeFormat_Call) Return(reportFormat entities.ReportFormat) *MockConfig_AnalyzeFormat_Call {
+	_c.Call.Return(reportFormat)
+	return _c
+}
+
+func (_c *MockConfig_AnalyzeFormat_Call) RunAndReturn(run func() entities.ReportFormat) *MockConfig_AnalyzeFormat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AsPIISafeJSONString provides a mock function for the type MockConfig
 func (_mock *MockConfig) AsPIISafeJSONString() string {
 	ret := _mock.Called()
@@ -83,12 +171,12 @@ func (_c *MockConfig_AsPIISafeJSONString_Call) RunAndReturn(run func() string) *
 	return _c
 }
 
-// BaseDir provides a mock function for the type MockConfig
-func (_mock *MockConfig) BaseDir() string {
+// AuditLogFolder provides a mock function for the type MockConfig
+func (_mock *MockConfig) AuditLogFolder() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for BaseDir")
+		panic("no return value specified for AuditLogFolder")
 	}
 
 	var r0 string
@@ -100,39 +188,39 @@ func (_mock *MockConfig) BaseDir() string {
 	return r0
 }
 
-// MockConfig_BaseDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseDir'
-type MockConfig_BaseDir_Call struct {
+// MockConfig_AuditLogFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogFolder'
+type MockConfig_AuditLogFolder_Call struct {
 	*mock.Call
 }
 
-// BaseDir is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) BaseDir() *MockConfig_BaseDir_Call {
-	return &MockConfig_BaseDir_Call{Call: _e.mock.On("BaseDir")}
+// AuditLogFolder is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AuditLogFolder() *MockConfig_AuditLogFolder_Call {
+	return &MockConfig_AuditLogFolder_Call{Call: _e.mock.On("AuditLogFolder")}
 }
 
-func (_c *MockConfig_BaseDir_Call) Run(run func()) *MockConfig_BaseDir_Call {
+func (_c *MockConfig_AuditLogFolder_Call) Run(run func()) *MockConfig_AuditLogFolder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_BaseDir_Call) Return(s string) *MockConfig_BaseDir_Call {
+func (_c *MockConfig_AuditLogFolder_Call) Return(s string) *MockConfig_AuditLogFolder_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_BaseDir_Call) RunAndReturn(run func() string) *MockConfig_BaseDir_Call {
+func (_c *MockConfig_AuditLogFolder_Call) RunAndReturn(run func() string) *MockConfig_AuditLogFolder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DisableTelemetry provides a mock function for the type MockConfig
-func (_mock *MockConfig) DisableTelemetry() bool {
+// AuditLogHashChain provides a mock function for the type MockConfig
+func (_mock *MockConfig) AuditLogHashChain() bool {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for DisableTelemetry")
+		panic("no return value specified for AuditLogHashChain")
 	}
 
 	var r0 bool
@@ -144,212 +232,564 @@ func (_mock *MockConfig) DisableTelemetry() bool {
 	return r0
 }
 
-// MockConfig_DisableTelemetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableTelemetry'
-type MockConfig_DisableTelemetry_Call struct {
+// MockConfig_AuditLogHashChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogHashChain'
+type MockConfig_AuditLogHashChain_Call struct {
 	*mock.Call
 }
 
-// DisableTelemetry is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) DisableTelemetry() *MockConfig_DisableTelemetry_Call {
-	return &MockConfig_DisableTelemetry_Call{Call: _e.mock.On("DisableTelemetry")}
+// AuditLogHashChain is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AuditLogHashChain() *MockConfig_AuditLogHashChain_Call {
+	return &MockConfig_AuditLogHashChain_Call{Call: _e.mock.On("AuditLogHashChain")}
 }
 
-func (_c *MockConfig_DisableTelemetry_Call) Run(run func()) *MockConfig_DisableTelemetry_Call {
+func (_c *MockConfig_AuditLogHashChain_Call) Run(run func()) *MockConfig_AuditLogHashChain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_DisableTelemetry_Call) Return(b bool) *MockConfig_DisableTelemetry_Call {
+func (_c *MockConfig_AuditLogHashChain_Call) Return(b bool) *MockConfig_AuditLogHashChain_Call {
 	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockConfig_DisableTelemetry_Call) RunAndReturn(run func() bool) *MockConfig_DisableTelemetry_Call {
+func (_c *MockConfig_AuditLogHashChain_Call) RunAndReturn(run func() bool) *MockConfig_AuditLogHashChain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DuplicateLogsToStderr provides a mock function for the type MockConfig
-func (_mock *MockConfig) DuplicateLogsToStderr() bool {
+// AuditLogMaxSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) AuditLogMaxSize() uint64 {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for DuplicateLogsToStderr")
+		panic("no return value specified for AuditLogMaxSize")
 	}
 
-	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint64)
 	}
 	return r0
 }
 
-// MockConfig_DuplicateLogsToStderr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateLogsToStderr'
-type MockConfig_DuplicateLogsToStderr_Call struct {
+// MockConfig_AuditLogMaxSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogMaxSize'
+type MockConfig_AuditLogMaxSize_Call struct {
 	*mock.Call
 }
 
-// DuplicateLogsToStderr is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) DuplicateLogsToStderr() *MockConfig_DuplicateLogsToStderr_Call {
-	return &MockConfig_DuplicateLogsToStderr_Call{Call: _e.mock.On("DuplicateLogsToStderr")}
+// AuditLogMaxSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) AuditLogMaxSize() *MockConfig_AuditLogMaxSize_Call {
+	return &MockConfig_AuditLogMaxSize_Call{Call: _e.mock.On("AuditLogMaxSize")}
 }
 
-func (_c *MockConfig_DuplicateLogsToStderr_Call) Run(run func()) *MockConfig_DuplicateLogsToStderr_Call {
+func (_c *MockConfig_AuditLogMaxSize_Call) Run(run func()) *MockConfig_AuditLogMaxSize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_DuplicateLogsToStderr_Call) Return(b bool) *MockConfig_DuplicateLogsToStderr_Call {
-	_c.Call.Return(b)
+func (_c *MockConfig_AuditLogMaxSize_Call) Return(v uint64) *MockConfig_AuditLogMaxSize_Call {
+	_c.Call.Return(v)
 	return _c
 }
 
-func (_c *MockConfig_DuplicateLogsToStderr_Call) RunAndReturn(run func() bool) *MockConfig_DuplicateLogsToStderr_Call {
+func (_c *MockConfig_AuditLogMaxSize_Call) RunAndReturn(run func() uint64) *MockConfig_AuditLogMaxSize_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EmbeddedConnectorDetailsTimeout provides a mock function for the type MockConfig
-func (_mock *MockConfig) EmbeddedConnectorDetailsTimeout() time.Duration {
+// BaseDir provides a mock function for the type MockConfig
+func (_mock *MockConfig) BaseDir() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for EmbeddedConnectorDetailsTimeout")
+		panic("no return value specified for BaseDir")
 	}
 
-	var r0 time.Duration
-	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(string)
 	}
 	return r0
 }
 
-// MockConfig_EmbeddedConnectorDetailsTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmbeddedConnectorDetailsTimeout'
-type MockConfig_EmbeddedConnectorDetailsTimeout_Call struct {
+// MockConfig_BaseDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseDir'
+type MockConfig_BaseDir_Call struct {
 	*mock.Call
 }
 
-// EmbeddedConnectorDetailsTimeout is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) EmbeddedConnectorDetailsTimeout() *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
-	return &MockConfig_EmbeddedConnectorDetailsTimeout_Call{Call: _e.mock.On("EmbeddedConnectorDetailsTimeout")}
+// BaseDir is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) BaseDir() *MockConfig_BaseDir_Call {
+	return &MockConfig_BaseDir_Call{Call: _e.mock.On("BaseDir")}
 }
 
-func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) Run(run func()) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+func (_c *MockConfig_BaseDir_Call) Run(run func()) *MockConfig_BaseDir_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) Return(duration time.Duration) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
-	_c.Call.Return(duration)
+func (_c *MockConfig_BaseDir_Call) Return(s string) *MockConfig_BaseDir_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+func (_c *MockConfig_BaseDir_Call) RunAndReturn(run func() string) *MockConfig_BaseDir_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExtensionFiles provides a mock function for the type MockConfig
-func (_mock *MockConfig) ExtensionFiles() []string {
+// CodePolicyFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) CodePolicyFile() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExtensionFiles")
+		panic("no return value specified for CodePolicyFile")
 	}
 
-	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
 		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(string)
 	}
 	return r0
 }
 
-// MockConfig_ExtensionFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtensionFiles'
-type MockConfig_ExtensionFiles_Call struct {
+// MockConfig_CodePolicyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CodePolicyFile'
+type MockConfig_CodePolicyFile_Call struct {
 	*mock.Call
 }
 
-// ExtensionFiles is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) ExtensionFiles() *MockConfig_ExtensionFiles_Call {
-	return &MockConfig_ExtensionFiles_Call{Call: _e.mock.On("ExtensionFiles")}
+// CodePolicyFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CodePolicyFile() *MockConfig_CodePolicyFile_Call {
+	return &MockConfig_CodePolicyFile_Call{Call: _e.mock.On("CodePolicyFile")}
 }
 
-func (_c *MockConfig_ExtensionFiles_Call) Run(run func()) *MockConfig_ExtensionFiles_Call {
+func (_c *MockConfig_CodePolicyFile_Call) Run(run func()) *MockConfig_CodePolicyFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_ExtensionFiles_Call) Return(strings []string) *MockConfig_ExtensionFiles_Call {
-	_c.Call.Return(strings)
+func (_c *MockConfig_CodePolicyFile_Call) Return(s string) *MockConfig_CodePolicyFile_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_ExtensionFiles_Call) RunAndReturn(run func() []string) *MockConfig_ExtensionFiles_Call {
+func (_c *MockConfig_CodePolicyFile_Call) RunAndReturn(run func() string) *MockConfig_CodePolicyFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockConfig
-func (_mock *MockConfig) Get(key string) (any, messages.Error) {
-	ret := _mock.Called(key)
+// ConfigFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) ConfigFile() string {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for ConfigFile")
 	}
 
-	var r0 any
-	var r1 messages.Error
-	if returnFunc, ok := ret.Get(0).(func(string) (any, messages.Error)); ok {
-		return returnFunc(key)
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) any); ok {
-		r0 = returnFunc(key)
+	return r0
+}
+
+// MockConfig_ConfigFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigFile'
+type MockConfig_ConfigFile_Call struct {
+	*mock.Call
+}
+
+// ConfigFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ConfigFile() *MockConfig_ConfigFile_Call {
+	return &MockConfig_ConfigFile_Call{Call: _e.mock.On("ConfigFile")}
+}
+
+func (_c *MockConfig_ConfigFile_Call) Run(run func()) *MockConfig_ConfigFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ConfigFile_Call) Return(s string) *MockConfig_ConfigFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_ConfigFile_Call) RunAndReturn(run func() string) *MockConfig_ConfigFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmDestructiveTools provides a mock function for the type MockConfig
+func (_mock *MockConfig) ConfirmDestructiveTools() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmDestructiveTools")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(any)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) messages.Error); ok {
-		r1 = returnFunc(key)
+	return r0
+}
+
+// MockConfig_ConfirmDestructiveTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmDestructiveTools'
+type MockConfig_ConfirmDestructiveTools_Call struct {
+	*mock.Call
+}
+
+// ConfirmDestructiveTools is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ConfirmDestructiveTools() *MockConfig_ConfirmDestructiveTools_Call {
+	return &MockConfig_ConfirmDestructiveTools_Call{Call: _e.mock.On("ConfirmDestructiveTools")}
+}
+
+func (_c *MockConfig_ConfirmDestructiveTools_Call) Run(run func()) *MockConfig_ConfirmDestructiveTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ConfirmDestructiveTools_Call) Return(b bool) *MockConfig_ConfirmDestructiveTools_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ConfirmDestructiveTools_Call) RunAndReturn(run func() bool) *MockConfig_ConfirmDestructiveTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableTelemetry provides a mock function for the type MockConfig
+func (_mock *MockConfig) DisableTelemetry() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableTelemetry")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(messages.Error)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockConfig_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockConfig_Get_Call struct {
+// MockConfig_DisableTelemetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableTelemetry'
+type MockConfig_DisableTelemetry_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - key string
-func (_e *MockConfig_Expecter) Get(key interface{}) *MockConfig_Get_Call {
-	return &MockConfig_Get_Call{Call: _e.mock.On("Get", key)}
+// DisableTelemetry is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DisableTelemetry() *MockConfig_DisableTelemetry_Call {
+	return &MockConfig_DisableTelemetry_Call{Call: _e.mock.On("DisableTelemetry")}
 }
 
-func (_c *MockConfig_Get_Call) Run(run func(key string)) *MockConfig_Get_Call {
+func (_c *MockConfig_DisableTelemetry_Call) Run(run func()) *MockConfig_DisableTelemetry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
-		if args[0] != nil {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DisableTelemetry_Call) Return(b bool) *MockConfig_DisableTelemetry_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DisableTelemetry_Call) RunAndReturn(run func() bool) *MockConfig_DisableTelemetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DoctorFormat provides a mock function for the type MockConfig
+func (_mock *MockConfig) DoctorFormat() entities.ReportFormat {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoctorFormat")
+	}
+
+	var r0 entities.ReportFormat
+	if returnFunc, ok := ret.Get(0).(func() entities.ReportFormat); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.ReportFormat)
+	}
+	return r0
+}
+
+// MockConfig_DoctorFormat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoctorFormat'
+type MockConfig_DoctorFormat_Call struct {
+	*mock.Call
+}
+
+// DoctorFormat is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DoctorFormat() *MockConfig_DoctorFormat_Call {
+	return &MockConfig_DoctorFormat_Call{Call: _e.mock.On("DoctorFormat")}
+}
+
+func (_c *MockConfig_DoctorFormat_Call) Run(run func()) *MockConfig_DoctorFormat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DoctorFormat_Call) Return(reportFormat entities.ReportFormat) *MockConfig_DoctorFormat_Call {
+	_c.Call.Return(reportFormat)
+	return _c
+}
+
+func (_c *MockConfig_DoctorFormat_Call) RunAndReturn(run func() entities.ReportFormat) *MockConfig_DoctorFormat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DoctorMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) DoctorMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoctorMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_DoctorMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoctorMode'
+type MockConfig_DoctorMode_Call struct {
+	*mock.Call
+}
+
+// DoctorMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DoctorMode() *MockConfig_DoctorMode_Call {
+	return &MockConfig_DoctorMode_Call{Call: _e.mock.On("DoctorMode")}
+}
+
+func (_c *MockConfig_DoctorMode_Call) Run(run func()) *MockConfig_DoctorMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DoctorMode_Call) Return(b bool) *MockConfig_DoctorMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DoctorMode_Call) RunAndReturn(run func() bool) *MockConfig_DoctorMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateLogsToStderr provides a mock function for the type MockConfig
+func (_mock *MockConfig) DuplicateLogsToStderr() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DuplicateLogsToStderr")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_DuplicateLogsToStderr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateLogsToStderr'
+type MockConfig_DuplicateLogsToStderr_Call struct {
+	*mock.Call
+}
+
+// DuplicateLogsToStderr is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DuplicateLogsToStderr() *MockConfig_DuplicateLogsToStderr_Call {
+	return &MockConfig_DuplicateLogsToStderr_Call{Call: _e.mock.On("DuplicateLogsToStderr")}
+}
+
+func (_c *MockConfig_DuplicateLogsToStderr_Call) Run(run func()) *MockConfig_DuplicateLogsToStderr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DuplicateLogsToStderr_Call) Return(b bool) *MockConfig_DuplicateLogsToStderr_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_DuplicateLogsToStderr_Call) RunAndReturn(run func() bool) *MockConfig_DuplicateLogsToStderr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EmbeddedConnectorDetailsTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) EmbeddedConnectorDetailsTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmbeddedConnectorDetailsTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_EmbeddedConnectorDetailsTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmbeddedConnectorDetailsTimeout'
+type MockConfig_EmbeddedConnectorDetailsTimeout_Call struct {
+	*mock.Call
+}
+
+// EmbeddedConnectorDetailsTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) EmbeddedConnectorDetailsTimeout() *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+	return &MockConfig_EmbeddedConnectorDetailsTimeout_Call{Call: _e.mock.On("EmbeddedConnectorDetailsTimeout")}
+}
+
+func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) Run(run func()) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) Return(duration time.Duration) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_EmbeddedConnectorDetailsTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_EmbeddedConnectorDetailsTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtensionFiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) ExtensionFiles() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtensionFiles")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_ExtensionFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtensionFiles'
+type MockConfig_ExtensionFiles_Call struct {
+	*mock.Call
+}
+
+// ExtensionFiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ExtensionFiles() *MockConfig_ExtensionFiles_Call {
+	return &MockConfig_ExtensionFiles_Call{Call: _e.mock.On("ExtensionFiles")}
+}
+
+func (_c *MockConfig_ExtensionFiles_Call) Run(run func()) *MockConfig_ExtensionFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ExtensionFiles_Call) Return(strings []string) *MockConfig_ExtensionFiles_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_ExtensionFiles_Call) RunAndReturn(run func() []string) *MockConfig_ExtensionFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockConfig
+func (_mock *MockConfig) Get(key string) (any, messages.Error) {
+	ret := _mock.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 any
+	var r1 messages.Error
+	if returnFunc, ok := ret.Get(0).(func(string) (any, messages.Error)); ok {
+		return returnFunc(key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) any); ok {
+		r0 = returnFunc(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) messages.Error); ok {
+		r1 = returnFunc(key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(messages.Error)
+		}
+	}
+	return r0, r1
+}
+
+// MockConfig_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockConfig_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - key string
+func (_e *MockConfig_Expecter) Get(key interface{}) *MockConfig_Get_Call {
+	return &MockConfig_Get_Call{Call: _e.mock.On("Get", key)}
+}
+
+func (_c *MockConfig_Get_Call) Run(run func(key string)) *MockConfig_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
 		run(
@@ -359,154 +799,910 @@ func (_c *MockConfig_Get_Call) Run(run func(key string)) *MockConfig_Get_Call {
 	return _c
 }
 
-func (_c *MockConfig_Get_Call) Return(v any, error messages.Error) *MockConfig_Get_Call {
-	_c.Call.Return(v, error)
+func (_c *MockConfig_Get_Call) Return(v any, error messages.Error) *MockConfig_Get_Call {
+	_c.Call.Return(v, error)
+	return _c
+}
+
+func (_c *MockConfig_Get_Call) RunAndReturn(run func(key string) (any, messages.Error)) *MockConfig_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HelpMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) HelpMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HelpMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_HelpMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HelpMode'
+type MockConfig_HelpMode_Call struct {
+	*mock.Call
+}
+
+// HelpMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) HelpMode() *MockConfig_HelpMode_Call {
+	return &MockConfig_HelpMode_Call{Call: _e.mock.On("HelpMode")}
+}
+
+func (_c *MockConfig_HelpMode_Call) Run(run func()) *MockConfig_HelpMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_HelpMode_Call) Return(b bool) *MockConfig_HelpMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_HelpMode_Call) RunAndReturn(run func() bool) *MockConfig_HelpMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitializeMATLABOnStartup provides a mock function for the type MockConfig
+func (_mock *MockConfig) InitializeMATLABOnStartup() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitializeMATLABOnStartup")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_InitializeMATLABOnStartup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitializeMATLABOnStartup'
+type MockConfig_InitializeMATLABOnStartup_Call struct {
+	*mock.Call
+}
+
+// InitializeMATLABOnStartup is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) InitializeMATLABOnStartup() *MockConfig_InitializeMATLABOnStartup_Call {
+	return &MockConfig_InitializeMATLABOnStartup_Call{Call: _e.mock.On("InitializeMATLABOnStartup")}
+}
+
+func (_c *MockConfig_InitializeMATLABOnStartup_Call) Run(run func()) *MockConfig_InitializeMATLABOnStartup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_InitializeMATLABOnStartup_Call) Return(b bool) *MockConfig_InitializeMATLABOnStartup_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_InitializeMATLABOnStartup_Call) RunAndReturn(run func() bool) *MockConfig_InitializeMATLABOnStartup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogLevel provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogLevel() entities.LogLevel {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLevel")
+	}
+
+	var r0 entities.LogLevel
+	if returnFunc, ok := ret.Get(0).(func() entities.LogLevel); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.LogLevel)
+	}
+	return r0
+}
+
+// MockConfig_LogLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevel'
+type MockConfig_LogLevel_Call struct {
+	*mock.Call
+}
+
+// LogLevel is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogLevel() *MockConfig_LogLevel_Call {
+	return &MockConfig_LogLevel_Call{Call: _e.mock.On("LogLevel")}
+}
+
+func (_c *MockConfig_LogLevel_Call) Run(run func()) *MockConfig_LogLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogLevel_Call) Return(logLevel entities.LogLevel) *MockConfig_LogLevel_Call {
+	_c.Call.Return(logLevel)
+	return _c
+}
+
+func (_c *MockConfig_LogLevel_Call) RunAndReturn(run func() entities.LogLevel) *MockConfig_LogLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMaxAge provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxAge() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxAge")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxAge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxAge'
+type MockConfig_LogMaxAge_Call struct {
+	*mock.Call
+}
+
+// LogMaxAge is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxAge() *MockConfig_LogMaxAge_Call {
+	return &MockConfig_LogMaxAge_Call{Call: _e.mock.On("LogMaxAge")}
+}
+
+func (_c *MockConfig_LogMaxAge_Call) Run(run func()) *MockConfig_LogMaxAge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxAge_Call) Return(duration time.Duration) *MockConfig_LogMaxAge_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxAge_Call) RunAndReturn(run func() time.Duration) *MockConfig_LogMaxAge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMaxFiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxFiles() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxFiles")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxFiles'
+type MockConfig_LogMaxFiles_Call struct {
+	*mock.Call
+}
+
+// LogMaxFiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxFiles() *MockConfig_LogMaxFiles_Call {
+	return &MockConfig_LogMaxFiles_Call{Call: _e.mock.On("LogMaxFiles")}
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) Run(run func()) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) Return(n int) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxFiles_Call) RunAndReturn(run func() int) *MockConfig_LogMaxFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogMaxSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogMaxSize() uint64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogMaxSize")
+	}
+
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	return r0
+}
+
+// MockConfig_LogMaxSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogMaxSize'
+type MockConfig_LogMaxSize_Call struct {
+	*mock.Call
+}
+
+// LogMaxSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogMaxSize() *MockConfig_LogMaxSize_Call {
+	return &MockConfig_LogMaxSize_Call{Call: _e.mock.On("LogMaxSize")}
+}
+
+func (_c *MockConfig_LogMaxSize_Call) Run(run func()) *MockConfig_LogMaxSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogMaxSize_Call) Return(v uint64) *MockConfig_LogMaxSize_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockConfig_LogMaxSize_Call) RunAndReturn(run func() uint64) *MockConfig_LogMaxSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogPerSessionFiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) LogPerSessionFiles() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogPerSessionFiles")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_LogPerSessionFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogPerSessionFiles'
+type MockConfig_LogPerSessionFiles_Call struct {
+	*mock.Call
+}
+
+// LogPerSessionFiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) LogPerSessionFiles() *MockConfig_LogPerSessionFiles_Call {
+	return &MockConfig_LogPerSessionFiles_Call{Call: _e.mock.On("LogPerSessionFiles")}
+}
+
+func (_c *MockConfig_LogPerSessionFiles_Call) Run(run func()) *MockConfig_LogPerSessionFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_LogPerSessionFiles_Call) Return(b bool) *MockConfig_LogPerSessionFiles_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_LogPerSessionFiles_Call) RunAndReturn(run func() bool) *MockConfig_LogPerSessionFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABEnvironmentVariables provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABEnvironmentVariables() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABEnvironmentVariables")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_MATLABEnvironmentVariables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABEnvironmentVariables'
+type MockConfig_MATLABEnvironmentVariables_Call struct {
+	*mock.Call
+}
+
+// MATLABEnvironmentVariables is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABEnvironmentVariables() *MockConfig_MATLABEnvironmentVariables_Call {
+	return &MockConfig_MATLABEnvironmentVariables_Call{Call: _e.mock.On("MATLABEnvironmentVariables")}
+}
+
+func (_c *MockConfig_MATLABEnvironmentVariables_Call) Run(run func()) *MockConfig_MATLABEnvironmentVariables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABEnvironmentVariables_Call) Return(strings []string) *MockConfig_MATLABEnvironmentVariables_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_MATLABEnvironmentVariables_Call) RunAndReturn(run func() []string) *MockConfig_MATLABEnvironmentVariables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABIdleTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABIdleTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABIdleTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_MATLABIdleTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABIdleTimeout'
+type MockConfig_MATLABIdleTimeout_Call struct {
+	*mock.Call
+}
+
+// MATLABIdleTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABIdleTimeout() *MockConfig_MATLABIdleTimeout_Call {
+	return &MockConfig_MATLABIdleTimeout_Call{Call: _e.mock.On("MATLABIdleTimeout")}
+}
+
+func (_c *MockConfig_MATLABIdleTimeout_Call) Run(run func()) *MockConfig_MATLABIdleTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABIdleTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABIdleTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_MATLABIdleTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABIdleTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABMemoryLimit provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABMemoryLimit() uint64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABMemoryLimit")
+	}
+
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	return r0
+}
+
+// MockConfig_MATLABMemoryLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABMemoryLimit'
+type MockConfig_MATLABMemoryLimit_Call struct {
+	*mock.Call
+}
+
+// MATLABMemoryLimit is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABMemoryLimit() *MockConfig_MATLABMemoryLimit_Call {
+	return &MockConfig_MATLABMemoryLimit_Call{Call: _e.mock.On("MATLABMemoryLimit")}
+}
+
+func (_c *MockConfig_MATLABMemoryLimit_Call) Run(run func()) *MockConfig_MATLABMemoryLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABMemoryLimit_Call) Return(v uint64) *MockConfig_MATLABMemoryLimit_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockConfig_MATLABMemoryLimit_Call) RunAndReturn(run func() uint64) *MockConfig_MATLABMemoryLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABPath provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABPath() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABPath")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_MATLABPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABPath'
+type MockConfig_MATLABPath_Call struct {
+	*mock.Call
+}
+
+// MATLABPath is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABPath() *MockConfig_MATLABPath_Call {
+	return &MockConfig_MATLABPath_Call{Call: _e.mock.On("MATLABPath")}
+}
+
+func (_c *MockConfig_MATLABPath_Call) Run(run func()) *MockConfig_MATLABPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABPath_Call) Return(strings []string) *MockConfig_MATLABPath_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_MATLABPath_Call) RunAndReturn(run func() []string) *MockConfig_MATLABPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABQueueMaxDepth provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABQueueMaxDepth() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABQueueMaxDepth")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_MATLABQueueMaxDepth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABQueueMaxDepth'
+type MockConfig_MATLABQueueMaxDepth_Call struct {
+	*mock.Call
+}
+
+// MATLABQueueMaxDepth is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABQueueMaxDepth() *MockConfig_MATLABQueueMaxDepth_Call {
+	return &MockConfig_MATLABQueueMaxDepth_Call{Call: _e.mock.On("MATLABQueueMaxDepth")}
+}
+
+func (_c *MockConfig_MATLABQueueMaxDepth_Call) Run(run func()) *MockConfig_MATLABQueueMaxDepth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABQueueMaxDepth_Call) Return(n int) *MockConfig_MATLABQueueMaxDepth_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_MATLABQueueMaxDepth_Call) RunAndReturn(run func() int) *MockConfig_MATLABQueueMaxDepth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABQueueWaitTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABQueueWaitTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABQueueWaitTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_MATLABQueueWaitTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABQueueWaitTimeout'
+type MockConfig_MATLABQueueWaitTimeout_Call struct {
+	*mock.Call
+}
+
+// MATLABQueueWaitTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABQueueWaitTimeout() *MockConfig_MATLABQueueWaitTimeout_Call {
+	return &MockConfig_MATLABQueueWaitTimeout_Call{Call: _e.mock.On("MATLABQueueWaitTimeout")}
+}
+
+func (_c *MockConfig_MATLABQueueWaitTimeout_Call) Run(run func()) *MockConfig_MATLABQueueWaitTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABQueueWaitTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABQueueWaitTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_MATLABQueueWaitTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABQueueWaitTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABSearchFolders provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSearchFolders() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSearchFolders")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_MATLABSearchFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSearchFolders'
+type MockConfig_MATLABSearchFolders_Call struct {
+	*mock.Call
+}
+
+// MATLABSearchFolders is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSearchFolders() *MockConfig_MATLABSearchFolders_Call {
+	return &MockConfig_MATLABSearchFolders_Call{Call: _e.mock.On("MATLABSearchFolders")}
+}
+
+func (_c *MockConfig_MATLABSearchFolders_Call) Run(run func()) *MockConfig_MATLABSearchFolders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSearchFolders_Call) Return(strings []string) *MockConfig_MATLABSearchFolders_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSearchFolders_Call) RunAndReturn(run func() []string) *MockConfig_MATLABSearchFolders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABSessionConnectionDetails provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionConnectionDetails() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionConnectionDetails")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionConnectionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionConnectionDetails'
+type MockConfig_MATLABSessionConnectionDetails_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionConnectionDetails is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionConnectionDetails() *MockConfig_MATLABSessionConnectionDetails_Call {
+	return &MockConfig_MATLABSessionConnectionDetails_Call{Call: _e.mock.On("MATLABSessionConnectionDetails")}
+}
+
+func (_c *MockConfig_MATLABSessionConnectionDetails_Call) Run(run func()) *MockConfig_MATLABSessionConnectionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionConnectionDetails_Call) Return(s string) *MockConfig_MATLABSessionConnectionDetails_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionConnectionDetails_Call) RunAndReturn(run func() string) *MockConfig_MATLABSessionConnectionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABSessionConnectionTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionConnectionTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionConnectionTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionConnectionTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionConnectionTimeout'
+type MockConfig_MATLABSessionConnectionTimeout_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionConnectionTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionConnectionTimeout() *MockConfig_MATLABSessionConnectionTimeout_Call {
+	return &MockConfig_MATLABSessionConnectionTimeout_Call{Call: _e.mock.On("MATLABSessionConnectionTimeout")}
+}
+
+func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) Run(run func()) *MockConfig_MATLABSessionConnectionTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABSessionConnectionTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABSessionConnectionTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MATLABSessionDiscoveryTimeout provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionDiscoveryTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionDiscoveryTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionDiscoveryTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionDiscoveryTimeout'
+type MockConfig_MATLABSessionDiscoveryTimeout_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionDiscoveryTimeout is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionDiscoveryTimeout() *MockConfig_MATLABSessionDiscoveryTimeout_Call {
+	return &MockConfig_MATLABSessionDiscoveryTimeout_Call{Call: _e.mock.On("MATLABSessionDiscoveryTimeout")}
+}
+
+func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) Run(run func()) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
+	_c.Call.Return(duration)
 	return _c
 }
 
-func (_c *MockConfig_Get_Call) RunAndReturn(run func(key string) (any, messages.Error)) *MockConfig_Get_Call {
+func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HelpMode provides a mock function for the type MockConfig
-func (_mock *MockConfig) HelpMode() bool {
+// MATLABSessionMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionMode() entities.MATLABSessionMode {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for HelpMode")
+		panic("no return value specified for MATLABSessionMode")
 	}
 
-	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+	var r0 entities.MATLABSessionMode
+	if returnFunc, ok := ret.Get(0).(func() entities.MATLABSessionMode); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(entities.MATLABSessionMode)
 	}
 	return r0
 }
 
-// MockConfig_HelpMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HelpMode'
-type MockConfig_HelpMode_Call struct {
+// MockConfig_MATLABSessionMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionMode'
+type MockConfig_MATLABSessionMode_Call struct {
 	*mock.Call
 }
 
-// HelpMode is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) HelpMode() *MockConfig_HelpMode_Call {
-	return &MockConfig_HelpMode_Call{Call: _e.mock.On("HelpMode")}
+// MATLABSessionMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionMode() *MockConfig_MATLABSessionMode_Call {
+	return &MockConfig_MATLABSessionMode_Call{Call: _e.mock.On("MATLABSessionMode")}
 }
 
-func (_c *MockConfig_HelpMode_Call) Run(run func()) *MockConfig_HelpMode_Call {
+func (_c *MockConfig_MATLABSessionMode_Call) Run(run func()) *MockConfig_MATLABSessionMode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_HelpMode_Call) Return(b bool) *MockConfig_HelpMode_Call {
-	_c.Call.Return(b)
+func (_c *MockConfig_MATLABSessionMode_Call) Return(mATLABSessionMode entities.MATLABSessionMode) *MockConfig_MATLABSessionMode_Call {
+	_c.Call.Return(mATLABSessionMode)
 	return _c
 }
 
-func (_c *MockConfig_HelpMode_Call) RunAndReturn(run func() bool) *MockConfig_HelpMode_Call {
+func (_c *MockConfig_MATLABSessionMode_Call) RunAndReturn(run func() entities.MATLABSessionMode) *MockConfig_MATLABSessionMode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InitializeMATLABOnStartup provides a mock function for the type MockConfig
-func (_mock *MockConfig) InitializeMATLABOnStartup() bool {
+// MATLABSessionPoolSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionPoolSize() int {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for InitializeMATLABOnStartup")
+		panic("no return value specified for MATLABSessionPoolSize")
 	}
 
-	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(int)
 	}
 	return r0
 }
 
-// MockConfig_InitializeMATLABOnStartup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitializeMATLABOnStartup'
-type MockConfig_InitializeMATLABOnStartup_Call struct {
+// MockConfig_MATLABSessionPoolSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionPoolSize'
+type MockConfig_MATLABSessionPoolSize_Call struct {
 	*mock.Call
 }
 
-// InitializeMATLABOnStartup is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) InitializeMATLABOnStartup() *MockConfig_InitializeMATLABOnStartup_Call {
-	return &MockConfig_InitializeMATLABOnStartup_Call{Call: _e.mock.On("InitializeMATLABOnStartup")}
+// MATLABSessionPoolSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionPoolSize() *MockConfig_MATLABSessionPoolSize_Call {
+	return &MockConfig_MATLABSessionPoolSize_Call{Call: _e.mock.On("MATLABSessionPoolSize")}
 }
 
-func (_c *MockConfig_InitializeMATLABOnStartup_Call) Run(run func()) *MockConfig_InitializeMATLABOnStartup_Call {
+func (_c *MockConfig_MATLABSessionPoolSize_Call) Run(run func()) *MockConfig_MATLABSessionPoolSize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_InitializeMATLABOnStartup_Call) Return(b bool) *MockConfig_InitializeMATLABOnStartup_Call {
-	_c.Call.Return(b)
+func (_c *MockConfig_MATLABSessionPoolSize_Call) Return(n int) *MockConfig_MATLABSessionPoolSize_Call {
+	_c.Call.Return(n)
 	return _c
 }
 
-func (_c *MockConfig_InitializeMATLABOnStartup_Call) RunAndReturn(run func() bool) *MockConfig_InitializeMATLABOnStartup_Call {
+func (_c *MockConfig_MATLABSessionPoolSize_Call) RunAndReturn(run func() int) *MockConfig_MATLABSessionPoolSize_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogLevel provides a mock function for the type MockConfig
-func (_mock *MockConfig) LogLevel() entities.LogLevel {
+// MATLABStartupFlags provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABStartupFlags() []string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogLevel")
+		panic("no return value specified for MATLABStartupFlags")
 	}
 
-	var r0 entities.LogLevel
-	if returnFunc, ok := ret.Get(0).(func() entities.LogLevel); ok {
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(entities.LogLevel)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 	return r0
 }
 
-// MockConfig_LogLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLevel'
-type MockConfig_LogLevel_Call struct {
+// MockConfig_MATLABStartupFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABStartupFlags'
+type MockConfig_MATLABStartupFlags_Call struct {
 	*mock.Call
 }
 
-// LogLevel is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) LogLevel() *MockConfig_LogLevel_Call {
-	return &MockConfig_LogLevel_Call{Call: _e.mock.On("LogLevel")}
+// MATLABStartupFlags is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABStartupFlags() *MockConfig_MATLABStartupFlags_Call {
+	return &MockConfig_MATLABStartupFlags_Call{Call: _e.mock.On("MATLABStartupFlags")}
 }
 
-func (_c *MockConfig_LogLevel_Call) Run(run func()) *MockConfig_LogLevel_Call {
+func (_c *MockConfig_MATLABStartupFlags_Call) Run(run func()) *MockConfig_MATLABStartupFlags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_LogLevel_Call) Return(logLevel entities.LogLevel) *MockConfig_LogLevel_Call {
-	_c.Call.Return(logLevel)
+func (_c *MockConfig_MATLABStartupFlags_Call) Return(strings []string) *MockConfig_MATLABStartupFlags_Call {
+	_c.Call.Return(strings)
 	return _c
 }
 
-func (_c *MockConfig_LogLevel_Call) RunAndReturn(run func() entities.LogLevel) *MockConfig_LogLevel_Call {
+func (_c *MockConfig_MATLABStartupFlags_Call) RunAndReturn(run func() []string) *MockConfig_MATLABStartupFlags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MATLABSessionConnectionDetails provides a mock function for the type MockConfig
-func (_mock *MockConfig) MATLABSessionConnectionDetails() string {
+// MATLABStartupScript provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABStartupScript() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for MATLABSessionConnectionDetails")
+		panic("no return value specified for MATLABStartupScript")
 	}
 
 	var r0 string
@@ -518,171 +1714,171 @@ func (_mock *MockConfig) MATLABSessionConnectionDetails() string {
 	return r0
 }
 
-// MockConfig_MATLABSessionConnectionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionConnectionDetails'
-type MockConfig_MATLABSessionConnectionDetails_Call struct {
+// MockConfig_MATLABStartupScript_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABStartupScript'
+type MockConfig_MATLABStartupScript_Call struct {
 	*mock.Call
 }
 
-// MATLABSessionConnectionDetails is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) MATLABSessionConnectionDetails() *MockConfig_MATLABSessionConnectionDetails_Call {
-	return &MockConfig_MATLABSessionConnectionDetails_Call{Call: _e.mock.On("MATLABSessionConnectionDetails")}
+// MATLABStartupScript is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABStartupScript() *MockConfig_MATLABStartupScript_Call {
+	return &MockConfig_MATLABStartupScript_Call{Call: _e.mock.On("MATLABStartupScript")}
 }
 
-func (_c *MockConfig_MATLABSessionConnectionDetails_Call) Run(run func()) *MockConfig_MATLABSessionConnectionDetails_Call {
+func (_c *MockConfig_MATLABStartupScript_Call) Run(run func()) *MockConfig_MATLABStartupScript_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionConnectionDetails_Call) Return(s string) *MockConfig_MATLABSessionConnectionDetails_Call {
+func (_c *MockConfig_MATLABStartupScript_Call) Return(s string) *MockConfig_MATLABStartupScript_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionConnectionDetails_Call) RunAndReturn(run func() string) *MockConfig_MATLABSessionConnectionDetails_Call {
+func (_c *MockConfig_MATLABStartupScript_Call) RunAndReturn(run func() string) *MockConfig_MATLABStartupScript_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MATLABSessionConnectionTimeout provides a mock function for the type MockConfig
-func (_mock *MockConfig) MATLABSessionConnectionTimeout() time.Duration {
+// MaxToolOutputBytes provides a mock function for the type MockConfig
+func (_mock *MockConfig) MaxToolOutputBytes() int {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for MATLABSessionConnectionTimeout")
+		panic("no return value specified for MaxToolOutputBytes")
 	}
 
-	var r0 time.Duration
-	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(int)
 	}
 	return r0
 }
 
-// MockConfig_MATLABSessionConnectionTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionConnectionTimeout'
-type MockConfig_MATLABSessionConnectionTimeout_Call struct {
+// MockConfig_MaxToolOutputBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxToolOutputBytes'
+type MockConfig_MaxToolOutputBytes_Call struct {
 	*mock.Call
 }
 
-// MATLABSessionConnectionTimeout is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) MATLABSessionConnectionTimeout() *MockConfig_MATLABSessionConnectionTimeout_Call {
-	return &MockConfig_MATLABSessionConnectionTimeout_Call{Call: _e.mock.On("MATLABSessionConnectionTimeout")}
+// MaxToolOutputBytes is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MaxToolOutputBytes() *MockConfig_MaxToolOutputBytes_Call {
+	return &MockConfig_MaxToolOutputBytes_Call{Call: _e.mock.On("MaxToolOutputBytes")}
 }
 
-func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) Run(run func()) *MockConfig_MATLABSessionConnectionTimeout_Call {
+func (_c *MockConfig_MaxToolOutputBytes_Call) Run(run func()) *MockConfig_MaxToolOutputBytes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABSessionConnectionTimeout_Call {
-	_c.Call.Return(duration)
+func (_c *MockConfig_MaxToolOutputBytes_Call) Return(n int) *MockConfig_MaxToolOutputBytes_Call {
+	_c.Call.Return(n)
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionConnectionTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABSessionConnectionTimeout_Call {
+func (_c *MockConfig_MaxToolOutputBytes_Call) RunAndReturn(run func() int) *MockConfig_MaxToolOutputBytes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MATLABSessionDiscoveryTimeout provides a mock function for the type MockConfig
-func (_mock *MockConfig) MATLABSessionDiscoveryTimeout() time.Duration {
+// OpenMATLABProject provides a mock function for the type MockConfig
+func (_mock *MockConfig) OpenMATLABProject() bool {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for MATLABSessionDiscoveryTimeout")
+		panic("no return value specified for OpenMATLABProject")
 	}
 
-	var r0 time.Duration
-	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(bool)
 	}
 	return r0
 }
 
-// MockConfig_MATLABSessionDiscoveryTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionDiscoveryTimeout'
-type MockConfig_MATLABSessionDiscoveryTimeout_Call struct {
+// MockConfig_OpenMATLABProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenMATLABProject'
+type MockConfig_OpenMATLABProject_Call struct {
 	*mock.Call
 }
 
-// MATLABSessionDiscoveryTimeout is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) MATLABSessionDiscoveryTimeout() *MockConfig_MATLABSessionDiscoveryTimeout_Call {
-	return &MockConfig_MATLABSessionDiscoveryTimeout_Call{Call: _e.mock.On("MATLABSessionDiscoveryTimeout")}
+// OpenMATLABProject is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) OpenMATLABProject() *MockConfig_OpenMATLABProject_Call {
+	return &MockConfig_OpenMATLABProject_Call{Call: _e.mock.On("OpenMATLABProject")}
 }
 
-func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) Run(run func()) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
+func (_c *MockConfig_OpenMATLABProject_Call) Run(run func()) *MockConfig_OpenMATLABProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) Return(duration time.Duration) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
-	_c.Call.Return(duration)
+func (_c *MockConfig_OpenMATLABProject_Call) Return(b bool) *MockConfig_OpenMATLABProject_Call {
+	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionDiscoveryTimeout_Call) RunAndReturn(run func() time.Duration) *MockConfig_MATLABSessionDiscoveryTimeout_Call {
+func (_c *MockConfig_OpenMATLABProject_Call) RunAndReturn(run func() bool) *MockConfig_OpenMATLABProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MATLABSessionMode provides a mock function for the type MockConfig
-func (_mock *MockConfig) MATLABSessionMode() entities.MATLABSessionMode {
+// PreferredLocalMATLABRoot provides a mock function for the type MockConfig
+func (_mock *MockConfig) PreferredLocalMATLABRoot() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for MATLABSessionMode")
+		panic("no return value specified for PreferredLocalMATLABRoot")
 	}
 
-	var r0 entities.MATLABSessionMode
-	if returnFunc, ok := ret.Get(0).(func() entities.MATLABSessionMode); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(entities.MATLABSessionMode)
+		r0 = ret.Get(0).(string)
 	}
 	return r0
 }
 
-// MockConfig_MATLABSessionMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionMode'
-type MockConfig_MATLABSessionMode_Call struct {
+// MockConfig_PreferredLocalMATLABRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreferredLocalMATLABRoot'
+type MockConfig_PreferredLocalMATLABRoot_Call struct {
 	*mock.Call
 }
 
-// MATLABSessionMode is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) MATLABSessionMode() *MockConfig_MATLABSessionMode_Call {
-	return &MockConfig_MATLABSessionMode_Call{Call: _e.mock.On("MATLABSessionMode")}
+// PreferredLocalMATLABRoot is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PreferredLocalMATLABRoot() *MockConfig_PreferredLocalMATLABRoot_Call {
+	return &MockConfig_PreferredLocalMATLABRoot_Call{Call: _e.mock.On("PreferredLocalMATLABRoot")}
 }
 
-func (_c *MockConfig_MATLABSessionMode_Call) Run(run func()) *MockConfig_MATLABSessionMode_Call {
+func (_c *MockConfig_PreferredLocalMATLABRoot_Call) Run(run func()) *MockConfig_PreferredLocalMATLABRoot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionMode_Call) Return(mATLABSessionMode entities.MATLABSessionMode) *MockConfig_MATLABSessionMode_Call {
-	_c.Call.Return(mATLABSessionMode)
+func (_c *MockConfig_PreferredLocalMATLABRoot_Call) Return(s string) *MockConfig_PreferredLocalMATLABRoot_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_MATLABSessionMode_Call) RunAndReturn(run func() entities.MATLABSessionMode) *MockConfig_MATLABSessionMode_Call {
+func (_c *MockConfig_PreferredLocalMATLABRoot_Call) RunAndReturn(run func() string) *MockConfig_PreferredLocalMATLABRoot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PreferredLocalMATLABRoot provides a mock function for the type MockConfig
-func (_mock *MockConfig) PreferredLocalMATLABRoot() string {
+// PreferredMATLABRelease provides a mock function for the type MockConfig
+func (_mock *MockConfig) PreferredMATLABRelease() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for PreferredLocalMATLABRoot")
+		panic("no return value specified for PreferredMATLABRelease")
 	}
 
 	var r0 string
@@ -694,29 +1890,29 @@ func (_mock *MockConfig) PreferredLocalMATLABRoot() string {
 	return r0
 }
 
-// MockConfig_PreferredLocalMATLABRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreferredLocalMATLABRoot'
-type MockConfig_PreferredLocalMATLABRoot_Call struct {
+// MockConfig_PreferredMATLABRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreferredMATLABRelease'
+type MockConfig_PreferredMATLABRelease_Call struct {
 	*mock.Call
 }
 
-// PreferredLocalMATLABRoot is a helper method to define mock.On call
-func (_e *MockConfig_Expecter) PreferredLocalMATLABRoot() *MockConfig_PreferredLocalMATLABRoot_Call {
-	return &MockConfig_PreferredLocalMATLABRoot_Call{Call: _e.mock.On("PreferredLocalMATLABRoot")}
+// PreferredMATLABRelease is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PreferredMATLABRelease() *MockConfig_PreferredMATLABRelease_Call {
+	return &MockConfig_PreferredMATLABRelease_Call{Call: _e.mock.On("PreferredMATLABRelease")}
 }
 
-func (_c *MockConfig_PreferredLocalMATLABRoot_Call) Run(run func()) *MockConfig_PreferredLocalMATLABRoot_Call {
+func (_c *MockConfig_PreferredMATLABRelease_Call) Run(run func()) *MockConfig_PreferredMATLABRelease_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockConfig_PreferredLocalMATLABRoot_Call) Return(s string) *MockConfig_PreferredLocalMATLABRoot_Call {
+func (_c *MockConfig_PreferredMATLABRelease_Call) Return(s string) *MockConfig_PreferredMATLABRelease_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockConfig_PreferredLocalMATLABRoot_Call) RunAndReturn(run func() string) *MockConfig_PreferredLocalMATLABRoot_Call {
+func (_c *MockConfig_PreferredMATLABRelease_Call) RunAndReturn(run func() string) *MockConfig_PreferredMATLABRelease_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -765,6 +1961,50 @@ func (_c *MockConfig_PreferredMATLABStartingDirectory_Call) RunAndReturn(run fun
 	return _c
 }
 
+// PrintConfigMode provides a mock function for the type MockConfig
+func (_mock *MockConfig) PrintConfigMode() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrintConfigMode")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_PrintConfigMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrintConfigMode'
+type MockConfig_PrintConfigMode_Call struct {
+	*mock.Call
+}
+
+// PrintConfigMode is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PrintConfigMode() *MockConfig_PrintConfigMode_Call {
+	return &MockConfig_PrintConfigMode_Call{Call: _e.mock.On("PrintConfigMode")}
+}
+
+func (_c *MockConfig_PrintConfigMode_Call) Run(run func()) *MockConfig_PrintConfigMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_PrintConfigMode_Call) Return(b bool) *MockConfig_PrintConfigMode_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_PrintConfigMode_Call) RunAndReturn(run func() bool) *MockConfig_PrintConfigMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordToLogger provides a mock function for the type MockConfig
 func (_mock *MockConfig) RecordToLogger(logger entities.Logger) {
 	_mock.Called(logger)
@@ -1290,3 +2530,91 @@ func (_c *MockConfig_WatchdogMode_Call) RunAndReturn(run func() bool) *MockConfi
 	_c.Call.Return(run)
 	return _c
 }
+
+// WorkspaceSnapshotMaxSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) WorkspaceSnapshotMaxSize() uint64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkspaceSnapshotMaxSize")
+	}
+
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	return r0
+}
+
+// MockConfig_WorkspaceSnapshotMaxSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkspaceSnapshotMaxSize'
+type MockConfig_WorkspaceSnapshotMaxSize_Call struct {
+	*mock.Call
+}
+
+// WorkspaceSnapshotMaxSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) WorkspaceSnapshotMaxSize() *MockConfig_WorkspaceSnapshotMaxSize_Call {
+	return &MockConfig_WorkspaceSnapshotMaxSize_Call{Call: _e.mock.On("WorkspaceSnapshotMaxSize")}
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxSize_Call) Run(run func()) *MockConfig_WorkspaceSnapshotMaxSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxSize_Call) Return(v uint64) *MockConfig_WorkspaceSnapshotMaxSize_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxSize_Call) RunAndReturn(run func() uint64) *MockConfig_WorkspaceSnapshotMaxSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkspaceSnapshotMaxTotalSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) WorkspaceSnapshotMaxTotalSize() uint64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkspaceSnapshotMaxTotalSize")
+	}
+
+	var r0 uint64
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	return r0
+}
+
+// MockConfig_WorkspaceSnapshotMaxTotalSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkspaceSnapshotMaxTotalSize'
+type MockConfig_WorkspaceSnapshotMaxTotalSize_Call struct {
+	*mock.Call
+}
+
+// WorkspaceSnapshotMaxTotalSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) WorkspaceSnapshotMaxTotalSize() *MockConfig_WorkspaceSnapshotMaxTotalSize_Call {
+	return &MockConfig_WorkspaceSnapshotMaxTotalSize_Call{Call: _e.mock.On("WorkspaceSnapshotMaxTotalSize")}
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxTotalSize_Call) Run(run func()) *MockConfig_WorkspaceSnapshotMaxTotalSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxTotalSize_Call) Return(v uint64) *MockConfig_WorkspaceSnapshotMaxTotalSize_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockConfig_WorkspaceSnapshotMaxTotalSize_Call) RunAndReturn(run func() uint64) *MockConfig_WorkspaceSnapshotMaxTotalSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
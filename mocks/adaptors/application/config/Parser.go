@@ -116,3 +116,89 @@ func (_c *MockParser_Parse_Call) RunAndReturn(run func(args []string) ([]entitie
 	_c.Call.Return(run)
 	return _c
 }
+
+// ProjectConfigFileSettings provides a mock function for the type MockParser
+func (_mock *MockParser) ProjectConfigFileSettings() []entities.ConfigFileSetting {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectConfigFileSettings")
+	}
+
+	var r0 []entities.ConfigFileSetting
+	if returnFunc, ok := ret.Get(0).(func() []entities.ConfigFileSetting); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ConfigFileSetting)
+		}
+	}
+	return r0
+}
+
+// MockParser_ProjectConfigFileSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectConfigFileSettings'
+type MockParser_ProjectConfigFileSettings_Call struct {
+	*mock.Call
+}
+
+// ProjectConfigFileSettings is a helper method to define mock.On call
+func (_e *MockParser_Expecter) ProjectConfigFileSettings() *MockParser_ProjectConfigFileSettings_Call {
+	return &MockParser_ProjectConfigFileSettings_Call{Call: _e.mock.On("ProjectConfigFileSettings")}
+}
+
+func (_c *MockParser_ProjectConfigFileSettings_Call) Run(run func()) *MockParser_ProjectConfigFileSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockParser_ProjectConfigFileSettings_Call) Return(configFileSettings []entities.ConfigFileSetting) *MockParser_ProjectConfigFileSettings_Call {
+	_c.Call.Return(configFileSettings)
+	return _c
+}
+
+func (_c *MockParser_ProjectConfigFileSettings_Call) RunAndReturn(run func() []entities.ConfigFileSetting) *MockParser_ProjectConfigFileSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProjectConfigFile provides a mock function for the type MockParser
+func (_mock *MockParser) SetProjectConfigFile(path string) {
+	_mock.Called(path)
+	return
+}
+
+// MockParser_SetProjectConfigFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProjectConfigFile'
+type MockParser_SetProjectConfigFile_Call struct {
+	*mock.Call
+}
+
+// SetProjectConfigFile is a helper method to define mock.On call
+//   - path string
+func (_e *MockParser_Expecter) SetProjectConfigFile(path interface{}) *MockParser_SetProjectConfigFile_Call {
+	return &MockParser_SetProjectConfigFile_Call{Call: _e.mock.On("SetProjectConfigFile", path)}
+}
+
+func (_c *MockParser_SetProjectConfigFile_Call) Run(run func(path string)) *MockParser_SetProjectConfigFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockParser_SetProjectConfigFile_Call) Return() *MockParser_SetProjectConfigFile_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockParser_SetProjectConfigFile_Call) RunAndReturn(run func(path string)) *MockParser_SetProjectConfigFile_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/messages"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAnalyze creates a new instance of MockAnalyze. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyze(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyze {
+	mock := &MockAnalyze{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyze is an autogenerated mock type for the Analyze type
+type MockAnalyze struct {
+	mock.Mock
+}
+
+type MockAnalyze_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyze) EXPECT() *MockAnalyze_Expecter {
+	return &MockAnalyze_Expecter{mock: &_m.Mock}
+}
+
+// StartAndWaitForCompletion provides a mock function for the type MockAnalyze
+func (_mock *MockAnalyze) StartAndWaitForCompletion(ctx context.Context) messages.Error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartAndWaitForCompletion")
+	}
+
+	var r0 messages.Error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) messages.Error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(messages.Error)
+		}
+	}
+	return r0
+}
+
+// MockAnalyze_StartAndWaitForCompletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartAndWaitForCompletion'
+type MockAnalyze_StartAndWaitForCompletion_Call struct {
+	*mock.Call
+}
+
+// StartAndWaitForCompletion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAnalyze_Expecter) StartAndWaitForCompletion(ctx interface{}) *MockAnalyze_StartAndWaitForCompletion_Call {
+	return &MockAnalyze_StartAndWaitForCompletion_Call{Call: _e.mock.On("StartAndWaitForCompletion", ctx)}
+}
+
+func (_c *MockAnalyze_StartAndWaitForCompletion_Call) Run(run func(ctx context.Context)) *MockAnalyze_StartAndWaitForCompletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyze_StartAndWaitForCompletion_Call) Return(error messages.Error) *MockAnalyze_StartAndWaitForCompletion_Call {
+	_c.Call.Return(error)
+	return _c
+}
+
+func (_c *MockAnalyze_StartAndWaitForCompletion_Call) RunAndReturn(run func(ctx context.Context) messages.Error) *MockAnalyze_StartAndWaitForCompletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -36,6 +36,61 @@ func (_m *MockParser) EXPECT() *MockParser_Expecter {
 	return &MockParser_Expecter{mock: &_m.Mock}
 }
 
+// PrintableConfig provides a mock function for the type MockParser
+func (_mock *MockParser) PrintableConfig() (string, messages.Error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrintableConfig")
+	}
+
+	var r0 string
+	var r1 messages.Error
+	if returnFunc, ok := ret.Get(0).(func() (string, messages.Error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() messages.Error); ok {
+		r1 = returnFunc()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(messages.Error)
+		}
+	}
+	return r0, r1
+}
+
+// MockParser_PrintableConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrintableConfig'
+type MockParser_PrintableConfig_Call struct {
+	*mock.Call
+}
+
+// PrintableConfig is a helper method to define mock.On call
+func (_e *MockParser_Expecter) PrintableConfig() *MockParser_PrintableConfig_Call {
+	return &MockParser_PrintableConfig_Call{Call: _e.mock.On("PrintableConfig")}
+}
+
+func (_c *MockParser_PrintableConfig_Call) Run(run func()) *MockParser_PrintableConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockParser_PrintableConfig_Call) Return(s string, error messages.Error) *MockParser_PrintableConfig_Call {
+	_c.Call.Return(s, error)
+	return _c
+}
+
+func (_c *MockParser_PrintableConfig_Call) RunAndReturn(run func() (string, messages.Error)) *MockParser_PrintableConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Usage provides a mock function for the type MockParser
 func (_mock *MockParser) Usage() (string, messages.Error) {
 	ret := _mock.Called()
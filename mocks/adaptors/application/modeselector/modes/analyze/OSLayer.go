@@ -0,0 +1,84 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOSLayer creates a new instance of MockOSLayer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOSLayer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOSLayer {
+	mock := &MockOSLayer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOSLayer is an autogenerated mock type for the OSLayer type
+type MockOSLayer struct {
+	mock.Mock
+}
+
+type MockOSLayer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOSLayer) EXPECT() *MockOSLayer_Expecter {
+	return &MockOSLayer_Expecter{mock: &_m.Mock}
+}
+
+// Stdout provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) Stdout() io.Writer {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stdout")
+	}
+
+	var r0 io.Writer
+	if returnFunc, ok := ret.Get(0).(func() io.Writer); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Writer)
+		}
+	}
+	return r0
+}
+
+// MockOSLayer_Stdout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stdout'
+type MockOSLayer_Stdout_Call struct {
+	*mock.Call
+}
+
+// Stdout is a helper method to define mock.On call
+func (_e *MockOSLayer_Expecter) Stdout() *MockOSLayer_Stdout_Call {
+	return &MockOSLayer_Stdout_Call{Call: _e.mock.On("Stdout")}
+}
+
+func (_c *MockOSLayer_Stdout_Call) Run(run func()) *MockOSLayer_Stdout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_Stdout_Call) Return(writer io.Writer) *MockOSLayer_Stdout_Call {
+	_c.Call.Return(writer)
+	return _c
+}
+
+func (_c *MockOSLayer_Stdout_Call) RunAndReturn(run func() io.Writer) *MockOSLayer_Stdout_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/http/client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockHTTPClientFactory creates a new instance of MockHTTPClientFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHTTPClientFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHTTPClientFactory {
+	mock := &MockHTTPClientFactory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHTTPClientFactory is an autogenerated mock type for the HTTPClientFactory type
+type MockHTTPClientFactory struct {
+	mock.Mock
+}
+
+type MockHTTPClientFactory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHTTPClientFactory) EXPECT() *MockHTTPClientFactory_Expecter {
+	return &MockHTTPClientFactory_Expecter{mock: &_m.Mock}
+}
+
+// NewClientForSelfSignedTLSServer provides a mock function for the type MockHTTPClientFactory
+func (_mock *MockHTTPClientFactory) NewClientForSelfSignedTLSServer(certificatePEM []byte) (client.HttpClient, error) {
+	ret := _mock.Called(certificatePEM)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewClientForSelfSignedTLSServer")
+	}
+
+	var r0 client.HttpClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte) (client.HttpClient, error)); ok {
+		return returnFunc(certificatePEM)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte) client.HttpClient); ok {
+		r0 = returnFunc(certificatePEM)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.HttpClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = returnFunc(certificatePEM)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewClientForSelfSignedTLSServer'
+type MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call struct {
+	*mock.Call
+}
+
+// NewClientForSelfSignedTLSServer is a helper method to define mock.On call
+//   - certificatePEM []byte
+func (_e *MockHTTPClientFactory_Expecter) NewClientForSelfSignedTLSServer(certificatePEM interface{}) *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call {
+	return &MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call{Call: _e.mock.On("NewClientForSelfSignedTLSServer", certificatePEM)}
+}
+
+func (_c *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call) Run(run func(certificatePEM []byte)) *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call) Return(httpClient client.HttpClient, err error) *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call {
+	_c.Call.Return(httpClient, err)
+	return _c
+}
+
+func (_c *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call) RunAndReturn(run func(certificatePEM []byte) (client.HttpClient, error)) *MockHTTPClientFactory_NewClientForSelfSignedTLSServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
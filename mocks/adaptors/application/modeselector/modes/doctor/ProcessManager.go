@@ -0,0 +1,90 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProcessManager creates a new instance of MockProcessManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProcessManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProcessManager {
+	mock := &MockProcessManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProcessManager is an autogenerated mock type for the ProcessManager type
+type MockProcessManager struct {
+	mock.Mock
+}
+
+type MockProcessManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProcessManager) EXPECT() *MockProcessManager_Expecter {
+	return &MockProcessManager_Expecter{mock: &_m.Mock}
+}
+
+// FindProcess provides a mock function for the type MockProcessManager
+func (_mock *MockProcessManager) FindProcess(processPid int) osfacade.Process {
+	ret := _mock.Called(processPid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProcess")
+	}
+
+	var r0 osfacade.Process
+	if returnFunc, ok := ret.Get(0).(func(int) osfacade.Process); ok {
+		r0 = returnFunc(processPid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(osfacade.Process)
+		}
+	}
+	return r0
+}
+
+// MockProcessManager_FindProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProcess'
+type MockProcessManager_FindProcess_Call struct {
+	*mock.Call
+}
+
+// FindProcess is a helper method to define mock.On call
+//   - processPid int
+func (_e *MockProcessManager_Expecter) FindProcess(processPid interface{}) *MockProcessManager_FindProcess_Call {
+	return &MockProcessManager_FindProcess_Call{Call: _e.mock.On("FindProcess", processPid)}
+}
+
+func (_c *MockProcessManager_FindProcess_Call) Run(run func(processPid int)) *MockProcessManager_FindProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProcessManager_FindProcess_Call) Return(process osfacade.Process) *MockProcessManager_FindProcess_Call {
+	_c.Call.Return(process)
+	return _c
+}
+
+func (_c *MockProcessManager_FindProcess_Call) RunAndReturn(run func(processPid int) osfacade.Process) *MockProcessManager_FindProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,217 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionDiscoverer creates a new instance of MockSessionDiscoverer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionDiscoverer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionDiscoverer {
+	mock := &MockSessionDiscoverer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionDiscoverer is an autogenerated mock type for the SessionDiscoverer type
+type MockSessionDiscoverer struct {
+	mock.Mock
+}
+
+type MockSessionDiscoverer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionDiscoverer) EXPECT() *MockSessionDiscoverer_Expecter {
+	return &MockSessionDiscoverer_Expecter{mock: &_m.Mock}
+}
+
+// FromSessionDetails provides a mock function for the type MockSessionDiscoverer
+func (_mock *MockSessionDiscoverer) FromSessionDetails(logger entities.Logger, sessionDetails []byte) (embeddedconnector.ConnectionDetails, error) {
+	ret := _mock.Called(logger, sessionDetails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FromSessionDetails")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, []byte) (embeddedconnector.ConnectionDetails, error)); ok {
+		return returnFunc(logger, sessionDetails)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, []byte) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, sessionDetails)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, []byte) error); ok {
+		r1 = returnFunc(logger, sessionDetails)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionDiscoverer_FromSessionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromSessionDetails'
+type MockSessionDiscoverer_FromSessionDetails_Call struct {
+	*mock.Call
+}
+
+// FromSessionDetails is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - sessionDetails []byte
+func (_e *MockSessionDiscoverer_Expecter) FromSessionDetails(logger interface{}, sessionDetails interface{}) *MockSessionDiscoverer_FromSessionDetails_Call {
+	return &MockSessionDiscoverer_FromSessionDetails_Call{Call: _e.mock.On("FromSessionDetails", logger, sessionDetails)}
+}
+
+func (_c *MockSessionDiscoverer_FromSessionDetails_Call) Run(run func(logger entities.Logger, sessionDetails []byte)) *MockSessionDiscoverer_FromSessionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_FromSessionDetails_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, err error) *MockSessionDiscoverer_FromSessionDetails_Call {
+	_c.Call.Return(connectionDetails, err)
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_FromSessionDetails_Call) RunAndReturn(run func(logger entities.Logger, sessionDetails []byte) (embeddedconnector.ConnectionDetails, error)) *MockSessionDiscoverer_FromSessionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PIDFromSessionDetails provides a mock function for the type MockSessionDiscoverer
+func (_mock *MockSessionDiscoverer) PIDFromSessionDetails(sessionDetails []byte) (int, error) {
+	ret := _mock.Called(sessionDetails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PIDFromSessionDetails")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte) (int, error)); ok {
+		return returnFunc(sessionDetails)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte) int); ok {
+		r0 = returnFunc(sessionDetails)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = returnFunc(sessionDetails)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionDiscoverer_PIDFromSessionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PIDFromSessionDetails'
+type MockSessionDiscoverer_PIDFromSessionDetails_Call struct {
+	*mock.Call
+}
+
+// PIDFromSessionDetails is a helper method to define mock.On call
+//   - sessionDetails []byte
+func (_e *MockSessionDiscoverer_Expecter) PIDFromSessionDetails(sessionDetails interface{}) *MockSessionDiscoverer_PIDFromSessionDetails_Call {
+	return &MockSessionDiscoverer_PIDFromSessionDetails_Call{Call: _e.mock.On("PIDFromSessionDetails", sessionDetails)}
+}
+
+func (_c *MockSessionDiscoverer_PIDFromSessionDetails_Call) Run(run func(sessionDetails []byte)) *MockSessionDiscoverer_PIDFromSessionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_PIDFromSessionDetails_Call) Return(n int, err error) *MockSessionDiscoverer_PIDFromSessionDetails_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_PIDFromSessionDetails_Call) RunAndReturn(run func(sessionDetails []byte) (int, error)) *MockSessionDiscoverer_PIDFromSessionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SessionDetailsFile provides a mock function for the type MockSessionDiscoverer
+func (_mock *MockSessionDiscoverer) SessionDetailsFile() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionDetailsFile")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionDiscoverer_SessionDetailsFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionDetailsFile'
+type MockSessionDiscoverer_SessionDetailsFile_Call struct {
+	*mock.Call
+}
+
+// SessionDetailsFile is a helper method to define mock.On call
+func (_e *MockSessionDiscoverer_Expecter) SessionDetailsFile() *MockSessionDiscoverer_SessionDetailsFile_Call {
+	return &MockSessionDiscoverer_SessionDetailsFile_Call{Call: _e.mock.On("SessionDetailsFile")}
+}
+
+func (_c *MockSessionDiscoverer_SessionDetailsFile_Call) Run(run func()) *MockSessionDiscoverer_SessionDetailsFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_SessionDetailsFile_Call) Return(s string, err error) *MockSessionDiscoverer_SessionDetailsFile_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSessionDiscoverer_SessionDetailsFile_Call) RunAndReturn(run func() (string, error)) *MockSessionDiscoverer_SessionDetailsFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,8 +5,11 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -143,6 +146,149 @@ func (_c *MockApplicationDefinition_Features_Call) RunAndReturn(run func() defin
 	return _c
 }
 
+// LifecycleHookTimeout provides a mock function for the type MockApplicationDefinition
+func (_mock *MockApplicationDefinition) LifecycleHookTimeout() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LifecycleHookTimeout")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockApplicationDefinition_LifecycleHookTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LifecycleHookTimeout'
+type MockApplicationDefinition_LifecycleHookTimeout_Call struct {
+	*mock.Call
+}
+
+// LifecycleHookTimeout is a helper method to define mock.On call
+func (_e *MockApplicationDefinition_Expecter) LifecycleHookTimeout() *MockApplicationDefinition_LifecycleHookTimeout_Call {
+	return &MockApplicationDefinition_LifecycleHookTimeout_Call{Call: _e.mock.On("LifecycleHookTimeout")}
+}
+
+func (_c *MockApplicationDefinition_LifecycleHookTimeout_Call) Run(run func()) *MockApplicationDefinition_LifecycleHookTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplicationDefinition_LifecycleHookTimeout_Call) Return(duration time.Duration) *MockApplicationDefinition_LifecycleHookTimeout_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockApplicationDefinition_LifecycleHookTimeout_Call) RunAndReturn(run func() time.Duration) *MockApplicationDefinition_LifecycleHookTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LifecycleHooks provides a mock function for the type MockApplicationDefinition
+func (_mock *MockApplicationDefinition) LifecycleHooks() []definition.LifecycleHook {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LifecycleHooks")
+	}
+
+	var r0 []definition.LifecycleHook
+	if returnFunc, ok := ret.Get(0).(func() []definition.LifecycleHook); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]definition.LifecycleHook)
+		}
+	}
+	return r0
+}
+
+// MockApplicationDefinition_LifecycleHooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LifecycleHooks'
+type MockApplicationDefinition_LifecycleHooks_Call struct {
+	*mock.Call
+}
+
+// LifecycleHooks is a helper method to define mock.On call
+func (_e *MockApplicationDefinition_Expecter) LifecycleHooks() *MockApplicationDefinition_LifecycleHooks_Call {
+	return &MockApplicationDefinition_LifecycleHooks_Call{Call: _e.mock.On("LifecycleHooks")}
+}
+
+func (_c *MockApplicationDefinition_LifecycleHooks_Call) Run(run func()) *MockApplicationDefinition_LifecycleHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplicationDefinition_LifecycleHooks_Call) Return(lifecycleHooks []definition.LifecycleHook) *MockApplicationDefinition_LifecycleHooks_Call {
+	_c.Call.Return(lifecycleHooks)
+	return _c
+}
+
+func (_c *MockApplicationDefinition_LifecycleHooks_Call) RunAndReturn(run func() []definition.LifecycleHook) *MockApplicationDefinition_LifecycleHooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToolMiddleware provides a mock function for the type MockApplicationDefinition
+func (_mock *MockApplicationDefinition) ToolMiddleware(resources definition.ToolsProviderResources) []mcp.Middleware {
+	ret := _mock.Called(resources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToolMiddleware")
+	}
+
+	var r0 []mcp.Middleware
+	if returnFunc, ok := ret.Get(0).(func(definition.ToolsProviderResources) []mcp.Middleware); ok {
+		r0 = returnFunc(resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mcp.Middleware)
+		}
+	}
+	return r0
+}
+
+// MockApplicationDefinition_ToolMiddleware_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToolMiddleware'
+type MockApplicationDefinition_ToolMiddleware_Call struct {
+	*mock.Call
+}
+
+// ToolMiddleware is a helper method to define mock.On call
+//   - resources definition.ToolsProviderResources
+func (_e *MockApplicationDefinition_Expecter) ToolMiddleware(resources interface{}) *MockApplicationDefinition_ToolMiddleware_Call {
+	return &MockApplicationDefinition_ToolMiddleware_Call{Call: _e.mock.On("ToolMiddleware", resources)}
+}
+
+func (_c *MockApplicationDefinition_ToolMiddleware_Call) Run(run func(resources definition.ToolsProviderResources)) *MockApplicationDefinition_ToolMiddleware_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 definition.ToolsProviderResources
+		if args[0] != nil {
+			arg0 = args[0].(definition.ToolsProviderResources)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockApplicationDefinition_ToolMiddleware_Call) Return(middlewares []mcp.Middleware) *MockApplicationDefinition_ToolMiddleware_Call {
+	_c.Call.Return(middlewares)
+	return _c
+}
+
+func (_c *MockApplicationDefinition_ToolMiddleware_Call) RunAndReturn(run func(resources definition.ToolsProviderResources) []mcp.Middleware) *MockApplicationDefinition_ToolMiddleware_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Tools provides a mock function for the type MockApplicationDefinition
 func (_mock *MockApplicationDefinition) Tools(resources definition.ToolsProviderResources) []tools.Tool {
 	ret := _mock.Called(resources)
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,16 +38,16 @@ func (_m *MockServer) EXPECT() *MockServer_Expecter {
 }
 
 // Run provides a mock function for the type MockServer
-func (_mock *MockServer) Run(tools1 []tools.Tool) error {
-	ret := _mock.Called(tools1)
+func (_mock *MockServer) Run(tools1 []tools.Tool, toolMiddleware []mcp.Middleware) error {
+	ret := _mock.Called(tools1, toolMiddleware)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Run")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func([]tools.Tool) error); ok {
-		r0 = returnFunc(tools1)
+	if returnFunc, ok := ret.Get(0).(func([]tools.Tool, []mcp.Middleware) error); ok {
+		r0 = returnFunc(tools1, toolMiddleware)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -60,18 +61,24 @@ type MockServer_Run_Call struct {
 
 // Run is a helper method to define mock.On call
 //   - tools1 []tools.Tool
-func (_e *MockServer_Expecter) Run(tools1 interface{}) *MockServer_Run_Call {
-	return &MockServer_Run_Call{Call: _e.mock.On("Run", tools1)}
+//   - toolMiddleware []mcp.Middleware
+func (_e *MockServer_Expecter) Run(tools1 interface{}, toolMiddleware interface{}) *MockServer_Run_Call {
+	return &MockServer_Run_Call{Call: _e.mock.On("Run", tools1, toolMiddleware)}
 }
 
-func (_c *MockServer_Run_Call) Run(run func(tools1 []tools.Tool)) *MockServer_Run_Call {
+func (_c *MockServer_Run_Call) Run(run func(tools1 []tools.Tool, toolMiddleware []mcp.Middleware)) *MockServer_Run_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 []tools.Tool
 		if args[0] != nil {
 			arg0 = args[0].([]tools.Tool)
 		}
+		var arg1 []mcp.Middleware
+		if args[1] != nil {
+			arg1 = args[1].([]mcp.Middleware)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -82,7 +89,7 @@ func (_c *MockServer_Run_Call) Return(err error) *MockServer_Run_Call {
 	return _c
 }
 
-func (_c *MockServer_Run_Call) RunAndReturn(run func(tools1 []tools.Tool) error) *MockServer_Run_Call {
+func (_c *MockServer_Run_Call) RunAndReturn(run func(tools1 []tools.Tool, toolMiddleware []mcp.Middleware) error) *MockServer_Run_Call {
 	_c.Call.Return(run)
 	return _c
 }
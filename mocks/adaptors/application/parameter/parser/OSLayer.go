@@ -94,3 +94,65 @@ func (_c *MockOSLayer_LookupEnv_Call) RunAndReturn(run func(key string) (string,
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReadFile provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) ReadFile(name string) ([]byte, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFile")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_ReadFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFile'
+type MockOSLayer_ReadFile_Call struct {
+	*mock.Call
+}
+
+// ReadFile is a helper method to define mock.On call
+//   - name string
+func (_e *MockOSLayer_Expecter) ReadFile(name interface{}) *MockOSLayer_ReadFile_Call {
+	return &MockOSLayer_ReadFile_Call{Call: _e.mock.On("ReadFile", name)}
+}
+
+func (_c *MockOSLayer_ReadFile_Call) Run(run func(name string)) *MockOSLayer_ReadFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_ReadFile_Call) Return(bytes []byte, err error) *MockOSLayer_ReadFile_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockOSLayer_ReadFile_Call) RunAndReturn(run func(name string) ([]byte, error)) *MockOSLayer_ReadFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,68 @@ func (_m *MockMATLABManagerAdaptor) EXPECT() *MockMATLABManagerAdaptor_Expecter
 	return &MockMATLABManagerAdaptor_Expecter{mock: &_m.Mock}
 }
 
+// GetBackgroundMATLABSessionClient provides a mock function for the type MockMATLABManagerAdaptor
+func (_mock *MockMATLABManagerAdaptor) GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackgroundMATLABSessionClient")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) (entities.MATLABSessionClient, error)); ok {
+		return returnFunc(sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.SessionID) error); ok {
+		r1 = returnFunc(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackgroundMATLABSessionClient'
+type MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call struct {
+	*mock.Call
+}
+
+// GetBackgroundMATLABSessionClient is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManagerAdaptor_Expecter) GetBackgroundMATLABSessionClient(sessionID interface{}) *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call {
+	return &MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call{Call: _e.mock.On("GetBackgroundMATLABSessionClient", sessionID)}
+}
+
+func (_c *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call) Run(run func(sessionID entities.SessionID)) *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call) Return(mATLABSessionClient entities.MATLABSessionClient, err error) *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Return(mATLABSessionClient, err)
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call) RunAndReturn(run func(sessionID entities.SessionID) (entities.MATLABSessionClient, error)) *MockMATLABManagerAdaptor_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMATLABSessionClient provides a mock function for the type MockMATLABManagerAdaptor
 func (_mock *MockMATLABManagerAdaptor) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
 	ret := _mock.Called(ctx, sessionLogger, sessionID)
@@ -113,6 +175,57 @@ func (_c *MockMATLABManagerAdaptor_GetMATLABSessionClient_Call) RunAndReturn(run
 	return _c
 }
 
+// IsAttachedSession provides a mock function for the type MockMATLABManagerAdaptor
+func (_mock *MockMATLABManagerAdaptor) IsAttachedSession(sessionID entities.SessionID) bool {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAttachedSession")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) bool); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockMATLABManagerAdaptor_IsAttachedSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAttachedSession'
+type MockMATLABManagerAdaptor_IsAttachedSession_Call struct {
+	*mock.Call
+}
+
+// IsAttachedSession is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManagerAdaptor_Expecter) IsAttachedSession(sessionID interface{}) *MockMATLABManagerAdaptor_IsAttachedSession_Call {
+	return &MockMATLABManagerAdaptor_IsAttachedSession_Call{Call: _e.mock.On("IsAttachedSession", sessionID)}
+}
+
+func (_c *MockMATLABManagerAdaptor_IsAttachedSession_Call) Run(run func(sessionID entities.SessionID)) *MockMATLABManagerAdaptor_IsAttachedSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_IsAttachedSession_Call) Return(b bool) *MockMATLABManagerAdaptor_IsAttachedSession_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockMATLABManagerAdaptor_IsAttachedSession_Call) RunAndReturn(run func(sessionID entities.SessionID) bool) *MockMATLABManagerAdaptor_IsAttachedSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldRestart provides a mock function for the type MockMATLABManagerAdaptor
 func (_mock *MockMATLABManagerAdaptor) ShouldRestart() (bool, messages.Error) {
 	ret := _mock.Called()
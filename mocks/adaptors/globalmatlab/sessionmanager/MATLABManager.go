@@ -38,6 +38,68 @@ func (_m *MockMATLABManager) EXPECT() *MockMATLABManager_Expecter {
 	return &MockMATLABManager_Expecter{mock: &_m.Mock}
 }
 
+// GetBackgroundMATLABSessionClient provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) GetBackgroundMATLABSessionClient(sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackgroundMATLABSessionClient")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) (entities.MATLABSessionClient, error)); ok {
+		return returnFunc(sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.SessionID) error); ok {
+		r1 = returnFunc(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManager_GetBackgroundMATLABSessionClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackgroundMATLABSessionClient'
+type MockMATLABManager_GetBackgroundMATLABSessionClient_Call struct {
+	*mock.Call
+}
+
+// GetBackgroundMATLABSessionClient is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) GetBackgroundMATLABSessionClient(sessionID interface{}) *MockMATLABManager_GetBackgroundMATLABSessionClient_Call {
+	return &MockMATLABManager_GetBackgroundMATLABSessionClient_Call{Call: _e.mock.On("GetBackgroundMATLABSessionClient", sessionID)}
+}
+
+func (_c *MockMATLABManager_GetBackgroundMATLABSessionClient_Call) Run(run func(sessionID entities.SessionID)) *MockMATLABManager_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_GetBackgroundMATLABSessionClient_Call) Return(mATLABSessionClient entities.MATLABSessionClient, err error) *MockMATLABManager_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Return(mATLABSessionClient, err)
+	return _c
+}
+
+func (_c *MockMATLABManager_GetBackgroundMATLABSessionClient_Call) RunAndReturn(run func(sessionID entities.SessionID) (entities.MATLABSessionClient, error)) *MockMATLABManager_GetBackgroundMATLABSessionClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMATLABSessionClient provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
 	ret := _mock.Called(ctx, sessionLogger, sessionID)
@@ -0,0 +1,98 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/launchsettings"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLaunchSettingsProvider creates a new instance of MockLaunchSettingsProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLaunchSettingsProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLaunchSettingsProvider {
+	mock := &MockLaunchSettingsProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLaunchSettingsProvider is an autogenerated mock type for the LaunchSettingsProvider type
+type MockLaunchSettingsProvider struct {
+	mock.Mock
+}
+
+type MockLaunchSettingsProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLaunchSettingsProvider) EXPECT() *MockLaunchSettingsProvider_Expecter {
+	return &MockLaunchSettingsProvider_Expecter{mock: &_m.Mock}
+}
+
+// Settings provides a mock function for the type MockLaunchSettingsProvider
+func (_mock *MockLaunchSettingsProvider) Settings(logger entities.Logger) (launchsettings.Settings, error) {
+	ret := _mock.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Settings")
+	}
+
+	var r0 launchsettings.Settings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) (launchsettings.Settings, error)); ok {
+		return returnFunc(logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) launchsettings.Settings); ok {
+		r0 = returnFunc(logger)
+	} else {
+		r0 = ret.Get(0).(launchsettings.Settings)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger) error); ok {
+		r1 = returnFunc(logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLaunchSettingsProvider_Settings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Settings'
+type MockLaunchSettingsProvider_Settings_Call struct {
+	*mock.Call
+}
+
+// Settings is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockLaunchSettingsProvider_Expecter) Settings(logger interface{}) *MockLaunchSettingsProvider_Settings_Call {
+	return &MockLaunchSettingsProvider_Settings_Call{Call: _e.mock.On("Settings", logger)}
+}
+
+func (_c *MockLaunchSettingsProvider_Settings_Call) Run(run func(logger entities.Logger)) *MockLaunchSettingsProvider_Settings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLaunchSettingsProvider_Settings_Call) Return(settings launchsettings.Settings, err error) *MockLaunchSettingsProvider_Settings_Call {
+	_c.Call.Return(settings, err)
+	return _c
+}
+
+func (_c *MockLaunchSettingsProvider_Settings_Call) RunAndReturn(run func(logger entities.Logger) (launchsettings.Settings, error)) *MockLaunchSettingsProvider_Settings_Call {
+	_c.Call.Return(run)
+	return _c
+}
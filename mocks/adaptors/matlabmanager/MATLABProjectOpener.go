@@ -0,0 +1,118 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABProjectOpener creates a new instance of MockMATLABProjectOpener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABProjectOpener(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABProjectOpener {
+	mock := &MockMATLABProjectOpener{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABProjectOpener is an autogenerated mock type for the MATLABProjectOpener type
+type MockMATLABProjectOpener struct {
+	mock.Mock
+}
+
+type MockMATLABProjectOpener_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABProjectOpener) EXPECT() *MockMATLABProjectOpener_Expecter {
+	return &MockMATLABProjectOpener_Expecter{mock: &_m.Mock}
+}
+
+// Open provides a mock function for the type MockMATLABProjectOpener
+func (_mock *MockMATLABProjectOpener) Open(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error) {
+	ret := _mock.Called(ctx, sessionLogger, client, projectPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 matlabproject.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) (matlabproject.Project, error)); ok {
+		return returnFunc(ctx, sessionLogger, client, projectPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) matlabproject.Project); ok {
+		r0 = returnFunc(ctx, sessionLogger, client, projectPath)
+	} else {
+		r0 = ret.Get(0).(matlabproject.Project)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, client, projectPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABProjectOpener_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockMATLABProjectOpener_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - projectPath string
+func (_e *MockMATLABProjectOpener_Expecter) Open(ctx interface{}, sessionLogger interface{}, client interface{}, projectPath interface{}) *MockMATLABProjectOpener_Open_Call {
+	return &MockMATLABProjectOpener_Open_Call{Call: _e.mock.On("Open", ctx, sessionLogger, client, projectPath)}
+}
+
+func (_c *MockMATLABProjectOpener_Open_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string)) *MockMATLABProjectOpener_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABProjectOpener_Open_Call) Return(project matlabproject.Project, err error) *MockMATLABProjectOpener_Open_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockMATLABProjectOpener_Open_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error)) *MockMATLABProjectOpener_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
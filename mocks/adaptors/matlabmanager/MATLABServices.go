@@ -92,7 +92,7 @@ func (_c *MockMATLABServices_ListDiscoveredMatlabInfo_Call) RunAndReturn(run fun
 }
 
 // StartLocalMATLABSession provides a mock function for the type MockMATLABServices
-func (_mock *MockMATLABServices) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+func (_mock *MockMATLABServices) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error) {
 	ret := _mock.Called(ctx, logger, request)
 
 	if len(ret) == 0 {
@@ -101,8 +101,9 @@ func (_mock *MockMATLABServices) StartLocalMATLABSession(ctx context.Context, lo
 
 	var r0 embeddedconnector.ConnectionDetails
 	var r1 func() error
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)); ok {
+	var r2 <-chan struct{}
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error)); ok {
 		return returnFunc(ctx, logger, request)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) embeddedconnector.ConnectionDetails); ok {
@@ -117,12 +118,19 @@ func (_mock *MockMATLABServices) StartLocalMATLABSession(ctx context.Context, lo
 			r1 = ret.Get(1).(func() error)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) error); ok {
+	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) <-chan struct{}); ok {
 		r2 = returnFunc(ctx, logger, request)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(<-chan struct{})
+		}
+	}
+	if returnFunc, ok := ret.Get(3).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) error); ok {
+		r3 = returnFunc(ctx, logger, request)
+	} else {
+		r3 = ret.Error(3)
 	}
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 // MockMATLABServices_StartLocalMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLocalMATLABSession'
@@ -161,12 +169,12 @@ func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, err error) *MockMATLABServices_StartLocalMATLABSession_Call {
-	_c.Call.Return(connectionDetails, fn, err)
+func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, valCh <-chan struct{}, err error) *MockMATLABServices_StartLocalMATLABSession_Call {
+	_c.Call.Return(connectionDetails, fn, valCh, err)
 	return _c
 }
 
-func (_c *MockMATLABServices_StartLocalMATLABSession_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)) *MockMATLABServices_StartLocalMATLABSession_Call {
+func (_c *MockMATLABServices_StartLocalMATLABSession_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, error)) *MockMATLABServices_StartLocalMATLABSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,167 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABSessionPool creates a new instance of MockMATLABSessionPool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABSessionPool(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABSessionPool {
+	mock := &MockMATLABSessionPool{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABSessionPool is an autogenerated mock type for the MATLABSessionPool type
+type MockMATLABSessionPool struct {
+	mock.Mock
+}
+
+type MockMATLABSessionPool_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABSessionPool) EXPECT() *MockMATLABSessionPool_Expecter {
+	return &MockMATLABSessionPool_Expecter{mock: &_m.Mock}
+}
+
+// Fill provides a mock function for the type MockMATLABSessionPool
+func (_mock *MockMATLABSessionPool) Fill(logger entities.Logger, request datatypes.LocalSessionDetails) {
+	_mock.Called(logger, request)
+	return
+}
+
+// MockMATLABSessionPool_Fill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fill'
+type MockMATLABSessionPool_Fill_Call struct {
+	*mock.Call
+}
+
+// Fill is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.LocalSessionDetails
+func (_e *MockMATLABSessionPool_Expecter) Fill(logger interface{}, request interface{}) *MockMATLABSessionPool_Fill_Call {
+	return &MockMATLABSessionPool_Fill_Call{Call: _e.mock.On("Fill", logger, request)}
+}
+
+func (_c *MockMATLABSessionPool_Fill_Call) Run(run func(logger entities.Logger, request datatypes.LocalSessionDetails)) *MockMATLABSessionPool_Fill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.LocalSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.LocalSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Fill_Call) Return() *MockMATLABSessionPool_Fill_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Fill_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails)) *MockMATLABSessionPool_Fill_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Take provides a mock function for the type MockMATLABSessionPool
+func (_mock *MockMATLABSessionPool) Take(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, bool) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Take")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 func() error
+	var r2 <-chan struct{}
+	var r3 bool
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, bool)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) func() error); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func() error)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) <-chan struct{}); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(<-chan struct{})
+		}
+	}
+	if returnFunc, ok := ret.Get(3).(func(entities.Logger, datatypes.LocalSessionDetails) bool); ok {
+		r3 = returnFunc(logger, request)
+	} else {
+		r3 = ret.Get(3).(bool)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockMATLABSessionPool_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type MockMATLABSessionPool_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.LocalSessionDetails
+func (_e *MockMATLABSessionPool_Expecter) Take(logger interface{}, request interface{}) *MockMATLABSessionPool_Take_Call {
+	return &MockMATLABSessionPool_Take_Call{Call: _e.mock.On("Take", logger, request)}
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) Run(run func(logger entities.Logger, request datatypes.LocalSessionDetails)) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.LocalSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.LocalSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, valCh <-chan struct{}, b bool) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Return(connectionDetails, fn, valCh, b)
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, <-chan struct{}, bool)) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -150,6 +150,68 @@ func (_c *MockMATLABSessionStore_Get_Call) RunAndReturn(run func(sessionID entit
 	return _c
 }
 
+// GetBackground provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) GetBackground(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackground")
+	}
+
+	var r0 matlabsessionstore.MATLABSessionClientWithCleanup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)); ok {
+		return returnFunc(sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) matlabsessionstore.MATLABSessionClientWithCleanup); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(matlabsessionstore.MATLABSessionClientWithCleanup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.SessionID) error); ok {
+		r1 = returnFunc(sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABSessionStore_GetBackground_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackground'
+type MockMATLABSessionStore_GetBackground_Call struct {
+	*mock.Call
+}
+
+// GetBackground is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockMATLABSessionStore_Expecter) GetBackground(sessionID interface{}) *MockMATLABSessionStore_GetBackground_Call {
+	return &MockMATLABSessionStore_GetBackground_Call{Call: _e.mock.On("GetBackground", sessionID)}
+}
+
+func (_c *MockMATLABSessionStore_GetBackground_Call) Run(run func(sessionID entities.SessionID)) *MockMATLABSessionStore_GetBackground_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_GetBackground_Call) Return(mATLABSessionClientWithCleanup matlabsessionstore.MATLABSessionClientWithCleanup, err error) *MockMATLABSessionStore_GetBackground_Call {
+	_c.Call.Return(mATLABSessionClientWithCleanup, err)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_GetBackground_Call) RunAndReturn(run func(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)) *MockMATLABSessionStore_GetBackground_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function for the type MockMATLABSessionStore
 func (_mock *MockMATLABSessionStore) Remove(sessionID entities.SessionID) {
 	_mock.Called(sessionID)
@@ -39,8 +39,8 @@ func (_m *MockMATLABProcessLauncher) EXPECT() *MockMATLABProcessLauncher_Expecte
 }
 
 // Launch provides a mock function for the type MockMATLABProcessLauncher
-func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error) {
-	ret := _mock.Called(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string, memoryLimit uint64) (int, func(), <-chan struct{}, error) {
+	ret := _mock.Called(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Launch")
@@ -50,30 +50,30 @@ func (_mock *MockMATLABProcessLauncher) Launch(ctx context.Context, logger entit
 	var r1 func()
 	var r2 <-chan struct{}
 	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string) (int, func(), <-chan struct{}, error)); ok {
-		return returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string, uint64) (int, func(), <-chan struct{}, error)); ok {
+		return returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string) int); ok {
-		r0 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, string, string, []string, []string, uint64) int); ok {
+		r0 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string, string, string, []string, []string) func()); ok {
-		r1 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string, string, string, []string, []string, uint64) func()); ok {
+		r1 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(func())
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, string, string, string, []string, []string) <-chan struct{}); ok {
-		r2 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, string, string, string, []string, []string, uint64) <-chan struct{}); ok {
+		r2 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(<-chan struct{})
 		}
 	}
-	if returnFunc, ok := ret.Get(3).(func(context.Context, entities.Logger, string, string, string, []string, []string) error); ok {
-		r3 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(3).(func(context.Context, entities.Logger, string, string, string, []string, []string, uint64) error); ok {
+		r3 = returnFunc(ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -93,11 +93,12 @@ type MockMATLABProcessLauncher_Launch_Call struct {
 //   - workingDir string
 //   - args []string
 //   - env []string
-func (_e *MockMATLABProcessLauncher_Expecter) Launch(ctx interface{}, logger interface{}, sessionRoot interface{}, matlabRoot interface{}, workingDir interface{}, args interface{}, env interface{}) *MockMATLABProcessLauncher_Launch_Call {
-	return &MockMATLABProcessLauncher_Launch_Call{Call: _e.mock.On("Launch", ctx, logger, sessionRoot, matlabRoot, workingDir, args, env)}
+//   - memoryLimit uint64
+func (_e *MockMATLABProcessLauncher_Expecter) Launch(ctx interface{}, logger interface{}, sessionRoot interface{}, matlabRoot interface{}, workingDir interface{}, args interface{}, env interface{}, memoryLimit interface{}) *MockMATLABProcessLauncher_Launch_Call {
+	return &MockMATLABProcessLauncher_Launch_Call{Call: _e.mock.On("Launch", ctx, logger, sessionRoot, matlabRoot, workingDir, args, env, memoryLimit)}
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string, memoryLimit uint64)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -127,6 +128,10 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(ctx context.Contex
 		if args[6] != nil {
 			arg6 = args[6].([]string)
 		}
+		var arg7 uint64
+		if args[7] != nil {
+			arg7 = args[7].(uint64)
+		}
 		run(
 			arg0,
 			arg1,
@@ -135,6 +140,7 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(ctx context.Contex
 			arg4,
 			arg5,
 			arg6,
+			arg7,
 		)
 	})
 	return _c
@@ -145,7 +151,7 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(), valCh
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), <-chan struct{}, error)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string, memoryLimit uint64) (int, func(), <-chan struct{}, error)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Return(run)
 	return _c
 }
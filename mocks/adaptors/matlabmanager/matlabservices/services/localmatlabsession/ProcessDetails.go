@@ -36,16 +36,16 @@ func (_m *MockProcessDetails) EXPECT() *MockProcessDetails_Expecter {
 }
 
 // EnvironmentVariables provides a mock function for the type MockProcessDetails
-func (_mock *MockProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string {
-	ret := _mock.Called(sessionDirPath, apiKey, certificateFile, certificateKey)
+func (_mock *MockProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, additionalEnvironmentVariables []string) []string {
+	ret := _mock.Called(sessionDirPath, apiKey, certificateFile, certificateKey, additionalEnvironmentVariables)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EnvironmentVariables")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) []string); ok {
-		r0 = returnFunc(sessionDirPath, apiKey, certificateFile, certificateKey)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, []string) []string); ok {
+		r0 = returnFunc(sessionDirPath, apiKey, certificateFile, certificateKey, additionalEnvironmentVariables)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -64,11 +64,12 @@ type MockProcessDetails_EnvironmentVariables_Call struct {
 //   - apiKey string
 //   - certificateFile string
 //   - certificateKey string
-func (_e *MockProcessDetails_Expecter) EnvironmentVariables(sessionDirPath interface{}, apiKey interface{}, certificateFile interface{}, certificateKey interface{}) *MockProcessDetails_EnvironmentVariables_Call {
-	return &MockProcessDetails_EnvironmentVariables_Call{Call: _e.mock.On("EnvironmentVariables", sessionDirPath, apiKey, certificateFile, certificateKey)}
+//   - additionalEnvironmentVariables []string
+func (_e *MockProcessDetails_Expecter) EnvironmentVariables(sessionDirPath interface{}, apiKey interface{}, certificateFile interface{}, certificateKey interface{}, additionalEnvironmentVariables interface{}) *MockProcessDetails_EnvironmentVariables_Call {
+	return &MockProcessDetails_EnvironmentVariables_Call{Call: _e.mock.On("EnvironmentVariables", sessionDirPath, apiKey, certificateFile, certificateKey, additionalEnvironmentVariables)}
 }
 
-func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string)) *MockProcessDetails_EnvironmentVariables_Call {
+func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, additionalEnvironmentVariables []string)) *MockProcessDetails_EnvironmentVariables_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -86,11 +87,16 @@ func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirP
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -101,7 +107,7 @@ func (_c *MockProcessDetails_EnvironmentVariables_Call) Return(strings []string)
 	return _c
 }
 
-func (_c *MockProcessDetails_EnvironmentVariables_Call) RunAndReturn(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string) *MockProcessDetails_EnvironmentVariables_Call {
+func (_c *MockProcessDetails_EnvironmentVariables_Call) RunAndReturn(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, additionalEnvironmentVariables []string) []string) *MockProcessDetails_EnvironmentVariables_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -151,16 +157,16 @@ func (_c *MockProcessDetails_NewAPIKey_Call) RunAndReturn(run func() string) *Mo
 }
 
 // StartupFlag provides a mock function for the type MockProcessDetails
-func (_mock *MockProcessDetails) StartupFlag(os string, showMATLAB bool, startupCode string) []string {
-	ret := _mock.Called(os, showMATLAB, startupCode)
+func (_mock *MockProcessDetails) StartupFlag(os string, showMATLAB bool, additionalFlags []string, startupCode string) []string {
+	ret := _mock.Called(os, showMATLAB, additionalFlags, startupCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartupFlag")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(string, bool, string) []string); ok {
-		r0 = returnFunc(os, showMATLAB, startupCode)
+	if returnFunc, ok := ret.Get(0).(func(string, bool, []string, string) []string); ok {
+		r0 = returnFunc(os, showMATLAB, additionalFlags, startupCode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -177,12 +183,13 @@ type MockProcessDetails_StartupFlag_Call struct {
 // StartupFlag is a helper method to define mock.On call
 //   - os string
 //   - showMATLAB bool
+//   - additionalFlags []string
 //   - startupCode string
-func (_e *MockProcessDetails_Expecter) StartupFlag(os interface{}, showMATLAB interface{}, startupCode interface{}) *MockProcessDetails_StartupFlag_Call {
-	return &MockProcessDetails_StartupFlag_Call{Call: _e.mock.On("StartupFlag", os, showMATLAB, startupCode)}
+func (_e *MockProcessDetails_Expecter) StartupFlag(os interface{}, showMATLAB interface{}, additionalFlags interface{}, startupCode interface{}) *MockProcessDetails_StartupFlag_Call {
+	return &MockProcessDetails_StartupFlag_Call{Call: _e.mock.On("StartupFlag", os, showMATLAB, additionalFlags, startupCode)}
 }
 
-func (_c *MockProcessDetails_StartupFlag_Call) Run(run func(os string, showMATLAB bool, startupCode string)) *MockProcessDetails_StartupFlag_Call {
+func (_c *MockProcessDetails_StartupFlag_Call) Run(run func(os string, showMATLAB bool, additionalFlags []string, startupCode string)) *MockProcessDetails_StartupFlag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -192,14 +199,19 @@ func (_c *MockProcessDetails_StartupFlag_Call) Run(run func(os string, showMATLA
 		if args[1] != nil {
 			arg1 = args[1].(bool)
 		}
-		var arg2 string
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].([]string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -210,7 +222,7 @@ func (_c *MockProcessDetails_StartupFlag_Call) Return(strings []string) *MockPro
 	return _c
 }
 
-func (_c *MockProcessDetails_StartupFlag_Call) RunAndReturn(run func(os string, showMATLAB bool, startupCode string) []string) *MockProcessDetails_StartupFlag_Call {
+func (_c *MockProcessDetails_StartupFlag_Call) RunAndReturn(run func(os string, showMATLAB bool, additionalFlags []string, startupCode string) []string) *MockProcessDetails_StartupFlag_Call {
 	_c.Call.Return(run)
 	return _c
 }
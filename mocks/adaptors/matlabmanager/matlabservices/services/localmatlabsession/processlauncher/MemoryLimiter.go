@@ -0,0 +1,93 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMemoryLimiter creates a new instance of MockMemoryLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMemoryLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMemoryLimiter {
+	mock := &MockMemoryLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMemoryLimiter is an autogenerated mock type for the MemoryLimiter type
+type MockMemoryLimiter struct {
+	mock.Mock
+}
+
+type MockMemoryLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMemoryLimiter) EXPECT() *MockMemoryLimiter_Expecter {
+	return &MockMemoryLimiter_Expecter{mock: &_m.Mock}
+}
+
+// LimitMemory provides a mock function for the type MockMemoryLimiter
+func (_mock *MockMemoryLimiter) LimitMemory(pid int, limit uint64) error {
+	ret := _mock.Called(pid, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LimitMemory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, uint64) error); ok {
+		r0 = returnFunc(pid, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMemoryLimiter_LimitMemory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LimitMemory'
+type MockMemoryLimiter_LimitMemory_Call struct {
+	*mock.Call
+}
+
+// LimitMemory is a helper method to define mock.On call
+//   - pid int
+//   - limit uint64
+func (_e *MockMemoryLimiter_Expecter) LimitMemory(pid interface{}, limit interface{}) *MockMemoryLimiter_LimitMemory_Call {
+	return &MockMemoryLimiter_LimitMemory_Call{Call: _e.mock.On("LimitMemory", pid, limit)}
+}
+
+func (_c *MockMemoryLimiter_LimitMemory_Call) Run(run func(pid int, limit uint64)) *MockMemoryLimiter_LimitMemory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 uint64
+		if args[1] != nil {
+			arg1 = args[1].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryLimiter_LimitMemory_Call) Return(err error) *MockMemoryLimiter_LimitMemory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMemoryLimiter_LimitMemory_Call) RunAndReturn(run func(pid int, limit uint64) error) *MockMemoryLimiter_LimitMemory_Call {
+	_c.Call.Return(run)
+	return _c
+}
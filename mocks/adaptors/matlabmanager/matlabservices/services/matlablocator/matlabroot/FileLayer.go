@@ -94,3 +94,65 @@ func (_c *MockFileLayer_EvalSymlinks_Call) RunAndReturn(run func(path string) (s
 	_c.Call.Return(run)
 	return _c
 }
+
+// Glob provides a mock function for the type MockFileLayer
+func (_mock *MockFileLayer) Glob(pattern string) ([]string, error) {
+	ret := _mock.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Glob")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(pattern)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFileLayer_Glob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Glob'
+type MockFileLayer_Glob_Call struct {
+	*mock.Call
+}
+
+// Glob is a helper method to define mock.On call
+//   - pattern string
+func (_e *MockFileLayer_Expecter) Glob(pattern interface{}) *MockFileLayer_Glob_Call {
+	return &MockFileLayer_Glob_Call{Call: _e.mock.On("Glob", pattern)}
+}
+
+func (_c *MockFileLayer_Glob_Call) Run(run func(pattern string)) *MockFileLayer_Glob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileLayer_Glob_Call) Return(strings []string, err error) *MockFileLayer_Glob_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockFileLayer_Glob_Call) RunAndReturn(run func(pattern string) ([]string, error)) *MockFileLayer_Glob_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABManager creates a new instance of MockMATLABManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABManager {
+	mock := &MockMATLABManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABManager is an autogenerated mock type for the MATLABManager type
+type MockMATLABManager struct {
+	mock.Mock
+}
+
+type MockMATLABManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABManager) EXPECT() *MockMATLABManager_Expecter {
+	return &MockMATLABManager_Expecter{mock: &_m.Mock}
+}
+
+// PrestartMATLABSessions provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) PrestartMATLABSessions(ctx context.Context, sessionLogger entities.Logger, request entities.LocalSessionDetails) error {
+	ret := _mock.Called(ctx, sessionLogger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrestartMATLABSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.LocalSessionDetails) error); ok {
+		r0 = returnFunc(ctx, sessionLogger, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABManager_PrestartMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrestartMATLABSessions'
+type MockMATLABManager_PrestartMATLABSessions_Call struct {
+	*mock.Call
+}
+
+// PrestartMATLABSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - request entities.LocalSessionDetails
+func (_e *MockMATLABManager_Expecter) PrestartMATLABSessions(ctx interface{}, sessionLogger interface{}, request interface{}) *MockMATLABManager_PrestartMATLABSessions_Call {
+	return &MockMATLABManager_PrestartMATLABSessions_Call{Call: _e.mock.On("PrestartMATLABSessions", ctx, sessionLogger, request)}
+}
+
+func (_c *MockMATLABManager_PrestartMATLABSessions_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, request entities.LocalSessionDetails)) *MockMATLABManager_PrestartMATLABSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.LocalSessionDetails
+		if args[2] != nil {
+			arg2 = args[2].(entities.LocalSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_PrestartMATLABSessions_Call) Return(err error) *MockMATLABManager_PrestartMATLABSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABManager_PrestartMATLABSessions_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, request entities.LocalSessionDetails) error) *MockMATLABManager_PrestartMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
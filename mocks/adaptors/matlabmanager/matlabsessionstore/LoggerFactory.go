@@ -37,6 +37,57 @@ func (_m *MockLoggerFactory) EXPECT() *MockLoggerFactory_Expecter {
 	return &MockLoggerFactory_Expecter{mock: &_m.Mock}
 }
 
+// CloseSessionLogFile provides a mock function for the type MockLoggerFactory
+func (_mock *MockLoggerFactory) CloseSessionLogFile(sessionID entities.SessionID) error {
+	ret := _mock.Called(sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSessionLogFile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID) error); ok {
+		r0 = returnFunc(sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLoggerFactory_CloseSessionLogFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSessionLogFile'
+type MockLoggerFactory_CloseSessionLogFile_Call struct {
+	*mock.Call
+}
+
+// CloseSessionLogFile is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+func (_e *MockLoggerFactory_Expecter) CloseSessionLogFile(sessionID interface{}) *MockLoggerFactory_CloseSessionLogFile_Call {
+	return &MockLoggerFactory_CloseSessionLogFile_Call{Call: _e.mock.On("CloseSessionLogFile", sessionID)}
+}
+
+func (_c *MockLoggerFactory_CloseSessionLogFile_Call) Run(run func(sessionID entities.SessionID)) *MockLoggerFactory_CloseSessionLogFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoggerFactory_CloseSessionLogFile_Call) Return(err error) *MockLoggerFactory_CloseSessionLogFile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLoggerFactory_CloseSessionLogFile_Call) RunAndReturn(run func(sessionID entities.SessionID) error) *MockLoggerFactory_CloseSessionLogFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGlobalLogger provides a mock function for the type MockLoggerFactory
 func (_mock *MockLoggerFactory) GetGlobalLogger() (entities.Logger, messages.Error) {
 	ret := _mock.Called()
@@ -182,6 +182,52 @@ func (_c *MockMATLABSessionClientWithCleanup_EvalWithCapture_Call) RunAndReturn(
 	return _c
 }
 
+// Exited provides a mock function for the type MockMATLABSessionClientWithCleanup
+func (_mock *MockMATLABSessionClientWithCleanup) Exited() <-chan struct{} {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exited")
+	}
+
+	var r0 <-chan struct{}
+	if returnFunc, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+	return r0
+}
+
+// MockMATLABSessionClientWithCleanup_Exited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exited'
+type MockMATLABSessionClientWithCleanup_Exited_Call struct {
+	*mock.Call
+}
+
+// Exited is a helper method to define mock.On call
+func (_e *MockMATLABSessionClientWithCleanup_Expecter) Exited() *MockMATLABSessionClientWithCleanup_Exited_Call {
+	return &MockMATLABSessionClientWithCleanup_Exited_Call{Call: _e.mock.On("Exited")}
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Exited_Call) Run(run func()) *MockMATLABSessionClientWithCleanup_Exited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Exited_Call) Return(valCh <-chan struct{}) *MockMATLABSessionClientWithCleanup_Exited_Call {
+	_c.Call.Return(valCh)
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_Exited_Call) RunAndReturn(run func() <-chan struct{}) *MockMATLABSessionClientWithCleanup_Exited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FEval provides a mock function for the type MockMATLABSessionClientWithCleanup
 func (_mock *MockMATLABSessionClientWithCleanup) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
 	ret := _mock.Called(ctx, sessionLogger, request)
@@ -0,0 +1,179 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/baseresource"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockResourceWithCompletions creates a new instance of MockResourceWithCompletions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResourceWithCompletions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResourceWithCompletions {
+	mock := &MockResourceWithCompletions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockResourceWithCompletions is an autogenerated mock type for the ResourceWithCompletions type
+type MockResourceWithCompletions struct {
+	mock.Mock
+}
+
+type MockResourceWithCompletions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResourceWithCompletions) EXPECT() *MockResourceWithCompletions_Expecter {
+	return &MockResourceWithCompletions_Expecter{mock: &_m.Mock}
+}
+
+// AddToServer provides a mock function for the type MockResourceWithCompletions
+func (_mock *MockResourceWithCompletions) AddToServer(server resources.Server) error {
+	ret := _mock.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToServer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(resources.Server) error); ok {
+		r0 = returnFunc(server)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockResourceWithCompletions_AddToServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToServer'
+type MockResourceWithCompletions_AddToServer_Call struct {
+	*mock.Call
+}
+
+// AddToServer is a helper method to define mock.On call
+//   - server resources.Server
+func (_e *MockResourceWithCompletions_Expecter) AddToServer(server interface{}) *MockResourceWithCompletions_AddToServer_Call {
+	return &MockResourceWithCompletions_AddToServer_Call{Call: _e.mock.On("AddToServer", server)}
+}
+
+func (_c *MockResourceWithCompletions_AddToServer_Call) Run(run func(server resources.Server)) *MockResourceWithCompletions_AddToServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 resources.Server
+		if args[0] != nil {
+			arg0 = args[0].(resources.Server)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_AddToServer_Call) Return(err error) *MockResourceWithCompletions_AddToServer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_AddToServer_Call) RunAndReturn(run func(server resources.Server) error) *MockResourceWithCompletions_AddToServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompletionProviders provides a mock function for the type MockResourceWithCompletions
+func (_mock *MockResourceWithCompletions) CompletionProviders() map[string]baseresource.CompletionProvider {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompletionProviders")
+	}
+
+	var r0 map[string]baseresource.CompletionProvider
+	if returnFunc, ok := ret.Get(0).(func() map[string]baseresource.CompletionProvider); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]baseresource.CompletionProvider)
+		}
+	}
+	return r0
+}
+
+// MockResourceWithCompletions_CompletionProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletionProviders'
+type MockResourceWithCompletions_CompletionProviders_Call struct {
+	*mock.Call
+}
+
+// CompletionProviders is a helper method to define mock.On call
+func (_e *MockResourceWithCompletions_Expecter) CompletionProviders() *MockResourceWithCompletions_CompletionProviders_Call {
+	return &MockResourceWithCompletions_CompletionProviders_Call{Call: _e.mock.On("CompletionProviders")}
+}
+
+func (_c *MockResourceWithCompletions_CompletionProviders_Call) Run(run func()) *MockResourceWithCompletions_CompletionProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_CompletionProviders_Call) Return(stringToCompletionProvider map[string]baseresource.CompletionProvider) *MockResourceWithCompletions_CompletionProviders_Call {
+	_c.Call.Return(stringToCompletionProvider)
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_CompletionProviders_Call) RunAndReturn(run func() map[string]baseresource.CompletionProvider) *MockResourceWithCompletions_CompletionProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URITemplate provides a mock function for the type MockResourceWithCompletions
+func (_mock *MockResourceWithCompletions) URITemplate() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for URITemplate")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockResourceWithCompletions_URITemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URITemplate'
+type MockResourceWithCompletions_URITemplate_Call struct {
+	*mock.Call
+}
+
+// URITemplate is a helper method to define mock.On call
+func (_e *MockResourceWithCompletions_Expecter) URITemplate() *MockResourceWithCompletions_URITemplate_Call {
+	return &MockResourceWithCompletions_URITemplate_Call{Call: _e.mock.On("URITemplate")}
+}
+
+func (_c *MockResourceWithCompletions_URITemplate_Call) Run(run func()) *MockResourceWithCompletions_URITemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_URITemplate_Call) Return(s string) *MockResourceWithCompletions_URITemplate_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockResourceWithCompletions_URITemplate_Call) RunAndReturn(run func() string) *MockResourceWithCompletions_URITemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGlobalMATLAB creates a new instance of MockGlobalMATLAB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGlobalMATLAB(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGlobalMATLAB {
+	mock := &MockGlobalMATLAB{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGlobalMATLAB is an autogenerated mock type for the GlobalMATLAB type
+type MockGlobalMATLAB struct {
+	mock.Mock
+}
+
+type MockGlobalMATLAB_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGlobalMATLAB) EXPECT() *MockGlobalMATLAB_Expecter {
+	return &MockGlobalMATLAB_Expecter{mock: &_m.Mock}
+}
+
+// BackgroundClient provides a mock function for the type MockGlobalMATLAB
+func (_mock *MockGlobalMATLAB) BackgroundClient(logger entities.Logger) (entities.MATLABSessionClient, bool) {
+	ret := _mock.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackgroundClient")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) (entities.MATLABSessionClient, bool)); ok {
+		return returnFunc(logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger) bool); ok {
+		r1 = returnFunc(logger)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockGlobalMATLAB_BackgroundClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackgroundClient'
+type MockGlobalMATLAB_BackgroundClient_Call struct {
+	*mock.Call
+}
+
+// BackgroundClient is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockGlobalMATLAB_Expecter) BackgroundClient(logger interface{}) *MockGlobalMATLAB_BackgroundClient_Call {
+	return &MockGlobalMATLAB_BackgroundClient_Call{Call: _e.mock.On("BackgroundClient", logger)}
+}
+
+func (_c *MockGlobalMATLAB_BackgroundClient_Call) Run(run func(logger entities.Logger)) *MockGlobalMATLAB_BackgroundClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_BackgroundClient_Call) Return(mATLABSessionClient entities.MATLABSessionClient, b bool) *MockGlobalMATLAB_BackgroundClient_Call {
+	_c.Call.Return(mATLABSessionClient, b)
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_BackgroundClient_Call) RunAndReturn(run func(logger entities.Logger) (entities.MATLABSessionClient, bool)) *MockGlobalMATLAB_BackgroundClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPrompt creates a new instance of MockPrompt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPrompt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPrompt {
+	mock := &MockPrompt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPrompt is an autogenerated mock type for the Prompt type
+type MockPrompt struct {
+	mock.Mock
+}
+
+type MockPrompt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrompt) EXPECT() *MockPrompt_Expecter {
+	return &MockPrompt_Expecter{mock: &_m.Mock}
+}
+
+// AddToServer provides a mock function for the type MockPrompt
+func (_mock *MockPrompt) AddToServer(server prompts.Server) error {
+	ret := _mock.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToServer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(prompts.Server) error); ok {
+		r0 = returnFunc(server)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPrompt_AddToServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToServer'
+type MockPrompt_AddToServer_Call struct {
+	*mock.Call
+}
+
+// AddToServer is a helper method to define mock.On call
+//   - server prompts.Server
+func (_e *MockPrompt_Expecter) AddToServer(server interface{}) *MockPrompt_AddToServer_Call {
+	return &MockPrompt_AddToServer_Call{Call: _e.mock.On("AddToServer", server)}
+}
+
+func (_c *MockPrompt_AddToServer_Call) Run(run func(server prompts.Server)) *MockPrompt_AddToServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 prompts.Server
+		if args[0] != nil {
+			arg0 = args[0].(prompts.Server)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrompt_AddToServer_Call) Return(err error) *MockPrompt_AddToServer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPrompt_AddToServer_Call) RunAndReturn(run func(server prompts.Server) error) *MockPrompt_AddToServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockPrompt
+func (_mock *MockPrompt) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPrompt_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockPrompt_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockPrompt_Expecter) Name() *MockPrompt_Name_Call {
+	return &MockPrompt_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockPrompt_Name_Call) Run(run func()) *MockPrompt_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPrompt_Name_Call) Return(s string) *MockPrompt_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPrompt_Name_Call) RunAndReturn(run func() string) *MockPrompt_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -81,3 +81,49 @@ func (_c *MockServer_AddResource_Call) RunAndReturn(run func(resource *mcp.Resou
 	_c.Run(run)
 	return _c
 }
+
+// AddResourceTemplate provides a mock function for the type MockServer
+func (_mock *MockServer) AddResourceTemplate(template *mcp.ResourceTemplate, handler mcp.ResourceHandler) {
+	_mock.Called(template, handler)
+	return
+}
+
+// MockServer_AddResourceTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddResourceTemplate'
+type MockServer_AddResourceTemplate_Call struct {
+	*mock.Call
+}
+
+// AddResourceTemplate is a helper method to define mock.On call
+//   - template *mcp.ResourceTemplate
+//   - handler mcp.ResourceHandler
+func (_e *MockServer_Expecter) AddResourceTemplate(template interface{}, handler interface{}) *MockServer_AddResourceTemplate_Call {
+	return &MockServer_AddResourceTemplate_Call{Call: _e.mock.On("AddResourceTemplate", template, handler)}
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) Run(run func(template *mcp.ResourceTemplate, handler mcp.ResourceHandler)) *MockServer_AddResourceTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *mcp.ResourceTemplate
+		if args[0] != nil {
+			arg0 = args[0].(*mcp.ResourceTemplate)
+		}
+		var arg1 mcp.ResourceHandler
+		if args[1] != nil {
+			arg1 = args[1].(mcp.ResourceHandler)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) Return() *MockServer_AddResourceTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) RunAndReturn(run func(template *mcp.ResourceTemplate, handler mcp.ResourceHandler)) *MockServer_AddResourceTemplate_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,113 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockFunctionCompleter creates a new instance of MockFunctionCompleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFunctionCompleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFunctionCompleter {
+	mock := &MockFunctionCompleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFunctionCompleter is an autogenerated mock type for the FunctionCompleter type
+type MockFunctionCompleter struct {
+	mock.Mock
+}
+
+type MockFunctionCompleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFunctionCompleter) EXPECT() *MockFunctionCompleter_Expecter {
+	return &MockFunctionCompleter_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockFunctionCompleter
+func (_mock *MockFunctionCompleter) Complete(ctx context.Context, logger entities.Logger, value string) ([]string, error) {
+	ret := _mock.Called(ctx, logger, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) ([]string, error)); ok {
+		return returnFunc(ctx, logger, value)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) []string); ok {
+		r0 = returnFunc(ctx, logger, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string) error); ok {
+		r1 = returnFunc(ctx, logger, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFunctionCompleter_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockFunctionCompleter_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - value string
+func (_e *MockFunctionCompleter_Expecter) Complete(ctx interface{}, logger interface{}, value interface{}) *MockFunctionCompleter_Complete_Call {
+	return &MockFunctionCompleter_Complete_Call{Call: _e.mock.On("Complete", ctx, logger, value)}
+}
+
+func (_c *MockFunctionCompleter_Complete_Call) Run(run func(ctx context.Context, logger entities.Logger, value string)) *MockFunctionCompleter_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFunctionCompleter_Complete_Call) Return(strings []string, err error) *MockFunctionCompleter_Complete_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockFunctionCompleter_Complete_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, value string) ([]string, error)) *MockFunctionCompleter_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,109 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/utils/responseconverter"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutputStore creates a new instance of MockOutputStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutputStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutputStore {
+	mock := &MockOutputStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutputStore is an autogenerated mock type for the OutputStore type
+type MockOutputStore struct {
+	mock.Mock
+}
+
+type MockOutputStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutputStore) EXPECT() *MockOutputStore_Expecter {
+	return &MockOutputStore_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function for the type MockOutputStore
+func (_mock *MockOutputStore) Read(callID string, offset int, limit int) (responseconverter.OutputPage, error) {
+	ret := _mock.Called(callID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 responseconverter.OutputPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) (responseconverter.OutputPage, error)); ok {
+		return returnFunc(callID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) responseconverter.OutputPage); ok {
+		r0 = returnFunc(callID, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responseconverter.OutputPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = returnFunc(callID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutputStore_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockOutputStore_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - callID string
+//   - offset int
+//   - limit int
+func (_e *MockOutputStore_Expecter) Read(callID interface{}, offset interface{}, limit interface{}) *MockOutputStore_Read_Call {
+	return &MockOutputStore_Read_Call{Call: _e.mock.On("Read", callID, offset, limit)}
+}
+
+func (_c *MockOutputStore_Read_Call) Run(run func(callID string, offset int, limit int)) *MockOutputStore_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutputStore_Read_Call) Return(outputPage responseconverter.OutputPage, err error) *MockOutputStore_Read_Call {
+	_c.Call.Return(outputPage, err)
+	return _c
+}
+
+func (_c *MockOutputStore_Read_Call) RunAndReturn(run func(callID string, offset int, limit int) (responseconverter.OutputPage, error)) *MockOutputStore_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
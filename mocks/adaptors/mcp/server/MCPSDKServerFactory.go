@@ -38,8 +38,8 @@ func (_m *MockMCPSDKServerFactory) EXPECT() *MockMCPSDKServerFactory_Expecter {
 }
 
 // NewServer provides a mock function for the type MockMCPSDKServerFactory
-func (_mock *MockMCPSDKServerFactory) NewServer() (*mcp.Server, messages.Error) {
-	ret := _mock.Called()
+func (_mock *MockMCPSDKServerFactory) NewServer(toolMiddleware []mcp.Middleware) (*mcp.Server, messages.Error) {
+	ret := _mock.Called(toolMiddleware)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewServer")
@@ -47,18 +47,18 @@ func (_mock *MockMCPSDKServerFactory) NewServer() (*mcp.Server, messages.Error)
 
 	var r0 *mcp.Server
 	var r1 messages.Error
-	if returnFunc, ok := ret.Get(0).(func() (*mcp.Server, messages.Error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func([]mcp.Middleware) (*mcp.Server, messages.Error)); ok {
+		return returnFunc(toolMiddleware)
 	}
-	if returnFunc, ok := ret.Get(0).(func() *mcp.Server); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func([]mcp.Middleware) *mcp.Server); ok {
+		r0 = returnFunc(toolMiddleware)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*mcp.Server)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() messages.Error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func([]mcp.Middleware) messages.Error); ok {
+		r1 = returnFunc(toolMiddleware)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(messages.Error)
@@ -73,13 +73,20 @@ type MockMCPSDKServerFactory_NewServer_Call struct {
 }
 
 // NewServer is a helper method to define mock.On call
-func (_e *MockMCPSDKServerFactory_Expecter) NewServer() *MockMCPSDKServerFactory_NewServer_Call {
-	return &MockMCPSDKServerFactory_NewServer_Call{Call: _e.mock.On("NewServer")}
+//   - toolMiddleware []mcp.Middleware
+func (_e *MockMCPSDKServerFactory_Expecter) NewServer(toolMiddleware interface{}) *MockMCPSDKServerFactory_NewServer_Call {
+	return &MockMCPSDKServerFactory_NewServer_Call{Call: _e.mock.On("NewServer", toolMiddleware)}
 }
 
-func (_c *MockMCPSDKServerFactory_NewServer_Call) Run(run func()) *MockMCPSDKServerFactory_NewServer_Call {
+func (_c *MockMCPSDKServerFactory_NewServer_Call) Run(run func(toolMiddleware []mcp.Middleware)) *MockMCPSDKServerFactory_NewServer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 []mcp.Middleware
+		if args[0] != nil {
+			arg0 = args[0].([]mcp.Middleware)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -89,7 +96,7 @@ func (_c *MockMCPSDKServerFactory_NewServer_Call) Return(server *mcp.Server, err
 	return _c
 }
 
-func (_c *MockMCPSDKServerFactory_NewServer_Call) RunAndReturn(run func() (*mcp.Server, messages.Error)) *MockMCPSDKServerFactory_NewServer_Call {
+func (_c *MockMCPSDKServerFactory_NewServer_Call) RunAndReturn(run func(toolMiddleware []mcp.Middleware) (*mcp.Server, messages.Error)) *MockMCPSDKServerFactory_NewServer_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools"
 	mock "github.com/stretchr/testify/mock"
@@ -37,6 +38,68 @@ func (_m *MockMCPServerConfigurator) EXPECT() *MockMCPServerConfigurator_Expecte
 	return &MockMCPServerConfigurator_Expecter{mock: &_m.Mock}
 }
 
+// GetPromptsToAdd provides a mock function for the type MockMCPServerConfigurator
+func (_mock *MockMCPServerConfigurator) GetPromptsToAdd(toolsToAdd []tools.Tool) ([]prompts.Prompt, error) {
+	ret := _mock.Called(toolsToAdd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromptsToAdd")
+	}
+
+	var r0 []prompts.Prompt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]tools.Tool) ([]prompts.Prompt, error)); ok {
+		return returnFunc(toolsToAdd)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]tools.Tool) []prompts.Prompt); ok {
+		r0 = returnFunc(toolsToAdd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]prompts.Prompt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]tools.Tool) error); ok {
+		r1 = returnFunc(toolsToAdd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMCPServerConfigurator_GetPromptsToAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromptsToAdd'
+type MockMCPServerConfigurator_GetPromptsToAdd_Call struct {
+	*mock.Call
+}
+
+// GetPromptsToAdd is a helper method to define mock.On call
+//   - toolsToAdd []tools.Tool
+func (_e *MockMCPServerConfigurator_Expecter) GetPromptsToAdd(toolsToAdd interface{}) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	return &MockMCPServerConfigurator_GetPromptsToAdd_Call{Call: _e.mock.On("GetPromptsToAdd", toolsToAdd)}
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Run(run func(toolsToAdd []tools.Tool)) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []tools.Tool
+		if args[0] != nil {
+			arg0 = args[0].([]tools.Tool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Return(prompts1 []prompts.Prompt, err error) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(prompts1, err)
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) RunAndReturn(run func(toolsToAdd []tools.Tool) ([]prompts.Prompt, error)) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResourcesToAdd provides a mock function for the type MockMCPServerConfigurator
 func (_mock *MockMCPServerConfigurator) GetResourcesToAdd() []resources.Resource {
 	ret := _mock.Called()
@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCompleter creates a new instance of MockCompleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCompleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCompleter {
+	mock := &MockCompleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCompleter is an autogenerated mock type for the Completer type
+type MockCompleter struct {
+	mock.Mock
+}
+
+type MockCompleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCompleter) EXPECT() *MockCompleter_Expecter {
+	return &MockCompleter_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockCompleter
+func (_mock *MockCompleter) Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 *mcp.CompleteResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *mcp.CompleteRequest) (*mcp.CompleteResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *mcp.CompleteRequest) *mcp.CompleteResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mcp.CompleteResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *mcp.CompleteRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCompleter_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockCompleter_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *mcp.CompleteRequest
+func (_e *MockCompleter_Expecter) Complete(ctx interface{}, req interface{}) *MockCompleter_Complete_Call {
+	return &MockCompleter_Complete_Call{Call: _e.mock.On("Complete", ctx, req)}
+}
+
+func (_c *MockCompleter_Complete_Call) Run(run func(ctx context.Context, req *mcp.CompleteRequest)) *MockCompleter_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *mcp.CompleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*mcp.CompleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCompleter_Complete_Call) Return(completeResult *mcp.CompleteResult, err error) *MockCompleter_Complete_Call {
+	_c.Call.Return(completeResult, err)
+	return _c
+}
+
+func (_c *MockCompleter_Complete_Call) RunAndReturn(run func(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error)) *MockCompleter_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
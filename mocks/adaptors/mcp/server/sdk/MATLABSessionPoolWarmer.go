@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABSessionPoolWarmer creates a new instance of MockMATLABSessionPoolWarmer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABSessionPoolWarmer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABSessionPoolWarmer {
+	mock := &MockMATLABSessionPoolWarmer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABSessionPoolWarmer is an autogenerated mock type for the MATLABSessionPoolWarmer type
+type MockMATLABSessionPoolWarmer struct {
+	mock.Mock
+}
+
+type MockMATLABSessionPoolWarmer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABSessionPoolWarmer) EXPECT() *MockMATLABSessionPoolWarmer_Expecter {
+	return &MockMATLABSessionPoolWarmer_Expecter{mock: &_m.Mock}
+}
+
+// Warm provides a mock function for the type MockMATLABSessionPoolWarmer
+func (_mock *MockMATLABSessionPoolWarmer) Warm(ctx context.Context, logger entities.Logger) error {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Warm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) error); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABSessionPoolWarmer_Warm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Warm'
+type MockMATLABSessionPoolWarmer_Warm_Call struct {
+	*mock.Call
+}
+
+// Warm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockMATLABSessionPoolWarmer_Expecter) Warm(ctx interface{}, logger interface{}) *MockMATLABSessionPoolWarmer_Warm_Call {
+	return &MockMATLABSessionPoolWarmer_Warm_Call{Call: _e.mock.On("Warm", ctx, logger)}
+}
+
+func (_c *MockMATLABSessionPoolWarmer_Warm_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockMATLABSessionPoolWarmer_Warm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionPoolWarmer_Warm_Call) Return(err error) *MockMATLABSessionPoolWarmer_Warm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABSessionPoolWarmer_Warm_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) error) *MockMATLABSessionPoolWarmer_Warm_Call {
+	_c.Call.Return(run)
+	return _c
+}
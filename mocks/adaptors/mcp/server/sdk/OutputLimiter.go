@@ -0,0 +1,97 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutputLimiter creates a new instance of MockOutputLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutputLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutputLimiter {
+	mock := &MockOutputLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutputLimiter is an autogenerated mock type for the OutputLimiter type
+type MockOutputLimiter struct {
+	mock.Mock
+}
+
+type MockOutputLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutputLimiter) EXPECT() *MockOutputLimiter_Expecter {
+	return &MockOutputLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Middleware provides a mock function for the type MockOutputLimiter
+func (_mock *MockOutputLimiter) Middleware(logger entities.Logger, maxBytes int) mcp.Middleware {
+	ret := _mock.Called(logger, maxBytes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Middleware")
+	}
+
+	var r0 mcp.Middleware
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, int) mcp.Middleware); ok {
+		r0 = returnFunc(logger, maxBytes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mcp.Middleware)
+		}
+	}
+	return r0
+}
+
+// MockOutputLimiter_Middleware_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Middleware'
+type MockOutputLimiter_Middleware_Call struct {
+	*mock.Call
+}
+
+// Middleware is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - maxBytes int
+func (_e *MockOutputLimiter_Expecter) Middleware(logger interface{}, maxBytes interface{}) *MockOutputLimiter_Middleware_Call {
+	return &MockOutputLimiter_Middleware_Call{Call: _e.mock.On("Middleware", logger, maxBytes)}
+}
+
+func (_c *MockOutputLimiter_Middleware_Call) Run(run func(logger entities.Logger, maxBytes int)) *MockOutputLimiter_Middleware_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutputLimiter_Middleware_Call) Return(middleware mcp.Middleware) *MockOutputLimiter_Middleware_Call {
+	_c.Call.Return(middleware)
+	return _c
+}
+
+func (_c *MockOutputLimiter_Middleware_Call) RunAndReturn(run func(logger entities.Logger, maxBytes int) mcp.Middleware) *MockOutputLimiter_Middleware_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,115 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConfirmer creates a new instance of MockConfirmer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfirmer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfirmer {
+	mock := &MockConfirmer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfirmer is an autogenerated mock type for the Confirmer type
+type MockConfirmer struct {
+	mock.Mock
+}
+
+type MockConfirmer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfirmer) EXPECT() *MockConfirmer_Expecter {
+	return &MockConfirmer_Expecter{mock: &_m.Mock}
+}
+
+// Confirm provides a mock function for the type MockConfirmer
+func (_mock *MockConfirmer) Confirm(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, toolName string, action string) error {
+	ret := _mock.Called(ctx, logger, session, toolName, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, *mcp.ServerSession, string, string) error); ok {
+		r0 = returnFunc(ctx, logger, session, toolName, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfirmer_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockConfirmer_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - session *mcp.ServerSession
+//   - toolName string
+//   - action string
+func (_e *MockConfirmer_Expecter) Confirm(ctx interface{}, logger interface{}, session interface{}, toolName interface{}, action interface{}) *MockConfirmer_Confirm_Call {
+	return &MockConfirmer_Confirm_Call{Call: _e.mock.On("Confirm", ctx, logger, session, toolName, action)}
+}
+
+func (_c *MockConfirmer_Confirm_Call) Run(run func(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, toolName string, action string)) *MockConfirmer_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 *mcp.ServerSession
+		if args[2] != nil {
+			arg2 = args[2].(*mcp.ServerSession)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfirmer_Confirm_Call) Return(err error) *MockConfirmer_Confirm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfirmer_Confirm_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, session *mcp.ServerSession, toolName string, action string) error) *MockConfirmer_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
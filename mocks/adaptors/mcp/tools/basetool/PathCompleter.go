@@ -0,0 +1,99 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/tools/basetool"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPathCompleter creates a new instance of MockPathCompleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPathCompleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPathCompleter {
+	mock := &MockPathCompleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPathCompleter is an autogenerated mock type for the PathCompleter type
+type MockPathCompleter struct {
+	mock.Mock
+}
+
+type MockPathCompleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPathCompleter) EXPECT() *MockPathCompleter_Expecter {
+	return &MockPathCompleter_Expecter{mock: &_m.Mock}
+}
+
+// Files provides a mock function for the type MockPathCompleter
+func (_mock *MockPathCompleter) Files(extensions ...string) basetool.CompletionProvider {
+	var tmpRet mock.Arguments
+	if len(extensions) > 0 {
+		tmpRet = _mock.Called(extensions)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Files")
+	}
+
+	var r0 basetool.CompletionProvider
+	if returnFunc, ok := ret.Get(0).(func(...string) basetool.CompletionProvider); ok {
+		r0 = returnFunc(extensions...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(basetool.CompletionProvider)
+		}
+	}
+	return r0
+}
+
+// MockPathCompleter_Files_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Files'
+type MockPathCompleter_Files_Call struct {
+	*mock.Call
+}
+
+// Files is a helper method to define mock.On call
+//   - extensions ...string
+func (_e *MockPathCompleter_Expecter) Files(extensions ...interface{}) *MockPathCompleter_Files_Call {
+	return &MockPathCompleter_Files_Call{Call: _e.mock.On("Files",
+		append([]interface{}{}, extensions...)...)}
+}
+
+func (_c *MockPathCompleter_Files_Call) Run(run func(extensions ...string)) *MockPathCompleter_Files_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		var variadicArgs []string
+		if len(args) > 0 {
+			variadicArgs = args[0].([]string)
+		}
+		arg0 = variadicArgs
+		run(
+			arg0...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPathCompleter_Files_Call) Return(completionProvider basetool.CompletionProvider) *MockPathCompleter_Files_Call {
+	_c.Call.Return(completionProvider)
+	return _c
+}
+
+func (_c *MockPathCompleter_Files_Call) RunAndReturn(run func(extensions ...string) basetool.CompletionProvider) *MockPathCompleter_Files_Call {
+	_c.Call.Return(run)
+	return _c
+}
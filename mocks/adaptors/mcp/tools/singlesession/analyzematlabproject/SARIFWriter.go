@@ -0,0 +1,94 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabproject"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSARIFWriter creates a new instance of MockSARIFWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSARIFWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSARIFWriter {
+	mock := &MockSARIFWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSARIFWriter is an autogenerated mock type for the SARIFWriter type
+type MockSARIFWriter struct {
+	mock.Mock
+}
+
+type MockSARIFWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSARIFWriter) EXPECT() *MockSARIFWriter_Expecter {
+	return &MockSARIFWriter_Expecter{mock: &_m.Mock}
+}
+
+// WriteSARIF provides a mock function for the type MockSARIFWriter
+func (_mock *MockSARIFWriter) WriteSARIF(path string, result analyzematlabproject.ReturnArgs) error {
+	ret := _mock.Called(path, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteSARIF")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, analyzematlabproject.ReturnArgs) error); ok {
+		r0 = returnFunc(path, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSARIFWriter_WriteSARIF_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteSARIF'
+type MockSARIFWriter_WriteSARIF_Call struct {
+	*mock.Call
+}
+
+// WriteSARIF is a helper method to define mock.On call
+//   - path string
+//   - result analyzematlabproject.ReturnArgs
+func (_e *MockSARIFWriter_Expecter) WriteSARIF(path interface{}, result interface{}) *MockSARIFWriter_WriteSARIF_Call {
+	return &MockSARIFWriter_WriteSARIF_Call{Call: _e.mock.On("WriteSARIF", path, result)}
+}
+
+func (_c *MockSARIFWriter_WriteSARIF_Call) Run(run func(path string, result analyzematlabproject.ReturnArgs)) *MockSARIFWriter_WriteSARIF_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 analyzematlabproject.ReturnArgs
+		if args[1] != nil {
+			arg1 = args[1].(analyzematlabproject.ReturnArgs)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSARIFWriter_WriteSARIF_Call) Return(err error) *MockSARIFWriter_WriteSARIF_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSARIFWriter_WriteSARIF_Call) RunAndReturn(run func(path string, result analyzematlabproject.ReturnArgs) error) *MockSARIFWriter_WriteSARIF_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGlobalMATLABSession creates a new instance of MockGlobalMATLABSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGlobalMATLABSession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGlobalMATLABSession {
+	mock := &MockGlobalMATLABSession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGlobalMATLABSession is an autogenerated mock type for the GlobalMATLABSession type
+type MockGlobalMATLABSession struct {
+	mock.Mock
+}
+
+type MockGlobalMATLABSession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGlobalMATLABSession) EXPECT() *MockGlobalMATLABSession_Expecter {
+	return &MockGlobalMATLABSession_Expecter{mock: &_m.Mock}
+}
+
+// IsAttachedToExistingSession provides a mock function for the type MockGlobalMATLABSession
+func (_mock *MockGlobalMATLABSession) IsAttachedToExistingSession() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAttachedToExistingSession")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockGlobalMATLABSession_IsAttachedToExistingSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAttachedToExistingSession'
+type MockGlobalMATLABSession_IsAttachedToExistingSession_Call struct {
+	*mock.Call
+}
+
+// IsAttachedToExistingSession is a helper method to define mock.On call
+func (_e *MockGlobalMATLABSession_Expecter) IsAttachedToExistingSession() *MockGlobalMATLABSession_IsAttachedToExistingSession_Call {
+	return &MockGlobalMATLABSession_IsAttachedToExistingSession_Call{Call: _e.mock.On("IsAttachedToExistingSession")}
+}
+
+func (_c *MockGlobalMATLABSession_IsAttachedToExistingSession_Call) Run(run func()) *MockGlobalMATLABSession_IsAttachedToExistingSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLABSession_IsAttachedToExistingSession_Call) Return(b bool) *MockGlobalMATLABSession_IsAttachedToExistingSession_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockGlobalMATLABSession_IsAttachedToExistingSession_Call) RunAndReturn(run func() bool) *MockGlobalMATLABSession_IsAttachedToExistingSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
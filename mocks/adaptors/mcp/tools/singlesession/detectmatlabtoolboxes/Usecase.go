@@ -110,3 +110,75 @@ func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, s
 	_c.Call.Return(run)
 	return _c
 }
+
+// ExecuteForInstallation provides a mock function for the type MockUsecase
+func (_mock *MockUsecase) ExecuteForInstallation(ctx context.Context, sessionLogger entities.Logger, matlabRoot string) (detectmatlabtoolboxes.ReturnArgs, error) {
+	ret := _mock.Called(ctx, sessionLogger, matlabRoot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteForInstallation")
+	}
+
+	var r0 detectmatlabtoolboxes.ReturnArgs
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) (detectmatlabtoolboxes.ReturnArgs, error)); ok {
+		return returnFunc(ctx, sessionLogger, matlabRoot)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) detectmatlabtoolboxes.ReturnArgs); ok {
+		r0 = returnFunc(ctx, sessionLogger, matlabRoot)
+	} else {
+		r0 = ret.Get(0).(detectmatlabtoolboxes.ReturnArgs)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, matlabRoot)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsecase_ExecuteForInstallation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteForInstallation'
+type MockUsecase_ExecuteForInstallation_Call struct {
+	*mock.Call
+}
+
+// ExecuteForInstallation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - matlabRoot string
+func (_e *MockUsecase_Expecter) ExecuteForInstallation(ctx interface{}, sessionLogger interface{}, matlabRoot interface{}) *MockUsecase_ExecuteForInstallation_Call {
+	return &MockUsecase_ExecuteForInstallation_Call{Call: _e.mock.On("ExecuteForInstallation", ctx, sessionLogger, matlabRoot)}
+}
+
+func (_c *MockUsecase_ExecuteForInstallation_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, matlabRoot string)) *MockUsecase_ExecuteForInstallation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsecase_ExecuteForInstallation_Call) Return(returnArgs detectmatlabtoolboxes.ReturnArgs, err error) *MockUsecase_ExecuteForInstallation_Call {
+	_c.Call.Return(returnArgs, err)
+	return _c
+}
+
+func (_c *MockUsecase_ExecuteForInstallation_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, matlabRoot string) (detectmatlabtoolboxes.ReturnArgs, error)) *MockUsecase_ExecuteForInstallation_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -93,6 +93,75 @@ func (_c *MockSyscallLayer_Getrlimit_Call) RunAndReturn(run func(resource int, r
 	return _c
 }
 
+// Prlimit provides a mock function for the type MockSyscallLayer
+func (_mock *MockSyscallLayer) Prlimit(pid int, resource int, newLimit *unix.Rlimit, old *unix.Rlimit) error {
+	ret := _mock.Called(pid, resource, newLimit, old)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prlimit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, *unix.Rlimit, *unix.Rlimit) error); ok {
+		r0 = returnFunc(pid, resource, newLimit, old)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSyscallLayer_Prlimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prlimit'
+type MockSyscallLayer_Prlimit_Call struct {
+	*mock.Call
+}
+
+// Prlimit is a helper method to define mock.On call
+//   - pid int
+//   - resource int
+//   - newLimit *unix.Rlimit
+//   - old *unix.Rlimit
+func (_e *MockSyscallLayer_Expecter) Prlimit(pid interface{}, resource interface{}, newLimit interface{}, old interface{}) *MockSyscallLayer_Prlimit_Call {
+	return &MockSyscallLayer_Prlimit_Call{Call: _e.mock.On("Prlimit", pid, resource, newLimit, old)}
+}
+
+func (_c *MockSyscallLayer_Prlimit_Call) Run(run func(pid int, resource int, newLimit *unix.Rlimit, old *unix.Rlimit)) *MockSyscallLayer_Prlimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 *unix.Rlimit
+		if args[2] != nil {
+			arg2 = args[2].(*unix.Rlimit)
+		}
+		var arg3 *unix.Rlimit
+		if args[3] != nil {
+			arg3 = args[3].(*unix.Rlimit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSyscallLayer_Prlimit_Call) Return(err error) *MockSyscallLayer_Prlimit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSyscallLayer_Prlimit_Call) RunAndReturn(run func(pid int, resource int, newLimit *unix.Rlimit, old *unix.Rlimit) error) *MockSyscallLayer_Prlimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Setrlimit provides a mock function for the type MockSyscallLayer
 func (_mock *MockSyscallLayer) Setrlimit(resource int, rlim *unix.Rlimit) error {
 	ret := _mock.Called(resource, rlim)
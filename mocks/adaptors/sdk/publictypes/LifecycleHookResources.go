@@ -0,0 +1,175 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLifecycleHookResources creates a new instance of MockLifecycleHookResources. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLifecycleHookResources[Dependencies any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLifecycleHookResources[Dependencies] {
+	mock := &MockLifecycleHookResources[Dependencies]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLifecycleHookResources is an autogenerated mock type for the LifecycleHookResources type
+type MockLifecycleHookResources[Dependencies any] struct {
+	mock.Mock
+}
+
+type MockLifecycleHookResources_Expecter[Dependencies any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLifecycleHookResources[Dependencies]) EXPECT() *MockLifecycleHookResources_Expecter[Dependencies] {
+	return &MockLifecycleHookResources_Expecter[Dependencies]{mock: &_m.Mock}
+}
+
+// Config provides a mock function for the type MockLifecycleHookResources
+func (_mock *MockLifecycleHookResources[Dependencies]) Config() publictypes.Config {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 publictypes.Config
+	if returnFunc, ok := ret.Get(0).(func() publictypes.Config); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.Config)
+		}
+	}
+	return r0
+}
+
+// MockLifecycleHookResources_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type MockLifecycleHookResources_Config_Call[Dependencies any] struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *MockLifecycleHookResources_Expecter[Dependencies]) Config() *MockLifecycleHookResources_Config_Call[Dependencies] {
+	return &MockLifecycleHookResources_Config_Call[Dependencies]{Call: _e.mock.On("Config")}
+}
+
+func (_c *MockLifecycleHookResources_Config_Call[Dependencies]) Run(run func()) *MockLifecycleHookResources_Config_Call[Dependencies] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Config_Call[Dependencies]) Return(config publictypes.Config) *MockLifecycleHookResources_Config_Call[Dependencies] {
+	_c.Call.Return(config)
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Config_Call[Dependencies]) RunAndReturn(run func() publictypes.Config) *MockLifecycleHookResources_Config_Call[Dependencies] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dependencies provides a mock function for the type MockLifecycleHookResources
+func (_mock *MockLifecycleHookResources[Dependencies]) Dependencies() Dependencies {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dependencies")
+	}
+
+	var r0 Dependencies
+	if returnFunc, ok := ret.Get(0).(func() Dependencies); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(Dependencies)
+		}
+	}
+	return r0
+}
+
+// MockLifecycleHookResources_Dependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dependencies'
+type MockLifecycleHookResources_Dependencies_Call[Dependencies any] struct {
+	*mock.Call
+}
+
+// Dependencies is a helper method to define mock.On call
+func (_e *MockLifecycleHookResources_Expecter[Dependencies]) Dependencies() *MockLifecycleHookResources_Dependencies_Call[Dependencies] {
+	return &MockLifecycleHookResources_Dependencies_Call[Dependencies]{Call: _e.mock.On("Dependencies")}
+}
+
+func (_c *MockLifecycleHookResources_Dependencies_Call[Dependencies]) Run(run func()) *MockLifecycleHookResources_Dependencies_Call[Dependencies] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Dependencies_Call[Dependencies]) Return(v Dependencies) *MockLifecycleHookResources_Dependencies_Call[Dependencies] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Dependencies_Call[Dependencies]) RunAndReturn(run func() Dependencies) *MockLifecycleHookResources_Dependencies_Call[Dependencies] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logger provides a mock function for the type MockLifecycleHookResources
+func (_mock *MockLifecycleHookResources[Dependencies]) Logger() publictypes.Logger {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logger")
+	}
+
+	var r0 publictypes.Logger
+	if returnFunc, ok := ret.Get(0).(func() publictypes.Logger); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.Logger)
+		}
+	}
+	return r0
+}
+
+// MockLifecycleHookResources_Logger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logger'
+type MockLifecycleHookResources_Logger_Call[Dependencies any] struct {
+	*mock.Call
+}
+
+// Logger is a helper method to define mock.On call
+func (_e *MockLifecycleHookResources_Expecter[Dependencies]) Logger() *MockLifecycleHookResources_Logger_Call[Dependencies] {
+	return &MockLifecycleHookResources_Logger_Call[Dependencies]{Call: _e.mock.On("Logger")}
+}
+
+func (_c *MockLifecycleHookResources_Logger_Call[Dependencies]) Run(run func()) *MockLifecycleHookResources_Logger_Call[Dependencies] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Logger_Call[Dependencies]) Return(logger publictypes.Logger) *MockLifecycleHookResources_Logger_Call[Dependencies] {
+	_c.Call.Return(logger)
+	return _c
+}
+
+func (_c *MockLifecycleHookResources_Logger_Call[Dependencies]) RunAndReturn(run func() publictypes.Logger) *MockLifecycleHookResources_Logger_Call[Dependencies] {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,274 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"encoding/json"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockToolCall creates a new instance of MockToolCall. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockToolCall(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockToolCall {
+	mock := &MockToolCall{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockToolCall is an autogenerated mock type for the ToolCall type
+type MockToolCall struct {
+	mock.Mock
+}
+
+type MockToolCall_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockToolCall) EXPECT() *MockToolCall_Expecter {
+	return &MockToolCall_Expecter{mock: &_m.Mock}
+}
+
+// Arguments provides a mock function for the type MockToolCall
+func (_mock *MockToolCall) Arguments() json.RawMessage {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Arguments")
+	}
+
+	var r0 json.RawMessage
+	if returnFunc, ok := ret.Get(0).(func() json.RawMessage); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+	return r0
+}
+
+// MockToolCall_Arguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Arguments'
+type MockToolCall_Arguments_Call struct {
+	*mock.Call
+}
+
+// Arguments is a helper method to define mock.On call
+func (_e *MockToolCall_Expecter) Arguments() *MockToolCall_Arguments_Call {
+	return &MockToolCall_Arguments_Call{Call: _e.mock.On("Arguments")}
+}
+
+func (_c *MockToolCall_Arguments_Call) Run(run func()) *MockToolCall_Arguments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolCall_Arguments_Call) Return(rawMessage json.RawMessage) *MockToolCall_Arguments_Call {
+	_c.Call.Return(rawMessage)
+	return _c
+}
+
+func (_c *MockToolCall_Arguments_Call) RunAndReturn(run func() json.RawMessage) *MockToolCall_Arguments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Config provides a mock function for the type MockToolCall
+func (_mock *MockToolCall) Config() publictypes.Config {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 publictypes.Config
+	if returnFunc, ok := ret.Get(0).(func() publictypes.Config); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.Config)
+		}
+	}
+	return r0
+}
+
+// MockToolCall_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type MockToolCall_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *MockToolCall_Expecter) Config() *MockToolCall_Config_Call {
+	return &MockToolCall_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *MockToolCall_Config_Call) Run(run func()) *MockToolCall_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolCall_Config_Call) Return(config publictypes.Config) *MockToolCall_Config_Call {
+	_c.Call.Return(config)
+	return _c
+}
+
+func (_c *MockToolCall_Config_Call) RunAndReturn(run func() publictypes.Config) *MockToolCall_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logger provides a mock function for the type MockToolCall
+func (_mock *MockToolCall) Logger() publictypes.Logger {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logger")
+	}
+
+	var r0 publictypes.Logger
+	if returnFunc, ok := ret.Get(0).(func() publictypes.Logger); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.Logger)
+		}
+	}
+	return r0
+}
+
+// MockToolCall_Logger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logger'
+type MockToolCall_Logger_Call struct {
+	*mock.Call
+}
+
+// Logger is a helper method to define mock.On call
+func (_e *MockToolCall_Expecter) Logger() *MockToolCall_Logger_Call {
+	return &MockToolCall_Logger_Call{Call: _e.mock.On("Logger")}
+}
+
+func (_c *MockToolCall_Logger_Call) Run(run func()) *MockToolCall_Logger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolCall_Logger_Call) Return(logger publictypes.Logger) *MockToolCall_Logger_Call {
+	_c.Call.Return(logger)
+	return _c
+}
+
+func (_c *MockToolCall_Logger_Call) RunAndReturn(run func() publictypes.Logger) *MockToolCall_Logger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockToolCall
+func (_mock *MockToolCall) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockToolCall_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockToolCall_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockToolCall_Expecter) Name() *MockToolCall_Name_Call {
+	return &MockToolCall_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockToolCall_Name_Call) Run(run func()) *MockToolCall_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolCall_Name_Call) Return(s string) *MockToolCall_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockToolCall_Name_Call) RunAndReturn(run func() string) *MockToolCall_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithArguments provides a mock function for the type MockToolCall
+func (_mock *MockToolCall) WithArguments(arguments json.RawMessage) publictypes.ToolCall {
+	ret := _mock.Called(arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithArguments")
+	}
+
+	var r0 publictypes.ToolCall
+	if returnFunc, ok := ret.Get(0).(func(json.RawMessage) publictypes.ToolCall); ok {
+		r0 = returnFunc(arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(publictypes.ToolCall)
+		}
+	}
+	return r0
+}
+
+// MockToolCall_WithArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithArguments'
+type MockToolCall_WithArguments_Call struct {
+	*mock.Call
+}
+
+// WithArguments is a helper method to define mock.On call
+//   - arguments json.RawMessage
+func (_e *MockToolCall_Expecter) WithArguments(arguments interface{}) *MockToolCall_WithArguments_Call {
+	return &MockToolCall_WithArguments_Call{Call: _e.mock.On("WithArguments", arguments)}
+}
+
+func (_c *MockToolCall_WithArguments_Call) Run(run func(arguments json.RawMessage)) *MockToolCall_WithArguments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 json.RawMessage
+		if args[0] != nil {
+			arg0 = args[0].(json.RawMessage)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockToolCall_WithArguments_Call) Return(toolCall publictypes.ToolCall) *MockToolCall_WithArguments_Call {
+	_c.Call.Return(toolCall)
+	return _c
+}
+
+func (_c *MockToolCall_WithArguments_Call) RunAndReturn(run func(arguments json.RawMessage) publictypes.ToolCall) *MockToolCall_WithArguments_Call {
+	_c.Call.Return(run)
+	return _c
+}
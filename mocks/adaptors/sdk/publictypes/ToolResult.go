@@ -0,0 +1,126 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockToolResult creates a new instance of MockToolResult. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockToolResult(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockToolResult {
+	mock := &MockToolResult{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockToolResult is an autogenerated mock type for the ToolResult type
+type MockToolResult struct {
+	mock.Mock
+}
+
+type MockToolResult_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockToolResult) EXPECT() *MockToolResult_Expecter {
+	return &MockToolResult_Expecter{mock: &_m.Mock}
+}
+
+// IsError provides a mock function for the type MockToolResult
+func (_mock *MockToolResult) IsError() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsError")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockToolResult_IsError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsError'
+type MockToolResult_IsError_Call struct {
+	*mock.Call
+}
+
+// IsError is a helper method to define mock.On call
+func (_e *MockToolResult_Expecter) IsError() *MockToolResult_IsError_Call {
+	return &MockToolResult_IsError_Call{Call: _e.mock.On("IsError")}
+}
+
+func (_c *MockToolResult_IsError_Call) Run(run func()) *MockToolResult_IsError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolResult_IsError_Call) Return(b bool) *MockToolResult_IsError_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockToolResult_IsError_Call) RunAndReturn(run func() bool) *MockToolResult_IsError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TextContent provides a mock function for the type MockToolResult
+func (_mock *MockToolResult) TextContent() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TextContent")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockToolResult_TextContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TextContent'
+type MockToolResult_TextContent_Call struct {
+	*mock.Call
+}
+
+// TextContent is a helper method to define mock.On call
+func (_e *MockToolResult_Expecter) TextContent() *MockToolResult_TextContent_Call {
+	return &MockToolResult_TextContent_Call{Call: _e.mock.On("TextContent")}
+}
+
+func (_c *MockToolResult_TextContent_Call) Run(run func()) *MockToolResult_TextContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolResult_TextContent_Call) Return(strings []string) *MockToolResult_TextContent_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockToolResult_TextContent_Call) RunAndReturn(run func() []string) *MockToolResult_TextContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLifecycleHooksFactory creates a new instance of MockLifecycleHooksFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLifecycleHooksFactory[Dependencies any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLifecycleHooksFactory[Dependencies] {
+	mock := &MockLifecycleHooksFactory[Dependencies]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLifecycleHooksFactory is an autogenerated mock type for the LifecycleHooksFactory type
+type MockLifecycleHooksFactory[Dependencies any] struct {
+	mock.Mock
+}
+
+type MockLifecycleHooksFactory_Expecter[Dependencies any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLifecycleHooksFactory[Dependencies]) EXPECT() *MockLifecycleHooksFactory_Expecter[Dependencies] {
+	return &MockLifecycleHooksFactory_Expecter[Dependencies]{mock: &_m.Mock}
+}
+
+// New provides a mock function for the type MockLifecycleHooksFactory
+func (_mock *MockLifecycleHooksFactory[Dependencies]) New(hooks []publictypes.LifecycleHook[Dependencies]) []definition.LifecycleHook {
+	ret := _mock.Called(hooks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for New")
+	}
+
+	var r0 []definition.LifecycleHook
+	if returnFunc, ok := ret.Get(0).(func([]publictypes.LifecycleHook[Dependencies]) []definition.LifecycleHook); ok {
+		r0 = returnFunc(hooks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]definition.LifecycleHook)
+		}
+	}
+	return r0
+}
+
+// MockLifecycleHooksFactory_New_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'New'
+type MockLifecycleHooksFactory_New_Call[Dependencies any] struct {
+	*mock.Call
+}
+
+// New is a helper method to define mock.On call
+//   - hooks []publictypes.LifecycleHook[Dependencies]
+func (_e *MockLifecycleHooksFactory_Expecter[Dependencies]) New(hooks interface{}) *MockLifecycleHooksFactory_New_Call[Dependencies] {
+	return &MockLifecycleHooksFactory_New_Call[Dependencies]{Call: _e.mock.On("New", hooks)}
+}
+
+func (_c *MockLifecycleHooksFactory_New_Call[Dependencies]) Run(run func(hooks []publictypes.LifecycleHook[Dependencies])) *MockLifecycleHooksFactory_New_Call[Dependencies] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []publictypes.LifecycleHook[Dependencies]
+		if args[0] != nil {
+			arg0 = args[0].([]publictypes.LifecycleHook[Dependencies])
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLifecycleHooksFactory_New_Call[Dependencies]) Return(lifecycleHooks []definition.LifecycleHook) *MockLifecycleHooksFactory_New_Call[Dependencies] {
+	_c.Call.Return(lifecycleHooks)
+	return _c
+}
+
+func (_c *MockLifecycleHooksFactory_New_Call[Dependencies]) RunAndReturn(run func(hooks []publictypes.LifecycleHook[Dependencies]) []definition.LifecycleHook) *MockLifecycleHooksFactory_New_Call[Dependencies] {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/application/definition"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockToolMiddlewareFactory creates a new instance of MockToolMiddlewareFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockToolMiddlewareFactory[Dependencies any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockToolMiddlewareFactory[Dependencies] {
+	mock := &MockToolMiddlewareFactory[Dependencies]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockToolMiddlewareFactory is an autogenerated mock type for the ToolMiddlewareFactory type
+type MockToolMiddlewareFactory[Dependencies any] struct {
+	mock.Mock
+}
+
+type MockToolMiddlewareFactory_Expecter[Dependencies any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockToolMiddlewareFactory[Dependencies]) EXPECT() *MockToolMiddlewareFactory_Expecter[Dependencies] {
+	return &MockToolMiddlewareFactory_Expecter[Dependencies]{mock: &_m.Mock}
+}
+
+// New provides a mock function for the type MockToolMiddlewareFactory
+func (_mock *MockToolMiddlewareFactory[Dependencies]) New(provider publictypes.ToolMiddlewareProvider[Dependencies]) definition.ToolMiddlewareProvider {
+	ret := _mock.Called(provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for New")
+	}
+
+	var r0 definition.ToolMiddlewareProvider
+	if returnFunc, ok := ret.Get(0).(func(publictypes.ToolMiddlewareProvider[Dependencies]) definition.ToolMiddlewareProvider); ok {
+		r0 = returnFunc(provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(definition.ToolMiddlewareProvider)
+		}
+	}
+	return r0
+}
+
+// MockToolMiddlewareFactory_New_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'New'
+type MockToolMiddlewareFactory_New_Call[Dependencies any] struct {
+	*mock.Call
+}
+
+// New is a helper method to define mock.On call
+//   - provider publictypes.ToolMiddlewareProvider[Dependencies]
+func (_e *MockToolMiddlewareFactory_Expecter[Dependencies]) New(provider interface{}) *MockToolMiddlewareFactory_New_Call[Dependencies] {
+	return &MockToolMiddlewareFactory_New_Call[Dependencies]{Call: _e.mock.On("New", provider)}
+}
+
+func (_c *MockToolMiddlewareFactory_New_Call[Dependencies]) Run(run func(provider publictypes.ToolMiddlewareProvider[Dependencies])) *MockToolMiddlewareFactory_New_Call[Dependencies] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 publictypes.ToolMiddlewareProvider[Dependencies]
+		if args[0] != nil {
+			arg0 = args[0].(publictypes.ToolMiddlewareProvider[Dependencies])
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockToolMiddlewareFactory_New_Call[Dependencies]) Return(toolMiddlewareProvider definition.ToolMiddlewareProvider) *MockToolMiddlewareFactory_New_Call[Dependencies] {
+	_c.Call.Return(toolMiddlewareProvider)
+	return _c
+}
+
+func (_c *MockToolMiddlewareFactory_New_Call[Dependencies]) RunAndReturn(run func(provider publictypes.ToolMiddlewareProvider[Dependencies]) definition.ToolMiddlewareProvider) *MockToolMiddlewareFactory_New_Call[Dependencies] {
+	_c.Call.Return(run)
+	return _c
+}
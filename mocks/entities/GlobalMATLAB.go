@@ -105,3 +105,56 @@ func (_c *MockGlobalMATLAB_Client_Call) RunAndReturn(run func(ctx context.Contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// SessionID provides a mock function for the type MockGlobalMATLAB
+func (_mock *MockGlobalMATLAB) SessionID() (entities.SessionID, bool) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionID")
+	}
+
+	var r0 entities.SessionID
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func() (entities.SessionID, bool)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() entities.SessionID); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.SessionID)
+	}
+	if returnFunc, ok := ret.Get(1).(func() bool); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockGlobalMATLAB_SessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionID'
+type MockGlobalMATLAB_SessionID_Call struct {
+	*mock.Call
+}
+
+// SessionID is a helper method to define mock.On call
+func (_e *MockGlobalMATLAB_Expecter) SessionID() *MockGlobalMATLAB_SessionID_Call {
+	return &MockGlobalMATLAB_SessionID_Call{Call: _e.mock.On("SessionID")}
+}
+
+func (_c *MockGlobalMATLAB_SessionID_Call) Run(run func()) *MockGlobalMATLAB_SessionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_SessionID_Call) Return(sessionID entities.SessionID, ok bool) *MockGlobalMATLAB_SessionID_Call {
+	_c.Call.Return(sessionID, ok)
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_SessionID_Call) RunAndReturn(run func() (entities.SessionID, bool)) *MockGlobalMATLAB_SessionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
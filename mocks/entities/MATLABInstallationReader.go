@@ -0,0 +1,103 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABInstallationReader creates a new instance of MockMATLABInstallationReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABInstallationReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABInstallationReader {
+	mock := &MockMATLABInstallationReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABInstallationReader is an autogenerated mock type for the MATLABInstallationReader type
+type MockMATLABInstallationReader struct {
+	mock.Mock
+}
+
+type MockMATLABInstallationReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABInstallationReader) EXPECT() *MockMATLABInstallationReader_Expecter {
+	return &MockMATLABInstallationReader_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function for the type MockMATLABInstallationReader
+func (_mock *MockMATLABInstallationReader) Read(logger entities.Logger, matlabRoot string) (entities.MATLABInstallation, error) {
+	ret := _mock.Called(logger, matlabRoot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 entities.MATLABInstallation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string) (entities.MATLABInstallation, error)); ok {
+		return returnFunc(logger, matlabRoot)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string) entities.MATLABInstallation); ok {
+		r0 = returnFunc(logger, matlabRoot)
+	} else {
+		r0 = ret.Get(0).(entities.MATLABInstallation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string) error); ok {
+		r1 = returnFunc(logger, matlabRoot)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABInstallationReader_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockMATLABInstallationReader_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - matlabRoot string
+func (_e *MockMATLABInstallationReader_Expecter) Read(logger interface{}, matlabRoot interface{}) *MockMATLABInstallationReader_Read_Call {
+	return &MockMATLABInstallationReader_Read_Call{Call: _e.mock.On("Read", logger, matlabRoot)}
+}
+
+func (_c *MockMATLABInstallationReader_Read_Call) Run(run func(logger entities.Logger, matlabRoot string)) *MockMATLABInstallationReader_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABInstallationReader_Read_Call) Return(mATLABInstallation entities.MATLABInstallation, err error) *MockMATLABInstallationReader_Read_Call {
+	_c.Call.Return(mATLABInstallation, err)
+	return _c
+}
+
+func (_c *MockMATLABInstallationReader_Read_Call) RunAndReturn(run func(logger entities.Logger, matlabRoot string) (entities.MATLABInstallation, error)) *MockMATLABInstallationReader_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
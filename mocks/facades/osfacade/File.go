@@ -229,6 +229,72 @@ func (_c *MockFile_Read_Call) RunAndReturn(run func(b []byte) (int, error)) *Moc
 	return _c
 }
 
+// ReadAt provides a mock function for the type MockFile
+func (_mock *MockFile) ReadAt(b []byte, off int64) (int, error) {
+	ret := _mock.Called(b, off)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAt")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, int64) (int, error)); ok {
+		return returnFunc(b, off)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, int64) int); ok {
+		r0 = returnFunc(b, off)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, int64) error); ok {
+		r1 = returnFunc(b, off)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFile_ReadAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAt'
+type MockFile_ReadAt_Call struct {
+	*mock.Call
+}
+
+// ReadAt is a helper method to define mock.On call
+//   - b []byte
+//   - off int64
+func (_e *MockFile_Expecter) ReadAt(b interface{}, off interface{}) *MockFile_ReadAt_Call {
+	return &MockFile_ReadAt_Call{Call: _e.mock.On("ReadAt", b, off)}
+}
+
+func (_c *MockFile_ReadAt_Call) Run(run func(b []byte, off int64)) *MockFile_ReadAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFile_ReadAt_Call) Return(n int, err error) *MockFile_ReadAt_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockFile_ReadAt_Call) RunAndReturn(run func(b []byte, off int64) (int, error)) *MockFile_ReadAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unwrap provides a mock function for the type MockFile
 func (_mock *MockFile) Unwrap() *os.File {
 	ret := _mock.Called()
@@ -0,0 +1,278 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/analyzematlabdependencies"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockDependencyAnalyzer creates a new instance of MockDependencyAnalyzer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDependencyAnalyzer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDependencyAnalyzer {
+	mock := &MockDependencyAnalyzer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDependencyAnalyzer is an autogenerated mock type for the DependencyAnalyzer type
+type MockDependencyAnalyzer struct {
+	mock.Mock
+}
+
+type MockDependencyAnalyzer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDependencyAnalyzer) EXPECT() *MockDependencyAnalyzer_Expecter {
+	return &MockDependencyAnalyzer_Expecter{mock: &_m.Mock}
+}
+
+// FunctionReferences provides a mock function for the type MockDependencyAnalyzer
+func (_mock *MockDependencyAnalyzer) FunctionReferences(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) ([]analyzematlabdependencies.FileReferences, error) {
+	ret := _mock.Called(ctx, logger, client, files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FunctionReferences")
+	}
+
+	var r0 []analyzematlabdependencies.FileReferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) ([]analyzematlabdependencies.FileReferences, error)); ok {
+		return returnFunc(ctx, logger, client, files)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) []analyzematlabdependencies.FileReferences); ok {
+		r0 = returnFunc(ctx, logger, client, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]analyzematlabdependencies.FileReferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) error); ok {
+		r1 = returnFunc(ctx, logger, client, files)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDependencyAnalyzer_FunctionReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FunctionReferences'
+type MockDependencyAnalyzer_FunctionReferences_Call struct {
+	*mock.Call
+}
+
+// FunctionReferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - files []string
+func (_e *MockDependencyAnalyzer_Expecter) FunctionReferences(ctx interface{}, logger interface{}, client interface{}, files interface{}) *MockDependencyAnalyzer_FunctionReferences_Call {
+	return &MockDependencyAnalyzer_FunctionReferences_Call{Call: _e.mock.On("FunctionReferences", ctx, logger, client, files)}
+}
+
+func (_c *MockDependencyAnalyzer_FunctionReferences_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string)) *MockDependencyAnalyzer_FunctionReferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_FunctionReferences_Call) Return(fileReferencess []analyzematlabdependencies.FileReferences, err error) *MockDependencyAnalyzer_FunctionReferences_Call {
+	_c.Call.Return(fileReferencess, err)
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_FunctionReferences_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) ([]analyzematlabdependencies.FileReferences, error)) *MockDependencyAnalyzer_FunctionReferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequiredFilesAndProducts provides a mock function for the type MockDependencyAnalyzer
+func (_mock *MockDependencyAnalyzer) RequiredFilesAndProducts(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) (analyzematlabdependencies.Requirements, error) {
+	ret := _mock.Called(ctx, logger, client, files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequiredFilesAndProducts")
+	}
+
+	var r0 analyzematlabdependencies.Requirements
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) (analyzematlabdependencies.Requirements, error)); ok {
+		return returnFunc(ctx, logger, client, files)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) analyzematlabdependencies.Requirements); ok {
+		r0 = returnFunc(ctx, logger, client, files)
+	} else {
+		r0 = ret.Get(0).(analyzematlabdependencies.Requirements)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) error); ok {
+		r1 = returnFunc(ctx, logger, client, files)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDependencyAnalyzer_RequiredFilesAndProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequiredFilesAndProducts'
+type MockDependencyAnalyzer_RequiredFilesAndProducts_Call struct {
+	*mock.Call
+}
+
+// RequiredFilesAndProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - files []string
+func (_e *MockDependencyAnalyzer_Expecter) RequiredFilesAndProducts(ctx interface{}, logger interface{}, client interface{}, files interface{}) *MockDependencyAnalyzer_RequiredFilesAndProducts_Call {
+	return &MockDependencyAnalyzer_RequiredFilesAndProducts_Call{Call: _e.mock.On("RequiredFilesAndProducts", ctx, logger, client, files)}
+}
+
+func (_c *MockDependencyAnalyzer_RequiredFilesAndProducts_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string)) *MockDependencyAnalyzer_RequiredFilesAndProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_RequiredFilesAndProducts_Call) Return(requirements analyzematlabdependencies.Requirements, err error) *MockDependencyAnalyzer_RequiredFilesAndProducts_Call {
+	_c.Call.Return(requirements, err)
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_RequiredFilesAndProducts_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, files []string) (analyzematlabdependencies.Requirements, error)) *MockDependencyAnalyzer_RequiredFilesAndProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndefinedFunctions provides a mock function for the type MockDependencyAnalyzer
+func (_mock *MockDependencyAnalyzer) UndefinedFunctions(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, names []string) ([]string, error) {
+	ret := _mock.Called(ctx, logger, client, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndefinedFunctions")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) ([]string, error)); ok {
+		return returnFunc(ctx, logger, client, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) []string); ok {
+		r0 = returnFunc(ctx, logger, client, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, []string) error); ok {
+		r1 = returnFunc(ctx, logger, client, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDependencyAnalyzer_UndefinedFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndefinedFunctions'
+type MockDependencyAnalyzer_UndefinedFunctions_Call struct {
+	*mock.Call
+}
+
+// UndefinedFunctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - names []string
+func (_e *MockDependencyAnalyzer_Expecter) UndefinedFunctions(ctx interface{}, logger interface{}, client interface{}, names interface{}) *MockDependencyAnalyzer_UndefinedFunctions_Call {
+	return &MockDependencyAnalyzer_UndefinedFunctions_Call{Call: _e.mock.On("UndefinedFunctions", ctx, logger, client, names)}
+}
+
+func (_c *MockDependencyAnalyzer_UndefinedFunctions_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, names []string)) *MockDependencyAnalyzer_UndefinedFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_UndefinedFunctions_Call) Return(strings []string, err error) *MockDependencyAnalyzer_UndefinedFunctions_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockDependencyAnalyzer_UndefinedFunctions_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, names []string) ([]string, error)) *MockDependencyAnalyzer_UndefinedFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
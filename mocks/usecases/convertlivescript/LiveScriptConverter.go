@@ -0,0 +1,114 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockLiveScriptConverter creates a new instance of MockLiveScriptConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLiveScriptConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLiveScriptConverter {
+	mock := &MockLiveScriptConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLiveScriptConverter is an autogenerated mock type for the LiveScriptConverter type
+type MockLiveScriptConverter struct {
+	mock.Mock
+}
+
+type MockLiveScriptConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLiveScriptConverter) EXPECT() *MockLiveScriptConverter_Expecter {
+	return &MockLiveScriptConverter_Expecter{mock: &_m.Mock}
+}
+
+// Convert provides a mock function for the type MockLiveScriptConverter
+func (_mock *MockLiveScriptConverter) Convert(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string, outputPath string) error {
+	ret := _mock.Called(ctx, logger, client, liveScriptPath, outputPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Convert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string, string) error); ok {
+		r0 = returnFunc(ctx, logger, client, liveScriptPath, outputPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLiveScriptConverter_Convert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Convert'
+type MockLiveScriptConverter_Convert_Call struct {
+	*mock.Call
+}
+
+// Convert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - liveScriptPath string
+//   - outputPath string
+func (_e *MockLiveScriptConverter_Expecter) Convert(ctx interface{}, logger interface{}, client interface{}, liveScriptPath interface{}, outputPath interface{}) *MockLiveScriptConverter_Convert_Call {
+	return &MockLiveScriptConverter_Convert_Call{Call: _e.mock.On("Convert", ctx, logger, client, liveScriptPath, outputPath)}
+}
+
+func (_c *MockLiveScriptConverter_Convert_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string, outputPath string)) *MockLiveScriptConverter_Convert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveScriptConverter_Convert_Call) Return(err error) *MockLiveScriptConverter_Convert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLiveScriptConverter_Convert_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, liveScriptPath string, outputPath string) error) *MockLiveScriptConverter_Convert_Call {
+	_c.Call.Return(run)
+	return _c
+}
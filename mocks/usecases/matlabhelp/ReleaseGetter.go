@@ -0,0 +1,117 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockReleaseGetter creates a new instance of MockReleaseGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReleaseGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReleaseGetter {
+	mock := &MockReleaseGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReleaseGetter is an autogenerated mock type for the ReleaseGetter type
+type MockReleaseGetter struct {
+	mock.Mock
+}
+
+type MockReleaseGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReleaseGetter) EXPECT() *MockReleaseGetter_Expecter {
+	return &MockReleaseGetter_Expecter{mock: &_m.Mock}
+}
+
+// Release provides a mock function for the type MockReleaseGetter
+func (_mock *MockReleaseGetter) Release(ctx context.Context, logger entities.Logger, sessionID entities.SessionID, client entities.MATLABSessionClient) (string, error) {
+	ret := _mock.Called(ctx, logger, sessionID, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID, entities.MATLABSessionClient) (string, error)); ok {
+		return returnFunc(ctx, logger, sessionID, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID, entities.MATLABSessionClient) string); ok {
+		r0 = returnFunc(ctx, logger, sessionID, client)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.SessionID, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, sessionID, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReleaseGetter_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockReleaseGetter_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - sessionID entities.SessionID
+//   - client entities.MATLABSessionClient
+func (_e *MockReleaseGetter_Expecter) Release(ctx interface{}, logger interface{}, sessionID interface{}, client interface{}) *MockReleaseGetter_Release_Call {
+	return &MockReleaseGetter_Release_Call{Call: _e.mock.On("Release", ctx, logger, sessionID, client)}
+}
+
+func (_c *MockReleaseGetter_Release_Call) Run(run func(ctx context.Context, logger entities.Logger, sessionID entities.SessionID, client entities.MATLABSessionClient)) *MockReleaseGetter_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		var arg3 entities.MATLABSessionClient
+		if args[3] != nil {
+			arg3 = args[3].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReleaseGetter_Release_Call) Return(s string, err error) *MockReleaseGetter_Release_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockReleaseGetter_Release_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionID entities.SessionID, client entities.MATLABSessionClient) (string, error)) *MockReleaseGetter_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
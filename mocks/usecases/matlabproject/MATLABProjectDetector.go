@@ -0,0 +1,88 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABProjectDetector creates a new instance of MockMATLABProjectDetector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABProjectDetector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABProjectDetector {
+	mock := &MockMATLABProjectDetector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABProjectDetector is an autogenerated mock type for the MATLABProjectDetector type
+type MockMATLABProjectDetector struct {
+	mock.Mock
+}
+
+type MockMATLABProjectDetector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABProjectDetector) EXPECT() *MockMATLABProjectDetector_Expecter {
+	return &MockMATLABProjectDetector_Expecter{mock: &_m.Mock}
+}
+
+// DetectMATLABProject provides a mock function for the type MockMATLABProjectDetector
+func (_mock *MockMATLABProjectDetector) DetectMATLABProject(logger entities.Logger) string {
+	ret := _mock.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetectMATLABProject")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) string); ok {
+		r0 = returnFunc(logger)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockMATLABProjectDetector_DetectMATLABProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetectMATLABProject'
+type MockMATLABProjectDetector_DetectMATLABProject_Call struct {
+	*mock.Call
+}
+
+// DetectMATLABProject is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockMATLABProjectDetector_Expecter) DetectMATLABProject(logger interface{}) *MockMATLABProjectDetector_DetectMATLABProject_Call {
+	return &MockMATLABProjectDetector_DetectMATLABProject_Call{Call: _e.mock.On("DetectMATLABProject", logger)}
+}
+
+func (_c *MockMATLABProjectDetector_DetectMATLABProject_Call) Run(run func(logger entities.Logger)) *MockMATLABProjectDetector_DetectMATLABProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABProjectDetector_DetectMATLABProject_Call) Return(s string) *MockMATLABProjectDetector_DetectMATLABProject_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockMATLABProjectDetector_DetectMATLABProject_Call) RunAndReturn(run func(logger entities.Logger) string) *MockMATLABProjectDetector_DetectMATLABProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
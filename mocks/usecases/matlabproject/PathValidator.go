@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPathValidator creates a new instance of MockPathValidator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPathValidator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPathValidator {
+	mock := &MockPathValidator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPathValidator is an autogenerated mock type for the PathValidator type
+type MockPathValidator struct {
+	mock.Mock
+}
+
+type MockPathValidator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPathValidator) EXPECT() *MockPathValidator_Expecter {
+	return &MockPathValidator_Expecter{mock: &_m.Mock}
+}
+
+// ValidateMATLABProject provides a mock function for the type MockPathValidator
+func (_mock *MockPathValidator) ValidateMATLABProject(projectPath string) (string, error) {
+	ret := _mock.Called(projectPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateMATLABProject")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(projectPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(projectPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(projectPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPathValidator_ValidateMATLABProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateMATLABProject'
+type MockPathValidator_ValidateMATLABProject_Call struct {
+	*mock.Call
+}
+
+// ValidateMATLABProject is a helper method to define mock.On call
+//   - projectPath string
+func (_e *MockPathValidator_Expecter) ValidateMATLABProject(projectPath interface{}) *MockPathValidator_ValidateMATLABProject_Call {
+	return &MockPathValidator_ValidateMATLABProject_Call{Call: _e.mock.On("ValidateMATLABProject", projectPath)}
+}
+
+func (_c *MockPathValidator_ValidateMATLABProject_Call) Run(run func(projectPath string)) *MockPathValidator_ValidateMATLABProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPathValidator_ValidateMATLABProject_Call) Return(s string, err error) *MockPathValidator_ValidateMATLABProject_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockPathValidator_ValidateMATLABProject_Call) RunAndReturn(run func(projectPath string) (string, error)) *MockPathValidator_ValidateMATLABProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,338 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProjectManager creates a new instance of MockProjectManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectManager {
+	mock := &MockProjectManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectManager is an autogenerated mock type for the ProjectManager type
+type MockProjectManager struct {
+	mock.Mock
+}
+
+type MockProjectManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectManager) EXPECT() *MockProjectManager_Expecter {
+	return &MockProjectManager_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockProjectManager
+func (_mock *MockProjectManager) Close(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (matlabproject.Project, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 matlabproject.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) (matlabproject.Project, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) matlabproject.Project); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Get(0).(matlabproject.Project)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectManager_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockProjectManager_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockProjectManager_Expecter) Close(ctx interface{}, logger interface{}, client interface{}) *MockProjectManager_Close_Call {
+	return &MockProjectManager_Close_Call{Call: _e.mock.On("Close", ctx, logger, client)}
+}
+
+func (_c *MockProjectManager_Close_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockProjectManager_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectManager_Close_Call) Return(project matlabproject.Project, err error) *MockProjectManager_Close_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectManager_Close_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (matlabproject.Project, error)) *MockProjectManager_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFiles provides a mock function for the type MockProjectManager
+func (_mock *MockProjectManager) ListFiles(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.File, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFiles")
+	}
+
+	var r0 []matlabproject.File
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) ([]matlabproject.File, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) []matlabproject.File); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]matlabproject.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectManager_ListFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFiles'
+type MockProjectManager_ListFiles_Call struct {
+	*mock.Call
+}
+
+// ListFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockProjectManager_Expecter) ListFiles(ctx interface{}, logger interface{}, client interface{}) *MockProjectManager_ListFiles_Call {
+	return &MockProjectManager_ListFiles_Call{Call: _e.mock.On("ListFiles", ctx, logger, client)}
+}
+
+func (_c *MockProjectManager_ListFiles_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockProjectManager_ListFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectManager_ListFiles_Call) Return(files []matlabproject.File, err error) *MockProjectManager_ListFiles_Call {
+	_c.Call.Return(files, err)
+	return _c
+}
+
+func (_c *MockProjectManager_ListFiles_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.File, error)) *MockProjectManager_ListFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function for the type MockProjectManager
+func (_mock *MockProjectManager) Open(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error) {
+	ret := _mock.Called(ctx, logger, client, projectPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 matlabproject.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) (matlabproject.Project, error)); ok {
+		return returnFunc(ctx, logger, client, projectPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) matlabproject.Project); ok {
+		r0 = returnFunc(ctx, logger, client, projectPath)
+	} else {
+		r0 = ret.Get(0).(matlabproject.Project)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) error); ok {
+		r1 = returnFunc(ctx, logger, client, projectPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectManager_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockProjectManager_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - projectPath string
+func (_e *MockProjectManager_Expecter) Open(ctx interface{}, logger interface{}, client interface{}, projectPath interface{}) *MockProjectManager_Open_Call {
+	return &MockProjectManager_Open_Call{Call: _e.mock.On("Open", ctx, logger, client, projectPath)}
+}
+
+func (_c *MockProjectManager_Open_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, projectPath string)) *MockProjectManager_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectManager_Open_Call) Return(project matlabproject.Project, err error) *MockProjectManager_Open_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectManager_Open_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, projectPath string) (matlabproject.Project, error)) *MockProjectManager_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunChecks provides a mock function for the type MockProjectManager
+func (_mock *MockProjectManager) RunChecks(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.CheckResult, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunChecks")
+	}
+
+	var r0 []matlabproject.CheckResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) ([]matlabproject.CheckResult, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) []matlabproject.CheckResult); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]matlabproject.CheckResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectManager_RunChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunChecks'
+type MockProjectManager_RunChecks_Call struct {
+	*mock.Call
+}
+
+// RunChecks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockProjectManager_Expecter) RunChecks(ctx interface{}, logger interface{}, client interface{}) *MockProjectManager_RunChecks_Call {
+	return &MockProjectManager_RunChecks_Call{Call: _e.mock.On("RunChecks", ctx, logger, client)}
+}
+
+func (_c *MockProjectManager_RunChecks_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockProjectManager_RunChecks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectManager_RunChecks_Call) Return(checkResults []matlabproject.CheckResult, err error) *MockProjectManager_RunChecks_Call {
+	_c.Call.Return(checkResults, err)
+	return _c
+}
+
+func (_c *MockProjectManager_RunChecks_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]matlabproject.CheckResult, error)) *MockProjectManager_RunChecks_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionStatusReader creates a new instance of MockSessionStatusReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionStatusReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionStatusReader {
+	mock := &MockSessionStatusReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionStatusReader is an autogenerated mock type for the SessionStatusReader type
+type MockSessionStatusReader struct {
+	mock.Mock
+}
+
+type MockSessionStatusReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionStatusReader) EXPECT() *MockSessionStatusReader_Expecter {
+	return &MockSessionStatusReader_Expecter{mock: &_m.Mock}
+}
+
+// Statuses provides a mock function for the type MockSessionStatusReader
+func (_mock *MockSessionStatusReader) Statuses() []entities.MATLABSessionStatus {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statuses")
+	}
+
+	var r0 []entities.MATLABSessionStatus
+	if returnFunc, ok := ret.Get(0).(func() []entities.MATLABSessionStatus); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.MATLABSessionStatus)
+		}
+	}
+	return r0
+}
+
+// MockSessionStatusReader_Statuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statuses'
+type MockSessionStatusReader_Statuses_Call struct {
+	*mock.Call
+}
+
+// Statuses is a helper method to define mock.On call
+func (_e *MockSessionStatusReader_Expecter) Statuses() *MockSessionStatusReader_Statuses_Call {
+	return &MockSessionStatusReader_Statuses_Call{Call: _e.mock.On("Statuses")}
+}
+
+func (_c *MockSessionStatusReader_Statuses_Call) Run(run func()) *MockSessionStatusReader_Statuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionStatusReader_Statuses_Call) Return(mATLABSessionStatuss []entities.MATLABSessionStatus) *MockSessionStatusReader_Statuses_Call {
+	_c.Call.Return(mATLABSessionStatuss)
+	return _c
+}
+
+func (_c *MockSessionStatusReader_Statuses_Call) RunAndReturn(run func() []entities.MATLABSessionStatus) *MockSessionStatusReader_Statuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
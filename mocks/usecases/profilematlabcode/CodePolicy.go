@@ -0,0 +1,138 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCodePolicy creates a new instance of MockCodePolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCodePolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCodePolicy {
+	mock := &MockCodePolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCodePolicy is an autogenerated mock type for the CodePolicy type
+type MockCodePolicy struct {
+	mock.Mock
+}
+
+type MockCodePolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCodePolicy) EXPECT() *MockCodePolicy_Expecter {
+	return &MockCodePolicy_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockCodePolicy
+func (_mock *MockCodePolicy) Check(code string) error {
+	ret := _mock.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCodePolicy_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockCodePolicy_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - code string
+func (_e *MockCodePolicy_Expecter) Check(code interface{}) *MockCodePolicy_Check_Call {
+	return &MockCodePolicy_Check_Call{Call: _e.mock.On("Check", code)}
+}
+
+func (_c *MockCodePolicy_Check_Call) Run(run func(code string)) *MockCodePolicy_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCodePolicy_Check_Call) Return(err error) *MockCodePolicy_Check_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCodePolicy_Check_Call) RunAndReturn(run func(code string) error) *MockCodePolicy_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckFile provides a mock function for the type MockCodePolicy
+func (_mock *MockCodePolicy) CheckFile(filePath string) error {
+	ret := _mock.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckFile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(filePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCodePolicy_CheckFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckFile'
+type MockCodePolicy_CheckFile_Call struct {
+	*mock.Call
+}
+
+// CheckFile is a helper method to define mock.On call
+//   - filePath string
+func (_e *MockCodePolicy_Expecter) CheckFile(filePath interface{}) *MockCodePolicy_CheckFile_Call {
+	return &MockCodePolicy_CheckFile_Call{Call: _e.mock.On("CheckFile", filePath)}
+}
+
+func (_c *MockCodePolicy_CheckFile_Call) Run(run func(filePath string)) *MockCodePolicy_CheckFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCodePolicy_CheckFile_Call) Return(err error) *MockCodePolicy_CheckFile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCodePolicy_CheckFile_Call) RunAndReturn(run func(filePath string) error) *MockCodePolicy_CheckFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
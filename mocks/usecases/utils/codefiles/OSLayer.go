@@ -0,0 +1,100 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"os"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOSLayer creates a new instance of MockOSLayer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOSLayer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOSLayer {
+	mock := &MockOSLayer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOSLayer is an autogenerated mock type for the OSLayer type
+type MockOSLayer struct {
+	mock.Mock
+}
+
+type MockOSLayer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOSLayer) EXPECT() *MockOSLayer_Expecter {
+	return &MockOSLayer_Expecter{mock: &_m.Mock}
+}
+
+// ReadDir provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) ReadDir(name string) ([]os.DirEntry, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDir")
+	}
+
+	var r0 []os.DirEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]os.DirEntry, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []os.DirEntry); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]os.DirEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_ReadDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDir'
+type MockOSLayer_ReadDir_Call struct {
+	*mock.Call
+}
+
+// ReadDir is a helper method to define mock.On call
+//   - name string
+func (_e *MockOSLayer_Expecter) ReadDir(name interface{}) *MockOSLayer_ReadDir_Call {
+	return &MockOSLayer_ReadDir_Call{Call: _e.mock.On("ReadDir", name)}
+}
+
+func (_c *MockOSLayer_ReadDir_Call) Run(run func(name string)) *MockOSLayer_ReadDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_ReadDir_Call) Return(vs []os.DirEntry, err error) *MockOSLayer_ReadDir_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockOSLayer_ReadDir_Call) RunAndReturn(run func(name string) ([]os.DirEntry, error)) *MockOSLayer_ReadDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,495 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSnapshotStore creates a new instance of MockSnapshotStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSnapshotStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSnapshotStore {
+	mock := &MockSnapshotStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSnapshotStore is an autogenerated mock type for the SnapshotStore type
+type MockSnapshotStore struct {
+	mock.Mock
+}
+
+type MockSnapshotStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSnapshotStore) EXPECT() *MockSnapshotStore_Expecter {
+	return &MockSnapshotStore_Expecter{mock: &_m.Mock}
+}
+
+// CheckSize provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) CheckSize(sizeBytes int64) error {
+	ret := _mock.Called(sizeBytes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSize")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(sizeBytes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSnapshotStore_CheckSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSize'
+type MockSnapshotStore_CheckSize_Call struct {
+	*mock.Call
+}
+
+// CheckSize is a helper method to define mock.On call
+//   - sizeBytes int64
+func (_e *MockSnapshotStore_Expecter) CheckSize(sizeBytes interface{}) *MockSnapshotStore_CheckSize_Call {
+	return &MockSnapshotStore_CheckSize_Call{Call: _e.mock.On("CheckSize", sizeBytes)}
+}
+
+func (_c *MockSnapshotStore_CheckSize_Call) Run(run func(sizeBytes int64)) *MockSnapshotStore_CheckSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_CheckSize_Call) Return(err error) *MockSnapshotStore_CheckSize_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_CheckSize_Call) RunAndReturn(run func(sizeBytes int64) error) *MockSnapshotStore_CheckSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) Commit(sessionFolder string, name string, variables []string) (workspacesnapshot.Snapshot, error) {
+	ret := _mock.Called(sessionFolder, name, variables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 workspacesnapshot.Snapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, []string) (workspacesnapshot.Snapshot, error)); ok {
+		return returnFunc(sessionFolder, name, variables)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, []string) workspacesnapshot.Snapshot); ok {
+		r0 = returnFunc(sessionFolder, name, variables)
+	} else {
+		r0 = ret.Get(0).(workspacesnapshot.Snapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = returnFunc(sessionFolder, name, variables)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnapshotStore_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockSnapshotStore_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - sessionFolder string
+//   - name string
+//   - variables []string
+func (_e *MockSnapshotStore_Expecter) Commit(sessionFolder interface{}, name interface{}, variables interface{}) *MockSnapshotStore_Commit_Call {
+	return &MockSnapshotStore_Commit_Call{Call: _e.mock.On("Commit", sessionFolder, name, variables)}
+}
+
+func (_c *MockSnapshotStore_Commit_Call) Run(run func(sessionFolder string, name string, variables []string)) *MockSnapshotStore_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_Commit_Call) Return(snapshot workspacesnapshot.Snapshot, err error) *MockSnapshotStore_Commit_Call {
+	_c.Call.Return(snapshot, err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_Commit_Call) RunAndReturn(run func(sessionFolder string, name string, variables []string) (workspacesnapshot.Snapshot, error)) *MockSnapshotStore_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) Delete(sessionFolder string, name string) (workspacesnapshot.Snapshot, error) {
+	ret := _mock.Called(sessionFolder, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 workspacesnapshot.Snapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (workspacesnapshot.Snapshot, error)); ok {
+		return returnFunc(sessionFolder, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) workspacesnapshot.Snapshot); ok {
+		r0 = returnFunc(sessionFolder, name)
+	} else {
+		r0 = ret.Get(0).(workspacesnapshot.Snapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(sessionFolder, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnapshotStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSnapshotStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - sessionFolder string
+//   - name string
+func (_e *MockSnapshotStore_Expecter) Delete(sessionFolder interface{}, name interface{}) *MockSnapshotStore_Delete_Call {
+	return &MockSnapshotStore_Delete_Call{Call: _e.mock.On("Delete", sessionFolder, name)}
+}
+
+func (_c *MockSnapshotStore_Delete_Call) Run(run func(sessionFolder string, name string)) *MockSnapshotStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_Delete_Call) Return(snapshot workspacesnapshot.Snapshot, err error) *MockSnapshotStore_Delete_Call {
+	_c.Call.Return(snapshot, err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_Delete_Call) RunAndReturn(run func(sessionFolder string, name string) (workspacesnapshot.Snapshot, error)) *MockSnapshotStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Discard provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) Discard(sessionFolder string, name string) error {
+	ret := _mock.Called(sessionFolder, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Discard")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(sessionFolder, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSnapshotStore_Discard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Discard'
+type MockSnapshotStore_Discard_Call struct {
+	*mock.Call
+}
+
+// Discard is a helper method to define mock.On call
+//   - sessionFolder string
+//   - name string
+func (_e *MockSnapshotStore_Expecter) Discard(sessionFolder interface{}, name interface{}) *MockSnapshotStore_Discard_Call {
+	return &MockSnapshotStore_Discard_Call{Call: _e.mock.On("Discard", sessionFolder, name)}
+}
+
+func (_c *MockSnapshotStore_Discard_Call) Run(run func(sessionFolder string, name string)) *MockSnapshotStore_Discard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_Discard_Call) Return(err error) *MockSnapshotStore_Discard_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_Discard_Call) RunAndReturn(run func(sessionFolder string, name string) error) *MockSnapshotStore_Discard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) Get(sessionFolder string, name string) (workspacesnapshot.Snapshot, string, error) {
+	ret := _mock.Called(sessionFolder, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 workspacesnapshot.Snapshot
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (workspacesnapshot.Snapshot, string, error)); ok {
+		return returnFunc(sessionFolder, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) workspacesnapshot.Snapshot); ok {
+		r0 = returnFunc(sessionFolder, name)
+	} else {
+		r0 = ret.Get(0).(workspacesnapshot.Snapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) string); ok {
+		r1 = returnFunc(sessionFolder, name)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string) error); ok {
+		r2 = returnFunc(sessionFolder, name)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSnapshotStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSnapshotStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - sessionFolder string
+//   - name string
+func (_e *MockSnapshotStore_Expecter) Get(sessionFolder interface{}, name interface{}) *MockSnapshotStore_Get_Call {
+	return &MockSnapshotStore_Get_Call{Call: _e.mock.On("Get", sessionFolder, name)}
+}
+
+func (_c *MockSnapshotStore_Get_Call) Run(run func(sessionFolder string, name string)) *MockSnapshotStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_Get_Call) Return(snapshot workspacesnapshot.Snapshot, s string, err error) *MockSnapshotStore_Get_Call {
+	_c.Call.Return(snapshot, s, err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_Get_Call) RunAndReturn(run func(sessionFolder string, name string) (workspacesnapshot.Snapshot, string, error)) *MockSnapshotStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) List(sessionFolder string) ([]workspacesnapshot.Snapshot, error) {
+	ret := _mock.Called(sessionFolder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []workspacesnapshot.Snapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]workspacesnapshot.Snapshot, error)); ok {
+		return returnFunc(sessionFolder)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []workspacesnapshot.Snapshot); ok {
+		r0 = returnFunc(sessionFolder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]workspacesnapshot.Snapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(sessionFolder)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnapshotStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockSnapshotStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - sessionFolder string
+func (_e *MockSnapshotStore_Expecter) List(sessionFolder interface{}) *MockSnapshotStore_List_Call {
+	return &MockSnapshotStore_List_Call{Call: _e.mock.On("List", sessionFolder)}
+}
+
+func (_c *MockSnapshotStore_List_Call) Run(run func(sessionFolder string)) *MockSnapshotStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_List_Call) Return(snapshots []workspacesnapshot.Snapshot, err error) *MockSnapshotStore_List_Call {
+	_c.Call.Return(snapshots, err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_List_Call) RunAndReturn(run func(sessionFolder string) ([]workspacesnapshot.Snapshot, error)) *MockSnapshotStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function for the type MockSnapshotStore
+func (_mock *MockSnapshotStore) Reserve(sessionFolder string, name string, overwrite bool) (string, string, error) {
+	ret := _mock.Called(sessionFolder, name, overwrite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) (string, string, error)); ok {
+		return returnFunc(sessionFolder, name, overwrite)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) string); ok {
+		r0 = returnFunc(sessionFolder, name, overwrite)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, bool) string); ok {
+		r1 = returnFunc(sessionFolder, name, overwrite)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string, bool) error); ok {
+		r2 = returnFunc(sessionFolder, name, overwrite)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSnapshotStore_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type MockSnapshotStore_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - sessionFolder string
+//   - name string
+//   - overwrite bool
+func (_e *MockSnapshotStore_Expecter) Reserve(sessionFolder interface{}, name interface{}, overwrite interface{}) *MockSnapshotStore_Reserve_Call {
+	return &MockSnapshotStore_Reserve_Call{Call: _e.mock.On("Reserve", sessionFolder, name, overwrite)}
+}
+
+func (_c *MockSnapshotStore_Reserve_Call) Run(run func(sessionFolder string, name string, overwrite bool)) *MockSnapshotStore_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshotStore_Reserve_Call) Return(s string, s1 string, err error) *MockSnapshotStore_Reserve_Call {
+	_c.Call.Return(s, s1, err)
+	return _c
+}
+
+func (_c *MockSnapshotStore_Reserve_Call) RunAndReturn(run func(sessionFolder string, name string, overwrite bool) (string, string, error)) *MockSnapshotStore_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
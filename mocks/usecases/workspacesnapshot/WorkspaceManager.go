@@ -0,0 +1,350 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWorkspaceManager creates a new instance of MockWorkspaceManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkspaceManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkspaceManager {
+	mock := &MockWorkspaceManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWorkspaceManager is an autogenerated mock type for the WorkspaceManager type
+type MockWorkspaceManager struct {
+	mock.Mock
+}
+
+type MockWorkspaceManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkspaceManager) EXPECT() *MockWorkspaceManager_Expecter {
+	return &MockWorkspaceManager_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function for the type MockWorkspaceManager
+func (_mock *MockWorkspaceManager) Load(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string, mode workspacesnapshot.RestoreMode) ([]string, error) {
+	ret := _mock.Called(ctx, logger, client, filePath, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string, workspacesnapshot.RestoreMode) ([]string, error)); ok {
+		return returnFunc(ctx, logger, client, filePath, mode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string, workspacesnapshot.RestoreMode) []string); ok {
+		r0 = returnFunc(ctx, logger, client, filePath, mode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string, workspacesnapshot.RestoreMode) error); ok {
+		r1 = returnFunc(ctx, logger, client, filePath, mode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorkspaceManager_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type MockWorkspaceManager_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - filePath string
+//   - mode workspacesnapshot.RestoreMode
+func (_e *MockWorkspaceManager_Expecter) Load(ctx interface{}, logger interface{}, client interface{}, filePath interface{}, mode interface{}) *MockWorkspaceManager_Load_Call {
+	return &MockWorkspaceManager_Load_Call{Call: _e.mock.On("Load", ctx, logger, client, filePath, mode)}
+}
+
+func (_c *MockWorkspaceManager_Load_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string, mode workspacesnapshot.RestoreMode)) *MockWorkspaceManager_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 workspacesnapshot.RestoreMode
+		if args[4] != nil {
+			arg4 = args[4].(workspacesnapshot.RestoreMode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Load_Call) Return(strings []string, err error) *MockWorkspaceManager_Load_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Load_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string, mode workspacesnapshot.RestoreMode) ([]string, error)) *MockWorkspaceManager_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockWorkspaceManager
+func (_mock *MockWorkspaceManager) Save(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string) ([]string, error) {
+	ret := _mock.Called(ctx, logger, client, filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) ([]string, error)); ok {
+		return returnFunc(ctx, logger, client, filePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) []string); ok {
+		r0 = returnFunc(ctx, logger, client, filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, string) error); ok {
+		r1 = returnFunc(ctx, logger, client, filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorkspaceManager_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockWorkspaceManager_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - filePath string
+func (_e *MockWorkspaceManager_Expecter) Save(ctx interface{}, logger interface{}, client interface{}, filePath interface{}) *MockWorkspaceManager_Save_Call {
+	return &MockWorkspaceManager_Save_Call{Call: _e.mock.On("Save", ctx, logger, client, filePath)}
+}
+
+func (_c *MockWorkspaceManager_Save_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string)) *MockWorkspaceManager_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Save_Call) Return(strings []string, err error) *MockWorkspaceManager_Save_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Save_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, filePath string) ([]string, error)) *MockWorkspaceManager_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SessionFolder provides a mock function for the type MockWorkspaceManager
+func (_mock *MockWorkspaceManager) SessionFolder(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (string, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionFolder")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) (string, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) string); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorkspaceManager_SessionFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionFolder'
+type MockWorkspaceManager_SessionFolder_Call struct {
+	*mock.Call
+}
+
+// SessionFolder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockWorkspaceManager_Expecter) SessionFolder(ctx interface{}, logger interface{}, client interface{}) *MockWorkspaceManager_SessionFolder_Call {
+	return &MockWorkspaceManager_SessionFolder_Call{Call: _e.mock.On("SessionFolder", ctx, logger, client)}
+}
+
+func (_c *MockWorkspaceManager_SessionFolder_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockWorkspaceManager_SessionFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceManager_SessionFolder_Call) Return(s string, err error) *MockWorkspaceManager_SessionFolder_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockWorkspaceManager_SessionFolder_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (string, error)) *MockWorkspaceManager_SessionFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Size provides a mock function for the type MockWorkspaceManager
+func (_mock *MockWorkspaceManager) Size(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (int64, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Size")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) (int64, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) int64); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWorkspaceManager_Size_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Size'
+type MockWorkspaceManager_Size_Call struct {
+	*mock.Call
+}
+
+// Size is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockWorkspaceManager_Expecter) Size(ctx interface{}, logger interface{}, client interface{}) *MockWorkspaceManager_Size_Call {
+	return &MockWorkspaceManager_Size_Call{Call: _e.mock.On("Size", ctx, logger, client)}
+}
+
+func (_c *MockWorkspaceManager_Size_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockWorkspaceManager_Size_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Size_Call) Return(n int64, err error) *MockWorkspaceManager_Size_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWorkspaceManager_Size_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (int64, error)) *MockWorkspaceManager_Size_Call {
+	_c.Call.Return(run)
+	return _c
+}
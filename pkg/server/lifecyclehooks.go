@@ -0,0 +1,16 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+)
+
+type LifecycleHookResources[Dependencies any] = publictypes.LifecycleHookResources[Dependencies]
+
+// LifecycleHookFunc is the OnStart or OnShutdown function of a hook. It must honour the cancellation of ctx, which is
+// cancelled when the hook times out, and return promptly: the server does not run the shutdown hooks until the start
+// hooks that timed out have returned.
+type LifecycleHookFunc[Dependencies any] = publictypes.LifecycleHookFunc[Dependencies]
+
+type LifecycleHook[Dependencies any] = publictypes.LifecycleHook[Dependencies]
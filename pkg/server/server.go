@@ -3,6 +3,8 @@
 package server
 
 import (
+	"time"
+
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
 	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/server"
@@ -20,6 +22,17 @@ type Definition[Dependencies any] struct {
 	DependenciesProvider DependenciesProvider[Dependencies]
 
 	ToolsProvider ToolsProvider[Dependencies]
+
+	// ToolMiddlewareProvider returns the middleware that wraps every tool call, outermost first,
+	// for both the built-in tools and the tools of ToolsProvider.
+	ToolMiddlewareProvider ToolMiddlewareProvider[Dependencies]
+
+	// LifecycleHooks run OnStart in order before the server starts serving, and OnShutdown in reverse order when it stops.
+	LifecycleHooks []LifecycleHook[Dependencies]
+
+	// LifecycleHookTimeout is the time each hook is given to complete, after which its context is cancelled.
+	// It defaults to 30 seconds.
+	LifecycleHookTimeout time.Duration
 }
 
 type Server = publictypes.Server
@@ -33,6 +46,9 @@ func New[Dependencies any](thisDefinition Definition[Dependencies]) Server {
 		thisDefinition.Parameters,
 		thisDefinition.DependenciesProvider,
 		thisDefinition.ToolsProvider,
+		thisDefinition.ToolMiddlewareProvider,
+		thisDefinition.LifecycleHooks,
+		thisDefinition.LifecycleHookTimeout,
 	)
 	return sdk.NewServer(internalDefinition)
 }
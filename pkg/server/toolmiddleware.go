@@ -0,0 +1,17 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package server
+
+import (
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/sdk/publictypes"
+)
+
+type ToolCall = publictypes.ToolCall
+
+type ToolResult = publictypes.ToolResult
+
+type ToolHandler = publictypes.ToolHandler
+
+type ToolMiddleware = publictypes.ToolMiddleware
+
+type ToolMiddlewareProvider[Dependencies any] = publictypes.ToolMiddlewareProvider[Dependencies]
@@ -0,0 +1,140 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sdk_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-server/tests/functional/sdk/testbinaries"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mcpclient"
+	"github.com/stretchr/testify/suite"
+)
+
+// ServerWithLifecycleHooksTestSuite tests SDK lifecycle hooks functionalities.
+type ServerWithLifecycleHooksTestSuite struct {
+	SDKTestSuite
+
+	serverDetails testbinaries.ServerWithLifecycleHooksDetails
+}
+
+// SetupSuite runs once before all tests in a suite
+func (s *ServerWithLifecycleHooksTestSuite) SetupSuite() {
+	s.serverDetails = testbinaries.BuildServerWithLifecycleHooks(s.T())
+}
+
+func TestServerWithLifecycleHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerWithLifecycleHooksTestSuite))
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) TestSDK_LifecycleHooks_RunInOrder() {
+	// Arrange
+	eventsFile := filepath.Join(s.T().TempDir(), "events.txt")
+	env := append(os.Environ(), s.serverDetails.EventsFileEnvVar()+"="+eventsFile)
+
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), env, nil)
+	defer s.CleanupSession(session, true)
+
+	// Start hooks run in order before the server starts serving
+	s.Require().Equal(s.startEvents(), s.readEvents(eventsFile), "start hooks should run in order before the session starts")
+
+	// Act
+	s.Require().NoError(session.Close(), "closing session should not error")
+
+	// Assert
+	expectedEvents := append(s.startEvents(), s.shutdownEvents()...)
+	s.Require().Eventually(func() bool {
+		return slices.Equal(expectedEvents, s.readEvents(eventsFile))
+	}, 10*time.Second, 100*time.Millisecond, "shutdown hooks should run in reverse order after session close")
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) TestSDK_LifecycleHooks_ShutdownOnSignal() {
+	if runtime.GOOS == "windows" {
+		s.T().Skip("Sending an interrupt signal to a process is not supported on Windows")
+	}
+
+	// Arrange
+	eventsFile := filepath.Join(s.T().TempDir(), "events.txt")
+	env := append(os.Environ(), s.serverDetails.EventsFileEnvVar()+"="+eventsFile)
+
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), env, nil)
+	defer s.CleanupSession(session, true)
+
+	s.Require().Equal(s.startEvents(), s.readEvents(eventsFile), "start hooks should run before the session starts")
+
+	// Act
+	s.Require().NoError(session.Interrupt(), "should send interrupt signal to the server")
+
+	// Assert
+	expectedEvents := append(s.startEvents(), s.shutdownEvents()...)
+	s.Require().Eventually(func() bool {
+		return slices.Equal(expectedEvents, s.readEvents(eventsFile))
+	}, 10*time.Second, 100*time.Millisecond, "shutdown hooks should run in reverse order after an interrupt signal")
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) TestSDK_LifecycleHooks_StartHookFails() {
+	// Arrange
+	// The first hook starts, and the second one fails
+	hookNames := s.serverDetails.HookNames()
+	startedHook, failingHook := hookNames[0], hookNames[1]
+
+	eventsFile := filepath.Join(s.T().TempDir(), "events.txt")
+	env := append(os.Environ(),
+		s.serverDetails.EventsFileEnvVar()+"="+eventsFile,
+		s.serverDetails.FailingStartHookEnvVar()+"="+failingHook,
+	)
+
+	// The log folder must be short, as the server creates its socket in it
+	logFolder, err := os.MkdirTemp("", "sdk-logs-")
+	s.Require().NoError(err, "should create SDK log folder")
+	defer func() {
+		_ = os.RemoveAll(logFolder)
+	}()
+
+	client := mcpclient.NewClient(s.T().Context(), s.serverDetails.BinaryLocation(), env, "--log-folder="+logFolder)
+
+	// Act
+	_, err = client.CreateSession(s.T().Context())
+
+	// Assert
+	s.Require().Error(err, "server should not start when a start hook fails")
+
+	expectedEvents := []string{"start " + startedHook, "shutdown " + startedHook}
+	s.Require().Eventually(func() bool {
+		return slices.Equal(expectedEvents, s.readEvents(eventsFile))
+	}, 10*time.Second, 100*time.Millisecond, "only the hooks that started should be shut down")
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) startEvents() []string {
+	events := make([]string, 0, len(s.serverDetails.HookNames()))
+	for _, name := range s.serverDetails.HookNames() {
+		events = append(events, "start "+name)
+	}
+	return events
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) shutdownEvents() []string {
+	hookNames := s.serverDetails.HookNames()
+	events := make([]string, 0, len(hookNames))
+	for i := len(hookNames) - 1; i >= 0; i-- {
+		events = append(events, "shutdown "+hookNames[i])
+	}
+	return events
+}
+
+func (s *ServerWithLifecycleHooksTestSuite) readEvents(eventsFile string) []string {
+	content, err := os.ReadFile(eventsFile) //nolint:gosec // Test file path
+	if err != nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(string(content))
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "\n")
+}
@@ -6,9 +6,60 @@ import (
 	"testing"
 
 	"github.com/matlab/matlab-mcp-server/tests/functional/sdk/testbinaries"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/suite"
 )
 
+// expectedMATLABFeatureTools are the tools that the MATLAB feature adds to a server.
+func expectedMATLABFeatureTools() []string {
+	return []string{
+		"analyze_matlab_dependencies",
+		"analyze_matlab_project",
+		"check_matlab_code",
+		"clear_matlab_breakpoints",
+		"close_matlab_project",
+		"convert_live_script",
+		"debug_matlab_code",
+		"delete_workspace_snapshot",
+		"detect_matlab_toolboxes",
+		"evaluate_matlab_code",
+		"get_matlab_debug_stack",
+		"list_matlab_project_files",
+		"list_workspace_snapshots",
+		"matlab_session_status",
+		"open_matlab_project",
+		"profile_matlab_code",
+		"restore_workspace",
+		"run_matlab_file",
+		"run_matlab_project_checks",
+		"run_matlab_sections",
+		"run_matlab_test_file",
+		"set_matlab_breakpoint",
+		"simulink_get_block_params",
+		"simulink_list_blocks",
+		"simulink_open_model",
+		"simulink_set_block_params",
+		"simulink_sim",
+		"simulink_update_diagram",
+		"snapshot_workspace",
+		"step_matlab_debugger",
+	}
+}
+
+// expectedMATLABFeatureResources are the URIs of the resources that the MATLAB feature adds to a server.
+func expectedMATLABFeatureResources() []string {
+	return []string{
+		"guidelines://coding",
+		"guidelines://plain-text-live-code",
+		"matlab-help://matlab.buildtool",
+		"matlab-help://matlab.io",
+		"matlab-help://matlab.lang",
+		"matlab-help://matlab.net.http",
+		"matlab-help://matlab.project",
+		"matlab-help://matlab.unittest",
+	}
+}
+
 // ServerWithMATLABFeatureTestSuite tests SDK MATLAB feature functionalities.
 type ServerWithMATLABFeatureTestSuite struct {
 	SDKTestSuite
@@ -37,10 +88,54 @@ func (s *ServerWithMATLABFeatureTestSuite) TestSDK_MATLABFeature_HappyPath() {
 	listResourcesResponse, err := session.ListResources(s.T().Context(), nil)
 	s.Require().NoError(err)
 
+	listPromptsResponse, err := session.ListPrompts(s.T().Context(), nil)
+	s.Require().NoError(err)
+
 	// Assert
 	s.Require().NotNil(listToolsResponse)
-	s.Len(listToolsResponse.Tools, 5)
+	toolNames := make([]string, 0, len(listToolsResponse.Tools))
+	for _, tool := range listToolsResponse.Tools {
+		toolNames = append(toolNames, tool.Name)
+	}
+	s.ElementsMatch(expectedMATLABFeatureTools(), toolNames)
 
 	s.Require().NotNil(listResourcesResponse)
-	s.Len(listResourcesResponse.Resources, 2)
+	resourceURIs := make([]string, 0, len(listResourcesResponse.Resources))
+	for _, resource := range listResourcesResponse.Resources {
+		resourceURIs = append(resourceURIs, resource.URI)
+	}
+	s.ElementsMatch(expectedMATLABFeatureResources(), resourceURIs)
+
+	s.Require().NotNil(listPromptsResponse)
+	promptNames := make([]string, 0, len(listPromptsResponse.Prompts))
+	for _, prompt := range listPromptsResponse.Prompts {
+		promptNames = append(promptNames, prompt.Name)
+	}
+	// Each tool that completes its arguments has a prompt
+	s.ElementsMatch([]string{
+		"check_matlab_code",
+		"convert_live_script",
+		"open_matlab_project",
+		"run_matlab_file",
+		"run_matlab_test_file",
+		"set_matlab_breakpoint",
+		"step_matlab_debugger",
+	}, promptNames)
+}
+
+func (s *ServerWithMATLABFeatureTestSuite) TestSDK_MATLABFeature_CompletesToolArguments() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	// Act
+	completeResponse, err := session.Complete(s.T().Context(), &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: "step_matlab_debugger"},
+		Argument: mcp.CompleteParamsArgument{Name: "action", Value: "step_"},
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().NotNil(completeResponse)
+	s.ElementsMatch([]string{"step_in", "step_out"}, completeResponse.Completion.Values)
 }
@@ -0,0 +1,115 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package sdk_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/tests/functional/sdk/testbinaries"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+// ServerWithToolMiddlewareTestSuite tests SDK tool middleware functionalities.
+type ServerWithToolMiddlewareTestSuite struct {
+	SDKTestSuite
+
+	serverDetails testbinaries.ServerWithToolMiddlewareDetails
+}
+
+// SetupSuite runs once before all tests in a suite
+func (s *ServerWithToolMiddlewareTestSuite) SetupSuite() {
+	s.serverDetails = testbinaries.BuildServerWithToolMiddleware(s.T())
+}
+
+func TestServerWithToolMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerWithToolMiddlewareTestSuite))
+}
+
+func (s *ServerWithToolMiddlewareTestSuite) TestSDK_ToolMiddleware_ComposesInOrder() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	// Act
+	result, err := session.CallTool(s.T().Context(), s.serverDetails.GreetToolName(), map[string]any{"name": "World"})
+
+	// Assert
+	s.Require().NoError(err, "should call tool successfully")
+
+	expectedTextContent := append([]string{"Hello World"}, s.serverDetails.MiddlewareTags()...)
+	s.Require().Equal(expectedTextContent, textContentOf(result), "middleware should wrap the tool, outermost first")
+}
+
+func (s *ServerWithToolMiddlewareTestSuite) TestSDK_ToolMiddleware_RewritesArguments() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	// Act
+	result, err := session.CallTool(s.T().Context(), s.serverDetails.GreetToolName(), map[string]any{"name": "  World  "})
+
+	// Assert
+	s.Require().NoError(err, "should call tool successfully")
+
+	textContent, err := session.GetTextContent(result)
+	s.Require().NoError(err, "should get text content")
+	s.Require().Equal("Hello World", textContent, "the tool should receive the rewritten arguments")
+}
+
+func (s *ServerWithToolMiddlewareTestSuite) TestSDK_ToolMiddleware_DeniesSDKTool() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	// Act
+	_, err := session.CallTool(s.T().Context(), s.serverDetails.GreetToolName(), map[string]any{"name": " " + s.serverDetails.DeniedUserName()})
+
+	// Assert
+	s.Require().ErrorContains(err, s.serverDetails.DeniedMessage(), "middleware should deny the SDK tool call")
+}
+
+func (s *ServerWithToolMiddlewareTestSuite) TestSDK_ToolMiddleware_DeniesBuiltInTool() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	// Act
+	_, err := session.EvaluateCode(s.T().Context(), "disp('hello')")
+
+	// Assert
+	s.Require().ErrorContains(err, s.serverDetails.DeniedMessage(), "middleware should deny the built-in tool call")
+}
+
+func (s *ServerWithToolMiddlewareTestSuite) TestSDK_ToolMiddleware_CachesResults() {
+	// Arrange
+	session := s.CreateSession(s.serverDetails.BinaryLocation(), nil, nil)
+	defer s.CleanupSession(session, true)
+
+	firstResult, err := session.CallTool(s.T().Context(), s.serverDetails.CountToolName(), map[string]any{})
+	s.Require().NoError(err, "should call tool successfully")
+
+	// Act
+	secondResult, err := session.CallTool(s.T().Context(), s.serverDetails.CountToolName(), map[string]any{})
+
+	// Assert
+	s.Require().NoError(err, "should call tool successfully")
+
+	firstTextContent, err := session.GetTextContent(firstResult)
+	s.Require().NoError(err, "should get text content")
+	s.Require().Equal("Count 1", firstTextContent, "the first call should run the tool")
+
+	secondTextContent, err := session.GetTextContent(secondResult)
+	s.Require().NoError(err, "should get text content")
+	s.Require().Equal(firstTextContent, secondTextContent, "the second call should be served from the cache")
+}
+
+func textContentOf(result *mcp.CallToolResult) []string {
+	textContent := make([]string, 0, len(result.Content))
+	for _, content := range result.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			textContent = append(textContent, text.Text)
+		}
+	}
+	return textContent
+}
@@ -116,3 +116,55 @@ func (s ServerWithLoggingDetails) ToolThatLogsName() string {
 func (s ServerWithLoggingDetails) StructuredToolThatLogsName() string {
 	return s.structuredToolThatLogsName
 }
+
+type ServerWithLifecycleHooksDetails struct {
+	ServerDetails
+
+	hookNames              []string
+	eventsFileEnvVar       string
+	failingStartHookEnvVar string
+}
+
+// HookNames returns the names of the lifecycle hooks, in the order they start.
+func (s ServerWithLifecycleHooksDetails) HookNames() []string {
+	return s.hookNames
+}
+
+func (s ServerWithLifecycleHooksDetails) EventsFileEnvVar() string {
+	return s.eventsFileEnvVar
+}
+
+func (s ServerWithLifecycleHooksDetails) FailingStartHookEnvVar() string {
+	return s.failingStartHookEnvVar
+}
+
+type ServerWithToolMiddlewareDetails struct {
+	ServerDetails
+
+	greetToolName  string
+	countToolName  string
+	middlewareTags []string
+	deniedUserName string
+	deniedMessage  string
+}
+
+func (s ServerWithToolMiddlewareDetails) GreetToolName() string {
+	return s.greetToolName
+}
+
+func (s ServerWithToolMiddlewareDetails) CountToolName() string {
+	return s.countToolName
+}
+
+// MiddlewareTags returns the tags that the tagging middleware append to every result, innermost first.
+func (s ServerWithToolMiddlewareDetails) MiddlewareTags() []string {
+	return s.middlewareTags
+}
+
+func (s ServerWithToolMiddlewareDetails) DeniedUserName() string {
+	return s.deniedUserName
+}
+
+func (s ServerWithToolMiddlewareDetails) DeniedMessage() string {
+	return s.deniedMessage
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"os"
+
+	"github.com/matlab/matlab-mcp-server/pkg/i18n"
+)
+
+const (
+	eventsFileEnvVar       = "SERVER_WITH_LIFECYCLE_HOOKS_EVENTS_FILE"
+	failingStartHookEnvVar = "SERVER_WITH_LIFECYCLE_HOOKS_FAILING_START_HOOK"
+)
+
+type Dependencies struct {
+	EventRecorder    *EventRecorder
+	FailingStartHook string
+}
+
+type DependenciesProviderResources interface{}
+
+func DependenciesProvider(_ DependenciesProviderResources) (Dependencies, i18n.Error) {
+	return Dependencies{
+		EventRecorder:    NewEventRecorder(os.Getenv(eventsFileEnvVar)),
+		FailingStartHook: os.Getenv(failingStartHookEnvVar),
+	}, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"os"
+)
+
+// EventRecorder appends one line per lifecycle event to a file, so that the test can check the order of the events.
+type EventRecorder struct {
+	filePath string
+}
+
+func NewEventRecorder(filePath string) *EventRecorder {
+	return &EventRecorder{
+		filePath: filePath,
+	}
+}
+
+func (r *EventRecorder) Record(event string) error {
+	file, err := os.OpenFile(r.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = file.WriteString(event + "\n")
+	return err
+}
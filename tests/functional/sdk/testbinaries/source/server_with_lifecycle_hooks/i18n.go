@@ -0,0 +1,20 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import "errors"
+
+var (
+	HookStartErr = &i18nError{ //nolint:gochecknoglobals // This is an error
+		error: errors.New("hook failed to start"),
+	}
+	EventRecordErr = &i18nError{ //nolint:gochecknoglobals // This is an error
+		error: errors.New("failed to record lifecycle event"),
+	}
+)
+
+type i18nError struct {
+	error
+}
+
+func (e *i18nError) MWMarker() {}
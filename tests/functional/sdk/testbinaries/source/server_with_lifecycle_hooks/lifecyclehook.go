@@ -0,0 +1,39 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-server/pkg/i18n"
+	"github.com/matlab/matlab-mcp-server/pkg/server"
+)
+
+// NewLifecycleHook creates a hook that records its start and shutdown events.
+func NewLifecycleHook(name string) server.LifecycleHook[Dependencies] {
+	return server.LifecycleHook[Dependencies]{
+		Name: name,
+		OnStart: func(ctx context.Context, resources server.LifecycleHookResources[Dependencies]) i18n.Error {
+			dependencies := resources.Dependencies()
+
+			if dependencies.FailingStartHook == name {
+				return HookStartErr
+			}
+
+			if err := dependencies.EventRecorder.Record("start " + name); err != nil {
+				return EventRecordErr
+			}
+
+			resources.Logger().With("hook", name).Info("Lifecycle hook started")
+			return nil
+		},
+		OnShutdown: func(ctx context.Context, resources server.LifecycleHookResources[Dependencies]) i18n.Error {
+			if err := resources.Dependencies().EventRecorder.Record("shutdown " + name); err != nil {
+				return EventRecordErr
+			}
+
+			resources.Logger().With("hook", name).Info("Lifecycle hook shut down")
+			return nil
+		},
+	}
+}
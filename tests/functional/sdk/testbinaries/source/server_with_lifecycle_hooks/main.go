@@ -0,0 +1,32 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/matlab/matlab-mcp-server/pkg/i18n"
+	"github.com/matlab/matlab-mcp-server/pkg/server"
+)
+
+func main() {
+	serverDefinition := server.Definition[Dependencies]{
+		Name:         "server-with-lifecycle-hooks",
+		Title:        "Server With Lifecycle Hooks",
+		Instructions: "This is a test server with lifecycle hooks",
+
+		DependenciesProvider: func(dependenciesProviderResources server.DependenciesProviderResources) (Dependencies, i18n.Error) {
+			return DependenciesProvider(dependenciesProviderResources)
+		},
+		LifecycleHooks: []server.LifecycleHook[Dependencies]{
+			NewLifecycleHook("database"),
+			NewLifecycleHook("cache"),
+		},
+	}
+	serverInstance := server.New(serverDefinition)
+
+	exitCode := serverInstance.StartAndWaitForCompletion(context.Background())
+
+	os.Exit(exitCode)
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/matlab/matlab-mcp-server/pkg/i18n"
+	"github.com/matlab/matlab-mcp-server/pkg/tools"
+)
+
+type CountToolInput struct{}
+
+// NewCountTool creates a tool that returns how many times it ran, so that the test can tell a cached result apart.
+func NewCountTool() tools.Tool {
+	var count atomic.Int64
+
+	return tools.NewToolWithUnstructuredContentOutput(
+		tools.NewDefinition(
+			"count",
+			"Count",
+			"Returns how many times the tool ran",
+			tools.NewReadOnlyAnnotations(),
+		),
+		func(ctx context.Context, request tools.CallRequest, inputs CountToolInput) (tools.RichContent, i18n.Error) {
+			return tools.RichContent{
+				TextContent: []string{"Count " + strconv.FormatInt(count.Add(1), 10)},
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import "errors"
+
+var (
+	AccessDeniedErr = &i18nError{ //nolint:gochecknoglobals // This is an error
+		error: errors.New("access denied"),
+	}
+	InvalidArgumentsErr = &i18nError{ //nolint:gochecknoglobals // This is an error
+		error: errors.New("invalid arguments"),
+	}
+)
+
+type i18nError struct {
+	error
+}
+
+func (e *i18nError) MWMarker() {}
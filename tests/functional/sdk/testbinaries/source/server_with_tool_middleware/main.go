@@ -0,0 +1,37 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/matlab/matlab-mcp-server/pkg/server"
+	"github.com/matlab/matlab-mcp-server/pkg/tools"
+)
+
+func main() {
+	serverDefinition := server.Definition[any]{
+		Name:         "server-with-tool-middleware",
+		Title:        "Server With Tool Middleware",
+		Instructions: "This is a test server with tool middleware",
+
+		Features: server.Features{
+			MATLAB: server.MATLABFeature{
+				Enabled: true,
+			},
+		},
+
+		ToolsProvider: func(toolsProviderResources server.ToolsProviderResources[any]) []tools.Tool {
+			return ToolsProvider(toolsProviderResources)
+		},
+		ToolMiddlewareProvider: func(toolsProviderResources server.ToolsProviderResources[any]) []server.ToolMiddleware {
+			return ToolMiddlewareProvider(toolsProviderResources)
+		},
+	}
+	serverInstance := server.New(serverDefinition)
+
+	exitCode := serverInstance.StartAndWaitForCompletion(context.Background())
+
+	os.Exit(exitCode)
+}
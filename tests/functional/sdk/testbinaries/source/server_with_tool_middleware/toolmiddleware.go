@@ -0,0 +1,144 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-server/pkg/i18n"
+	"github.com/matlab/matlab-mcp-server/pkg/server"
+)
+
+const (
+	greetToolName        = "greet"
+	countToolName        = "count"
+	evaluateCodeToolName = "evaluate_matlab_code"
+	deniedUserName       = "Mallory"
+)
+
+// ToolMiddlewareProvider returns the middleware, outermost first.
+func ToolMiddlewareProvider(resources ToolsProviderResources) []server.ToolMiddleware {
+	return []server.ToolMiddleware{
+		NewTaggingMiddleware("outer"),
+		NewTaggingMiddleware("inner"),
+		NewTrimNameMiddleware(),
+		NewAuthorizationMiddleware(),
+		NewCachingMiddleware(),
+	}
+}
+
+// NewTaggingMiddleware appends its tag to the text content of the result, so that the test can check the composition order.
+func NewTaggingMiddleware(tag string) server.ToolMiddleware {
+	return func(next server.ToolHandler) server.ToolHandler {
+		return func(ctx context.Context, call server.ToolCall) (server.ToolResult, i18n.Error) {
+			result, err := next(ctx, call)
+			if err != nil {
+				return nil, err
+			}
+
+			return toolResult{
+				isError:     result.IsError(),
+				textContent: append(slices.Clone(result.TextContent()), tag),
+			}, nil
+		}
+	}
+}
+
+// NewTrimNameMiddleware trims the spaces around the name argument of the greet tool.
+func NewTrimNameMiddleware() server.ToolMiddleware {
+	return func(next server.ToolHandler) server.ToolHandler {
+		return func(ctx context.Context, call server.ToolCall) (server.ToolResult, i18n.Error) {
+			if call.Name() != greetToolName {
+				return next(ctx, call)
+			}
+
+			var input GreetToolInput
+			if err := json.Unmarshal(call.Arguments(), &input); err != nil {
+				return nil, InvalidArgumentsErr
+			}
+
+			input.Name = strings.TrimSpace(input.Name)
+
+			arguments, err := json.Marshal(input)
+			if err != nil {
+				return nil, InvalidArgumentsErr
+			}
+
+			return next(ctx, call.WithArguments(arguments))
+		}
+	}
+}
+
+// NewAuthorizationMiddleware denies MATLAB code evaluation, and greeting the denied user.
+func NewAuthorizationMiddleware() server.ToolMiddleware {
+	return func(next server.ToolHandler) server.ToolHandler {
+		return func(ctx context.Context, call server.ToolCall) (server.ToolResult, i18n.Error) {
+			switch call.Name() {
+			case evaluateCodeToolName:
+				return nil, AccessDeniedErr
+			case greetToolName:
+				var input GreetToolInput
+				if err := json.Unmarshal(call.Arguments(), &input); err != nil {
+					return nil, InvalidArgumentsErr
+				}
+				if input.Name == deniedUserName {
+					return nil, AccessDeniedErr
+				}
+			}
+
+			call.Logger().Debug("Tool call authorized")
+			return next(ctx, call)
+		}
+	}
+}
+
+// NewCachingMiddleware caches the first successful result of the count tool.
+func NewCachingMiddleware() server.ToolMiddleware {
+	var (
+		lock   sync.Mutex
+		cached server.ToolResult
+	)
+
+	return func(next server.ToolHandler) server.ToolHandler {
+		return func(ctx context.Context, call server.ToolCall) (server.ToolResult, i18n.Error) {
+			if call.Name() != countToolName {
+				return next(ctx, call)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			if cached != nil {
+				return cached, nil
+			}
+
+			result, err := next(ctx, call)
+			if err != nil {
+				return nil, err
+			}
+
+			if !result.IsError() {
+				cached = result
+			}
+
+			return result, nil
+		}
+	}
+}
+
+type toolResult struct {
+	isError     bool
+	textContent []string
+}
+
+func (r toolResult) IsError() bool {
+	return r.isError
+}
+
+func (r toolResult) TextContent() []string {
+	return r.textContent
+}
@@ -145,6 +145,46 @@ func BuildServerWithLogging(t *testing.T) ServerWithLoggingDetails {
 	}
 }
 
+func BuildServerWithLifecycleHooks(t *testing.T) ServerWithLifecycleHooksDetails {
+	// Those string literals match the one in the source code
+	return ServerWithLifecycleHooksDetails{
+		ServerDetails: ServerDetails{
+			binaryLocation: buildSDKServer(t, "server_with_lifecycle_hooks"),
+
+			moduleName: goModName,
+
+			name:         "server-with-lifecycle-hooks",
+			title:        "Server With Lifecycle Hooks",
+			instructions: "This is a test server with lifecycle hooks",
+		},
+
+		hookNames:              []string{"database", "cache"},
+		eventsFileEnvVar:       "SERVER_WITH_LIFECYCLE_HOOKS_EVENTS_FILE",
+		failingStartHookEnvVar: "SERVER_WITH_LIFECYCLE_HOOKS_FAILING_START_HOOK",
+	}
+}
+
+func BuildServerWithToolMiddleware(t *testing.T) ServerWithToolMiddlewareDetails {
+	// Those string literals match the one in the source code
+	return ServerWithToolMiddlewareDetails{
+		ServerDetails: ServerDetails{
+			binaryLocation: buildSDKServer(t, "server_with_tool_middleware"),
+
+			moduleName: goModName,
+
+			name:         "server-with-tool-middleware",
+			title:        "Server With Tool Middleware",
+			instructions: "This is a test server with tool middleware",
+		},
+
+		greetToolName:  "greet",
+		countToolName:  "count",
+		middlewareTags: []string{"inner", "outer"},
+		deniedUserName: "Mallory",
+		deniedMessage:  "access denied",
+	}
+}
+
 func buildSDKServer(t *testing.T, serverFolder string) string {
 	t.Helper()
 
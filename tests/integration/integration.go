@@ -8,6 +8,6 @@ import (
 )
 
 func NewEmptyApplication() *wire.Application {
-	serverDefinition := definition.New("", "", "", definition.Features{}, nil, nil, nil)
+	serverDefinition := definition.New("", "", "", definition.Features{}, nil, nil, nil, nil, nil, 0)
 	return wire.Initialize(serverDefinition)
 }
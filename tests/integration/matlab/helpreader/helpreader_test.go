@@ -0,0 +1,434 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package helpreader_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/helpreader"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	matlabhelpresource "github.com/matlab/matlab-mcp-server/internal/adaptors/mcp/resources/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabhelp"
+	entitiesmocks "github.com/matlab/matlab-mcp-server/mocks/entities"
+	matlabhelpmocks "github.com/matlab/matlab-mcp-server/mocks/usecases/matlabhelp"
+	"github.com/matlab/matlab-mcp-server/tests/integration"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mockembeddedconnector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const helpFunction = "matlab_mcp.mcpHelp"
+
+// plotHelp is the help text of plot as MATLAB prints it in recent releases, with a Syntax section.
+const plotHelp = ` <strong>plot</strong> - 2-D line plot
+    This MATLAB function creates a 2-D line plot of the data in Y versus
+    the corresponding values in X.
+
+    Syntax
+      plot(X,Y)
+      plot(X,Y,LineSpec)
+
+      plot(Y)
+
+    Input Arguments
+      X - x-coordinates
+        scalar | vector | matrix
+
+    See also <a href="matlab:help gca">gca</a>, <a href="matlab:help hold">hold</a>, <a href="matlab:help legend">legend</a>, <a href="matlab:help plot3">plot3</a>,
+      LineSpec, Line Properties
+
+    Introduced in MATLAB before R2006a
+    <a href="matlab:doc plot">Documentation for plot</a>
+       doc plot
+
+    Other uses of plot
+
+       codistributed/plot    fints/plot
+`
+
+// trapzHelp is the help text of a function written in the "H1 line" style, where the syntax is given inline.
+const trapzHelp = ` TRAPZ  Trapezoidal numerical integration.
+    Z = TRAPZ(Y) computes an approximation of the integral of Y via
+    the trapezoidal method (with unit spacing).
+
+    Z = TRAPZ(X,Y) computes the integral of Y with respect to X using
+    the trapezoidal method.
+
+    See also SUM, CUMSUM, CUMTRAPZ, INTEGRAL.
+
+    Documentation for trapz
+       doc trapz
+`
+
+func TestReader_Read_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectHelpCall(t, request, "plot")
+
+			respondWithResults(t, response, helpOutput(t, true, plotHelp, "MATLAB", nil))
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	help, err := reader.Read(t.Context(), logger, client, "plot")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, matlabhelp.Help{
+		Name:    "plot",
+		Summary: "2-D line plot",
+		Text: "This MATLAB function creates a 2-D line plot of the data in Y versus\n" +
+			"the corresponding values in X.\n" +
+			"\n" +
+			"Input Arguments\n" +
+			"  X - x-coordinates\n" +
+			"    scalar | vector | matrix\n" +
+			"\n" +
+			"Introduced in MATLAB before R2006a",
+		Syntax:  []string{"plot(X,Y)", "plot(X,Y,LineSpec)", "plot(Y)"},
+		SeeAlso: []string{"gca", "hold", "legend", "plot3", "LineSpec"},
+		Members: []string{},
+		Product: "MATLAB",
+	}, help)
+}
+
+func TestReader_Read_InlineSyntax(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, helpOutput(t, true, trapzHelp, "MATLAB", nil))
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	help, err := reader.Read(t.Context(), logger, client, "trapz")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Trapezoidal numerical integration.", help.Summary)
+	assert.Equal(t, []string{"Z = TRAPZ(Y)", "Z = TRAPZ(X,Y)"}, help.Syntax)
+	assert.Equal(t, []string{"sum", "cumsum", "cumtrapz", "integral"}, help.SeeAlso)
+	assert.NotContains(t, help.Text, "Documentation for")
+}
+
+func TestReader_Read_Namespace(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	members := []string{"matlab.unittest.TestCase", "matlab.unittest.TestRunner", "matlab.unittest.constraints"}
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, helpOutput(t, true, "", "MATLAB", members))
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	help, err := reader.Read(t.Context(), logger, client, "matlab.unittest")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, members, help.Members)
+	assert.Empty(t, help.Summary)
+}
+
+func TestReader_Read_NotFound(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, helpOutput(t, false, "", "", nil))
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	help, err := reader.Read(t.Context(), logger, client, "notAFunction")
+
+	// Assert
+	require.ErrorIs(t, err, matlabhelp.ErrNotFound)
+	assert.Empty(t, help)
+}
+
+func TestReader_Release_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+	calls := 0
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			calls++
+
+			req := mockembeddedconnector.ReadConnectorRequest(t, request)
+			if assert.Len(t, req.Messages.FEval, 1) {
+				assert.Equal(t, "version", req.Messages.FEval[0].Function)
+				assert.Equal(t, []string{"-release"}, req.Messages.FEval[0].Arguments)
+			}
+
+			respondWithResults(t, response, "2025b")
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	first, firstErr := reader.Release(t.Context(), logger, entities.SessionID(1), client)
+	second, secondErr := reader.Release(t.Context(), logger, entities.SessionID(1), client)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, "R2025b", first)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, calls, "The release should be read once per session")
+}
+
+func TestReader_Release_NewSession(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	firstServer := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, "2025a")
+		},
+		nil,
+	)
+	defer firstServer.Stop()
+
+	secondServer := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, "2025b")
+		},
+		nil,
+	)
+	defer secondServer.Stop()
+
+	reader := helpreader.New()
+
+	// Act
+	first, firstErr := reader.Release(t.Context(), logger, entities.SessionID(1), newClient(t, firstServer.ConnectionDetails()))
+	second, secondErr := reader.Release(t.Context(), logger, entities.SessionID(2), newClient(t, secondServer.ConnectionDetails()))
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, "R2025a", first)
+	assert.Equal(t, "R2025b", second, "The release of a new session should be read again")
+}
+
+func TestReader_Release_NewSessionWithSameClient(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+	calls := 0
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			calls++
+			respondWithResults(t, response, "2025b")
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	_, firstErr := reader.Release(t.Context(), logger, entities.SessionID(1), client)
+	_, secondErr := reader.Release(t.Context(), logger, entities.SessionID(2), client)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.Equal(t, 2, calls, "The release should be read again for a new session, even if the client is the same")
+}
+
+func TestReader_Release_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, 2025)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	reader := helpreader.New()
+
+	// Act
+	release, err := reader.Release(t.Context(), logger, entities.SessionID(1), client)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, release)
+}
+
+func TestMATLABHelpResource_Markdown(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectHelpCall(t, request, "plot")
+
+			respondWithResults(t, response, helpOutput(t, true, plotHelp, "MATLAB", nil))
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockReleaseGetter := &matlabhelpmocks.MockReleaseGetter{}
+	defer mockReleaseGetter.AssertExpectations(t)
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, logger.AsMockArg()).
+		Return(client, nil).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		SessionID().
+		Return(entities.SessionID(1), true).
+		Once()
+
+	mockReleaseGetter.EXPECT().
+		Release(ctx, logger.AsMockArg(), entities.SessionID(1), client).
+		Return("R2025b", nil).
+		Once()
+
+	usecase := matlabhelp.New(helpreader.New(), mockReleaseGetter)
+	handler := matlabhelpresource.Handler(usecase, mockGlobalMATLAB)
+
+	// Act
+	result, err := handler(ctx, logger, "matlab-help://plot")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/markdown", result.Contents[0].MIMEType)
+	assert.Equal(t, "# plot\n"+
+		"\n"+
+		"2-D line plot\n"+
+		"\n"+
+		"- **Product:** MATLAB\n"+
+		"- **Release:** R2025b\n"+
+		"\n"+
+		"## Syntax\n"+
+		"\n"+
+		"```matlab\n"+
+		"plot(X,Y)\n"+
+		"plot(X,Y,LineSpec)\n"+
+		"plot(Y)\n"+
+		"```\n"+
+		"\n"+
+		"## Description\n"+
+		"\n"+
+		"```text\n"+
+		"This MATLAB function creates a 2-D line plot of the data in Y versus\n"+
+		"the corresponding values in X.\n"+
+		"\n"+
+		"Input Arguments\n"+
+		"  X - x-coordinates\n"+
+		"    scalar | vector | matrix\n"+
+		"\n"+
+		"Introduced in MATLAB before R2006a\n"+
+		"```\n"+
+		"\n"+
+		"## See also\n"+
+		"\n"+
+		"- [gca](matlab-help://gca)\n"+
+		"- [hold](matlab-help://hold)\n"+
+		"- [legend](matlab-help://legend)\n"+
+		"- [plot3](matlab-help://plot3)\n"+
+		"- [LineSpec](matlab-help://LineSpec)\n",
+		result.Contents[0].Text)
+}
+
+func expectHelpCall(t *testing.T, request *http.Request, name string) {
+	t.Helper()
+
+	req := mockembeddedconnector.ReadConnectorRequest(t, request)
+	if assert.Len(t, req.Messages.FEval, 1) {
+		assert.Equal(t, helpFunction, req.Messages.FEval[0].Function)
+		assert.Equal(t, []string{name}, req.Messages.FEval[0].Arguments)
+		assert.Equal(t, 1, req.Messages.FEval[0].Nargout)
+	}
+}
+
+func helpOutput(t *testing.T, found bool, text string, product string, members []string) string {
+	if members == nil {
+		members = []string{}
+	}
+
+	output, err := json.Marshal(map[string]any{
+		"Found":   found,
+		"Text":    text,
+		"Product": product,
+		"Members": members,
+	})
+	require.NoError(t, err)
+
+	return string(output)
+}
+
+func newClient(t *testing.T, connectionDetails embeddedconnector.ConnectionDetails) entities.MATLABSessionClient {
+	application := integration.NewEmptyApplication()
+
+	client, err := application.MATLABClientFactory.New(connectionDetails)
+	require.NoError(t, err)
+
+	return client
+}
+
+func respondWithResults(t *testing.T, response http.ResponseWriter, results ...any) {
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError: false,
+					Results: results,
+				},
+			},
+		},
+	})
+}
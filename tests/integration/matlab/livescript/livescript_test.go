@@ -0,0 +1,239 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package livescript_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/livescript"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/tests/integration"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mockembeddedconnector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const liveScriptPath = "/home/user/matlab/report.mlx"
+
+func TestConverter_Code_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const liveScriptCode = "%[text] # Weekly report\nx = magic(3)\n"
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			req := mockembeddedconnector.ReadConnectorRequest(t, request)
+			assert.Len(t, req.Messages.FEval, 1)
+			assert.Equal(t, "matlab_mcp.mcpLiveScriptCode", req.Messages.FEval[0].Function)
+			assert.Equal(t, []string{liveScriptPath}, req.Messages.FEval[0].Arguments)
+			assert.Equal(t, 1, req.Messages.FEval[0].Nargout)
+
+			respondWithResults(t, response, liveScriptCode)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	code, err := converter.Code(t.Context(), logger, client, liveScriptPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, liveScriptCode, code)
+}
+
+func TestConverter_Code_RunWithCapture(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const liveScriptCode = "x = magic(3)\n"
+	const expectedOutput = "x =\n     8     1     6"
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			req := mockembeddedconnector.ReadConnectorRequest(t, request)
+			assert.Len(t, req.Messages.FEval, 1)
+
+			switch req.Messages.FEval[0].Function {
+			case "matlab_mcp.mcpLiveScriptCode":
+				respondWithResults(t, response, liveScriptCode)
+			case "matlab_mcp.mcpEval":
+				assert.Equal(t, []string{liveScriptCode}, req.Messages.FEval[0].Arguments)
+
+				outputs, err := json.Marshal([]map[string]any{
+					{
+						"type":    "stream",
+						"content": map[string]string{"name": "stdout", "text": expectedOutput},
+					},
+				})
+				assert.NoError(t, err)
+				respondWithResults(t, response, string(outputs))
+			default:
+				t.Errorf("unexpected function %s", req.Messages.FEval[0].Function)
+			}
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	code, err := converter.Code(t.Context(), logger, client, liveScriptPath)
+	require.NoError(t, err)
+
+	response, err := client.EvalWithCapture(t.Context(), logger, entities.EvalRequest{Code: code})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, response.ConsoleOutput)
+}
+
+func TestConverter_Code_MATLABError(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Unable to open file report.mlx."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	code, err := converter.Code(t.Context(), logger, client, liveScriptPath)
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Empty(t, code)
+}
+
+func TestConverter_Code_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, 42)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	code, err := converter.Code(t.Context(), logger, client, liveScriptPath)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to cast output of matlab_mcp.mcpLiveScriptCode to string")
+	assert.Empty(t, code)
+}
+
+func TestConverter_Convert_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const outputPath = "/home/user/matlab/report.html"
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			req := mockembeddedconnector.ReadConnectorRequest(t, request)
+			assert.Len(t, req.Messages.FEval, 1)
+			assert.Equal(t, "matlab_mcp.mcpConvertLiveScript", req.Messages.FEval[0].Function)
+			assert.Equal(t, []string{liveScriptPath, outputPath}, req.Messages.FEval[0].Arguments)
+			assert.Equal(t, 0, req.Messages.FEval[0].Nargout)
+
+			respondWithResults(t, response)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	err := converter.Convert(t.Context(), logger, client, liveScriptPath, outputPath)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestConverter_Convert_MATLABError(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Unable to write file report.pdf."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	converter := livescript.New()
+
+	// Act
+	err := converter.Convert(t.Context(), logger, client, liveScriptPath, "/home/user/matlab/report.pdf")
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+}
+
+func newClient(t *testing.T, connectionDetails embeddedconnector.ConnectionDetails) entities.MATLABSessionClient {
+	application := integration.NewEmptyApplication()
+
+	client, err := application.MATLABClientFactory.New(connectionDetails)
+	require.NoError(t, err)
+
+	return client
+}
+
+func respondWithResults(t *testing.T, response http.ResponseWriter, results ...any) {
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError: false,
+					Results: results,
+				},
+			},
+		},
+	})
+}
+
+func respondWithFault(t *testing.T, response http.ResponseWriter, message string) {
+	fault, err := json.Marshal(embeddedconnector.Fault{Message: message})
+	require.NoError(t, err)
+
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError:       true,
+					MessageFaults: []json.RawMessage{fault},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package projectmanager_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/projectmanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/matlabproject"
+	"github.com/matlab/matlab-mcp-server/tests/integration"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mockembeddedconnector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	projectFunction = "matlab_mcp.mcpProject"
+	projectPath     = "/home/user/weather/Weather.prj"
+)
+
+func TestManager_Open_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectProjectCall(t, request, "open", projectPath)
+
+			respondWithResults(t, response, `{"Name":"Weather","RootFolder":"/home/user/weather","FileCount":12}`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	project, err := manager.Open(t.Context(), logger, client, projectPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, matlabproject.Project{Name: "Weather", RootFolder: "/home/user/weather", FileCount: 12}, project)
+}
+
+func TestManager_Open_MATLABError(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Unable to open project Weather.prj."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	project, err := manager.Open(t.Context(), logger, client, projectPath)
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Empty(t, project)
+}
+
+func TestManager_Close_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectProjectCall(t, request, "close")
+
+			respondWithResults(t, response, `{"Name":"Weather","RootFolder":"/home/user/weather","FileCount":12}`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	project, err := manager.Close(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Weather", project.Name)
+}
+
+func TestManager_Close_NoProject(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "No MATLAB project is open. Open a project first."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	project, err := manager.Close(t.Context(), logger, client)
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Empty(t, project)
+}
+
+func TestManager_ListFiles_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectProjectCall(t, request, "listFiles")
+
+			respondWithResults(t, response, `[`+
+				`{"Path":"/home/user/weather/forecast.m","Labels":[{"Category":"Classification","Name":"Design"}]},`+
+				`{"Path":"/home/user/weather/notes.txt","Labels":[]}]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	files, err := manager.ListFiles(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []matlabproject.File{
+		{
+			Path:   "/home/user/weather/forecast.m",
+			Labels: []matlabproject.Label{{Category: "Classification", Name: "Design"}},
+		},
+		{
+			Path:   "/home/user/weather/notes.txt",
+			Labels: []matlabproject.Label{},
+		},
+	}, files)
+}
+
+func TestManager_ListFiles_UnexpectedOutput(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, 42)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	files, err := manager.ListFiles(t.Context(), logger, client)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to cast output of matlab_mcp.mcpProject to string")
+	assert.Nil(t, files)
+}
+
+func TestManager_RunChecks_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectProjectCall(t, request, "runChecks")
+
+			respondWithResults(t, response, `[`+
+				`{"ID":"Project:Checks:ProjectPath","Description":"Project folders are on the path.","Passed":true,"ProblemFiles":[]},`+
+				`{"ID":"Project:Checks:UnsavedFiles","Description":"All project files are saved.","Passed":false,"ProblemFiles":["/home/user/weather/forecast.m"]}]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	results, err := manager.RunChecks(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []matlabproject.CheckResult{
+		{
+			ID:           "Project:Checks:ProjectPath",
+			Description:  "Project folders are on the path.",
+			Passed:       true,
+			ProblemFiles: []string{},
+		},
+		{
+			ID:           "Project:Checks:UnsavedFiles",
+			Description:  "All project files are saved.",
+			Passed:       false,
+			ProblemFiles: []string{"/home/user/weather/forecast.m"},
+		},
+	}, results)
+}
+
+func TestManager_RunChecks_InvalidJSON(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, "not json")
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := projectmanager.New()
+
+	// Act
+	results, err := manager.RunChecks(t.Context(), logger, client)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse output of matlab_mcp.mcpProject runChecks")
+	assert.Nil(t, results)
+}
+
+func expectProjectCall(t *testing.T, request *http.Request, arguments ...string) {
+	t.Helper()
+
+	req := mockembeddedconnector.ReadConnectorRequest(t, request)
+	if assert.Len(t, req.Messages.FEval, 1) {
+		assert.Equal(t, projectFunction, req.Messages.FEval[0].Function)
+		assert.Equal(t, arguments, req.Messages.FEval[0].Arguments)
+		assert.Equal(t, 1, req.Messages.FEval[0].Nargout)
+	}
+}
+
+func newClient(t *testing.T, connectionDetails embeddedconnector.ConnectionDetails) entities.MATLABSessionClient {
+	application := integration.NewEmptyApplication()
+
+	client, err := application.MATLABClientFactory.New(connectionDetails)
+	require.NoError(t, err)
+
+	return client
+}
+
+func respondWithResults(t *testing.T, response http.ResponseWriter, results ...any) {
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError: false,
+					Results: results,
+				},
+			},
+		},
+	})
+}
+
+func respondWithFault(t *testing.T, response http.ResponseWriter, message string) {
+	fault, err := json.Marshal(embeddedconnector.Fault{Message: message})
+	require.NoError(t, err)
+
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError:       true,
+					MessageFaults: []json.RawMessage{fault},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package simulink_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/simulink"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/utils/pathvalidator"
+	mocks "github.com/matlab/matlab-mcp-server/mocks/usecases/simulink"
+	"github.com/matlab/matlab-mcp-server/tests/integration"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mockembeddedconnector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const simulinkFunction = "matlab_mcp.mcpSimulink"
+
+func TestUsecase_OpenModel_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	modelPath := filepath.Join(t.TempDir(), "controller.slx")
+	require.NoError(t, os.WriteFile(modelPath, []byte{}, 0o600))
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectSimulinkCall(t, request, "openModel", modelPath)
+
+			respondWithResults(t, response, `{"Name":"controller","FileName":"`+filepath.ToSlash(modelPath)+`","BlockCount":3}`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	usecase := newUsecase(t)
+
+	// Act
+	model, err := usecase.OpenModel(t.Context(), logger, newClient(t, server.ConnectionDetails()), modelPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.Model{Name: "controller", FileName: filepath.ToSlash(modelPath), BlockCount: 3}, model)
+}
+
+func TestUsecase_ListBlocks_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectSimulinkCall(t, request, "listBlocks", "controller", "false")
+
+			respondWithResults(t, response, `[{"Path":"controller/Gain","Name":"Gain","BlockType":"Gain"}]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	usecase := newUsecase(t)
+
+	// Act
+	blocks, err := usecase.ListBlocks(t.Context(), logger, newClient(t, server.ConnectionDetails()), simulink.ListBlocksArgs{System: "controller"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []simulink.Block{{Path: "controller/Gain", Name: "Gain", BlockType: "Gain"}}, blocks)
+}
+
+func TestUsecase_SetBlockParams_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectSimulinkCall(t, request, "setBlockParams", "controller/Gain", `[{"Name":"Gain","Value":"2.5"}]`)
+
+			respondWithResults(t, response, `[{"Name":"Gain","Value":"2.5"}]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	defer mockCodePolicy.AssertExpectations(t)
+
+	mockCodePolicy.EXPECT().
+		Check("2.5").
+		Return(nil).
+		Once()
+
+	usecase := simulink.New(pathvalidator.New(osfacade.New()), mockCodePolicy)
+
+	// Act
+	parameters, err := usecase.SetBlockParams(t.Context(), logger, newClient(t, server.ConnectionDetails()), simulink.SetBlockParamsArgs{
+		BlockPath:  "controller/Gain",
+		Parameters: []simulink.BlockParameter{{Name: "Gain", Value: "2.5"}},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []simulink.BlockParameter{{Name: "Gain", Value: "2.5"}}, parameters)
+}
+
+func TestUsecase_UpdateDiagram_CompileErrors(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectSimulinkCall(t, request, "updateDiagram", "controller")
+
+			respondWithResults(t, response, `{"Model":"controller","Succeeded":false,"Diagnostics":[`+
+				`{"Severity":"error","Message":"Invalid setting in 'controller/Gain' for parameter 'Gain'.","Identifier":"Simulink:Parameters:InvParamSetting","Blocks":["controller/Gain"]},`+
+				`{"Severity":"warning","Message":"Output port 1 of 'controller/Plant' is not connected.","Identifier":"Simulink:Engine:OutputNotConnected","Blocks":[]}]}`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	usecase := newUsecase(t)
+
+	// Act
+	result, err := usecase.UpdateDiagram(t.Context(), logger, newClient(t, server.ConnectionDetails()), "controller")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.UpdateDiagramReturnArgs{
+		Model:     "controller",
+		Succeeded: false,
+		Diagnostics: []simulink.Diagnostic{
+			{
+				Severity:   simulink.SeverityError,
+				Message:    "Invalid setting in 'controller/Gain' for parameter 'Gain'.",
+				Identifier: "Simulink:Parameters:InvParamSetting",
+				Blocks:     []string{"controller/Gain"},
+			},
+			{
+				Severity:   simulink.SeverityWarning,
+				Message:    "Output port 1 of 'controller/Plant' is not connected.",
+				Identifier: "Simulink:Engine:OutputNotConnected",
+				Blocks:     []string{},
+			},
+		},
+	}, result)
+}
+
+func TestUsecase_Simulate_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectSimulinkCall(t, request, "simulate", "controller", "")
+
+			// jsonencode writes NaN as null, and the image as base64 text.
+			respondWithResults(t, response, `{"Model":"controller","SimulationTime":10,"Signals":[`+
+				`{"Name":"position","BlockPath":"controller/Scope","Samples":3,"StartTime":0,"EndTime":10,"Min":0,"Max":2,"Mean":1,"Final":[2,null]}],`+
+				`"Diagnostics":[],"Image":"iVBORw0KGgo="}`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	usecase := newUsecase(t)
+
+	// Act
+	result, err := usecase.Simulate(t.Context(), logger, newClient(t, server.ConnectionDetails()), simulink.SimulateArgs{Model: "controller"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulink.SimulateReturnArgs{
+		Model:          "controller",
+		SimulationTime: 10,
+		Signals: []simulink.SignalSummary{
+			{Name: "position", BlockPath: "controller/Scope", Samples: 3, StartTime: 0, EndTime: 10, Min: 0, Max: 2, Mean: 1, Final: []float64{2, 0}},
+		},
+		Diagnostics: []simulink.Diagnostic{},
+		Image:       []byte("\x89PNG\r\n\x1a\n"),
+	}, result)
+}
+
+func TestUsecase_Simulate_SimulinkNotInstalled(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Undefined function 'load_system' for input arguments of type 'char'."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	usecase := newUsecase(t)
+
+	// Act
+	result, err := usecase.Simulate(t.Context(), logger, newClient(t, server.ConnectionDetails()), simulink.SimulateArgs{Model: "controller"})
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Empty(t, result)
+}
+
+func expectSimulinkCall(t *testing.T, request *http.Request, arguments ...string) {
+	req := mockembeddedconnector.ReadConnectorRequest(t, request)
+	if !assert.Len(t, req.Messages.FEval, 1) {
+		return
+	}
+
+	assert.Equal(t, simulinkFunction, req.Messages.FEval[0].Function)
+	assert.Equal(t, arguments, req.Messages.FEval[0].Arguments)
+	assert.Equal(t, 1, req.Messages.FEval[0].Nargout)
+}
+
+func newUsecase(t *testing.T) *simulink.Usecase {
+	mockCodePolicy := &mocks.MockCodePolicy{}
+	t.Cleanup(func() { mockCodePolicy.AssertExpectations(t) })
+
+	return simulink.New(pathvalidator.New(osfacade.New()), mockCodePolicy)
+}
+
+func newClient(t *testing.T, connectionDetails embeddedconnector.ConnectionDetails) entities.MATLABSessionClient {
+	application := integration.NewEmptyApplication()
+
+	client, err := application.MATLABClientFactory.New(connectionDetails)
+	require.NoError(t, err)
+
+	return client
+}
+
+func respondWithResults(t *testing.T, response http.ResponseWriter, results ...any) {
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError: false,
+					Results: results,
+				},
+			},
+		},
+	})
+}
+
+func respondWithFault(t *testing.T, response http.ResponseWriter, message string) {
+	fault, err := json.Marshal(embeddedconnector.Fault{Message: message})
+	require.NoError(t, err)
+
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError:       true,
+					MessageFaults: []json.RawMessage{fault},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,330 @@
+// Copyright 2026 The MathWorks, Inc.
+
+package workspacemanager_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlab/workspacemanager"
+	"github.com/matlab/matlab-mcp-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-server/internal/entities"
+	"github.com/matlab/matlab-mcp-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-server/internal/usecases/workspacesnapshot"
+	"github.com/matlab/matlab-mcp-server/tests/integration"
+	"github.com/matlab/matlab-mcp-server/tests/testutils/mockembeddedconnector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	workspaceFunction = "matlab_mcp.mcpWorkspace"
+	snapshotPath      = "/tmp/matlab-session-1/workspace_snapshots/before-fit.partial.mat"
+)
+
+func TestManager_SessionFolder_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectCall(t, request, "getenv", "MW_MCP_SESSION_DIR")
+
+			respondWithResults(t, response, "/tmp/matlab-session-1")
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	sessionFolder, err := manager.SessionFolder(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/matlab-session-1", sessionFolder)
+}
+
+func TestManager_SessionFolder_AttachedSession(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectCall(t, request, "getenv", "MW_MCP_SESSION_DIR")
+
+			respondWithResults(t, response, "")
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	sessionFolder, err := manager.SessionFolder(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, sessionFolder, "A session that the server did not start should have no session folder")
+}
+
+func TestManager_Size_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectCall(t, request, workspaceFunction, "size")
+
+			respondWithResults(t, response, `8000000`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	size, err := manager.Size(t.Context(), logger, client)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(8000000), size)
+}
+
+func TestManager_Size_InvalidOutput(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, `["x"]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	_, err := manager.Size(t.Context(), logger, client)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestManager_Save_HappyPath(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectCall(t, request, workspaceFunction, "save", snapshotPath)
+
+			respondWithResults(t, response, `["x","y"]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	variables, err := manager.Save(t.Context(), logger, client, snapshotPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x", "y"}, variables)
+}
+
+func TestManager_Save_EmptyWorkspace(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			expectCall(t, request, workspaceFunction, "save", snapshotPath)
+
+			respondWithResults(t, response, `[]`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	variables, err := manager.Save(t.Context(), logger, client, snapshotPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, variables)
+	assert.NotNil(t, variables)
+}
+
+func TestManager_Save_MATLABError(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Unable to write file before-fit.partial.mat: permission denied."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	variables, err := manager.Save(t.Context(), logger, client, snapshotPath)
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Nil(t, variables)
+}
+
+func TestManager_Save_InvalidOutput(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithResults(t, response, `not json`)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	_, err := manager.Save(t.Context(), logger, client, snapshotPath)
+
+	// Assert
+	require.ErrorContains(t, err, "failed to parse output of matlab_mcp.mcpWorkspace save")
+}
+
+func TestManager_Load_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name string
+		mode workspacesnapshot.RestoreMode
+	}{
+		{name: "merge", mode: workspacesnapshot.RestoreModeMerge},
+		{name: "replace", mode: workspacesnapshot.RestoreModeReplace},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			logger := testutils.NewInspectableLogger()
+
+			server := mockembeddedconnector.New(t,
+				func(response http.ResponseWriter, request *http.Request) {
+					expectCall(t, request, workspaceFunction, "load", snapshotPath, string(tc.mode))
+
+					respondWithResults(t, response, `["x"]`)
+				},
+				nil,
+			)
+			defer server.Stop()
+
+			client := newClient(t, server.ConnectionDetails())
+			manager := workspacemanager.New()
+
+			// Act
+			variables, err := manager.Load(t.Context(), logger, client, snapshotPath, tc.mode)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []string{"x"}, variables)
+		})
+	}
+}
+
+func TestManager_Load_MATLABError(t *testing.T) {
+	// Arrange
+	logger := testutils.NewInspectableLogger()
+
+	const faultMessage = "Unable to read MAT-file before-fit.mat. Not a binary MAT-file."
+
+	server := mockembeddedconnector.New(t,
+		func(response http.ResponseWriter, request *http.Request) {
+			respondWithFault(t, response, faultMessage)
+		},
+		nil,
+	)
+	defer server.Stop()
+
+	client := newClient(t, server.ConnectionDetails())
+	manager := workspacemanager.New()
+
+	// Act
+	variables, err := manager.Load(t.Context(), logger, client, snapshotPath, workspacesnapshot.RestoreModeMerge)
+
+	// Assert
+	require.ErrorContains(t, err, faultMessage)
+	assert.Nil(t, variables)
+}
+
+func expectCall(t *testing.T, request *http.Request, function string, arguments ...string) {
+	t.Helper()
+
+	req := mockembeddedconnector.ReadConnectorRequest(t, request)
+	if assert.Len(t, req.Messages.FEval, 1) {
+		assert.Equal(t, function, req.Messages.FEval[0].Function)
+		assert.Equal(t, arguments, req.Messages.FEval[0].Arguments)
+		assert.Equal(t, 1, req.Messages.FEval[0].Nargout)
+	}
+}
+
+func newClient(t *testing.T, connectionDetails embeddedconnector.ConnectionDetails) entities.MATLABSessionClient {
+	application := integration.NewEmptyApplication()
+
+	client, err := application.MATLABClientFactory.New(connectionDetails)
+	require.NoError(t, err)
+
+	return client
+}
+
+func respondWithResults(t *testing.T, response http.ResponseWriter, results ...any) {
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError: false,
+					Results: results,
+				},
+			},
+		},
+	})
+}
+
+func respondWithFault(t *testing.T, response http.ResponseWriter, message string) {
+	fault, err := json.Marshal(embeddedconnector.Fault{Message: message})
+	require.NoError(t, err)
+
+	mockembeddedconnector.RespondWithJSON(t, response, embeddedconnector.ConnectorPayload{
+		Messages: embeddedconnector.ConnectorMessage{
+			FevalResponse: []embeddedconnector.FevalResponseMessage{
+				{
+					IsError:       true,
+					MessageFaults: []json.RawMessage{fault},
+				},
+			},
+		},
+	})
+}
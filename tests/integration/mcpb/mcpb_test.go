@@ -16,6 +16,42 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// expectedManifestTools are the tools that the manifest lists, in order.
+func expectedManifestTools() []string {
+	return []string{
+		"check_matlab_code",
+		"detect_matlab_toolboxes",
+		"evaluate_matlab_code",
+		"run_matlab_file",
+		"run_matlab_sections",
+		"run_matlab_test_file",
+		"set_matlab_breakpoint",
+		"clear_matlab_breakpoints",
+		"debug_matlab_code",
+		"get_matlab_debug_stack",
+		"step_matlab_debugger",
+		"profile_matlab_code",
+		"analyze_matlab_project",
+		"analyze_matlab_dependencies",
+		"convert_live_script",
+		"simulink_open_model",
+		"simulink_list_blocks",
+		"simulink_get_block_params",
+		"simulink_set_block_params",
+		"simulink_update_diagram",
+		"simulink_sim",
+		"open_matlab_project",
+		"close_matlab_project",
+		"list_matlab_project_files",
+		"run_matlab_project_checks",
+		"snapshot_workspace",
+		"restore_workspace",
+		"list_workspace_snapshots",
+		"delete_workspace_snapshot",
+		"matlab_session_status",
+	}
+}
+
 func TestBuild_HappyPath(t *testing.T) {
 	// Arrange
 	stagingDir := filepath.Join(t.TempDir(), "staging")
@@ -139,14 +175,18 @@ func assertManifestStaged(t *testing.T, stagingDir string) {
 
 	toolsRaw, ok := manifest["tools"].([]any)
 	require.True(t, ok)
-	assert.Len(t, toolsRaw, 5)
 
+	toolNames := make([]string, 0, len(toolsRaw))
 	for _, raw := range toolsRaw {
 		tool, ok := raw.(map[string]any)
 		require.True(t, ok)
-		assert.NotEmpty(t, tool["name"])
 		assert.NotEmpty(t, tool["description"])
+
+		name, ok := tool["name"].(string)
+		require.True(t, ok)
+		toolNames = append(toolNames, name)
 	}
+	assert.Equal(t, expectedManifestTools(), toolNames)
 
 	userConfigRaw, ok := manifest["user_config"].(map[string]any)
 	require.True(t, ok)
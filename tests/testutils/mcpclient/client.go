@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 	"sync"
@@ -41,6 +42,7 @@ type MCPClient struct {
 	client     *mcp.Client
 	clientImpl *mcp.Implementation
 	transport  *mcp.CommandTransport
+	cmd        *exec.Cmd
 	stderr     *syncBuffer
 }
 
@@ -48,6 +50,7 @@ type MCPClient struct {
 type MCPClientSession struct {
 	session    *mcp.ClientSession
 	clientImpl *mcp.Implementation
+	cmd        *exec.Cmd
 	stderr     *syncBuffer
 }
 
@@ -97,6 +100,7 @@ func newClient(ctx context.Context, serverPath string, env []string, clientOpts
 		client:     client,
 		clientImpl: impl,
 		transport:  transport,
+		cmd:        cmd,
 		stderr:     stderr,
 	}
 }
@@ -129,6 +133,7 @@ func (c *MCPClient) CreateSession(ctx context.Context, opts ...CreateSessionOpti
 	return &MCPClientSession{
 		session:    session,
 		clientImpl: c.clientImpl,
+		cmd:        c.cmd,
 		stderr:     c.stderr,
 	}, nil
 }
@@ -157,6 +162,15 @@ func (s *MCPClientSession) Close() error {
 	return nil
 }
 
+// Interrupt sends an interrupt signal to the MCP server process, as a user pressing Ctrl+C would.
+// Sending an interrupt signal is not supported on Windows.
+func (s *MCPClientSession) Interrupt() error {
+	if s.cmd.Process == nil {
+		return fmt.Errorf("server process is not running")
+	}
+	return s.cmd.Process.Signal(os.Interrupt)
+}
+
 // Stderr returns the captured stderr output from the MCP server process.
 // This is useful for debugging test failures.
 func (s *MCPClientSession) Stderr() string {
@@ -176,6 +190,14 @@ func (s *MCPClientSession) ListResources(ctx context.Context, params *mcp.ListRe
 	return s.session.ListResources(ctx, params)
 }
 
+func (s *MCPClientSession) ListPrompts(ctx context.Context, params *mcp.ListPromptsParams) (*mcp.ListPromptsResult, error) {
+	return s.session.ListPrompts(ctx, params)
+}
+
+func (s *MCPClientSession) Complete(ctx context.Context, params *mcp.CompleteParams) (*mcp.CompleteResult, error) {
+	return s.session.Complete(ctx, params)
+}
+
 // CallTool calls an MCP tool and asserts it doesn't error
 func (s *MCPClientSession) CallTool(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
 	result, err := s.session.CallTool(ctx, &mcp.CallToolParams{